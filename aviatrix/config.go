@@ -1,13 +1,18 @@
 package aviatrix
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
-	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"runtime"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
 )
@@ -20,6 +25,15 @@ type Config struct {
 	Username string
 	// Password is the password for accessing the Aviatrix Controller.
 	Password string
+	// PasswordCommand is a command whose output is the password, run on
+	// every login instead of using Password.
+	PasswordCommand string
+	// PasswordFile is the path to a file containing the password, read on
+	// every login instead of using Password.
+	PasswordFile string
+	// APIToken is a pre-issued session or API token used instead of logging
+	// in with the username and password.
+	APIToken string
 	// ControllerIP Is the IP address of the Aviatrix Controller.
 	ControllerIP string
 	// VerifyCert signals whether to verify the server's certificate chain and
@@ -32,32 +46,91 @@ type Config struct {
 	// across all resources handled by this provider for situations where
 	// external systems are managing certain tags.
 	IgnoreTags *goaviatrix.IgnoreTagsConfig
+	// DefaultTags are added to every resource handled by this provider that
+	// supports tags. Tags set on the resource take precedence.
+	DefaultTags map[string]string
+	// RetryPolicy controls how requests failing with transient errors are
+	// retried. The goaviatrix default is used when nil.
+	RetryPolicy *goaviatrix.RetryPolicy
+	// MaxRequestsPerSecond limits the rate of requests sent to the Aviatrix
+	// Controller. 0 means no limit.
+	MaxRequestsPerSecond float64
+	// MaxConcurrentRequests limits the number of requests in flight to the
+	// Aviatrix Controller at the same time. 0 means no limit.
+	MaxConcurrentRequests int
+	// RedactedLogKeys are field and header names masked in the client logs
+	// in addition to the known sensitive fields.
+	RedactedLogKeys []string
+	// CheckAccountAudit makes gateways fail at plan time when their access
+	// account did not pass the controller's account audit.
+	CheckAccountAudit bool
+	// ClientCertPath and ClientKeyPath are the paths to the PEM encoded
+	// client certificate and key presented for mutual TLS.
+	ClientCertPath string
+	ClientKeyPath  string
+	// TLSServerName overrides the server name used to verify the controller
+	// certificate and sent with SNI.
+	TLSServerName string
+	// MinTLSVersion is the minimum TLS version, such as "1.2", accepted when
+	// connecting to the Aviatrix Controller. The Go default is used when empty.
+	MinTLSVersion string
+	// ExtraHeaders are added to every request sent to the Aviatrix Controller.
+	ExtraHeaders map[string]string
+	// ProxyURL is the URL of the proxy used to reach the Aviatrix Controller.
+	// The proxy environment variables are used when empty.
+	ProxyURL string
+}
+
+// tlsVersions maps the supported min_tls_version values to their TLS version.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// tlsVersionNames returns the supported min_tls_version values.
+func tlsVersionNames() []string {
+	names := make([]string, 0, len(tlsVersions))
+	for name := range tlsVersions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 // wrapTransport represents an HTTP transport used for setting the user-agent
-// for all requests.
+// and the extra headers for all requests.
 type wrapTransport struct {
-	transport http.RoundTripper
-	userAgent string
+	transport    http.RoundTripper
+	userAgent    string
+	extraHeaders map[string]string
 }
 
-// RoundTrip implements the HTTP transport interface sending user-agent for all
-// requests.
+// RoundTrip implements the HTTP transport interface sending user-agent and the
+// extra headers for all requests. Extra headers never replace the headers set
+// by the client, such as the API token or session of the request.
 func (wtr *wrapTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req = req.Clone(req.Context())
+	for k, v := range wtr.extraHeaders {
+		if req.Header.Get(k) == "" {
+			req.Header.Set(k, v)
+		}
+	}
 	req.Header.Set("User-Agent", wtr.userAgent)
 	return wtr.transport.RoundTrip(req)
 }
 
 // defaultTransport returns the default HTTP transport to use when accessing the
 // Aviatrix Controller.
-func defaultTransport(caCertPath string, verifyCert bool) (*http.Transport, error) {
+func defaultTransport(c *Config) (*http.Transport, error) {
 	tlsConfig := &tls.Config{
-		InsecureSkipVerify: !verifyCert,
+		InsecureSkipVerify: !c.VerifyCert,
+		ServerName:         c.TLSServerName,
 	}
 
-	if verifyCert && caCertPath != "" {
-		caCert, err := os.ReadFile(caCertPath)
+	if c.VerifyCert && c.PathToCACert != "" {
+		caCert, err := os.ReadFile(c.PathToCACert)
 		if err != nil {
 			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
 		}
@@ -68,8 +141,33 @@ func defaultTransport(caCertPath string, verifyCert bool) (*http.Transport, erro
 		tlsConfig.RootCAs = caCertPool
 	}
 
+	if c.ClientCertPath != "" || c.ClientKeyPath != "" {
+		cert, err := tls.LoadX509KeyPair(c.ClientCertPath, c.ClientKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if c.MinTLSVersion != "" {
+		version, ok := tlsVersions[c.MinTLSVersion]
+		if !ok {
+			return nil, fmt.Errorf("invalid minimum TLS version %q, expected one of %v", c.MinTLSVersion, tlsVersionNames())
+		}
+		tlsConfig.MinVersion = version
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
 	return &http.Transport{
-		Proxy:           http.ProxyFromEnvironment,
+		Proxy:           proxy,
 		TLSClientConfig: tlsConfig,
 	}, nil
 }
@@ -79,24 +177,64 @@ func getUserAgent() string {
 	return fmt.Sprintf("terraform-provider-aviatrix/%s (%s; %s; %s)", Version, runtime.GOOS, runtime.GOARCH, runtime.Version())
 }
 
+// validateCredentials checks that either an API token or a username with one
+// password source is configured.
+func (c *Config) validateCredentials() error {
+	if c.APIToken != "" {
+		return nil
+	}
+	if c.Username == "" {
+		return errors.New("either \"api_token\" or \"username\" must be set")
+	}
+	if c.Password == "" && c.PasswordCommand == "" && c.PasswordFile == "" {
+		return errors.New("one of \"password\", \"password_command\" or \"password_file\" must be set with \"username\"")
+	}
+	return nil
+}
+
+// passwordSource returns the source of the password when it is not set
+// in-line.
+func (c *Config) passwordSource() goaviatrix.PasswordSource {
+	switch {
+	case c.PasswordCommand != "":
+		return goaviatrix.PasswordFromCommand(c.PasswordCommand)
+	case c.PasswordFile != "":
+		return goaviatrix.PasswordFromFile(c.PasswordFile)
+	}
+	return nil
+}
+
 // Client returns a client for accessing the Aviatrix Controller
-func (c *Config) Client() (*goaviatrix.Client, error) {
-	tr, err := defaultTransport(c.PathToCACert, c.VerifyCert)
+func (c *Config) Client(ctx context.Context) (*goaviatrix.Client, error) {
+	tr, err := defaultTransport(c)
 	if err != nil {
 		return nil, err
 	}
 
-	// Wrap the transport so we always send the user-agent on all requests.
+	// Wrap the transport so we always send the user-agent and extra headers
+	// on all requests.
 	wtr := &wrapTransport{
-		userAgent: getUserAgent(),
-		transport: tr,
+		userAgent:    getUserAgent(),
+		transport:    tr,
+		extraHeaders: c.ExtraHeaders,
 	}
-	client, err := goaviatrix.NewClient(c.Username, c.Password, c.ControllerIP, &http.Client{Transport: wtr}, c.IgnoreTags)
+	client, err := goaviatrix.NewClientContext(ctx, c.Username, c.Password, c.ControllerIP, &http.Client{Transport: wtr}, c.IgnoreTags,
+		goaviatrix.WithRetryPolicy(c.RetryPolicy),
+		goaviatrix.WithDefaultTags(c.DefaultTags),
+		goaviatrix.WithRateLimit(c.MaxRequestsPerSecond),
+		goaviatrix.WithMaxConcurrentRequests(c.MaxConcurrentRequests),
+		goaviatrix.WithRedactedKeys(c.RedactedLogKeys),
+		goaviatrix.WithAPIToken(c.APIToken),
+		goaviatrix.WithPasswordSource(c.passwordSource()))
 
-	log.Printf("[INFO] Aviatrix Client configured for use")
+	tflog.Info(ctx, "Aviatrix Client configured for use")
 
 	if client == nil || err != nil {
-		log.Printf("[ERROR] unable to create client: %s", err)
+		tflog.Error(ctx, "unable to create client", map[string]interface{}{"error": err})
+		return client, err
+	}
+	if c.CheckAccountAudit {
+		accountAuditClients.Store(client, true)
 	}
-	return client, err
+	return client, nil
 }
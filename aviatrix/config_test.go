@@ -0,0 +1,153 @@
+package aviatrix
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testCert is a certificate and key signed by a test CA, written as PEM files.
+type testCert struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certPath string
+	keyPath  string
+}
+
+func newTestCert(t *testing.T, dir, name string, tmpl *x509.Certificate, parent *testCert) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl.SerialNumber = big.NewInt(time.Now().UnixNano())
+	tmpl.Subject = pkix.Name{CommonName: name}
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	parentCert, parentKey := tmpl, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &testCert{
+		cert:     cert,
+		key:      key,
+		certPath: filepath.Join(dir, name+".crt"),
+		keyPath:  filepath.Join(dir, name+".key"),
+	}
+	if err := os.WriteFile(c.certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(c.keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestDefaultTransportMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, dir, "ca", &x509.Certificate{IsCA: true, BasicConstraintsValid: true, KeyUsage: x509.KeyUsageCertSign}, nil)
+	server := newTestCert(t, dir, "server", &x509.Certificate{DNSNames: []string{"controller.internal"}, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}}, ca)
+	client := newTestCert(t, dir, "client", &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}, ca)
+
+	var headers http.Header
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+	srv.TLS = &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{server.cert.Raw}, PrivateKey: server.key}},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS12,
+	}
+	srv.StartTLS()
+	defer srv.Close()
+
+	config := &Config{
+		VerifyCert:     true,
+		PathToCACert:   ca.certPath,
+		ClientCertPath: client.certPath,
+		ClientKeyPath:  client.keyPath,
+		TLSServerName:  "controller.internal",
+		MinTLSVersion:  "1.3",
+		ExtraHeaders:   map[string]string{"X-Proxy-Tenant": "netops", "Content-Type": "text/plain"},
+	}
+	tr, err := defaultTransport(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	httpClient := &http.Client{Transport: &wrapTransport{transport: tr, userAgent: getUserAgent(), extraHeaders: config.ExtraHeaders}}
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, nil)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		t.Fatalf("request with client certificate failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.TLS.Version != tls.VersionTLS13 {
+		t.Errorf("expected TLS 1.3, got %x", resp.TLS.Version)
+	}
+	if headers.Get("X-Proxy-Tenant") != "netops" {
+		t.Errorf("expected the extra header to be sent, got %v", headers)
+	}
+	if headers.Get("Content-Type") != "application/json" {
+		t.Errorf("expected the extra header not to replace the request header, got %q", headers.Get("Content-Type"))
+	}
+
+	config.ClientCertPath, config.ClientKeyPath = "", ""
+	tr, err = defaultTransport(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := (&http.Client{Transport: tr}).Get(srv.URL); err == nil {
+		t.Error("expected the request without client certificate to fail")
+	}
+}
+
+func TestDefaultTransportOptions(t *testing.T) {
+	tr, err := defaultTransport(&Config{ProxyURL: "http://proxy.internal:3128"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://1.2.3.4/v2/api", nil)
+	proxy, err := tr.Proxy(req)
+	if err != nil || proxy == nil || proxy.Host != "proxy.internal:3128" {
+		t.Errorf("expected proxy.internal:3128, got %v, %v", proxy, err)
+	}
+	if !tr.TLSClientConfig.InsecureSkipVerify {
+		t.Error("expected the certificate not to be verified by default")
+	}
+
+	if _, err := defaultTransport(&Config{MinTLSVersion: "1.4"}); err == nil {
+		t.Error("expected an error for an unknown TLS version")
+	}
+	if _, err := defaultTransport(&Config{ClientCertPath: filepath.Join(t.TempDir(), "missing.crt"), ClientKeyPath: "missing.key"}); err == nil {
+		t.Error("expected an error for a missing client certificate")
+	}
+}
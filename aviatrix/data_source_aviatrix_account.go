@@ -1,16 +1,17 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixAccount() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixAccountRead,
+		ReadContext: dataSourceAviatrixAccountRead,
 
 		Schema: map[string]*schema.Schema{
 			"account_name": {
@@ -117,22 +118,22 @@ func dataSourceAviatrixAccount() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixAccountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	account := &goaviatrix.Account{
 		AccountName: d.Get("account_name").(string),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix account: %#v", account)
+	tflog.Info(ctx, "Looking for Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 
-	acc, err := client.GetAccount(account)
+	acc, err := client.GetAccountContext(ctx, account)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("aviatrix Account: %s", err)
+		return diag.Errorf("aviatrix Account: %s", err)
 	}
 
 	_ = d.Set("account_name", acc.AccountName)
@@ -0,0 +1,88 @@
+package aviatrix
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixAccountAudit() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixAccountAuditRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFilterSchema("account_name", "name_regex", "status"),
+			"accounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Audit results of the onboarded cloud accounts.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the access account.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Audit status of the access account.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Audit comment of the access account.",
+						},
+						"audit_passed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the access account passed the audit.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixAccountAuditRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	filters, err := expandDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	records, err := client.GetAccountAuditRecords(ctx)
+	if err != nil {
+		return diag.Errorf("could not get Aviatrix account audit records: %s", err)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].AccountName < records[j].AccountName
+	})
+
+	var result []map[string]interface{}
+	for _, record := range records {
+		item := filterItem{AccountName: record.AccountName, Name: record.AccountName, Status: record.Status}
+		if !matchDataSourceFilters(filters, item) {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"account_name": record.AccountName,
+			"status":       record.Status,
+			"comment":      record.Comment,
+			"audit_passed": record.IsAuditPassed(),
+		})
+	}
+	if err = d.Set("accounts", result); err != nil {
+		return diag.Errorf("couldn't set accounts: %s", err)
+	}
+
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
+	return nil
+}
@@ -0,0 +1,100 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// newAccountAuditClientMock returns a client serving the audit records of
+// the accounts "aws-prod", "azure-dev" and "gcp-dev", where "azure-dev"
+// failed the audit.
+func newAccountAuditClientMock() *goaviatrix.ClientInterfaceMock {
+	return &goaviatrix.ClientInterfaceMock{
+		GetControllerIPFunc: func() string { return "10.0.0.1" },
+		GetAccountAuditRecordsFunc: func(ctx context.Context) ([]goaviatrix.AccountAuditRecord, error) {
+			return []goaviatrix.AccountAuditRecord{
+				{AccountName: "gcp-dev", Status: "Pass"},
+				{AccountName: "azure-dev", Status: "Fail", Comment: "client secret expired"},
+				{AccountName: "aws-prod", Status: "Pass"},
+			}, nil
+		},
+	}
+}
+
+func TestDataSourceAviatrixAccountAuditRead(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   []interface{}
+		expected []string
+	}{
+		{
+			name:     "all accounts sorted by name",
+			expected: []string{"aws-prod", "azure-dev", "gcp-dev"},
+		},
+		{
+			name:     "filter by status",
+			filter:   []interface{}{map[string]interface{}{"status": "fail"}},
+			expected: []string{"azure-dev"},
+		},
+		{
+			name: "filter by name regex or account name",
+			filter: []interface{}{
+				map[string]interface{}{"name_regex": "^gcp-"},
+				map[string]interface{}{"account_name": "aws-prod"},
+			},
+			expected: []string{"aws-prod", "gcp-dev"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{}
+			if tt.filter != nil {
+				raw["filter"] = tt.filter
+			}
+			d := schema.TestResourceDataRaw(t, dataSourceAviatrixAccountAudit().Schema, raw)
+
+			diags := dataSourceAviatrixAccountAuditRead(context.Background(), d, newAccountAuditClientMock())
+
+			assert.Empty(t, diags)
+			assert.Equal(t, "10-0-0-1", d.Id())
+			var names []string
+			for _, account := range d.Get("accounts").([]interface{}) {
+				names = append(names, account.(map[string]interface{})["account_name"].(string))
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestDataSourceAviatrixAccountAuditRead_Attributes(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixAccountAudit().Schema, map[string]interface{}{})
+
+	diags := dataSourceAviatrixAccountAuditRead(context.Background(), d, newAccountAuditClientMock())
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "azure-dev", d.Get("accounts.1.account_name"))
+	assert.Equal(t, "Fail", d.Get("accounts.1.status"))
+	assert.Equal(t, "client secret expired", d.Get("accounts.1.comment"))
+	assert.Equal(t, false, d.Get("accounts.1.audit_passed"))
+	assert.Equal(t, true, d.Get("accounts.0.audit_passed"))
+}
+
+func TestDataSourceAviatrixAccountAuditRead_WhenRequestFails(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetAccountAuditRecordsFunc: func(ctx context.Context) ([]goaviatrix.AccountAuditRecord, error) {
+			return nil, errors.New("boom")
+		},
+	}
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixAccountAudit().Schema, map[string]interface{}{})
+
+	diags := dataSourceAviatrixAccountAuditRead(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("could not get Aviatrix account audit records: boom"), diags)
+}
@@ -0,0 +1,142 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixAwsTgwAttachment() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixAwsTgwAttachmentRead,
+
+		Schema: map[string]*schema.Schema{
+			"tgw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the AWS TGW.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the VPC attached to the AWS TGW.",
+			},
+			"vpc_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the attached VPC.",
+			},
+			"vpc_account_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Access account of the attached VPC.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the attached VPC.",
+			},
+			"network_domain_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Network domain the VPC is attached to.",
+			},
+			"gw_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the Aviatrix gateway of the attached VPC, if any.",
+			},
+			"subnets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the subnets of the attachment.",
+			},
+			"route_tables": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the VPC route tables programmed with the routes of the network domain.",
+			},
+			"customized_routes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Customized routes programmed in the VPC route tables.",
+			},
+			"customized_route_advertisement": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Customized CIDRs advertised by the VPC to the TGW.",
+			},
+			"disable_local_route_propagation": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the VPC CIDR is not propagated to the TGW route table of the network domain.",
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixAwsTgwAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	tgwName := d.Get("tgw_name").(string)
+	vpcID := d.Get("vpc_id").(string)
+
+	info, err := client.GetAwsTgwAttachmentInfoContext(ctx, &goaviatrix.AwsTgwVpcAttachment{
+		TgwName: tgwName,
+		VpcID:   vpcID,
+	})
+	if errors.Is(err, goaviatrix.ErrNotFound) {
+		return diag.Errorf("couldn't find the attachment of VPC %s to AWS TGW %s", vpcID, tgwName)
+	}
+	if err != nil {
+		return diag.Errorf("couldn't get the attachment of VPC %s to AWS TGW %s: %s", vpcID, tgwName, err)
+	}
+
+	details, err := client.GetAttachmentRouteTableDetailsContext(ctx, tgwName, vpcID)
+	if err != nil {
+		return diag.Errorf("couldn't get the route table details of the attachment of VPC %s to AWS TGW %s: %s", vpcID, tgwName, err)
+	}
+
+	d.Set("vpc_name", info.VpcName)
+	d.Set("vpc_account_name", info.AccountName)
+	d.Set("region", info.Region)
+	d.Set("network_domain_name", info.SecurityDomainName)
+	d.Set("gw_name", info.GwName)
+	d.Set("disable_local_route_propagation", details.DisableLocalRoutePropagation)
+
+	// Subnets are returned as "subnet-id~~subnet-name".
+	var subnets []string
+	for _, subnet := range details.Subnets {
+		subnets = append(subnets, strings.Split(subnet, "~~")[0])
+	}
+	if err := d.Set("subnets", subnets); err != nil {
+		return diag.Errorf("failed to set subnets: %s", err)
+	}
+	var routeTables []string
+	for _, routeTable := range strings.Split(details.RouteTables, ",") {
+		if routeTable = strings.TrimSpace(routeTable); routeTable != "" {
+			routeTables = append(routeTables, routeTable)
+		}
+	}
+	if err := d.Set("route_tables", routeTables); err != nil {
+		return diag.Errorf("failed to set route_tables: %s", err)
+	}
+	if err := d.Set("customized_routes", details.CustomizedRoutes); err != nil {
+		return diag.Errorf("failed to set customized_routes: %s", err)
+	}
+	if err := d.Set("customized_route_advertisement", details.CustomizedRouteAdvertisement); err != nil {
+		return diag.Errorf("failed to set customized_route_advertisement: %s", err)
+	}
+
+	d.SetId(tgwName + "~" + info.SecurityDomainName + "~" + vpcID)
+	return nil
+}
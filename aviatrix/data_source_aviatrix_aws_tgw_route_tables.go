@@ -0,0 +1,241 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixAwsTgwRouteTables() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixAwsTgwRouteTablesRead,
+
+		Schema: map[string]*schema.Schema{
+			"tgw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the AWS TGW.",
+			},
+			"filter": dataSourceFilterSchema("name_regex"),
+			"tgw_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the AWS TGW.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Access account of the AWS TGW.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the AWS TGW.",
+			},
+			"route_tables": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "TGW route tables of the network domains of the AWS TGW.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network_domain_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the network domain.",
+						},
+						"route_table_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the TGW route table of the network domain.",
+						},
+						"connected_network_domains": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Network domains connected to the network domain.",
+						},
+						"aviatrix_firewall": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the network domain is an Aviatrix firewall domain.",
+						},
+						"native_egress": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the network domain is a native egress domain.",
+						},
+						"native_firewall": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the network domain is a native firewall domain.",
+						},
+						"attached_vpcs": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "VPCs attached to the network domain.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"vpc_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of the VPC.",
+									},
+									"vpc_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the VPC.",
+									},
+									"account_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Access account of the VPC.",
+									},
+									"region": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Region of the VPC.",
+									},
+									"attachment_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of the TGW attachment.",
+									},
+									"vpc_cidrs": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "CIDRs of the VPC.",
+									},
+								},
+							},
+						},
+						"routes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Routes of the TGW route table.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cidr_block": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Destination CIDR of the route.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Type of the route: 'propagated' or 'static'.",
+									},
+									"state": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "State of the route, such as 'active' or 'blackhole'.",
+									},
+									"vpc_ids": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "IDs of the VPCs the route points to.",
+									},
+									"tgw_attachment_ids": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "IDs of the TGW attachments the route points to.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixAwsTgwRouteTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	tgwName := d.Get("tgw_name").(string)
+
+	filters, err := expandDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	awsTgw, err := client.GetAwsTgwDetailContext(ctx, &goaviatrix.AWSTgw{Name: tgwName})
+	if errors.Is(err, goaviatrix.ErrNotFound) {
+		return diag.Errorf("couldn't find AWS TGW %s", tgwName)
+	}
+	if err != nil {
+		return diag.Errorf("couldn't get AWS TGW %s: %s", tgwName, err)
+	}
+	d.Set("tgw_id", awsTgw.TgwId)
+	d.Set("account_name", awsTgw.AccountName)
+	d.Set("region", awsTgw.Region)
+
+	routeDomains, err := client.ListAwsTgwRouteDomainDetailsContext(ctx, tgwName)
+	if err != nil {
+		return diag.Errorf("couldn't get the network domains of AWS TGW %s: %s", tgwName, err)
+	}
+
+	var routeTables []map[string]interface{}
+	for _, domain := range routeDomains {
+		if !matchDataSourceFilters(filters, filterItem{Name: domain.Name}) {
+			continue
+		}
+
+		var connectedDomains []string
+		for _, connected := range domain.ConnectedRouteDomain {
+			// Skip the domains of TGW peerings
+			if strings.HasPrefix(connected, "peering_") || strings.Contains(connected, ":") {
+				continue
+			}
+			connectedDomains = append(connectedDomains, connected)
+		}
+
+		var attachedVpcs []map[string]interface{}
+		for _, vpc := range domain.AttachedVPC {
+			attachedVpcs = append(attachedVpcs, map[string]interface{}{
+				"vpc_id":        vpc.VPCId,
+				"vpc_name":      vpc.VPCName,
+				"account_name":  vpc.AccountName,
+				"region":        vpc.Region,
+				"attachment_id": vpc.AttachmentId,
+				"vpc_cidrs":     vpc.VPCCidr,
+			})
+		}
+
+		var routes []map[string]interface{}
+		for _, route := range domain.RoutesInRouteTable {
+			routes = append(routes, map[string]interface{}{
+				"cidr_block":         route.CidrBlock,
+				"type":               route.Type,
+				"state":              route.State,
+				"vpc_ids":            route.VPCId,
+				"tgw_attachment_ids": route.TgwAttachmentId,
+			})
+		}
+
+		routeTables = append(routeTables, map[string]interface{}{
+			"network_domain_name":       domain.Name,
+			"route_table_id":            domain.RouteTableId,
+			"connected_network_domains": connectedDomains,
+			"aviatrix_firewall":         domain.AviatrixFirewallDomain,
+			"native_egress":             domain.NativeEgressDomain,
+			"native_firewall":           domain.NativeFirewallDomain,
+			"attached_vpcs":             attachedVpcs,
+			"routes":                    routes,
+		})
+	}
+	if err = d.Set("route_tables", routeTables); err != nil {
+		return diag.Errorf("couldn't set route_tables: %s", err)
+	}
+
+	d.SetId(tgwName)
+	return nil
+}
@@ -0,0 +1,114 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceAviatrixAwsTgwAttachmentRead(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetAwsTgwAttachmentInfoContextFunc: func(ctx context.Context, attachment *goaviatrix.AwsTgwVpcAttachment) (*goaviatrix.AttachmentInfo, error) {
+			assert.Equal(t, "tgw-1", attachment.TgwName)
+			assert.Equal(t, "vpc-0123", attachment.VpcID)
+			return &goaviatrix.AttachmentInfo{VpcID: "vpc-0123", VpcName: "app", AccountName: "prod", Region: "us-east-1", SecurityDomainName: "prod-domain"}, nil
+		},
+		GetAttachmentRouteTableDetailsContextFunc: func(ctx context.Context, tgwName string, attachmentName string) (*goaviatrix.AttachmentRouteTableDetails, error) {
+			return &goaviatrix.AttachmentRouteTableDetails{
+				Subnets:          []string{"subnet-1~~app-a", "subnet-2~~app-b"},
+				RouteTables:      "rtb-1, rtb-2",
+				CustomizedRoutes: []string{"10.0.0.0/8"},
+			}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixAwsTgwAttachment().Schema, map[string]interface{}{
+		"tgw_name": "tgw-1",
+		"vpc_id":   "vpc-0123",
+	})
+	diags := dataSourceAviatrixAwsTgwAttachmentRead(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "tgw-1~prod-domain~vpc-0123", d.Id())
+	assert.Equal(t, "prod-domain", d.Get("network_domain_name"))
+	assert.Equal(t, []interface{}{"subnet-1", "subnet-2"}, d.Get("subnets"))
+	assert.Equal(t, []interface{}{"rtb-1", "rtb-2"}, d.Get("route_tables"))
+	assert.Equal(t, []interface{}{"10.0.0.0/8"}, d.Get("customized_routes"))
+}
+
+func TestDataSourceAviatrixAwsTgwAttachmentRead_WhenNotFound(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetAwsTgwAttachmentInfoContextFunc: func(ctx context.Context, attachment *goaviatrix.AwsTgwVpcAttachment) (*goaviatrix.AttachmentInfo, error) {
+			return nil, goaviatrix.ErrNotFound
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixAwsTgwAttachment().Schema, map[string]interface{}{
+		"tgw_name": "tgw-1",
+		"vpc_id":   "vpc-0123",
+	})
+	diags := dataSourceAviatrixAwsTgwAttachmentRead(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("couldn't find the attachment of VPC vpc-0123 to AWS TGW tgw-1"), diags)
+}
+
+func TestDataSourceAviatrixAwsTgwRouteTablesRead(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetAwsTgwDetailContextFunc: func(ctx context.Context, awsTgw *goaviatrix.AWSTgw) (*goaviatrix.AWSTgw, error) {
+			awsTgw.TgwId = "tgw-0abc"
+			awsTgw.Region = "us-east-1"
+			return awsTgw, nil
+		},
+		ListAwsTgwRouteDomainDetailsContextFunc: func(ctx context.Context, tgwName string) ([]goaviatrix.RouteDomainDetail, error) {
+			return []goaviatrix.RouteDomainDetail{
+				{Name: "Aviatrix_Edge_Domain", RouteTableId: "tgw-rtb-edge"},
+				{
+					Name:                 "prod-domain",
+					RouteTableId:         "tgw-rtb-prod",
+					ConnectedRouteDomain: []string{"shared", "peering_tgw-2"},
+					AttachedVPC:          []goaviatrix.AttachedVPCDetail{{VPCId: "vpc-0123", AttachmentId: "tgw-attach-1", VPCCidr: []string{"10.1.0.0/16"}}},
+					RoutesInRouteTable: []goaviatrix.RoutesInRouteTable{
+						{CidrBlock: "10.1.0.0/16", Type: "propagated", State: "active", VPCId: []string{"vpc-0123"}, TgwAttachmentId: []string{"tgw-attach-1"}},
+						{CidrBlock: "0.0.0.0/0", Type: "static", State: "blackhole"},
+					},
+				},
+			}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixAwsTgwRouteTables().Schema, map[string]interface{}{
+		"tgw_name": "tgw-1",
+		"filter":   []interface{}{map[string]interface{}{"name_regex": "^prod-"}},
+	})
+	diags := dataSourceAviatrixAwsTgwRouteTablesRead(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "tgw-0abc", d.Get("tgw_id"))
+	assert.Equal(t, 1, d.Get("route_tables.#"))
+	assert.Equal(t, "tgw-rtb-prod", d.Get("route_tables.0.route_table_id"))
+	assert.Equal(t, []interface{}{"shared"}, d.Get("route_tables.0.connected_network_domains"))
+	assert.Equal(t, "tgw-attach-1", d.Get("route_tables.0.attached_vpcs.0.attachment_id"))
+	assert.Equal(t, 2, d.Get("route_tables.0.routes.#"))
+	assert.Equal(t, "blackhole", d.Get("route_tables.0.routes.1.state"))
+	assert.Equal(t, []interface{}{"vpc-0123"}, d.Get("route_tables.0.routes.0.vpc_ids"))
+}
+
+func TestDataSourceAviatrixAwsTgwRouteTablesRead_WhenNotFound(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetAwsTgwDetailContextFunc: func(ctx context.Context, awsTgw *goaviatrix.AWSTgw) (*goaviatrix.AWSTgw, error) {
+			return nil, fmt.Errorf("couldn't find AWS TGW %s: %w", awsTgw.Name, goaviatrix.ErrNotFound)
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixAwsTgwRouteTables().Schema, map[string]interface{}{
+		"tgw_name": "tgw-1",
+	})
+	diags := dataSourceAviatrixAwsTgwRouteTablesRead(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("couldn't find AWS TGW tgw-1"), diags)
+}
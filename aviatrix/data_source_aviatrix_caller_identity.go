@@ -1,16 +1,17 @@
 package aviatrix
 
 import (
-	"log"
+	"context"
 	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixCallerIdentity() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixCallerIdentityRead,
+		ReadContext: dataSourceAviatrixCallerIdentityRead,
 
 		Schema: map[string]*schema.Schema{
 			"cid": {
@@ -22,12 +23,10 @@ func dataSourceAviatrixCallerIdentity() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixCallerIdentityRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
-
-	log.Printf("[DEBUG] CID is '%s'", client.CID)
+func dataSourceAviatrixCallerIdentityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	d.SetId(time.Now().UTC().String())
-	d.Set("cid", client.CID)
+	d.Set("cid", client.GetCID())
 	return nil
 }
@@ -40,7 +40,7 @@ func dataSourceAviatrixControllerMetadata() *schema.Resource {
 }
 
 func dataSourceAviatrixControllerMetadataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	controllerMetadata, err := client.GetControllerMetadata(ctx)
 	if err != nil {
@@ -56,6 +56,6 @@ func dataSourceAviatrixControllerMetadataRead(ctx context.Context, d *schema.Res
 	d.Set("instance_id", controllerMetadata.InstanceId)
 	d.Set("cloud_type", controllerMetadata.CloudType)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
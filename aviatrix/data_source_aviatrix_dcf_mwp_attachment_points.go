@@ -28,9 +28,9 @@ func dataSourceAviatrixDcfMwpAttachmentPoints() *schema.Resource {
 }
 
 func dataSourceAviatrixDcfMwpAttachmentPointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("client must be of type *goaviatrix.Client")
+		return diag.Errorf("client must be of type goaviatrix.ClientInterface")
 	}
 
 	name := d.Get("name").(string)
@@ -54,7 +54,7 @@ func dataSourceAviatrixDcfWebgroups() *schema.Resource {
 }
 
 func dataSourceAviatrixDcfWebgroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	name, ok := d.Get("name").(string)
 	if !ok {
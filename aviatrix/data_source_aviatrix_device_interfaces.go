@@ -1,15 +1,16 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixDeviceInterfaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixDeviceInterfaceConfigRead,
+		ReadContext: dataSourceAviatrixDeviceInterfaceConfigRead,
 
 		Schema: map[string]*schema.Schema{
 			"device_name": {
@@ -40,14 +41,14 @@ func dataSourceAviatrixDeviceInterfaces() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixDeviceInterfaceConfigRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixDeviceInterfaceConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	deviceName := d.Get("device_name").(string)
 
-	deviceWanInterfaces, err := client.GetDeviceInterfaces(deviceName)
+	deviceWanInterfaces, err := client.GetDeviceInterfacesContext(ctx, deviceName)
 	if err != nil {
-		return fmt.Errorf("couldn't get device wan interfaces: %s", err)
+		return diag.Errorf("couldn't get device wan interfaces: %s", err)
 	}
 
 	var wanInterfaces []map[string]interface{}
@@ -59,7 +60,7 @@ func dataSourceAviatrixDeviceInterfaceConfigRead(d *schema.ResourceData, meta in
 	}
 
 	if err = d.Set("wan_interfaces", wanInterfaces); err != nil {
-		return fmt.Errorf("couldn't set wan_interfaces: %s", err)
+		return diag.Errorf("couldn't set wan_interfaces: %s", err)
 	}
 
 	d.SetId(deviceName)
@@ -34,7 +34,7 @@ func dataSourceAviatrixEdgeGatewayWanInterfaceDiscovery() *schema.Resource {
 }
 
 func dataSourceAviatrixEdgeGatewayWanInterfaceDiscoveryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	gwName := d.Get("gw_name").(string)
 	wanInterfaceName := d.Get("wan_interface_name").(string)
@@ -1,15 +1,16 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixFireNet() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixFireNetRead,
+		ReadContext: dataSourceAviatrixFireNetRead,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -49,20 +50,20 @@ func dataSourceAviatrixFireNet() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixFireNetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixFireNetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	fireNet := &goaviatrix.FireNet{
 		VpcID: d.Get("vpc_id").(string),
 	}
 
-	fireNetDetail, err := client.GetFireNet(fireNet)
+	fireNetDetail, err := client.GetFireNetContext(ctx, fireNet)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find FireNet: %s", err)
+		return diag.Errorf("couldn't find FireNet: %s", err)
 	}
 
 	d.Set("vpc_id", fireNetDetail.VpcID)
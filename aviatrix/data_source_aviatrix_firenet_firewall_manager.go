@@ -90,7 +90,7 @@ func dataSourceAviatrixFireNetFirewallManager() *schema.Resource {
 }
 
 func dataSourceAviatrixFireNetFirewallManagerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	firewallManager := &goaviatrix.FirewallManager{
 		VpcID:         d.Get("vpc_id").(string),
@@ -1,15 +1,16 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixFirewall() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixFirewallRead,
+		ReadContext: dataSourceAviatrixFirewallRead,
 
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
@@ -75,8 +76,8 @@ func dataSourceAviatrixFirewall() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixFirewallRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixFirewallRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gwName := d.Get("gw_name").(string)
 
@@ -84,14 +85,14 @@ func dataSourceAviatrixFirewallRead(d *schema.ResourceData, meta interface{}) er
 		GwName: gwName,
 	}
 
-	fw, err := client.GetPolicy(firewall)
+	fw, err := client.GetPolicyContext(ctx, firewall)
 
 	if err == goaviatrix.ErrNotFound {
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("error fetching firewall policy for gateway %s: %s", firewall.GwName, err)
+		return diag.Errorf("error fetching firewall policy for gateway %s: %s", firewall.GwName, err)
 	}
 
 	d.Set("gw_name", gwName)
@@ -111,7 +112,7 @@ func dataSourceAviatrixFirewallRead(d *schema.ResourceData, meta interface{}) er
 		policies = append(policies, goaviatrix.PolicyToMap(p))
 	}
 	if err = d.Set("policies", policies); err != nil {
-		return fmt.Errorf("error setting firewall policies for gateway %s: %s", firewall.GwName, err)
+		return diag.Errorf("error setting firewall policies for gateway %s: %s", firewall.GwName, err)
 	}
 
 	d.SetId(gwName)
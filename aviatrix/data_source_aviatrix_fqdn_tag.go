@@ -0,0 +1,161 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixFQDNTag() *schema.Resource {
+	s := dataSourceAviatrixFQDNTagSchema()
+	s["fqdn_tag"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "FQDN Filter Tag Name.",
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixFQDNTagRead,
+
+		Schema: s,
+	}
+}
+
+// dataSourceAviatrixFQDNTagSchema returns the computed attributes of an FQDN
+// filter tag, shared by the aviatrix_fqdn_tag and aviatrix_fqdn_tags data
+// sources.
+func dataSourceAviatrixFQDNTagSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"fqdn_enabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "FQDN Filter Tag Status.",
+		},
+		"fqdn_mode": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Tag color: 'white' for a white-list tag or 'black' for a black-list tag.",
+		},
+		"domain_names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Domain names/tag rules of the tag.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"fqdn": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "FQDN.",
+					},
+					"proto": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Protocol.",
+					},
+					"port": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Port.",
+					},
+					"action": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Action of matching requests: 'Base Policy', 'Allow' or 'Deny'.",
+					},
+				},
+			},
+		},
+		"gw_filter_tag_list": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Gateways attached to the tag.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"gw_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the gateway attached to the tag.",
+					},
+					"source_ip_list": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "List of source IPs in the VPC qualified for the tag.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenFQDNTag reads the domain names and attached gateways of an FQDN
+// filter tag and returns the attributes of dataSourceAviatrixFQDNTagSchema.
+func flattenFQDNTag(ctx context.Context, client goaviatrix.ClientInterface, fqdn *goaviatrix.FQDN) (map[string]interface{}, error) {
+	tag := &goaviatrix.FQDN{FQDNTag: fqdn.FQDNTag}
+
+	domains, err := client.ListDomainsContext(ctx, tag)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't list the domain names of FQDN tag %s: %w", fqdn.FQDNTag, err)
+	}
+	var domainNames []map[string]interface{}
+	for _, domain := range domains.DomainList {
+		domainNames = append(domainNames, map[string]interface{}{
+			"fqdn":   domain.FQDN,
+			"proto":  domain.Protocol,
+			"port":   domain.Port,
+			"action": domain.Verdict,
+		})
+	}
+
+	gws, err := client.GetGwFilterTagListContext(ctx, tag)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't list the gateways of FQDN tag %s: %w", fqdn.FQDNTag, err)
+	}
+	var gwFilterTagList []map[string]interface{}
+	for _, gw := range gws.GwFilterTagList {
+		gwFilterTagList = append(gwFilterTagList, map[string]interface{}{
+			"gw_name":        gw.Name,
+			"source_ip_list": gw.SourceIPList,
+		})
+	}
+
+	return map[string]interface{}{
+		"fqdn_tag":           fqdn.FQDNTag,
+		"fqdn_enabled":       fqdn.FQDNStatus == "enabled",
+		"fqdn_mode":          fqdn.FQDNMode,
+		"domain_names":       domainNames,
+		"gw_filter_tag_list": gwFilterTagList,
+	}, nil
+}
+
+func dataSourceAviatrixFQDNTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	tagName := d.Get("fqdn_tag").(string)
+
+	fqdn, err := client.GetFQDNTagContext(ctx, &goaviatrix.FQDN{FQDNTag: tagName})
+	if errors.Is(err, goaviatrix.ErrNotFound) {
+		return diag.Errorf("couldn't find FQDN tag %s", tagName)
+	}
+	if err != nil {
+		return diag.Errorf("couldn't get FQDN tag %s: %s", tagName, err)
+	}
+
+	tag, err := flattenFQDNTag(ctx, client, fqdn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for k, v := range tag {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s: %s", k, err)
+		}
+	}
+
+	d.SetId(tagName)
+	return nil
+}
@@ -0,0 +1,111 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFQDNTagsClientMock returns a client serving the FQDN tags "dev", "prod"
+// and "web", where "prod" is attached to gateway "egress-gw".
+func newFQDNTagsClientMock() *goaviatrix.ClientInterfaceMock {
+	tags := map[string]*goaviatrix.FQDN{
+		"web":  {FQDNTag: "web", FQDNMode: "white", FQDNStatus: "enabled"},
+		"prod": {FQDNTag: "prod", FQDNMode: "white", FQDNStatus: "enabled"},
+		"dev":  {FQDNTag: "dev", FQDNMode: "black", FQDNStatus: "disabled"},
+	}
+	return &goaviatrix.ClientInterfaceMock{
+		GetControllerIPFunc: func() string { return "10.0.0.1" },
+		ListFQDNTagsContextFunc: func(ctx context.Context) ([]*goaviatrix.FQDN, error) {
+			return []*goaviatrix.FQDN{tags["web"], tags["prod"], tags["dev"]}, nil
+		},
+		GetFQDNTagContextFunc: func(ctx context.Context, fqdn *goaviatrix.FQDN) (*goaviatrix.FQDN, error) {
+			if tag, ok := tags[fqdn.FQDNTag]; ok {
+				return tag, nil
+			}
+			return nil, goaviatrix.ErrNotFound
+		},
+		ListDomainsContextFunc: func(ctx context.Context, fqdn *goaviatrix.FQDN) (*goaviatrix.FQDN, error) {
+			fqdn.DomainList = []*goaviatrix.Filters{{FQDN: fqdn.FQDNTag + ".example.com", Protocol: "tcp", Port: "443", Verdict: "Allow"}}
+			return fqdn, nil
+		},
+		GetGwFilterTagListContextFunc: func(ctx context.Context, fqdn *goaviatrix.FQDN) (*goaviatrix.FQDN, error) {
+			if fqdn.FQDNTag == "prod" {
+				fqdn.GwFilterTagList = []goaviatrix.GwFilterTag{{Name: "egress-gw", SourceIPList: []string{"10.1.0.0/24"}}}
+			}
+			return fqdn, nil
+		},
+	}
+}
+
+func TestDataSourceAviatrixFQDNTagRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixFQDNTag().Schema, map[string]interface{}{
+		"fqdn_tag": "prod",
+	})
+	diags := dataSourceAviatrixFQDNTagRead(context.Background(), d, newFQDNTagsClientMock())
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "prod", d.Id())
+	assert.Equal(t, true, d.Get("fqdn_enabled"))
+	assert.Equal(t, "white", d.Get("fqdn_mode"))
+	assert.Equal(t, "prod.example.com", d.Get("domain_names.0.fqdn"))
+	assert.Equal(t, "Allow", d.Get("domain_names.0.action"))
+	assert.Equal(t, "egress-gw", d.Get("gw_filter_tag_list.0.gw_name"))
+	assert.Equal(t, []interface{}{"10.1.0.0/24"}, d.Get("gw_filter_tag_list.0.source_ip_list"))
+}
+
+func TestDataSourceAviatrixFQDNTagRead_WhenNotFound(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixFQDNTag().Schema, map[string]interface{}{
+		"fqdn_tag": "missing",
+	})
+	diags := dataSourceAviatrixFQDNTagRead(context.Background(), d, newFQDNTagsClientMock())
+
+	assert.Equal(t, diag.Errorf("couldn't find FQDN tag missing"), diags)
+}
+
+func TestDataSourceAviatrixFQDNTagsRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  []interface{}
+		want    []string
+		fetched []string
+	}{
+		{name: "no filter", want: []string{"dev", "prod", "web"}, fetched: []string{"dev", "prod", "web"}},
+		{name: "status", filter: []interface{}{map[string]interface{}{"status": "Enabled"}}, want: []string{"prod", "web"}, fetched: []string{"prod", "web"}},
+		{name: "gateway", filter: []interface{}{map[string]interface{}{"gw_name": "egress-gw"}}, want: []string{"prod"}, fetched: []string{"dev", "prod", "web"}},
+		{name: "gateway and status", filter: []interface{}{map[string]interface{}{"gw_name": "egress-gw", "status": "enabled"}}, want: []string{"prod"}, fetched: []string{"prod", "web"}},
+		{name: "name or status", filter: []interface{}{
+			map[string]interface{}{"name_regex": "^w"},
+			map[string]interface{}{"status": "disabled"},
+		}, want: []string{"dev", "web"}, fetched: []string{"dev", "web"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFQDNTagsClientMock()
+			listDomains := client.ListDomainsContextFunc
+			var fetched []string
+			client.ListDomainsContextFunc = func(ctx context.Context, fqdn *goaviatrix.FQDN) (*goaviatrix.FQDN, error) {
+				fetched = append(fetched, fqdn.FQDNTag)
+				return listDomains(ctx, fqdn)
+			}
+
+			d := schema.TestResourceDataRaw(t, dataSourceAviatrixFQDNTags().Schema, map[string]interface{}{
+				"filter": tt.filter,
+			})
+			diags := dataSourceAviatrixFQDNTagsRead(context.Background(), d, client)
+
+			assert.Empty(t, diags)
+			var names []string
+			for _, tag := range d.Get("fqdn_tags").([]interface{}) {
+				names = append(names, tag.(map[string]interface{})["fqdn_tag"].(string))
+			}
+			assert.Equal(t, tt.want, names)
+			assert.Equal(t, tt.fetched, fetched)
+			assert.Len(t, client.GetGwFilterTagListContextCalls(), len(tt.fetched))
+		})
+	}
+}
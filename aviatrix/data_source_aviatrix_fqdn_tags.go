@@ -0,0 +1,82 @@
+package aviatrix
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixFQDNTags() *schema.Resource {
+	tagSchema := dataSourceAviatrixFQDNTagSchema()
+	tagSchema["fqdn_tag"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "FQDN Filter Tag Name.",
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixFQDNTagsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFilterSchema("name_regex", "gw_name", "status"),
+			"fqdn_tags": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of FQDN Filter Tags.",
+				Elem:        &schema.Resource{Schema: tagSchema},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixFQDNTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	filters, err := expandDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fqdnTags, err := client.ListFQDNTagsContext(ctx)
+	if err != nil {
+		return diag.Errorf("could not get Aviatrix FQDN tags: %s", err)
+	}
+	sort.Slice(fqdnTags, func(i, j int) bool {
+		return fqdnTags[i].FQDNTag < fqdnTags[j].FQDNTag
+	})
+
+	// The domain names and gateways of a tag take two more calls, only make
+	// them for the tags matching the other filter arguments.
+	prefilters := withoutGwName(filters)
+
+	var result []map[string]interface{}
+	for _, fqdn := range fqdnTags {
+		item := filterItem{Name: fqdn.FQDNTag, Status: fqdn.FQDNStatus}
+		if !matchDataSourceFilters(prefilters, item) {
+			continue
+		}
+
+		tag, err := flattenFQDNTag(ctx, client, fqdn)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, gw := range tag["gw_filter_tag_list"].([]map[string]interface{}) {
+			item.GwNames = append(item.GwNames, gw["gw_name"].(string))
+		}
+		if !matchDataSourceFilters(filters, item) {
+			continue
+		}
+		result = append(result, tag)
+	}
+	if err = d.Set("fqdn_tags", result); err != nil {
+		return diag.Errorf("couldn't set fqdn_tags: %s", err)
+	}
+
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
+	return nil
+}
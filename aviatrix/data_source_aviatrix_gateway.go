@@ -1,18 +1,20 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixGatewayRead,
+		ReadContext: dataSourceAviatrixGatewayRead,
 
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
@@ -434,8 +436,9 @@ func dataSourceAviatrixGateway() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	var diags diag.Diagnostics
 
 	gateway := &goaviatrix.Gateway{
 		GwName: d.Get("gw_name").(string),
@@ -445,13 +448,13 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 		gateway.AccountName = d.Get("account_name").(string)
 	}
 
-	gw, err := client.GetGateway(gateway)
+	gw, err := client.GetGatewayContext(ctx, gateway)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Gateway: %s", err)
 	}
 	if gw != nil {
 		d.Set("cloud_type", gw.CloudType)
@@ -512,9 +515,9 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 			d.Set("enable_ldap", false)
 		}
 
-		gwDetail, err := client.GetGatewayDetail(gateway)
+		gwDetail, err := client.GetGatewayDetailContext(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("couldn't get Detail info for VPN gateway: %s due to: %s", gateway.GwName, err)
+			return diag.Errorf("couldn't get Detail info for VPN gateway: %s due to: %s", gateway.GwName, err)
 		}
 		if gw.VpnStatus != "" {
 			if gw.VpnStatus == "disabled" {
@@ -651,7 +654,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 		} else {
 			d.Set("enable_public_subnet_filtering", true)
 			if err := d.Set("public_subnet_filtering_route_tables", gw.PsfDetails.RouteTableList); err != nil {
-				return fmt.Errorf("could not set public_subnet_filtering_route_tables into state: %v", err)
+				return diag.Errorf("could not set public_subnet_filtering_route_tables into state: %v", err)
 			}
 			d.Set("public_subnet_filtering_guard_duty_enforced", gw.PsfDetails.GuardDutyEnforced == "yes")
 			d.Set("subnet", gw.PsfDetails.GwSubnetCidr)
@@ -659,11 +662,11 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 			if gw.HaGw.GwSize == "" {
 				err := d.Set("public_subnet_filtering_ha_route_tables", nil)
 				if err != nil {
-					return fmt.Errorf("could not set public_subnet_filtering_ha_route_tables into state: %v", err)
+					return diag.Errorf("could not set public_subnet_filtering_ha_route_tables into state: %v", err)
 				}
 			} else {
 				if err := d.Set("public_subnet_filtering_ha_route_tables", gw.PsfDetails.HaRouteTableList); err != nil {
-					return fmt.Errorf("could not set public_subnet_filtering_ha_route_tables into state: %v", err)
+					return diag.Errorf("could not set public_subnet_filtering_ha_route_tables into state: %v", err)
 				}
 				d.Set("peering_ha_subnet", gw.PsfDetails.HaGwSubnetCidr)
 				d.Set("peering_ha_zone", gw.PsfDetails.HaGwSubnetAz)
@@ -674,7 +677,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 			AccountName: d.Get("account_name").(string),
 			GwName:      d.Get("gw_name").(string) + "-hagw",
 		}
-		gwHaGw, _ := client.GetGateway(peeringHaGateway)
+		gwHaGw, _ := client.GetGatewayContext(ctx, peeringHaGateway)
 		if gwHaGw != nil {
 			d.Set("peering_ha_cloud_instance_id", gwHaGw.CloudnGatewayInstID)
 			d.Set("peering_ha_gw_name", gwHaGw.GwName)
@@ -689,7 +692,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 				if len(azureEip) == 3 {
 					d.Set("peering_ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 				} else {
-					log.Printf("[WARN] could not get Azure EIP name and resource group for the Peering HA Gateway %s", gw.GwName)
+					tflog.Warn(ctx, "could not get Azure EIP name and resource group for the Peering HA Gateway", map[string]interface{}{"gw_name": gw.GwName})
 				}
 			}
 			if !gw.IsPsfGateway {
@@ -729,13 +732,17 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 				CloudType:    gw.CloudType,
 			}
 
-			_, err := client.GetTags(tags)
+			_, err := client.GetTagsContext(ctx, tags)
 			if err != nil {
-				log.Printf("[WARN] Failed to get tags for gateway %s: %v", tags.ResourceName, err)
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Failed to get tags for gateway %s", tags.ResourceName),
+					Detail:   err.Error(),
+				})
 			}
 			if len(tags.Tags) > 0 {
 				if err := d.Set("tags", tags.Tags); err != nil {
-					log.Printf("[WARN] Error setting tags for gateway %s: %v", tags.ResourceName, err)
+					tflog.Warn(ctx, "Error setting tags for gateway", map[string]interface{}{"resource_name": tags.ResourceName, "error": err})
 				}
 			}
 		}
@@ -749,7 +756,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 			} else {
 				splitTunnel.ElbName = gw.GwName
 			}
-			splitTunnel1, _ := client.GetSplitTunnel(splitTunnel)
+			splitTunnel1, _ := client.GetSplitTunnelContext(ctx, splitTunnel)
 			if splitTunnel1 != nil {
 				d.Set("name_servers", splitTunnel1.NameServers)
 				d.Set("search_domains", splitTunnel1.SearchDomains)
@@ -762,17 +769,26 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 		}
 
 		d.Set("tunnel_detection_time", gw.TunnelDetectionTime)
-		d.Set("enable_jumbo_frame", gw.JumboFrame)
+		jumboFrame, err := client.GetJumboFrameStatusContext(ctx, &goaviatrix.Gateway{GwName: gw.GwName})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to get jumbo frame status for gateway %s", gw.GwName),
+				Detail:   err.Error(),
+			})
+			jumboFrame = gw.JumboFrame
+		}
+		d.Set("enable_jumbo_frame", jumboFrame)
 
 		d.Set("enable_monitor_gateway_subnets", gw.MonitorSubnetsAction == "enable")
 		if err := d.Set("monitor_exclude_list", gw.MonitorExcludeGWList); err != nil {
-			return fmt.Errorf("setting 'monitor_exclude_list' to state: %v", err)
+			return diag.Errorf("setting 'monitor_exclude_list' to state: %v", err)
 		}
 
 		if gw.IdleTimeout != "NA" {
 			idleTimeout, err := strconv.Atoi(gw.IdleTimeout)
 			if err != nil {
-				return fmt.Errorf("couldn't get idle timeout for the gateway %s: %v", gw.GwName, err)
+				return diag.Errorf("couldn't get idle timeout for the gateway %s: %v", gw.GwName, err)
 			}
 			d.Set("idle_timeout", idleTimeout)
 		} else {
@@ -782,7 +798,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 		if gw.RenegotiationInterval != "NA" {
 			renegotiationInterval, err := strconv.Atoi(gw.RenegotiationInterval)
 			if err != nil {
-				return fmt.Errorf("couldn't get renegotiation interval for the gateway %s: %v", gw.GwName, err)
+				return diag.Errorf("couldn't get renegotiation interval for the gateway %s: %v", gw.GwName, err)
 			}
 			d.Set("renegotiation_interval", renegotiationInterval)
 		} else {
@@ -792,6 +808,16 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 		fqdnLanCidr, ok := gw.ArmFqdnLanCidr[gw.GwName]
 		if ok && goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 			d.Set("fqdn_lan_cidr", fqdnLanCidr)
+			fqdnGatewayInfo, err := client.GetFqdnGatewayInfoContext(ctx, &goaviatrix.Gateway{VpcID: gw.VpcID})
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Failed to get FQDN gateway info for gateway %s", gw.GwName),
+					Detail:   err.Error(),
+				})
+			} else if interfaces := fqdnGatewayInfo.Interface[gw.GwName]; len(interfaces) != 0 {
+				d.Set("fqdn_lan_interface", interfaces[0])
+			}
 		} else if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.GCPRelatedCloudTypes) {
 			d.Set("fqdn_lan_vpc_id", gw.BundleVpcInfo.LAN.VpcID)
 			d.Set("fqdn_lan_cidr", strings.Split(gw.BundleVpcInfo.LAN.Subnet, "~~")[0])
@@ -809,11 +835,11 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) err
 			if len(azureEip) == 3 {
 				d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Gateway %s", gw.GwName)
+				tflog.Warn(ctx, "could not get Azure EIP name and resource group for the Gateway", map[string]interface{}{"gw_name": gw.GwName})
 			}
 		}
 	}
 
 	d.SetId(gateway.GwName)
-	return nil
+	return diags
 }
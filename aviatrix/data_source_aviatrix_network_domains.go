@@ -15,6 +15,7 @@ func dataSourceAviatrixNetworkDomains() *schema.Resource {
 		ReadWithoutTimeout: dataSourceAviatrixNetworkDomainsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFilterSchema("account_name", "vpc_reg", "name_regex"),
 			"network_domains": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -89,7 +90,12 @@ func dataSourceAviatrixNetworkDomains() *schema.Resource {
 }
 
 func dataSourceAviatrixNetworkDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
+
+	filters, err := expandDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	domainList, err := client.GetAllNetworkDomains(ctx)
 	if err != nil {
@@ -97,6 +103,9 @@ func dataSourceAviatrixNetworkDomainsRead(ctx context.Context, d *schema.Resourc
 	}
 	var result []map[string]interface{}
 	for i, domain := range domainList {
+		if !matchDataSourceFilters(filters, filterItem{Name: domain.Name, AccountName: domain.Account, Region: domain.Region}) {
+			continue
+		}
 		domainList[i] = domain
 		tempDomain := map[string]interface{}{
 			"name":                         domain.Name,
@@ -117,6 +126,6 @@ func dataSourceAviatrixNetworkDomainsRead(ctx context.Context, d *schema.Resourc
 	if err = d.Set("network_domains", result); err != nil {
 		return diag.Errorf("couldn't set network_domains: %s", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
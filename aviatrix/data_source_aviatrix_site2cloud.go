@@ -0,0 +1,276 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixSite2Cloud() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixSite2CloudRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "VPC ID of the cloud gateway.",
+			},
+			"connection_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Site2Cloud connection name.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the connection.",
+			},
+			"connection_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Connection type: 'unmapped' or 'mapped'.",
+			},
+			"tunnel_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Site2Cloud tunnel type: 'policy' or 'route'.",
+			},
+			"remote_gateway_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Remote gateway type.",
+			},
+			"primary_cloud_gateway_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Primary cloud gateway name.",
+			},
+			"backup_gateway_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Backup gateway name.",
+			},
+			"remote_gateway_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Remote gateway IP.",
+			},
+			"backup_remote_gateway_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Backup remote gateway IP.",
+			},
+			"remote_subnet_cidr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Remote subnet CIDR.",
+			},
+			"remote_subnet_virtual": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Remote subnet CIDR (Virtual).",
+			},
+			"local_subnet_cidr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Local subnet CIDR.",
+			},
+			"local_subnet_virtual": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Local subnet CIDR (Virtual).",
+			},
+			"ha_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether HA is enabled.",
+			},
+			"local_tunnel_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Local tunnel IP address.",
+			},
+			"remote_tunnel_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Remote tunnel IP address.",
+			},
+			"backup_local_tunnel_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Backup local tunnel IP address.",
+			},
+			"backup_remote_tunnel_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Backup remote tunnel IP address.",
+			},
+			"custom_algorithms": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether custom algorithms are used.",
+			},
+			"phase_1_authentication": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Phase one Authentication.",
+			},
+			"phase_2_authentication": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Phase two Authentication.",
+			},
+			"phase_1_dh_groups": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Phase one DH Groups.",
+			},
+			"phase_2_dh_groups": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Phase two DH Groups.",
+			},
+			"phase_1_encryption": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Phase one Encryption.",
+			},
+			"phase_2_encryption": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Phase two Encryption.",
+			},
+			"enable_ikev2": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether IKEv2 is enabled.",
+			},
+			"enable_dead_peer_detection": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Dead Peer Detection is enabled.",
+			},
+			"enable_active_active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Active Active HA is enabled.",
+			},
+			"tunnels": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Tunnels of the connection.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the gateway of the tunnel.",
+						},
+						"ip_addr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the gateway of the tunnel.",
+						},
+						"peer_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the remote peer of the tunnel.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the tunnel.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixSite2CloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	vpcID := d.Get("vpc_id").(string)
+	connName := d.Get("connection_name").(string)
+
+	s2c, err := client.GetSite2CloudConnDetailContext(ctx, &goaviatrix.Site2Cloud{
+		VpcID:      vpcID,
+		TunnelName: connName,
+	})
+	if errors.Is(err, goaviatrix.ErrNotFound) {
+		return diag.Errorf("couldn't find Aviatrix Site2Cloud connection %s in VPC %s", connName, vpcID)
+	}
+	if err != nil {
+		return diag.Errorf("couldn't get Aviatrix Site2Cloud connection %s: %s", connName, err)
+	}
+
+	summary, err := client.GetSite2CloudContext(ctx, &goaviatrix.Site2Cloud{
+		VpcID:      vpcID,
+		TunnelName: connName,
+	})
+	if err != nil && !errors.Is(err, goaviatrix.ErrNotFound) {
+		return diag.Errorf("couldn't get the status of Aviatrix Site2Cloud connection %s: %s", connName, err)
+	}
+	if summary != nil {
+		d.Set("status", summary.Status)
+	}
+
+	d.Set("connection_type", s2c.ConnType)
+	d.Set("tunnel_type", s2c.TunnelType)
+	d.Set("remote_gateway_type", s2c.RemoteGwType)
+	d.Set("primary_cloud_gateway_name", s2c.GwName)
+	d.Set("backup_gateway_name", s2c.BackupGwName)
+	d.Set("remote_gateway_ip", s2c.RemoteGwIP)
+	d.Set("backup_remote_gateway_ip", s2c.RemoteGwIP2)
+	d.Set("remote_subnet_cidr", s2c.RemoteSubnet)
+	d.Set("remote_subnet_virtual", s2c.RemoteSubnetVirtual)
+	d.Set("local_subnet_cidr", s2c.LocalSubnet)
+	d.Set("local_subnet_virtual", s2c.LocalSubnetVirtual)
+	d.Set("ha_enabled", s2c.HAEnabled == "enabled")
+	d.Set("local_tunnel_ip", s2c.LocalTunnelIp)
+	d.Set("remote_tunnel_ip", s2c.RemoteTunnelIp)
+	d.Set("backup_local_tunnel_ip", s2c.BackupLocalTunnelIp)
+	d.Set("backup_remote_tunnel_ip", s2c.BackupRemoteTunnelIp)
+	d.Set("custom_algorithms", s2c.CustomAlgorithms)
+	if s2c.CustomAlgorithms {
+		d.Set("phase_1_authentication", s2c.Phase1Auth)
+		d.Set("phase_2_authentication", s2c.Phase2Auth)
+		d.Set("phase_1_dh_groups", s2c.Phase1DhGroups)
+		d.Set("phase_2_dh_groups", s2c.Phase2DhGroups)
+		d.Set("phase_1_encryption", s2c.Phase1Encryption)
+		d.Set("phase_2_encryption", s2c.Phase2Encryption)
+	} else {
+		// The controller uses the default algorithms
+		d.Set("phase_1_authentication", goaviatrix.Phase1AuthDefault)
+		d.Set("phase_2_authentication", goaviatrix.Phase2AuthDefault)
+		d.Set("phase_1_dh_groups", goaviatrix.Phase1DhGroupDefault)
+		d.Set("phase_2_dh_groups", goaviatrix.Phase2DhGroupDefault)
+		d.Set("phase_1_encryption", goaviatrix.Phase1EncryptionDefault)
+		d.Set("phase_2_encryption", goaviatrix.Phase2EncryptionDefault)
+	}
+	d.Set("enable_ikev2", s2c.EnableIKEv2 == "true")
+	d.Set("enable_dead_peer_detection", s2c.DeadPeerDetection)
+	d.Set("enable_active_active", s2c.EnableActiveActive)
+
+	var tunnels []map[string]interface{}
+	for _, tunnel := range s2c.Tunnels {
+		tunnels = append(tunnels, map[string]interface{}{
+			"gw_name": tunnel.GwName,
+			"ip_addr": tunnel.IPAddr,
+			"peer_ip": tunnel.PeerIP,
+			"status":  tunnel.Status,
+		})
+	}
+	if err := d.Set("tunnels", tunnels); err != nil {
+		return diag.Errorf("failed to set tunnels: %s", err)
+	}
+
+	d.SetId(connName + "~" + vpcID)
+	return nil
+}
@@ -0,0 +1,120 @@
+package aviatrix
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixSite2CloudConnections() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixSite2CloudConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFilterSchema("gw_name", "status", "name_regex"),
+			"connections": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of Site2Cloud connections.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "VPC ID of the cloud gateway.",
+						},
+						"connection_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Site2Cloud connection name.",
+						},
+						"primary_cloud_gateway_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Primary cloud gateway name.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the connection.",
+						},
+						"connection_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Connection type: 'unmapped' or 'mapped'.",
+						},
+						"tunnel_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Site2Cloud tunnel type.",
+						},
+						"remote_gateway_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Remote gateway IP.",
+						},
+						"remote_subnet_cidr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Remote subnet CIDR.",
+						},
+						"local_subnet_cidr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Local subnet CIDR.",
+						},
+						"ha_enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether HA is enabled.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixSite2CloudConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	filters, err := expandDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	connections, err := client.ListSite2CloudContext(ctx)
+	if err != nil {
+		return diag.Errorf("could not get Aviatrix Site2Cloud connections: %s", err)
+	}
+
+	var result []map[string]interface{}
+	for _, conn := range connections {
+		item := filterItem{Name: conn.TunnelName, GwNames: []string{conn.GwName}, Status: conn.Status}
+		if !matchDataSourceFilters(filters, item) {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"vpc_id":                     conn.VpcID,
+			"connection_name":            conn.TunnelName,
+			"primary_cloud_gateway_name": conn.GwName,
+			"status":                     conn.Status,
+			"connection_type":            conn.ConnType,
+			"tunnel_type":                conn.TunnelType,
+			"remote_gateway_ip":          conn.RemoteGwIP,
+			"remote_subnet_cidr":         conn.RemoteSubnet,
+			"local_subnet_cidr":          conn.LocalSubnet,
+			"ha_enabled":                 conn.HAEnabled == "enabled",
+		})
+	}
+	if err = d.Set("connections", result); err != nil {
+		return diag.Errorf("couldn't set connections: %s", err)
+	}
+
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
+	return nil
+}
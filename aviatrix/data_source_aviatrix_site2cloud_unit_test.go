@@ -0,0 +1,86 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceAviatrixSite2CloudRead(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetSite2CloudConnDetailContextFunc: func(ctx context.Context, s2c *goaviatrix.Site2Cloud) (*goaviatrix.Site2Cloud, error) {
+			assert.Equal(t, "vpc-0123", s2c.VpcID)
+			assert.Equal(t, "onprem-dc1", s2c.TunnelName)
+			s2c.GwName = "spoke-gw"
+			s2c.ConnType = "unmapped"
+			s2c.TunnelType = "route"
+			s2c.RemoteGwIP = "203.0.113.10"
+			s2c.RemoteSubnet = "192.168.0.0/16"
+			s2c.HAEnabled = "enabled"
+			s2c.LocalTunnelIp = "169.254.10.1/30"
+			s2c.RemoteTunnelIp = "169.254.10.2/30"
+			s2c.Tunnels = []goaviatrix.TunnelInfo{{GwName: "spoke-gw", PeerIP: "203.0.113.10", Status: "up"}}
+			return s2c, nil
+		},
+		GetSite2CloudContextFunc: func(ctx context.Context, s2c *goaviatrix.Site2Cloud) (*goaviatrix.Site2Cloud, error) {
+			return &goaviatrix.Site2Cloud{VpcID: s2c.VpcID, TunnelName: s2c.TunnelName, Status: "Up"}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixSite2Cloud().Schema, map[string]interface{}{
+		"vpc_id":          "vpc-0123",
+		"connection_name": "onprem-dc1",
+	})
+	diags := dataSourceAviatrixSite2CloudRead(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "onprem-dc1~vpc-0123", d.Id())
+	assert.Equal(t, "Up", d.Get("status"))
+	assert.Equal(t, "spoke-gw", d.Get("primary_cloud_gateway_name"))
+	assert.Equal(t, true, d.Get("ha_enabled"))
+	assert.Equal(t, "169.254.10.1/30", d.Get("local_tunnel_ip"))
+	assert.Equal(t, goaviatrix.Phase1AuthDefault, d.Get("phase_1_authentication"))
+	assert.Equal(t, "up", d.Get("tunnels.0.status"))
+}
+
+func TestDataSourceAviatrixSite2CloudRead_WhenNotFound(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetSite2CloudConnDetailContextFunc: func(ctx context.Context, s2c *goaviatrix.Site2Cloud) (*goaviatrix.Site2Cloud, error) {
+			return nil, goaviatrix.ErrNotFound
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixSite2Cloud().Schema, map[string]interface{}{
+		"vpc_id":          "vpc-0123",
+		"connection_name": "onprem-dc1",
+	})
+	diags := dataSourceAviatrixSite2CloudRead(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("couldn't find Aviatrix Site2Cloud connection onprem-dc1 in VPC vpc-0123"), diags)
+}
+
+func TestDataSourceAviatrixSite2CloudConnectionsRead_WithFilters(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetControllerIPFunc: func() string { return "10.0.0.1" },
+		ListSite2CloudContextFunc: func(ctx context.Context) ([]goaviatrix.Site2Cloud, error) {
+			return []goaviatrix.Site2Cloud{
+				{VpcID: "vpc-1", TunnelName: "dc1", GwName: "spoke-gw", Status: "Up"},
+				{VpcID: "vpc-1", TunnelName: "dc2", GwName: "spoke-gw", Status: "Down"},
+				{VpcID: "vpc-2", TunnelName: "dc3", GwName: "transit-gw", Status: "Down"},
+			}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixSite2CloudConnections().Schema, map[string]interface{}{
+		"filter": []interface{}{map[string]interface{}{"gw_name": "spoke-gw", "status": "down"}},
+	})
+	diags := dataSourceAviatrixSite2CloudConnectionsRead(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, 1, d.Get("connections.#"))
+	assert.Equal(t, "dc2", d.Get("connections.0.connection_name"))
+}
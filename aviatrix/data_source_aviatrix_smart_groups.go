@@ -15,6 +15,7 @@ func dataSourceAviatrixSmartGroups() *schema.Resource {
 		ReadWithoutTimeout: dataSourceAviatrixSmartGroupsRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFilterSchema("name_regex"),
 			"smart_groups": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -132,7 +133,12 @@ func dataSourceAviatrixSmartGroups() *schema.Resource {
 }
 
 func dataSourceAviatrixSmartGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
+
+	filters, err := expandDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	smartGroups, err := client.GetSmartGroups(ctx)
 	if err != nil {
@@ -141,6 +147,9 @@ func dataSourceAviatrixSmartGroupsRead(ctx context.Context, d *schema.ResourceDa
 
 	var result []map[string]interface{}
 	for _, smartGroup := range smartGroups {
+		if !matchDataSourceFilters(filters, filterItem{Name: smartGroup.Name}) {
+			continue
+		}
 		var expressions []interface{}
 
 		for _, filter := range smartGroup.Selector.Expressions {
@@ -183,6 +192,6 @@ func dataSourceAviatrixSmartGroupsRead(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("couldn't set smart_groups: %s", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
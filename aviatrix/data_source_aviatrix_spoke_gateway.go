@@ -1,17 +1,19 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixSpokeGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixSpokeGatewayRead,
+		ReadContext: dataSourceAviatrixSpokeGatewayRead,
 
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
@@ -387,8 +389,9 @@ func dataSourceAviatrixSpokeGateway() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixSpokeGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	var diags diag.Diagnostics
 
 	gateway := &goaviatrix.Gateway{
 		GwName: d.Get("gw_name").(string),
@@ -398,13 +401,13 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 		gateway.AccountName = d.Get("account_name").(string)
 	}
 
-	gw, err := client.GetGateway(gateway)
+	gw, err := client.GetGatewayContext(ctx, gateway)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix spoke gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix spoke gateway: %s", err)
 	}
 	if gw != nil {
 		d.Set("cloud_type", gw.CloudType)
@@ -521,7 +524,7 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 			AccountName: d.Get("account_name").(string),
 			GwName:      d.Get("gw_name").(string) + "-hagw",
 		}
-		haGw, _ := client.GetGateway(haGateway)
+		haGw, _ := client.GetGatewayContext(ctx, haGateway)
 		if haGw != nil {
 			if goaviatrix.IsCloudType(haGw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
 				d.Set("ha_subnet", haGw.VpcNet)
@@ -560,7 +563,7 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 				if len(azureEip) == 3 {
 					d.Set("ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 				} else {
-					log.Printf("[WARN] could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName)
+					tflog.Warn(ctx, "could not get Azure EIP name and resource group for the HA Gateway", map[string]interface{}{"gw_name": gw.GwName})
 				}
 			}
 		}
@@ -572,13 +575,17 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 				CloudType:    gw.CloudType,
 			}
 
-			_, err := client.GetTags(tags)
+			_, err := client.GetTagsContext(ctx, tags)
 			if err != nil {
-				log.Printf("[WARN] Failed to get tags for spoke gateway %s: %v", tags.ResourceName, err)
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Failed to get tags for spoke gateway %s", tags.ResourceName),
+					Detail:   err.Error(),
+				})
 			}
 			if len(tags.Tags) > 0 {
 				if err := d.Set("tags", tags.Tags); err != nil {
-					log.Printf("[WARN] Error setting tags for spoke gateway %s: %v", tags.ResourceName, err)
+					tflog.Warn(ctx, "Error setting tags for spoke gateway", map[string]interface{}{"resource_name": tags.ResourceName, "error": err})
 				}
 			}
 		}
@@ -592,13 +599,13 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 		d.Set("enable_bgp", gw.EnableBgp)
 		d.Set("enable_learned_cidrs_approval", gw.EnableLearnedCidrsApproval)
 		if gw.EnableLearnedCidrsApproval {
-			spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfig(&goaviatrix.SpokeVpc{GwName: gw.GwName})
+			spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfigContext(ctx, &goaviatrix.SpokeVpc{GwName: gw.GwName})
 			if err != nil {
-				return fmt.Errorf("could not get advanced config for spoke gateway: %v", err)
+				return diag.Errorf("could not get advanced config for spoke gateway: %v", err)
 			}
 
 			if err = d.Set("approved_learned_cidrs", spokeAdvancedConfig.ApprovedLearnedCidrs); err != nil {
-				return fmt.Errorf("could not set approved_learned_cidrs into state: %v", err)
+				return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
 			}
 		} else {
 			d.Set("approved_learned_cidrs", nil)
@@ -615,12 +622,12 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 		}
 		err = d.Set("prepend_as_path", prependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path: %v", err)
+			return diag.Errorf("could not set prepend_as_path: %v", err)
 		}
 
 		d.Set("enable_monitor_gateway_subnets", gw.MonitorSubnetsAction == "enable")
 		if err := d.Set("monitor_exclude_list", gw.MonitorExcludeGWList); err != nil {
-			return fmt.Errorf("setting 'monitor_exclude_list' to state: %v", err)
+			return diag.Errorf("setting 'monitor_exclude_list' to state: %v", err)
 		}
 
 		if gw.EnableBgp {
@@ -643,11 +650,11 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}
 			if len(azureEip) == 3 {
 				d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Spoke Gateway %s", gw.GwName)
+				tflog.Warn(ctx, "could not get Azure EIP name and resource group for the Spoke Gateway", map[string]interface{}{"gw_name": gw.GwName})
 			}
 		}
 	}
 
 	d.SetId(gateway.GwName)
-	return nil
+	return diags
 }
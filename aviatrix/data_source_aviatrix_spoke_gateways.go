@@ -16,6 +16,7 @@ func dataSourceAviatrixSpokeGateways() *schema.Resource {
 		ReadWithoutTimeout: dataSourceAviatrixSpokeGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFilterSchema("cloud_type", "account_name", "vpc_reg", "name_regex", "tags", "transit_gw"),
 			"gateway_list": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -307,7 +308,12 @@ func dataSourceAviatrixSpokeGateways() *schema.Resource {
 }
 
 func dataSourceAviatrixSpokeGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
+
+	filters, err := expandDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	SpokeGatewayList, err := client.GetSpokeGatewayList(ctx)
 	if err != nil {
@@ -316,6 +322,9 @@ func dataSourceAviatrixSpokeGatewaysRead(ctx context.Context, d *schema.Resource
 	var result []map[string]interface{}
 	for i := range SpokeGatewayList {
 		gw := SpokeGatewayList[i]
+		if !matchDataSourceFilters(filters, gatewayFilterItem(gw)) {
+			continue
+		}
 		spokeGateway := make(map[string]interface{})
 
 		spokeGateway["gw_name"] = gw.GwName
@@ -487,6 +496,6 @@ func dataSourceAviatrixSpokeGatewaysRead(ctx context.Context, d *schema.Resource
 	if err = d.Set("gateway_list", result); err != nil {
 		return diag.Errorf("couldn't set gateway_list: %s", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
@@ -1,18 +1,20 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixTransitGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixTransitGatewayRead,
+		ReadContext: dataSourceAviatrixTransitGatewayRead,
 
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
@@ -505,20 +507,21 @@ func dataSourceAviatrixTransitGateway() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixTransitGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	var diags diag.Diagnostics
 
 	gateway := &goaviatrix.Gateway{
 		GwName: d.Get("gw_name").(string),
 	}
 
-	gw, err := client.GetGateway(gateway)
+	gw, err := client.GetGatewayContext(ctx, gateway)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Transit Gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Transit Gateway: %s", err)
 	}
 	if gw != nil {
 		d.Set("cloud_type", gw.CloudType)
@@ -629,9 +632,9 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 			d.Set("excluded_advertised_spoke_routes", "")
 		}
 
-		gwDetail, err := client.GetGatewayDetail(gw)
+		gwDetail, err := client.GetGatewayDetailContext(ctx, gw)
 		if err != nil {
-			return fmt.Errorf("couldn't get Aviatrix Transit Gateway: %s", err)
+			return diag.Errorf("couldn't get Aviatrix Transit Gateway: %s", err)
 		}
 
 		d.Set("enable_firenet", gwDetail.EnableFireNet)
@@ -674,13 +677,17 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 				CloudType:    gw.CloudType,
 			}
 
-			_, err := client.GetTags(tags)
+			_, err := client.GetTagsContext(ctx, tags)
 			if err != nil {
-				log.Printf("[WARN] Failed to get tags for transit gateway %s: %v", tags.ResourceName, err)
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Failed to get tags for transit gateway %s", tags.ResourceName),
+					Detail:   err.Error(),
+				})
 			}
 			if len(tags.Tags) > 0 {
 				if err := d.Set("tags", tags.Tags); err != nil {
-					log.Printf("[WARN] Error setting tags for transit gateway %s: %v", tags.ResourceName, err)
+					tflog.Warn(ctx, "Error setting tags for transit gateway", map[string]interface{}{"resource_name": tags.ResourceName, "error": err})
 				}
 			}
 		}
@@ -694,7 +701,7 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 			AccountName: d.Get("account_name").(string),
 			GwName:      d.Get("gw_name").(string) + "-hagw",
 		}
-		haGw, _ := client.GetGateway(haGateway)
+		haGw, _ := client.GetGatewayContext(ctx, haGateway)
 		if haGw != nil {
 			if goaviatrix.IsCloudType(haGw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
 				d.Set("ha_subnet", haGw.VpcNet)
@@ -733,13 +740,17 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 				if len(azureEip) == 3 {
 					d.Set("ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 				} else {
-					log.Printf("[WARN] could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName)
+					tflog.Warn(ctx, "could not get Azure EIP name and resource group for the HA Gateway", map[string]interface{}{"gw_name": gw.GwName})
 				}
 			}
 
-			lanCidr, err := client.GetTransitGatewayLanCidr(gw.HaGw.GwName)
+			lanCidr, err := client.GetTransitGatewayLanCidrContext(ctx, gw.HaGw.GwName)
 			if err != nil && err != goaviatrix.ErrNotFound {
-				log.Printf("[WARN] Error getting lan cidr for HA transit gateway %s due to %s", gw.HaGw.GwName, err)
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Failed to get LAN interface CIDR for HA transit gateway %s", gw.HaGw.GwName),
+					Detail:   err.Error(),
+				})
 			}
 			d.Set("ha_lan_interface_cidr", lanCidr)
 		}
@@ -764,13 +775,13 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 		}
 
 		if gw.EnableLearnedCidrsApproval {
-			transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfig(&goaviatrix.TransitVpc{GwName: gw.GwName})
+			transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfigContext(ctx, &goaviatrix.TransitVpc{GwName: gw.GwName})
 			if err != nil {
-				return fmt.Errorf("could not get advanced config for transit gateway: %v", err)
+				return diag.Errorf("could not get advanced config for transit gateway: %v", err)
 			}
 
 			if err = d.Set("approved_learned_cidrs", transitAdvancedConfig.ApprovedLearnedCidrs); err != nil {
-				return fmt.Errorf("could not set approved_learned_cidrs into state: %v", err)
+				return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
 			}
 		} else {
 			d.Set("approved_learned_cidrs", nil)
@@ -784,12 +795,12 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 		}
 		err = d.Set("prepend_as_path", prependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path: %v", err)
+			return diag.Errorf("could not set prepend_as_path: %v", err)
 		}
 
 		d.Set("enable_monitor_gateway_subnets", gw.MonitorSubnetsAction == "enable")
 		if err := d.Set("monitor_exclude_list", gw.MonitorExcludeGWList); err != nil {
-			return fmt.Errorf("setting 'monitor_exclude_list' to state: %v", err)
+			return diag.Errorf("setting 'monitor_exclude_list' to state: %v", err)
 		}
 
 		d.Set("enable_bgp_over_lan", goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes) && gw.EnableBgpOverLan)
@@ -803,7 +814,7 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 					interfaces = append(interfaces, interfaceDict)
 				}
 				if err = d.Set("bgp_lan_interfaces", interfaces); err != nil {
-					return fmt.Errorf("could not set bgp_lan_interfaces into state: %v", err)
+					return diag.Errorf("could not set bgp_lan_interfaces into state: %v", err)
 				}
 			}
 
@@ -816,35 +827,35 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 					haInterfaces = append(haInterfaces, interfaceDict)
 				}
 				if err = d.Set("ha_bgp_lan_interfaces", haInterfaces); err != nil {
-					return fmt.Errorf("could not set ha_bgp_lan_interfaces into state: %v", err)
+					return diag.Errorf("could not set ha_bgp_lan_interfaces into state: %v", err)
 				}
 			}
 
-			bgpLanIpInfo, err := client.GetBgpLanIPList(&goaviatrix.TransitVpc{GwName: gateway.GwName})
+			bgpLanIpInfo, err := client.GetBgpLanIPListContext(ctx, &goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not get BGP LAN IP info for GCP transit gateway %s: %v", gateway.GwName, err)
+				return diag.Errorf("could not get BGP LAN IP info for GCP transit gateway %s: %v", gateway.GwName, err)
 			}
 			if err = d.Set("bgp_lan_ip_list", bgpLanIpInfo.BgpLanIpList); err != nil {
-				return fmt.Errorf("could not set bgp_lan_ip_list into state: %v", err)
+				return diag.Errorf("could not set bgp_lan_ip_list into state: %v", err)
 			}
 			if len(bgpLanIpInfo.HaBgpLanIpList) != 0 {
 				if err = d.Set("ha_bgp_lan_ip_list", bgpLanIpInfo.HaBgpLanIpList); err != nil {
-					return fmt.Errorf("could not set ha_bgp_lan_ip_list into tate: %v", err)
+					return diag.Errorf("could not set ha_bgp_lan_ip_list into tate: %v", err)
 				}
 			} else {
 				d.Set("ha_bgp_lan_ip_list", nil)
 			}
 		} else if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && gw.EnableBgpOverLan {
-			bgpLanIpInfo, err := client.GetBgpLanIPList(&goaviatrix.TransitVpc{GwName: gateway.GwName})
+			bgpLanIpInfo, err := client.GetBgpLanIPListContext(ctx, &goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not get BGP LAN IP info for Azure transit gateway %s: %v", gateway.GwName, err)
+				return diag.Errorf("could not get BGP LAN IP info for Azure transit gateway %s: %v", gateway.GwName, err)
 			}
 			if err = d.Set("bgp_lan_ip_list", bgpLanIpInfo.AzureBgpLanIpList); err != nil {
-				return fmt.Errorf("could not set bgp_lan_ip_list into state: %v", err)
+				return diag.Errorf("could not set bgp_lan_ip_list into state: %v", err)
 			}
 			if len(bgpLanIpInfo.AzureHaBgpLanIpList) != 0 {
 				if err = d.Set("ha_bgp_lan_ip_list", bgpLanIpInfo.AzureHaBgpLanIpList); err != nil {
-					return fmt.Errorf("could not set ha_bgp_lan_ip_list into state: %v", err)
+					return diag.Errorf("could not set ha_bgp_lan_ip_list into state: %v", err)
 				}
 			} else {
 				d.Set("ha_bgp_lan_ip_list", nil)
@@ -864,17 +875,21 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface
 			if len(azureEip) == 3 {
 				d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName)
+				tflog.Warn(ctx, "could not get Azure EIP name and resource group for the Transit Gateway", map[string]interface{}{"gw_name": gw.GwName})
 			}
 		}
 
-		lanCidr, err := client.GetTransitGatewayLanCidr(gw.GwName)
+		lanCidr, err := client.GetTransitGatewayLanCidrContext(ctx, gw.GwName)
 		if err != nil && err != goaviatrix.ErrNotFound {
-			log.Printf("[WARN] Error getting lan cidr for transit gateway %s due to %s", gw.GwName, err)
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to get LAN interface CIDR for transit gateway %s", gw.GwName),
+				Detail:   err.Error(),
+			})
 		}
 		d.Set("lan_interface_cidr", lanCidr)
 	}
 
 	d.SetId(gateway.GwName)
-	return nil
+	return diags
 }
@@ -3,10 +3,10 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -18,6 +18,7 @@ func dataSourceAviatrixTransitGateways() *schema.Resource {
 		ReadWithoutTimeout: dataSourceAviatrixTransitGatewaysRead,
 
 		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFilterSchema("cloud_type", "account_name", "vpc_reg", "name_regex", "tags"),
 			"gateway_list": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -366,7 +367,12 @@ func dataSourceAviatrixTransitGateways() *schema.Resource {
 }
 
 func dataSourceAviatrixTransitGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
+
+	filters, err := expandDataSourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	TransitGatewayList, err := client.GetTransitGatewayList(ctx)
 	if err != nil {
@@ -375,6 +381,9 @@ func dataSourceAviatrixTransitGatewaysRead(ctx context.Context, d *schema.Resour
 	var result []map[string]interface{}
 	for i := range TransitGatewayList {
 		gw := TransitGatewayList[i]
+		if !matchDataSourceFilters(filters, gatewayFilterItem(gw)) {
+			continue
+		}
 		transitGateway := make(map[string]interface{})
 		transitGateway["cloud_type"] = gw.CloudType
 		transitGateway["account_name"] = gw.AccountName
@@ -545,7 +554,7 @@ func dataSourceAviatrixTransitGatewaysRead(ctx context.Context, d *schema.Resour
 			if len(azureEip) == 3 {
 				transitGateway["azure_eip_name_resource_group"] = fmt.Sprintf("%s:%s", azureEip[0], azureEip[1])
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName)
+				tflog.Warn(ctx, "could not get Azure EIP name and resource group for the Transit Gateway", map[string]interface{}{"gw_name": gw.GwName})
 			}
 		}
 
@@ -555,6 +564,6 @@ func dataSourceAviatrixTransitGatewaysRead(ctx context.Context, d *schema.Resour
 	if err = d.Set("gateway_list", result); err != nil {
 		return diag.Errorf("couldn't set gateway_list: %s", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAviatrixVpc() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixVpcRead,
+		ReadContext: dataSourceAviatrixVpcRead,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -183,20 +184,20 @@ func dataSourceAviatrixVpc() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func dataSourceAviatrixVpcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpc := &goaviatrix.Vpc{
 		Name: d.Get("name").(string),
 	}
 
-	vC, err := client.GetVpc(vpc)
+	vC, err := client.GetVpcContext(ctx, vpc)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find VPC: %s", err)
+		return diag.Errorf("couldn't find VPC: %s", err)
 	}
 
 	d.Set("cloud_type", vC.CloudType)
@@ -233,10 +234,10 @@ func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 			AccountName: d.Get("account_name").(string),
 		}
 
-		acc, err := client.GetAccount(account)
+		acc, err := client.GetAccountContext(ctx, account)
 		if err != nil {
 			if err != goaviatrix.ErrNotFound {
-				return fmt.Errorf("aviatrix Account: %s", err)
+				return diag.Errorf("aviatrix Account: %s", err)
 			}
 		}
 
@@ -264,7 +265,7 @@ func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		subnetList = append(subnetList, sub)
 	}
 	if err := d.Set("subnets", subnetList); err != nil {
-		log.Printf("[WARN] Error setting subnets for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, "Error setting subnets", map[string]interface{}{"id": d.Id(), "error": err})
 	}
 
 	var privateSubnetList []map[string]string
@@ -286,42 +287,42 @@ func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		publicSubnetList = append(publicSubnetList, sub)
 	}
 	if err := d.Set("private_subnets", privateSubnetList); err != nil {
-		log.Printf("[WARN] Error setting 'private_subnets' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, "Error setting 'private_subnets'", map[string]interface{}{"id": d.Id(), "error": err})
 	}
 	if err := d.Set("public_subnets", publicSubnetList); err != nil {
-		log.Printf("[WARN] Error setting 'public_subnets' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, "Error setting 'public_subnets'", map[string]interface{}{"id": d.Id(), "error": err})
 	}
 
 	if goaviatrix.IsCloudType(vC.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		var rtbs []string
 		routeTableFilter := d.Get("route_tables_filter")
 		if routeTableFilter == "private" {
-			rtbs, err = getPrivateRouteTables(vpc, client)
+			rtbs, err = getPrivateRouteTables(ctx, vpc, client)
 		} else if routeTableFilter == "public" {
-			rtbs, err = getPublicRouteTables(vpc, client)
+			rtbs, err = getPublicRouteTables(ctx, vpc, client)
 		} else {
-			rtbs, err = getAllRouteTables(vpc, client)
+			rtbs, err = getAllRouteTables(ctx, vpc, client)
 		}
 
 		if err != nil {
-			return fmt.Errorf("could not get vpc route table ids: %v", err)
+			return diag.Errorf("could not get vpc route table ids: %v", err)
 		}
 
 		if err := d.Set("route_tables", rtbs); err != nil {
-			log.Printf("[WARN] Error setting route tables for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, "Error setting route tables", map[string]interface{}{"id": d.Id(), "error": err})
 		}
 	}
 
 	if goaviatrix.IsCloudType(vC.CloudType, goaviatrix.OCIRelatedCloudTypes) {
-		availabilityDomains, err := client.ListOciVpcAvailabilityDomains(vC)
+		availabilityDomains, err := client.ListOciVpcAvailabilityDomainsContext(ctx, vC)
 		if err != nil {
-			return fmt.Errorf("could not get OCI availability domains: %v", err)
+			return diag.Errorf("could not get OCI availability domains: %v", err)
 		}
 		d.Set("availability_domains", availabilityDomains)
 
-		faultDomains, err := client.ListOciVpcFaultDomains(vC)
+		faultDomains, err := client.ListOciVpcFaultDomainsContext(ctx, vC)
 		if err != nil {
-			return fmt.Errorf("could not get OCI fault domains: %v", err)
+			return diag.Errorf("could not get OCI fault domains: %v", err)
 		}
 		d.Set("fault_domains", faultDomains)
 	}
@@ -332,13 +333,13 @@ func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 
 // To find all the private route tables we will remove the public route tables
 // from the list of all route tables.
-func getPrivateRouteTables(vpc *goaviatrix.Vpc, client *goaviatrix.Client) ([]string, error) {
-	all, err := getAllRouteTables(vpc, client)
+func getPrivateRouteTables(ctx context.Context, vpc *goaviatrix.Vpc, client goaviatrix.VpcClient) ([]string, error) {
+	all, err := getAllRouteTables(ctx, vpc, client)
 	if err != nil {
 		return nil, err
 	}
 
-	public, err := getPublicRouteTables(vpc, client)
+	public, err := getPublicRouteTables(ctx, vpc, client)
 	if err != nil {
 		return nil, err
 	}
@@ -352,18 +353,18 @@ func getPrivateRouteTables(vpc *goaviatrix.Vpc, client *goaviatrix.Client) ([]st
 	return rtbs, nil
 }
 
-func getPublicRouteTables(vpc *goaviatrix.Vpc, client *goaviatrix.Client) ([]string, error) {
+func getPublicRouteTables(ctx context.Context, vpc *goaviatrix.Vpc, client goaviatrix.VpcClient) ([]string, error) {
 	vpc.PublicRoutesOnly = true
-	rtbs, err := client.GetVpcRouteTableIDs(vpc)
+	rtbs, err := client.GetVpcRouteTableIDsContext(ctx, vpc)
 	if err != nil {
 		return nil, err
 	}
 	return rtbs, nil
 }
 
-func getAllRouteTables(vpc *goaviatrix.Vpc, client *goaviatrix.Client) ([]string, error) {
+func getAllRouteTables(ctx context.Context, vpc *goaviatrix.Vpc, client goaviatrix.VpcClient) ([]string, error) {
 	vpc.PublicRoutesOnly = false
-	rtbs, err := client.GetVpcRouteTableIDs(vpc)
+	rtbs, err := client.GetVpcRouteTableIDsContext(ctx, vpc)
 	if err != nil {
 		return nil, err
 	}
@@ -1,16 +1,18 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAviatrixVpcTracker() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixVpcTrackerRead,
+		ReadContext: dataSourceAviatrixVpcTrackerRead,
 		Schema: map[string]*schema.Schema{
 			"cloud_type": {
 				Type:     schema.TypeInt,
@@ -112,11 +114,11 @@ func dataSourceAviatrixVpcTracker() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixVpcTrackerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
-	vpcTracker, err := client.GetVpcTracker()
+func dataSourceAviatrixVpcTrackerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+	vpcTracker, err := client.GetVpcTrackerContext(ctx)
 	if err != nil {
-		return fmt.Errorf("could not get vpc list: %s", err)
+		return diag.Errorf("could not get vpc list: %s", err)
 	}
 	vpcTracker = filterVpcTrackerResult(d, vpcTracker)
 
@@ -135,7 +137,7 @@ func dataSourceAviatrixVpcTrackerRead(d *schema.ResourceData, meta interface{})
 	}
 	err = d.Set("vpc_list", vpcList)
 	if err != nil {
-		return fmt.Errorf("could not set vpc list: %s", err)
+		return diag.Errorf("could not set vpc list: %s", err)
 	}
 
 	ct := d.Get("cloud_type").(int)
@@ -0,0 +1,39 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPrivateRouteTables(t *testing.T) {
+	client := &goaviatrix.VpcClientMock{
+		GetVpcRouteTableIDsContextFunc: func(ctx context.Context, vpc *goaviatrix.Vpc) ([]string, error) {
+			if vpc.PublicRoutesOnly {
+				return []string{"rtb-public"}, nil
+			}
+			return []string{"rtb-public", "rtb-private-1", "rtb-private-2"}, nil
+		},
+	}
+
+	rtbs, err := getPrivateRouteTables(context.Background(), &goaviatrix.Vpc{VpcID: "vpc-0123"}, client)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rtb-private-1", "rtb-private-2"}, rtbs)
+	assert.Len(t, client.GetVpcRouteTableIDsContextCalls(), 2)
+}
+
+func TestGetPrivateRouteTables_WhenListFails(t *testing.T) {
+	client := &goaviatrix.VpcClientMock{
+		GetVpcRouteTableIDsContextFunc: func(ctx context.Context, vpc *goaviatrix.Vpc) ([]string, error) {
+			return nil, errors.New("controller API failure")
+		},
+	}
+
+	_, err := getPrivateRouteTables(context.Background(), &goaviatrix.Vpc{VpcID: "vpc-0123"}, client)
+
+	assert.EqualError(t, err, "controller API failure")
+}
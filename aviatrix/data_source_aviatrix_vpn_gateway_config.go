@@ -0,0 +1,274 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAviatrixVPNGatewayConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixVPNGatewayConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"gw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the VPN gateway.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "VPC ID of the VPN gateway.",
+			},
+			"lb_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the ELB fronting the VPN gateway, or the gateway name if ELB is disabled.",
+			},
+			"vpn_cidr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "VPN CIDR block of the gateway.",
+			},
+			"vpn_protocol": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ELB protocol of the VPN gateway: 'TCP' or 'UDP'. Empty if ELB is disabled.",
+			},
+			"enable_elb": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether ELB is enabled for the VPN gateway.",
+			},
+			"split_tunnel": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether split tunnel mode is enabled.",
+			},
+			"name_servers": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Comma separated DNS servers used by VPN users in split tunnel mode.",
+			},
+			"search_domains": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Comma separated search domains used by VPN users in split tunnel mode.",
+			},
+			"additional_cidrs": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Comma separated destination CIDRs also routed through the VPN tunnel in split tunnel mode.",
+			},
+			"max_vpn_conn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Maximum number of VPN connections.",
+			},
+			"idle_timeout": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Idle timeout of VPN connections in seconds. -1 if disabled.",
+			},
+			"renegotiation_interval": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Renegotiation interval of VPN connections in seconds. -1 if disabled.",
+			},
+			"enable_vpn_nat": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether VPN NAT is enabled.",
+			},
+			"enable_ldap": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether LDAP authentication is enabled.",
+			},
+			"ldap_server": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "LDAP server address.",
+			},
+			"ldap_bind_dn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "LDAP bind DN.",
+			},
+			"ldap_base_dn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "LDAP base DN.",
+			},
+			"ldap_username_attribute": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "LDAP user attribute.",
+			},
+			"saml_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether SAML authentication is enabled.",
+			},
+			"otp_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Two step authentication mode: '2' for DUO, '3' for Okta. Empty if disabled.",
+			},
+			"enable_pbr": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether policy based routing is enabled.",
+			},
+			"vpn_configs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Full VPN configuration list of the gateway, as shown on the controller.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the VPN configuration.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value of the VPN configuration.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the VPN configuration.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAviatrixVPNGatewayConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	gwName := d.Get("gw_name").(string)
+
+	gw, err := client.GetGatewayContext(ctx, &goaviatrix.Gateway{GwName: gwName})
+	if errors.Is(err, goaviatrix.ErrNotFound) {
+		return diag.Errorf("couldn't find Aviatrix gateway %s", gwName)
+	}
+	if err != nil {
+		return diag.Errorf("couldn't get Aviatrix gateway %s: %s", gwName, err)
+	}
+	if gw.VpnStatus != "enabled" {
+		return diag.Errorf("VPN access is not enabled on Aviatrix gateway %s", gwName)
+	}
+
+	gwDetail, err := client.GetGatewayDetailContext(ctx, &goaviatrix.Gateway{GwName: gwName})
+	if err != nil {
+		return diag.Errorf("couldn't get Detail info for VPN gateway %s: %s", gwName, err)
+	}
+
+	lbName := gw.GwName
+	if gw.ElbState == "enabled" {
+		lbName = gw.ElbName
+	}
+
+	d.Set("vpc_id", gw.VpcID)
+	d.Set("lb_name", lbName)
+	d.Set("vpn_cidr", gw.VpnCidr)
+	d.Set("enable_elb", gw.ElbState == "enabled")
+	if gw.ElbState == "enabled" {
+		if gwDetail.Elb.VpnProtocol == "udp" || gwDetail.Elb.VpnProtocol == "UDP" {
+			d.Set("vpn_protocol", "UDP")
+		} else {
+			d.Set("vpn_protocol", "TCP")
+		}
+	} else {
+		d.Set("vpn_protocol", "")
+	}
+	d.Set("max_vpn_conn", gw.MaxConn)
+	d.Set("enable_vpn_nat", gwDetail.VpnNat)
+	d.Set("enable_ldap", gw.EnableLdap)
+	d.Set("ldap_server", gw.LdapServer)
+	d.Set("ldap_bind_dn", gw.LdapBindDn)
+	d.Set("ldap_base_dn", gw.LdapBaseDn)
+	d.Set("ldap_username_attribute", gw.LdapUserAttr)
+	d.Set("saml_enabled", gw.SamlEnabled == "yes")
+	d.Set("enable_pbr", gw.PbrEnabled == "yes")
+
+	if gw.AuthMethod == "duo_auth" || gw.AuthMethod == "duo_auth+LDAP" {
+		d.Set("otp_mode", "2")
+	} else if gw.AuthMethod == "okta_auth" {
+		d.Set("otp_mode", "3")
+	} else {
+		d.Set("otp_mode", "")
+	}
+
+	idleTimeout, err := parseVPNGatewayTimer(gw.IdleTimeout)
+	if err != nil {
+		return diag.Errorf("couldn't get idle timeout for the gateway %s: %v", gwName, err)
+	}
+	d.Set("idle_timeout", idleTimeout)
+	renegotiationInterval, err := parseVPNGatewayTimer(gw.RenegotiationInterval)
+	if err != nil {
+		return diag.Errorf("couldn't get renegotiation interval for the gateway %s: %v", gwName, err)
+	}
+	d.Set("renegotiation_interval", renegotiationInterval)
+
+	d.Set("split_tunnel", gw.SplitTunnel == "yes")
+	if gw.SplitTunnel == "yes" {
+		splitTunnel, err := client.GetSplitTunnelContext(ctx, &goaviatrix.SplitTunnel{
+			VpcID:   gw.VpcID,
+			ElbName: lbName,
+		})
+		if err != nil {
+			return diag.Errorf("couldn't get split tunnel details for the gateway %s: %s", gwName, err)
+		}
+		d.Set("name_servers", splitTunnel.NameServers)
+		d.Set("search_domains", splitTunnel.SearchDomains)
+		d.Set("additional_cidrs", splitTunnel.AdditionalCidrs)
+	} else {
+		d.Set("name_servers", "")
+		d.Set("search_domains", "")
+		d.Set("additional_cidrs", "")
+	}
+
+	vpnConfigs, err := client.GetVPNConfigListContext(ctx, &goaviatrix.Gateway{
+		VpcID:  gw.VpcID,
+		GwName: lbName,
+	})
+	if err != nil {
+		return diag.Errorf("couldn't get the VPN configuration list of the gateway %s: %s", gwName, err)
+	}
+	var configs []map[string]interface{}
+	for _, config := range vpnConfigs {
+		configs = append(configs, map[string]interface{}{
+			"name":   config.Name,
+			"value":  config.Value,
+			"status": config.Status,
+		})
+	}
+	if err := d.Set("vpn_configs", configs); err != nil {
+		return diag.Errorf("failed to set vpn_configs: %s", err)
+	}
+
+	d.SetId(gwName)
+	return nil
+}
+
+// parseVPNGatewayTimer converts the idle timeout or renegotiation interval of a
+// VPN gateway to seconds. The controller reports "NA" when the timer is
+// disabled, which is exported as -1.
+func parseVPNGatewayTimer(value string) (int, error) {
+	if value == "NA" || value == "" {
+		return -1, nil
+	}
+	return strconv.Atoi(value)
+}
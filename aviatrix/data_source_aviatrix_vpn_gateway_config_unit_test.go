@@ -0,0 +1,85 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceAviatrixVPNGatewayConfigRead(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetGatewayContextFunc: func(ctx context.Context, gateway *goaviatrix.Gateway) (*goaviatrix.Gateway, error) {
+			assert.Equal(t, "vpn-gw", gateway.GwName)
+			return &goaviatrix.Gateway{
+				GwName:                "vpn-gw",
+				VpcID:                 "vpc-0123",
+				VpnStatus:             "enabled",
+				VpnCidr:               "192.168.43.0/24",
+				ElbState:              "enabled",
+				ElbName:               "vpn-elb",
+				SplitTunnel:           "yes",
+				MaxConn:               "100",
+				IdleTimeout:           "300",
+				RenegotiationInterval: "NA",
+				EnableLdap:            true,
+				LdapServer:            "10.0.0.10",
+				SamlEnabled:           "no",
+				AuthMethod:            "okta_auth",
+				PbrEnabled:            "yes",
+			}, nil
+		},
+		GetGatewayDetailContextFunc: func(ctx context.Context, gateway *goaviatrix.Gateway) (*goaviatrix.GatewayDetail, error) {
+			detail := &goaviatrix.GatewayDetail{VpnNat: true}
+			detail.Elb.VpnProtocol = "udp"
+			return detail, nil
+		},
+		GetSplitTunnelContextFunc: func(ctx context.Context, splitTunnel *goaviatrix.SplitTunnel) (*goaviatrix.SplitTunnelUnit, error) {
+			assert.Equal(t, "vpn-elb", splitTunnel.ElbName)
+			return &goaviatrix.SplitTunnelUnit{NameServers: "8.8.8.8", SearchDomains: "example.com", AdditionalCidrs: "10.10.0.0/16"}, nil
+		},
+		GetVPNConfigListContextFunc: func(ctx context.Context, gateway *goaviatrix.Gateway) ([]goaviatrix.VPNConfig, error) {
+			assert.Equal(t, "vpc-0123", gateway.VpcID)
+			assert.Equal(t, "vpn-elb", gateway.GwName)
+			return []goaviatrix.VPNConfig{{Name: "Policy Based Routing", Value: "10.0.0.0/24", Status: "enabled"}}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixVPNGatewayConfig().Schema, map[string]interface{}{
+		"gw_name": "vpn-gw",
+	})
+	diags := dataSourceAviatrixVPNGatewayConfigRead(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "vpn-gw", d.Id())
+	assert.Equal(t, "vpn-elb", d.Get("lb_name"))
+	assert.Equal(t, "UDP", d.Get("vpn_protocol"))
+	assert.Equal(t, true, d.Get("split_tunnel"))
+	assert.Equal(t, "8.8.8.8", d.Get("name_servers"))
+	assert.Equal(t, 300, d.Get("idle_timeout"))
+	assert.Equal(t, -1, d.Get("renegotiation_interval"))
+	assert.Equal(t, true, d.Get("enable_vpn_nat"))
+	assert.Equal(t, true, d.Get("enable_ldap"))
+	assert.Equal(t, "3", d.Get("otp_mode"))
+	assert.Equal(t, true, d.Get("enable_pbr"))
+	assert.Equal(t, "Policy Based Routing", d.Get("vpn_configs.0.name"))
+	assert.Equal(t, "enabled", d.Get("vpn_configs.0.status"))
+}
+
+func TestDataSourceAviatrixVPNGatewayConfigRead_WhenVPNDisabled(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetGatewayContextFunc: func(ctx context.Context, gateway *goaviatrix.Gateway) (*goaviatrix.Gateway, error) {
+			return &goaviatrix.Gateway{GwName: "gw", VpnStatus: "disabled"}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixVPNGatewayConfig().Schema, map[string]interface{}{
+		"gw_name": "gw",
+	})
+	diags := dataSourceAviatrixVPNGatewayConfigRead(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("VPN access is not enabled on Aviatrix gateway gw"), diags)
+}
@@ -0,0 +1,224 @@
+package aviatrix
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceFilterAttributes are the attributes of the filter blocks of the
+// plural data sources. Each data source supports a subset of them.
+var dataSourceFilterAttributes = map[string]*schema.Schema{
+	"cloud_type": {
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "Only return the items of this cloud type.",
+	},
+	"account_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return the items of this access account.",
+	},
+	"vpc_reg": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return the items in this region.",
+	},
+	"name_regex": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+		Description:  "Only return the items whose name matches this regular expression.",
+	},
+	"tags": {
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Only return the items having all of these tags. An empty value matches any value of the tag key.",
+	},
+	"transit_gw": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return the spoke gateways attached to this transit gateway.",
+	},
+	"gw_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return the items of this gateway.",
+	},
+	"status": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Only return the items with this status, compared ignoring case.",
+	},
+}
+
+// dataSourceFilterSchema returns the schema of the filter blocks supporting
+// the given attributes.
+func dataSourceFilterSchema(attributes ...string) *schema.Schema {
+	s := make(map[string]*schema.Schema, len(attributes))
+	for _, name := range attributes {
+		s[name] = dataSourceFilterAttributes[name]
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Filters evaluated on the results. An item is returned if it matches all the arguments of any filter block.",
+		Elem:        &schema.Resource{Schema: s},
+	}
+}
+
+// dataSourceFilter is one filter block of a plural data source. Unset fields
+// match everything.
+type dataSourceFilter struct {
+	CloudType   int
+	AccountName string
+	Region      string
+	NameRegex   *regexp.Regexp
+	Tags        map[string]string
+	TransitGw   string
+	GwName      string
+	Status      string
+}
+
+// filterItem holds the attributes of a data source item that filters are
+// evaluated on.
+type filterItem struct {
+	CloudType   int
+	AccountName string
+	Region      string
+	Name        string
+	Tags        map[string]string
+	TransitGws  []string
+	GwNames     []string
+	Status      string
+}
+
+// gatewayFilterItem returns the attributes of a gateway that filters are
+// evaluated on.
+func gatewayFilterItem(gw goaviatrix.Gateway) filterItem {
+	item := filterItem{
+		CloudType:   gw.CloudType,
+		AccountName: gw.AccountName,
+		Region:      gw.VpcRegion,
+		Name:        gw.GwName,
+		Tags:        gw.Tags,
+	}
+	if gw.TransitGwName != "" {
+		item.TransitGws = append(item.TransitGws, gw.TransitGwName)
+	}
+	if gw.EgressTransitGwName != "" {
+		item.TransitGws = append(item.TransitGws, gw.EgressTransitGwName)
+	}
+	return item
+}
+
+// expandDataSourceFilters reads the filter blocks of a data source.
+func expandDataSourceFilters(d *schema.ResourceData) ([]*dataSourceFilter, error) {
+	var filters []*dataSourceFilter
+	for _, v := range d.Get("filter").([]interface{}) {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			// An empty filter block matches everything.
+			filters = append(filters, &dataSourceFilter{})
+			continue
+		}
+
+		filter := &dataSourceFilter{}
+		if cloudType, ok := m["cloud_type"].(int); ok {
+			filter.CloudType = cloudType
+		}
+		if accountName, ok := m["account_name"].(string); ok {
+			filter.AccountName = accountName
+		}
+		if region, ok := m["vpc_reg"].(string); ok {
+			filter.Region = region
+		}
+		if nameRegex, ok := m["name_regex"].(string); ok && nameRegex != "" {
+			re, err := regexp.Compile(nameRegex)
+			if err != nil {
+				return nil, fmt.Errorf("invalid name_regex %q: %w", nameRegex, err)
+			}
+			filter.NameRegex = re
+		}
+		if tags, ok := m["tags"].(map[string]interface{}); ok && len(tags) != 0 {
+			filter.Tags = make(map[string]string, len(tags))
+			for k, v := range tags {
+				filter.Tags[k] = v.(string)
+			}
+		}
+		if transitGw, ok := m["transit_gw"].(string); ok {
+			filter.TransitGw = transitGw
+		}
+		if gwName, ok := m["gw_name"].(string); ok {
+			filter.GwName = gwName
+		}
+		if status, ok := m["status"].(string); ok {
+			filter.Status = status
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// match reports whether item matches all the set fields of the filter.
+func (f *dataSourceFilter) match(item filterItem) bool {
+	if f.CloudType != 0 && f.CloudType != item.CloudType {
+		return false
+	}
+	if f.AccountName != "" && f.AccountName != item.AccountName {
+		return false
+	}
+	if f.Region != "" && f.Region != item.Region {
+		return false
+	}
+	if f.NameRegex != nil && !f.NameRegex.MatchString(item.Name) {
+		return false
+	}
+	for k, v := range f.Tags {
+		tag, ok := item.Tags[k]
+		if !ok || (v != "" && v != tag) {
+			return false
+		}
+	}
+	if f.TransitGw != "" && !stringInSlice(f.TransitGw, item.TransitGws) {
+		return false
+	}
+	if f.GwName != "" && !stringInSlice(f.GwName, item.GwNames) {
+		return false
+	}
+	if f.Status != "" && !strings.EqualFold(f.Status, item.Status) {
+		return false
+	}
+	return true
+}
+
+// withoutGwName returns copies of the filters ignoring gw_name, so the other
+// arguments can be evaluated before the gateways of an item are fetched.
+func withoutGwName(filters []*dataSourceFilter) []*dataSourceFilter {
+	result := make([]*dataSourceFilter, 0, len(filters))
+	for _, f := range filters {
+		filter := *f
+		filter.GwName = ""
+		result = append(result, &filter)
+	}
+	return result
+}
+
+// matchDataSourceFilters reports whether item matches any of the filters. All
+// items match when there are no filters.
+func matchDataSourceFilters(filters []*dataSourceFilter, item filterItem) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, f := range filters {
+		if f.match(item) {
+			return true
+		}
+	}
+	return false
+}
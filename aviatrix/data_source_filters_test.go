@@ -0,0 +1,90 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceFilterMatch(t *testing.T) {
+	item := filterItem{
+		CloudType:   goaviatrix.AWS,
+		AccountName: "prod",
+		Region:      "us-east-1",
+		Name:        "spoke-prod-1",
+		Tags:        map[string]string{"env": "prod", "team": "netops"},
+		TransitGws:  []string{"transit-1", "egress-transit"},
+	}
+
+	tests := []struct {
+		name   string
+		filter dataSourceFilter
+		want   bool
+	}{
+		{name: "empty", filter: dataSourceFilter{}, want: true},
+		{name: "all fields", filter: dataSourceFilter{CloudType: goaviatrix.AWS, AccountName: "prod", Region: "us-east-1", Tags: map[string]string{"env": "prod"}, TransitGw: "egress-transit"}, want: true},
+		{name: "cloud type", filter: dataSourceFilter{CloudType: goaviatrix.Azure}, want: false},
+		{name: "account", filter: dataSourceFilter{AccountName: "dev"}, want: false},
+		{name: "region", filter: dataSourceFilter{Region: "us-west-2"}, want: false},
+		{name: "tag key only", filter: dataSourceFilter{Tags: map[string]string{"team": ""}}, want: true},
+		{name: "tag value", filter: dataSourceFilter{Tags: map[string]string{"env": "dev"}}, want: false},
+		{name: "missing tag", filter: dataSourceFilter{Tags: map[string]string{"owner": ""}}, want: false},
+		{name: "transit gateway", filter: dataSourceFilter{TransitGw: "transit-2"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.match(item))
+		})
+	}
+}
+
+func TestDataSourceAviatrixSpokeGatewaysRead_WithFilters(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetControllerIPFunc: func() string { return "10.0.0.1" },
+		GetSpokeGatewayListFunc: func(ctx context.Context) ([]goaviatrix.Gateway, error) {
+			return []goaviatrix.Gateway{
+				{GwName: "spoke-prod-1", CloudType: goaviatrix.AWS, AccountName: "prod", VpcRegion: "us-east-1", SpokeVpc: "yes", TransitGwName: "transit-1"},
+				{GwName: "spoke-prod-2", CloudType: goaviatrix.AWS, AccountName: "prod", VpcRegion: "us-west-2", SpokeVpc: "yes", TransitGwName: "transit-2"},
+				{GwName: "spoke-dev-1", CloudType: goaviatrix.Azure, AccountName: "dev", VpcRegion: "East US", Tags: map[string]string{"env": "dev"}},
+				{GwName: "edge-1", CloudType: goaviatrix.AWS, AccountName: "prod", VpcRegion: "us-east-1"},
+			}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixSpokeGateways().Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"account_name": "prod", "name_regex": "^spoke-", "transit_gw": "transit-1"},
+			map[string]interface{}{"tags": map[string]interface{}{"env": "dev"}},
+		},
+	})
+	diags := dataSourceAviatrixSpokeGatewaysRead(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	var names []string
+	for _, gw := range d.Get("gateway_list").([]interface{}) {
+		names = append(names, gw.(map[string]interface{})["gw_name"].(string))
+	}
+	assert.Equal(t, []string{"spoke-prod-1", "spoke-dev-1"}, names)
+}
+
+func TestDataSourceAviatrixSmartGroupsRead_WithFilters(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetControllerIPFunc: func() string { return "10.0.0.1" },
+		GetSmartGroupsFunc: func(ctx context.Context) ([]*goaviatrix.SmartGroup, error) {
+			return []*goaviatrix.SmartGroup{{Name: "web-prod", UUID: "1"}, {Name: "db-prod", UUID: "2"}, {Name: "web-dev", UUID: "3"}}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAviatrixSmartGroups().Schema, map[string]interface{}{
+		"filter": []interface{}{map[string]interface{}{"name_regex": "^web-"}},
+	})
+	diags := dataSourceAviatrixSmartGroupsRead(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, 2, d.Get("smart_groups.#"))
+	assert.Equal(t, "web-prod", d.Get("smart_groups.0.name"))
+	assert.Equal(t, "web-dev", d.Get("smart_groups.1.name"))
+}
@@ -0,0 +1,253 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix/fakecontroller"
+)
+
+// The tests in this file call the CRUD functions of the resources against an
+// in-process fake controller, so they need neither TF_ACC, cloud credentials
+// nor a terraform binary.
+
+func testFakeController(t *testing.T) *fakecontroller.Server {
+	srv := fakecontroller.New()
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestFakeController_ProviderConfigure(t *testing.T) {
+	srv := testFakeController(t)
+
+	p := Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"controller_ip": srv.Host(),
+		"username":      srv.Username,
+		"password":      srv.Password,
+	}))
+	assert.False(t, diags.HasError(), "%v", diags)
+
+	client, ok := p.Meta().(*goaviatrix.Client)
+	assert.True(t, ok)
+	assert.NotEmpty(t, client.CID)
+	assert.Contains(t, srv.Actions(), "list_version_info")
+}
+
+// testFakeMeta returns the client of a provider configured against srv.
+func testFakeMeta(t *testing.T, srv *fakecontroller.Server) *goaviatrix.Client {
+	p := Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"controller_ip": srv.Host(),
+		"username":      srv.Username,
+		"password":      srv.Password,
+		"retry_policy": []interface{}{
+			map[string]interface{}{"async_poll_interval": "1ms"},
+		},
+	}))
+	if diags.HasError() {
+		t.Fatalf("configure provider: %v", diags)
+	}
+	return p.Meta().(*goaviatrix.Client)
+}
+
+// testFakeResourceData returns the resource data planned for config from the
+// prior state, which is nil for a new resource.
+func testFakeResourceData(t *testing.T, r *schema.Resource, state *terraform.InstanceState, config map[string]interface{}, meta interface{}) *schema.ResourceData {
+	diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), meta)
+	if err != nil {
+		t.Fatalf("plan %v: %v", config, err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("plan %v: %v", config, err)
+	}
+	return d
+}
+
+func TestFakeController_Account(t *testing.T) {
+	srv := testFakeController(t)
+	meta := testFakeMeta(t, srv)
+	ctx := context.Background()
+	r := resourceAviatrixAccount()
+	config := map[string]interface{}{
+		"account_name":       "aws-account",
+		"cloud_type":         1,
+		"aws_account_number": "123456789012",
+		"aws_iam":            false,
+		"aws_access_key":     "access-key",
+		"aws_secret_key":     "secret-key",
+	}
+
+	d := testFakeResourceData(t, r, nil, config, meta)
+	diags := resourceAviatrixAccountCreate(ctx, d, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "aws-account", d.Id())
+	stored, ok := srv.Account("aws-account")
+	assert.True(t, ok)
+	assert.Equal(t, "123456789012", stored.AwsAccountNumber)
+
+	imported := r.Data(nil)
+	imported.SetId("aws-account")
+	diags = resourceAviatrixAccountRead(ctx, imported, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 1, imported.Get("cloud_type"))
+	assert.Equal(t, "123456789012", imported.Get("aws_account_number"))
+
+	config["aws_account_number"] = "210987654321"
+	d = testFakeResourceData(t, r, d.State(), config, meta)
+	diags = resourceAviatrixAccountUpdate(ctx, d, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	stored, _ = srv.Account("aws-account")
+	assert.Equal(t, "210987654321", stored.AwsAccountNumber)
+
+	diags = resourceAviatrixAccountDelete(ctx, d, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	_, ok = srv.Account("aws-account")
+	assert.False(t, ok)
+
+	// Reading a deleted account removes it from the state.
+	diags = resourceAviatrixAccountRead(ctx, d, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Empty(t, d.Id())
+}
+
+func TestFakeController_Vpc(t *testing.T) {
+	srv := testFakeController(t)
+	srv.AddAccount(goaviatrix.Account{AccountName: "aws-account", CloudType: goaviatrix.AWS})
+	meta := testFakeMeta(t, srv)
+	ctx := context.Background()
+	r := resourceAviatrixVpc()
+
+	d := testFakeResourceData(t, r, nil, map[string]interface{}{
+		"cloud_type":   1,
+		"account_name": "aws-account",
+		"region":       "us-west-1",
+		"name":         "spoke-vpc",
+		"cidr":         "10.1.0.0/16",
+	}, meta)
+	diags := resourceAviatrixVpcCreate(ctx, d, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "spoke-vpc", d.Id())
+	stored, ok := srv.Vpc("spoke-vpc")
+	assert.True(t, ok)
+	assert.Equal(t, stored.VpcID[0], d.Get("vpc_id"))
+
+	imported := r.Data(nil)
+	imported.SetId("spoke-vpc")
+	diags = resourceAviatrixVpcRead(ctx, imported, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "10.1.0.0/16", imported.Get("cidr"))
+	assert.Equal(t, "us-west-1", imported.Get("region"))
+
+	diags = resourceAviatrixVpcDelete(ctx, d, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	_, ok = srv.Vpc("spoke-vpc")
+	assert.False(t, ok)
+}
+
+func TestFakeController_SpokeGatewayAndAttachment(t *testing.T) {
+	srv := testFakeController(t)
+	srv.AddAccount(goaviatrix.Account{AccountName: "aws-account", CloudType: goaviatrix.AWS})
+	srv.AddGateway(goaviatrix.Gateway{GwName: "transit-gw", CloudType: goaviatrix.AWS, AccountName: "aws-account", TransitVpc: "yes"})
+	meta := testFakeMeta(t, srv)
+	ctx := context.Background()
+
+	spoke := testFakeResourceData(t, resourceAviatrixSpokeGateway(), nil, map[string]interface{}{
+		"cloud_type":   1,
+		"account_name": "aws-account",
+		"gw_name":      "spoke-gw",
+		"vpc_id":       "vpc-0123",
+		"vpc_reg":      "us-west-1",
+		"gw_size":      "t3.small",
+		"subnet":       "10.1.0.0/24",
+	}, meta)
+	diags := resourceAviatrixSpokeGatewayCreate(ctx, spoke, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "spoke-gw", spoke.Id())
+	assert.NotEmpty(t, spoke.Get("public_ip"))
+
+	attachment := testFakeResourceData(t, resourceAviatrixSpokeTransitAttachment(), nil, map[string]interface{}{
+		"spoke_gw_name":   "spoke-gw",
+		"transit_gw_name": "transit-gw",
+	}, meta)
+	diags = resourceAviatrixSpokeTransitAttachmentCreate(ctx, attachment, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "spoke-gw~transit-gw", attachment.Id())
+	gw, _ := srv.Gateway("spoke-gw")
+	assert.Equal(t, "transit-gw", gw.TransitGwName)
+
+	imported := resourceAviatrixSpokeTransitAttachment().Data(nil)
+	imported.SetId("spoke-gw~transit-gw")
+	diags = resourceAviatrixSpokeTransitAttachmentRead(ctx, imported, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "spoke-gw", imported.Get("spoke_gw_name"))
+	assert.Equal(t, "transit-gw", imported.Get("transit_gw_name"))
+
+	diags = resourceAviatrixSpokeTransitAttachmentDelete(ctx, attachment, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	gw, _ = srv.Gateway("spoke-gw")
+	assert.Empty(t, gw.TransitGwName)
+
+	diags = resourceAviatrixSpokeGatewayDelete(ctx, spoke, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	_, ok := srv.Gateway("spoke-gw")
+	assert.False(t, ok)
+}
+
+func TestFakeController_SmartGroupAndPolicyList(t *testing.T) {
+	srv := testFakeController(t)
+	meta := testFakeMeta(t, srv)
+	ctx := context.Background()
+	r := resourceAviatrixSmartGroup()
+	config := map[string]interface{}{
+		"name": "web",
+		"selector": []interface{}{map[string]interface{}{
+			"match_expressions": []interface{}{map[string]interface{}{"cidr": "10.0.0.0/16"}},
+		}},
+	}
+
+	smartGroup := testFakeResourceData(t, r, nil, config, meta)
+	diags := resourceAviatrixSmartGroupCreate(ctx, smartGroup, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	uuid := smartGroup.Id()
+	assert.Equal(t, uuid, smartGroup.Get("uuid"))
+
+	config["name"] = "web-tier"
+	smartGroup = testFakeResourceData(t, r, smartGroup.State(), config, meta)
+	diags = resourceAviatrixSmartGroupUpdate(ctx, smartGroup, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	stored, ok := srv.SmartGroup(uuid)
+	assert.True(t, ok)
+	assert.Equal(t, "web-tier", stored.Name)
+
+	policyList := testFakeResourceData(t, resourceAviatrixDistributedFirewallingPolicyList(), nil, map[string]interface{}{
+		"policies": []interface{}{map[string]interface{}{
+			"name":             "allow-web",
+			"action":           "PERMIT",
+			"priority":         1,
+			"protocol":         "TCP",
+			"src_smart_groups": []interface{}{uuid},
+			"dst_smart_groups": []interface{}{uuid},
+		}},
+	}, meta)
+	diags = resourceAviatrixDistributedFirewallingPolicyListCreate(ctx, policyList, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "allow-web", policyList.Get("policies.0.name"))
+	assert.NotEmpty(t, policyList.Get("policies.0.uuid"))
+
+	diags = resourceAviatrixDistributedFirewallingPolicyListDelete(ctx, policyList, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	_, ok = srv.PolicyList()
+	assert.False(t, ok)
+
+	diags = resourceAviatrixSmartGroupDelete(ctx, smartGroup, meta)
+	assert.False(t, diags.HasError(), "%v", diags)
+	_, ok = srv.SmartGroup(uuid)
+	assert.False(t, ok)
+}
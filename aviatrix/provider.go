@@ -1,12 +1,17 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var supportedVersions = []string{"8.1"}
@@ -22,13 +27,36 @@ func Provider() *schema.Provider {
 			},
 			"username": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: envDefaultFunc("AVIATRIX_USERNAME"),
 			},
 			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   envDefaultFunc("AVIATRIX_PASSWORD"),
+				ConflictsWith: []string{"password_command", "password_file"},
+			},
+			"password_command": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   envDefaultFunc("AVIATRIX_PASSWORD_COMMAND"),
+				ConflictsWith: []string{"password", "password_file"},
+				Description:   "Command run with the system shell whose output is the password of username.",
+			},
+			"password_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   envDefaultFunc("AVIATRIX_PASSWORD_FILE"),
+				ConflictsWith: []string{"password", "password_command"},
+				Description:   "Path to a file containing the password of username.",
+			},
+			"api_token": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: envDefaultFunc("AVIATRIX_PASSWORD"),
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: envDefaultFunc("AVIATRIX_API_TOKEN"),
+				Description: "Pre-issued session or API token used instead of logging in with username and password.",
 			},
 			"skip_version_validation": {
 				Type:     schema.TypeBool,
@@ -44,6 +72,41 @@ func Provider() *schema.Provider {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"client_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_key"},
+				Description:  "Path to the PEM encoded client certificate presented to the controller for mutual TLS.",
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_certificate"},
+				Description:  "Path to the PEM encoded private key of client_certificate.",
+			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Server name used to verify the controller certificate and sent with SNI, instead of controller_ip.",
+			},
+			"min_tls_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(tlsVersionNames(), false),
+				Description:  "Minimum TLS version accepted when connecting to the controller.",
+			},
+			"extra_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "HTTP headers added to every request sent to the controller.",
+			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "socks5"}),
+				Description:  "URL of the proxy used to reach the controller, instead of the HTTPS_PROXY environment variable.",
+			},
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -68,6 +131,111 @@ func Provider() *schema.Provider {
 					},
 				},
 			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with tags applied to all resources that support tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tags applied to all resources that support tags.",
+						},
+					},
+				},
+			},
+			"max_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Maximum number of requests per second sent to the controller. 0 means no limit.",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of requests in flight to the controller at the same time. 0 means no limit.",
+			},
+			"retry_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to retry requests failing with transient controller errors.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      goaviatrix.DefaultRetryMaxAttempts,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Total number of attempts for a single request, including the first one.",
+						},
+						"base_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      goaviatrix.DefaultRetryBaseBackoff.String(),
+							ValidateFunc: validateDuration,
+							Description:  "Wait before the first retry. It doubles after every failed attempt and is randomized with jitter.",
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      goaviatrix.DefaultRetryMaxBackoff.String(),
+							ValidateFunc: validateDuration,
+							Description:  "Maximum wait between two attempts.",
+						},
+						"retryable_status_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(400, 599)},
+							Description: "HTTP status codes considered transient. Defaults to 429, 502, 503 and 504.",
+						},
+						"retryable_reasons": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+							Description: "Substrings of non-JSON error responses, such as proxy error pages, considered transient. Defaults to \"502 Proxy Error\" and \"503 Service Unavailable\".",
+						},
+						"retry_non_idempotent": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Retry requests that change controller state even when the controller may have processed them.",
+						},
+						"async_poll_interval": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      goaviatrix.DefaultAsyncPollInterval.String(),
+							ValidateFunc: validateDuration,
+							Description:  "Wait between two status checks of a long-running controller task.",
+						},
+						"async_poll_timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      goaviatrix.DefaultAsyncPollTimeout.String(),
+							ValidateFunc: validateDuration,
+							Description:  "Longest time to wait for a long-running controller task to finish.",
+						},
+					},
+				},
+			},
+			"redacted_log_keys": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional request field, JSON key and header names whose values are masked in the provider logs.",
+			},
+			"check_account_audit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail the plan of gateways whose access account did not pass the controller's account audit.",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -103,6 +271,7 @@ func Provider() *schema.Provider {
 			"aviatrix_controller_private_mode_config":                         resourceAviatrixControllerPrivateModeConfig(),
 			"aviatrix_controller_private_oob":                                 resourceAviatrixControllerPrivateOob(),
 			"aviatrix_controller_security_group_management_config":            resourceAviatrixControllerSecurityGroupManagementConfig(),
+			"aviatrix_cloudn_transit_gateway_attachment":                      resourceAviatrixCloudnTransitGatewayAttachment(),
 			"aviatrix_copilot_association":                                    resourceAviatrixCopilotAssociation(),
 			"aviatrix_copilot_fault_tolerant_deployment":                      resourceAviatrixCopilotFaultTolerantDeployment(),
 			"aviatrix_copilot_security_group_management_config":               resourceAviatrixCopilotSecurityGroupManagementConfig(),
@@ -110,7 +279,9 @@ func Provider() *schema.Provider {
 			"aviatrix_datadog_agent":                                          resourceAviatrixDatadogAgent(),
 			"aviatrix_dcf_mwp_policy_block":                                   resourceAviatrixDCFPolicyBlock(),
 			"aviatrix_dcf_mwp_policy_list":                                    resourceAviatrixDCFPolicyList(),
+			"aviatrix_device_aws_tgw_attachment":                              resourceAviatrixDeviceAwsTgwAttachment(),
 			"aviatrix_device_interface_config":                                resourceAviatrixDeviceInterfaceConfig(),
+			"aviatrix_device_tag":                                             resourceAviatrixDeviceTag(),
 			"aviatrix_distributed_firewalling_config":                         resourceAviatrixDistributedFirewallingConfig(),
 			"aviatrix_distributed_firewalling_intra_vpc":                      resourceAviatrixDistributedFirewallingIntraVpc(),
 			"aviatrix_distributed_firewalling_origin_cert_enforcement_config": resourceAviatrixDistributedFirewallingOriginCertEnforcementConfig(),
@@ -142,6 +313,7 @@ func Provider() *schema.Provider {
 			"aviatrix_edge_zededa_ha":                                         resourceAviatrixEdgeZededaHa(),
 			"aviatrix_filebeat_forwarder":                                     resourceAviatrixFilebeatForwarder(),
 			"aviatrix_firenet":                                                resourceAviatrixFireNet(),
+			"aviatrix_firenet_tgw_connection":                                 resourceAviatrixFireNetTgwConnection(),
 			"aviatrix_firewall":                                               resourceAviatrixFirewall(),
 			"aviatrix_firewall_instance":                                      resourceAviatrixFirewallInstance(),
 			"aviatrix_firewall_instance_association":                          resourceAviatrixFirewallInstanceAssociation(),
@@ -203,10 +375,14 @@ func Provider() *schema.Provider {
 			"aviatrix_vpn_profile":                                            resourceAviatrixProfile(),
 			"aviatrix_vpn_user":                                               resourceAviatrixVPNUser(),
 			"aviatrix_vpn_user_accelerator":                                   resourceAviatrixVPNUserAccelerator(),
+			"aviatrix_vpn_users":                                              resourceAviatrixVPNUsers(),
 			"aviatrix_web_group":                                              resourceAviatrixWebGroup(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"aviatrix_account":                              dataSourceAviatrixAccount(),
+			"aviatrix_account_audit":                        dataSourceAviatrixAccountAudit(),
+			"aviatrix_aws_tgw_attachment":                   dataSourceAviatrixAwsTgwAttachment(),
+			"aviatrix_aws_tgw_route_tables":                 dataSourceAviatrixAwsTgwRouteTables(),
 			"aviatrix_caller_identity":                      dataSourceAviatrixCallerIdentity(),
 			"aviatrix_controller_metadata":                  dataSourceAviatrixControllerMetadata(),
 			"aviatrix_web_group":                            dataSourceAviatrixDcfWebgroups(),
@@ -216,9 +392,13 @@ func Provider() *schema.Provider {
 			"aviatrix_firenet":                              dataSourceAviatrixFireNet(),
 			"aviatrix_firenet_firewall_manager":             dataSourceAviatrixFireNetFirewallManager(),
 			"aviatrix_firenet_vendor_integration":           dataSourceAviatrixFireNetVendorIntegration(),
+			"aviatrix_fqdn_tag":                             dataSourceAviatrixFQDNTag(),
+			"aviatrix_fqdn_tags":                            dataSourceAviatrixFQDNTags(),
 			"aviatrix_gateway":                              dataSourceAviatrixGateway(),
 			"aviatrix_gateway_image":                        dataSourceAviatrixGatewayImage(),
 			"aviatrix_network_domains":                      dataSourceAviatrixNetworkDomains(),
+			"aviatrix_site2cloud":                           dataSourceAviatrixSite2Cloud(),
+			"aviatrix_site2cloud_connections":               dataSourceAviatrixSite2CloudConnections(),
 			"aviatrix_smart_groups":                         dataSourceAviatrixSmartGroups(),
 			"aviatrix_spoke_gateway":                        dataSourceAviatrixSpokeGateway(),
 			"aviatrix_spoke_gateways":                       dataSourceAviatrixSpokeGateways(),
@@ -227,10 +407,11 @@ func Provider() *schema.Provider {
 			"aviatrix_transit_gateways":                     dataSourceAviatrixTransitGateways(),
 			"aviatrix_vpc":                                  dataSourceAviatrixVpc(),
 			"aviatrix_vpc_tracker":                          dataSourceAviatrixVpcTracker(),
+			"aviatrix_vpn_gateway_config":                   dataSourceAviatrixVPNGatewayConfig(),
 			"aviatrix_firewall":                             dataSourceAviatrixFirewall(),
 			"aviatrix_firewall_instance_images":             dataSourceAviatrixFirewallInstanceImages(),
 		},
-		ConfigureFunc: aviatrixConfigure,
+		ConfigureContextFunc: aviatrixConfigure,
 	}
 }
 
@@ -244,45 +425,78 @@ func envDefaultFunc(k string) schema.SchemaDefaultFunc {
 	}
 }
 
-func aviatrixConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfig(d *schema.ResourceData) (Config, error) {
+	retryPolicy, err := expandProviderRetryPolicy(d.Get("retry_policy").([]interface{}))
+	if err != nil {
+		return Config{}, err
+	}
+
 	config := Config{
-		ControllerIP: d.Get("controller_ip").(string),
-		Username:     d.Get("username").(string),
-		Password:     d.Get("password").(string),
-		VerifyCert:   d.Get("verify_ssl_certificate").(bool),
-		PathToCACert: d.Get("path_to_ca_certificate").(string),
-		IgnoreTags:   expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		ControllerIP:    d.Get("controller_ip").(string),
+		Username:        d.Get("username").(string),
+		Password:        d.Get("password").(string),
+		PasswordCommand: d.Get("password_command").(string),
+		PasswordFile:    d.Get("password_file").(string),
+		APIToken:        d.Get("api_token").(string),
+		VerifyCert:      d.Get("verify_ssl_certificate").(bool),
+		PathToCACert:    d.Get("path_to_ca_certificate").(string),
+		ClientCertPath:  d.Get("client_certificate").(string),
+		ClientKeyPath:   d.Get("client_key").(string),
+		TLSServerName:   d.Get("tls_server_name").(string),
+		MinTLSVersion:   d.Get("min_tls_version").(string),
+		ExtraHeaders:    getStringMap(d, "extra_headers"),
+		ProxyURL:        d.Get("proxy_url").(string),
+		IgnoreTags:      expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		DefaultTags:     expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
+		RetryPolicy:     retryPolicy,
+
+		MaxRequestsPerSecond:  d.Get("max_requests_per_second").(float64),
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+		RedactedLogKeys:       getStringSet(d, "redacted_log_keys"),
+		CheckAccountAudit:     d.Get("check_account_audit").(bool),
+	}
+	if err := config.validateCredentials(); err != nil {
+		return Config{}, err
 	}
 
-	skipVersionValidation := d.Get("skip_version_validation").(bool)
-	if skipVersionValidation {
-		return config.Client()
+	return config, nil
+}
+
+func aviatrixConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	config, err := providerConfig(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
 
-	client, err := config.Client()
+	client, err := config.Client(ctx)
 	if err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
+	}
+
+	skipVersionValidation := d.Get("skip_version_validation").(bool)
+	if skipVersionValidation {
+		return client, nil
 	}
 
-	err = client.ControllerVersionValidation(supportedVersions)
+	err = client.ControllerVersionValidationContext(ctx, supportedVersions)
 	if err != nil {
-		return nil, errors.New("controller version validation failed: " + err.Error())
+		return nil, diag.FromErr(errors.New("controller version validation failed: " + err.Error()))
 	}
 
 	return client, nil
 }
 
-func aviatrixConfigureWithoutVersionValidation(d *schema.ResourceData) (interface{}, error) {
-	config := Config{
-		ControllerIP: d.Get("controller_ip").(string),
-		Username:     d.Get("username").(string),
-		Password:     d.Get("password").(string),
-		VerifyCert:   d.Get("verify_ssl_certificate").(bool),
-		PathToCACert: d.Get("path_to_ca_certificate").(string),
-		IgnoreTags:   expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+func aviatrixConfigureWithoutVersionValidation(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	config, err := providerConfig(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
 
-	return config.Client()
+	client, err := config.Client(ctx)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return client, nil
 }
 
 func expandProviderIgnoreTags(l []interface{}) *goaviatrix.IgnoreTagsConfig {
@@ -303,3 +517,61 @@ func expandProviderIgnoreTags(l []interface{}) *goaviatrix.IgnoreTagsConfig {
 
 	return ignoreConfig
 }
+
+func expandProviderDefaultTags(l []interface{}) map[string]string {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	tags, ok := m["tags"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	defaultTags := make(map[string]string, len(tags))
+	for k, v := range tags {
+		defaultTags[k] = v.(string)
+	}
+
+	return defaultTags
+}
+
+func expandProviderRetryPolicy(l []interface{}) (*goaviatrix.RetryPolicy, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	retryPolicy := goaviatrix.DefaultRetryPolicy()
+	m := l[0].(map[string]interface{})
+
+	retryPolicy.MaxAttempts = m["max_attempts"].(int)
+	retryPolicy.RetryNonIdempotent = m["retry_non_idempotent"].(bool)
+
+	durations := map[string]*time.Duration{
+		"base_backoff":        &retryPolicy.BaseBackoff,
+		"max_backoff":         &retryPolicy.MaxBackoff,
+		"async_poll_interval": &retryPolicy.AsyncPollInterval,
+		"async_poll_timeout":  &retryPolicy.AsyncPollTimeout,
+	}
+	for k, v := range durations {
+		duration, err := time.ParseDuration(m[k].(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry_policy %s: %w", k, err)
+		}
+		*v = duration
+	}
+
+	if v, ok := m["retryable_status_codes"].(*schema.Set); ok && v.Len() > 0 {
+		retryPolicy.RetryableStatusCodes = nil
+		for _, code := range v.List() {
+			retryPolicy.RetryableStatusCodes = append(retryPolicy.RetryableStatusCodes, code.(int))
+		}
+	}
+
+	if v, ok := m["retryable_reasons"].(*schema.Set); ok && v.Len() > 0 {
+		retryPolicy.RetryableReasons = goaviatrix.ExpandStringList(v.List())
+	}
+
+	return retryPolicy, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -31,7 +32,7 @@ func init() {
 	}
 
 	testAccProviderVersionValidation = Provider()
-	testAccProviderVersionValidation.ConfigureFunc = aviatrixConfigureWithoutVersionValidation
+	testAccProviderVersionValidation.ConfigureContextFunc = aviatrixConfigureWithoutVersionValidation
 	testAccProvidersVersionValidation = map[string]*schema.Provider{
 		"aviatrix": testAccProviderVersionValidation,
 	}
@@ -47,6 +48,93 @@ func TestProvider_impl(t *testing.T) {
 	_ = Provider()
 }
 
+func TestExpandProviderRetryPolicy(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"retry_policy": []interface{}{
+			map[string]interface{}{
+				"max_attempts":           3,
+				"base_backoff":           "1s",
+				"async_poll_timeout":     "2h",
+				"retryable_status_codes": []interface{}{503},
+				"retryable_reasons":      []interface{}{"upstream connect error"},
+			},
+		},
+	})
+
+	retryPolicy, err := expandProviderRetryPolicy(d.Get("retry_policy").([]interface{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if retryPolicy.MaxAttempts != 3 {
+		t.Errorf("expected max_attempts 3, got %d", retryPolicy.MaxAttempts)
+	}
+	if retryPolicy.BaseBackoff != time.Second {
+		t.Errorf("expected base_backoff 1s, got %s", retryPolicy.BaseBackoff)
+	}
+	if retryPolicy.MaxBackoff != goaviatrix.DefaultRetryMaxBackoff {
+		t.Errorf("expected default max_backoff, got %s", retryPolicy.MaxBackoff)
+	}
+	if retryPolicy.AsyncPollTimeout != 2*time.Hour {
+		t.Errorf("expected async_poll_timeout 2h, got %s", retryPolicy.AsyncPollTimeout)
+	}
+	if len(retryPolicy.RetryableStatusCodes) != 1 || retryPolicy.RetryableStatusCodes[0] != 503 {
+		t.Errorf("expected retryable_status_codes [503], got %v", retryPolicy.RetryableStatusCodes)
+	}
+	if len(retryPolicy.RetryableReasons) != 1 || retryPolicy.RetryableReasons[0] != "upstream connect error" {
+		t.Errorf("expected retryable_reasons [upstream connect error], got %v", retryPolicy.RetryableReasons)
+	}
+
+	retryPolicy, err = expandProviderRetryPolicy(nil)
+	if err != nil || retryPolicy != nil {
+		t.Errorf("expected no retry policy without a retry_policy block, got %v, %v", retryPolicy, err)
+	}
+}
+
+func TestProviderConfigCredentials(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "password",
+			raw:  map[string]interface{}{"username": "admin", "password": "p@ss"},
+		},
+		{
+			name: "password file",
+			raw:  map[string]interface{}{"username": "admin", "password_file": "/run/secrets/aviatrix"},
+		},
+		{
+			name: "api token",
+			raw:  map[string]interface{}{"api_token": "t0k3n"},
+		},
+		{
+			name:    "no credentials",
+			raw:     map[string]interface{}{},
+			wantErr: `either "api_token" or "username" must be set`,
+		},
+		{
+			name:    "no password",
+			raw:     map[string]interface{}{"username": "admin"},
+			wantErr: `one of "password", "password_command" or "password_file" must be set`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range []string{"AVIATRIX_USERNAME", "AVIATRIX_PASSWORD", "AVIATRIX_PASSWORD_COMMAND", "AVIATRIX_PASSWORD_FILE", "AVIATRIX_API_TOKEN"} {
+				t.Setenv(k, "")
+			}
+			_, err := providerConfig(schema.TestResourceDataRaw(t, Provider().Schema, tt.raw))
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("AVIATRIX_CONTROLLER_IP"); v == "" {
 		t.Fatal("AVIATRIX_CONTROLLER_IP must be set for acceptance tests.")
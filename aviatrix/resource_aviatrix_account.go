@@ -3,11 +3,11 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -19,7 +19,7 @@ func resourceAviatrixAccount() *schema.Resource {
 		UpdateWithoutTimeout: resourceAviatrixAccountUpdate,
 		DeleteWithoutTimeout: resourceAviatrixAccountDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -440,7 +440,7 @@ func resourceAviatrixAccount() *schema.Resource {
 }
 
 func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 	account := &goaviatrix.Account{
 		AccountName:                           d.Get("account_name").(string),
 		CloudType:                             d.Get("cloud_type").(int),
@@ -578,7 +578,7 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 			return diag.Errorf("aws iam can only be 'true' or 'false'")
 		}
 
-		log.Printf("[INFO] Creating Aviatrix account: %#v", account)
+		tflog.Info(ctx, "Creating Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 		if awsIam {
 			if account.AwsAccessKey != "" || account.AwsSecretKey != "" {
 				return diag.Errorf("could not create Aviatrix Account: 'aws_access_key' and 'aws_secret_key' can only be set when 'aws_iam' is false and 'cloud_type' is AWS (1)")
@@ -590,8 +590,8 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 			if _, ok := d.GetOk("aws_role_ec2"); !ok {
 				account.AwsRoleEc2 = fmt.Sprintf("arn:aws:iam::%s:role/aviatrix-role-ec2", account.AwsAccountNumber)
 			}
-			log.Printf("[TRACE] Reading Aviatrix account aws_role_app: [%s]", d.Get("aws_role_app").(string))
-			log.Printf("[TRACE] Reading Aviatrix account aws_role_ec2: [%s]", d.Get("aws_role_ec2").(string))
+			tflog.Trace(ctx, "Reading Aviatrix account aws_role_app", map[string]interface{}{"aws_role_app": d.Get("aws_role_app").(string)})
+			tflog.Trace(ctx, "Reading Aviatrix account aws_role_ec2", map[string]interface{}{"aws_role_ec2": d.Get("aws_role_ec2").(string)})
 		} else {
 			if account.AwsRoleApp != "" || account.AwsRoleEc2 != "" {
 				return diag.Errorf("could not create Aviatrix Account: 'aws_role_app' and 'aws_role_ec2' can only be set when 'aws_iam' is true and 'cloud_type' is AWS (1)")
@@ -604,7 +604,7 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 		if account.GcloudProjectCredentialsFilepathLocal == "" {
 			return diag.Errorf("gcloud project credentials local filepath needed to upload file to controller")
 		}
-		log.Printf("[INFO] Creating Aviatrix account: %#v", account)
+		tflog.Info(ctx, "Creating Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 	} else if account.CloudType == goaviatrix.Azure {
 		if account.ArmSubscriptionId == "" {
 			return diag.Errorf("arm subscription id needed for azure cloud")
@@ -725,7 +725,7 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 				"edge_zededa_username and edge_zededa_password are required to create an Aviatrix account for Edge Zededa")
 		}
 	} else if goaviatrix.IsCloudType(account.CloudType, goaviatrix.EdgeRelatedCloudTypes) {
-		log.Print("no check is needed to create an Aviatrix account for Edge Equinix, Edge NEO and Edge Megaport")
+		tflog.Debug(ctx, "no check is needed to create an Aviatrix account for Edge Equinix, Edge NEO and Edge Megaport")
 	} else {
 		return diag.Errorf("cloud type can only be either AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384), AWS Secret (32768), Edge CSP/Zededa (65536), Edge Equinix (524288), Edge Megaport(1048576) or Edge NEO/Platform (262144)")
 	}
@@ -758,7 +758,7 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 	isImport := accountName == ""
 	if isImport {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no account name received", map[string]interface{}{"id": id})
 		d.Set("account_name", id)
 		d.SetId(id)
 	}
@@ -767,7 +767,7 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 		AccountName: d.Get("account_name").(string),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix account: %#v", account)
+	tflog.Info(ctx, "Looking for Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 
 	acc, err := client.GetAccount(account)
 	if err != nil {
@@ -867,7 +867,7 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 	defer client.InvalidateCache()
 	account := &goaviatrix.Account{
 		AccountName:                           d.Get("account_name").(string),
@@ -933,7 +933,7 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 	awsChinaIam := d.Get("awschina_iam").(bool)
 	account.AwsChinaIam = strconv.FormatBool(awsChinaIam)
 
-	log.Printf("[INFO] Updating Aviatrix account: %#v", account)
+	tflog.Info(ctx, "Updating Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 
 	d.Partial(true)
 
@@ -1100,7 +1100,7 @@ func resourceAviatrixAccountDelete(ctx context.Context, d *schema.ResourceData,
 		AccountName: d.Get("account_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix account: %#v", account)
+	tflog.Info(ctx, "Deleting Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 	defer client.InvalidateCache()
 	err := client.DeleteAccount(account)
 	if err != nil {
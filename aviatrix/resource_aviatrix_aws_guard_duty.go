@@ -1,23 +1,24 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAviatrixAwsGuardDuty() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAwsGuardDutyCreate,
-		Read:   resourceAviatrixAwsGuardDutyRead,
-		Update: resourceAviatrixAwsGuardDutyUpdate,
-		Delete: resourceAviatrixAwsGuardDutyDelete,
+		CreateContext: resourceAviatrixAwsGuardDutyCreate,
+		ReadContext:   resourceAviatrixAwsGuardDutyRead,
+		UpdateContext: resourceAviatrixAwsGuardDutyUpdate,
+		DeleteContext: resourceAviatrixAwsGuardDutyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"account_name": {
@@ -45,78 +46,78 @@ func resourceAviatrixAwsGuardDuty() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAwsGuardDutyCreate(d *schema.ResourceData, meta interface{}) (err error) {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsGuardDutyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(goaviatrix.ClientInterface)
 	guardDuty := marshalAwsGuardDutyInput(d)
 
-	err = client.EnableAwsGuardDuty(guardDuty)
+	err := client.EnableAwsGuardDutyContext(ctx, guardDuty)
 	if err != nil {
-		return fmt.Errorf("could not enable AWS GuardDuty: %v", err)
+		return diag.Errorf("could not enable AWS GuardDuty: %v", err)
 	}
 	d.SetId(guardDuty.ID())
-	defer captureErr(resourceAviatrixAwsGuardDutyRead, d, meta, &err)
-	err = client.UpdateAwsGuardDutyExcludedIPs(guardDuty)
+	defer captureDiags(ctx, resourceAviatrixAwsGuardDutyRead, d, meta, &diags)
+	err = client.UpdateAwsGuardDutyExcludedIPsContext(ctx, guardDuty)
 	if err != nil {
-		return fmt.Errorf("could not set excluded IPs: %v", err)
+		return diag.Errorf("could not set excluded IPs: %v", err)
 	}
-	return err
+	return nil
 }
 
-func resourceAviatrixAwsGuardDutyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsGuardDutyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	accName := d.Get("account_name").(string)
 	region := d.Get("region").(string)
 	if accName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account_name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no account_name received", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "~~")
 		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-			return fmt.Errorf("invalid import ID: %q", id)
+			return diag.Errorf("invalid import ID: %q", id)
 		}
 		accName, region = parts[0], parts[1]
 		d.SetId(id)
 	}
 
-	acc, err := client.GetAwsGuardDutyAccount(accName, region)
+	acc, err := client.GetAwsGuardDutyAccountContext(ctx, accName, region)
 	if err == goaviatrix.ErrNotFound {
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get guard duty account: %v", err)
+		return diag.Errorf("could not get guard duty account: %v", err)
 	}
 
 	d.Set("account_name", acc.AccountName)
 	d.Set("region", acc.Region)
 	if err := d.Set("excluded_ips", acc.ExcludedIPs); err != nil {
-		return fmt.Errorf("setting excluded_ips: %v", err)
+		return diag.Errorf("setting excluded_ips: %v", err)
 	}
 
 	d.SetId(acc.ID())
 	return nil
 }
 
-func resourceAviatrixAwsGuardDutyUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsGuardDutyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	account := marshalAwsGuardDutyInput(d)
 
 	if d.HasChange("excluded_ips") {
-		err := client.UpdateAwsGuardDutyExcludedIPs(account)
+		err := client.UpdateAwsGuardDutyExcludedIPsContext(ctx, account)
 		if err != nil {
-			return fmt.Errorf("could not edit GuardDuty excluded IPs: %v", err)
+			return diag.Errorf("could not edit GuardDuty excluded IPs: %v", err)
 		}
 	}
 	return nil
 }
 
-func resourceAviatrixAwsGuardDutyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsGuardDutyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	account := marshalAwsGuardDutyInput(d)
 
-	err := client.DisableAwsGuardDuty(account)
+	err := client.DisableAwsGuardDutyContext(ctx, account)
 	if err != nil {
-		return fmt.Errorf("could not disable GuardDuty: %v", err)
+		return diag.Errorf("could not disable GuardDuty: %v", err)
 	}
 	return nil
 }
@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAWSPeer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAWSPeerCreate,
-		Read:   resourceAviatrixAWSPeerRead,
-		Delete: resourceAviatrixAWSPeerDelete,
+		CreateContext: resourceAviatrixAWSPeerCreate,
+		ReadContext:   resourceAviatrixAWSPeerRead,
+		DeleteContext: resourceAviatrixAWSPeerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -83,8 +84,8 @@ func resourceAviatrixAWSPeer() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAWSPeerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSPeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	awsPeer := &goaviatrix.AWSPeer{
 		AccountName1: d.Get("account_name1").(string),
@@ -106,36 +107,36 @@ func resourceAviatrixAWSPeerCreate(d *schema.ResourceData, meta interface{}) err
 		awsPeer.RtbList2 = "all"
 	}
 
-	log.Printf("[INFO] Creating Aviatrix aws_peer: %#v", awsPeer)
+	tflog.Info(ctx, "Creating Aviatrix aws_peer", map[string]interface{}{"vpc_id1": awsPeer.VpcID1, "vpc_id2": awsPeer.VpcID2})
 
 	d.SetId(awsPeer.VpcID1 + "~" + awsPeer.VpcID2)
 	flag := false
-	defer resourceAviatrixAWSPeerReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAWSPeerReadIfRequired(ctx, d, meta, &flag)
 
-	_, err := client.CreateAWSPeer(awsPeer)
+	_, err := client.CreateAWSPeerContext(ctx, awsPeer)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix AWSPeer: %s", err)
+		return diag.Errorf("failed to create Aviatrix AWSPeer: %s", err)
 	}
 
-	return resourceAviatrixAWSPeerReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAWSPeerReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAWSPeerReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAWSPeerReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAWSPeerRead(d, meta)
+		return resourceAviatrixAWSPeerRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAWSPeerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSPeerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpcID1 := d.Get("vpc_id1").(string)
 	vpcID2 := d.Get("vpc_id2").(string)
 	if vpcID1 == "" || vpcID2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc id received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no vpc id received", map[string]interface{}{"id": id})
 		d.Set("vpc_id1", strings.Split(id, "~")[0])
 		d.Set("vpc_id2", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -146,16 +147,16 @@ func resourceAviatrixAWSPeerRead(d *schema.ResourceData, meta interface{}) error
 		VpcID2: d.Get("vpc_id2").(string),
 	}
 
-	ap, err := client.GetAWSPeer(awsPeer)
+	ap, err := client.GetAWSPeerContext(ctx, awsPeer)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix AWSPeer: %s", err)
+		return diag.Errorf("couldn't find Aviatrix AWSPeer: %s", err)
 	}
 
-	log.Printf("[TRACE] Reading aws_peer: %#v", ap)
+	tflog.Trace(ctx, "Reading aws_peer", map[string]interface{}{"vpc_id1": ap.VpcID1, "vpc_id2": ap.VpcID2})
 
 	if ap != nil {
 		d.Set("vpc_id1", ap.VpcID1)
@@ -166,28 +167,28 @@ func resourceAviatrixAWSPeerRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("vpc_reg2", ap.Region2)
 
 		if err := d.Set("rtb_list1", strings.Split(ap.RtbList1, ",")); err != nil {
-			log.Printf("[WARN] Error setting rtb_list1 for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, "Error setting rtb_list1", map[string]interface{}{"id": d.Id(), "error": err})
 		}
 		if err := d.Set("rtb_list2", strings.Split(ap.RtbList2, ",")); err != nil {
-			log.Printf("[WARN] Error setting rtb_list2 for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, "Error setting rtb_list2", map[string]interface{}{"id": d.Id(), "error": err})
 		}
 	}
 
 	return nil
 }
 
-func resourceAviatrixAWSPeerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSPeerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	awsPeer := &goaviatrix.AWSPeer{
 		VpcID1: d.Get("vpc_id1").(string),
 		VpcID2: d.Get("vpc_id2").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix aws_peer: %#v", awsPeer)
+	tflog.Info(ctx, "Deleting Aviatrix aws_peer", map[string]interface{}{"vpc_id1": awsPeer.VpcID1, "vpc_id2": awsPeer.VpcID2})
 
-	err := client.DeleteAWSPeer(awsPeer)
+	err := client.DeleteAWSPeerContext(ctx, awsPeer)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix AWSPeer: %s", err)
+		return diag.Errorf("failed to delete Aviatrix AWSPeer: %s", err)
 	}
 
 	return nil
@@ -2,10 +2,10 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -68,7 +68,7 @@ func marshalAwsTgwConnectInput(d *schema.ResourceData) *goaviatrix.AwsTgwConnect
 }
 
 func resourceAviatrixAwsTgwConnectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	connect := marshalAwsTgwConnectInput(d)
 
@@ -81,13 +81,13 @@ func resourceAviatrixAwsTgwConnectCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixAwsTgwConnectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	connectionName := d.Get("connection_name").(string)
 	tgwName := d.Get("tgw_name").(string)
 	if connectionName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no aws_tgw_connect connection_name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no aws_tgw_connect connection_name received", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "~~")
 		if len(parts) != 2 {
 			return diag.Errorf("Invalid Import ID received for aws_tgw_connect, ID must be in the form tgw_name~~connection_name")
@@ -122,7 +122,7 @@ func resourceAviatrixAwsTgwConnectRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixAwsTgwConnectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	connect := marshalAwsTgwConnectInput(d)
 	connect.ConnectAttachmentID = d.Get("connect_attachment_id").(string)
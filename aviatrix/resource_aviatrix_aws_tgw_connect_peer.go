@@ -2,10 +2,10 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -98,7 +98,7 @@ func marshalAwsTgwConnectPeerInput(d *schema.ResourceData) *goaviatrix.AwsTgwCon
 }
 
 func resourceAviatrixAwsTgwConnectPeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	peer := marshalAwsTgwConnectPeerInput(d)
 	d.SetId(peer.ID())
@@ -121,14 +121,14 @@ func resourceAviatrixAwsTgwConnectPeerReadIfRequired(ctx context.Context, d *sch
 }
 
 func resourceAviatrixAwsTgwConnectPeerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	connectionName := d.Get("connection_name").(string)
 	tgwName := d.Get("tgw_name").(string)
 	connectPeerName := d.Get("connect_peer_name").(string)
 	if connectionName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no aws_tgw_connect_peer connection_name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no aws_tgw_connect_peer connection_name received", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "~~")
 		if len(parts) != 3 {
 			return diag.Errorf("Invalid Import ID received for aws_tgw_connect_peer, ID must be in the form tgw_name~~connection_name~~connect_peer_name")
@@ -169,7 +169,7 @@ func resourceAviatrixAwsTgwConnectPeerRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceAviatrixAwsTgwConnectPeerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	peer := marshalAwsTgwConnectPeerInput(d)
 	peer.ConnectPeerID = d.Get("connect_peer_id").(string)
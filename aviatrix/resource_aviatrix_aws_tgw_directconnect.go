@@ -1,22 +1,23 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAWSTgwDirectConnect() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAWSTgwDirectConnectCreate,
-		Read:   resourceAviatrixAWSTgwDirectConnectRead,
-		Update: resourceAviatrixAWSTgwDirectConnectUpdate,
-		Delete: resourceAviatrixAWSTgwDirectConnectDelete,
+		CreateContext: resourceAviatrixAWSTgwDirectConnectCreate,
+		ReadContext:   resourceAviatrixAWSTgwDirectConnectRead,
+		UpdateContext: resourceAviatrixAWSTgwDirectConnectUpdate,
+		DeleteContext: resourceAviatrixAWSTgwDirectConnectDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -59,8 +60,8 @@ func resourceAviatrixAWSTgwDirectConnect() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAWSTgwDirectConnectCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwDirectConnectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	awsTgwDirectConnect := &goaviatrix.AwsTgwDirectConnect{
 		TgwName:                  d.Get("tgw_name").(string),
@@ -79,35 +80,35 @@ func resourceAviatrixAWSTgwDirectConnectCreate(d *schema.ResourceData, meta inte
 
 	d.SetId(awsTgwDirectConnect.TgwName + "~" + awsTgwDirectConnect.DxGatewayID)
 	flag := false
-	defer resourceAviatrixAWSTgwDirectConnectReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAWSTgwDirectConnectReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateAwsTgwDirectConnect(awsTgwDirectConnect)
+	err := client.CreateAwsTgwDirectConnectContext(ctx, awsTgwDirectConnect)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix AWS TGW Direct Connect: %s", err)
+		return diag.Errorf("failed to create Aviatrix AWS TGW Direct Connect: %s", err)
 	}
 
-	return resourceAviatrixAWSTgwDirectConnectReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAWSTgwDirectConnectReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAWSTgwDirectConnectReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAWSTgwDirectConnectReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAWSTgwDirectConnectRead(d, meta)
+		return resourceAviatrixAWSTgwDirectConnectRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAWSTgwDirectConnectRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwDirectConnectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	tgwName := d.Get("tgw_name").(string)
 	directConnectGatewayID := d.Get("dx_gateway_id").(string)
 
 	if tgwName == "" || directConnectGatewayID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 		if !strings.Contains(id, "~") {
-			log.Printf("[DEBUG] Import Id: %s is invalid", id)
+			tflog.Debug(ctx, "Invalid import ID", map[string]interface{}{"id": id})
 		}
 		d.Set("tgw_name", strings.Split(id, "~")[0])
 		d.Set("dx_gateway_id", strings.Split(id, "~")[1])
@@ -119,15 +120,15 @@ func resourceAviatrixAWSTgwDirectConnectRead(d *schema.ResourceData, meta interf
 		DxGatewayID: d.Get("dx_gateway_id").(string),
 	}
 
-	directConnect, err := client.GetAwsTgwDirectConnect(awsTgwDirectConnect)
+	directConnect, err := client.GetAwsTgwDirectConnectContext(ctx, awsTgwDirectConnect)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Aws Tgw Direct Connect: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Aws Tgw Direct Connect: %s", err)
 	}
-	log.Printf("[INFO] Found Aviatrix Aws Tgw Direct Connect: %#v", directConnect)
+	tflog.Info(ctx, "Found Aviatrix Aws Tgw Direct Connect", map[string]interface{}{"tgw_name": directConnect.TgwName})
 
 	d.Set("tgw_name", directConnect.TgwName)
 	d.Set("directconnect_account_name", directConnect.DirectConnectAccountName)
@@ -145,8 +146,8 @@ func resourceAviatrixAWSTgwDirectConnectRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAviatrixAWSTgwDirectConnectUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwDirectConnectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	awsTgwDirectConnect := &goaviatrix.AwsTgwDirectConnect{
 		TgwName:       d.Get("tgw_name").(string),
@@ -155,12 +156,12 @@ func resourceAviatrixAWSTgwDirectConnectUpdate(d *schema.ResourceData, meta inte
 
 	d.Partial(true)
 
-	log.Printf("[INFO] Updating Aviatrix Site2Cloud: %#v", awsTgwDirectConnect)
+	tflog.Info(ctx, "Updating Aviatrix AWS TGW Direct Connect", map[string]interface{}{"tgw_name": awsTgwDirectConnect.TgwName})
 	if ok := d.HasChange("allowed_prefix"); ok {
 		awsTgwDirectConnect.AllowedPrefix = d.Get("allowed_prefix").(string)
-		err := client.UpdateDirectConnAllowedPrefix(awsTgwDirectConnect)
+		err := client.UpdateDirectConnAllowedPrefixContext(ctx, awsTgwDirectConnect)
 		if err != nil {
-			return fmt.Errorf("failed to update Aws Tgw Direct Connect Allowed Prefix: %s", err)
+			return diag.Errorf("failed to update Aws Tgw Direct Connect Allowed Prefix: %s", err)
 		}
 	}
 
@@ -168,35 +169,35 @@ func resourceAviatrixAWSTgwDirectConnectUpdate(d *schema.ResourceData, meta inte
 		learnedCidrsApproval := d.Get("enable_learned_cidrs_approval").(bool)
 		if learnedCidrsApproval {
 			awsTgwDirectConnect.LearnedCidrsApproval = "yes"
-			err := client.EnableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect)
+			err := client.EnableDirectConnectLearnedCidrsApprovalContext(ctx, awsTgwDirectConnect)
 			if err != nil {
-				return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 			}
 		} else {
 			awsTgwDirectConnect.LearnedCidrsApproval = "no"
-			err := client.DisableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect)
+			err := client.DisableDirectConnectLearnedCidrsApprovalContext(ctx, awsTgwDirectConnect)
 			if err != nil {
-				return fmt.Errorf("failed to disable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to disable learned cidrs approval: %s", err)
 			}
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixAWSTgwDirectConnectRead(d, meta)
+	return resourceAviatrixAWSTgwDirectConnectRead(ctx, d, meta)
 }
 
-func resourceAviatrixAWSTgwDirectConnectDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwDirectConnectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	awsTgwDirectConnect := &goaviatrix.AwsTgwDirectConnect{
 		TgwName:         d.Get("tgw_name").(string),
 		DirectConnectID: d.Get("dx_gateway_id").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix AWS TGW Direct Connect: %#v", awsTgwDirectConnect)
+	tflog.Info(ctx, "Deleting Aviatrix AWS TGW Direct Connect", map[string]interface{}{"tgw_name": awsTgwDirectConnect.TgwName})
 
-	err := client.DeleteAwsTgwDirectConnect(awsTgwDirectConnect)
+	err := client.DeleteAwsTgwDirectConnectContext(ctx, awsTgwDirectConnect)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix AWS TGW Direct Connect: %s", err)
+		return diag.Errorf("failed to delete Aviatrix AWS TGW Direct Connect: %s", err)
 	}
 
 	return nil
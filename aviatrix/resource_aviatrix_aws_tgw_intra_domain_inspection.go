@@ -2,10 +2,10 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -51,7 +51,7 @@ func marshalAwsTgwIntraDomainInspectionInput(d *schema.ResourceData) *goaviatrix
 }
 
 func resourceAviatrixAwsTgwIntraDomainInspectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	intraDomainInspection := marshalAwsTgwIntraDomainInspectionInput(d)
 
@@ -65,11 +65,11 @@ func resourceAviatrixAwsTgwIntraDomainInspectionCreate(ctx context.Context, d *s
 }
 
 func resourceAviatrixAwsTgwIntraDomainInspectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.Get("tgw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
@@ -107,7 +107,7 @@ func resourceAviatrixAwsTgwIntraDomainInspectionRead(ctx context.Context, d *sch
 }
 
 func resourceAviatrixAwsTgwIntraDomainInspectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	intraDomainInspection := marshalAwsTgwIntraDomainInspectionInput(d)
 
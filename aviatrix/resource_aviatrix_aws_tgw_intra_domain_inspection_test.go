@@ -140,11 +140,9 @@ resource "aviatrix_aws_tgw_peering_domain_conn" "nd_conn" {
 	tgw_name2    = aviatrix_aws_tgw.test.tgw_name
 	domain_name2 = aviatrix_aws_tgw_network_domain.route_domain.name
 }
-resource "aviatrix_aws_tgw_vpc_attachment" "test" {
+resource "aviatrix_firenet_tgw_connection" "test" {
 	tgw_name            = aviatrix_aws_tgw.test.tgw_name
-	region              = aviatrix_vpc.test.region
 	network_domain_name = aviatrix_aws_tgw_network_domain.firewall_domain.name
-	vpc_account_name    = aviatrix_vpc.test.account_name
 	vpc_id              = aviatrix_vpc.test.vpc_id
    	depends_on = [aviatrix_transit_gateway.test]
 }
@@ -152,7 +150,7 @@ resource "aviatrix_aws_tgw_intra_domain_inspection" "test" {
 	tgw_name             = aviatrix_aws_tgw.test.tgw_name
 	route_domain_name    = aviatrix_aws_tgw_network_domain.route_domain.name
 	firewall_domain_name = aviatrix_aws_tgw_network_domain.firewall_domain.name
-	depends_on = [aviatrix_aws_tgw_vpc_attachment.test]
+	depends_on = [aviatrix_firenet_tgw_connection.test]
 }
 	`, accName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
 		tgwName, routeDomainName, firewallDomainName)
@@ -2,9 +2,9 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -73,7 +73,7 @@ func marshalNetworkDomainInput(d *schema.ResourceData) *goaviatrix.SecurityDomai
 }
 
 func resourceAviatrixAwsTgwNetworkDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	networkDomain := marshalNetworkDomainInput(d)
 
@@ -111,13 +111,13 @@ func resourceAviatrixAwsTgwNetworkDomainReadIfRequired(ctx context.Context, d *s
 }
 
 func resourceAviatrixAwsTgwNetworkDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	name := d.Get("name").(string)
 
 	if name == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
 			return diag.Errorf("invalid ID, expected ID tgw_name~domain_name, instead got %s", d.Id())
@@ -153,7 +153,7 @@ func resourceAviatrixAwsTgwNetworkDomainRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixAwsTgwNetworkDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	networkDomain := &goaviatrix.SecurityDomain{
 		Name:       d.Get("name").(string),
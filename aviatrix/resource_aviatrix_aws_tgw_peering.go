@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAWSTgwPeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAWSTgwPeeringCreate,
-		Read:   resourceAviatrixAWSTgwPeeringRead,
-		Delete: resourceAviatrixAWSTgwPeeringDelete,
+		CreateContext: resourceAviatrixAWSTgwPeeringCreate,
+		ReadContext:   resourceAviatrixAWSTgwPeeringRead,
+		DeleteContext: resourceAviatrixAWSTgwPeeringDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -37,45 +38,45 @@ func resourceAviatrixAWSTgwPeering() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAWSTgwPeeringCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwPeeringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	awsTgwPeering := &goaviatrix.AwsTgwPeering{
 		TgwName1: d.Get("tgw_name1").(string),
 		TgwName2: d.Get("tgw_name2").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix AWS tgw peering: %#v", awsTgwPeering)
+	tflog.Info(ctx, "Creating Aviatrix AWS tgw peering", map[string]interface{}{"tgw_name1": awsTgwPeering.TgwName1, "tgw_name2": awsTgwPeering.TgwName2})
 
 	d.SetId(awsTgwPeering.TgwName1 + "~" + awsTgwPeering.TgwName2)
 	flag := false
-	defer resourceAviatrixAWSTgwPeeringReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAWSTgwPeeringReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateAwsTgwPeering(awsTgwPeering)
+	err := client.CreateAwsTgwPeeringContext(ctx, awsTgwPeering)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix AWS tgw peering: %s", err)
+		return diag.Errorf("failed to create Aviatrix AWS tgw peering: %s", err)
 	}
 
-	return resourceAviatrixAWSTgwPeeringReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAWSTgwPeeringReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAWSTgwPeeringReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAWSTgwPeeringReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAWSTgwPeeringRead(d, meta)
+		return resourceAviatrixAWSTgwPeeringRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAWSTgwPeeringRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwPeeringRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	tgwName1 := d.Get("tgw_name1").(string)
 	tgwName2 := d.Get("tgw_name2").(string)
 
 	if tgwName1 == "" || tgwName2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 		d.Set("tgw_name1", strings.Split(id, "~")[0])
 		d.Set("tgw_name2", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -86,32 +87,32 @@ func resourceAviatrixAWSTgwPeeringRead(d *schema.ResourceData, meta interface{})
 		TgwName2: d.Get("tgw_name2").(string),
 	}
 
-	err := client.GetAwsTgwPeering(awsTgwPeering)
+	err := client.GetAwsTgwPeeringContext(ctx, awsTgwPeering)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix AWS tgw peering: %s", err)
+		return diag.Errorf("couldn't find Aviatrix AWS tgw peering: %s", err)
 	}
 
 	d.SetId(awsTgwPeering.TgwName1 + "~" + awsTgwPeering.TgwName2)
 	return nil
 }
 
-func resourceAviatrixAWSTgwPeeringDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAWSTgwPeeringDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	awsTgwPeering := &goaviatrix.AwsTgwPeering{
 		TgwName1: d.Get("tgw_name1").(string),
 		TgwName2: d.Get("tgw_name2").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix AWS tgw peering: %#v", awsTgwPeering)
+	tflog.Info(ctx, "Deleting Aviatrix AWS tgw peering", map[string]interface{}{"tgw_name1": awsTgwPeering.TgwName1, "tgw_name2": awsTgwPeering.TgwName2})
 
-	err := client.DeleteAwsTgwPeering(awsTgwPeering)
+	err := client.DeleteAwsTgwPeeringContext(ctx, awsTgwPeering)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix AWS tgw peering: %s", err)
+		return diag.Errorf("failed to delete Aviatrix AWS tgw peering: %s", err)
 	}
 
 	return nil
@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAwsTgwTransitGatewayAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAwsTgwTransitGatewayAttachmentCreate,
-		Read:   resourceAviatrixAwsTgwTransitGatewayAttachmentRead,
-		Delete: resourceAviatrixAwsTgwTransitGatewayAttachmentDelete,
+		CreateContext: resourceAviatrixAwsTgwTransitGatewayAttachmentCreate,
+		ReadContext:   resourceAviatrixAwsTgwTransitGatewayAttachmentRead,
+		DeleteContext: resourceAviatrixAwsTgwTransitGatewayAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,8 +54,8 @@ func resourceAviatrixAwsTgwTransitGatewayAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAwsTgwTransitGatewayAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwTransitGatewayAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	awsTgwTransitGwAttachment := &goaviatrix.AwsTgwTransitGwAttachment{
 		TgwName:            d.Get("tgw_name").(string),
@@ -66,33 +67,33 @@ func resourceAviatrixAwsTgwTransitGatewayAttachmentCreate(d *schema.ResourceData
 
 	d.SetId(awsTgwTransitGwAttachment.TgwName + "~" + awsTgwTransitGwAttachment.VpcID)
 	flag := false
-	defer resourceAviatrixAwsTgwTransitGatewayAttachmentReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAwsTgwTransitGatewayAttachmentReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment)
+	err := client.CreateAwsTgwTransitGwAttachmentContext(ctx, awsTgwTransitGwAttachment)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix AWS tgw transit gateway Attachment: %s", err)
+		return diag.Errorf("failed to create Aviatrix AWS tgw transit gateway Attachment: %s", err)
 	}
 
-	return resourceAviatrixAwsTgwTransitGatewayAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAwsTgwTransitGatewayAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAwsTgwTransitGatewayAttachmentReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAwsTgwTransitGatewayAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAwsTgwTransitGatewayAttachmentRead(d, meta)
+		return resourceAviatrixAwsTgwTransitGatewayAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAwsTgwTransitGatewayAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwTransitGatewayAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	tgwName := d.Get("tgw_name").(string)
 	vpcID := d.Get("vpc_id").(string)
 
 	if tgwName == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no tgw names or vpc ids received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no tgw names or vpc ids received", map[string]interface{}{"id": id})
 		d.Set("tgw_name", strings.Split(id, "~")[0])
 		d.Set("vpc_id", strings.Split(id, "~")[1])
 	}
@@ -100,13 +101,13 @@ func resourceAviatrixAwsTgwTransitGatewayAttachmentRead(d *schema.ResourceData,
 		TgwName: d.Get("tgw_name").(string),
 		VpcID:   d.Get("vpc_id").(string),
 	}
-	transitGwAttachment, err := client.GetAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment)
+	transitGwAttachment, err := client.GetAwsTgwTransitGwAttachmentContext(ctx, awsTgwTransitGwAttachment)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to get Aviatrix Aws Tgw Vpc Attach: %s", err)
+		return diag.Errorf("failed to get Aviatrix Aws Tgw Vpc Attach: %s", err)
 	}
 	if transitGwAttachment != nil {
 		d.Set("tgw_name", transitGwAttachment.TgwName)
@@ -120,17 +121,17 @@ func resourceAviatrixAwsTgwTransitGatewayAttachmentRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceAviatrixAwsTgwTransitGatewayAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwTransitGatewayAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	awsTgwTransitGwAttachment := &goaviatrix.AwsTgwTransitGwAttachment{
 		TgwName: d.Get("tgw_name").(string),
 		VpcID:   d.Get("vpc_id").(string),
 	}
 
-	err := client.DeleteAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment)
+	err := client.DeleteAwsTgwTransitGwAttachmentContext(ctx, awsTgwTransitGwAttachment)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix AWS tgw transit gateway attachment: %s", err)
+		return diag.Errorf("failed to delete Aviatrix AWS tgw transit gateway attachment: %s", err)
 	}
 
 	return nil
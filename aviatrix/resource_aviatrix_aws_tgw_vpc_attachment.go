@@ -1,22 +1,23 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAwsTgwVpcAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAwsTgwVpcAttachmentCreate,
-		Read:   resourceAviatrixAwsTgwVpcAttachmentRead,
-		Update: resourceAviatrixAwsTgwVpcAttachmentUpdate,
-		Delete: resourceAviatrixAwsTgwVpcAttachmentDelete,
+		CreateContext: resourceAviatrixAwsTgwVpcAttachmentCreate,
+		ReadContext:   resourceAviatrixAwsTgwVpcAttachmentRead,
+		UpdateContext: resourceAviatrixAwsTgwVpcAttachmentUpdate,
+		DeleteContext: resourceAviatrixAwsTgwVpcAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -87,14 +88,15 @@ func resourceAviatrixAwsTgwVpcAttachment() *schema.Resource {
 				Optional: true,
 				Description: "Edge attachment ID. To allow access to the private IP of the MGMT interface of the " +
 					"Firewalls, set this attribute to enable Management Access From Onprem. This feature advertises " +
-					"the Firewalls private MGMT subnet to your Edge domain.",
+					"the Firewalls private MGMT subnet to your Edge domain. Only supported for FireNet attachments " +
+					"created before aviatrix_firenet_tgw_connection was available.",
 			},
 		},
 	}
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwVpcAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	awsTgwVpcAttachment := &goaviatrix.AwsTgwVpcAttachment{
 		TgwName:                      d.Get("tgw_name").(string),
@@ -110,63 +112,53 @@ func resourceAviatrixAwsTgwVpcAttachmentCreate(d *schema.ResourceData, meta inte
 		SecurityDomainName:           d.Get("network_domain_name").(string),
 	}
 
-	isFirewallSecurityDomain, err := client.IsFirewallSecurityDomain(awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.SecurityDomainName)
+	isFirewallSecurityDomain, err := client.IsFirewallSecurityDomainContext(ctx, awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.SecurityDomainName)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
-			return fmt.Errorf("could not find Security Domain: %s", awsTgwVpcAttachment.SecurityDomainName)
+			return diag.Errorf("could not find Security Domain: %s", awsTgwVpcAttachment.SecurityDomainName)
 		}
-		return fmt.Errorf("could not find Security Domain due to: %v", err)
+		return diag.Errorf("could not find Security Domain due to: %v", err)
+	}
+	if isFirewallSecurityDomain {
+		return diag.Errorf("network domain %s of AWS TGW %s is an Aviatrix firewall domain: use aviatrix_firenet_tgw_connection to connect FireNet VPC %s to it",
+			awsTgwVpcAttachment.SecurityDomainName, awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.VpcID)
 	}
 
-	log.Printf("[INFO] Attaching vpc: %s to tgw %s", awsTgwVpcAttachment.VpcID, awsTgwVpcAttachment.TgwName)
+	tflog.Info(ctx, "Attaching VPC to TGW", map[string]interface{}{"vpc_id": awsTgwVpcAttachment.VpcID, "tgw_name": awsTgwVpcAttachment.TgwName})
 
 	d.SetId(awsTgwVpcAttachment.TgwName + "~" + awsTgwVpcAttachment.SecurityDomainName + "~" + awsTgwVpcAttachment.VpcID)
 	flag := false
-	defer resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx, d, meta, &flag)
 
-	if isFirewallSecurityDomain {
-		err = client.CreateAwsTgwVpcAttachmentForFireNet(awsTgwVpcAttachment)
-		if err != nil {
-			return fmt.Errorf("failed to create Aviatrix Aws Tgw Vpc Attach for FireNet: %s", err)
-		}
-
-		if awsTgwVpcAttachment.EdgeAttachment != "" {
-			err = client.UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment)
-			if err != nil {
-				return fmt.Errorf("failed to enable firewall attachment access from onprem: %s", err)
-			}
-		}
-	} else {
-		if awsTgwVpcAttachment.EdgeAttachment != "" {
-			return fmt.Errorf("management access from onprem only works for FireNet")
-		}
+	if awsTgwVpcAttachment.EdgeAttachment != "" {
+		return diag.Errorf("management access from onprem only works for FireNet, use aviatrix_firenet_tgw_connection instead")
+	}
 
-		err = client.CreateAwsTgwVpcAttachment(awsTgwVpcAttachment)
-		if err != nil {
-			return fmt.Errorf("failed to create Aviatrix Aws Tgw Vpc Attach: %s", err)
-		}
+	err = client.CreateAwsTgwVpcAttachmentContext(ctx, awsTgwVpcAttachment)
+	if err != nil {
+		return diag.Errorf("failed to create Aviatrix Aws Tgw Vpc Attach: %s", err)
 	}
 
-	return resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAwsTgwVpcAttachmentRead(d, meta)
+		return resourceAviatrixAwsTgwVpcAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwVpcAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	tgwName := d.Get("tgw_name").(string)
 	vpcID := d.Get("vpc_id").(string)
 
 	if tgwName == "" || d.Get("network_domain_name").(string) == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc names received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no vpc names received", map[string]interface{}{"id": id})
 		d.Set("tgw_name", strings.Split(id, "~")[0])
 		d.Set("network_domain_name", strings.Split(id, "~")[1])
 		d.Set("vpc_id", strings.Split(id, "~")[2])
@@ -177,13 +169,13 @@ func resourceAviatrixAwsTgwVpcAttachmentRead(d *schema.ResourceData, meta interf
 		SecurityDomainName: d.Get("network_domain_name").(string),
 	}
 
-	aTVA, err := client.GetAwsTgwVpcAttachment(awsTgwVpcAttachment)
+	aTVA, err := client.GetAwsTgwVpcAttachmentContext(ctx, awsTgwVpcAttachment)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to get Aviatrix Aws Tgw Vpc Attach: %s", err)
+		return diag.Errorf("failed to get Aviatrix Aws Tgw Vpc Attach: %s", err)
 	}
 	if aTVA != nil {
 		d.Set("tgw_name", aTVA.TgwName)
@@ -233,21 +225,21 @@ func resourceAviatrixAwsTgwVpcAttachmentRead(d *schema.ResourceData, meta interf
 		return nil
 	}
 
-	return fmt.Errorf("no Aviatrix Aws Tgw Vpc Attach found")
+	return diag.Errorf("no Aviatrix Aws Tgw Vpc Attach found")
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixAwsTgwVpcAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	flag := false
-	defer resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx, d, meta, &flag)
 
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	d.Partial(true)
 	if d.HasChange("region") {
-		return fmt.Errorf("updating region is not allowed")
+		return diag.Errorf("updating region is not allowed")
 	}
 	if d.HasChange("vpc_account_name") {
-		return fmt.Errorf("updating vpc_account_name is not allowed")
+		return diag.Errorf("updating vpc_account_name is not allowed")
 	}
 	if d.HasChange("customized_routes") {
 		awsTgwVpcAttachment := &goaviatrix.AwsTgwVpcAttachment{
@@ -255,9 +247,9 @@ func resourceAviatrixAwsTgwVpcAttachmentUpdate(d *schema.ResourceData, meta inte
 			VpcID:            d.Get("vpc_id").(string),
 			CustomizedRoutes: d.Get("customized_routes").(string),
 		}
-		err := client.EditTgwSpokeVpcCustomizedRoutes(awsTgwVpcAttachment)
+		err := client.EditTgwSpokeVpcCustomizedRoutesContext(ctx, awsTgwVpcAttachment)
 		if err != nil {
-			return fmt.Errorf("failed to update spoke vpc customized routes: %s", err)
+			return diag.Errorf("failed to update spoke vpc customized routes: %s", err)
 		}
 	}
 	if d.HasChange("customized_route_advertisement") {
@@ -266,9 +258,9 @@ func resourceAviatrixAwsTgwVpcAttachmentUpdate(d *schema.ResourceData, meta inte
 			VpcID:                        d.Get("vpc_id").(string),
 			CustomizedRouteAdvertisement: d.Get("customized_route_advertisement").(string),
 		}
-		err := client.EditTgwSpokeVpcCustomizedRouteAdvertisement(awsTgwVpcAttachment)
+		err := client.EditTgwSpokeVpcCustomizedRouteAdvertisementContext(ctx, awsTgwVpcAttachment)
 		if err != nil {
-			return fmt.Errorf("failed to update spoke vpc customized routes advertisement: %s", err)
+			return diag.Errorf("failed to update spoke vpc customized routes advertisement: %s", err)
 		}
 	}
 
@@ -280,12 +272,12 @@ func resourceAviatrixAwsTgwVpcAttachmentUpdate(d *schema.ResourceData, meta inte
 			SecurityDomainName: d.Get("network_domain_name").(string),
 		}
 
-		isFirewallSecurityDomain, err := client.IsFirewallSecurityDomain(awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.SecurityDomainName)
+		isFirewallSecurityDomain, err := client.IsFirewallSecurityDomainContext(ctx, awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.SecurityDomainName)
 		if err != nil {
 			if err == goaviatrix.ErrNotFound {
-				return fmt.Errorf("could not find Network Domain: %s", awsTgwVpcAttachment.SecurityDomainName)
+				return diag.Errorf("could not find Network Domain: %s", awsTgwVpcAttachment.SecurityDomainName)
 			}
-			return fmt.Errorf("could not find Network Domain due to: %v", err)
+			return diag.Errorf("could not find Network Domain due to: %v", err)
 		}
 
 		oldEA, newEA := d.GetChange("edge_attachment")
@@ -296,37 +288,37 @@ func resourceAviatrixAwsTgwVpcAttachmentUpdate(d *schema.ResourceData, meta inte
 			if oldEAString != "" && newEAString != "" {
 				awsTgwVpcAttachment.EdgeAttachment = ""
 
-				err := client.UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment)
+				err := client.UpdateFirewallAttachmentAccessFromOnpremContext(ctx, awsTgwVpcAttachment)
 				if err != nil {
-					return fmt.Errorf("failed to disable firewall attachment access from onprem while updating: %s", err)
+					return diag.Errorf("failed to disable firewall attachment access from onprem while updating: %s", err)
 				}
 
 				awsTgwVpcAttachment.EdgeAttachment = newEAString
 
-				err = client.UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment)
+				err = client.UpdateFirewallAttachmentAccessFromOnpremContext(ctx, awsTgwVpcAttachment)
 				if err != nil {
-					return fmt.Errorf("failed to enable firewall attachment access from onprem while updating: %s", err)
+					return diag.Errorf("failed to enable firewall attachment access from onprem while updating: %s", err)
 				}
 			} else {
-				err := client.UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment)
+				err := client.UpdateFirewallAttachmentAccessFromOnpremContext(ctx, awsTgwVpcAttachment)
 				if err != nil {
-					return fmt.Errorf("failed to update firewall attachment access from onprem: %s", err)
+					return diag.Errorf("failed to update firewall attachment access from onprem: %s", err)
 				}
 			}
 		} else {
 			if newEAString != "" {
-				return fmt.Errorf("management access from onprem only works for FireNet")
+				return diag.Errorf("management access from onprem only works for FireNet")
 			}
 		}
 
 	}
 
 	d.Partial(false)
-	return resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwVpcAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	awsTgwVpcAttachment := &goaviatrix.AwsTgwVpcAttachment{
 		TgwName:            d.Get("tgw_name").(string),
@@ -336,23 +328,23 @@ func resourceAviatrixAwsTgwVpcAttachmentDelete(d *schema.ResourceData, meta inte
 		SecurityDomainName: d.Get("network_domain_name").(string),
 	}
 
-	isFirewallSecurityDomain, err := client.IsFirewallSecurityDomain(awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.SecurityDomainName)
+	isFirewallSecurityDomain, err := client.IsFirewallSecurityDomainContext(ctx, awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.SecurityDomainName)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
-			return fmt.Errorf("could not find Network Domain: %s", awsTgwVpcAttachment.VpcID)
+			return diag.Errorf("could not find Network Domain: %s", awsTgwVpcAttachment.VpcID)
 		}
-		return fmt.Errorf("could not find Network Domain due to: %v", err)
+		return diag.Errorf("could not find Network Domain due to: %v", err)
 	}
 
 	if isFirewallSecurityDomain {
-		err := client.DeleteAwsTgwVpcAttachmentForFireNet(awsTgwVpcAttachment)
+		err := client.DeleteAwsTgwVpcAttachmentForFireNetContext(ctx, awsTgwVpcAttachment)
 		if err != nil {
-			return fmt.Errorf("failed to detach FireNet VPC from TGW: %v", err)
+			return diag.Errorf("failed to detach FireNet VPC from TGW: %v", err)
 		}
 	} else {
-		err := client.DeleteAwsTgwVpcAttachment(awsTgwVpcAttachment)
+		err := client.DeleteAwsTgwVpcAttachmentContext(ctx, awsTgwVpcAttachment)
 		if err != nil {
-			return fmt.Errorf("failed to detach VPC from TGW: %v", err)
+			return diag.Errorf("failed to detach VPC from TGW: %v", err)
 		}
 	}
 
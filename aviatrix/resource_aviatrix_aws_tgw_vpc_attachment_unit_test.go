@@ -0,0 +1,32 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceAviatrixAwsTgwVpcAttachmentCreate_RejectsFirewallDomain(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		IsFirewallSecurityDomainContextFunc: func(ctx context.Context, tgwName string, domainName string) (bool, error) {
+			return true, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixAwsTgwVpcAttachment().Schema, map[string]interface{}{
+		"tgw_name":            "tgw-1",
+		"region":              "us-east-1",
+		"network_domain_name": "firewall-domain",
+		"vpc_account_name":    "prod",
+		"vpc_id":              "vpc-firenet",
+	})
+	diags := resourceAviatrixAwsTgwVpcAttachmentCreate(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("network domain firewall-domain of AWS TGW tgw-1 is an Aviatrix firewall domain: use aviatrix_firenet_tgw_connection to connect FireNet VPC vpc-firenet to it"), diags)
+	assert.Empty(t, client.CreateAwsTgwVpcAttachmentContextCalls())
+	assert.Empty(t, d.Id())
+}
@@ -1,12 +1,13 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -15,12 +16,12 @@ import (
 
 func resourceAviatrixAwsTgwVpnConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAwsTgwVpnConnCreate,
-		Read:   resourceAviatrixAwsTgwVpnConnRead,
-		Update: resourceAviatrixAwsTgwVpnConnUpdate,
-		Delete: resourceAviatrixAwsTgwVpnConnDelete,
+		CreateContext: resourceAviatrixAwsTgwVpnConnCreate,
+		ReadContext:   resourceAviatrixAwsTgwVpnConnRead,
+		UpdateContext: resourceAviatrixAwsTgwVpnConnUpdate,
+		DeleteContext: resourceAviatrixAwsTgwVpnConnDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -169,8 +170,8 @@ func resourceAviatrixAwsTgwVpnConn() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAwsTgwVpnConnCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwVpnConnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	awsTgwVpnConn := &goaviatrix.AwsTgwVpnConn{
 		TgwName:          d.Get("tgw_name").(string),
@@ -190,13 +191,13 @@ func resourceAviatrixAwsTgwVpnConnCreate(d *schema.ResourceData, meta interface{
 	remoteAsn := d.Get("remote_as_number").(string)
 	remoteCIDR := d.Get("remote_cidr").(string)
 	if connectionType == "dynamic" && remoteAsn == "" {
-		return fmt.Errorf("please specify 'remote_as_number' to create a BGP VPN connection")
+		return diag.Errorf("please specify 'remote_as_number' to create a BGP VPN connection")
 	} else if connectionType == "dynamic" && remoteCIDR != "" {
-		return fmt.Errorf("please set 'remote_cidr' as empty since it is only required for a static VPN connection")
+		return diag.Errorf("please set 'remote_cidr' as empty since it is only required for a static VPN connection")
 	} else if connectionType == "static" && remoteCIDR == "" {
-		return fmt.Errorf("please specify 'remote_cidr' to create a static VPN connection")
+		return diag.Errorf("please specify 'remote_cidr' to create a static VPN connection")
 	} else if connectionType == "static" && remoteAsn != "" {
-		return fmt.Errorf("please set 'remote_as_number' as empty since it is only required for a BGP VPN connection")
+		return diag.Errorf("please set 'remote_as_number' as empty since it is only required for a BGP VPN connection")
 	}
 
 	if remoteAsn != "" {
@@ -209,26 +210,26 @@ func resourceAviatrixAwsTgwVpnConnCreate(d *schema.ResourceData, meta interface{
 	learnedCidrsApproval := d.Get("enable_learned_cidrs_approval").(bool)
 	if learnedCidrsApproval {
 		if connectionType == "static" {
-			return fmt.Errorf("learned cidrs approval is supported for a BGP VPN connection, not for a static connection")
+			return diag.Errorf("learned cidrs approval is supported for a BGP VPN connection, not for a static connection")
 		}
 		awsTgwVpnConn.LearnedCidrsApproval = "yes"
 	} else {
 		awsTgwVpnConn.LearnedCidrsApproval = "no"
 	}
 
-	log.Printf("[INFO] Creating Aviatrix AWS TGW VPN Connection: %#v", awsTgwVpnConn)
+	tflog.Info(ctx, "Creating Aviatrix AWS TGW VPN Connection", map[string]interface{}{"conn_name": awsTgwVpnConn.ConnName})
 
-	vpnID, err := client.CreateAwsTgwVpnConn(awsTgwVpnConn)
+	vpnID, err := client.CreateAwsTgwVpnConnContext(ctx, awsTgwVpnConn)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix AWS TGW VPN Connection: %s", err)
+		return diag.Errorf("failed to create Aviatrix AWS TGW VPN Connection: %s", err)
 	}
 
 	d.SetId(awsTgwVpnConn.TgwName + "~" + vpnID)
-	return resourceAviatrixAwsTgwVpnConnRead(d, meta)
+	return resourceAviatrixAwsTgwVpnConnRead(ctx, d, meta)
 }
 
-func resourceAviatrixAwsTgwVpnConnRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwVpnConnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	tgwName := d.Get("tgw_name").(string)
 	vpnID := d.Get("vpn_id").(string)
@@ -236,10 +237,10 @@ func resourceAviatrixAwsTgwVpnConnRead(d *schema.ResourceData, meta interface{})
 	if tgwName == "" || vpnID == "" {
 		id := d.Id()
 
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 
 		if !strings.Contains(id, "~") {
-			log.Printf("[DEBUG] Import Id: %s is invalid", id)
+			tflog.Debug(ctx, "Invalid import ID", map[string]interface{}{"id": id})
 		}
 
 		d.Set("tgw_name", strings.Split(id, "~")[0])
@@ -253,15 +254,15 @@ func resourceAviatrixAwsTgwVpnConnRead(d *schema.ResourceData, meta interface{})
 		VpnID:   d.Get("vpn_id").(string),
 	}
 
-	vpnConn, err := client.GetAwsTgwVpnConn(awsTgwVpnConn)
+	vpnConn, err := client.GetAwsTgwVpnConnContext(ctx, awsTgwVpnConn)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix AWS TGW VPN Connection: %s", err)
+		return diag.Errorf("couldn't find Aviatrix AWS TGW VPN Connection: %s", err)
 	}
-	log.Printf("[INFO] Found Aviatrix AWS TGW VPN Connection: %#v", vpnConn)
+	tflog.Info(ctx, "Found Aviatrix AWS TGW VPN Connection", map[string]interface{}{"conn_name": vpnConn.ConnName})
 
 	d.Set("tgw_name", vpnConn.TgwName)
 	d.Set("route_domain_name", vpnConn.RouteDomainName)
@@ -280,9 +281,9 @@ func resourceAviatrixAwsTgwVpnConnRead(d *schema.ResourceData, meta interface{})
 	d.Set("enable_learned_cidrs_approval", vpnConn.LearnedCidrsApproval == "yes")
 	d.Set("enable_global_acceleration", vpnConn.EnableAcceleration == "yes")
 
-	AllVpnTunnelData, err := client.GetAwsTgwVpnTunnelData(vpnConn)
+	AllVpnTunnelData, err := client.GetAwsTgwVpnTunnelDataContext(ctx, vpnConn)
 	if err != nil {
-		return fmt.Errorf("couldn't get Aviatrix AWS TGW VPN Connection tunnel information: %s", err)
+		return diag.Errorf("couldn't get Aviatrix AWS TGW VPN Connection tunnel information: %s", err)
 	}
 
 	var vpnTunnelData []map[string]interface{}
@@ -307,8 +308,8 @@ func resourceAviatrixAwsTgwVpnConnRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAviatrixAwsTgwVpnConnUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwVpnConnUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	awsTgwVpnConn := &goaviatrix.AwsTgwVpnConn{
 		TgwName: d.Get("tgw_name").(string),
@@ -316,43 +317,43 @@ func resourceAviatrixAwsTgwVpnConnUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	d.Partial(true)
-	log.Printf("[INFO] Updating Aviatrix aws tgw vpn connection: %#v", awsTgwVpnConn)
+	tflog.Info(ctx, "Updating Aviatrix aws tgw vpn connection", map[string]interface{}{"conn_name": awsTgwVpnConn.ConnName})
 
 	if d.HasChange("enable_learned_cidrs_approval") {
 		if d.Get("connection_type").(string) == "static" {
-			return fmt.Errorf("learned cidrs approval is supported for a BGP VPN connection, not for a static connection")
+			return diag.Errorf("learned cidrs approval is supported for a BGP VPN connection, not for a static connection")
 		}
 		learnedCidrsApproval := d.Get("enable_learned_cidrs_approval").(bool)
 		if learnedCidrsApproval {
 			awsTgwVpnConn.LearnedCidrsApproval = "yes"
-			err := client.EnableVpnConnectionLearnedCidrsApproval(awsTgwVpnConn)
+			err := client.EnableVpnConnectionLearnedCidrsApprovalContext(ctx, awsTgwVpnConn)
 			if err != nil {
-				return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 			}
 		} else {
 			awsTgwVpnConn.LearnedCidrsApproval = "no"
-			err := client.DisableVpnConnectionLearnedCidrsApproval(awsTgwVpnConn)
+			err := client.DisableVpnConnectionLearnedCidrsApprovalContext(ctx, awsTgwVpnConn)
 			if err != nil {
-				return fmt.Errorf("failed to disable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to disable learned cidrs approval: %s", err)
 			}
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(awsTgwVpnConn.TgwName + "~" + awsTgwVpnConn.VpnID)
-	return resourceAviatrixAwsTgwVpnConnRead(d, meta)
+	return resourceAviatrixAwsTgwVpnConnRead(ctx, d, meta)
 }
 
-func resourceAviatrixAwsTgwVpnConnDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAwsTgwVpnConnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	awsTgwVpnConn := &goaviatrix.AwsTgwVpnConn{
 		TgwName: d.Get("tgw_name").(string),
 		VpnID:   d.Get("vpn_id").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix aws_tgw_vpn_conn: %#v", awsTgwVpnConn)
+	tflog.Info(ctx, "Deleting Aviatrix aws_tgw_vpn_conn", map[string]interface{}{"tgw_name": awsTgwVpnConn.TgwName})
 
-	err := client.DeleteAwsTgwVpnConn(awsTgwVpnConn)
+	err := client.DeleteAwsTgwVpnConnContext(ctx, awsTgwVpnConn)
 
 	time.Sleep(40 * time.Second)
 
@@ -361,7 +362,7 @@ func resourceAviatrixAwsTgwVpnConnDelete(d *schema.ResourceData, meta interface{
 			return nil
 		}
 
-		return fmt.Errorf("failed to delete Aviatrix AwsTgwVpnConn: %s", err)
+		return diag.Errorf("failed to delete Aviatrix AwsTgwVpnConn: %s", err)
 	}
 
 	return nil
@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAzurePeer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAzurePeerCreate,
-		Read:   resourceAviatrixAzurePeerRead,
-		Delete: resourceAviatrixAzurePeerDelete,
+		CreateContext: resourceAviatrixAzurePeerCreate,
+		ReadContext:   resourceAviatrixAzurePeerRead,
+		DeleteContext: resourceAviatrixAzurePeerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,8 +72,8 @@ func resourceAviatrixAzurePeer() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAzurePeerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAzurePeerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	azurePeer := &goaviatrix.AzurePeer{
 		AccountName1: d.Get("account_name1").(string),
@@ -83,36 +84,36 @@ func resourceAviatrixAzurePeerCreate(d *schema.ResourceData, meta interface{}) e
 		Region2:      d.Get("vnet_reg2").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Azure peer: %#v", azurePeer)
+	tflog.Info(ctx, "Creating Aviatrix Azure peer", map[string]interface{}{"vnet1": azurePeer.VNet1, "vnet2": azurePeer.VNet2})
 
 	d.SetId(azurePeer.VNet1 + "~" + azurePeer.VNet2)
 	flag := false
-	defer resourceAviatrixAzurePeerReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAzurePeerReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateAzurePeer(azurePeer)
+	err := client.CreateAzurePeerContext(ctx, azurePeer)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Azure Peer: %s", err)
+		return diag.Errorf("failed to create Aviatrix Azure Peer: %s", err)
 	}
 
-	return resourceAviatrixAzurePeerReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAzurePeerReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAzurePeerReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAzurePeerReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAzurePeerRead(d, meta)
+		return resourceAviatrixAzurePeerRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAzurePeerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAzurePeerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vNet1 := d.Get("vnet_name_resource_group1").(string)
 	vNet2 := d.Get("vnet_name_resource_group2").(string)
 	if vNet1 == "" || vNet2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no Azure peer id received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no Azure peer id received", map[string]interface{}{"id": id})
 		d.Set("vnet_name_resource_group1", strings.Split(id, "~")[0])
 		d.Set("vnet_name_resource_group2", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -123,16 +124,16 @@ func resourceAviatrixAzurePeerRead(d *schema.ResourceData, meta interface{}) err
 		VNet2: d.Get("vnet_name_resource_group2").(string),
 	}
 
-	azureP, err := client.GetAzurePeer(azurePeer)
+	azureP, err := client.GetAzurePeerContext(ctx, azurePeer)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Azure peer: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Azure peer: %s", err)
 	}
 
-	log.Printf("[TRACE] Reading azure peer: %#v", azureP)
+	tflog.Trace(ctx, "Reading azure peer", map[string]interface{}{"vnet1": azureP.VNet1, "vnet2": azureP.VNet2})
 
 	if azureP != nil {
 		d.Set("vnet_name_resource_group1", azureP.VNet1)
@@ -143,29 +144,29 @@ func resourceAviatrixAzurePeerRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("vnet_reg2", azureP.Region2)
 
 		if err := d.Set("vnet_cidr1", azureP.VNetCidr1); err != nil {
-			log.Printf("[WARN] Error setting vnet_cidr1 for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, "Error setting vnet_cidr1", map[string]interface{}{"id": d.Id(), "error": err})
 		}
 		if err := d.Set("vnet_cidr2", azureP.VNetCidr2); err != nil {
-			log.Printf("[WARN] Error setting vnet_cidr2 for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, "Error setting vnet_cidr2", map[string]interface{}{"id": d.Id(), "error": err})
 		}
 	}
 
 	return nil
 }
 
-func resourceAviatrixAzurePeerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAzurePeerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	azurePeer := &goaviatrix.AzurePeer{
 		VNet1: d.Get("vnet_name_resource_group1").(string),
 		VNet2: d.Get("vnet_name_resource_group2").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Azure peer: %#v", azurePeer)
+	tflog.Info(ctx, "Deleting Aviatrix Azure peer", map[string]interface{}{"vnet1": azurePeer.VNet1, "vnet2": azurePeer.VNet2})
 
-	err := client.DeleteAzurePeer(azurePeer)
+	err := client.DeleteAzurePeerContext(ctx, azurePeer)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Azure peer: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Azure peer: %s", err)
 	}
 
 	return nil
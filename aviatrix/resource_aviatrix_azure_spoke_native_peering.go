@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAzureSpokeNativePeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAzureSpokeNativePeeringCreate,
-		Read:   resourceAviatrixAzureSpokeNativePeeringRead,
-		Delete: resourceAviatrixAzureSpokeNativePeeringDelete,
+		CreateContext: resourceAviatrixAzureSpokeNativePeeringCreate,
+		ReadContext:   resourceAviatrixAzureSpokeNativePeeringRead,
+		DeleteContext: resourceAviatrixAzureSpokeNativePeeringDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,8 +48,8 @@ func resourceAviatrixAzureSpokeNativePeering() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAzureSpokeNativePeeringCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAzureSpokeNativePeeringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	azureSpokeNativePeering := &goaviatrix.AzureSpokeNativePeering{
 		TransitGatewayName: d.Get("transit_gateway_name").(string),
@@ -57,30 +58,30 @@ func resourceAviatrixAzureSpokeNativePeeringCreate(d *schema.ResourceData, meta
 		SpokeVpcID:         d.Get("spoke_vpc_id").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Azure spoke native peering: %#v", azureSpokeNativePeering)
+	tflog.Info(ctx, "Creating Aviatrix Azure spoke native peering", map[string]interface{}{"transit_gateway_name": azureSpokeNativePeering.TransitGatewayName})
 
 	d.SetId(azureSpokeNativePeering.TransitGatewayName + "~" + azureSpokeNativePeering.SpokeAccountName + "~" + azureSpokeNativePeering.SpokeVpcID)
 	flag := false
-	defer resourceAviatrixAzureSpokeNativePeeringReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAzureSpokeNativePeeringReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateAzureSpokeNativePeering(azureSpokeNativePeering)
+	err := client.CreateAzureSpokeNativePeeringContext(ctx, azureSpokeNativePeering)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Azure spoke native peering: %s", err)
+		return diag.Errorf("failed to create Aviatrix Azure spoke native peering: %s", err)
 	}
 
-	return resourceAviatrixAzureSpokeNativePeeringReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAzureSpokeNativePeeringReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAzureSpokeNativePeeringReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAzureSpokeNativePeeringReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAzureSpokeNativePeeringRead(d, meta)
+		return resourceAviatrixAzureSpokeNativePeeringRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAzureSpokeNativePeeringRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAzureSpokeNativePeeringRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	transitGatewayName := d.Get("transit_gateway_name").(string)
 	spokeAccountName := d.Get("spoke_account_name").(string)
@@ -88,7 +89,7 @@ func resourceAviatrixAzureSpokeNativePeeringRead(d *schema.ResourceData, meta in
 
 	if transitGatewayName == "" || spokeAccountName == "" || spokeVpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no transit gateway name, or spoke account name, or spoke vpc id received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no transit gateway name, or spoke account name, or spoke vpc id received", map[string]interface{}{"id": id})
 		d.Set("transit_gateway_name", strings.Split(id, "~")[0])
 		d.Set("spoke_account_name", strings.Split(id, "~")[1])
 		d.Set("spoke_vpc_id", strings.Split(id, "~")[2])
@@ -101,13 +102,13 @@ func resourceAviatrixAzureSpokeNativePeeringRead(d *schema.ResourceData, meta in
 		SpokeVpcID:         d.Get("spoke_vpc_id").(string),
 	}
 
-	azureSpokeNativePeering, err := client.GetAzureSpokeNativePeering(azureSpokeNativePeering)
+	azureSpokeNativePeering, err := client.GetAzureSpokeNativePeeringContext(ctx, azureSpokeNativePeering)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix azure spoke native peering: %s", err)
+		return diag.Errorf("couldn't find Aviatrix azure spoke native peering: %s", err)
 	}
 
 	d.Set("transit_gateway_name", azureSpokeNativePeering.TransitGatewayName)
@@ -119,8 +120,8 @@ func resourceAviatrixAzureSpokeNativePeeringRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAviatrixAzureSpokeNativePeeringDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAzureSpokeNativePeeringDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	azureSpokeNativePeering := &goaviatrix.AzureSpokeNativePeering{
 		TransitGatewayName: d.Get("transit_gateway_name").(string),
@@ -128,11 +129,11 @@ func resourceAviatrixAzureSpokeNativePeeringDelete(d *schema.ResourceData, meta
 		SpokeVpcID:         d.Get("spoke_vpc_id").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Azure spoke native peering: %#v", azureSpokeNativePeering)
+	tflog.Info(ctx, "Deleting Aviatrix Azure spoke native peering", map[string]interface{}{"transit_gateway_name": azureSpokeNativePeering.TransitGatewayName})
 
-	err := client.DeleteAzureSpokeNativePeering(azureSpokeNativePeering)
+	err := client.DeleteAzureSpokeNativePeeringContext(ctx, azureSpokeNativePeering)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Azure spoke native peering: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Azure spoke native peering: %s", err)
 	}
 
 	return nil
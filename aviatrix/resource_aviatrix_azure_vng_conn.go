@@ -1,20 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixAzureVngConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAzureVngConnCreate,
-		Read:   resourceAviatrixAzureVngConnRead,
-		Delete: resourceAviatrixAzureVngConnDelete,
+		CreateContext: resourceAviatrixAzureVngConnCreate,
+		ReadContext:   resourceAviatrixAzureVngConnRead,
+		DeleteContext: resourceAviatrixAzureVngConnDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,50 +57,50 @@ func marshalAzureVngConnInput(d *schema.ResourceData) *goaviatrix.AzureVngConn {
 	}
 }
 
-func resourceAviatrixAzureVngConnCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAzureVngConnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	azureVngConn := marshalAzureVngConnInput(d)
 
 	d.SetId(azureVngConn.ConnectionName)
 	flag := false
-	defer resourceAviatrixAzureVngConnReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixAzureVngConnReadIfRequired(ctx, d, meta, &flag)
 
-	if err := client.ConnectAzureVng(azureVngConn); err != nil {
-		return fmt.Errorf("could not connect to azure vng: %v", err)
+	if err := client.ConnectAzureVngContext(ctx, azureVngConn); err != nil {
+		return diag.Errorf("could not connect to azure vng: %v", err)
 	}
 
-	return resourceAviatrixAzureVngConnReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAzureVngConnReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAzureVngConnReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixAzureVngConnReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAzureVngConnRead(d, meta)
+		return resourceAviatrixAzureVngConnRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAzureVngConnRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAzureVngConnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	connectionName := d.Get("connection_name").(string)
 
 	if connectionName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 
 		d.Set("connection_name", id)
 		connectionName = id
 	}
 
-	azureVngConnStatus, err := client.GetAzureVngConnStatus(connectionName)
+	azureVngConnStatus, err := client.GetAzureVngConnStatusContext(ctx, connectionName)
 	if err == goaviatrix.ErrNotFound {
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get azure vng conn status: %v", err)
+		return diag.Errorf("could not get azure vng conn status: %v", err)
 	}
 
 	d.Set("primary_gateway_name", azureVngConnStatus.PrimaryGatewayName)
@@ -111,14 +112,14 @@ func resourceAviatrixAzureVngConnRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAviatrixAzureVngConnDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixAzureVngConnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpcId := d.Get("vpc_id").(string)
 	connectionName := d.Get("connection_name").(string)
 
-	if err := client.DisconnectAzureVng(vpcId, connectionName); err != nil {
-		return fmt.Errorf("could not disconnect vng connection: %v", err)
+	if err := client.DisconnectAzureVngContext(ctx, vpcId, connectionName); err != nil {
+		return diag.Errorf("could not disconnect vng connection: %v", err)
 	}
 
 	return nil
@@ -2,9 +2,9 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -34,6 +34,8 @@ func resourceAviatrixCentralizedTransitFireNet() *schema.Resource {
 				Description: "Secondary firenet gateway name.",
 			},
 		},
+		DeprecationMessage: "Since V8.1.10+, please use the secondary_firenet_gw_names attribute of resource aviatrix_firenet instead. " +
+			"Resource aviatrix_centralized_transit_firenet will be deprecated in a future release.",
 	}
 }
 
@@ -47,7 +49,7 @@ func marshalCentralizedTransitFireNetInput(d *schema.ResourceData) *goaviatrix.C
 }
 
 func resourceAviatrixCentralizedTransitFireNetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	centralizedTransitFirenet := marshalCentralizedTransitFireNetInput(d)
 
@@ -60,12 +62,12 @@ func resourceAviatrixCentralizedTransitFireNetCreate(ctx context.Context, d *sch
 		return diag.Errorf("gateway %s doesn't meet all the conditions for primary firenet", centralizedTransitFirenet.PrimaryGwName)
 	}
 
-	secondaryFirenetList, err := client.GetPrimaryFireNet(ctx)
+	secondaryFirenetList, err := client.GetSecondaryFireNet(ctx)
 	if err != nil {
 		return diag.Errorf("could not get the list of secondary firenet: %v", err)
 	}
 	if !goaviatrix.Contains(secondaryFirenetList, centralizedTransitFirenet.SecondaryGwName) {
-		return diag.Errorf("gateway %s doesn't meet all the conditions for secondary firenet", centralizedTransitFirenet.PrimaryGwName)
+		return diag.Errorf("gateway %s doesn't meet all the conditions for secondary firenet", centralizedTransitFirenet.SecondaryGwName)
 	}
 
 	d.SetId(centralizedTransitFirenet.PrimaryGwName + "~" + centralizedTransitFirenet.SecondaryGwName)
@@ -88,14 +90,18 @@ func resourceAviatrixCentralizedTransitFireNetReadIfRequired(ctx context.Context
 }
 
 func resourceAviatrixCentralizedTransitFireNetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// handle import
 	if d.Get("primary_firenet_gw_name").(string) == "" || d.Get("secondary_firenet_gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no primary or secondary gateway name received. Import Id is %s", id)
-		d.Set("primary_firenet_gw_name", strings.Split(id, "~")[0])
-		d.Set("secondary_firenet_gw_name", strings.Split(id, "~")[1])
+		tflog.Debug(ctx, "Looks like an import, no primary or secondary gateway name received", map[string]interface{}{"id": id})
+		parts := strings.Split(id, "~")
+		if len(parts) != 2 {
+			return diag.Errorf("invalid ID %q, expected ID in the form 'primary_firenet_gw_name~secondary_firenet_gw_name'", id)
+		}
+		d.Set("primary_firenet_gw_name", parts[0])
+		d.Set("secondary_firenet_gw_name", parts[1])
 		d.SetId(id)
 	}
 
@@ -115,7 +121,7 @@ func resourceAviatrixCentralizedTransitFireNetRead(ctx context.Context, d *schem
 }
 
 func resourceAviatrixCentralizedTransitFireNetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	centralizedFirenet := marshalCentralizedTransitFireNetInput(d)
 
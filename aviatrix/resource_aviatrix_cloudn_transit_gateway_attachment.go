@@ -0,0 +1,342 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixCloudnTransitGatewayAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixCloudnTransitGatewayAttachmentCreate,
+		ReadWithoutTimeout:   resourceAviatrixCloudnTransitGatewayAttachmentRead,
+		UpdateWithoutTimeout: resourceAviatrixCloudnTransitGatewayAttachmentUpdate,
+		DeleteWithoutTimeout: resourceAviatrixCloudnTransitGatewayAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CloudN device name.",
+			},
+			"transit_gateway_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Transit gateway name.",
+			},
+			"connection_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Connection name.",
+			},
+			"transit_gateway_bgp_asn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: goaviatrix.ValidateASN,
+				Description:  "Transit gateway BGP AS number.",
+			},
+			"cloudn_bgp_asn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: goaviatrix.ValidateASN,
+				Description:  "CloudN BGP AS number.",
+			},
+			"cloudn_lan_interface_neighbor_ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "CloudN LAN interface neighbor's IP address.",
+			},
+			"cloudn_lan_interface_neighbor_bgp_asn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: goaviatrix.ValidateASN,
+				Description:  "CloudN LAN interface neighbor's AS number.",
+			},
+			"enable_over_private_network": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "Enable connection over private network.",
+			},
+			"enable_jumbo_frame": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable jumbo frame support for the connection.",
+			},
+			"enable_dead_peer_detection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "Enable dead peer detection.",
+			},
+			"enable_learned_cidrs_approval": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable learned CIDRs approval.",
+			},
+			"approved_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				Description: "Set of approved CIDRs. Requires 'enable_learned_cidrs_approval' to be true.",
+			},
+			"prepend_as_path": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Connection AS Path Prepend customized by specifying AS PATH for a BGP connection.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: goaviatrix.ValidateASN,
+				},
+				MaxItems: 25,
+			},
+		},
+	}
+}
+
+func marshalCloudnTransitGatewayAttachmentInput(d *schema.ResourceData) (*goaviatrix.CloudnTransitGatewayAttachment, error) {
+	neighborBgpAsn, err := strconv.Atoi(d.Get("cloudn_lan_interface_neighbor_bgp_asn").(string))
+	if err != nil {
+		return nil, fmt.Errorf("could not convert 'cloudn_lan_interface_neighbor_bgp_asn' to int: %v", err)
+	}
+
+	attachment := &goaviatrix.CloudnTransitGatewayAttachment{
+		DeviceName:                       d.Get("device_name").(string),
+		TransitGatewayName:               d.Get("transit_gateway_name").(string),
+		ConnectionName:                   d.Get("connection_name").(string),
+		TransitGatewayBgpAsn:             d.Get("transit_gateway_bgp_asn").(string),
+		CloudnBgpAsn:                     d.Get("cloudn_bgp_asn").(string),
+		CloudnLanInterfaceNeighborIP:     d.Get("cloudn_lan_interface_neighbor_ip").(string),
+		CloudnLanInterfaceNeighborBgpAsn: neighborBgpAsn,
+		EnableOverPrivateNetwork:         d.Get("enable_over_private_network").(bool),
+		EnableJumboFrame:                 d.Get("enable_jumbo_frame").(bool),
+		EnableDeadPeerDetection:          d.Get("enable_dead_peer_detection").(bool),
+	}
+
+	return attachment, nil
+}
+
+func getCloudnTransitGatewayAttachmentPrependASPath(d *schema.ResourceData) []string {
+	var prependASPath []string
+	for _, v := range d.Get("prepend_as_path").([]interface{}) {
+		prependASPath = append(prependASPath, v.(string))
+	}
+	return prependASPath
+}
+
+func resourceAviatrixCloudnTransitGatewayAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	attachment, err := marshalCloudnTransitGatewayAttachmentInput(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	approvedCidrs := getStringSet(d, "approved_cidrs")
+	enableLearnedCidrsApproval := d.Get("enable_learned_cidrs_approval").(bool)
+	if !enableLearnedCidrsApproval && len(approvedCidrs) > 0 {
+		return diag.Errorf("'approved_cidrs' requires 'enable_learned_cidrs_approval' to be true")
+	}
+	prependASPath := getCloudnTransitGatewayAttachmentPrependASPath(d)
+
+	tflog.Info(ctx, "Creating Aviatrix CloudN Transit Gateway Attachment", map[string]interface{}{"connection_name": attachment.ConnectionName})
+
+	if err := client.CreateCloudnTransitGatewayAttachment(ctx, attachment); err != nil {
+		return diag.Errorf("failed to create Aviatrix CloudN Transit Gateway Attachment: %v", err)
+	}
+	d.SetId(attachment.ConnectionName)
+
+	if attachment.EnableJumboFrame || !attachment.EnableDeadPeerDetection {
+		vpcID, err := client.GetDeviceAttachmentVpcIDContext(ctx, attachment.ConnectionName)
+		if err != nil {
+			return diag.Errorf("could not get CloudN Transit Gateway Attachment VPC id after create: %v", err)
+		}
+
+		if attachment.EnableJumboFrame {
+			if err := client.EnableJumboFrameOnConnectionToCloudn(ctx, attachment.ConnectionName, vpcID); err != nil {
+				return diag.Errorf("could not enable jumbo frame for CloudN Transit Gateway Attachment after create: %v", err)
+			}
+		}
+
+		if !attachment.EnableDeadPeerDetection {
+			site2cloud := &goaviatrix.Site2Cloud{
+				VpcID:      vpcID,
+				TunnelName: attachment.ConnectionName,
+			}
+			if err := client.DisableDeadPeerDetectionContext(ctx, site2cloud); err != nil {
+				return diag.Errorf("could not disable dead peer detection for CloudN Transit Gateway Attachment after create: %v", err)
+			}
+		}
+	}
+
+	if enableLearnedCidrsApproval {
+		if err := client.EnableTransitConnectionLearnedCIDRApprovalContext(ctx, attachment.TransitGatewayName, attachment.ConnectionName); err != nil {
+			return diag.Errorf("could not enable learned cidrs approval for CloudN Transit Gateway Attachment after create: %v", err)
+		}
+
+		if len(approvedCidrs) > 0 {
+			if err := client.UpdateTransitConnectionPendingApprovedCidrsContext(ctx, attachment.TransitGatewayName, attachment.ConnectionName, approvedCidrs); err != nil {
+				return diag.Errorf("could not set approved cidrs for CloudN Transit Gateway Attachment after create: %v", err)
+			}
+		}
+	}
+
+	if len(prependASPath) > 0 {
+		if err := client.EditCloudnTransitGatewayAttachmentASPathPrepend(ctx, attachment, prependASPath); err != nil {
+			return diag.Errorf("could not set prepend_as_path for CloudN Transit Gateway Attachment after create: %v", err)
+		}
+	}
+
+	return resourceAviatrixCloudnTransitGatewayAttachmentRead(ctx, d, meta)
+}
+
+func resourceAviatrixCloudnTransitGatewayAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	connectionName := d.Get("connection_name").(string)
+	if connectionName == "" {
+		id := d.Id()
+		tflog.Debug(ctx, "Looks like an import, no 'connection_name' received", map[string]interface{}{"id": id})
+		d.Set("connection_name", id)
+		d.SetId(id)
+		connectionName = id
+	}
+
+	attachment, err := client.GetCloudnTransitGatewayAttachment(ctx, connectionName)
+	if err == goaviatrix.ErrNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("could not find CloudN Transit Gateway Attachment %s: %v", connectionName, err)
+	}
+
+	d.Set("device_name", attachment.DeviceName)
+	d.Set("transit_gateway_name", attachment.TransitGatewayName)
+	d.Set("connection_name", attachment.ConnectionName)
+	d.Set("transit_gateway_bgp_asn", attachment.TransitGatewayBgpAsn)
+	d.Set("cloudn_bgp_asn", attachment.CloudnBgpAsn)
+	d.Set("cloudn_lan_interface_neighbor_ip", attachment.CloudnLanInterfaceNeighborIP)
+	d.Set("cloudn_lan_interface_neighbor_bgp_asn", string(attachment.CloudnLanInterfaceNeighborBgpAsn))
+	d.Set("enable_over_private_network", attachment.EnableOverPrivateNetwork)
+	d.Set("enable_jumbo_frame", attachment.EnableJumboFrame)
+	d.Set("enable_dead_peer_detection", attachment.EnableDeadPeerDetection)
+	d.Set("enable_learned_cidrs_approval", attachment.EnableLearnedCidrsApproval == "yes")
+	if err := d.Set("approved_cidrs", attachment.ApprovedCidrs); err != nil {
+		return diag.Errorf("failed to set approved_cidrs for CloudN Transit Gateway Attachment: %v", err)
+	}
+
+	var prependASPath []string
+	if attachment.PrependAsPath != "" {
+		for _, str := range strings.Split(attachment.PrependAsPath, " ") {
+			prependASPath = append(prependASPath, strings.TrimSpace(str))
+		}
+	}
+	if err := d.Set("prepend_as_path", prependASPath); err != nil {
+		return diag.Errorf("could not set value for prepend_as_path: %v", err)
+	}
+
+	d.SetId(attachment.ConnectionName)
+	return nil
+}
+
+func resourceAviatrixCloudnTransitGatewayAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	connectionName := d.Get("connection_name").(string)
+	transitGatewayName := d.Get("transit_gateway_name").(string)
+
+	if d.HasChange("enable_jumbo_frame") {
+		vpcID, err := client.GetDeviceAttachmentVpcIDContext(ctx, connectionName)
+		if err != nil {
+			return diag.Errorf("could not get CloudN Transit Gateway Attachment VPC id during update: %v", err)
+		}
+
+		if d.Get("enable_jumbo_frame").(bool) {
+			if err := client.EnableJumboFrameOnConnectionToCloudn(ctx, connectionName, vpcID); err != nil {
+				return diag.Errorf("could not enable jumbo frame for CloudN Transit Gateway Attachment during update: %v", err)
+			}
+		} else {
+			if err := client.DisableJumboFrameOnConnectionToCloudn(ctx, connectionName, vpcID); err != nil {
+				return diag.Errorf("could not disable jumbo frame for CloudN Transit Gateway Attachment during update: %v", err)
+			}
+		}
+	}
+
+	enableLearnedCidrsApproval := d.Get("enable_learned_cidrs_approval").(bool)
+	if d.HasChange("enable_learned_cidrs_approval") {
+		if enableLearnedCidrsApproval {
+			if err := client.EnableTransitConnectionLearnedCIDRApprovalContext(ctx, transitGatewayName, connectionName); err != nil {
+				return diag.Errorf("could not enable learned cidrs approval for CloudN Transit Gateway Attachment during update: %v", err)
+			}
+		} else {
+			if err := client.DisableTransitConnectionLearnedCIDRApprovalContext(ctx, transitGatewayName, connectionName); err != nil {
+				return diag.Errorf("could not disable learned cidrs approval for CloudN Transit Gateway Attachment during update: %v", err)
+			}
+		}
+	}
+
+	if d.HasChange("approved_cidrs") {
+		approvedCidrs := getStringSet(d, "approved_cidrs")
+		if !enableLearnedCidrsApproval && len(approvedCidrs) > 0 {
+			return diag.Errorf("'approved_cidrs' requires 'enable_learned_cidrs_approval' to be true")
+		}
+		if enableLearnedCidrsApproval {
+			if err := client.UpdateTransitConnectionPendingApprovedCidrsContext(ctx, transitGatewayName, connectionName, approvedCidrs); err != nil {
+				return diag.Errorf("could not update approved cidrs for CloudN Transit Gateway Attachment: %v", err)
+			}
+		}
+	}
+
+	if d.HasChange("prepend_as_path") {
+		attachment := &goaviatrix.CloudnTransitGatewayAttachment{
+			TransitGatewayName: transitGatewayName,
+			ConnectionName:     connectionName,
+		}
+		if err := client.EditCloudnTransitGatewayAttachmentASPathPrepend(ctx, attachment, getCloudnTransitGatewayAttachmentPrependASPath(d)); err != nil {
+			return diag.Errorf("could not update prepend_as_path for CloudN Transit Gateway Attachment: %v", err)
+		}
+	}
+
+	return resourceAviatrixCloudnTransitGatewayAttachmentRead(ctx, d, meta)
+}
+
+func resourceAviatrixCloudnTransitGatewayAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	connectionName := d.Get("connection_name").(string)
+
+	tflog.Info(ctx, "Deleting Aviatrix CloudN Transit Gateway Attachment", map[string]interface{}{"connection_name": connectionName})
+
+	if err := client.DeleteDeviceAttachmentContext(ctx, connectionName); err != nil {
+		return diag.Errorf("failed to delete Aviatrix CloudN Transit Gateway Attachment %s: %v", connectionName, err)
+	}
+
+	return nil
+}
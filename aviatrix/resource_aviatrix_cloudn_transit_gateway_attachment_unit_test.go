@@ -0,0 +1,252 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCloudnTransitGatewayAttachmentResp() *goaviatrix.CloudnTransitGatewayAttachmentResp {
+	return &goaviatrix.CloudnTransitGatewayAttachmentResp{
+		DeviceName:                       "cloudn-device",
+		TransitGatewayName:               "transit-gw",
+		ConnectionName:                   "cloudn-conn",
+		TransitGatewayBgpAsn:             "65000",
+		CloudnBgpAsn:                     "65046",
+		CloudnLanInterfaceNeighborIP:     "10.210.38.100",
+		CloudnLanInterfaceNeighborBgpAsn: "65219",
+		EnableOverPrivateNetwork:         true,
+		EnableJumboFrame:                 true,
+		EnableDeadPeerDetection:          true,
+		EnableLearnedCidrsApproval:       "no",
+		PrependAsPath:                    "65000 65000",
+	}
+}
+
+// testCloudnTransitGatewayAttachmentData returns the resource data of an
+// existing attachment matching testCloudnTransitGatewayAttachmentResp, with
+// changes applied to its configuration.
+func testCloudnTransitGatewayAttachmentData(t *testing.T, changes map[string]interface{}) *schema.ResourceData {
+	state := &terraform.InstanceState{
+		ID: "cloudn-conn",
+		Attributes: map[string]string{
+			"id":                                    "cloudn-conn",
+			"device_name":                           "cloudn-device",
+			"transit_gateway_name":                  "transit-gw",
+			"connection_name":                       "cloudn-conn",
+			"transit_gateway_bgp_asn":               "65000",
+			"cloudn_bgp_asn":                        "65046",
+			"cloudn_lan_interface_neighbor_ip":      "10.210.38.100",
+			"cloudn_lan_interface_neighbor_bgp_asn": "65219",
+			"enable_over_private_network":           "true",
+			"enable_jumbo_frame":                    "true",
+			"enable_dead_peer_detection":            "true",
+			"enable_learned_cidrs_approval":         "false",
+			"approved_cidrs.#":                      "0",
+			"prepend_as_path.#":                     "2",
+			"prepend_as_path.0":                     "65000",
+			"prepend_as_path.1":                     "65000",
+		},
+	}
+	config := map[string]interface{}{
+		"device_name":                           "cloudn-device",
+		"transit_gateway_name":                  "transit-gw",
+		"connection_name":                       "cloudn-conn",
+		"transit_gateway_bgp_asn":               "65000",
+		"cloudn_bgp_asn":                        "65046",
+		"cloudn_lan_interface_neighbor_ip":      "10.210.38.100",
+		"cloudn_lan_interface_neighbor_bgp_asn": "65219",
+		"enable_jumbo_frame":                    true,
+		"prepend_as_path":                       []interface{}{"65000", "65000"},
+	}
+	for k, v := range changes {
+		config[k] = v
+	}
+
+	r := resourceAviatrixCloudnTransitGatewayAttachment()
+	diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("could not diff resource: %v", err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("could not build resource data: %v", err)
+	}
+	return d
+}
+
+func TestResourceAviatrixCloudnTransitGatewayAttachmentCreate(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		CreateCloudnTransitGatewayAttachmentFunc: func(ctx context.Context, attachment *goaviatrix.CloudnTransitGatewayAttachment) error {
+			assert.Equal(t, "cloudn-conn", attachment.ConnectionName)
+			assert.Equal(t, 65219, attachment.CloudnLanInterfaceNeighborBgpAsn)
+			assert.True(t, attachment.EnableJumboFrame)
+			return nil
+		},
+		GetDeviceAttachmentVpcIDContextFunc: func(ctx context.Context, connectionName string) (string, error) {
+			return "vpc-cloudn", nil
+		},
+		EnableJumboFrameOnConnectionToCloudnFunc: func(ctx context.Context, connName, vpcID string) error {
+			assert.Equal(t, "cloudn-conn", connName)
+			assert.Equal(t, "vpc-cloudn", vpcID)
+			return nil
+		},
+		EditCloudnTransitGatewayAttachmentASPathPrependFunc: func(ctx context.Context, attachment *goaviatrix.CloudnTransitGatewayAttachment, prependASPath []string) error {
+			assert.Equal(t, "transit-gw", attachment.TransitGatewayName)
+			assert.Equal(t, []string{"65000", "65000"}, prependASPath)
+			return nil
+		},
+		GetCloudnTransitGatewayAttachmentFunc: func(ctx context.Context, connName string) (*goaviatrix.CloudnTransitGatewayAttachmentResp, error) {
+			return testCloudnTransitGatewayAttachmentResp(), nil
+		},
+	}
+	defer func() { assert.Len(t, client.CreateCloudnTransitGatewayAttachmentCalls(), 1) }()
+	defer func() { assert.Len(t, client.EnableJumboFrameOnConnectionToCloudnCalls(), 1) }()
+	defer func() { assert.Len(t, client.EditCloudnTransitGatewayAttachmentASPathPrependCalls(), 1) }()
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixCloudnTransitGatewayAttachment().Schema, map[string]interface{}{
+		"device_name":                           "cloudn-device",
+		"transit_gateway_name":                  "transit-gw",
+		"connection_name":                       "cloudn-conn",
+		"transit_gateway_bgp_asn":               "65000",
+		"cloudn_bgp_asn":                        "65046",
+		"cloudn_lan_interface_neighbor_ip":      "10.210.38.100",
+		"cloudn_lan_interface_neighbor_bgp_asn": "65219",
+		"enable_jumbo_frame":                    true,
+		"prepend_as_path":                       []interface{}{"65000", "65000"},
+	})
+	res := resourceAviatrixCloudnTransitGatewayAttachmentCreate(context.Background(), d, client)
+
+	assert.Empty(t, res)
+	assert.Equal(t, "cloudn-conn", d.Id())
+}
+
+func TestResourceAviatrixCloudnTransitGatewayAttachmentCreate_ApprovedCidrsRequireApproval(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixCloudnTransitGatewayAttachment().Schema, map[string]interface{}{
+		"connection_name":                       "cloudn-conn",
+		"cloudn_lan_interface_neighbor_bgp_asn": "65219",
+		"approved_cidrs":                        []interface{}{"10.0.0.0/16"},
+	})
+	res := resourceAviatrixCloudnTransitGatewayAttachmentCreate(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("'approved_cidrs' requires 'enable_learned_cidrs_approval' to be true"), res)
+	assert.Empty(t, client.CreateCloudnTransitGatewayAttachmentCalls())
+}
+
+func TestResourceAviatrixCloudnTransitGatewayAttachmentRead_Import(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetCloudnTransitGatewayAttachmentFunc: func(ctx context.Context, connName string) (*goaviatrix.CloudnTransitGatewayAttachmentResp, error) {
+			assert.Equal(t, "cloudn-conn", connName)
+			return testCloudnTransitGatewayAttachmentResp(), nil
+		},
+	}
+
+	d := resourceAviatrixCloudnTransitGatewayAttachment().TestResourceData()
+	d.SetId("cloudn-conn")
+	res := resourceAviatrixCloudnTransitGatewayAttachmentRead(context.Background(), d, client)
+
+	assert.Empty(t, res)
+	assert.Equal(t, "cloudn-conn", d.Id())
+	assert.Equal(t, "cloudn-device", d.Get("device_name"))
+	assert.Equal(t, "65219", d.Get("cloudn_lan_interface_neighbor_bgp_asn"))
+	assert.Equal(t, true, d.Get("enable_jumbo_frame"))
+	assert.Equal(t, false, d.Get("enable_learned_cidrs_approval"))
+	assert.Equal(t, []interface{}{"65000", "65000"}, d.Get("prepend_as_path"))
+}
+
+func TestResourceAviatrixCloudnTransitGatewayAttachmentRead_NotFound(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetCloudnTransitGatewayAttachmentFunc: func(ctx context.Context, connName string) (*goaviatrix.CloudnTransitGatewayAttachmentResp, error) {
+			return nil, goaviatrix.ErrNotFound
+		},
+	}
+
+	d := testCloudnTransitGatewayAttachmentData(t, nil)
+	res := resourceAviatrixCloudnTransitGatewayAttachmentRead(context.Background(), d, client)
+
+	assert.Empty(t, res)
+	assert.Equal(t, "", d.Id())
+}
+
+func TestResourceAviatrixCloudnTransitGatewayAttachmentUpdate(t *testing.T) {
+	tests := []struct {
+		name            string
+		changes         map[string]interface{}
+		jumboFrameCalls int
+		prependCalls    int
+	}{
+		{
+			name:            "disable jumbo frame",
+			changes:         map[string]interface{}{"enable_jumbo_frame": false},
+			jumboFrameCalls: 1,
+		},
+		{
+			name:         "edit prepend_as_path",
+			changes:      map[string]interface{}{"prepend_as_path": []interface{}{"65000"}},
+			prependCalls: 1,
+		},
+		{
+			name: "disable jumbo frame and remove prepend_as_path",
+			changes: map[string]interface{}{
+				"enable_jumbo_frame": false,
+				"prepend_as_path":    []interface{}{},
+			},
+			jumboFrameCalls: 1,
+			prependCalls:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &goaviatrix.ClientInterfaceMock{
+				GetDeviceAttachmentVpcIDContextFunc: func(ctx context.Context, connectionName string) (string, error) {
+					return "vpc-cloudn", nil
+				},
+				DisableJumboFrameOnConnectionToCloudnFunc: func(ctx context.Context, connName, vpcID string) error {
+					assert.Equal(t, "cloudn-conn", connName)
+					assert.Equal(t, "vpc-cloudn", vpcID)
+					return nil
+				},
+				EditCloudnTransitGatewayAttachmentASPathPrependFunc: func(ctx context.Context, attachment *goaviatrix.CloudnTransitGatewayAttachment, prependASPath []string) error {
+					assert.Equal(t, "cloudn-conn", attachment.ConnectionName)
+					assert.Equal(t, "transit-gw", attachment.TransitGatewayName)
+					return nil
+				},
+				GetCloudnTransitGatewayAttachmentFunc: func(ctx context.Context, connName string) (*goaviatrix.CloudnTransitGatewayAttachmentResp, error) {
+					return testCloudnTransitGatewayAttachmentResp(), nil
+				},
+			}
+
+			d := testCloudnTransitGatewayAttachmentData(t, tt.changes)
+			res := resourceAviatrixCloudnTransitGatewayAttachmentUpdate(context.Background(), d, client)
+
+			assert.Empty(t, res)
+			assert.Len(t, client.DisableJumboFrameOnConnectionToCloudnCalls(), tt.jumboFrameCalls)
+			assert.Len(t, client.EditCloudnTransitGatewayAttachmentASPathPrependCalls(), tt.prependCalls)
+			assert.Empty(t, client.EnableJumboFrameOnConnectionToCloudnCalls())
+		})
+	}
+}
+
+func TestResourceAviatrixCloudnTransitGatewayAttachmentDelete_WhenDeleteFails(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		DeleteDeviceAttachmentContextFunc: func(ctx context.Context, connectionName string) error {
+			assert.Equal(t, "cloudn-conn", connectionName)
+			return errors.New("controller API failure")
+		},
+	}
+	defer func() { assert.Len(t, client.DeleteDeviceAttachmentContextCalls(), 1) }()
+
+	d := testCloudnTransitGatewayAttachmentData(t, nil)
+	res := resourceAviatrixCloudnTransitGatewayAttachmentDelete(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("failed to delete Aviatrix CloudN Transit Gateway Attachment cloudn-conn: controller API failure"), res)
+}
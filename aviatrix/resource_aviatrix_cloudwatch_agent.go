@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixCloudwatchAgent() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixCloudwatchAgentCreate,
-		Read:   resourceAviatrixCloudwatchAgentRead,
-		Delete: resourceAviatrixCloudwatchAgentDelete,
+		CreateContext: resourceAviatrixCloudwatchAgentCreate,
+		ReadContext:   resourceAviatrixCloudwatchAgentRead,
+		DeleteContext: resourceAviatrixCloudwatchAgentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,38 +75,38 @@ func marshalCloudwatchAgentInput(d *schema.ResourceData) *goaviatrix.CloudwatchA
 	return cloudwatchAgent
 }
 
-func resourceAviatrixCloudwatchAgentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixCloudwatchAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	_, err := client.GetCloudwatchAgentStatus()
+	_, err := client.GetCloudwatchAgentStatusContext(ctx)
 	if err != goaviatrix.ErrNotFound {
-		return fmt.Errorf("the cloudwatch_agent is already enabled, please import to manage with Terraform")
+		return diag.Errorf("the cloudwatch_agent is already enabled, please import to manage with Terraform")
 	}
 
 	cloudwatchAgent := marshalCloudwatchAgentInput(d)
 
-	if err := client.EnableCloudwatchAgent(cloudwatchAgent); err != nil {
-		return fmt.Errorf("could not enable cloudwatch agent: %v", err)
+	if err := client.EnableCloudwatchAgentContext(ctx, cloudwatchAgent); err != nil {
+		return diag.Errorf("could not enable cloudwatch agent: %v", err)
 	}
 
 	d.SetId("cloudwatch_agent")
 	return nil
 }
 
-func resourceAviatrixCloudwatchAgentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixCloudwatchAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.Id() != "cloudwatch_agent" {
-		return fmt.Errorf("invalid ID, expected ID \"cloudwatch_agent\", instead got %s", d.Id())
+		return diag.Errorf("invalid ID, expected ID \"cloudwatch_agent\", instead got %s", d.Id())
 	}
 
-	cloudwatchAgentStatus, err := client.GetCloudwatchAgentStatus()
+	cloudwatchAgentStatus, err := client.GetCloudwatchAgentStatusContext(ctx)
 	if err == goaviatrix.ErrNotFound {
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get cloudwatch agent status: %v", err)
+		return diag.Errorf("could not get cloudwatch agent status: %v", err)
 	}
 
 	d.Set("cloudwatch_role_arn", cloudwatchAgentStatus.RoleArn)
@@ -120,11 +121,11 @@ func resourceAviatrixCloudwatchAgentRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceAviatrixCloudwatchAgentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixCloudwatchAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	if err := client.DisableCloudwatchAgent(); err != nil {
-		return fmt.Errorf("could not disable cloudwatch agent: %v", err)
+	if err := client.DisableCloudwatchAgentContext(ctx); err != nil {
+		return diag.Errorf("could not disable cloudwatch agent: %v", err)
 	}
 
 	return nil
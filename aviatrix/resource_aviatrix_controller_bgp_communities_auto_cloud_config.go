@@ -38,9 +38,9 @@ func resourceAviatrixControllerBgpCommunitiesAutoCloudConfig() *schema.Resource
 }
 
 func resourceAviatrixControllerBgpCommunitiesAutoCloudConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
 	autoCloud, ok := d.Get("auto_cloud_enabled").(bool)
@@ -62,17 +62,17 @@ func resourceAviatrixControllerBgpCommunitiesAutoCloudConfigCreate(ctx context.C
 			return diag.Errorf("failed to disable controller BGP communities auto cloud config: %v", err)
 		}
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixControllerBgpCommunitiesAutoCloudConfigRead(ctx, d, meta)
 }
 
 func resourceAviatrixControllerBgpCommunitiesAutoCloudConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -100,14 +100,14 @@ func resourceAviatrixControllerBgpCommunitiesAutoCloudConfigRead(ctx context.Con
 			return diag.Errorf("failed to set auto cloud enabled: %v", err)
 		}
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixControllerBgpCommunitiesAutoCloudConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
 	if d.HasChange("auto_cloud_enabled") || d.HasChange("community_prefix") {
@@ -136,9 +136,9 @@ func resourceAviatrixControllerBgpCommunitiesAutoCloudConfigUpdate(ctx context.C
 }
 
 func resourceAviatrixControllerBgpCommunitiesAutoCloudConfigDelete(ctx context.Context, _ *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
 	err := client.DisableControllerBgpCommunitiesAutoCloud(ctx)
@@ -31,9 +31,9 @@ func resourceAviatrixControllerBgpCommunitiesGlobalConfig() *schema.Resource {
 }
 
 func resourceAviatrixControllerBgpCommunitiesGlobalConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
 	bgpCommunities, ok := d.Get("bgp_communities_global").(bool)
@@ -52,17 +52,17 @@ func resourceAviatrixControllerBgpCommunitiesGlobalConfigCreate(ctx context.Cont
 		}
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixControllerBgpCommunitiesGlobalConfigRead(ctx, d, meta)
 }
 
 func resourceAviatrixControllerBgpCommunitiesGlobalConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -79,14 +79,14 @@ func resourceAviatrixControllerBgpCommunitiesGlobalConfigRead(ctx context.Contex
 	if err != nil {
 		return diag.Errorf("failed to set bgp_communities_global: %v", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixControllerBgpCommunitiesGlobalConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
 	if d.HasChange("bgp_communities_global") {
@@ -107,14 +107,14 @@ func resourceAviatrixControllerBgpCommunitiesGlobalConfigUpdate(ctx context.Cont
 		}
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixControllerBgpCommunitiesGlobalConfigRead(ctx, d, meta)
 }
 
 func resourceAviatrixControllerBgpCommunitiesGlobalConfigDelete(ctx context.Context, _ *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
 	err := client.DisableControllerBgpCommunitiesGlobal(ctx)
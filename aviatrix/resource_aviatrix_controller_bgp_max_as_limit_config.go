@@ -32,7 +32,7 @@ func resourceAviatrixControllerBgpMaxAsLimitConfig() *schema.Resource {
 }
 
 func resourceAviatrixControllerBgpMaxAsLimitConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	maxAsLimit := d.Get("max_as_limit").(int)
 	err := client.SetControllerBgpMaxAsLimit(ctx, maxAsLimit)
@@ -40,14 +40,14 @@ func resourceAviatrixControllerBgpMaxAsLimitConfigCreate(ctx context.Context, d
 		return diag.Errorf("failed to create controller BGP max AS limit config: %v", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixControllerBgpMaxAsLimitConfigRead(ctx, d, meta)
 }
 
 func resourceAviatrixControllerBgpMaxAsLimitConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -61,12 +61,12 @@ func resourceAviatrixControllerBgpMaxAsLimitConfigRead(ctx context.Context, d *s
 	}
 
 	d.Set("max_as_limit", maxAsLimit)
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixControllerBgpMaxAsLimitConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.HasChange("max_as_limit") {
 		maxAsLimit := d.Get("max_as_limit").(int)
@@ -80,7 +80,7 @@ func resourceAviatrixControllerBgpMaxAsLimitConfigUpdate(ctx context.Context, d
 }
 
 func resourceAviatrixControllerBgpMaxAsLimitConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.DisableControllerBgpMaxAsLimit(ctx)
 	if err != nil {
@@ -1,12 +1,14 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,12 +19,12 @@ var validAwsGuardDutyScanningIntervals = []int{5, 10, 15, 30, 60}
 
 func resourceAviatrixControllerConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixControllerConfigCreate,
-		Read:   resourceAviatrixControllerConfigRead,
-		Update: resourceAviatrixControllerConfigUpdate,
-		Delete: resourceAviatrixControllerConfigDelete,
+		CreateContext: resourceAviatrixControllerConfigCreate,
+		ReadContext:   resourceAviatrixControllerConfigRead,
+		UpdateContext: resourceAviatrixControllerConfigUpdate,
+		DeleteContext: resourceAviatrixControllerConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -182,35 +184,35 @@ func resourceAviatrixControllerConfig() *schema.Resource {
 	}
 }
 
-func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixControllerConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var err error
 
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	flag := false
-	defer resourceAviatrixControllerConfigReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixControllerConfigReadIfRequired(ctx, d, meta, &flag)
 
-	log.Printf("[INFO] Configuring Aviatrix controller : %#v", d)
+	tflog.Info(ctx, "Configuring Aviatrix controller")
 
 	fqdnExceptionRule := d.Get("fqdn_exception_rule").(bool)
 	if fqdnExceptionRule {
-		curStatus, _ := client.GetExceptionRuleStatus()
+		curStatus, _ := client.GetExceptionRuleStatusContext(ctx)
 		if curStatus {
-			log.Printf("[INFO] FQDN Exception Rule is already enabled")
+			tflog.Info(ctx, "FQDN Exception Rule is already enabled")
 		} else {
-			err = client.EnableExceptionRule()
+			err = client.EnableExceptionRuleContext(ctx)
 		}
 	} else {
-		curStatus, _ := client.GetExceptionRuleStatus()
+		curStatus, _ := client.GetExceptionRuleStatusContext(ctx)
 		if !curStatus {
-			log.Printf("[INFO] FQDN Exception Rule is already disabled")
+			tflog.Info(ctx, "FQDN Exception Rule is already disabled")
 		} else {
-			err = client.DisableExceptionRule()
+			err = client.DisableExceptionRuleContext(ctx)
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("failed to configure controller exception rule: %s", err)
+		return diag.Errorf("failed to configure controller exception rule: %s", err)
 	}
 
 	backupConfiguration := d.Get("backup_configuration").(bool)
@@ -225,7 +227,7 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 	if backupConfiguration {
 		err = validateBackupConfig(d)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		cloudnBackupConfiguration := &goaviatrix.CloudnBackupConfiguration{
@@ -240,23 +242,23 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 			cloudnBackupConfiguration.MultipleBackups = "true"
 		}
 
-		err = client.EnableCloudnBackupConfig(cloudnBackupConfiguration)
+		err = client.EnableCloudnBackupConfigContext(ctx, cloudnBackupConfiguration)
 		if err != nil {
-			return fmt.Errorf("failed to enable backup configuration: %s", err)
+			return diag.Errorf("failed to enable backup configuration: %s", err)
 		}
 	} else {
 		if backupCloudType != 0 || backupAccountName != "" || backupBucketName != "" || backupStorageName != "" ||
 			backupContainerName != "" || backupRegion != "" || multipleBackups {
-			return fmt.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
+			return diag.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
 				" 'backup_storage_name', 'backup_container_name' and 'backup_region' should all be empty," +
 				" 'multiple_backups' should be empty or false for not enabling backup configuration")
 		}
 	}
 
 	enableVpcDnsServer := d.Get("enable_vpc_dns_server").(bool)
-	err = client.SetControllerVpcDnsServer(enableVpcDnsServer)
+	err = client.SetControllerVpcDnsServerContext(ctx, enableVpcDnsServer)
 	if err != nil {
-		return fmt.Errorf("could not toggle controller vpc dns server: %v", err)
+		return diag.Errorf("could not toggle controller vpc dns server: %v", err)
 	}
 
 	if _, useFilePath := d.GetOk("ca_certificate_file_path"); useFilePath {
@@ -265,9 +267,9 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 			ServerCertificateFilePath: d.Get("server_public_certificate_file_path").(string),
 			ServerPrivateKeyFilePath:  d.Get("server_private_key_file_path").(string),
 		}
-		err = client.ImportNewHTTPSCerts(certConfig)
+		err = client.ImportNewHTTPSCertsContext(ctx, certConfig)
 		if err != nil {
-			return fmt.Errorf("could not import HTTPS certs: %v", err)
+			return diag.Errorf("could not import HTTPS certs: %v", err)
 		}
 	} else if _, useFileContent := d.GetOk("ca_certificate_file"); useFileContent {
 		certConfig := &goaviatrix.HTTPSCertConfig{
@@ -275,37 +277,37 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta interfa
 			ServerCertificateFile: d.Get("server_public_certificate_file").(string),
 			ServerPrivateKeyFile:  d.Get("server_private_key_file").(string),
 		}
-		err = client.ImportNewHTTPSCerts(certConfig)
+		err = client.ImportNewHTTPSCertsContext(ctx, certConfig)
 		if err != nil {
-			return fmt.Errorf("could not import HTTPS certs: %v", err)
+			return diag.Errorf("could not import HTTPS certs: %v", err)
 		}
 	}
 
 	scanningInterval := d.Get("aws_guard_duty_scanning_interval")
-	err = client.UpdateAwsGuardDutyPollInterval(scanningInterval.(int))
+	err = client.UpdateAwsGuardDutyPollIntervalContext(ctx, scanningInterval.(int))
 	if err != nil {
-		return fmt.Errorf("could not update scanning interval: %v", err)
+		return diag.Errorf("could not update scanning interval: %v", err)
 	}
 
-	return resourceAviatrixControllerConfigReadIfRequired(d, meta, &flag)
+	return resourceAviatrixControllerConfigReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixControllerConfigReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixControllerConfigReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixControllerConfigRead(d, meta)
+		return resourceAviatrixControllerConfigRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	log.Printf("[INFO] Getting controller %s configuration", d.Id())
+	tflog.Info(ctx, "Getting controller configuration", map[string]interface{}{"id": d.Id()})
 
-	res, err := client.GetExceptionRuleStatus()
+	res, err := client.GetExceptionRuleStatusContext(ctx)
 	if err != nil {
-		return fmt.Errorf("could not read Aviatrix Controller Exception Rule Status: %s", err)
+		return diag.Errorf("could not read Aviatrix Controller Exception Rule Status: %s", err)
 	}
 	if res {
 		d.Set("fqdn_exception_rule", true)
@@ -317,10 +319,10 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 	try, maxTries, backoff := 0, 3, 1000*time.Millisecond
 	for {
 		try++
-		versionInfo, err = client.GetVersionInfo()
+		versionInfo, err = client.GetVersionInfoContext(ctx)
 		if err != nil {
 			if try == maxTries {
-				return fmt.Errorf("unable to read Controller version information: %s", err)
+				return diag.Errorf("unable to read Controller version information: %s", err)
 			}
 			time.Sleep(backoff)
 			// Double the backoff time after each failed try
@@ -333,9 +335,9 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 	d.Set("version", versionInfo.Current)
 	d.Set("previous_version", versionInfo.Previous)
 
-	cloudnBackupConfig, err := client.GetCloudnBackupConfig()
+	cloudnBackupConfig, err := client.GetCloudnBackupConfigContext(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to read current controller cloudn backup config: %s", err)
+		return diag.Errorf("unable to read current controller cloudn backup config: %s", err)
 	}
 	if cloudnBackupConfig != nil && cloudnBackupConfig.BackupConfiguration == "yes" {
 		d.Set("backup_configuration", true)
@@ -356,16 +358,16 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 		d.Set("multiple_backups", false)
 	}
 
-	vpcDnsServerEnabled, err := client.GetControllerVpcDnsServerStatus()
+	vpcDnsServerEnabled, err := client.GetControllerVpcDnsServerStatusContext(ctx)
 	if err != nil {
-		return fmt.Errorf("could not get controller vpc dns server status: %v", err)
+		return diag.Errorf("could not get controller vpc dns server status: %v", err)
 	}
 
 	d.Set("enable_vpc_dns_server", vpcDnsServerEnabled)
 
-	httpsCertsImported, err := client.GetHTTPSCertsStatus()
+	httpsCertsImported, err := client.GetHTTPSCertsStatusContext(ctx)
 	if err != nil {
-		return fmt.Errorf("could not get HTTPS Certificate status: %v", err)
+		return diag.Errorf("could not get HTTPS Certificate status: %v", err)
 	}
 	if !httpsCertsImported {
 		d.Set("ca_certificate_file_path", "")
@@ -376,35 +378,35 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta interface
 		d.Set("server_private_key_file", "")
 	}
 
-	guardDuty, err := client.GetAwsGuardDuty()
+	guardDuty, err := client.GetAwsGuardDutyContext(ctx)
 	if err != nil {
-		return fmt.Errorf("could not get aws guard duty scanning interval: %v", err)
+		return diag.Errorf("could not get aws guard duty scanning interval: %v", err)
 	}
 	d.Set("aws_guard_duty_scanning_interval", guardDuty.ScanningInterval)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
-func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	log.Printf("[INFO] Updating Controller configuration: %#v", d)
+	tflog.Info(ctx, "Updating Controller configuration")
 	d.Partial(true)
 
 	if d.HasChange("fqdn_exception_rule") {
 		fqdnExceptionRule := d.Get("fqdn_exception_rule").(bool)
 		if fqdnExceptionRule {
-			err := client.EnableExceptionRule()
+			err := client.EnableExceptionRuleContext(ctx)
 			if err != nil {
-				log.Printf("[ERROR] Failed to enable exception rule on controller %s", d.Id())
-				return err
+				tflog.Error(ctx, "Failed to enable exception rule on controller", map[string]interface{}{"id": d.Id()})
+				return diag.FromErr(err)
 			}
 		} else {
-			err := client.DisableExceptionRule()
+			err := client.DisableExceptionRuleContext(ctx)
 			if err != nil {
-				log.Printf("[ERROR] Failed to disable exception rule on controller %s", d.Id())
-				return err
+				tflog.Error(ctx, "Failed to disable exception rule on controller", map[string]interface{}{"id": d.Id()})
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -422,7 +424,7 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 		if backupConfiguration {
 			err := validateBackupConfig(d)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 
 			cloudnBackupConfiguration := &goaviatrix.CloudnBackupConfiguration{
@@ -437,21 +439,21 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 				cloudnBackupConfiguration.MultipleBackups = "true"
 			}
 
-			err = client.EnableCloudnBackupConfig(cloudnBackupConfiguration)
+			err = client.EnableCloudnBackupConfigContext(ctx, cloudnBackupConfiguration)
 			if err != nil {
-				return fmt.Errorf("failed to enable backup configuration: %s", err)
+				return diag.Errorf("failed to enable backup configuration: %s", err)
 			}
 		} else {
 			if backupCloudType != 0 || backupAccountName != "" || backupBucketName != "" || backupStorageName != "" ||
 				backupContainerName != "" || backupRegion != "" || multipleBackups {
-				return fmt.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
+				return diag.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
 					" 'backup_storage_name', 'backup_container_name' and 'backup_region' should all be empty," +
 					" 'multiple_backups' should be empty or false for not enabling backup configuration")
 			}
 
-			err := client.DisableCloudnBackupConfig()
+			err := client.DisableCloudnBackupConfigContext(ctx)
 			if err != nil {
-				return fmt.Errorf("failed to disable backup configuration: %s", err)
+				return diag.Errorf("failed to disable backup configuration: %s", err)
 			}
 		}
 	} else {
@@ -463,12 +465,12 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 			if backupConfiguration {
 				err := validateBackupConfig(d)
 				if err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 
-				err = client.DisableCloudnBackupConfig()
+				err = client.DisableCloudnBackupConfigContext(ctx)
 				if err != nil {
-					return fmt.Errorf("failed to disable backup configuration: %s", err)
+					return diag.Errorf("failed to disable backup configuration: %s", err)
 				}
 
 				cloudnBackupConfiguration := &goaviatrix.CloudnBackupConfiguration{
@@ -483,14 +485,14 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 					cloudnBackupConfiguration.MultipleBackups = "true"
 				}
 
-				err = client.EnableCloudnBackupConfig(cloudnBackupConfiguration)
+				err = client.EnableCloudnBackupConfigContext(ctx, cloudnBackupConfiguration)
 				if err != nil {
-					return fmt.Errorf("failed to enable backup configuration: %s", err)
+					return diag.Errorf("failed to enable backup configuration: %s", err)
 				}
 			} else {
 				if backupCloudType != 0 || backupAccountName != "" || backupBucketName != "" || backupStorageName != "" ||
 					backupContainerName != "" || backupRegion != "" || multipleBackups {
-					return fmt.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
+					return diag.Errorf("'backup_cloud_type', 'backup_account_name', 'backup_bucket_name'," +
 						" 'backup_storage_name', 'backup_container_name' and 'backup_region' should all be empty," +
 						" 'multiple_backups' should be empty or false for not enabling backup configuration")
 				}
@@ -500,9 +502,9 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 
 	if d.HasChange("enable_vpc_dns_server") {
 		enableVpcDnsServer := d.Get("enable_vpc_dns_server").(bool)
-		err := client.SetControllerVpcDnsServer(enableVpcDnsServer)
+		err := client.SetControllerVpcDnsServerContext(ctx, enableVpcDnsServer)
 		if err != nil {
-			return fmt.Errorf("could not toggle controller vpc dns server: %v", err)
+			return diag.Errorf("could not toggle controller vpc dns server: %v", err)
 		}
 	}
 
@@ -515,9 +517,9 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 				ServerPrivateKeyFilePath:  d.Get("server_private_key_file_path").(string),
 			}
 
-			err := client.ImportNewHTTPSCerts(certConfig)
+			err := client.ImportNewHTTPSCertsContext(ctx, certConfig)
 			if err != nil {
-				return fmt.Errorf("could not import new HTTPS certs: %v", err)
+				return diag.Errorf("could not import new HTTPS certs: %v", err)
 			}
 		} else if _, useFileContent := d.GetOk("ca_certificate_file"); useFileContent {
 			certConfig := &goaviatrix.HTTPSCertConfig{
@@ -526,61 +528,61 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta interfa
 				ServerPrivateKeyFile:  d.Get("server_private_key_file").(string),
 			}
 
-			err := client.ImportNewHTTPSCerts(certConfig)
+			err := client.ImportNewHTTPSCertsContext(ctx, certConfig)
 			if err != nil {
-				return fmt.Errorf("could not import new HTTPS certs: %v", err)
+				return diag.Errorf("could not import new HTTPS certs: %v", err)
 			}
 		}
 	}
 
 	if d.HasChange("aws_guard_duty_scanning_interval") {
 		scanningInterval := d.Get("aws_guard_duty_scanning_interval").(int)
-		err := client.UpdateAwsGuardDutyPollInterval(scanningInterval)
+		err := client.UpdateAwsGuardDutyPollIntervalContext(ctx, scanningInterval)
 		if err != nil {
-			return fmt.Errorf("could not update scanning interval: %v", err)
+			return diag.Errorf("could not update scanning interval: %v", err)
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixControllerConfigRead(d, meta)
+	return resourceAviatrixControllerConfigRead(ctx, d, meta)
 }
 
-func resourceAviatrixControllerConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	d.Set("fqdn_exception_rule", true)
-	curStatusException, _ := client.GetExceptionRuleStatus()
+	curStatusException, _ := client.GetExceptionRuleStatusContext(ctx)
 	if !curStatusException {
-		err := client.EnableExceptionRule()
+		err := client.EnableExceptionRuleContext(ctx)
 		if err != nil {
-			log.Printf("[ERROR] Failed to enable exception rule on controller %s", d.Id())
-			return err
+			tflog.Error(ctx, "Failed to enable exception rule on controller", map[string]interface{}{"id": d.Id()})
+			return diag.FromErr(err)
 		}
 	}
 
 	d.Set("backup_configuration", false)
-	cloudnBackupConfig, _ := client.GetCloudnBackupConfig()
+	cloudnBackupConfig, _ := client.GetCloudnBackupConfigContext(ctx)
 	if cloudnBackupConfig.BackupConfiguration == "yes" {
-		err := client.DisableCloudnBackupConfig()
+		err := client.DisableCloudnBackupConfigContext(ctx)
 		if err != nil {
-			log.Printf("[ERROR] Failed to disable cloudn backup config on controller %s", d.Id())
-			return err
+			tflog.Error(ctx, "Failed to disable cloudn backup config on controller", map[string]interface{}{"id": d.Id()})
+			return diag.FromErr(err)
 		}
 	}
 
-	err := client.SetControllerVpcDnsServer(false)
+	err := client.SetControllerVpcDnsServerContext(ctx, false)
 	if err != nil {
-		return fmt.Errorf("could not disable controller vpc dns server: %v", err)
+		return diag.Errorf("could not disable controller vpc dns server: %v", err)
 	}
 
-	err = client.DisableImportedHTTPSCerts()
+	err = client.DisableImportedHTTPSCertsContext(ctx)
 	if err != nil {
-		return fmt.Errorf("could not disable imported certs: %v", err)
+		return diag.Errorf("could not disable imported certs: %v", err)
 	}
 
-	err = client.UpdateAwsGuardDutyPollInterval(defaultAwsGuardDutyScanningInterval)
+	err = client.UpdateAwsGuardDutyPollIntervalContext(ctx, defaultAwsGuardDutyScanningInterval)
 	if err != nil {
-		return fmt.Errorf("could not update scanning interval: %v", err)
+		return diag.Errorf("could not update scanning interval: %v", err)
 	}
 
 	return nil
@@ -17,7 +17,7 @@ func resourceAviatrixControllerGatewayKeepaliveConfig() *schema.Resource {
 		UpdateWithoutTimeout: resourceControllerGatewayKeepaliveConfigUpdate,
 		DeleteWithoutTimeout: resourceControllerGatewayKeepaliveConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -32,7 +32,7 @@ func resourceAviatrixControllerGatewayKeepaliveConfig() *schema.Resource {
 }
 
 func resourceControllerGatewayKeepaliveConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	speed := d.Get("keepalive_speed").(string)
 	err := client.SetGatewayKeepaliveConfig(ctx, speed)
@@ -40,14 +40,14 @@ func resourceControllerGatewayKeepaliveConfigCreate(ctx context.Context, d *sche
 		return diag.Errorf("could not create Controller Gateway Keepalive Config: %v", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceControllerGatewayKeepaliveConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -61,12 +61,12 @@ func resourceControllerGatewayKeepaliveConfigRead(ctx context.Context, d *schema
 	}
 
 	d.Set("keepalive_speed", speed)
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceControllerGatewayKeepaliveConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.HasChange("keepalive_speed") {
 		speed := d.Get("keepalive_speed").(string)
@@ -80,7 +80,7 @@ func resourceControllerGatewayKeepaliveConfigUpdate(ctx context.Context, d *sche
 }
 
 func resourceControllerGatewayKeepaliveConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.SetGatewayKeepaliveConfig(ctx, "medium")
 	if err != nil {
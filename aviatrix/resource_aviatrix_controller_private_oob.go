@@ -1,22 +1,23 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixControllerPrivateOob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixControllerPrivateOobCreate,
-		Read:   resourceAviatrixControllerPrivateOobRead,
-		Update: resourceAviatrixControllerPrivateOobUpdate,
-		Delete: resourceAviatrixControllerPrivateOobDelete,
+		CreateContext: resourceAviatrixControllerPrivateOobCreate,
+		ReadContext:   resourceAviatrixControllerPrivateOobRead,
+		UpdateContext: resourceAviatrixControllerPrivateOobUpdate,
+		DeleteContext: resourceAviatrixControllerPrivateOobDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -30,60 +31,60 @@ func resourceAviatrixControllerPrivateOob() *schema.Resource {
 	}
 }
 
-func resourceAviatrixControllerPrivateOobCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerPrivateOobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	enablePrivateOob := d.Get("enable_private_oob").(bool)
 	if enablePrivateOob {
-		log.Printf("[INFO] Enabling Aviatrix controller private oob")
+		tflog.Info(ctx, "Enabling Aviatrix controller private oob")
 
-		err := client.EnablePrivateOob()
+		err := client.EnablePrivateOobContext(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to enable Aviatrix controller private oob: %s", err)
+			return diag.Errorf("failed to enable Aviatrix controller private oob: %s", err)
 		}
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
-	return resourceAviatrixControllerPrivateOobRead(d, meta)
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
+	return resourceAviatrixControllerPrivateOobRead(ctx, d, meta)
 }
 
-func resourceAviatrixControllerPrivateOobRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerPrivateOobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
-		return fmt.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
+		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
-	privateOobState, err := client.GetPrivateOobState()
+	privateOobState, err := client.GetPrivateOobStateContext(ctx)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't get private oob state: %s", err)
+		return diag.Errorf("couldn't get private oob state: %s", err)
 	}
 
 	d.Set("enable_private_oob", privateOobState)
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
-func resourceAviatrixControllerPrivateOobUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerPrivateOobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	log.Printf("[INFO] Updating Aviatrix controller private oob")
+	tflog.Info(ctx, "Updating Aviatrix controller private oob")
 
 	if d.HasChange("enable_private_oob") {
 		enablePrivateOob := d.Get("enable_private_oob").(bool)
 		if enablePrivateOob {
-			err := client.EnablePrivateOob()
+			err := client.EnablePrivateOobContext(ctx)
 			if err != nil {
-				return fmt.Errorf("failed to enable Aviatrix controller private oob: %s", err)
+				return diag.Errorf("failed to enable Aviatrix controller private oob: %s", err)
 			}
 		} else {
-			err := client.DisablePrivateOob()
+			err := client.DisablePrivateOobContext(ctx)
 			if err != nil {
-				return fmt.Errorf("failed to disable Aviatrix controller private oob: %s", err)
+				return diag.Errorf("failed to disable Aviatrix controller private oob: %s", err)
 			}
 		}
 	}
@@ -91,12 +92,12 @@ func resourceAviatrixControllerPrivateOobUpdate(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAviatrixControllerPrivateOobDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixControllerPrivateOobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	err := client.DisablePrivateOob()
+	err := client.DisablePrivateOobContext(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to disable Aviatrix controller private oob: %s", err)
+		return diag.Errorf("failed to disable Aviatrix controller private oob: %s", err)
 	}
 
 	return nil
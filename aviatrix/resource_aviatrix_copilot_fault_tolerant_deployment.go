@@ -12,13 +12,18 @@ import (
 
 func resourceAviatrixCopilotFaultTolerantDeployment() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixCopilotFaultTolerantDeploymentCreate,
-		ReadWithoutTimeout:   resourceAviatrixCopilotFaultTolerantDeploymentRead,
-		DeleteWithoutTimeout: resourceAviatrixCopilotFaultTolerantDeploymentDelete,
+		CreateContext: resourceAviatrixCopilotFaultTolerantDeploymentCreate,
+		ReadContext:   resourceAviatrixCopilotFaultTolerantDeploymentRead,
+		DeleteContext: resourceAviatrixCopilotFaultTolerantDeploymentDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cloud_type": {
 				Type:        schema.TypeInt,
@@ -150,7 +155,7 @@ func marshalCopilotFaultTolerantDeploymentInput(d *schema.ResourceData) *goaviat
 }
 
 func resourceAviatrixCopilotFaultTolerantDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	copilotFaultTolerantDeployment := marshalCopilotFaultTolerantDeploymentInput(d)
 
@@ -158,7 +163,7 @@ func resourceAviatrixCopilotFaultTolerantDeploymentCreate(ctx context.Context, d
 		return diag.Errorf("at least three cluster data nodes are required")
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	flag := false
 	defer resourceAviatrixCopilotFaultTolerantDeploymentReadIfRequired(ctx, d, meta, &flag)
 
@@ -177,11 +182,14 @@ func resourceAviatrixCopilotFaultTolerantDeploymentCreate(ctx context.Context, d
 			break
 		}
 
-		if i < 90 {
-			time.Sleep(time.Duration(20) * time.Second)
-		} else {
+		if i >= 90 {
 			return diag.Errorf("could not deploy copilot: %s", err)
 		}
+		select {
+		case <-ctx.Done():
+			return diag.Errorf("timed out waiting for copilot deployment: %v", ctx.Err())
+		case <-time.After(20 * time.Second):
+		}
 	}
 
 	return resourceAviatrixCopilotFaultTolerantDeploymentReadIfRequired(ctx, d, meta, &flag)
@@ -196,9 +204,9 @@ func resourceAviatrixCopilotFaultTolerantDeploymentReadIfRequired(ctx context.Co
 }
 
 func resourceAviatrixCopilotFaultTolerantDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -214,12 +222,12 @@ func resourceAviatrixCopilotFaultTolerantDeploymentRead(ctx context.Context, d *
 	d.Set("main_copilot_private_ip", copilotAssociationStatus.IP)
 	d.Set("main_copilot_public_ip", copilotAssociationStatus.PublicIp)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixCopilotFaultTolerantDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.DeleteCopilotFaultTolerant(ctx)
 	if err != nil {
@@ -76,11 +76,11 @@ func marshalCopilotSecurityGroupManagementConfigInput(d *schema.ResourceData) *g
 }
 
 func resourceAviatrixCopilotSecurityGroupManagementConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	copilotSecurityGroupManagementConfig := marshalCopilotSecurityGroupManagementConfigInput(d)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	flag := false
 	defer resourceAviatrixCopilotSecurityGroupManagementConfigReadIfRequired(ctx, d, meta, &flag)
 
@@ -129,9 +129,9 @@ func resourceAviatrixCopilotSecurityGroupManagementConfigReadIfRequired(ctx cont
 }
 
 func resourceAviatrixCopilotSecurityGroupManagementConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -156,12 +156,12 @@ func resourceAviatrixCopilotSecurityGroupManagementConfigRead(ctx context.Contex
 		return diag.Errorf("could not read copilot security group management config")
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixCopilotSecurityGroupManagementConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	copilotSecurityGroupManagementConfig := marshalCopilotSecurityGroupManagementConfigInput(d)
 
@@ -237,7 +237,7 @@ func resourceAviatrixCopilotSecurityGroupManagementConfigUpdate(ctx context.Cont
 }
 
 func resourceAviatrixCopilotSecurityGroupManagementConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.DisableCopilotSecurityGroupManagement(ctx)
 	if err != nil {
@@ -12,13 +12,18 @@ import (
 
 func resourceAviatrixCopilotSimpleDeployment() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixCopilotSimpleDeploymentCreate,
-		ReadWithoutTimeout:   resourceAviatrixCopilotSimpleDeploymentRead,
-		DeleteWithoutTimeout: resourceAviatrixCopilotSimpleDeploymentDelete,
+		CreateContext: resourceAviatrixCopilotSimpleDeploymentCreate,
+		ReadContext:   resourceAviatrixCopilotSimpleDeploymentRead,
+		DeleteContext: resourceAviatrixCopilotSimpleDeploymentDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cloud_type": {
 				Type:        schema.TypeInt,
@@ -108,11 +113,11 @@ func marshalCopilotSimpleDeploymentInput(d *schema.ResourceData) *goaviatrix.Cop
 }
 
 func resourceAviatrixCopilotSimpleDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	copilotSimpleDeployment := marshalCopilotSimpleDeploymentInput(d)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	flag := false
 	defer resourceAviatrixCopilotSimpleDeploymentReadIfRequired(ctx, d, meta, &flag)
 
@@ -131,11 +136,14 @@ func resourceAviatrixCopilotSimpleDeploymentCreate(ctx context.Context, d *schem
 			break
 		}
 
-		if i < 90 {
-			time.Sleep(time.Duration(20) * time.Second)
-		} else {
+		if i >= 90 {
 			return diag.Errorf("could not deploy copilot: %s", err)
 		}
+		select {
+		case <-ctx.Done():
+			return diag.Errorf("timed out waiting for copilot deployment: %v", ctx.Err())
+		case <-time.After(20 * time.Second):
+		}
 	}
 
 	return resourceAviatrixCopilotSimpleDeploymentReadIfRequired(ctx, d, meta, &flag)
@@ -150,7 +158,7 @@ func resourceAviatrixCopilotSimpleDeploymentReadIfRequired(ctx context.Context,
 }
 
 func resourceAviatrixCopilotSimpleDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	copilotAssociationStatus, err := client.GetCopilotAssociationStatus(ctx)
 	if err == goaviatrix.ErrNotFound {
@@ -164,12 +172,12 @@ func resourceAviatrixCopilotSimpleDeploymentRead(ctx context.Context, d *schema.
 	d.Set("private_ip", copilotAssociationStatus.IP)
 	d.Set("public_ip", copilotAssociationStatus.PublicIp)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixCopilotSimpleDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.DeleteCopilotSimple(ctx)
 	if err != nil {
@@ -246,9 +246,9 @@ func marshalSubPolicyListInput(subPolicyMap map[string]interface{}) (*goaviatrix
 }
 
 func resourceAviatrixDCFPolicyBlockCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("client must be of type *goaviatrix.Client")
+		return diag.Errorf("client must be of type goaviatrix.ClientInterface")
 	}
 
 	policyBlock, err := marshalDCFPolicyBlockInput(d)
@@ -267,9 +267,9 @@ func resourceAviatrixDCFPolicyBlockCreate(ctx context.Context, d *schema.Resourc
 
 //nolint:cyclop,funlen
 func resourceAviatrixDCFPolicyBlockRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("client must be of type *goaviatrix.Client")
+		return diag.Errorf("client must be of type goaviatrix.ClientInterface")
 	}
 
 	uuid := d.Id()
@@ -340,9 +340,9 @@ func resourceAviatrixDCFPolicyBlockRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixDCFPolicyBlockUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("client must be of type *goaviatrix.Client")
+		return diag.Errorf("client must be of type goaviatrix.ClientInterface")
 	}
 
 	policyBlock, err := marshalDCFPolicyBlockInput(d)
@@ -359,9 +359,9 @@ func resourceAviatrixDCFPolicyBlockUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixDCFPolicyBlockDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("client must be of type *goaviatrix.Client")
+		return diag.Errorf("client must be of type goaviatrix.ClientInterface")
 	}
 
 	uuid := d.Id()
@@ -362,9 +362,9 @@ func marshalSmartGroupsInput(policyMap map[string]interface{}, key string) ([]st
 }
 
 func resourceAviatrixDCFPolicyListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("client must be of type *goaviatrix.Client")
+		return diag.Errorf("client must be of type goaviatrix.ClientInterface")
 	}
 
 	policyList, err := marshalDCFPolicyListInput(d)
@@ -384,9 +384,9 @@ func resourceAviatrixDCFPolicyListCreate(ctx context.Context, d *schema.Resource
 
 //nolint:funlen,cyclop
 func resourceAviatrixDCFPolicyListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("client must be of type *goaviatrix.Client")
+		return diag.Errorf("client must be of type goaviatrix.ClientInterface")
 	}
 
 	uuid := d.Id()
@@ -456,9 +456,9 @@ func resourceAviatrixDCFPolicyListRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixDCFPolicyListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("client must be of type *goaviatrix.Client")
+		return diag.Errorf("client must be of type goaviatrix.ClientInterface")
 	}
 
 	policyList, err := marshalDCFPolicyListInput(d)
@@ -475,9 +475,9 @@ func resourceAviatrixDCFPolicyListUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixDCFPolicyListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("client must be of type *goaviatrix.Client")
+		return diag.Errorf("client must be of type goaviatrix.ClientInterface")
 	}
 
 	uuid := d.Id()
@@ -0,0 +1,147 @@
+package aviatrix
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAviatrixDeviceAwsTgwAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixDeviceAwsTgwAttachmentCreate,
+		ReadWithoutTimeout:   resourceAviatrixDeviceAwsTgwAttachmentRead,
+		DeleteWithoutTimeout: resourceAviatrixDeviceAwsTgwAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connection_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Connection name.",
+			},
+			"device_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Device name.",
+			},
+			"aws_tgw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "AWS TGW name.",
+			},
+			"device_bgp_asn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: goaviatrix.ValidateASN,
+				Description:  "Device BGP AS number.",
+			},
+			"network_domain_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Network domain name.",
+			},
+			"enable_global_accelerator": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Enable AWS Global Accelerator.",
+			},
+		},
+	}
+}
+
+func marshalDeviceAwsTgwAttachmentInput(d *schema.ResourceData) *goaviatrix.DeviceAwsTgwAttachment {
+	return &goaviatrix.DeviceAwsTgwAttachment{
+		ConnectionName:          d.Get("connection_name").(string),
+		DeviceName:              d.Get("device_name").(string),
+		AwsTgwName:              d.Get("aws_tgw_name").(string),
+		DeviceAsn:               d.Get("device_bgp_asn").(string),
+		SecurityDomainName:      d.Get("network_domain_name").(string),
+		EnableGlobalAccelerator: strconv.FormatBool(d.Get("enable_global_accelerator").(bool)),
+	}
+}
+
+func resourceAviatrixDeviceAwsTgwAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	attachment := marshalDeviceAwsTgwAttachmentInput(d)
+
+	tflog.Info(ctx, "Creating Aviatrix device AWS TGW attachment", map[string]interface{}{"connection_name": attachment.ConnectionName})
+
+	if err := client.CreateDeviceAwsTgwAttachmentContext(ctx, attachment); err != nil {
+		return diag.Errorf("could not create device AWS TGW attachment: %v", err)
+	}
+
+	d.SetId(attachment.ID())
+	return resourceAviatrixDeviceAwsTgwAttachmentRead(ctx, d, meta)
+}
+
+func resourceAviatrixDeviceAwsTgwAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	connectionName := d.Get("connection_name").(string)
+	if connectionName == "" {
+		id := d.Id()
+		tflog.Debug(ctx, "Looks like an import, no 'connection_name' received", map[string]interface{}{"id": id})
+		parts := strings.Split(id, "~")
+		if len(parts) != 3 {
+			return diag.Errorf("invalid ID %q, expected ID in the form 'connection_name~device_name~aws_tgw_name'", id)
+		}
+		d.Set("connection_name", parts[0])
+		d.Set("device_name", parts[1])
+		d.Set("aws_tgw_name", parts[2])
+		d.SetId(id)
+	}
+
+	attachment := &goaviatrix.DeviceAwsTgwAttachment{
+		ConnectionName: d.Get("connection_name").(string),
+		DeviceName:     d.Get("device_name").(string),
+		AwsTgwName:     d.Get("aws_tgw_name").(string),
+	}
+
+	attachment, err := client.GetDeviceAwsTgwAttachmentContext(ctx, attachment)
+	if err == goaviatrix.ErrNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("could not find device AWS TGW attachment: %v", err)
+	}
+
+	d.Set("connection_name", attachment.ConnectionName)
+	d.Set("device_name", attachment.DeviceName)
+	d.Set("aws_tgw_name", attachment.AwsTgwName)
+	d.Set("device_bgp_asn", attachment.DeviceAsn)
+	d.Set("network_domain_name", attachment.SecurityDomainName)
+	d.Set("enable_global_accelerator", attachment.EnableGlobalAccelerator == "true")
+
+	d.SetId(attachment.ID())
+	return nil
+}
+
+func resourceAviatrixDeviceAwsTgwAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	connectionName := d.Get("connection_name").(string)
+
+	tflog.Info(ctx, "Deleting Aviatrix device AWS TGW attachment", map[string]interface{}{"connection_name": connectionName})
+
+	if err := client.DeleteDeviceAttachmentContext(ctx, connectionName); err != nil {
+		return diag.Errorf("could not delete device AWS TGW attachment: %v", err)
+	}
+
+	return nil
+}
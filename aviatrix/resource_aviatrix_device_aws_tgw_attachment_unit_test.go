@@ -0,0 +1,104 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// newDeviceAwsTgwAttachmentClientMock returns a client keeping the
+// attachments in attachments by connection name.
+func newDeviceAwsTgwAttachmentClientMock(attachments map[string]*goaviatrix.DeviceAwsTgwAttachment) *goaviatrix.ClientInterfaceMock {
+	return &goaviatrix.ClientInterfaceMock{
+		CreateDeviceAwsTgwAttachmentContextFunc: func(ctx context.Context, attachment *goaviatrix.DeviceAwsTgwAttachment) error {
+			a := *attachment
+			attachments[a.ConnectionName] = &a
+			return nil
+		},
+		GetDeviceAwsTgwAttachmentContextFunc: func(ctx context.Context, tgwAttachment *goaviatrix.DeviceAwsTgwAttachment) (*goaviatrix.DeviceAwsTgwAttachment, error) {
+			a, ok := attachments[tgwAttachment.ConnectionName]
+			if !ok {
+				return nil, goaviatrix.ErrNotFound
+			}
+			return a, nil
+		},
+	}
+}
+
+func TestResourceAviatrixDeviceAwsTgwAttachmentCreate(t *testing.T) {
+	attachments := map[string]*goaviatrix.DeviceAwsTgwAttachment{}
+	client := newDeviceAwsTgwAttachmentClientMock(attachments)
+	d := schema.TestResourceDataRaw(t, resourceAviatrixDeviceAwsTgwAttachment().Schema, map[string]interface{}{
+		"connection_name":           "conn",
+		"device_name":               "cloudn-1",
+		"aws_tgw_name":              "tgw",
+		"device_bgp_asn":            "65001",
+		"network_domain_name":       "prod",
+		"enable_global_accelerator": true,
+	})
+
+	diags := resourceAviatrixDeviceAwsTgwAttachmentCreate(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "conn~cloudn-1~tgw", d.Id())
+	if assert.Len(t, client.CreateDeviceAwsTgwAttachmentContextCalls(), 1) {
+		assert.Equal(t, &goaviatrix.DeviceAwsTgwAttachment{
+			ConnectionName:          "conn",
+			DeviceName:              "cloudn-1",
+			AwsTgwName:              "tgw",
+			DeviceAsn:               "65001",
+			SecurityDomainName:      "prod",
+			EnableGlobalAccelerator: "true",
+		}, client.CreateDeviceAwsTgwAttachmentContextCalls()[0].Attachment)
+	}
+	assert.Equal(t, true, d.Get("enable_global_accelerator"))
+}
+
+func TestResourceAviatrixDeviceAwsTgwAttachmentRead(t *testing.T) {
+	attachments := map[string]*goaviatrix.DeviceAwsTgwAttachment{
+		"conn": {
+			ConnectionName:          "conn",
+			DeviceName:              "cloudn-1",
+			AwsTgwName:              "tgw",
+			DeviceAsn:               "65001",
+			SecurityDomainName:      "prod",
+			EnableGlobalAccelerator: "false",
+		},
+	}
+
+	t.Run("import", func(t *testing.T) {
+		d := resourceAviatrixDeviceAwsTgwAttachment().TestResourceData()
+		d.SetId("conn~cloudn-1~tgw")
+
+		diags := resourceAviatrixDeviceAwsTgwAttachmentRead(context.Background(), d, newDeviceAwsTgwAttachmentClientMock(attachments))
+
+		assert.Empty(t, diags)
+		assert.Equal(t, "cloudn-1", d.Get("device_name"))
+		assert.Equal(t, "65001", d.Get("device_bgp_asn"))
+		assert.Equal(t, "prod", d.Get("network_domain_name"))
+		assert.Equal(t, false, d.Get("enable_global_accelerator"))
+	})
+
+	t.Run("invalid import ID", func(t *testing.T) {
+		d := resourceAviatrixDeviceAwsTgwAttachment().TestResourceData()
+		d.SetId("conn~cloudn-1")
+
+		diags := resourceAviatrixDeviceAwsTgwAttachmentRead(context.Background(), d, newDeviceAwsTgwAttachmentClientMock(attachments))
+
+		assert.Equal(t, diag.Errorf("invalid ID %q, expected ID in the form 'connection_name~device_name~aws_tgw_name'", "conn~cloudn-1"), diags)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		d := resourceAviatrixDeviceAwsTgwAttachment().TestResourceData()
+		d.SetId("other~cloudn-1~tgw")
+
+		diags := resourceAviatrixDeviceAwsTgwAttachmentRead(context.Background(), d, newDeviceAwsTgwAttachmentClientMock(attachments))
+
+		assert.Empty(t, diags)
+		assert.Equal(t, "", d.Id())
+	})
+}
@@ -1,22 +1,23 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAviatrixDeviceInterfaceConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixDeviceInterfaceConfigCreate,
-		Read:   resourceAviatrixDeviceInterfaceConfigRead,
-		Update: resourceAviatrixDeviceInterfaceConfigUpdate,
-		Delete: resourceAviatrixDeviceInterfaceConfigDelete,
+		CreateContext: resourceAviatrixDeviceInterfaceConfigCreate,
+		ReadContext:   resourceAviatrixDeviceInterfaceConfigRead,
+		UpdateContext: resourceAviatrixDeviceInterfaceConfigUpdate,
+		DeleteContext: resourceAviatrixDeviceInterfaceConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -49,48 +50,48 @@ func marshalDeviceInterfaceConfigInput(d *schema.ResourceData) *goaviatrix.Devic
 	}
 }
 
-func resourceAviatrixDeviceInterfaceConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixDeviceInterfaceConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	config := marshalDeviceInterfaceConfigInput(d)
 
 	d.SetId(config.DeviceName)
 	flag := false
-	defer resourceAviatrixDeviceInterfaceConfigReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixDeviceInterfaceConfigReadIfRequired(ctx, d, meta, &flag)
 
-	if err := client.ConfigureDeviceInterfaces(config); err != nil {
-		return fmt.Errorf("could not configure device interfaces: %v", err)
+	if err := client.ConfigureDeviceInterfacesContext(ctx, config); err != nil {
+		return diag.Errorf("could not configure device interfaces: %v", err)
 	}
 
-	return resourceAviatrixDeviceInterfaceConfigReadIfRequired(d, meta, &flag)
+	return resourceAviatrixDeviceInterfaceConfigReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixDeviceInterfaceConfigReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixDeviceInterfaceConfigReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixDeviceInterfaceConfigRead(d, meta)
+		return resourceAviatrixDeviceInterfaceConfigRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixDeviceInterfaceConfigRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixDeviceInterfaceConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	name := d.Get("device_name").(string)
 	if name == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no device_interface_config device_name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no device_interface_config device_name received", map[string]interface{}{"id": id})
 		d.SetId(id)
 		name = id
 	}
 
-	device, err := client.GetDevice(&goaviatrix.Device{Name: name})
+	device, err := client.GetDeviceContext(ctx, &goaviatrix.Device{Name: name})
 	if err == goaviatrix.ErrNotFound {
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not find device_interface_config %s: %v", name, err)
+		return diag.Errorf("could not find device_interface_config %s: %v", name, err)
 	}
 
 	d.Set("device_name", name)
@@ -101,20 +102,20 @@ func resourceAviatrixDeviceInterfaceConfigRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAviatrixDeviceInterfaceConfigUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixDeviceInterfaceConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	config := marshalDeviceInterfaceConfigInput(d)
 
-	if err := client.ConfigureDeviceInterfaces(config); err != nil {
-		return fmt.Errorf("could not reconfigure device interfaces: %v", err)
+	if err := client.ConfigureDeviceInterfacesContext(ctx, config); err != nil {
+		return diag.Errorf("could not reconfigure device interfaces: %v", err)
 	}
 
 	d.SetId(config.DeviceName)
-	return resourceAviatrixDeviceInterfaceConfigRead(d, meta)
+	return resourceAviatrixDeviceInterfaceConfigRead(ctx, d, meta)
 }
 
-func resourceAviatrixDeviceInterfaceConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAviatrixDeviceInterfaceConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// This is intentionally left empty.
 	// There is no way to unconfigure/delete the WAN interface of a device.
 	// Due to backend design the ability to unconfigure/delete can not be added.
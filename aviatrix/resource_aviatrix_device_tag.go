@@ -0,0 +1,155 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAviatrixDeviceTag() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixDeviceTagCreate,
+		ReadWithoutTimeout:   resourceAviatrixDeviceTagRead,
+		UpdateWithoutTimeout: resourceAviatrixDeviceTagUpdate,
+		DeleteWithoutTimeout: resourceAviatrixDeviceTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the tag.",
+			},
+			"config": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Config to apply to the devices attached to the tag.",
+			},
+			"device_names": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the devices to attach to the tag.",
+			},
+		},
+	}
+}
+
+func marshalDeviceTagInput(d *schema.ResourceData) *goaviatrix.DeviceTag {
+	return &goaviatrix.DeviceTag{
+		Name:    d.Get("name").(string),
+		Config:  d.Get("config").(string),
+		Devices: getStringSet(d, "device_names"),
+	}
+}
+
+func resourceAviatrixDeviceTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	deviceTag := marshalDeviceTagInput(d)
+
+	tflog.Info(ctx, "Creating Aviatrix device tag", map[string]interface{}{"name": deviceTag.Name})
+
+	d.SetId(deviceTag.Name)
+	flag := false
+	defer resourceAviatrixDeviceTagReadIfRequired(ctx, d, meta, &flag)
+
+	// CreateDeviceTag also sets the config, attaches the devices and commits
+	// the config to them.
+	if err := client.CreateDeviceTagContext(ctx, deviceTag); err != nil {
+		return diag.Errorf("failed to create device tag: %v", err)
+	}
+
+	return resourceAviatrixDeviceTagReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixDeviceTagReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixDeviceTagRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixDeviceTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	name := d.Get("name").(string)
+	if name == "" {
+		id := d.Id()
+		tflog.Debug(ctx, "Looks like an import, no device tag name received", map[string]interface{}{"id": id})
+		d.Set("name", id)
+		d.SetId(id)
+		name = id
+	}
+
+	deviceTag, err := client.GetDeviceTagContext(ctx, &goaviatrix.DeviceTag{Name: name})
+	if err == goaviatrix.ErrNotFound {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("could not find device tag %s: %v", name, err)
+	}
+
+	d.Set("name", deviceTag.Name)
+	d.Set("config", deviceTag.Config)
+	if err := d.Set("device_names", deviceTag.Devices); err != nil {
+		return diag.Errorf("failed to set device_names for device tag: %v", err)
+	}
+
+	d.SetId(deviceTag.Name)
+	return nil
+}
+
+func resourceAviatrixDeviceTagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	deviceTag := marshalDeviceTagInput(d)
+
+	if d.HasChange("config") {
+		if err := client.UpdateDeviceTagConfigContext(ctx, deviceTag); err != nil {
+			return diag.Errorf("could not update config for device tag: %v", err)
+		}
+	}
+
+	if d.HasChange("device_names") {
+		if err := client.AttachDeviceTagContext(ctx, deviceTag); err != nil {
+			return diag.Errorf("could not update devices attached to device tag: %v", err)
+		}
+	}
+
+	// Changes only take effect on the devices once committed.
+	if d.HasChanges("config", "device_names") {
+		if err := client.CommitDeviceTagContext(ctx, deviceTag); err != nil {
+			return diag.Errorf("could not commit device tag to devices: %v", err)
+		}
+	}
+
+	return resourceAviatrixDeviceTagRead(ctx, d, meta)
+}
+
+func resourceAviatrixDeviceTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	deviceTag := &goaviatrix.DeviceTag{
+		Name: d.Get("name").(string),
+	}
+
+	tflog.Info(ctx, "Deleting Aviatrix device tag", map[string]interface{}{"name": deviceTag.Name})
+
+	if err := client.DeleteDeviceTagContext(ctx, deviceTag); err != nil {
+		return diag.Errorf("failed to delete device tag: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixDeviceTag_basic(t *testing.T) {
+	if os.Getenv("SKIP_DEVICE_TAG") == "yes" {
+		t.Skip("Skipping device tag test as SKIP_DEVICE_TAG is set")
+	}
+
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_device_tag.test_device_tag"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAviatrixDeviceTagPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDeviceTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceTagBasic(rName, "hostname test-device"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "device-tag-"+rName),
+					resource.TestCheckResourceAttr(resourceName, "config", "hostname test-device"),
+				),
+			},
+			{
+				Config: testAccDeviceTagBasic(rName, "hostname test-device-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeviceTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config", "hostname test-device-updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDeviceTagBasic(rName, config string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_device_tag" "test_device_tag" {
+	name         = "device-tag-%s"
+	config       = "%s"
+	device_names = ["%s"]
+}
+`, rName, config, os.Getenv("CLOUDN_DEVICE_NAME"))
+}
+
+func testAccCheckDeviceTagExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("device_tag Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no device_tag ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		deviceTag, err := client.GetDeviceTag(&goaviatrix.DeviceTag{Name: rs.Primary.Attributes["name"]})
+		if err != nil {
+			return err
+		}
+		if deviceTag.Name != rs.Primary.ID {
+			return fmt.Errorf("device_tag not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeviceTagDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_device_tag" {
+			continue
+		}
+		_, err := client.GetDeviceTag(&goaviatrix.DeviceTag{Name: rs.Primary.Attributes["name"]})
+		if err != goaviatrix.ErrNotFound {
+			return fmt.Errorf("device_tag still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccAviatrixDeviceTagPreCheck(t *testing.T) {
+	if os.Getenv("CLOUDN_DEVICE_NAME") == "" {
+		t.Fatal("CLOUDN_DEVICE_NAME must be set for aviatrix_device_tag acceptance test.")
+	}
+}
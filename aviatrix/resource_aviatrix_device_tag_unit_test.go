@@ -0,0 +1,146 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// newDeviceTagClientMock returns a client keeping the device tags in tags.
+func newDeviceTagClientMock(tags map[string]*goaviatrix.DeviceTag) *goaviatrix.ClientInterfaceMock {
+	return &goaviatrix.ClientInterfaceMock{
+		CreateDeviceTagContextFunc: func(ctx context.Context, deviceTag *goaviatrix.DeviceTag) error {
+			tag := *deviceTag
+			tags[tag.Name] = &tag
+			return nil
+		},
+		GetDeviceTagContextFunc: func(ctx context.Context, brt *goaviatrix.DeviceTag) (*goaviatrix.DeviceTag, error) {
+			tag, ok := tags[brt.Name]
+			if !ok {
+				return nil, goaviatrix.ErrNotFound
+			}
+			return tag, nil
+		},
+		UpdateDeviceTagConfigContextFunc: func(ctx context.Context, brt *goaviatrix.DeviceTag) error {
+			tags[brt.Name].Config = brt.Config
+			return nil
+		},
+		AttachDeviceTagContextFunc: func(ctx context.Context, brt *goaviatrix.DeviceTag) error {
+			tags[brt.Name].Devices = brt.Devices
+			return nil
+		},
+		CommitDeviceTagContextFunc: func(ctx context.Context, brt *goaviatrix.DeviceTag) error { return nil },
+		DeleteDeviceTagContextFunc: func(ctx context.Context, brt *goaviatrix.DeviceTag) error {
+			delete(tags, brt.Name)
+			return nil
+		},
+	}
+}
+
+func TestResourceAviatrixDeviceTagCreate(t *testing.T) {
+	tags := map[string]*goaviatrix.DeviceTag{}
+	client := newDeviceTagClientMock(tags)
+	d := schema.TestResourceDataRaw(t, resourceAviatrixDeviceTag().Schema, map[string]interface{}{
+		"name":         "branch",
+		"config":       "hostname branch",
+		"device_names": []interface{}{"cloudn-1", "cloudn-2"},
+	})
+
+	diags := resourceAviatrixDeviceTagCreate(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "branch", d.Id())
+	if assert.Len(t, client.CreateDeviceTagContextCalls(), 1) {
+		assert.ElementsMatch(t, []string{"cloudn-1", "cloudn-2"}, client.CreateDeviceTagContextCalls()[0].DeviceTag.Devices)
+	}
+	assert.Len(t, client.GetDeviceTagContextCalls(), 1)
+	assert.Equal(t, "hostname branch", d.Get("config"))
+	assert.Equal(t, 2, d.Get("device_names").(*schema.Set).Len())
+}
+
+func TestResourceAviatrixDeviceTagCreate_WhenCreateFails(t *testing.T) {
+	client := newDeviceTagClientMock(map[string]*goaviatrix.DeviceTag{})
+	client.CreateDeviceTagContextFunc = func(ctx context.Context, deviceTag *goaviatrix.DeviceTag) error { return errors.New("boom") }
+	d := schema.TestResourceDataRaw(t, resourceAviatrixDeviceTag().Schema, map[string]interface{}{
+		"name":         "branch",
+		"config":       "hostname branch",
+		"device_names": []interface{}{"cloudn-1"},
+	})
+
+	diags := resourceAviatrixDeviceTagCreate(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("failed to create device tag: boom"), diags)
+}
+
+func TestResourceAviatrixDeviceTagRead(t *testing.T) {
+	tags := map[string]*goaviatrix.DeviceTag{
+		"branch": {Name: "branch", Config: "hostname branch", Devices: []string{"cloudn-1"}},
+	}
+
+	t.Run("import", func(t *testing.T) {
+		d := resourceAviatrixDeviceTag().TestResourceData()
+		d.SetId("branch")
+
+		diags := resourceAviatrixDeviceTagRead(context.Background(), d, newDeviceTagClientMock(tags))
+
+		assert.Empty(t, diags)
+		assert.Equal(t, "branch", d.Get("name"))
+		assert.Equal(t, "hostname branch", d.Get("config"))
+		assert.Equal(t, []interface{}{"cloudn-1"}, d.Get("device_names").(*schema.Set).List())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		d := resourceAviatrixDeviceTag().TestResourceData()
+		d.SetId("missing")
+
+		diags := resourceAviatrixDeviceTagRead(context.Background(), d, newDeviceTagClientMock(tags))
+
+		assert.Empty(t, diags)
+		assert.Equal(t, "", d.Id())
+	})
+}
+
+func TestResourceAviatrixDeviceTagUpdate(t *testing.T) {
+	r := resourceAviatrixDeviceTag()
+	state := &terraform.InstanceState{
+		ID: "branch",
+		Attributes: map[string]string{
+			"id":             "branch",
+			"name":           "branch",
+			"config":         "hostname branch",
+			"device_names.#": "1",
+			"device_names.0": "cloudn-1",
+		},
+	}
+	config := map[string]interface{}{
+		"name":         "branch",
+		"config":       "hostname branch",
+		"device_names": []interface{}{"cloudn-1", "cloudn-2"},
+	}
+	diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("could not diff resource: %v", err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("could not build resource data: %v", err)
+	}
+	tags := map[string]*goaviatrix.DeviceTag{
+		"branch": {Name: "branch", Config: "hostname branch", Devices: []string{"cloudn-1"}},
+	}
+	client := newDeviceTagClientMock(tags)
+
+	diags := resourceAviatrixDeviceTagUpdate(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Empty(t, client.UpdateDeviceTagConfigContextCalls())
+	assert.Len(t, client.AttachDeviceTagContextCalls(), 1)
+	assert.Len(t, client.CommitDeviceTagContextCalls(), 1)
+	assert.ElementsMatch(t, []string{"cloudn-1", "cloudn-2"}, tags["branch"].Devices)
+}
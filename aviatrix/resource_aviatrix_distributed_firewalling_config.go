@@ -31,7 +31,7 @@ func resourceAviatrixDistributedFirewallingConfig() *schema.Resource {
 }
 
 func resourceAviatrixDistributedFirewallingConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	enableDFW := d.Get("enable_distributed_firewalling").(bool)
 	if enableDFW {
@@ -46,14 +46,14 @@ func resourceAviatrixDistributedFirewallingConfigCreate(ctx context.Context, d *
 		}
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixDistributedFirewallingConfigRead(ctx, d, meta)
 }
 
 func resourceAviatrixDistributedFirewallingConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -63,12 +63,12 @@ func resourceAviatrixDistributedFirewallingConfigRead(ctx context.Context, d *sc
 	}
 	d.Set("enable_distributed_firewalling", distributedFirewalling.EnableDistributedFirewalling)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.HasChange("enable_distributed_firewalling") {
 		distributedFirewalling := d.Get("enable_distributed_firewalling").(bool)
@@ -90,7 +90,7 @@ func resourceAviatrixDistributedFirewallingConfigUpdate(ctx context.Context, d *
 }
 
 func resourceAviatrixDistributedFirewallingConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.DisableDistributedFirewalling(ctx)
 	if err != nil {
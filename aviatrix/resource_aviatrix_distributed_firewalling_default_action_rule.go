@@ -40,9 +40,9 @@ func resourceAviatrixDistributedFirewallingDefaultActionRule() *schema.Resource
 }
 
 func resourceAviatrixDistributedFirewallingDefaultActionRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
 	action, ok := d.Get("action").(string)
@@ -64,14 +64,14 @@ func resourceAviatrixDistributedFirewallingDefaultActionRuleUpdate(ctx context.C
 		return diag.Errorf("failed to update the default action rule: %v", err)
 	}
 
-	d.SetId(strings.ReplaceAll(client.ControllerIP, ".", "-"))
+	d.SetId(strings.ReplaceAll(client.GetControllerIP(), ".", "-"))
 	return resourceAviatrixDistributedFirewallingDefaultActionRuleRead(ctx, d, meta)
 }
 
 func resourceAviatrixDistributedFirewallingDefaultActionRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
 	action, ok := d.Get("action").(string)
@@ -93,19 +93,19 @@ func resourceAviatrixDistributedFirewallingDefaultActionRuleCreate(ctx context.C
 		return diag.Errorf("failed to update the default action rule: %v", err)
 	}
 
-	d.SetId(strings.ReplaceAll(client.ControllerIP, ".", "-"))
+	d.SetId(strings.ReplaceAll(client.GetControllerIP(), ".", "-"))
 
 	return resourceAviatrixDistributedFirewallingDefaultActionRuleRead(ctx, d, meta)
 }
 
 func resourceAviatrixDistributedFirewallingDefaultActionRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
-	if d.Id() != strings.ReplaceAll(client.ControllerIP, ".", "-") {
-		return diag.Errorf("ID: %s does not match controller IP %q: please provide correct ID for importing", d.Id(), client.ControllerIP)
+	if d.Id() != strings.ReplaceAll(client.GetControllerIP(), ".", "-") {
+		return diag.Errorf("ID: %s does not match controller IP %q: please provide correct ID for importing", d.Id(), client.GetControllerIP())
 	}
 
 	defaultActionRule, err := client.GetDistributedFirewallingDefaultActionRule(ctx)
@@ -125,14 +125,14 @@ func resourceAviatrixDistributedFirewallingDefaultActionRuleRead(ctx context.Con
 		return diag.Errorf("failed to set 'logging': %v", err)
 	}
 
-	d.SetId(strings.ReplaceAll(client.ControllerIP, ".", "-"))
+	d.SetId(strings.ReplaceAll(client.GetControllerIP(), ".", "-"))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingDefaultActionRuleDelete(ctx context.Context, _ *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
 	defaultActionRuleConfig := &goaviatrix.DistributedFirewallingDefaultActionRule{
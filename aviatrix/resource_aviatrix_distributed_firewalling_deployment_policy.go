@@ -44,9 +44,9 @@ func resourceAviatrixDistributedFirewallingDeploymentPolicy() *schema.Resource {
 
 func resourceAviatrixDistributedFirewallingDeploymentPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 	setDefaults, ok := d.Get("set_defaults").(bool)
 	if !ok {
@@ -81,7 +81,7 @@ func resourceAviatrixDistributedFirewallingDeploymentPolicyCreate(ctx context.Co
 		return diag.Errorf("failed to create Aviatrix Distributed Firewalling Deployment Policy: %v", err)
 	}
 
-	d.SetId(strings.ReplaceAll(client.ControllerIP, ".", "-"))
+	d.SetId(strings.ReplaceAll(client.GetControllerIP(), ".", "-"))
 	return append(diags, resourceAviatrixDistributedFirewallingDeploymentPolicyRead(ctx, d, meta)...)
 }
 
@@ -100,14 +100,14 @@ func validateDeploymentPolicyInput(providers *schema.Set, setDefaults bool) (dia
 }
 
 func resourceAviatrixDistributedFirewallingDeploymentPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
-	if d.Id() != strings.ReplaceAll(client.ControllerIP, ".", "-") {
-		return diag.Errorf("ID: %s does not match controller IP %q: please provide correct ID for importing", d.Id(), client.ControllerIP)
+	if d.Id() != strings.ReplaceAll(client.GetControllerIP(), ".", "-") {
+		return diag.Errorf("ID: %s does not match controller IP %q: please provide correct ID for importing", d.Id(), client.GetControllerIP())
 	}
 
 	deploymentPolicy, err := client.GetDistributedFirewallingDeploymentPolicy(ctx)
@@ -127,14 +127,14 @@ func resourceAviatrixDistributedFirewallingDeploymentPolicyRead(ctx context.Cont
 		return diag.Errorf("failed to set 'set_defaults': %v", err)
 	}
 
-	d.SetId(strings.ReplaceAll(client.ControllerIP, ".", "-"))
+	d.SetId(strings.ReplaceAll(client.GetControllerIP(), ".", "-"))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingDeploymentPolicyDelete(ctx context.Context, _ *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok {
-		return diag.Errorf("failed to assert meta as *goaviatrix.Client")
+		return diag.Errorf("failed to assert meta as goaviatrix.ClientInterface")
 	}
 
 	// These dummy values are required but will be ignored by the API when SetDefaults=true
@@ -34,7 +34,7 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfig() *schema
 }
 
 func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	enforcementLevel := &goaviatrix.EnforcementLevel{
 		Level: d.Get("enforcement_level").(string),
@@ -48,7 +48,7 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigCreate(ctx
 		return diag.Errorf("failed to config Distributed-firewalling origin cert enforcement level: %s", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigReadIfRequired(ctx, d, meta, &flag)
 }
 
@@ -61,9 +61,9 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigReadIfRequ
 }
 
 func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -84,12 +84,12 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigRead(ctx c
 		d.Set("enforcement_level", "Permissive")
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	d.Partial(true)
 	if d.HasChange("enforcement_level") {
@@ -108,7 +108,7 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigUpdate(ctx
 }
 
 func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.DeleteEnforcementLevel(ctx)
 	if err != nil {
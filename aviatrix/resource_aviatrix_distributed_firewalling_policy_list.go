@@ -244,7 +244,7 @@ func marshalDistributedFirewallingPolicyListInput(d *schema.ResourceData) (*goav
 }
 
 func resourceAviatrixDistributedFirewallingPolicyListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	policyList, err := marshalDistributedFirewallingPolicyListInput(d)
 	if err != nil {
@@ -258,7 +258,7 @@ func resourceAviatrixDistributedFirewallingPolicyListCreate(ctx context.Context,
 	if err != nil {
 		return diag.Errorf("failed to create Distributed-firewalling Policy List: %s", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixDistributedFirewallingPolicyListReadIfRequired(ctx, d, meta, &flag)
 }
 
@@ -271,7 +271,7 @@ func resourceAviatrixDistributedFirewallingPolicyListReadIfRequired(ctx context.
 }
 
 func resourceAviatrixDistributedFirewallingPolicyListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	policyList, err := client.GetDistributedFirewallingPolicyList(ctx)
 	if err != nil {
@@ -328,12 +328,12 @@ func resourceAviatrixDistributedFirewallingPolicyListRead(ctx context.Context, d
 		return diag.Errorf("failed to set policies during Distributed-firewalling Policy List read: %s\n", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingPolicyListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	d.Partial(true)
 	if d.HasChange("policies") {
@@ -352,7 +352,7 @@ func resourceAviatrixDistributedFirewallingPolicyListUpdate(ctx context.Context,
 }
 
 func resourceAviatrixDistributedFirewallingPolicyListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.DeleteDistributedFirewallingPolicyList(ctx)
 	if err != nil {
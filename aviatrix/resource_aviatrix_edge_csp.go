@@ -2,11 +2,10 @@ package aviatrix
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,15 +16,21 @@ import (
 
 func resourceAviatrixEdgeCSP() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeCSPCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeCSPRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeCSPUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeCSPDelete,
+		CreateContext: resourceAviatrixEdgeCSPCreate,
+		ReadContext:   resourceAviatrixEdgeCSPRead,
+		UpdateContext: resourceAviatrixEdgeCSPUpdate,
+		DeleteContext: resourceAviatrixEdgeCSPDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
+		Schema: edgeGatewaySchema(map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -62,158 +67,6 @@ func resourceAviatrixEdgeCSP() *schema.Resource {
 				ForceNew:    true,
 				Description: "Edge CSP template UUID.",
 			},
-			"management_egress_ip_prefix_list": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "Set of management egress gateway IP/prefix.",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-			"enable_management_over_private_network": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     false,
-				Description: "Enable management over private network.",
-			},
-			"dns_server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "DNS server IP.",
-				ValidateFunc: validation.IsIPAddress,
-				Deprecated:   "DNS server ip attribute will be removed in the future release.",
-			},
-			"secondary_dns_server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "Secondary DNS server IP.",
-				ValidateFunc: validation.IsIPAddress,
-				Deprecated:   "Secondary DNS server ip attribute will be removed in the future release.",
-			},
-			"local_as_number": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "Local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
-			},
-			"prepend_as_path": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
-				},
-				MaxItems: 25,
-			},
-			"enable_edge_active_standby": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enables Edge Active-Standby Mode.",
-			},
-			"enable_edge_active_standby_preemptive": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enables Preemptive Mode for Edge Active-Standby, available only with Active-Standby enabled.",
-			},
-			"enable_learned_cidrs_approval": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Switch to enable/disable learned CIDR approval for BGP Spoke Gateway. Valid values: true, false.",
-			},
-			"approved_learned_cidrs": {
-				Type: schema.TypeSet,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.IsCIDR,
-				},
-				Optional:    true,
-				Description: "Approved learned CIDRs for BGP Spoke Gateway.",
-			},
-			"spoke_bgp_manual_advertise_cidrs": {
-				Type: schema.TypeSet,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-				Optional:    true,
-				Description: "Intended CIDR list to be advertised to external BGP router.",
-			},
-			"enable_preserve_as_path": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable preserve as path when advertising manual summary CIDRs on BGP spoke gateway.",
-			},
-			"bgp_polling_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpPollingTime,
-				ValidateFunc: validation.IntBetween(10, 50),
-				Description:  "BGP route polling time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 10 and 50.",
-			},
-			"bgp_neighbor_status_polling_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpNeighborStatusPollingTime,
-				ValidateFunc: validation.IntBetween(1, 10),
-				Description:  "BGP neighbor status polling time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 1 and 10.",
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return old == "0"
-				},
-			},
-			"bgp_hold_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpHoldTime,
-				ValidateFunc: validation.IntBetween(12, 360),
-				Description:  "BGP Hold Time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 12 and 360.",
-			},
-			"enable_edge_transitive_routing": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable Edge transitive routing.",
-			},
-			"enable_jumbo_frame": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable jumbo frame.",
-			},
-			"latitude": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
-				Description:      "The latitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
-			},
-			"longitude": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
-				Description:      "The longitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
-			},
-			"rx_queue_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"1K", "2K", "4K"}, false),
-				Description:  "Ethernet interface RX queue size.",
-			},
-			"state": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "State of Edge as a Spoke.",
-			},
 			"wan_interface_names": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -367,19 +220,13 @@ func resourceAviatrixEdgeCSP() *schema.Resource {
 				Description: "DNS profile to be associated with gateway, select an existing template.",
 				Deprecated:  "DNS profile support has been removed.",
 			},
-			"enable_single_ip_snat": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable Single IP SNAT.",
-			},
 			"enable_auto_advertise_lan_cidrs": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
 				Description: "Enable auto advertise LAN CIDRs.",
 			},
-		},
+		}),
 		DeprecationMessage: "Since V3.1.1+, please use resource aviatrix_edge_zededa instead. Resource " +
 			"aviatrix_edge_csp will be deprecated in the V3.2.0 release.",
 	}
@@ -468,33 +315,58 @@ func marshalEdgeCSPInput(d *schema.ResourceData) *goaviatrix.EdgeCSP {
 	return edgeCSP
 }
 
+// edgeCSPPlatform connects the shared edge gateway logic to the Edge CSP API.
+func edgeCSPPlatform(client goaviatrix.ClientInterface, edgeCSP *goaviatrix.EdgeCSP) *edgeGatewayPlatform {
+	return &edgeGatewayPlatform{
+		name: "Edge CSP",
+		update: func(ctx context.Context) error {
+			return client.UpdateEdgeCSP(ctx, edgeCSP)
+		},
+		updateAttributes: []string{"management_egress_ip_prefix_list", "interfaces", "vlan",
+			"enable_auto_advertise_lan_cidrs", "enable_edge_active_standby", "enable_edge_active_standby_preemptive"},
+		updateAfterCreate: edgeCSP.EnableAutoAdvertiseLanCidrs == "disable",
+	}
+}
+
+// edgeCSPState returns the shared edge gateway attributes of an Edge CSP.
+func edgeCSPState(edgeCSPResp *goaviatrix.EdgeCSPResp) *edgeGatewayState {
+	return &edgeGatewayState{
+		GwName:                             edgeCSPResp.GwName,
+		ManagementEgressIpPrefix:           edgeCSPResp.ManagementEgressIpPrefix,
+		EnableManagementOverPrivateNetwork: edgeCSPResp.EnableManagementOverPrivateNetwork,
+		DnsServerIp:                        edgeCSPResp.DnsServerIp,
+		SecondaryDnsServerIp:               edgeCSPResp.SecondaryDnsServerIp,
+		LocalAsNumber:                      edgeCSPResp.LocalAsNumber,
+		PrependAsPath:                      edgeCSPResp.PrependAsPath,
+		EnableEdgeActiveStandby:            edgeCSPResp.EnableEdgeActiveStandby,
+		EnableEdgeActiveStandbyPreemptive:  edgeCSPResp.EnableEdgeActiveStandbyPreemptive,
+		EnableLearnedCidrsApproval:         edgeCSPResp.EnableLearnedCidrsApproval,
+		SpokeBgpManualAdvertisedCidrs:      edgeCSPResp.SpokeBgpManualAdvertisedCidrs,
+		EnablePreserveAsPath:               edgeCSPResp.EnablePreserveAsPath,
+		BgpPollingTime:                     edgeCSPResp.BgpPollingTime,
+		BgpBfdPollingTime:                  edgeCSPResp.BgpBfdPollingTime,
+		BgpHoldTime:                        edgeCSPResp.BgpHoldTime,
+		EnableEdgeTransitiveRouting:        edgeCSPResp.EnableEdgeTransitiveRouting,
+		EnableJumboFrame:                   edgeCSPResp.EnableJumboFrame,
+		Latitude:                           edgeCSPResp.Latitude,
+		Longitude:                          edgeCSPResp.Longitude,
+		RxQueueSize:                        edgeCSPResp.RxQueueSize,
+		State:                              edgeCSPResp.State,
+		EnableNat:                          edgeCSPResp.EnableNat,
+		SnatMode:                           edgeCSPResp.SnatMode,
+		AdvertisedCidrList:                 edgeCSPResp.AdvertisedCidrList,
+	}
+}
+
 func resourceAviatrixEdgeCSPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeCSP := marshalEdgeCSPInput(d)
 
 	// checks before creation
-	if !edgeCSP.EnableEdgeActiveStandby && edgeCSP.EnableEdgeActiveStandbyPreemptive {
-		return diag.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
-	}
-
-	if !edgeCSP.EnableLearnedCidrsApproval && len(edgeCSP.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
-	}
-
-	if len(edgeCSP.PrependAsPath) != 0 {
-		if edgeCSP.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
-		}
-	}
-
-	if edgeCSP.Latitude != "" && edgeCSP.Longitude != "" {
-		latitude, _ := strconv.ParseFloat(edgeCSP.Latitude, 64)
-		longitude, _ := strconv.ParseFloat(edgeCSP.Longitude, 64)
-		if latitude == 0 && longitude == 0 {
-			return diag.Errorf("latitude and longitude must not be zero at the same time")
-		}
+	if err := validateEdgeGatewayConfig(expandEdgeGatewayConfig(d)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// create
@@ -507,136 +379,9 @@ func resourceAviatrixEdgeCSPCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// advanced configs
-	// use following variables to reuse functions for transit, spoke, gateway and EaaS
-	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
-		GwName: edgeCSP.GwName,
-	}
-	gatewayForSpokeFunctions := &goaviatrix.SpokeVpc{
-		GwName: edgeCSP.GwName,
-	}
-	gatewayForGatewayFunctions := &goaviatrix.Gateway{
-		GwName: edgeCSP.GwName,
-	}
-	gatewayForEaasFunctions := &goaviatrix.EdgeSpoke{
-		GwName: edgeCSP.GwName,
-	}
-
-	if edgeCSP.LocalAsNumber != "" {
-		err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeCSP.LocalAsNumber)
-		if err != nil {
-			return diag.Errorf("could not set 'local_as_number' after Edge CSP creation: %v", err)
-		}
-	}
-
-	if len(edgeCSP.PrependAsPath) != 0 {
-		err := client.SetPrependASPath(gatewayForTransitFunctions, edgeCSP.PrependAsPath)
-		if err != nil {
-			return diag.Errorf("could not set 'prepend_as_path' after Edge CSP creation: %v", err)
-		}
-	}
-
-	if edgeCSP.EnableLearnedCidrsApproval {
-		err := client.EnableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not enable learned CIDRs approval after Edge CSP creation: %v", err)
-		}
-	}
-
-	if len(edgeCSP.ApprovedLearnedCidrs) != 0 {
-		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeCSP.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not update approved CIDRs after Edge CSP creation: %v", err)
-		}
-	}
-
-	if len(edgeCSP.SpokeBgpManualAdvertisedCidrs) != 0 {
-		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeCSP.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not set spoke BGP manual advertised CIDRs after Edge CSP creation: %v", err)
-		}
-	}
-
-	if edgeCSP.EnablePreserveAsPath {
-		err := client.EnableSpokePreserveAsPath(gatewayForSpokeFunctions)
-		if err != nil {
-			return diag.Errorf("could not enable spoke preserve as path after Edge CSP creation: %v", err)
-		}
-	}
-
-	if edgeCSP.BgpPollingTime >= 10 && edgeCSP.BgpPollingTime != defaultBgpPollingTime {
-		err := client.SetBgpPollingTimeSpoke(gatewayForSpokeFunctions, edgeCSP.BgpPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp polling time after Edge CSP creation: %v", err)
-		}
-	}
-
-	if edgeCSP.BgpBfdPollingTime >= 1 && edgeCSP.BgpBfdPollingTime != defaultBgpNeighborStatusPollingTime {
-		err := client.SetBgpBfdPollingTimeSpoke(gatewayForSpokeFunctions, edgeCSP.BgpBfdPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp neighbor status polling time after Edge CSP creation: %v", err)
-		}
-	}
-
-	if edgeCSP.BgpHoldTime >= 12 && edgeCSP.BgpHoldTime != defaultBgpHoldTime {
-		err := client.ChangeBgpHoldTime(gatewayForSpokeFunctions.GwName, edgeCSP.BgpHoldTime)
-		if err != nil {
-			return diag.Errorf("could not change BGP Hold Time after Edge CSP creation: %v", err)
-		}
-	}
-
-	if edgeCSP.EnableEdgeTransitiveRouting {
-		err := client.EnableEdgeSpokeTransitiveRouting(ctx, edgeCSP.GwName)
-		if err != nil {
-			return diag.Errorf("could not enable Edge transitive routing after Edge CSP creation: %v", err)
-		}
-	}
-
-	if edgeCSP.EnableJumboFrame {
-		err := client.EnableJumboFrame(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not disable jumbo frame after Edge CSP creation: %v", err)
-		}
-	}
-
-	if edgeCSP.Latitude != "" || edgeCSP.Longitude != "" {
-		gatewayForEaasFunctions.Latitude = edgeCSP.Latitude
-		gatewayForEaasFunctions.Longitude = edgeCSP.Longitude
-		err := client.UpdateEdgeSpokeGeoCoordinate(ctx, gatewayForEaasFunctions)
-		if err != nil {
-			return diag.Errorf("could not update geo coordinate after Edge CSP creation: %v", err)
-		}
-	}
-
-	if edgeCSP.RxQueueSize != "" {
-		gatewayForGatewayFunctions.RxQueueSize = edgeCSP.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not set rx queue size after Edge CSP creation: %v", err)
-		}
-	}
-
-	if edgeCSP.EnableSingleIpSnat {
-		gatewayForGatewayFunctions.GatewayName = edgeCSP.GwName
-		err := client.EnableSNat(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("failed to enable single IP SNAT: %s", err)
-		}
-	}
-
-	if edgeCSP.EnableAutoAdvertiseLanCidrs == "disable" {
-		err := client.UpdateEdgeCSP(ctx, edgeCSP)
-		if err != nil {
-			return diag.Errorf("could not disable auto advertise LAN CIDRs after Edge CSP creation: %v", err)
-		}
-	}
-
-	if edgeCSP.EnableEdgeActiveStandby || edgeCSP.EnableEdgeActiveStandbyPreemptive {
-		err := client.UpdateEdgeCSP(ctx, edgeCSP)
-		if err != nil {
-			return diag.Errorf("could not update Edge active standby or Edge active standby preemptive after Edge CSP creation: %v", err)
-		}
+	err := configureEdgeGatewayAfterCreate(ctx, client, d, edgeCSPPlatform(client, edgeCSP))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceAviatrixEdgeCSPReadIfRequired(ctx, d, meta, &flag)
@@ -651,12 +396,12 @@ func resourceAviatrixEdgeCSPReadIfRequired(ctx context.Context, d *schema.Resour
 }
 
 func resourceAviatrixEdgeCSPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// handle import
 	if d.Get("gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no name received", map[string]interface{}{"id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -676,59 +421,11 @@ func resourceAviatrixEdgeCSPRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("project_uuid", edgeCSPResp.ProjectUuid)
 	d.Set("compute_node_uuid", edgeCSPResp.ComputeNodeUuid)
 	d.Set("template_uuid", edgeCSPResp.TemplateUuid)
-	d.Set("enable_management_over_private_network", edgeCSPResp.EnableManagementOverPrivateNetwork)
-	d.Set("dns_server_ip", edgeCSPResp.DnsServerIp)
-	d.Set("secondary_dns_server_ip", edgeCSPResp.SecondaryDnsServerIp)
-	d.Set("local_as_number", edgeCSPResp.LocalAsNumber)
-	d.Set("prepend_as_path", edgeCSPResp.PrependAsPath)
-	d.Set("enable_edge_active_standby", edgeCSPResp.EnableEdgeActiveStandby)
-	d.Set("enable_edge_active_standby_preemptive", edgeCSPResp.EnableEdgeActiveStandbyPreemptive)
-	d.Set("enable_learned_cidrs_approval", edgeCSPResp.EnableLearnedCidrsApproval)
-
-	if edgeCSPResp.ManagementEgressIpPrefix == "" {
-		d.Set("management_egress_ip_prefix_list", nil)
-	} else {
-		d.Set("management_egress_ip_prefix_list", strings.Split(edgeCSPResp.ManagementEgressIpPrefix, ","))
-	}
-
-	if edgeCSPResp.EnableLearnedCidrsApproval {
-		spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfig(&goaviatrix.SpokeVpc{GwName: edgeCSPResp.GwName})
-		if err != nil {
-			return diag.Errorf("could not get advanced config for Edge CSP: %v", err)
-		}
 
-		err = d.Set("approved_learned_cidrs", spokeAdvancedConfig.ApprovedLearnedCidrs)
-		if err != nil {
-			return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
-		}
-	} else {
-		d.Set("approved_learned_cidrs", nil)
-	}
-
-	spokeBgpManualAdvertisedCidrs := getStringSet(d, "spoke_bgp_manual_advertise_cidrs")
-	if len(goaviatrix.Difference(spokeBgpManualAdvertisedCidrs, edgeCSPResp.SpokeBgpManualAdvertisedCidrs)) != 0 ||
-		len(goaviatrix.Difference(edgeCSPResp.SpokeBgpManualAdvertisedCidrs, spokeBgpManualAdvertisedCidrs)) != 0 {
-		d.Set("spoke_bgp_manual_advertise_cidrs", edgeCSPResp.SpokeBgpManualAdvertisedCidrs)
-	} else {
-		d.Set("spoke_bgp_manual_advertise_cidrs", spokeBgpManualAdvertisedCidrs)
-	}
-
-	d.Set("enable_preserve_as_path", edgeCSPResp.EnablePreserveAsPath)
-	d.Set("bgp_polling_time", edgeCSPResp.BgpPollingTime)
-	d.Set("bgp_neighbor_status_polling_time", edgeCSPResp.BgpBfdPollingTime)
-	d.Set("bgp_hold_time", edgeCSPResp.BgpHoldTime)
-	d.Set("enable_edge_transitive_routing", edgeCSPResp.EnableEdgeTransitiveRouting)
-	d.Set("enable_jumbo_frame", edgeCSPResp.EnableJumboFrame)
-	if edgeCSPResp.Latitude != 0 || edgeCSPResp.Longitude != 0 {
-		d.Set("latitude", fmt.Sprintf("%.6f", edgeCSPResp.Latitude))
-		d.Set("longitude", fmt.Sprintf("%.6f", edgeCSPResp.Longitude))
-	} else {
-		d.Set("latitude", "")
-		d.Set("longitude", "")
+	if err := flattenEdgeGateway(client, d, edgeCSPState(edgeCSPResp)); err != nil {
+		return diag.Errorf("could not read Edge CSP: %v", err)
 	}
 
-	d.Set("rx_queue_size", edgeCSPResp.RxQueueSize)
-	d.Set("state", edgeCSPResp.State)
 	d.Set("wan_interface_names", edgeCSPResp.WanInterface)
 	d.Set("lan_interface_names", edgeCSPResp.LanInterface)
 	d.Set("management_interface_names", edgeCSPResp.MgmtInterface)
@@ -781,7 +478,6 @@ func resourceAviatrixEdgeCSPRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("failed to set vlan: %s\n", err)
 	}
 
-	d.Set("enable_single_ip_snat", edgeCSPResp.EnableNat == "yes" && edgeCSPResp.SnatMode == "primary")
 	d.Set("enable_auto_advertise_lan_cidrs", edgeCSPResp.EnableAutoAdvertiseLanCidrs)
 
 	d.SetId(edgeCSPResp.GwName)
@@ -789,211 +485,21 @@ func resourceAviatrixEdgeCSPRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeCSP := marshalEdgeCSPInput(d)
 
 	// checks before update
-	if !edgeCSP.EnableEdgeActiveStandby && edgeCSP.EnableEdgeActiveStandbyPreemptive {
-		return diag.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
-	}
-
-	if !edgeCSP.EnableLearnedCidrsApproval && len(edgeCSP.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
-	}
-
-	if len(edgeCSP.PrependAsPath) != 0 {
-		if edgeCSP.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
-		}
-	}
-
-	if edgeCSP.Latitude != "" && edgeCSP.Longitude != "" {
-		latitude, _ := strconv.ParseFloat(edgeCSP.Latitude, 64)
-		longitude, _ := strconv.ParseFloat(edgeCSP.Longitude, 64)
-		if latitude == 0 && longitude == 0 {
-			return diag.Errorf("latitude and longitude must not be zero at the same time")
-		}
+	if err := validateEdgeGatewayConfig(expandEdgeGatewayConfig(d)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Partial(true)
 
-	// update configs
-	// use following variables to reuse functions for transit, spoke and gateway
-	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
-		GwName: edgeCSP.GwName,
-	}
-	gatewayForSpokeFunctions := &goaviatrix.SpokeVpc{
-		GwName: edgeCSP.GwName,
-	}
-	gatewayForGatewayFunctions := &goaviatrix.Gateway{
-		GwName: edgeCSP.GwName,
-	}
-	gatewayForEaasFunctions := &goaviatrix.EdgeSpoke{
-		GwName: edgeCSP.GwName,
-	}
-
-	if d.HasChanges("local_as_number", "prepend_as_path") {
-		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(edgeCSP.PrependAsPath) == 0 {
-			// prependASPath must be deleted from the controller before local_as_number can be changed
-			// Handle the case where prependASPath is empty here so that the API is not called twice
-			err := client.SetPrependASPath(gatewayForTransitFunctions, nil)
-			if err != nil {
-				return diag.Errorf("could not delete prepend_as_path during Edge CSP update: %v", err)
-			}
-		}
-
-		if d.HasChange("local_as_number") {
-			err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeCSP.LocalAsNumber)
-			if err != nil {
-				return diag.Errorf("could not set local_as_number during Edge CSP update: %v", err)
-			}
-		}
-
-		if d.HasChange("prepend_as_path") && len(edgeCSP.PrependAsPath) > 0 {
-			err := client.SetPrependASPath(gatewayForTransitFunctions, edgeCSP.PrependAsPath)
-			if err != nil {
-				return diag.Errorf("could not set prepend_as_path during Edge CSP update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("enable_learned_cidrs_approval") {
-		if edgeCSP.EnableLearnedCidrsApproval {
-			err := client.EnableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable learned cidrs approval during Edge CSP update: %v", err)
-			}
-		} else {
-			err := client.DisableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable learned cidrs approval during Edge CSP update: %v", err)
-			}
-		}
-	}
-
-	if edgeCSP.EnableLearnedCidrsApproval && d.HasChange("approved_learned_cidrs") {
-		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeCSP.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not update approved learned CIDRs during Edge CSP update: %v", err)
-		}
-	}
-
-	if d.HasChange("spoke_bgp_manual_advertise_cidrs") {
-		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeCSP.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not set spoke BGP manual advertised CIDRs during Edge CSP update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_preserve_as_path") {
-		if edgeCSP.EnablePreserveAsPath {
-			err := client.EnableSpokePreserveAsPath(gatewayForSpokeFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable preserve as path during Edge CSP update: %v", err)
-			}
-		} else {
-			err := client.DisableSpokePreserveAsPath(gatewayForSpokeFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable preserve as path during Edge CSP update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("bgp_polling_time") {
-		err := client.SetBgpPollingTimeSpoke(gatewayForSpokeFunctions, edgeCSP.BgpPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp polling time during Edge CSP update: %v", err)
-		}
-	}
-
-	if d.HasChange("bgp_neighbor_status_polling_time") {
-		err := client.SetBgpBfdPollingTimeSpoke(gatewayForSpokeFunctions, edgeCSP.BgpBfdPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp neighbor status polling time during Edge CSP update: %v", err)
-		}
-	}
-
-	if d.HasChange("bgp_hold_time") {
-		err := client.ChangeBgpHoldTime(edgeCSP.GwName, edgeCSP.BgpHoldTime)
-		if err != nil {
-			return diag.Errorf("could not change bgp hold time during Edge CSP update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_edge_transitive_routing") {
-		if edgeCSP.EnableEdgeTransitiveRouting {
-			err := client.EnableEdgeSpokeTransitiveRouting(ctx, edgeCSP.GwName)
-			if err != nil {
-				return diag.Errorf("could not enable transitive routing during Edge CSP update: %v", err)
-			}
-		} else {
-			err := client.DisableEdgeSpokeTransitiveRouting(ctx, edgeCSP.GwName)
-			if err != nil {
-				return diag.Errorf("could not disable transitive routing during Edge CSP update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("enable_jumbo_frame") {
-		if edgeCSP.EnableJumboFrame {
-			err := client.EnableJumboFrame(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable jumbo frame during Edge CSP update: %v", err)
-			}
-		} else {
-			err := client.DisableJumboFrame(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable jumbo frame during Edge CSP update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChanges("latitude", "longitude") {
-		gatewayForEaasFunctions.Latitude = edgeCSP.Latitude
-		gatewayForEaasFunctions.Longitude = edgeCSP.Longitude
-		err := client.UpdateEdgeSpokeGeoCoordinate(ctx, gatewayForEaasFunctions)
-		if err != nil {
-			return diag.Errorf("could not update geo coordinate during Edge CSP update: %v", err)
-		}
-	}
-
-	if d.HasChange("rx_queue_size") {
-		gatewayForGatewayFunctions.RxQueueSize = edgeCSP.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not update rx queue size during Edge CSP update: %v", err)
-		}
-	}
-
-	if d.HasChanges("management_egress_ip_prefix_list", "interfaces", "vlan",
-		"enable_auto_advertise_lan_cidrs", "enable_edge_active_standby", "enable_edge_active_standby_preemptive") {
-		err := client.UpdateEdgeCSP(ctx, edgeCSP)
-		if err != nil {
-			return diag.Errorf("could not update management egress ip prefix list, WAN/LAN/VLAN interfaces, "+
-				"DNS profile name, auto advertise LAN CIDRs, Edge active standby or Edge active standby preemptive "+
-				"during Edge CSP update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_single_ip_snat") {
-		gatewayForGatewayFunctions.GatewayName = edgeCSP.GwName
-
-		if edgeCSP.EnableSingleIpSnat {
-			err := client.EnableSNat(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("failed to enable single IP SNAT during update: %s", err)
-			}
-		} else {
-			err := client.DisableSNat(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("failed to disable single IP SNAT during update: %s", err)
-			}
-		}
-
+	err := updateEdgeGateway(ctx, client, d, edgeCSPPlatform(client, edgeCSP))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Partial(false)
@@ -1002,7 +508,7 @@ func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixEdgeCSPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 	gwName := d.Get("gw_name").(string)
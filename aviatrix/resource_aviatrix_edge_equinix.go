@@ -2,12 +2,11 @@ package aviatrix
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"os"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,15 +17,21 @@ import (
 
 func resourceAviatrixEdgeEquinix() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeEquinixCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeEquinixRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeEquinixUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeEquinixDelete,
+		CreateContext: resourceAviatrixEdgeEquinixCreate,
+		ReadContext:   resourceAviatrixEdgeEquinixRead,
+		UpdateContext: resourceAviatrixEdgeEquinixUpdate,
+		DeleteContext: resourceAviatrixEdgeEquinixDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
+		Schema: edgeGatewaySchema(map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -47,164 +52,18 @@ func resourceAviatrixEdgeEquinix() *schema.Resource {
 			},
 			"ztp_file_download_path": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old != ""
 				},
-				Description: "The location where the ZTP file will be stored.",
-			},
-			"management_egress_ip_prefix_list": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "Set of management egress gateway IP/prefix.",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-			"enable_management_over_private_network": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     false,
-				Description: "Enable management over private network.",
-			},
-			"dns_server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "DNS server IP.",
-				ValidateFunc: validation.IsIPAddress,
-				Deprecated:   "DNS server ip attribute will be removed in the future release.",
-			},
-			"secondary_dns_server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "Secondary DNS server IP.",
-				ValidateFunc: validation.IsIPAddress,
-				Deprecated:   "Secondary DNS server ip attribute will be removed in the future release.",
-			},
-			"local_as_number": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "Local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
-			},
-			"prepend_as_path": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
-				},
-				MaxItems: 25,
-			},
-			"enable_edge_active_standby": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enables Edge Active-Standby Mode.",
-			},
-			"enable_edge_active_standby_preemptive": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enables Preemptive Mode for Edge Active-Standby, available only with Active-Standby enabled.",
-			},
-			"enable_learned_cidrs_approval": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Switch to enable/disable learned CIDR approval for BGP Spoke Gateway. Valid values: true, false.",
-			},
-			"approved_learned_cidrs": {
-				Type: schema.TypeSet,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.IsCIDR,
-				},
-				Optional:    true,
-				Description: "Approved learned CIDRs for BGP Spoke Gateway.",
+				Description: "The location where the ZTP file will be stored. If not set, the ZTP file is not written to disk and is only exported in ztp_file_content.",
 			},
-			"spoke_bgp_manual_advertise_cidrs": {
-				Type: schema.TypeSet,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-				Optional:    true,
-				Description: "Intended CIDR list to be advertised to external BGP router.",
-			},
-			"enable_preserve_as_path": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable preserve as path when advertising manual summary CIDRs on BGP spoke gateway.",
-			},
-			"bgp_polling_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpPollingTime,
-				ValidateFunc: validation.IntBetween(10, 50),
-				Description:  "BGP route polling time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 10 and 50.",
-			},
-			"bgp_neighbor_status_polling_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpNeighborStatusPollingTime,
-				ValidateFunc: validation.IntBetween(1, 10),
-				Description:  "BGP neighbor status polling time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 1 and 10.",
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return old == "0"
-				},
-			},
-			"bgp_hold_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpHoldTime,
-				ValidateFunc: validation.IntBetween(12, 360),
-				Description:  "BGP route polling time for BGP spoke gateway in seconds. Valid values are between 12 and 360.",
-			},
-			"enable_edge_transitive_routing": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable Edge transitive routing.",
-			},
-			"enable_jumbo_frame": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable jumbo frame.",
-			},
-			"latitude": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
-				Description:      "The latitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
-			},
-			"longitude": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
-				Description:      "The longitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
-			},
-			"rx_queue_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"1K", "2K", "4K"}, false),
-				Description:  "Ethernet interface RX queue size.",
-			},
-			"state": {
+			"ztp_file_content": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "State of Edge as a Spoke.",
+				Sensitive:   true,
+				Description: "Content of the ZTP cloud-init file.",
 			},
 			"interfaces": {
 				Type:        schema.TypeSet,
@@ -332,27 +191,13 @@ func resourceAviatrixEdgeEquinix() *schema.Resource {
 				Description: "DNS profile to be associated with gateway, select an existing template.",
 				Deprecated:  "DNS profile support has been removed.",
 			},
-			"enable_single_ip_snat": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable Single IP SNAT.",
-			},
 			"enable_auto_advertise_lan_cidrs": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
 				Description: "Enable auto advertise LAN CIDRs.",
 			},
-			"included_advertised_spoke_routes": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "A list of CIDRs to be advertised to on-prem as 'Included CIDR List'. When configured, it will replace all advertised routes from this VPC.",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-		},
+		}),
 	}
 }
 
@@ -438,33 +283,58 @@ func marshalEdgeEquinixInput(d *schema.ResourceData) *goaviatrix.EdgeEquinix {
 	return edgeEquinix
 }
 
+// edgeEquinixPlatform connects the shared edge gateway logic to the Edge Equinix API.
+func edgeEquinixPlatform(client goaviatrix.ClientInterface, edgeEquinix *goaviatrix.EdgeEquinix) *edgeGatewayPlatform {
+	return &edgeGatewayPlatform{
+		name: "Edge Equinix",
+		update: func(ctx context.Context) error {
+			return client.UpdateEdgeEquinix(ctx, edgeEquinix)
+		},
+		updateAttributes: []string{"management_egress_ip_prefix_list", "interfaces", "vlan",
+			"enable_auto_advertise_lan_cidrs", "enable_edge_active_standby", "enable_edge_active_standby_preemptive"},
+		updateAfterCreate: edgeEquinix.EnableAutoAdvertiseLanCidrs == "disable",
+	}
+}
+
+// edgeEquinixState returns the shared edge gateway attributes of an Edge Equinix.
+func edgeEquinixState(edgeEquinixResp *goaviatrix.EdgeEquinixResp) *edgeGatewayState {
+	return &edgeGatewayState{
+		GwName:                             edgeEquinixResp.GwName,
+		ManagementEgressIpPrefix:           edgeEquinixResp.ManagementEgressIpPrefix,
+		EnableManagementOverPrivateNetwork: edgeEquinixResp.EnableManagementOverPrivateNetwork,
+		DnsServerIp:                        edgeEquinixResp.DnsServerIp,
+		SecondaryDnsServerIp:               edgeEquinixResp.SecondaryDnsServerIp,
+		LocalAsNumber:                      edgeEquinixResp.LocalAsNumber,
+		PrependAsPath:                      edgeEquinixResp.PrependAsPath,
+		EnableEdgeActiveStandby:            edgeEquinixResp.EnableEdgeActiveStandby,
+		EnableEdgeActiveStandbyPreemptive:  edgeEquinixResp.EnableEdgeActiveStandbyPreemptive,
+		EnableLearnedCidrsApproval:         edgeEquinixResp.EnableLearnedCidrsApproval,
+		SpokeBgpManualAdvertisedCidrs:      edgeEquinixResp.SpokeBgpManualAdvertisedCidrs,
+		EnablePreserveAsPath:               edgeEquinixResp.EnablePreserveAsPath,
+		BgpPollingTime:                     edgeEquinixResp.BgpPollingTime,
+		BgpBfdPollingTime:                  edgeEquinixResp.BgpBfdPollingTime,
+		BgpHoldTime:                        edgeEquinixResp.BgpHoldTime,
+		EnableEdgeTransitiveRouting:        edgeEquinixResp.EnableEdgeTransitiveRouting,
+		EnableJumboFrame:                   edgeEquinixResp.EnableJumboFrame,
+		Latitude:                           edgeEquinixResp.Latitude,
+		Longitude:                          edgeEquinixResp.Longitude,
+		RxQueueSize:                        edgeEquinixResp.RxQueueSize,
+		State:                              edgeEquinixResp.State,
+		EnableNat:                          edgeEquinixResp.EnableNat,
+		SnatMode:                           edgeEquinixResp.SnatMode,
+		AdvertisedCidrList:                 edgeEquinixResp.AdvertisedCidrList,
+	}
+}
+
 func resourceAviatrixEdgeEquinixCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeEquinix := marshalEdgeEquinixInput(d)
 
 	// checks before creation
-	if !edgeEquinix.EnableEdgeActiveStandby && edgeEquinix.EnableEdgeActiveStandbyPreemptive {
-		return diag.Errorf("could not enable Preemptive Mode when Active-Standby is disabled")
-	}
-
-	if !edgeEquinix.EnableLearnedCidrsApproval && len(edgeEquinix.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
-	}
-
-	if len(edgeEquinix.PrependAsPath) != 0 {
-		if edgeEquinix.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
-		}
-	}
-
-	if edgeEquinix.Latitude != "" && edgeEquinix.Longitude != "" {
-		latitude, _ := strconv.ParseFloat(edgeEquinix.Latitude, 64)
-		longitude, _ := strconv.ParseFloat(edgeEquinix.Longitude, 64)
-		if latitude == 0 && longitude == 0 {
-			return diag.Errorf("latitude and longitude must not be zero at the same time")
-		}
+	if err := validateEdgeGatewayConfig(expandEdgeGatewayConfig(d)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// create
@@ -475,144 +345,12 @@ func resourceAviatrixEdgeEquinixCreate(ctx context.Context, d *schema.ResourceDa
 	if err := client.CreateEdgeEquinix(ctx, edgeEquinix); err != nil {
 		return diag.Errorf("could not create Edge Equinix %s: %v", edgeEquinix.GwName, err)
 	}
+	d.Set("ztp_file_content", edgeEquinix.ZtpFileContent)
 
 	// advanced configs
-	// use following variables to reuse functions for transit, spoke, gateway and EaaS
-	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
-		GwName: edgeEquinix.GwName,
-	}
-	gatewayForSpokeFunctions := &goaviatrix.SpokeVpc{
-		GwName: edgeEquinix.GwName,
-	}
-	gatewayForGatewayFunctions := &goaviatrix.Gateway{
-		GwName: edgeEquinix.GwName,
-	}
-	gatewayForEaasFunctions := &goaviatrix.EdgeSpoke{
-		GwName: edgeEquinix.GwName,
-	}
-
-	if edgeEquinix.LocalAsNumber != "" {
-		err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeEquinix.LocalAsNumber)
-		if err != nil {
-			return diag.Errorf("could not set 'local_as_number' after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if len(edgeEquinix.PrependAsPath) != 0 {
-		err := client.SetPrependASPath(gatewayForTransitFunctions, edgeEquinix.PrependAsPath)
-		if err != nil {
-			return diag.Errorf("could not set 'prepend_as_path' after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if edgeEquinix.EnableLearnedCidrsApproval {
-		err := client.EnableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not enable learned CIDRs approval after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if len(edgeEquinix.ApprovedLearnedCidrs) != 0 {
-		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeEquinix.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not update approved CIDRs after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if len(edgeEquinix.SpokeBgpManualAdvertisedCidrs) != 0 {
-		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeEquinix.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not set spoke BGP manual advertised CIDRs after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if edgeEquinix.EnablePreserveAsPath {
-		err := client.EnableSpokePreserveAsPath(gatewayForSpokeFunctions)
-		if err != nil {
-			return diag.Errorf("could not enable spoke preserve as path after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if edgeEquinix.BgpPollingTime >= 10 && edgeEquinix.BgpPollingTime != defaultBgpPollingTime {
-		err := client.SetBgpPollingTimeSpoke(gatewayForSpokeFunctions, edgeEquinix.BgpPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp polling time after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if edgeEquinix.BgpBfdPollingTime >= 1 && edgeEquinix.BgpBfdPollingTime != defaultBgpNeighborStatusPollingTime {
-		err := client.SetBgpBfdPollingTimeSpoke(gatewayForSpokeFunctions, edgeEquinix.BgpBfdPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp neighbor status polling time after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if edgeEquinix.BgpHoldTime >= 12 && edgeEquinix.BgpHoldTime != defaultBgpHoldTime {
-		err := client.ChangeBgpHoldTime(gatewayForSpokeFunctions.GwName, edgeEquinix.BgpHoldTime)
-		if err != nil {
-			return diag.Errorf("could not change BGP Hold Time after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if edgeEquinix.EnableEdgeTransitiveRouting {
-		err := client.EnableEdgeSpokeTransitiveRouting(ctx, edgeEquinix.GwName)
-		if err != nil {
-			return diag.Errorf("could not enable Edge transitive routing after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if edgeEquinix.EnableJumboFrame {
-		err := client.EnableJumboFrame(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not disable jumbo frame after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if edgeEquinix.Latitude != "" || edgeEquinix.Longitude != "" {
-		gatewayForEaasFunctions.Latitude = edgeEquinix.Latitude
-		gatewayForEaasFunctions.Longitude = edgeEquinix.Longitude
-		err := client.UpdateEdgeSpokeGeoCoordinate(ctx, gatewayForEaasFunctions)
-		if err != nil {
-			return diag.Errorf("could not update geo coordinate after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if edgeEquinix.RxQueueSize != "" {
-		gatewayForGatewayFunctions.RxQueueSize = edgeEquinix.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not set rx queue size after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if edgeEquinix.EnableSingleIpSnat {
-		gatewayForGatewayFunctions.GatewayName = edgeEquinix.GwName
-		err := client.EnableSNat(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("failed to enable single IP SNAT: %s", err)
-		}
-	}
-
-	if edgeEquinix.EnableAutoAdvertiseLanCidrs == "disable" {
-		err := client.UpdateEdgeEquinix(ctx, edgeEquinix)
-		if err != nil {
-			return diag.Errorf("could not disable auto advertise LAN CIDRs after Edge Equinix creation: %v", err)
-		}
-	}
-
-	if edgeEquinix.EnableEdgeActiveStandby || edgeEquinix.EnableEdgeActiveStandbyPreemptive {
-		err := client.UpdateEdgeEquinix(ctx, edgeEquinix)
-		if err != nil {
-			return diag.Errorf("could not update Edge active standby or Edge active standby preemptive after Edge Equinix creation: %v", err)
-		}
-	}
-
-	// set the advertised spoke cidr routes
-	err := editAdvertisedSpokeRoutesWithRetry(client, gatewayForGatewayFunctions, d)
+	err := configureEdgeGatewayAfterCreate(ctx, client, d, edgeEquinixPlatform(client, edgeEquinix))
 	if err != nil {
-		return diag.Errorf("failed to edit advertised spoke vpc routes of spoke gateway: %q: %s", gatewayForGatewayFunctions.GwName, err)
+		return diag.FromErr(err)
 	}
 
 	return resourceAviatrixEdgeEquinixReadIfRequired(ctx, d, meta, &flag)
@@ -627,12 +365,12 @@ func resourceAviatrixEdgeEquinixReadIfRequired(ctx context.Context, d *schema.Re
 }
 
 func resourceAviatrixEdgeEquinixRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// handle import
 	if d.Get("gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no name received", map[string]interface{}{"id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -649,64 +387,11 @@ func resourceAviatrixEdgeEquinixRead(ctx context.Context, d *schema.ResourceData
 	d.Set("account_name", edgeEquinixResp.AccountName)
 	d.Set("gw_name", edgeEquinixResp.GwName)
 	d.Set("site_id", edgeEquinixResp.SiteId)
-	d.Set("enable_management_over_private_network", edgeEquinixResp.EnableManagementOverPrivateNetwork)
-	d.Set("dns_server_ip", edgeEquinixResp.DnsServerIp)
-	d.Set("secondary_dns_server_ip", edgeEquinixResp.SecondaryDnsServerIp)
-	d.Set("local_as_number", edgeEquinixResp.LocalAsNumber)
-	d.Set("prepend_as_path", edgeEquinixResp.PrependAsPath)
-	d.Set("enable_edge_active_standby", edgeEquinixResp.EnableEdgeActiveStandby)
-	d.Set("enable_edge_active_standby_preemptive", edgeEquinixResp.EnableEdgeActiveStandbyPreemptive)
-	d.Set("enable_learned_cidrs_approval", edgeEquinixResp.EnableLearnedCidrsApproval)
-
-	if edgeEquinixResp.ManagementEgressIpPrefix == "" {
-		d.Set("management_egress_ip_prefix_list", nil)
-	} else {
-		d.Set("management_egress_ip_prefix_list", strings.Split(edgeEquinixResp.ManagementEgressIpPrefix, ","))
-	}
-
-	if edgeEquinixResp.EnableLearnedCidrsApproval {
-		spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfig(&goaviatrix.SpokeVpc{GwName: edgeEquinixResp.GwName})
-		if err != nil {
-			return diag.Errorf("could not get advanced config for Edge Equinix: %v", err)
-		}
-
-		err = d.Set("approved_learned_cidrs", spokeAdvancedConfig.ApprovedLearnedCidrs)
-		if err != nil {
-			return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
-		}
-	} else {
-		d.Set("approved_learned_cidrs", nil)
-	}
 
-	spokeBgpManualAdvertisedCidrs := getStringSet(d, "spoke_bgp_manual_advertise_cidrs")
-	if len(goaviatrix.Difference(spokeBgpManualAdvertisedCidrs, edgeEquinixResp.SpokeBgpManualAdvertisedCidrs)) != 0 ||
-		len(goaviatrix.Difference(edgeEquinixResp.SpokeBgpManualAdvertisedCidrs, spokeBgpManualAdvertisedCidrs)) != 0 {
-		d.Set("spoke_bgp_manual_advertise_cidrs", edgeEquinixResp.SpokeBgpManualAdvertisedCidrs)
-	} else {
-		d.Set("spoke_bgp_manual_advertise_cidrs", spokeBgpManualAdvertisedCidrs)
-	}
-
-	d.Set("enable_preserve_as_path", edgeEquinixResp.EnablePreserveAsPath)
-	d.Set("bgp_polling_time", edgeEquinixResp.BgpPollingTime)
-	d.Set("bgp_neighbor_status_polling_time", edgeEquinixResp.BgpBfdPollingTime)
-	d.Set("bgp_hold_time", edgeEquinixResp.BgpHoldTime)
-	d.Set("enable_edge_transitive_routing", edgeEquinixResp.EnableEdgeTransitiveRouting)
-	d.Set("enable_jumbo_frame", edgeEquinixResp.EnableJumboFrame)
-	if edgeEquinixResp.Latitude != 0 || edgeEquinixResp.Longitude != 0 {
-		d.Set("latitude", fmt.Sprintf("%.6f", edgeEquinixResp.Latitude))
-		d.Set("longitude", fmt.Sprintf("%.6f", edgeEquinixResp.Longitude))
-	} else {
-		d.Set("latitude", "")
-		d.Set("longitude", "")
-	}
-
-	if len(edgeEquinixResp.AdvertisedCidrList) > 0 {
-		_ = d.Set("included_advertised_spoke_routes", edgeEquinixResp.AdvertisedCidrList)
+	if err := flattenEdgeGateway(client, d, edgeEquinixState(edgeEquinixResp)); err != nil {
+		return diag.Errorf("could not read Edge Equinix: %v", err)
 	}
 
-	d.Set("rx_queue_size", edgeEquinixResp.RxQueueSize)
-	d.Set("state", edgeEquinixResp.State)
-
 	var interfaces []map[string]interface{}
 	var vlan []map[string]interface{}
 	for _, interface0 := range edgeEquinixResp.InterfaceList {
@@ -755,7 +440,6 @@ func resourceAviatrixEdgeEquinixRead(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("failed to set vlan: %s\n", err)
 	}
 
-	d.Set("enable_single_ip_snat", edgeEquinixResp.EnableNat == "yes" && edgeEquinixResp.SnatMode == "primary")
 	d.Set("enable_auto_advertise_lan_cidrs", edgeEquinixResp.EnableAutoAdvertiseLanCidrs)
 
 	d.SetId(edgeEquinixResp.GwName)
@@ -763,218 +447,21 @@ func resourceAviatrixEdgeEquinixRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeEquinix := marshalEdgeEquinixInput(d)
 
 	// checks before update
-	if !edgeEquinix.EnableEdgeActiveStandby && edgeEquinix.EnableEdgeActiveStandbyPreemptive {
-		return diag.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
-	}
-
-	if !edgeEquinix.EnableLearnedCidrsApproval && len(edgeEquinix.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
-	}
-
-	if len(edgeEquinix.PrependAsPath) != 0 {
-		if edgeEquinix.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
-		}
-	}
-
-	if edgeEquinix.Latitude != "" && edgeEquinix.Longitude != "" {
-		latitude, _ := strconv.ParseFloat(edgeEquinix.Latitude, 64)
-		longitude, _ := strconv.ParseFloat(edgeEquinix.Longitude, 64)
-		if latitude == 0 && longitude == 0 {
-			return diag.Errorf("latitude and longitude must not be zero at the same time")
-		}
+	if err := validateEdgeGatewayConfig(expandEdgeGatewayConfig(d)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Partial(true)
 
-	// update configs
-	// use following variables to reuse functions for transit, spoke and gateway
-	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
-		GwName: edgeEquinix.GwName,
-	}
-	gatewayForSpokeFunctions := &goaviatrix.SpokeVpc{
-		GwName: edgeEquinix.GwName,
-	}
-	gatewayForGatewayFunctions := &goaviatrix.Gateway{
-		GwName: edgeEquinix.GwName,
-	}
-	gatewayForEaasFunctions := &goaviatrix.EdgeSpoke{
-		GwName: edgeEquinix.GwName,
-	}
-
-	if d.HasChanges("local_as_number", "prepend_as_path") {
-		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(edgeEquinix.PrependAsPath) == 0 {
-			// prependASPath must be deleted from the controller before local_as_number can be changed
-			// Handle the case where prependASPath is empty here so that the API is not called twice
-			err := client.SetPrependASPath(gatewayForTransitFunctions, nil)
-			if err != nil {
-				return diag.Errorf("could not delete prepend_as_path during Edge Equinix update: %v", err)
-			}
-		}
-
-		if d.HasChange("local_as_number") {
-			err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeEquinix.LocalAsNumber)
-			if err != nil {
-				return diag.Errorf("could not set local_as_number during Edge Equinix update: %v", err)
-			}
-		}
-
-		if d.HasChange("prepend_as_path") && len(edgeEquinix.PrependAsPath) > 0 {
-			err := client.SetPrependASPath(gatewayForTransitFunctions, edgeEquinix.PrependAsPath)
-			if err != nil {
-				return diag.Errorf("could not set prepend_as_path during Edge Equinix update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("enable_learned_cidrs_approval") {
-		if edgeEquinix.EnableLearnedCidrsApproval {
-			err := client.EnableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable learned cidrs approval during Edge Equinix update: %v", err)
-			}
-		} else {
-			err := client.DisableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable learned cidrs approval during Edge Equinix update: %v", err)
-			}
-		}
-	}
-
-	if edgeEquinix.EnableLearnedCidrsApproval && d.HasChange("approved_learned_cidrs") {
-		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeEquinix.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not update approved learned CIDRs during Edge Equinix update: %v", err)
-		}
-	}
-
-	if d.HasChange("spoke_bgp_manual_advertise_cidrs") {
-		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeEquinix.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not set spoke BGP manual advertised CIDRs during Edge Equinix update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_preserve_as_path") {
-		if edgeEquinix.EnablePreserveAsPath {
-			err := client.EnableSpokePreserveAsPath(gatewayForSpokeFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable preserve as path during Edge Equinix update: %v", err)
-			}
-		} else {
-			err := client.DisableSpokePreserveAsPath(gatewayForSpokeFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable preserve as path during Edge Equinix update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("bgp_polling_time") {
-		err := client.SetBgpPollingTimeSpoke(gatewayForSpokeFunctions, edgeEquinix.BgpPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp polling time during Edge Equinix update: %v", err)
-		}
-	}
-
-	if d.HasChange("bgp_neighbor_status_polling_time") {
-		err := client.SetBgpBfdPollingTimeSpoke(gatewayForSpokeFunctions, edgeEquinix.BgpBfdPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp neighbor status polling time during Edge Equinix update: %v", err)
-		}
-	}
-
-	if d.HasChange("bgp_hold_time") {
-		err := client.ChangeBgpHoldTime(edgeEquinix.GwName, edgeEquinix.BgpHoldTime)
-		if err != nil {
-			return diag.Errorf("could not change bgp hold time during Edge Equinix update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_edge_transitive_routing") {
-		if edgeEquinix.EnableEdgeTransitiveRouting {
-			err := client.EnableEdgeSpokeTransitiveRouting(ctx, edgeEquinix.GwName)
-			if err != nil {
-				return diag.Errorf("could not enable transitive routing during Edge Equinix update: %v", err)
-			}
-		} else {
-			err := client.DisableEdgeSpokeTransitiveRouting(ctx, edgeEquinix.GwName)
-			if err != nil {
-				return diag.Errorf("could not disable transitive routing during Edge Equinix update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("enable_jumbo_frame") {
-		if edgeEquinix.EnableJumboFrame {
-			err := client.EnableJumboFrame(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable jumbo frame during Edge Equinix update: %v", err)
-			}
-		} else {
-			err := client.DisableJumboFrame(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable jumbo frame during Edge Equinix update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChanges("latitude", "longitude") {
-		gatewayForEaasFunctions.Latitude = edgeEquinix.Latitude
-		gatewayForEaasFunctions.Longitude = edgeEquinix.Longitude
-		err := client.UpdateEdgeSpokeGeoCoordinate(ctx, gatewayForEaasFunctions)
-		if err != nil {
-			return diag.Errorf("could not update geo coordinate during Edge Equinix update: %v", err)
-		}
-	}
-
-	if d.HasChange("included_advertised_spoke_routes") {
-		err := editAdvertisedSpokeRoutesWithRetry(client, gatewayForGatewayFunctions, d)
-		if err != nil {
-			return diag.Errorf("could not update included advertised spoke routes during Edge Equinix Gateway update: %v", err)
-		}
-	}
-
-	if d.HasChange("rx_queue_size") {
-		gatewayForGatewayFunctions.RxQueueSize = edgeEquinix.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not update rx queue size during Edge Equinix update: %v", err)
-		}
-	}
-
-	if d.HasChanges("management_egress_ip_prefix_list", "interfaces", "vlan",
-		"enable_auto_advertise_lan_cidrs", "enable_edge_active_standby", "enable_edge_active_standby_preemptive") {
-		err := client.UpdateEdgeEquinix(ctx, edgeEquinix)
-		if err != nil {
-			return diag.Errorf("could not update management egress ip prefix list, WAN/LAN/VLAN interfaces, "+
-				"DNS profile name, auto advertise LAN CIDRs, Edge active standby or Edge active standby preemptive "+
-				"during Edge CSP update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_single_ip_snat") {
-		gatewayForGatewayFunctions.GatewayName = edgeEquinix.GwName
-
-		if edgeEquinix.EnableSingleIpSnat {
-			err := client.EnableSNat(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("failed to enable single IP SNAT during Edge Equinix update: %s", err)
-			}
-		} else {
-			err := client.DisableSNat(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("failed to disable single IP SNAT during Edge Equinix update: %s", err)
-			}
-		}
-
+	err := updateEdgeGateway(ctx, client, d, edgeEquinixPlatform(client, edgeEquinix))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Partial(false)
@@ -983,7 +470,7 @@ func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixEdgeEquinixDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 	gwName := d.Get("gw_name").(string)
@@ -995,11 +482,15 @@ func resourceAviatrixEdgeEquinixDelete(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("could not delete Edge Equinix: %v", err)
 	}
 
+	if ztpFileDownloadPath == "" {
+		return nil
+	}
+
 	fileName := ztpFileDownloadPath + "/" + gwName + "-" + siteId + "-cloud-init.txt"
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, "could not remove the ztp file", map[string]interface{}{"error": err})
 	}
 
 	return nil
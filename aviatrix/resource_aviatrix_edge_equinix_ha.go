@@ -2,10 +2,10 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -14,14 +14,20 @@ import (
 
 func resourceAviatrixEdgeEquinixHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeEquinixHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeEquinixHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeEquinixHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeEquinixHaDelete,
+		CreateContext: resourceAviatrixEdgeEquinixHaCreate,
+		ReadContext:   resourceAviatrixEdgeEquinixHaRead,
+		UpdateContext: resourceAviatrixEdgeEquinixHaUpdate,
+		DeleteContext: resourceAviatrixEdgeEquinixHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -31,12 +37,18 @@ func resourceAviatrixEdgeEquinixHa() *schema.Resource {
 			},
 			"ztp_file_download_path": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old != ""
 				},
-				Description: "The location where the ZTP file will be stored.",
+				Description: "The location where the ZTP file will be stored. If not set, the ZTP file is not written to disk and is only exported in ztp_file_content.",
+			},
+			"ztp_file_content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Content of the ZTP cloud-init file.",
 			},
 			"interfaces": {
 				Type:        schema.TypeSet,
@@ -145,7 +157,7 @@ func marshalEdgeEquinixHaInput(d *schema.ResourceData) *goaviatrix.EdgeEquinixHa
 }
 
 func resourceAviatrixEdgeEquinixHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeEquinixHa := marshalEdgeEquinixHaInput(d)
 
@@ -153,17 +165,18 @@ func resourceAviatrixEdgeEquinixHaCreate(ctx context.Context, d *schema.Resource
 	if err != nil {
 		return diag.Errorf("failed to create Edge Equinix HA: %s", err)
 	}
+	d.Set("ztp_file_content", edgeEquinixHa.ZtpFileContent)
 
 	d.SetId(edgeEquinixHaName)
 	return resourceAviatrixEdgeEquinixHaRead(ctx, d, meta)
 }
 
 func resourceAviatrixEdgeEquinixHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.Get("primary_gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "-hagw")
 		d.Set("primary_gw_name", parts[0])
 		d.SetId(id)
@@ -212,7 +225,7 @@ func resourceAviatrixEdgeEquinixHaRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixEdgeEquinixHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeEquinixHa := marshalEdgeEquinixHaInput(d)
 
@@ -237,7 +250,7 @@ func resourceAviatrixEdgeEquinixHaUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixEdgeEquinixHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeEquinixHa := marshalEdgeEquinixHaInput(d)
 	accountName := d.Get("account_name").(string)
@@ -247,11 +260,15 @@ func resourceAviatrixEdgeEquinixHaDelete(ctx context.Context, d *schema.Resource
 		return diag.Errorf("could not delete Edge Equinix HA: %v", err)
 	}
 
+	if edgeEquinixHa.ZtpFileDownloadPath == "" {
+		return nil
+	}
+
 	fileName := edgeEquinixHa.ZtpFileDownloadPath + "/" + edgeEquinixHa.PrimaryGwName + "-hagw-cloud-init.txt"
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, "could not remove the ztp file", map[string]interface{}{"error": err})
 	}
 
 	return nil
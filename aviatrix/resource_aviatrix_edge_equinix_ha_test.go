@@ -44,7 +44,7 @@ func TestAccAviatrixEdgeEquinixHa_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ztp_file_download_path"},
+				ImportStateVerifyIgnore: []string{"ztp_file_download_path", "ztp_file_content"},
 			},
 		},
 	})
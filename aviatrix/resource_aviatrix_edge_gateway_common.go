@@ -0,0 +1,710 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// edgeGatewaySchema returns the schema of an edge gateway resource: the
+// attributes shared by every edge platform merged with the platform specific
+// attributes, such as the account, the device and the interfaces.
+func edgeGatewaySchema(platformSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	s := edgeGatewayCommonSchema()
+	for k, v := range platformSchema {
+		s[k] = v
+	}
+	return s
+}
+
+// edgeGatewayCommonSchema returns the management, BGP, learned CIDR approval,
+// geo coordinate, active standby and SNAT attributes shared by every edge
+// gateway resource.
+func edgeGatewayCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"management_egress_ip_prefix_list": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Set of management egress gateway IP/prefix.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"enable_management_over_private_network": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+			Description: "Enable management over private network.",
+		},
+		"dns_server_ip": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Description:  "DNS server IP.",
+			ValidateFunc: validation.IsIPAddress,
+			Deprecated:   "DNS server ip attribute will be removed in the future release.",
+		},
+		"secondary_dns_server_ip": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Description:  "Secondary DNS server IP.",
+			ValidateFunc: validation.IsIPAddress,
+			Deprecated:   "Secondary DNS server ip attribute will be removed in the future release.",
+		},
+		"local_as_number": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Local AS number.",
+			ValidateFunc: goaviatrix.ValidateASN,
+		},
+		"prepend_as_path": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: goaviatrix.ValidateASN,
+			},
+			MaxItems: 25,
+		},
+		"enable_edge_active_standby": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enables Edge Active-Standby Mode.",
+		},
+		"enable_edge_active_standby_preemptive": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enables Preemptive Mode for Edge Active-Standby, available only with Active-Standby enabled.",
+		},
+		"enable_learned_cidrs_approval": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Switch to enable/disable learned CIDR approval for BGP Spoke Gateway. Valid values: true, false.",
+		},
+		"approved_learned_cidrs": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+			Optional:    true,
+			Description: "Approved learned CIDRs for BGP Spoke Gateway.",
+		},
+		"spoke_bgp_manual_advertise_cidrs": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional:    true,
+			Description: "Intended CIDR list to be advertised to external BGP router.",
+		},
+		"enable_preserve_as_path": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable preserve as path when advertising manual summary CIDRs on BGP spoke gateway.",
+		},
+		"bgp_polling_time": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      defaultBgpPollingTime,
+			ValidateFunc: validation.IntBetween(10, 50),
+			Description:  "BGP route polling time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 10 and 50.",
+		},
+		"bgp_neighbor_status_polling_time": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      defaultBgpNeighborStatusPollingTime,
+			ValidateFunc: validation.IntBetween(1, 10),
+			Description:  "BGP neighbor status polling time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 1 and 10.",
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return old == "0"
+			},
+		},
+		"bgp_hold_time": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      defaultBgpHoldTime,
+			ValidateFunc: validation.IntBetween(12, 360),
+			Description:  "BGP hold time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 12 and 360.",
+		},
+		"enable_edge_transitive_routing": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable Edge transitive routing.",
+		},
+		"enable_jumbo_frame": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable jumbo frame.",
+		},
+		"latitude": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
+			Description:      "The latitude of the Edge as a Spoke.",
+			DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+		},
+		"longitude": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
+			Description:      "The longitude of the Edge as a Spoke.",
+			DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+		},
+		"rx_queue_size": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"1K", "2K", "4K"}, false),
+			Description:  "Ethernet interface RX queue size.",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "State of the Edge gateway.",
+		},
+		"enable_single_ip_snat": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Enable Single IP SNAT.",
+		},
+		"included_advertised_spoke_routes": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "A list of CIDRs to be advertised to on-prem as 'Included CIDR List'. When configured, it will replace all advertised routes from this VPC.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+// edgeGatewayPlatform connects the shared edge gateway logic to the API of
+// one edge platform.
+type edgeGatewayPlatform struct {
+	// name is the name of the platform used in error messages, e.g. "Edge Equinix".
+	name string
+	// update sends the platform specific configuration, such as the
+	// interfaces and the active standby mode, to the controller.
+	update func(ctx context.Context) error
+	// updateAttributes are the attributes applied by update.
+	updateAttributes []string
+	// updateAfterCreate is set when update has to be called after the
+	// creation for settings the create API does not accept.
+	updateAfterCreate bool
+}
+
+// edgeGatewayConfig holds the values of the edgeGatewayCommonSchema attributes
+// that are configured after the creation of the gateway.
+type edgeGatewayConfig struct {
+	GwName                            string
+	LocalAsNumber                     string
+	PrependAsPath                     []string
+	EnableEdgeActiveStandby           bool
+	EnableEdgeActiveStandbyPreemptive bool
+	EnableLearnedCidrsApproval        bool
+	ApprovedLearnedCidrs              []string
+	SpokeBgpManualAdvertisedCidrs     []string
+	EnablePreserveAsPath              bool
+	BgpPollingTime                    int
+	BgpBfdPollingTime                 int
+	BgpHoldTime                       int
+	EnableEdgeTransitiveRouting       bool
+	EnableJumboFrame                  bool
+	Latitude                          string
+	Longitude                         string
+	RxQueueSize                       string
+	EnableSingleIpSnat                bool
+}
+
+func expandEdgeGatewayConfig(d *schema.ResourceData) *edgeGatewayConfig {
+	config := &edgeGatewayConfig{
+		GwName:                            d.Get("gw_name").(string),
+		LocalAsNumber:                     d.Get("local_as_number").(string),
+		EnableEdgeActiveStandby:           d.Get("enable_edge_active_standby").(bool),
+		EnableEdgeActiveStandbyPreemptive: d.Get("enable_edge_active_standby_preemptive").(bool),
+		EnableLearnedCidrsApproval:        d.Get("enable_learned_cidrs_approval").(bool),
+		ApprovedLearnedCidrs:              getStringSet(d, "approved_learned_cidrs"),
+		SpokeBgpManualAdvertisedCidrs:     getStringSet(d, "spoke_bgp_manual_advertise_cidrs"),
+		EnablePreserveAsPath:              d.Get("enable_preserve_as_path").(bool),
+		BgpPollingTime:                    d.Get("bgp_polling_time").(int),
+		BgpBfdPollingTime:                 d.Get("bgp_neighbor_status_polling_time").(int),
+		BgpHoldTime:                       d.Get("bgp_hold_time").(int),
+		EnableEdgeTransitiveRouting:       d.Get("enable_edge_transitive_routing").(bool),
+		EnableJumboFrame:                  d.Get("enable_jumbo_frame").(bool),
+		Latitude:                          d.Get("latitude").(string),
+		Longitude:                         d.Get("longitude").(string),
+		RxQueueSize:                       d.Get("rx_queue_size").(string),
+		EnableSingleIpSnat:                d.Get("enable_single_ip_snat").(bool),
+	}
+
+	for _, v := range d.Get("prepend_as_path").([]interface{}) {
+		config.PrependAsPath = append(config.PrependAsPath, v.(string))
+	}
+
+	return config
+}
+
+// validateEdgeGatewayConfig checks the edgeGatewayCommonSchema attributes
+// before the gateway is created or updated.
+func validateEdgeGatewayConfig(config *edgeGatewayConfig) error {
+	if !config.EnableEdgeActiveStandby && config.EnableEdgeActiveStandbyPreemptive {
+		return fmt.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
+	}
+
+	if !config.EnableLearnedCidrsApproval && len(config.ApprovedLearnedCidrs) != 0 {
+		return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+	}
+
+	if len(config.PrependAsPath) != 0 && config.LocalAsNumber == "" {
+		return fmt.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
+	}
+
+	if config.Latitude != "" && config.Longitude != "" {
+		latitude, _ := strconv.ParseFloat(config.Latitude, 64)
+		longitude, _ := strconv.ParseFloat(config.Longitude, 64)
+		if latitude == 0 && longitude == 0 {
+			return fmt.Errorf("latitude and longitude must not be zero at the same time")
+		}
+	}
+
+	return nil
+}
+
+// configureEdgeGatewayAfterCreate applies the edgeGatewayCommonSchema
+// attributes that the create APIs of the edge platforms do not accept.
+func configureEdgeGatewayAfterCreate(ctx context.Context, client goaviatrix.ClientInterface, d *schema.ResourceData, platform *edgeGatewayPlatform) error {
+	config := expandEdgeGatewayConfig(d)
+
+	// use following variables to reuse functions for transit, spoke, gateway and EaaS
+	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
+		GwName: config.GwName,
+	}
+	gatewayForSpokeFunctions := &goaviatrix.SpokeVpc{
+		GwName: config.GwName,
+	}
+	gatewayForGatewayFunctions := &goaviatrix.Gateway{
+		GwName: config.GwName,
+	}
+	gatewayForEaasFunctions := &goaviatrix.EdgeSpoke{
+		GwName: config.GwName,
+	}
+
+	if config.LocalAsNumber != "" {
+		err := client.SetLocalASNumber(gatewayForTransitFunctions, config.LocalAsNumber)
+		if err != nil {
+			return fmt.Errorf("could not set 'local_as_number' after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if len(config.PrependAsPath) != 0 {
+		err := client.SetPrependASPath(gatewayForTransitFunctions, config.PrependAsPath)
+		if err != nil {
+			return fmt.Errorf("could not set 'prepend_as_path' after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if config.EnableLearnedCidrsApproval {
+		err := client.EnableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
+		if err != nil {
+			return fmt.Errorf("could not enable learned CIDRs approval after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if len(config.ApprovedLearnedCidrs) != 0 {
+		gatewayForTransitFunctions.ApprovedLearnedCidrs = config.ApprovedLearnedCidrs
+		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
+		if err != nil {
+			return fmt.Errorf("could not update approved CIDRs after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if len(config.SpokeBgpManualAdvertisedCidrs) != 0 {
+		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(config.SpokeBgpManualAdvertisedCidrs, ",")
+		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
+		if err != nil {
+			return fmt.Errorf("could not set spoke BGP manual advertised CIDRs after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if config.EnablePreserveAsPath {
+		err := client.EnableSpokePreserveAsPath(gatewayForSpokeFunctions)
+		if err != nil {
+			return fmt.Errorf("could not enable spoke preserve as path after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if config.BgpPollingTime >= 10 && config.BgpPollingTime != defaultBgpPollingTime {
+		err := client.SetBgpPollingTimeSpoke(gatewayForSpokeFunctions, config.BgpPollingTime)
+		if err != nil {
+			return fmt.Errorf("could not set bgp polling time after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if config.BgpBfdPollingTime >= 1 && config.BgpBfdPollingTime != defaultBgpNeighborStatusPollingTime {
+		err := client.SetBgpBfdPollingTimeSpoke(gatewayForSpokeFunctions, config.BgpBfdPollingTime)
+		if err != nil {
+			return fmt.Errorf("could not set bgp neighbor status polling time after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if config.BgpHoldTime >= 12 && config.BgpHoldTime != defaultBgpHoldTime {
+		err := client.ChangeBgpHoldTime(config.GwName, config.BgpHoldTime)
+		if err != nil {
+			return fmt.Errorf("could not change BGP Hold Time after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if config.EnableEdgeTransitiveRouting {
+		err := client.EnableEdgeSpokeTransitiveRouting(ctx, config.GwName)
+		if err != nil {
+			return fmt.Errorf("could not enable Edge transitive routing after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if config.EnableJumboFrame {
+		err := client.EnableJumboFrame(gatewayForGatewayFunctions)
+		if err != nil {
+			return fmt.Errorf("could not enable jumbo frame after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if config.Latitude != "" || config.Longitude != "" {
+		gatewayForEaasFunctions.Latitude = config.Latitude
+		gatewayForEaasFunctions.Longitude = config.Longitude
+		err := client.UpdateEdgeSpokeGeoCoordinate(ctx, gatewayForEaasFunctions)
+		if err != nil {
+			return fmt.Errorf("could not update geo coordinate after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if config.RxQueueSize != "" {
+		gatewayForGatewayFunctions.RxQueueSize = config.RxQueueSize
+		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
+		if err != nil {
+			return fmt.Errorf("could not set rx queue size after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if config.EnableSingleIpSnat {
+		gatewayForGatewayFunctions.GatewayName = config.GwName
+		err := client.EnableSNat(gatewayForGatewayFunctions)
+		if err != nil {
+			return fmt.Errorf("could not enable single IP SNAT after %s creation: %w", platform.name, err)
+		}
+	}
+
+	if platform.updateAfterCreate || config.EnableEdgeActiveStandby || config.EnableEdgeActiveStandbyPreemptive {
+		err := platform.update(ctx)
+		if err != nil {
+			return fmt.Errorf("could not update Edge active standby, Edge active standby preemptive or auto advertise LAN CIDRs after %s creation: %w", platform.name, err)
+		}
+	}
+
+	// set the advertised spoke cidr routes
+	err := editAdvertisedSpokeRoutesWithRetry(ctx, client, gatewayForGatewayFunctions, d)
+	if err != nil {
+		return fmt.Errorf("failed to edit advertised spoke vpc routes of %s %q: %w", platform.name, config.GwName, err)
+	}
+
+	return nil
+}
+
+// updateEdgeGateway applies the changes of an edge gateway resource. The
+// changed platform specific attributes are sent with platform.update.
+func updateEdgeGateway(ctx context.Context, client goaviatrix.ClientInterface, d *schema.ResourceData, platform *edgeGatewayPlatform) error {
+	config := expandEdgeGatewayConfig(d)
+
+	// use following variables to reuse functions for transit, spoke, gateway and EaaS
+	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
+		GwName: config.GwName,
+	}
+	gatewayForSpokeFunctions := &goaviatrix.SpokeVpc{
+		GwName: config.GwName,
+	}
+	gatewayForGatewayFunctions := &goaviatrix.Gateway{
+		GwName: config.GwName,
+	}
+	gatewayForEaasFunctions := &goaviatrix.EdgeSpoke{
+		GwName: config.GwName,
+	}
+
+	if d.HasChanges("local_as_number", "prepend_as_path") {
+		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(config.PrependAsPath) == 0 {
+			// prependASPath must be deleted from the controller before local_as_number can be changed
+			// Handle the case where prependASPath is empty here so that the API is not called twice
+			err := client.SetPrependASPath(gatewayForTransitFunctions, nil)
+			if err != nil {
+				return fmt.Errorf("could not delete prepend_as_path during %s update: %w", platform.name, err)
+			}
+		}
+
+		if d.HasChange("local_as_number") {
+			err := client.SetLocalASNumber(gatewayForTransitFunctions, config.LocalAsNumber)
+			if err != nil {
+				return fmt.Errorf("could not set local_as_number during %s update: %w", platform.name, err)
+			}
+		}
+
+		if d.HasChange("prepend_as_path") && len(config.PrependAsPath) > 0 {
+			err := client.SetPrependASPath(gatewayForTransitFunctions, config.PrependAsPath)
+			if err != nil {
+				return fmt.Errorf("could not set prepend_as_path during %s update: %w", platform.name, err)
+			}
+		}
+	}
+
+	if d.HasChange("enable_learned_cidrs_approval") {
+		if config.EnableLearnedCidrsApproval {
+			err := client.EnableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
+			if err != nil {
+				return fmt.Errorf("could not enable learned cidrs approval during %s update: %w", platform.name, err)
+			}
+		} else {
+			err := client.DisableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
+			if err != nil {
+				return fmt.Errorf("could not disable learned cidrs approval during %s update: %w", platform.name, err)
+			}
+		}
+	}
+
+	if config.EnableLearnedCidrsApproval && d.HasChange("approved_learned_cidrs") {
+		gatewayForTransitFunctions.ApprovedLearnedCidrs = config.ApprovedLearnedCidrs
+		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
+		if err != nil {
+			return fmt.Errorf("could not update approved learned CIDRs during %s update: %w", platform.name, err)
+		}
+	}
+
+	if d.HasChange("spoke_bgp_manual_advertise_cidrs") {
+		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(config.SpokeBgpManualAdvertisedCidrs, ",")
+		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
+		if err != nil {
+			return fmt.Errorf("could not set spoke BGP manual advertised CIDRs during %s update: %w", platform.name, err)
+		}
+	}
+
+	if d.HasChange("enable_preserve_as_path") {
+		if config.EnablePreserveAsPath {
+			err := client.EnableSpokePreserveAsPath(gatewayForSpokeFunctions)
+			if err != nil {
+				return fmt.Errorf("could not enable preserve as path during %s update: %w", platform.name, err)
+			}
+		} else {
+			err := client.DisableSpokePreserveAsPath(gatewayForSpokeFunctions)
+			if err != nil {
+				return fmt.Errorf("could not disable preserve as path during %s update: %w", platform.name, err)
+			}
+		}
+	}
+
+	if d.HasChange("bgp_polling_time") {
+		err := client.SetBgpPollingTimeSpoke(gatewayForSpokeFunctions, config.BgpPollingTime)
+		if err != nil {
+			return fmt.Errorf("could not set bgp polling time during %s update: %w", platform.name, err)
+		}
+	}
+
+	if d.HasChange("bgp_neighbor_status_polling_time") {
+		err := client.SetBgpBfdPollingTimeSpoke(gatewayForSpokeFunctions, config.BgpBfdPollingTime)
+		if err != nil {
+			return fmt.Errorf("could not set bgp neighbor status polling time during %s update: %w", platform.name, err)
+		}
+	}
+
+	if d.HasChange("bgp_hold_time") {
+		err := client.ChangeBgpHoldTime(config.GwName, config.BgpHoldTime)
+		if err != nil {
+			return fmt.Errorf("could not change bgp hold time during %s update: %w", platform.name, err)
+		}
+	}
+
+	if d.HasChange("enable_edge_transitive_routing") {
+		if config.EnableEdgeTransitiveRouting {
+			err := client.EnableEdgeSpokeTransitiveRouting(ctx, config.GwName)
+			if err != nil {
+				return fmt.Errorf("could not enable transitive routing during %s update: %w", platform.name, err)
+			}
+		} else {
+			err := client.DisableEdgeSpokeTransitiveRouting(ctx, config.GwName)
+			if err != nil {
+				return fmt.Errorf("could not disable transitive routing during %s update: %w", platform.name, err)
+			}
+		}
+	}
+
+	if d.HasChange("enable_jumbo_frame") {
+		if config.EnableJumboFrame {
+			err := client.EnableJumboFrame(gatewayForGatewayFunctions)
+			if err != nil {
+				return fmt.Errorf("could not enable jumbo frame during %s update: %w", platform.name, err)
+			}
+		} else {
+			err := client.DisableJumboFrame(gatewayForGatewayFunctions)
+			if err != nil {
+				return fmt.Errorf("could not disable jumbo frame during %s update: %w", platform.name, err)
+			}
+		}
+	}
+
+	if d.HasChanges("latitude", "longitude") {
+		gatewayForEaasFunctions.Latitude = config.Latitude
+		gatewayForEaasFunctions.Longitude = config.Longitude
+		err := client.UpdateEdgeSpokeGeoCoordinate(ctx, gatewayForEaasFunctions)
+		if err != nil {
+			return fmt.Errorf("could not update geo coordinate during %s update: %w", platform.name, err)
+		}
+	}
+
+	if d.HasChange("included_advertised_spoke_routes") {
+		err := editAdvertisedSpokeRoutesWithRetry(ctx, client, gatewayForGatewayFunctions, d)
+		if err != nil {
+			return fmt.Errorf("could not update included advertised spoke routes during %s update: %w", platform.name, err)
+		}
+	}
+
+	if d.HasChange("rx_queue_size") {
+		gatewayForGatewayFunctions.RxQueueSize = config.RxQueueSize
+		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
+		if err != nil {
+			return fmt.Errorf("could not update rx queue size during %s update: %w", platform.name, err)
+		}
+	}
+
+	if d.HasChanges(platform.updateAttributes...) {
+		err := platform.update(ctx)
+		if err != nil {
+			return fmt.Errorf("could not update %s during %s update: %w",
+				strings.Join(platform.updateAttributes, ", "), platform.name, err)
+		}
+	}
+
+	if d.HasChange("enable_single_ip_snat") {
+		gatewayForGatewayFunctions.GatewayName = config.GwName
+
+		if config.EnableSingleIpSnat {
+			err := client.EnableSNat(gatewayForGatewayFunctions)
+			if err != nil {
+				return fmt.Errorf("failed to enable single IP SNAT during %s update: %w", platform.name, err)
+			}
+		} else {
+			err := client.DisableSNat(gatewayForGatewayFunctions)
+			if err != nil {
+				return fmt.Errorf("failed to disable single IP SNAT during %s update: %w", platform.name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// edgeGatewayState holds the values of the edgeGatewayCommonSchema attributes
+// as returned by the platform specific read API.
+type edgeGatewayState struct {
+	GwName                             string
+	ManagementEgressIpPrefix           string
+	EnableManagementOverPrivateNetwork bool
+	DnsServerIp                        string
+	SecondaryDnsServerIp               string
+	LocalAsNumber                      string
+	PrependAsPath                      []string
+	EnableEdgeActiveStandby            bool
+	EnableEdgeActiveStandbyPreemptive  bool
+	EnableLearnedCidrsApproval         bool
+	SpokeBgpManualAdvertisedCidrs      []string
+	EnablePreserveAsPath               bool
+	BgpPollingTime                     int
+	BgpBfdPollingTime                  int
+	BgpHoldTime                        int
+	EnableEdgeTransitiveRouting        bool
+	EnableJumboFrame                   bool
+	Latitude                           float64
+	Longitude                          float64
+	RxQueueSize                        string
+	State                              string
+	EnableNat                          string
+	SnatMode                           string
+	AdvertisedCidrList                 []string
+}
+
+// flattenEdgeGateway sets the edgeGatewayCommonSchema attributes from the
+// state of the gateway.
+func flattenEdgeGateway(client goaviatrix.ClientInterface, d *schema.ResourceData, state *edgeGatewayState) error {
+	d.Set("enable_management_over_private_network", state.EnableManagementOverPrivateNetwork)
+	d.Set("dns_server_ip", state.DnsServerIp)
+	d.Set("secondary_dns_server_ip", state.SecondaryDnsServerIp)
+	d.Set("local_as_number", state.LocalAsNumber)
+	d.Set("prepend_as_path", state.PrependAsPath)
+	d.Set("enable_edge_active_standby", state.EnableEdgeActiveStandby)
+	d.Set("enable_edge_active_standby_preemptive", state.EnableEdgeActiveStandbyPreemptive)
+	d.Set("enable_learned_cidrs_approval", state.EnableLearnedCidrsApproval)
+
+	if state.ManagementEgressIpPrefix == "" {
+		d.Set("management_egress_ip_prefix_list", nil)
+	} else {
+		d.Set("management_egress_ip_prefix_list", strings.Split(state.ManagementEgressIpPrefix, ","))
+	}
+
+	if state.EnableLearnedCidrsApproval {
+		spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfig(&goaviatrix.SpokeVpc{GwName: state.GwName})
+		if err != nil {
+			return fmt.Errorf("could not get advanced config: %w", err)
+		}
+
+		err = d.Set("approved_learned_cidrs", spokeAdvancedConfig.ApprovedLearnedCidrs)
+		if err != nil {
+			return fmt.Errorf("could not set approved_learned_cidrs into state: %w", err)
+		}
+	} else {
+		d.Set("approved_learned_cidrs", nil)
+	}
+
+	spokeBgpManualAdvertisedCidrs := getStringSet(d, "spoke_bgp_manual_advertise_cidrs")
+	if len(goaviatrix.Difference(spokeBgpManualAdvertisedCidrs, state.SpokeBgpManualAdvertisedCidrs)) != 0 ||
+		len(goaviatrix.Difference(state.SpokeBgpManualAdvertisedCidrs, spokeBgpManualAdvertisedCidrs)) != 0 {
+		d.Set("spoke_bgp_manual_advertise_cidrs", state.SpokeBgpManualAdvertisedCidrs)
+	} else {
+		d.Set("spoke_bgp_manual_advertise_cidrs", spokeBgpManualAdvertisedCidrs)
+	}
+
+	d.Set("enable_preserve_as_path", state.EnablePreserveAsPath)
+	d.Set("bgp_polling_time", state.BgpPollingTime)
+	d.Set("bgp_neighbor_status_polling_time", state.BgpBfdPollingTime)
+	d.Set("bgp_hold_time", state.BgpHoldTime)
+	d.Set("enable_edge_transitive_routing", state.EnableEdgeTransitiveRouting)
+	d.Set("enable_jumbo_frame", state.EnableJumboFrame)
+	if state.Latitude != 0 || state.Longitude != 0 {
+		d.Set("latitude", fmt.Sprintf("%.6f", state.Latitude))
+		d.Set("longitude", fmt.Sprintf("%.6f", state.Longitude))
+	} else {
+		d.Set("latitude", "")
+		d.Set("longitude", "")
+	}
+
+	if len(state.AdvertisedCidrList) > 0 {
+		d.Set("included_advertised_spoke_routes", state.AdvertisedCidrList)
+	}
+
+	d.Set("rx_queue_size", state.RxQueueSize)
+	d.Set("state", state.State)
+	d.Set("enable_single_ip_snat", state.EnableNat == "yes" && state.SnatMode == "primary")
+
+	return nil
+}
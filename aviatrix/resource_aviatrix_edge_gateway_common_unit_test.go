@@ -0,0 +1,459 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// testEdgeGatewayResource describes an edge gateway resource built on the
+// shared edge gateway logic.
+type testEdgeGatewayResource struct {
+	name     string
+	resource func() *schema.Resource
+	// platform returns the edgeGatewayPlatform of the resource for the
+	// configuration in d.
+	platform func(client goaviatrix.ClientInterface, d *schema.ResourceData) *edgeGatewayPlatform
+	// updateCalls returns the number of calls to the platform update API.
+	updateCalls func(client *goaviatrix.ClientInterfaceMock) int
+}
+
+func testEdgeGatewayResources() []testEdgeGatewayResource {
+	return []testEdgeGatewayResource{
+		{
+			name:     "aviatrix_edge_csp",
+			resource: resourceAviatrixEdgeCSP,
+			platform: func(client goaviatrix.ClientInterface, d *schema.ResourceData) *edgeGatewayPlatform {
+				return edgeCSPPlatform(client, marshalEdgeCSPInput(d))
+			},
+			updateCalls: func(client *goaviatrix.ClientInterfaceMock) int { return len(client.UpdateEdgeCSPCalls()) },
+		},
+		{
+			name:     "aviatrix_edge_equinix",
+			resource: resourceAviatrixEdgeEquinix,
+			platform: func(client goaviatrix.ClientInterface, d *schema.ResourceData) *edgeGatewayPlatform {
+				return edgeEquinixPlatform(client, marshalEdgeEquinixInput(d))
+			},
+			updateCalls: func(client *goaviatrix.ClientInterfaceMock) int { return len(client.UpdateEdgeEquinixCalls()) },
+		},
+		{
+			name:     "aviatrix_edge_megaport",
+			resource: resourceAviatrixEdgeMegaport,
+			platform: func(client goaviatrix.ClientInterface, d *schema.ResourceData) *edgeGatewayPlatform {
+				edgeMegaport, _ := marshalEdgeMegaportInput(d)
+				return edgeMegaportPlatform(client, edgeMegaport)
+			},
+			updateCalls: func(client *goaviatrix.ClientInterfaceMock) int { return len(client.UpdateEdgeMegaportCalls()) },
+		},
+		{
+			name:     "aviatrix_edge_neo",
+			resource: resourceAviatrixEdgeNEO,
+			platform: func(client goaviatrix.ClientInterface, d *schema.ResourceData) *edgeGatewayPlatform {
+				return edgeNEOPlatform(client, marshalEdgeNEOInput(d))
+			},
+			updateCalls: func(client *goaviatrix.ClientInterfaceMock) int { return len(client.UpdateEdgeNEOCalls()) },
+		},
+		{
+			name:     "aviatrix_edge_platform",
+			resource: resourceAviatrixEdgePlatform,
+			platform: func(client goaviatrix.ClientInterface, d *schema.ResourceData) *edgeGatewayPlatform {
+				return edgePlatformPlatform(client, marshalEdgePlatformInput(d))
+			},
+			updateCalls: func(client *goaviatrix.ClientInterfaceMock) int { return len(client.UpdateEdgeNEOCalls()) },
+		},
+		{
+			name:     "aviatrix_edge_zededa",
+			resource: resourceAviatrixEdgeZededa,
+			platform: func(client goaviatrix.ClientInterface, d *schema.ResourceData) *edgeGatewayPlatform {
+				return edgeZededaPlatform(client, marshalEdgeZededaInput(d))
+			},
+			updateCalls: func(client *goaviatrix.ClientInterfaceMock) int { return len(client.UpdateEdgeCSPCalls()) },
+		},
+		{
+			name:     "aviatrix_edge_gateway_selfmanaged",
+			resource: resourceAviatrixEdgeGatewaySelfmanaged,
+			platform: func(client goaviatrix.ClientInterface, d *schema.ResourceData) *edgeGatewayPlatform {
+				edgeSpoke, _ := marshalEdgeGatewaySelfmanagedInput(d)
+				return edgeGatewaySelfmanagedPlatform(client, edgeSpoke)
+			},
+			updateCalls: func(client *goaviatrix.ClientInterfaceMock) int { return len(client.UpdateEdgeSpokeCalls()) },
+		},
+		{
+			name:     "aviatrix_edge_vm_selfmanaged",
+			resource: resourceAviatrixEdgeVmSelfmanaged,
+			platform: func(client goaviatrix.ClientInterface, d *schema.ResourceData) *edgeGatewayPlatform {
+				return edgeVmSelfmanagedPlatform(client, marshalEdgeVmSelfmanagedInput(d))
+			},
+			updateCalls: func(client *goaviatrix.ClientInterfaceMock) int { return len(client.UpdateEdgeSpokeCalls()) },
+		},
+	}
+}
+
+// testEdgeGatewayClient returns a client mock accepting every call made by
+// the shared edge gateway logic.
+func testEdgeGatewayClient() *goaviatrix.ClientInterfaceMock {
+	return &goaviatrix.ClientInterfaceMock{
+		SetLocalASNumberFunc:                   func(transitGateway *goaviatrix.TransitVpc, localASNumber string) error { return nil },
+		SetPrependASPathFunc:                   func(transitGateway *goaviatrix.TransitVpc, prependASPath []string) error { return nil },
+		EnableTransitLearnedCidrsApprovalFunc:  func(gateway *goaviatrix.TransitVpc) error { return nil },
+		DisableTransitLearnedCidrsApprovalFunc: func(gateway *goaviatrix.TransitVpc) error { return nil },
+		UpdateTransitPendingApprovedCidrsFunc:  func(gateway *goaviatrix.TransitVpc) error { return nil },
+		SetBgpManualSpokeAdvertisedNetworksFunc: func(transitGw *goaviatrix.TransitVpc) error {
+			return nil
+		},
+		EnableSpokePreserveAsPathFunc:        func(spokeGateway *goaviatrix.SpokeVpc) error { return nil },
+		DisableSpokePreserveAsPathFunc:       func(spokeGateway *goaviatrix.SpokeVpc) error { return nil },
+		SetBgpPollingTimeSpokeFunc:           func(spokeGateway *goaviatrix.SpokeVpc, newPollingTime int) error { return nil },
+		SetBgpBfdPollingTimeSpokeFunc:        func(spokeGateway *goaviatrix.SpokeVpc, newPollingTime int) error { return nil },
+		ChangeBgpHoldTimeFunc:                func(gwName string, holdTime int) error { return nil },
+		EnableEdgeSpokeTransitiveRoutingFunc: func(ctx context.Context, name string) error { return nil },
+		EnableJumboFrameFunc:                 func(gateway *goaviatrix.Gateway) error { return nil },
+		DisableJumboFrameFunc:                func(gateway *goaviatrix.Gateway) error { return nil },
+		UpdateEdgeSpokeGeoCoordinateFunc:     func(ctx context.Context, edgeSpoke *goaviatrix.EdgeSpoke) error { return nil },
+		SetRxQueueSizeFunc:                   func(gateway *goaviatrix.Gateway) error { return nil },
+		EnableSNatFunc:                       func(gateway *goaviatrix.Gateway) error { return nil },
+		DisableSNatFunc:                      func(gateway *goaviatrix.Gateway) error { return nil },
+		EditGatewayAdvertisedCidrFunc:        func(gateway *goaviatrix.Gateway) error { return nil },
+		UpdateEdgeCSPFunc:                    func(ctx context.Context, edgeCSP *goaviatrix.EdgeCSP) error { return nil },
+		UpdateEdgeEquinixFunc:                func(ctx context.Context, edgeEquinix *goaviatrix.EdgeEquinix) error { return nil },
+		UpdateEdgeMegaportFunc:               func(ctx context.Context, edgeMegaport *goaviatrix.EdgeMegaport) error { return nil },
+		UpdateEdgeNEOFunc:                    func(ctx context.Context, edgeNEO *goaviatrix.EdgeNEO) error { return nil },
+		UpdateEdgeSpokeFunc:                  func(ctx context.Context, edgeSpoke *goaviatrix.EdgeSpoke) error { return nil },
+	}
+}
+
+// testEdgeGatewayUpdateData returns the resource data of an existing edge
+// gateway with the shared attributes set to their defaults, with changes
+// applied to its configuration.
+func testEdgeGatewayUpdateData(t *testing.T, r *schema.Resource, changes map[string]interface{}) *schema.ResourceData {
+	state := &terraform.InstanceState{
+		ID: "edge-1",
+		Attributes: map[string]string{
+			"id":                                     "edge-1",
+			"gw_name":                                "edge-1",
+			"site_id":                                "site-1",
+			"local_as_number":                        "65001",
+			"enable_edge_active_standby":             "false",
+			"enable_edge_active_standby_preemptive":  "false",
+			"enable_learned_cidrs_approval":          "false",
+			"enable_preserve_as_path":                "false",
+			"bgp_polling_time":                       "50",
+			"bgp_neighbor_status_polling_time":       "5",
+			"bgp_hold_time":                          "180",
+			"enable_edge_transitive_routing":         "false",
+			"enable_jumbo_frame":                     "false",
+			"enable_single_ip_snat":                  "false",
+			"enable_management_over_private_network": "false",
+			"enable_auto_advertise_lan_cidrs":        "true",
+			"latitude":                               "",
+			"longitude":                              "",
+		},
+	}
+	config := map[string]interface{}{
+		"gw_name":         "edge-1",
+		"site_id":         "site-1",
+		"local_as_number": "65001",
+	}
+	for k, v := range changes {
+		config[k] = v
+	}
+
+	diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("could not diff resource: %v", err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("could not build resource data: %v", err)
+	}
+	return d
+}
+
+func TestEdgeGatewayResources_CommonSchema(t *testing.T) {
+	common := edgeGatewayCommonSchema()
+	for _, tt := range testEdgeGatewayResources() {
+		t.Run(tt.name, func(t *testing.T) {
+			s := tt.resource().Schema
+			for k, want := range common {
+				got, ok := s[k]
+				if !assert.True(t, ok, "missing attribute %s", k) {
+					continue
+				}
+				assert.Equal(t, want.Type, got.Type, k)
+				assert.Equal(t, want.Optional, got.Optional, k)
+				assert.Equal(t, want.Computed, got.Computed, k)
+				assert.Equal(t, want.ForceNew, got.ForceNew, k)
+				assert.Equal(t, want.Default, got.Default, k)
+				assert.Equal(t, want.Description, got.Description, k)
+			}
+		})
+	}
+}
+
+func TestValidateEdgeGatewayConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  edgeGatewayConfig
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			config: edgeGatewayConfig{EnableEdgeActiveStandby: true, EnableEdgeActiveStandbyPreemptive: true, Latitude: "1.5", Longitude: "0"},
+		},
+		{
+			name:    "preemptive without active standby",
+			config:  edgeGatewayConfig{EnableEdgeActiveStandbyPreemptive: true},
+			wantErr: "could not configure Preemptive Mode with Active-Standby disabled",
+		},
+		{
+			name:    "approved CIDRs without approval",
+			config:  edgeGatewayConfig{ApprovedLearnedCidrs: []string{"10.0.0.0/16"}},
+			wantErr: "'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false",
+		},
+		{
+			name:    "prepend AS path without local AS number",
+			config:  edgeGatewayConfig{PrependAsPath: []string{"65001"}},
+			wantErr: "'prepend_as_path' must be empty if 'local_as_number' is not set",
+		},
+		{
+			name:    "zero coordinates",
+			config:  edgeGatewayConfig{Latitude: "0", Longitude: "0.000000"},
+			wantErr: "latitude and longitude must not be zero at the same time",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateEdgeGatewayConfig(&tt.config)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigureEdgeGatewayAfterCreate(t *testing.T) {
+	for _, tt := range testEdgeGatewayResources() {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testEdgeGatewayClient()
+			d := schema.TestResourceDataRaw(t, tt.resource().Schema, map[string]interface{}{
+				"gw_name":                          "edge-1",
+				"site_id":                          "site-1",
+				"local_as_number":                  "65001",
+				"prepend_as_path":                  []interface{}{"65001", "65001"},
+				"enable_edge_active_standby":       true,
+				"enable_learned_cidrs_approval":    true,
+				"approved_learned_cidrs":           []interface{}{"10.1.0.0/16"},
+				"spoke_bgp_manual_advertise_cidrs": []interface{}{"10.2.0.0/16"},
+				"enable_preserve_as_path":          true,
+				"bgp_polling_time":                 20,
+				"bgp_neighbor_status_polling_time": 3,
+				"bgp_hold_time":                    60,
+				"enable_edge_transitive_routing":   true,
+				"enable_jumbo_frame":               true,
+				"latitude":                         "37.409000",
+				"longitude":                        "-122.041000",
+				"rx_queue_size":                    "2K",
+				"enable_single_ip_snat":            true,
+				"included_advertised_spoke_routes": []interface{}{"10.3.0.0/16"},
+			})
+
+			err := configureEdgeGatewayAfterCreate(context.Background(), client, d, tt.platform(client, d))
+
+			assert.NoError(t, err)
+			if assert.Len(t, client.SetLocalASNumberCalls(), 1) {
+				assert.Equal(t, "65001", client.SetLocalASNumberCalls()[0].LocalASNumber)
+			}
+			assert.Len(t, client.SetPrependASPathCalls(), 1)
+			assert.Len(t, client.EnableTransitLearnedCidrsApprovalCalls(), 1)
+			assert.Len(t, client.UpdateTransitPendingApprovedCidrsCalls(), 1)
+			assert.Len(t, client.SetBgpManualSpokeAdvertisedNetworksCalls(), 1)
+			assert.Len(t, client.EnableSpokePreserveAsPathCalls(), 1)
+			assert.Len(t, client.SetBgpPollingTimeSpokeCalls(), 1)
+			assert.Len(t, client.SetBgpBfdPollingTimeSpokeCalls(), 1)
+			assert.Len(t, client.ChangeBgpHoldTimeCalls(), 1)
+			assert.Len(t, client.EnableEdgeSpokeTransitiveRoutingCalls(), 1)
+			assert.Len(t, client.EnableJumboFrameCalls(), 1)
+			if assert.Len(t, client.UpdateEdgeSpokeGeoCoordinateCalls(), 1) {
+				assert.Equal(t, "37.409000", client.UpdateEdgeSpokeGeoCoordinateCalls()[0].EdgeSpoke.Latitude)
+			}
+			assert.Len(t, client.SetRxQueueSizeCalls(), 1)
+			if assert.Len(t, client.EnableSNatCalls(), 1) {
+				assert.Equal(t, "edge-1", client.EnableSNatCalls()[0].Gateway.GatewayName)
+			}
+			if assert.Len(t, client.EditGatewayAdvertisedCidrCalls(), 1) {
+				assert.Equal(t, []string{"10.3.0.0/16"}, client.EditGatewayAdvertisedCidrCalls()[0].Gateway.AdvertisedSpokeRoutes)
+			}
+			// active standby is only accepted by the platform update API
+			assert.Equal(t, 1, tt.updateCalls(client))
+		})
+	}
+}
+
+func TestConfigureEdgeGatewayAfterCreate_Defaults(t *testing.T) {
+	for _, tt := range testEdgeGatewayResources() {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testEdgeGatewayClient()
+			d := schema.TestResourceDataRaw(t, tt.resource().Schema, map[string]interface{}{
+				"gw_name": "edge-1",
+				"site_id": "site-1",
+			})
+
+			err := configureEdgeGatewayAfterCreate(context.Background(), client, d, tt.platform(client, d))
+
+			assert.NoError(t, err)
+			assert.Empty(t, client.SetLocalASNumberCalls())
+			assert.Empty(t, client.SetBgpPollingTimeSpokeCalls())
+			assert.Empty(t, client.SetBgpBfdPollingTimeSpokeCalls())
+			assert.Empty(t, client.ChangeBgpHoldTimeCalls())
+			assert.Empty(t, client.UpdateEdgeSpokeGeoCoordinateCalls())
+			assert.Empty(t, client.EnableSNatCalls())
+			assert.Len(t, client.EditGatewayAdvertisedCidrCalls(), 1)
+			assert.Equal(t, 0, tt.updateCalls(client))
+		})
+	}
+}
+
+func TestConfigureEdgeGatewayAfterCreate_WhenCallFails(t *testing.T) {
+	client := testEdgeGatewayClient()
+	client.ChangeBgpHoldTimeFunc = func(gwName string, holdTime int) error {
+		return errors.New("boom")
+	}
+	d := schema.TestResourceDataRaw(t, resourceAviatrixEdgeEquinix().Schema, map[string]interface{}{
+		"gw_name":       "edge-1",
+		"bgp_hold_time": 60,
+	})
+
+	err := configureEdgeGatewayAfterCreate(context.Background(), client, d, edgeEquinixPlatform(client, marshalEdgeEquinixInput(d)))
+
+	assert.EqualError(t, err, "could not change BGP Hold Time after Edge Equinix creation: boom")
+	assert.Empty(t, client.EditGatewayAdvertisedCidrCalls())
+}
+
+func TestUpdateEdgeGateway(t *testing.T) {
+	for _, tt := range testEdgeGatewayResources() {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testEdgeGatewayClient()
+			r := tt.resource()
+			d := testEdgeGatewayUpdateData(t, r, map[string]interface{}{
+				"bgp_neighbor_status_polling_time": 3,
+				"bgp_hold_time":                    90,
+				"enable_jumbo_frame":               true,
+				"latitude":                         "37.409000",
+				"longitude":                        "-122.041000",
+				"enable_single_ip_snat":            true,
+				"enable_edge_active_standby":       true,
+			})
+
+			err := updateEdgeGateway(context.Background(), client, d, tt.platform(client, d))
+
+			assert.NoError(t, err)
+			if assert.Len(t, client.SetBgpBfdPollingTimeSpokeCalls(), 1) {
+				assert.Equal(t, 3, client.SetBgpBfdPollingTimeSpokeCalls()[0].NewPollingTime)
+			}
+			if assert.Len(t, client.ChangeBgpHoldTimeCalls(), 1) {
+				assert.Equal(t, 90, client.ChangeBgpHoldTimeCalls()[0].HoldTime)
+			}
+			assert.Len(t, client.EnableJumboFrameCalls(), 1)
+			assert.Len(t, client.UpdateEdgeSpokeGeoCoordinateCalls(), 1)
+			assert.Len(t, client.EnableSNatCalls(), 1)
+			assert.Equal(t, 1, tt.updateCalls(client))
+			assert.Empty(t, client.SetLocalASNumberCalls())
+			assert.Empty(t, client.SetBgpPollingTimeSpokeCalls())
+			assert.Empty(t, client.EditGatewayAdvertisedCidrCalls())
+		})
+	}
+}
+
+func TestUpdateEdgeGateway_LocalAsNumber(t *testing.T) {
+	client := testEdgeGatewayClient()
+	d := testEdgeGatewayUpdateData(t, resourceAviatrixEdgeEquinix(), map[string]interface{}{
+		"local_as_number": "65002",
+	})
+
+	err := updateEdgeGateway(context.Background(), client, d, edgeEquinixPlatform(client, marshalEdgeEquinixInput(d)))
+
+	assert.NoError(t, err)
+	// prepend_as_path is cleared before local_as_number can be changed
+	if assert.Len(t, client.SetPrependASPathCalls(), 1) {
+		assert.Nil(t, client.SetPrependASPathCalls()[0].PrependASPath)
+	}
+	if assert.Len(t, client.SetLocalASNumberCalls(), 1) {
+		assert.Equal(t, "65002", client.SetLocalASNumberCalls()[0].LocalASNumber)
+	}
+	assert.Empty(t, client.UpdateEdgeEquinixCalls())
+}
+
+func TestFlattenEdgeGateway(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetSpokeGatewayAdvancedConfigFunc: func(spokeGateway *goaviatrix.SpokeVpc) (*goaviatrix.SpokeGatewayAdvancedConfig, error) {
+			assert.Equal(t, "edge-1", spokeGateway.GwName)
+			return &goaviatrix.SpokeGatewayAdvancedConfig{ApprovedLearnedCidrs: []string{"10.1.0.0/16"}}, nil
+		},
+	}
+
+	for _, tt := range testEdgeGatewayResources() {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, tt.resource().Schema, map[string]interface{}{
+				"gw_name": "edge-1",
+			})
+
+			err := flattenEdgeGateway(client, d, &edgeGatewayState{
+				GwName:                        "edge-1",
+				ManagementEgressIpPrefix:      "1.1.1.1/32,2.2.2.2/32",
+				LocalAsNumber:                 "65001",
+				PrependAsPath:                 []string{"65001"},
+				EnableLearnedCidrsApproval:    true,
+				SpokeBgpManualAdvertisedCidrs: []string{"10.2.0.0/16"},
+				BgpPollingTime:                50,
+				BgpBfdPollingTime:             5,
+				BgpHoldTime:                   180,
+				EnableJumboFrame:              true,
+				Latitude:                      37.409,
+				Longitude:                     -122.041,
+				RxQueueSize:                   "2K",
+				State:                         "up",
+				EnableNat:                     "yes",
+				SnatMode:                      "primary",
+				AdvertisedCidrList:            []string{"10.3.0.0/16"},
+			})
+
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, []string{"1.1.1.1/32", "2.2.2.2/32"}, getStringSet(d, "management_egress_ip_prefix_list"))
+			assert.Equal(t, "65001", d.Get("local_as_number"))
+			assert.Equal(t, []string{"65001"}, getStringList(d, "prepend_as_path"))
+			assert.Equal(t, []string{"10.1.0.0/16"}, getStringSet(d, "approved_learned_cidrs"))
+			assert.Equal(t, []string{"10.2.0.0/16"}, getStringSet(d, "spoke_bgp_manual_advertise_cidrs"))
+			assert.Equal(t, 5, d.Get("bgp_neighbor_status_polling_time"))
+			assert.Equal(t, 180, d.Get("bgp_hold_time"))
+			assert.Equal(t, true, d.Get("enable_jumbo_frame"))
+			assert.Equal(t, "37.409000", d.Get("latitude"))
+			assert.Equal(t, "-122.041000", d.Get("longitude"))
+			assert.Equal(t, "up", d.Get("state"))
+			assert.Equal(t, true, d.Get("enable_single_ip_snat"))
+			assert.Equal(t, []string{"10.3.0.0/16"}, getStringSet(d, "included_advertised_spoke_routes"))
+		})
+	}
+}
+
+func TestFlattenEdgeGateway_WhenAdvancedConfigFails(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetSpokeGatewayAdvancedConfigFunc: func(spokeGateway *goaviatrix.SpokeVpc) (*goaviatrix.SpokeGatewayAdvancedConfig, error) {
+			return nil, errors.New("boom")
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceAviatrixEdgeEquinix().Schema, map[string]interface{}{
+		"gw_name": "edge-1",
+	})
+
+	err := flattenEdgeGateway(client, d, &edgeGatewayState{GwName: "edge-1", EnableLearnedCidrsApproval: true})
+
+	assert.EqualError(t, err, "could not get advanced config: boom")
+}
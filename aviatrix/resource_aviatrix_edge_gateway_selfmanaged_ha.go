@@ -2,10 +2,10 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,14 +16,20 @@ import (
 
 func resourceAviatrixEdgeGatewaySelfmanagedHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeGatewaySelfmanagedHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedHaDelete,
+		CreateContext: resourceAviatrixEdgeGatewaySelfmanagedHaCreate,
+		ReadContext:   resourceAviatrixEdgeGatewaySelfmanagedHaRead,
+		UpdateContext: resourceAviatrixEdgeGatewaySelfmanagedHaUpdate,
+		DeleteContext: resourceAviatrixEdgeGatewaySelfmanagedHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -46,12 +52,18 @@ func resourceAviatrixEdgeGatewaySelfmanagedHa() *schema.Resource {
 			},
 			"ztp_file_download_path": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old != ""
 				},
-				Description: "The location where the ZTP file will be stored.",
+				Description: "The location where the ZTP file will be stored. If not set, the ZTP file is not written to disk and is only exported in ztp_file_content.",
+			},
+			"ztp_file_content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Content of the ZTP file. Base64 encoded if ztp_file_type is 'iso'.",
 			},
 			"dns_server_ip": {
 				Type:         schema.TypeString,
@@ -175,7 +187,7 @@ func marshalEdgeGatewaySelfmanagedHaInput(d *schema.ResourceData) *goaviatrix.Ed
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeGatewaySelfmanagedHa := marshalEdgeGatewaySelfmanagedHaInput(d)
 
@@ -183,17 +195,18 @@ func resourceAviatrixEdgeGatewaySelfmanagedHaCreate(ctx context.Context, d *sche
 	if err != nil {
 		return diag.Errorf("failed to create Edge Gateway Selfmanaged HA: %s", err)
 	}
+	d.Set("ztp_file_content", edgeGatewaySelfmanagedHa.ZtpFileContent)
 
 	d.SetId(edgeGatewaySelfmanagedHaName)
 	return resourceAviatrixEdgeGatewaySelfmanagedHaRead(ctx, d, meta)
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.Get("primary_gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "-hagw")
 		d.Set("primary_gw_name", parts[0])
 		d.SetId(id)
@@ -253,7 +266,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedHaRead(ctx context.Context, d *schema
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeGatewaySelfmanagedHa := marshalEdgeGatewaySelfmanagedHaInput(d)
 
@@ -278,7 +291,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedHaUpdate(ctx context.Context, d *sche
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.DeleteEdgeSpoke(ctx, d.Id())
 	if err != nil {
@@ -287,6 +300,10 @@ func resourceAviatrixEdgeGatewaySelfmanagedHaDelete(ctx context.Context, d *sche
 
 	edgeGatewaySelfmanagedHa := marshalEdgeGatewaySelfmanagedHaInput(d)
 
+	if edgeGatewaySelfmanagedHa.ZtpFileDownloadPath == "" {
+		return nil
+	}
+
 	var fileName string
 	if edgeGatewaySelfmanagedHa.ZtpFileType == "iso" {
 		fileName = edgeGatewaySelfmanagedHa.ZtpFileDownloadPath + "/" + edgeGatewaySelfmanagedHa.PrimaryGwName + "-" + edgeGatewaySelfmanagedHa.SiteId + "-ha.iso"
@@ -296,7 +313,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedHaDelete(ctx context.Context, d *sche
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, "could not remove the ztp file", map[string]interface{}{"error": err})
 	}
 
 	return nil
@@ -51,7 +51,7 @@ func TestAccAviatrixEdgeGatewaySelfmanaged_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ztp_file_type", "ztp_file_download_path"},
+				ImportStateVerifyIgnore: []string{"ztp_file_type", "ztp_file_download_path", "ztp_file_content"},
 			},
 		},
 	})
@@ -4,13 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,15 +18,21 @@ import (
 
 func resourceAviatrixEdgeMegaport() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeMegaportCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeMegaportRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeMegaportUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeMegaportDelete,
+		CreateContext: resourceAviatrixEdgeMegaportCreate,
+		ReadContext:   resourceAviatrixEdgeMegaportRead,
+		UpdateContext: resourceAviatrixEdgeMegaportUpdate,
+		DeleteContext: resourceAviatrixEdgeMegaportDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
+		Schema: edgeGatewaySchema(map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -54,155 +60,6 @@ func resourceAviatrixEdgeMegaport() *schema.Resource {
 				},
 				Description: "The location where the ZTP file will be stored locally.",
 			},
-			"management_egress_ip_prefix_list": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "Set of management egress gateway IP/prefix.",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-			"enable_management_over_private_network": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     false,
-				Description: "Enable management over private network.",
-			},
-			"dns_server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "DNS server IP.",
-				ValidateFunc: validation.IsIPAddress,
-				Deprecated:   "DNS server ip attribute will be removed in the future release.",
-			},
-			"secondary_dns_server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "Secondary DNS server IP.",
-				ValidateFunc: validation.IsIPAddress,
-				Deprecated:   "Secondary DNS server ip attribute will be removed in the future release.",
-			},
-			"local_as_number": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "Local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
-			},
-			"prepend_as_path": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
-				},
-				MaxItems: 25,
-			},
-			"enable_edge_active_standby": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enables Edge Active-Standby Mode.",
-			},
-			"enable_edge_active_standby_preemptive": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enables Preemptive Mode for Edge Active-Standby, available only with Active-Standby enabled.",
-			},
-			"enable_learned_cidrs_approval": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Switch to enable/disable learned CIDR approval for BGP Spoke Gateway. Valid values: true, false.",
-			},
-			"approved_learned_cidrs": {
-				Type: schema.TypeSet,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.IsCIDR,
-				},
-				Optional:    true,
-				Description: "Approved learned CIDRs for BGP Spoke Gateway.",
-			},
-			"spoke_bgp_manual_advertise_cidrs": {
-				Type: schema.TypeSet,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-				Optional:    true,
-				Description: "Intended CIDR list to be advertised to external BGP router.",
-			},
-			"enable_preserve_as_path": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable preserve as path when advertising manual summary CIDRs on BGP spoke gateway.",
-			},
-			"bgp_polling_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpPollingTime,
-				ValidateFunc: validation.IntBetween(10, 50),
-				Description:  "BGP route polling time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 10 and 50.",
-			},
-			"bgp_neighbor_status_polling_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpNeighborStatusPollingTime,
-				ValidateFunc: validation.IntBetween(1, 10),
-				Description:  "BGP neighbor status polling time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 1 and 10.",
-			},
-			"bgp_hold_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpHoldTime,
-				ValidateFunc: validation.IntBetween(12, 360),
-				Description:  "BGP route polling time for BGP spoke gateway in seconds. Valid values are between 12 and 360.",
-			},
-			"enable_edge_transitive_routing": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable Edge transitive routing.",
-			},
-			"enable_jumbo_frame": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable jumbo frame.",
-			},
-			"latitude": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
-				Description:      "The latitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
-			},
-			"longitude": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
-				Description:      "The longitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
-			},
-			"rx_queue_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"1K", "2K", "4K"}, false),
-				Description:  "Ethernet interface RX queue size.",
-			},
-			"state": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "State of Edge as a Spoke.",
-			},
 			"interfaces": {
 				Type:        schema.TypeList,
 				Required:    true,
@@ -321,12 +178,6 @@ func resourceAviatrixEdgeMegaport() *schema.Resource {
 				Description: "DNS profile to be associated with gateway, select an existing template.",
 				Deprecated:  "DNS profile support has been removed.",
 			},
-			"enable_single_ip_snat": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable Single IP SNAT.",
-			},
 			"enable_auto_advertise_lan_cidrs": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -359,15 +210,7 @@ func resourceAviatrixEdgeMegaport() *schema.Resource {
 					},
 				},
 			},
-			"included_advertised_spoke_routes": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "A list of CIDRs to be advertised to on-prem as 'Included CIDR List'. When configured, it will replace all advertised routes from this VPC.",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-		},
+		}),
 	}
 }
 
@@ -503,8 +346,51 @@ func marshalEdgeMegaportInput(d *schema.ResourceData) (*goaviatrix.EdgeMegaport,
 	return edgeMegaport, nil
 }
 
+// edgeMegaportPlatform connects the shared edge gateway logic to the Edge Megaport API.
+func edgeMegaportPlatform(client goaviatrix.ClientInterface, edgeMegaport *goaviatrix.EdgeMegaport) *edgeGatewayPlatform {
+	return &edgeGatewayPlatform{
+		name: "Edge Megaport",
+		update: func(ctx context.Context) error {
+			return client.UpdateEdgeMegaport(ctx, edgeMegaport)
+		},
+		updateAttributes: []string{"management_egress_ip_prefix_list", "interfaces", "vlan",
+			"enable_auto_advertise_lan_cidrs", "enable_edge_active_standby", "enable_edge_active_standby_preemptive"},
+		updateAfterCreate: edgeMegaport.EnableAutoAdvertiseLanCidrs == "disable",
+	}
+}
+
+// edgeMegaportState returns the shared edge gateway attributes of an Edge Megaport.
+func edgeMegaportState(edgeMegaportResp *goaviatrix.EdgeMegaportResp) *edgeGatewayState {
+	return &edgeGatewayState{
+		GwName:                             edgeMegaportResp.GwName,
+		ManagementEgressIpPrefix:           edgeMegaportResp.ManagementEgressIPPrefix,
+		EnableManagementOverPrivateNetwork: edgeMegaportResp.EnableManagementOverPrivateNetwork,
+		DnsServerIp:                        edgeMegaportResp.DNSServerIP,
+		SecondaryDnsServerIp:               edgeMegaportResp.SecondaryDNSServerIP,
+		LocalAsNumber:                      edgeMegaportResp.LocalAsNumber,
+		PrependAsPath:                      edgeMegaportResp.PrependAsPath,
+		EnableEdgeActiveStandby:            edgeMegaportResp.EnableEdgeActiveStandby,
+		EnableEdgeActiveStandbyPreemptive:  edgeMegaportResp.EnableEdgeActiveStandbyPreemptive,
+		EnableLearnedCidrsApproval:         edgeMegaportResp.EnableLearnedCidrsApproval,
+		SpokeBgpManualAdvertisedCidrs:      edgeMegaportResp.SpokeBgpManualAdvertisedCidrs,
+		EnablePreserveAsPath:               edgeMegaportResp.EnablePreserveAsPath,
+		BgpPollingTime:                     edgeMegaportResp.BgpPollingTime,
+		BgpBfdPollingTime:                  edgeMegaportResp.BgpBfdPollingTime,
+		BgpHoldTime:                        edgeMegaportResp.BgpHoldTime,
+		EnableEdgeTransitiveRouting:        edgeMegaportResp.EnableEdgeTransitiveRouting,
+		EnableJumboFrame:                   edgeMegaportResp.EnableJumboFrame,
+		Latitude:                           edgeMegaportResp.Latitude,
+		Longitude:                          edgeMegaportResp.Longitude,
+		RxQueueSize:                        edgeMegaportResp.RxQueueSize,
+		State:                              edgeMegaportResp.State,
+		EnableNat:                          edgeMegaportResp.EnableNat,
+		SnatMode:                           edgeMegaportResp.SnatMode,
+		AdvertisedCidrList:                 edgeMegaportResp.AdvertisedCidrList,
+	}
+}
+
 func resourceAviatrixEdgeMegaportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeMegaport, err := marshalEdgeMegaportInput(d)
@@ -513,26 +399,8 @@ func resourceAviatrixEdgeMegaportCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	// checks before creation
-	if !edgeMegaport.EnableEdgeActiveStandby && edgeMegaport.EnableEdgeActiveStandbyPreemptive {
-		return diag.Errorf("could not enable Preemptive Mode when Active-Standby is disabled")
-	}
-
-	if !edgeMegaport.EnableLearnedCidrsApproval && len(edgeMegaport.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
-	}
-
-	if len(edgeMegaport.PrependAsPath) != 0 {
-		if edgeMegaport.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
-		}
-	}
-
-	if edgeMegaport.Latitude != "" && edgeMegaport.Longitude != "" {
-		latitude, _ := strconv.ParseFloat(edgeMegaport.Latitude, 64)
-		longitude, _ := strconv.ParseFloat(edgeMegaport.Longitude, 64)
-		if latitude == 0 && longitude == 0 {
-			return diag.Errorf("latitude and longitude must not be zero at the same time")
-		}
+	if err := validateEdgeGatewayConfig(expandEdgeGatewayConfig(d)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// create
@@ -545,141 +413,9 @@ func resourceAviatrixEdgeMegaportCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	// advanced configs
-	// use following variables to reuse functions for transit, spoke, gateway and EaaS
-	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
-		GwName: edgeMegaport.GwName,
-	}
-	gatewayForSpokeFunctions := &goaviatrix.SpokeVpc{
-		GwName: edgeMegaport.GwName,
-	}
-	gatewayForGatewayFunctions := &goaviatrix.Gateway{
-		GwName: edgeMegaport.GwName,
-	}
-	gatewayForEaasFunctions := &goaviatrix.EdgeSpoke{
-		GwName: edgeMegaport.GwName,
-	}
-
-	if edgeMegaport.LocalAsNumber != "" {
-		err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeMegaport.LocalAsNumber)
-		if err != nil {
-			return diag.Errorf("could not set 'local_as_number' after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if len(edgeMegaport.PrependAsPath) != 0 {
-		err := client.SetPrependASPath(gatewayForTransitFunctions, edgeMegaport.PrependAsPath)
-		if err != nil {
-			return diag.Errorf("could not set 'prepend_as_path' after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if edgeMegaport.EnableLearnedCidrsApproval {
-		err := client.EnableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not enable learned CIDRs approval after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if len(edgeMegaport.ApprovedLearnedCidrs) != 0 {
-		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeMegaport.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not update approved CIDRs after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if len(edgeMegaport.SpokeBgpManualAdvertisedCidrs) != 0 {
-		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeMegaport.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not set spoke BGP manual advertised CIDRs after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if edgeMegaport.EnablePreserveAsPath {
-		err := client.EnableSpokePreserveAsPath(gatewayForSpokeFunctions)
-		if err != nil {
-			return diag.Errorf("could not enable spoke preserve as path after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if edgeMegaport.BgpPollingTime >= 10 && edgeMegaport.BgpPollingTime != defaultBgpPollingTime {
-		err := client.SetBgpPollingTimeSpoke(gatewayForSpokeFunctions, edgeMegaport.BgpPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp polling time after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if edgeMegaport.BgpBfdPollingTime >= 1 && edgeMegaport.BgpBfdPollingTime != defaultBgpNeighborStatusPollingTime {
-		err := client.SetBgpBfdPollingTimeSpoke(gatewayForSpokeFunctions, edgeMegaport.BgpBfdPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp neighbor status polling time after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if edgeMegaport.BgpHoldTime >= 12 && edgeMegaport.BgpHoldTime != defaultBgpHoldTime {
-		err := client.ChangeBgpHoldTime(gatewayForSpokeFunctions.GwName, edgeMegaport.BgpHoldTime)
-		if err != nil {
-			return diag.Errorf("could not change BGP Hold Time after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if edgeMegaport.EnableEdgeTransitiveRouting {
-		err := client.EnableEdgeSpokeTransitiveRouting(ctx, edgeMegaport.GwName)
-		if err != nil {
-			return diag.Errorf("could not enable Edge transitive routing after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if edgeMegaport.EnableJumboFrame {
-		err := client.EnableJumboFrame(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not disable jumbo frame after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if edgeMegaport.Latitude != "" || edgeMegaport.Longitude != "" {
-		gatewayForEaasFunctions.Latitude = edgeMegaport.Latitude
-		gatewayForEaasFunctions.Longitude = edgeMegaport.Longitude
-		err := client.UpdateEdgeSpokeGeoCoordinate(ctx, gatewayForEaasFunctions)
-		if err != nil {
-			return diag.Errorf("could not update geo coordinate after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if edgeMegaport.RxQueueSize != "" {
-		gatewayForGatewayFunctions.RxQueueSize = edgeMegaport.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not set rx queue size after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if edgeMegaport.EnableSingleIpSnat {
-		gatewayForGatewayFunctions.GatewayName = edgeMegaport.GwName
-		err := client.EnableSNat(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("failed to enable single IP SNAT: %s", err)
-		}
-	}
-
-	if edgeMegaport.EnableAutoAdvertiseLanCidrs == "disable" {
-		err := client.UpdateEdgeMegaport(ctx, edgeMegaport)
-		if err != nil {
-			return diag.Errorf("could not disable auto advertise LAN CIDRs after Edge Megaport creation: %v", err)
-		}
-	}
-
-	if edgeMegaport.EnableEdgeActiveStandby || edgeMegaport.EnableEdgeActiveStandbyPreemptive {
-		err := client.UpdateEdgeMegaport(ctx, edgeMegaport)
-		if err != nil {
-			return diag.Errorf("could not update Edge active standby or Edge active standby preemptive after Edge Megaport creation: %v", err)
-		}
-	}
-
-	err = editAdvertisedSpokeRoutesWithRetry(client, gatewayForGatewayFunctions, d)
+	err = configureEdgeGatewayAfterCreate(ctx, client, d, edgeMegaportPlatform(client, edgeMegaport))
 	if err != nil {
-		return diag.Errorf("failed to edit advertised spoke vpc routes of spoke gateway: %q: %s", gatewayForGatewayFunctions.GwName, err)
+		return diag.FromErr(err)
 	}
 
 	return resourceAviatrixEdgeMegaportReadIfRequired(ctx, d, meta, &flag)
@@ -694,12 +430,12 @@ func resourceAviatrixEdgeMegaportReadIfRequired(ctx context.Context, d *schema.R
 }
 
 func resourceAviatrixEdgeMegaportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// handle import
 	if d.Get("gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no name received", map[string]interface{}{"id": id})
 		_ = d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -714,74 +450,21 @@ func resourceAviatrixEdgeMegaportRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	edgeMegaportFields := map[string]interface{}{
-		"account_name":                           edgeMegaportResp.AccountName,
-		"gw_name":                                edgeMegaportResp.GwName,
-		"site_id":                                edgeMegaportResp.SiteId,
-		"enable_management_over_private_network": edgeMegaportResp.EnableManagementOverPrivateNetwork,
-		"dns_server_ip":                          edgeMegaportResp.DNSServerIP,
-		"secondary_dns_server_ip":                edgeMegaportResp.SecondaryDNSServerIP,
-		"local_as_number":                        edgeMegaportResp.LocalAsNumber,
-		"prepend_as_path":                        edgeMegaportResp.PrependAsPath,
-		"enable_edge_active_standby":             edgeMegaportResp.EnableEdgeActiveStandby,
-		"enable_edge_active_standby_preemptive":  edgeMegaportResp.EnableEdgeActiveStandbyPreemptive,
-		"enable_learned_cidrs_approval":          edgeMegaportResp.EnableLearnedCidrsApproval,
-		"enable_preserve_as_path":                edgeMegaportResp.EnablePreserveAsPath,
-		"bgp_polling_time":                       edgeMegaportResp.BgpPollingTime,
-		"bgp_neighbor_status_polling_time":       edgeMegaportResp.BgpBfdPollingTime,
-		"bgp_hold_time":                          edgeMegaportResp.BgpHoldTime,
-		"enable_edge_transitive_routing":         edgeMegaportResp.EnableEdgeTransitiveRouting,
-		"enable_jumbo_frame":                     edgeMegaportResp.EnableJumboFrame,
-		"rx_queue_size":                          edgeMegaportResp.RxQueueSize,
-		"state":                                  edgeMegaportResp.State,
-		"dns_profile_name":                       edgeMegaportResp.DNSProfileName,
-		"enable_single_ip_snat":                  edgeMegaportResp.EnableNat == "yes" && edgeMegaportResp.SnatMode == "primary",
-		"enable_auto_advertise_lan_cidrs":        edgeMegaportResp.EnableAutoAdvertiseLanCidrs,
+		"account_name":                    edgeMegaportResp.AccountName,
+		"gw_name":                         edgeMegaportResp.GwName,
+		"site_id":                         edgeMegaportResp.SiteId,
+		"dns_profile_name":                edgeMegaportResp.DNSProfileName,
+		"enable_auto_advertise_lan_cidrs": edgeMegaportResp.EnableAutoAdvertiseLanCidrs,
 	}
 
 	for key, value := range edgeMegaportFields {
 		if err := d.Set(key, value); err != nil {
-			log.Printf("[WARN] Failed to set %s: %v", key, err)
-		}
-	}
-
-	if edgeMegaportResp.ManagementEgressIPPrefix == "" {
-		_ = d.Set("management_egress_ip_prefix_list", nil)
-	} else {
-		_ = d.Set("management_egress_ip_prefix_list", strings.Split(edgeMegaportResp.ManagementEgressIPPrefix, ","))
-	}
-
-	if edgeMegaportResp.EnableLearnedCidrsApproval {
-		spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfig(&goaviatrix.SpokeVpc{GwName: edgeMegaportResp.GwName})
-		if err != nil {
-			return diag.Errorf("could not get advanced config for Edge Megaport: %v", err)
+			tflog.Warn(ctx, "Failed to set attribute", map[string]interface{}{"key": key, "error": err})
 		}
-
-		err = d.Set("approved_learned_cidrs", spokeAdvancedConfig.ApprovedLearnedCidrs)
-		if err != nil {
-			return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
-		}
-	} else {
-		_ = d.Set("approved_learned_cidrs", nil)
-	}
-
-	if len(edgeMegaportResp.AdvertisedCidrList) > 0 {
-		_ = d.Set("included_advertised_spoke_routes", edgeMegaportResp.AdvertisedCidrList)
-	}
-
-	spokeBgpManualAdvertisedCidrs := getStringSet(d, "spoke_bgp_manual_advertise_cidrs")
-	if len(goaviatrix.Difference(spokeBgpManualAdvertisedCidrs, edgeMegaportResp.SpokeBgpManualAdvertisedCidrs)) != 0 ||
-		len(goaviatrix.Difference(edgeMegaportResp.SpokeBgpManualAdvertisedCidrs, spokeBgpManualAdvertisedCidrs)) != 0 {
-		_ = d.Set("spoke_bgp_manual_advertise_cidrs", edgeMegaportResp.SpokeBgpManualAdvertisedCidrs)
-	} else {
-		_ = d.Set("spoke_bgp_manual_advertise_cidrs", spokeBgpManualAdvertisedCidrs)
 	}
 
-	if edgeMegaportResp.Latitude != 0 || edgeMegaportResp.Longitude != 0 {
-		_ = d.Set("latitude", fmt.Sprintf("%.6f", edgeMegaportResp.Latitude))
-		_ = d.Set("longitude", fmt.Sprintf("%.6f", edgeMegaportResp.Longitude))
-	} else {
-		_ = d.Set("latitude", "")
-		_ = d.Set("longitude", "")
+	if err := flattenEdgeGateway(client, d, edgeMegaportState(edgeMegaportResp)); err != nil {
+		return diag.Errorf("could not read Edge Megaport: %v", err)
 	}
 
 	var interfaces []map[string]interface{}
@@ -873,7 +556,7 @@ func resourceAviatrixEdgeMegaportRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixEdgeMegaportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeMegaport, err := marshalEdgeMegaportInput(d)
@@ -882,195 +565,8 @@ func resourceAviatrixEdgeMegaportUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	// checks before update
-	if !edgeMegaport.EnableEdgeActiveStandby && edgeMegaport.EnableEdgeActiveStandbyPreemptive {
-		return diag.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
-	}
-
-	if !edgeMegaport.EnableLearnedCidrsApproval && len(edgeMegaport.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
-	}
-
-	if len(edgeMegaport.PrependAsPath) != 0 {
-		if edgeMegaport.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
-		}
-	}
-
-	if edgeMegaport.Latitude != "" && edgeMegaport.Longitude != "" {
-		latitude, _ := strconv.ParseFloat(edgeMegaport.Latitude, 64)
-		longitude, _ := strconv.ParseFloat(edgeMegaport.Longitude, 64)
-		if latitude == 0 && longitude == 0 {
-			return diag.Errorf("latitude and longitude must not be zero at the same time")
-		}
-	}
-
-	d.Partial(true)
-
-	// update configs
-	// use following variables to reuse functions for transit, spoke and gateway
-	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
-		GwName: edgeMegaport.GwName,
-	}
-	gatewayForSpokeFunctions := &goaviatrix.SpokeVpc{
-		GwName: edgeMegaport.GwName,
-	}
-	gatewayForGatewayFunctions := &goaviatrix.Gateway{
-		GwName: edgeMegaport.GwName,
-	}
-	gatewayForEaasFunctions := &goaviatrix.EdgeSpoke{
-		GwName: edgeMegaport.GwName,
-	}
-
-	if d.HasChanges("local_as_number", "prepend_as_path") {
-		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(edgeMegaport.PrependAsPath) == 0 {
-			// prependASPath must be deleted from the controller before local_as_number can be changed
-			// Handle the case where prependASPath is empty here so that the API is not called twice
-			err := client.SetPrependASPath(gatewayForTransitFunctions, nil)
-			if err != nil {
-				return diag.Errorf("could not delete prepend_as_path during Edge Megaport update: %v", err)
-			}
-		}
-
-		if d.HasChange("local_as_number") {
-			err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeMegaport.LocalAsNumber)
-			if err != nil {
-				return diag.Errorf("could not set local_as_number during Edge Megaport update: %v", err)
-			}
-		}
-
-		if d.HasChange("prepend_as_path") && len(edgeMegaport.PrependAsPath) > 0 {
-			err := client.SetPrependASPath(gatewayForTransitFunctions, edgeMegaport.PrependAsPath)
-			if err != nil {
-				return diag.Errorf("could not set prepend_as_path during Edge Megaport update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("enable_learned_cidrs_approval") {
-		if edgeMegaport.EnableLearnedCidrsApproval {
-			err := client.EnableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable learned cidrs approval during Edge Megaport update: %v", err)
-			}
-		} else {
-			err := client.DisableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable learned cidrs approval during Edge Megaport update: %v", err)
-			}
-		}
-	}
-
-	if edgeMegaport.EnableLearnedCidrsApproval && d.HasChange("approved_learned_cidrs") {
-		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeMegaport.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not update approved learned CIDRs during Edge Megaport update: %v", err)
-		}
-	}
-
-	if d.HasChange("spoke_bgp_manual_advertise_cidrs") {
-		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeMegaport.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not set spoke BGP manual advertised CIDRs during Edge Megaport update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_preserve_as_path") {
-		if edgeMegaport.EnablePreserveAsPath {
-			err := client.EnableSpokePreserveAsPath(gatewayForSpokeFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable preserve as path during Edge Megaport update: %v", err)
-			}
-		} else {
-			err := client.DisableSpokePreserveAsPath(gatewayForSpokeFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable preserve as path during Edge Megaport update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("bgp_polling_time") {
-		err := client.SetBgpPollingTimeSpoke(gatewayForSpokeFunctions, edgeMegaport.BgpPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp polling time during Edge Megaport update: %v", err)
-		}
-	}
-
-	if d.HasChange("bgp_neighbor_status_polling_time") {
-		err := client.SetBgpBfdPollingTimeSpoke(gatewayForSpokeFunctions, edgeMegaport.BgpBfdPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp neighbor status polling time during Edge Megaport update: %v", err)
-		}
-	}
-
-	if d.HasChange("included_advertised_spoke_routes") {
-		err := editAdvertisedSpokeRoutesWithRetry(client, gatewayForGatewayFunctions, d)
-		if err != nil {
-			return diag.Errorf("could not update included advertised spoke routes during Edge Megaport Gateway update: %v", err)
-		}
-	}
-
-	if d.HasChange("bgp_hold_time") {
-		err := client.ChangeBgpHoldTime(edgeMegaport.GwName, edgeMegaport.BgpHoldTime)
-		if err != nil {
-			return diag.Errorf("could not change bgp hold time during Edge Megaport update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_edge_transitive_routing") {
-		if edgeMegaport.EnableEdgeTransitiveRouting {
-			err := client.EnableEdgeSpokeTransitiveRouting(ctx, edgeMegaport.GwName)
-			if err != nil {
-				return diag.Errorf("could not enable transitive routing during Edge Megaport update: %v", err)
-			}
-		} else {
-			err := client.DisableEdgeSpokeTransitiveRouting(ctx, edgeMegaport.GwName)
-			if err != nil {
-				return diag.Errorf("could not disable transitive routing during Edge Megaport update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("enable_jumbo_frame") {
-		if edgeMegaport.EnableJumboFrame {
-			err := client.EnableJumboFrame(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable jumbo frame during Edge Megaport update: %v", err)
-			}
-		} else {
-			err := client.DisableJumboFrame(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable jumbo frame during Edge Megaport update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChanges("latitude", "longitude") {
-		gatewayForEaasFunctions.Latitude = edgeMegaport.Latitude
-		gatewayForEaasFunctions.Longitude = edgeMegaport.Longitude
-		err := client.UpdateEdgeSpokeGeoCoordinate(ctx, gatewayForEaasFunctions)
-		if err != nil {
-			return diag.Errorf("could not update geo coordinate during Edge Megaport update: %v", err)
-		}
-	}
-
-	if d.HasChange("rx_queue_size") {
-		gatewayForGatewayFunctions.RxQueueSize = edgeMegaport.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not update rx queue size during Edge Megaport update: %v", err)
-		}
-	}
-
-	if d.HasChanges("management_egress_ip_prefix_list", "interfaces", "vlan",
-		"enable_auto_advertise_lan_cidrs", "enable_edge_active_standby", "enable_edge_active_standby_preemptive") {
-		err := client.UpdateEdgeMegaport(ctx, edgeMegaport)
-		if err != nil {
-			return diag.Errorf("could not update management egress ip prefix list, WAN/LAN/VLAN interfaces, "+
-				"DNS profile name, auto advertise LAN CIDRs, Edge active standby or Edge active standby preemptive "+
-				"during Edge CSP update: %v", err)
-		}
+	if err := validateEdgeGatewayConfig(expandEdgeGatewayConfig(d)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	if d.HasChange("interface_mapping") {
@@ -1078,20 +574,11 @@ func resourceAviatrixEdgeMegaportUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("interface mapping cannot be updated after the Edge Megaport is created")
 	}
 
-	if d.HasChange("enable_single_ip_snat") {
-		gatewayForGatewayFunctions.GatewayName = edgeMegaport.GwName
+	d.Partial(true)
 
-		if edgeMegaport.EnableSingleIpSnat {
-			err := client.EnableSNat(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("failed to enable single IP SNAT during Edge Megaport update: %s", err)
-			}
-		} else {
-			err := client.DisableSNat(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("failed to disable single IP SNAT during Edge Megaport update: %s", err)
-			}
-		}
+	err = updateEdgeGateway(ctx, client, d, edgeMegaportPlatform(client, edgeMegaport))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Partial(false)
@@ -1100,7 +587,7 @@ func resourceAviatrixEdgeMegaportUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgeMegaportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 	accountName := d.Get("account_name").(string)
 	gwName := d.Get("gw_name").(string)
 	siteId := d.Get("site_id").(string)
@@ -1114,7 +601,7 @@ func resourceAviatrixEdgeMegaportDelete(ctx context.Context, d *schema.ResourceD
 	fileName := ztpFileDownloadPath + "/" + gwName + "-" + siteId + "-cloud-init.txt"
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, "could not remove the ztp file", map[string]interface{}{"error": err})
 	}
 
 	return nil
@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"os"
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -18,14 +18,20 @@ import (
 
 func resourceAviatrixEdgeMegaportHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeMegaportHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeMegaportHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeMegaportHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeMegaportHaDelete,
+		CreateContext: resourceAviatrixEdgeMegaportHaCreate,
+		ReadContext:   resourceAviatrixEdgeMegaportHaRead,
+		UpdateContext: resourceAviatrixEdgeMegaportHaUpdate,
+		DeleteContext: resourceAviatrixEdgeMegaportHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -197,9 +203,9 @@ func assignInterfaceFields(interface1 map[string]interface{}, interface2 *goavia
 }
 
 func resourceAviatrixEdgeMegaportHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok || client == nil {
-		return diag.Errorf("failed to cast meta to *goaviatrix.Client")
+		return diag.Errorf("failed to cast meta to goaviatrix.ClientInterface")
 	}
 
 	edgeMegaportHa, err := marshalEdgeMegaportHaInput(d)
@@ -217,14 +223,14 @@ func resourceAviatrixEdgeMegaportHaCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixEdgeMegaportHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok || client == nil {
-		return diag.Errorf("failed to cast meta to *goaviatrix.Client")
+		return diag.Errorf("failed to cast meta to goaviatrix.ClientInterface")
 	}
 
 	if primaryGwName, ok := d.Get("primary_gw_name").(string); ok && primaryGwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "-hagw")
 		_ = d.Set("primary_gw_name", parts[0])
 		d.SetId(id)
@@ -272,9 +278,9 @@ func resourceAviatrixEdgeMegaportHaRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgeMegaportHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok || client == nil {
-		return diag.Errorf("failed to cast meta to *goaviatrix.Client")
+		return diag.Errorf("failed to cast meta to goaviatrix.ClientInterface")
 	}
 
 	edgeMegaportHa, err := marshalEdgeMegaportHaInput(d)
@@ -303,9 +309,9 @@ func resourceAviatrixEdgeMegaportHaUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixEdgeMegaportHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client)
+	client, ok := meta.(goaviatrix.ClientInterface)
 	if !ok || client == nil {
-		return diag.Errorf("failed to cast meta to *goaviatrix.Client")
+		return diag.Errorf("failed to cast meta to goaviatrix.ClientInterface")
 	}
 
 	edgeMegaportHa, err := marshalEdgeMegaportHaInput(d)
@@ -326,7 +332,7 @@ func resourceAviatrixEdgeMegaportHaDelete(ctx context.Context, d *schema.Resourc
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, "could not remove the ztp file", map[string]interface{}{"error": err})
 	}
 
 	return nil
@@ -2,11 +2,11 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -141,7 +141,7 @@ func marshalEdgeNEODeviceOnboardingInput(d *schema.ResourceData) *goaviatrix.Edg
 }
 
 func resourceAviatrixEdgeNEODeviceOnboardingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeNEODevice := marshalEdgeNEODeviceOnboardingInput(d)
 
@@ -199,13 +199,13 @@ func resourceAviatrixEdgeNEODeviceOnboardingReadIfRequired(ctx context.Context,
 }
 
 func resourceAviatrixEdgeNEODeviceOnboardingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 	deviceName := d.Get("device_name").(string)
 	if accountName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no account name received", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 32 {
 			return diag.Errorf("Invalid Import ID received, ID must be in the format account_name~device_name")
@@ -268,7 +268,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingRead(ctx context.Context, d *schema.
 }
 
 func resourceAviatrixEdgeNEODeviceOnboardingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeNEODevice := marshalEdgeNEODeviceOnboardingInput(d)
 
@@ -307,7 +307,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingUpdate(ctx context.Context, d *schem
 			fileName := oldConfigFileDownloadPath.(string) + edgeNEODevice.SerialNumber + "-bootstrap-config.img"
 			err := os.Remove(fileName)
 			if err != nil {
-				log.Printf("[WARN] could not remove the config file: %v", err)
+				tflog.Warn(ctx, "could not remove the config file", map[string]interface{}{"error": err})
 			}
 		}
 	}
@@ -323,7 +323,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingUpdate(ctx context.Context, d *schem
 }
 
 func resourceAviatrixEdgeNEODeviceOnboardingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeNEODevice := marshalEdgeNEODeviceOnboardingInput(d)
 
@@ -336,7 +336,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingDelete(ctx context.Context, d *schem
 		fileName := edgeNEODevice.ConfigFileDownloadPath + edgeNEODevice.SerialNumber + "-bootstrap-config.img"
 		err = os.Remove(fileName)
 		if err != nil {
-			log.Printf("[WARN] could not remove the config file: %v", err)
+			tflog.Warn(ctx, "could not remove the config file", map[string]interface{}{"error": err})
 		}
 	}
 
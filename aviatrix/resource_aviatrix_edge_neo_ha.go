@@ -2,9 +2,9 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -13,14 +13,20 @@ import (
 
 func resourceAviatrixEdgeNEOHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeNEOHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeNEOHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeNEOHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeNEOHaDelete,
+		CreateContext: resourceAviatrixEdgeNEOHaCreate,
+		ReadContext:   resourceAviatrixEdgeNEOHaRead,
+		UpdateContext: resourceAviatrixEdgeNEOHaUpdate,
+		DeleteContext: resourceAviatrixEdgeNEOHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -143,7 +149,7 @@ func marshalEdgeNEOHaInput(d *schema.ResourceData) *goaviatrix.EdgeNEOHa {
 }
 
 func resourceAviatrixEdgeNEOHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeNEOHa := marshalEdgeNEOHaInput(d)
 
@@ -157,11 +163,11 @@ func resourceAviatrixEdgeNEOHaCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeNEOHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.Get("primary_gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "-hagw")
 		d.Set("primary_gw_name", parts[0])
 		d.SetId(id)
@@ -211,7 +217,7 @@ func resourceAviatrixEdgeNEOHaRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixEdgeNEOHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeNEOHa := marshalEdgeNEOHaInput(d)
 
@@ -236,7 +242,7 @@ func resourceAviatrixEdgeNEOHaUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeNEOHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 
@@ -2,11 +2,10 @@ package aviatrix
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,15 +16,21 @@ import (
 
 func resourceAviatrixEdgePlatform() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgePlatformCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgePlatformRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgePlatformUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgePlatformDelete,
+		CreateContext: resourceAviatrixEdgePlatformCreate,
+		ReadContext:   resourceAviatrixEdgePlatformRead,
+		UpdateContext: resourceAviatrixEdgePlatformUpdate,
+		DeleteContext: resourceAviatrixEdgePlatformDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
+		Schema: edgeGatewaySchema(map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -56,158 +61,6 @@ func resourceAviatrixEdgePlatform() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"small", "medium", "large", "x-large"}, false),
 				Description:  "Gateway size (CPU and Memory).",
 			},
-			"management_egress_ip_prefix_list": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "Set of management egress gateway IP/prefix.",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-			"enable_management_over_private_network": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     false,
-				Description: "Enable management over private network.",
-			},
-			"dns_server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "DNS server IP.",
-				ValidateFunc: validation.IsIPAddress,
-				Deprecated:   "DNS server ip attribute will be removed in the future release.",
-			},
-			"secondary_dns_server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "Secondary DNS server IP.",
-				ValidateFunc: validation.IsIPAddress,
-				Deprecated:   "Secondary DNS server ip attribute will be removed in the future release.",
-			},
-			"local_as_number": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "Local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
-			},
-			"prepend_as_path": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
-				},
-				MaxItems: 25,
-			},
-			"enable_edge_active_standby": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enables Edge Active-Standby Mode.",
-			},
-			"enable_edge_active_standby_preemptive": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enables Preemptive Mode for Edge Active-Standby, available only with Active-Standby enabled.",
-			},
-			"enable_learned_cidrs_approval": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Switch to enable/disable learned CIDR approval for BGP Spoke Gateway. Valid values: true, false.",
-			},
-			"approved_learned_cidrs": {
-				Type: schema.TypeSet,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.IsCIDR,
-				},
-				Optional:    true,
-				Description: "Approved learned CIDRs for BGP Spoke Gateway.",
-			},
-			"spoke_bgp_manual_advertise_cidrs": {
-				Type: schema.TypeSet,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-				Optional:    true,
-				Description: "Intended CIDR list to be advertised to external BGP router.",
-			},
-			"enable_preserve_as_path": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable preserve as path when advertising manual summary CIDRs on BGP spoke gateway.",
-			},
-			"bgp_polling_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpPollingTime,
-				ValidateFunc: validation.IntBetween(10, 50),
-				Description:  "BGP route polling time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 10 and 50.",
-			},
-			"bgp_neighbor_status_polling_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpNeighborStatusPollingTime,
-				ValidateFunc: validation.IntBetween(1, 10),
-				Description:  "BGP neighbor status polling time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 1 and 10.",
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return old == "0"
-				},
-			},
-			"bgp_hold_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpHoldTime,
-				ValidateFunc: validation.IntBetween(12, 360),
-				Description:  "BGP Hold Time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 12 and 360.",
-			},
-			"enable_edge_transitive_routing": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable Edge transitive routing.",
-			},
-			"enable_jumbo_frame": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable jumbo frame.",
-			},
-			"latitude": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
-				Description:      "The latitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
-			},
-			"longitude": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
-				Description:      "The longitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
-			},
-			"rx_queue_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"1K", "2K", "4K"}, false),
-				Description:  "Ethernet interface RX queue size.",
-			},
-			"state": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "State of Edge as a Spoke.",
-			},
 			"wan_interface_names": {
 				Type:        schema.TypeList,
 				Required:    true,
@@ -371,27 +224,13 @@ func resourceAviatrixEdgePlatform() *schema.Resource {
 				Description: "DNS profile to be associated with gateway, select an existing template.",
 				Deprecated:  "DNS profile support has been removed.",
 			},
-			"enable_single_ip_snat": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable Single IP SNAT.",
-			},
 			"enable_auto_advertise_lan_cidrs": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
 				Description: "Enable auto advertise LAN CIDRs.",
 			},
-			"included_advertised_spoke_routes": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "A list of CIDRs to be advertised to on-prem as 'Included CIDR List'. When configured, it will replace all advertised routes from this VPC.",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-		},
+		}),
 	}
 }
 
@@ -479,33 +318,58 @@ func marshalEdgePlatformInput(d *schema.ResourceData) *goaviatrix.EdgeNEO {
 	return edgeNEO
 }
 
+// edgePlatformPlatform connects the shared edge gateway logic to the Edge Platform API.
+func edgePlatformPlatform(client goaviatrix.ClientInterface, edgeNEO *goaviatrix.EdgeNEO) *edgeGatewayPlatform {
+	return &edgeGatewayPlatform{
+		name: "Edge Platform",
+		update: func(ctx context.Context) error {
+			return client.UpdateEdgeNEO(ctx, edgeNEO)
+		},
+		updateAttributes: []string{"management_egress_ip_prefix_list", "interfaces", "vlan",
+			"enable_auto_advertise_lan_cidrs", "enable_edge_active_standby", "enable_edge_active_standby_preemptive"},
+		updateAfterCreate: edgeNEO.EnableAutoAdvertiseLanCidrs == "disable",
+	}
+}
+
+// edgePlatformState returns the shared edge gateway attributes of an Edge Platform.
+func edgePlatformState(edgeNEOResp *goaviatrix.EdgeNEOResp) *edgeGatewayState {
+	return &edgeGatewayState{
+		GwName:                             edgeNEOResp.GwName,
+		ManagementEgressIpPrefix:           edgeNEOResp.ManagementEgressIpPrefix,
+		EnableManagementOverPrivateNetwork: edgeNEOResp.EnableManagementOverPrivateNetwork,
+		DnsServerIp:                        edgeNEOResp.DnsServerIp,
+		SecondaryDnsServerIp:               edgeNEOResp.SecondaryDnsServerIp,
+		LocalAsNumber:                      edgeNEOResp.LocalAsNumber,
+		PrependAsPath:                      edgeNEOResp.PrependAsPath,
+		EnableEdgeActiveStandby:            edgeNEOResp.EnableEdgeActiveStandby,
+		EnableEdgeActiveStandbyPreemptive:  edgeNEOResp.EnableEdgeActiveStandbyPreemptive,
+		EnableLearnedCidrsApproval:         edgeNEOResp.EnableLearnedCidrsApproval,
+		SpokeBgpManualAdvertisedCidrs:      edgeNEOResp.SpokeBgpManualAdvertisedCidrs,
+		EnablePreserveAsPath:               edgeNEOResp.EnablePreserveAsPath,
+		BgpPollingTime:                     edgeNEOResp.BgpPollingTime,
+		BgpBfdPollingTime:                  edgeNEOResp.BgpBfdPollingTime,
+		BgpHoldTime:                        edgeNEOResp.BgpHoldTime,
+		EnableEdgeTransitiveRouting:        edgeNEOResp.EnableEdgeTransitiveRouting,
+		EnableJumboFrame:                   edgeNEOResp.EnableJumboFrame,
+		Latitude:                           edgeNEOResp.Latitude,
+		Longitude:                          edgeNEOResp.Longitude,
+		RxQueueSize:                        edgeNEOResp.RxQueueSize,
+		State:                              edgeNEOResp.State,
+		EnableNat:                          edgeNEOResp.EnableNat,
+		SnatMode:                           edgeNEOResp.SnatMode,
+		AdvertisedCidrList:                 edgeNEOResp.AdvertisedCidrList,
+	}
+}
+
 func resourceAviatrixEdgePlatformCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeNEO := marshalEdgePlatformInput(d)
 
 	// checks before creation
-	if !edgeNEO.EnableEdgeActiveStandby && edgeNEO.EnableEdgeActiveStandbyPreemptive {
-		return diag.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
-	}
-
-	if !edgeNEO.EnableLearnedCidrsApproval && len(edgeNEO.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
-	}
-
-	if len(edgeNEO.PrependAsPath) != 0 {
-		if edgeNEO.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
-		}
-	}
-
-	if edgeNEO.Latitude != "" && edgeNEO.Longitude != "" {
-		latitude, _ := strconv.ParseFloat(edgeNEO.Latitude, 64)
-		longitude, _ := strconv.ParseFloat(edgeNEO.Longitude, 64)
-		if latitude == 0 && longitude == 0 {
-			return diag.Errorf("latitude and longitude must not be zero at the same time")
-		}
+	if err := validateEdgeGatewayConfig(expandEdgeGatewayConfig(d)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// create
@@ -518,142 +382,9 @@ func resourceAviatrixEdgePlatformCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	// advanced configs
-	// use following variables to reuse functions for transit, spoke, gateway and EaaS
-	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
-		GwName: edgeNEO.GwName,
-	}
-	gatewayForSpokeFunctions := &goaviatrix.SpokeVpc{
-		GwName: edgeNEO.GwName,
-	}
-	gatewayForGatewayFunctions := &goaviatrix.Gateway{
-		GwName: edgeNEO.GwName,
-	}
-	gatewayForEaasFunctions := &goaviatrix.EdgeSpoke{
-		GwName: edgeNEO.GwName,
-	}
-
-	if edgeNEO.LocalAsNumber != "" {
-		err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeNEO.LocalAsNumber)
-		if err != nil {
-			return diag.Errorf("could not set 'local_as_number' after Edge Platform creation: %v", err)
-		}
-	}
-
-	if len(edgeNEO.PrependAsPath) != 0 {
-		err := client.SetPrependASPath(gatewayForTransitFunctions, edgeNEO.PrependAsPath)
-		if err != nil {
-			return diag.Errorf("could not set 'prepend_as_path' after Edge Platform creation: %v", err)
-		}
-	}
-
-	if edgeNEO.EnableLearnedCidrsApproval {
-		err := client.EnableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not enable learned CIDRs approval after Edge Platform creation: %v", err)
-		}
-	}
-
-	if len(edgeNEO.ApprovedLearnedCidrs) != 0 {
-		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeNEO.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not update approved CIDRs after Edge Platform creation: %v", err)
-		}
-	}
-
-	if len(edgeNEO.SpokeBgpManualAdvertisedCidrs) != 0 {
-		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeNEO.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not set spoke BGP manual advertised CIDRs after Edge Platform creation: %v", err)
-		}
-	}
-
-	if edgeNEO.EnablePreserveAsPath {
-		err := client.EnableSpokePreserveAsPath(gatewayForSpokeFunctions)
-		if err != nil {
-			return diag.Errorf("could not enable spoke preserve as path after Edge Platform creation: %v", err)
-		}
-	}
-
-	if edgeNEO.BgpPollingTime >= 10 && edgeNEO.BgpPollingTime != defaultBgpPollingTime {
-		err := client.SetBgpPollingTimeSpoke(gatewayForSpokeFunctions, edgeNEO.BgpPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp polling time after Edge Platform creation: %v", err)
-		}
-	}
-
-	if edgeNEO.BgpBfdPollingTime >= 1 && edgeNEO.BgpBfdPollingTime != defaultBgpNeighborStatusPollingTime {
-		err := client.SetBgpBfdPollingTimeSpoke(gatewayForSpokeFunctions, edgeNEO.BgpBfdPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp neighbor status polling time after Edge Platform creation: %v", err)
-		}
-	}
-
-	if edgeNEO.BgpHoldTime >= 12 && edgeNEO.BgpHoldTime != defaultBgpHoldTime {
-		err := client.ChangeBgpHoldTime(gatewayForSpokeFunctions.GwName, edgeNEO.BgpHoldTime)
-		if err != nil {
-			return diag.Errorf("could not change BGP Hold Time after Edge Platform creation: %v", err)
-		}
-	}
-
-	if edgeNEO.EnableEdgeTransitiveRouting {
-		err := client.EnableEdgeSpokeTransitiveRouting(ctx, edgeNEO.GwName)
-		if err != nil {
-			return diag.Errorf("could not enable Edge transitive routing after Edge Platform creation: %v", err)
-		}
-	}
-
-	if edgeNEO.EnableJumboFrame {
-		err := client.EnableJumboFrame(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not disable jumbo frame after Edge Platform creation: %v", err)
-		}
-	}
-
-	if edgeNEO.Latitude != "" || edgeNEO.Longitude != "" {
-		gatewayForEaasFunctions.Latitude = edgeNEO.Latitude
-		gatewayForEaasFunctions.Longitude = edgeNEO.Longitude
-		err := client.UpdateEdgeSpokeGeoCoordinate(ctx, gatewayForEaasFunctions)
-		if err != nil {
-			return diag.Errorf("could not update geo coordinate after Edge Platform creation: %v", err)
-		}
-	}
-
-	if edgeNEO.RxQueueSize != "" {
-		gatewayForGatewayFunctions.RxQueueSize = edgeNEO.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not set rx queue size after Edge Platform creation: %v", err)
-		}
-	}
-
-	if edgeNEO.EnableSingleIpSnat {
-		gatewayForGatewayFunctions.GatewayName = edgeNEO.GwName
-		err := client.EnableSNat(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("failed to enable single IP SNAT: %s", err)
-		}
-	}
-
-	if edgeNEO.EnableAutoAdvertiseLanCidrs == "disable" {
-		err := client.UpdateEdgeNEO(ctx, edgeNEO)
-		if err != nil {
-			return diag.Errorf("could not disable auto advertise LAN CIDRs after Edge Platform creation: %v", err)
-		}
-	}
-
-	if edgeNEO.EnableEdgeActiveStandby || edgeNEO.EnableEdgeActiveStandbyPreemptive {
-		err := client.UpdateEdgeNEO(ctx, edgeNEO)
-		if err != nil {
-			return diag.Errorf("could not update Edge active standby or Edge active standby preemptive after Edge Platform creation: %v", err)
-		}
-	}
-
-	// set the advertised spoke cidr routes
-	err := editAdvertisedSpokeRoutesWithRetry(client, gatewayForGatewayFunctions, d)
+	err := configureEdgeGatewayAfterCreate(ctx, client, d, edgePlatformPlatform(client, edgeNEO))
 	if err != nil {
-		return diag.Errorf("failed to edit advertised spoke vpc routes of spoke gateway %q: %s", gatewayForGatewayFunctions.GwName, err)
+		return diag.FromErr(err)
 	}
 
 	return resourceAviatrixEdgePlatformReadIfRequired(ctx, d, meta, &flag)
@@ -668,12 +399,12 @@ func resourceAviatrixEdgePlatformReadIfRequired(ctx context.Context, d *schema.R
 }
 
 func resourceAviatrixEdgePlatformRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// handle import
 	if d.Get("gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no name received", map[string]interface{}{"id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -692,63 +423,11 @@ func resourceAviatrixEdgePlatformRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("site_id", edgeNEOResp.SiteId)
 	d.Set("device_id", edgeNEOResp.DeviceId)
 	d.Set("gw_size", edgeNEOResp.GwSize)
-	d.Set("enable_management_over_private_network", edgeNEOResp.EnableManagementOverPrivateNetwork)
-	d.Set("dns_server_ip", edgeNEOResp.DnsServerIp)
-	d.Set("secondary_dns_server_ip", edgeNEOResp.SecondaryDnsServerIp)
-	d.Set("local_as_number", edgeNEOResp.LocalAsNumber)
-	d.Set("prepend_as_path", edgeNEOResp.PrependAsPath)
-	d.Set("enable_edge_active_standby", edgeNEOResp.EnableEdgeActiveStandby)
-	d.Set("enable_edge_active_standby_preemptive", edgeNEOResp.EnableEdgeActiveStandbyPreemptive)
-	d.Set("enable_learned_cidrs_approval", edgeNEOResp.EnableLearnedCidrsApproval)
-
-	if len(edgeNEOResp.AdvertisedCidrList) > 0 {
-		_ = d.Set("included_advertised_spoke_routes", edgeNEOResp.AdvertisedCidrList)
-	}
-
-	if edgeNEOResp.ManagementEgressIpPrefix == "" {
-		d.Set("management_egress_ip_prefix_list", nil)
-	} else {
-		d.Set("management_egress_ip_prefix_list", strings.Split(edgeNEOResp.ManagementEgressIpPrefix, ","))
-	}
-
-	if edgeNEOResp.EnableLearnedCidrsApproval {
-		spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfig(&goaviatrix.SpokeVpc{GwName: edgeNEOResp.GwName})
-		if err != nil {
-			return diag.Errorf("could not get advanced config for Edge Platform: %v", err)
-		}
-
-		err = d.Set("approved_learned_cidrs", spokeAdvancedConfig.ApprovedLearnedCidrs)
-		if err != nil {
-			return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
-		}
-	} else {
-		d.Set("approved_learned_cidrs", nil)
-	}
-
-	spokeBgpManualAdvertisedCidrs := getStringSet(d, "spoke_bgp_manual_advertise_cidrs")
-	if len(goaviatrix.Difference(spokeBgpManualAdvertisedCidrs, edgeNEOResp.SpokeBgpManualAdvertisedCidrs)) != 0 ||
-		len(goaviatrix.Difference(edgeNEOResp.SpokeBgpManualAdvertisedCidrs, spokeBgpManualAdvertisedCidrs)) != 0 {
-		d.Set("spoke_bgp_manual_advertise_cidrs", edgeNEOResp.SpokeBgpManualAdvertisedCidrs)
-	} else {
-		d.Set("spoke_bgp_manual_advertise_cidrs", spokeBgpManualAdvertisedCidrs)
-	}
 
-	d.Set("enable_preserve_as_path", edgeNEOResp.EnablePreserveAsPath)
-	d.Set("bgp_polling_time", edgeNEOResp.BgpPollingTime)
-	d.Set("bgp_neighbor_status_polling_time", edgeNEOResp.BgpBfdPollingTime)
-	d.Set("bgp_hold_time", edgeNEOResp.BgpHoldTime)
-	d.Set("enable_edge_transitive_routing", edgeNEOResp.EnableEdgeTransitiveRouting)
-	d.Set("enable_jumbo_frame", edgeNEOResp.EnableJumboFrame)
-	if edgeNEOResp.Latitude != 0 || edgeNEOResp.Longitude != 0 {
-		d.Set("latitude", fmt.Sprintf("%.6f", edgeNEOResp.Latitude))
-		d.Set("longitude", fmt.Sprintf("%.6f", edgeNEOResp.Longitude))
-	} else {
-		d.Set("latitude", "")
-		d.Set("longitude", "")
+	if err := flattenEdgeGateway(client, d, edgePlatformState(edgeNEOResp)); err != nil {
+		return diag.Errorf("could not read Edge Platform: %v", err)
 	}
 
-	d.Set("rx_queue_size", edgeNEOResp.RxQueueSize)
-	d.Set("state", edgeNEOResp.State)
 	d.Set("wan_interface_names", edgeNEOResp.WanInterface)
 	d.Set("lan_interface_names", edgeNEOResp.LanInterface)
 	d.Set("management_interface_names", edgeNEOResp.MgmtInterface)
@@ -803,7 +482,6 @@ func resourceAviatrixEdgePlatformRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("failed to set vlan: %s\n", err)
 	}
 
-	d.Set("enable_single_ip_snat", edgeNEOResp.EnableNat == "yes" && edgeNEOResp.SnatMode == "primary")
 	d.Set("enable_auto_advertise_lan_cidrs", edgeNEOResp.EnableAutoAdvertiseLanCidrs)
 
 	d.SetId(edgeNEOResp.GwName)
@@ -811,218 +489,21 @@ func resourceAviatrixEdgePlatformRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixEdgePlatformUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeNEO := marshalEdgePlatformInput(d)
 
 	// checks before update
-	if !edgeNEO.EnableEdgeActiveStandby && edgeNEO.EnableEdgeActiveStandbyPreemptive {
-		return diag.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
-	}
-
-	if !edgeNEO.EnableLearnedCidrsApproval && len(edgeNEO.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
-	}
-
-	if len(edgeNEO.PrependAsPath) != 0 {
-		if edgeNEO.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
-		}
-	}
-
-	if edgeNEO.Latitude != "" && edgeNEO.Longitude != "" {
-		latitude, _ := strconv.ParseFloat(edgeNEO.Latitude, 64)
-		longitude, _ := strconv.ParseFloat(edgeNEO.Longitude, 64)
-		if latitude == 0 && longitude == 0 {
-			return diag.Errorf("latitude and longitude must not be zero at the same time")
-		}
+	if err := validateEdgeGatewayConfig(expandEdgeGatewayConfig(d)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Partial(true)
 
-	// update configs
-	// use following variables to reuse functions for transit, spoke and gateway
-	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
-		GwName: edgeNEO.GwName,
-	}
-	gatewayForSpokeFunctions := &goaviatrix.SpokeVpc{
-		GwName: edgeNEO.GwName,
-	}
-	gatewayForGatewayFunctions := &goaviatrix.Gateway{
-		GwName: edgeNEO.GwName,
-	}
-	gatewayForEaasFunctions := &goaviatrix.EdgeSpoke{
-		GwName: edgeNEO.GwName,
-	}
-
-	if d.HasChanges("local_as_number", "prepend_as_path") {
-		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(edgeNEO.PrependAsPath) == 0 {
-			// prependASPath must be deleted from the controller before local_as_number can be changed
-			// Handle the case where prependASPath is empty here so that the API is not called twice
-			err := client.SetPrependASPath(gatewayForTransitFunctions, nil)
-			if err != nil {
-				return diag.Errorf("could not delete prepend_as_path during Edge Platform update: %v", err)
-			}
-		}
-
-		if d.HasChange("local_as_number") {
-			err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeNEO.LocalAsNumber)
-			if err != nil {
-				return diag.Errorf("could not set local_as_number during Edge Platform update: %v", err)
-			}
-		}
-
-		if d.HasChange("prepend_as_path") && len(edgeNEO.PrependAsPath) > 0 {
-			err := client.SetPrependASPath(gatewayForTransitFunctions, edgeNEO.PrependAsPath)
-			if err != nil {
-				return diag.Errorf("could not set prepend_as_path during Edge Platform update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("included_advertised_spoke_routes") {
-		err := editAdvertisedSpokeRoutesWithRetry(client, gatewayForGatewayFunctions, d)
-		if err != nil {
-			return diag.Errorf("could not update included advertised spoke routes during Edge Platform update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_learned_cidrs_approval") {
-		if edgeNEO.EnableLearnedCidrsApproval {
-			err := client.EnableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable learned cidrs approval during Edge Platform update: %v", err)
-			}
-		} else {
-			err := client.DisableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable learned cidrs approval during Edge Platform update: %v", err)
-			}
-		}
-	}
-
-	if edgeNEO.EnableLearnedCidrsApproval && d.HasChange("approved_learned_cidrs") {
-		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeNEO.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not update approved learned CIDRs during Edge Platform update: %v", err)
-		}
-	}
-
-	if d.HasChange("spoke_bgp_manual_advertise_cidrs") {
-		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeNEO.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not set spoke BGP manual advertised CIDRs during Edge Platform update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_preserve_as_path") {
-		if edgeNEO.EnablePreserveAsPath {
-			err := client.EnableSpokePreserveAsPath(gatewayForSpokeFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable preserve as path during Edge Platform update: %v", err)
-			}
-		} else {
-			err := client.DisableSpokePreserveAsPath(gatewayForSpokeFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable preserve as path during Edge Platform update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("bgp_polling_time") {
-		err := client.SetBgpPollingTimeSpoke(gatewayForSpokeFunctions, edgeNEO.BgpPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp polling time during Edge Platform update: %v", err)
-		}
-	}
-
-	if d.HasChange("bgp_neighbor_status_polling_time") {
-		err := client.SetBgpBfdPollingTimeSpoke(gatewayForSpokeFunctions, edgeNEO.BgpBfdPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp neighbor status polling time during Edge Platform update: %v", err)
-		}
-	}
-
-	if d.HasChange("bgp_hold_time") {
-		err := client.ChangeBgpHoldTime(edgeNEO.GwName, edgeNEO.BgpHoldTime)
-		if err != nil {
-			return diag.Errorf("could not change bgp hold time during Edge Platform update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_edge_transitive_routing") {
-		if edgeNEO.EnableEdgeTransitiveRouting {
-			err := client.EnableEdgeSpokeTransitiveRouting(ctx, edgeNEO.GwName)
-			if err != nil {
-				return diag.Errorf("could not enable transitive routing during Edge Platform update: %v", err)
-			}
-		} else {
-			err := client.DisableEdgeSpokeTransitiveRouting(ctx, edgeNEO.GwName)
-			if err != nil {
-				return diag.Errorf("could not disable transitive routing during Edge Platform update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("enable_jumbo_frame") {
-		if edgeNEO.EnableJumboFrame {
-			err := client.EnableJumboFrame(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable jumbo frame during Edge Platform update: %v", err)
-			}
-		} else {
-			err := client.DisableJumboFrame(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable jumbo frame during Edge Platform update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChanges("latitude", "longitude") {
-		gatewayForEaasFunctions.Latitude = edgeNEO.Latitude
-		gatewayForEaasFunctions.Longitude = edgeNEO.Longitude
-		err := client.UpdateEdgeSpokeGeoCoordinate(ctx, gatewayForEaasFunctions)
-		if err != nil {
-			return diag.Errorf("could not update geo coordinate during Edge Platform update: %v", err)
-		}
-	}
-
-	if d.HasChange("rx_queue_size") {
-		gatewayForGatewayFunctions.RxQueueSize = edgeNEO.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not update rx queue size during Edge Platform update: %v", err)
-		}
-	}
-
-	if d.HasChanges("management_egress_ip_prefix_list", "interfaces", "vlan",
-		"enable_auto_advertise_lan_cidrs", "enable_edge_active_standby", "enable_edge_active_standby_preemptive") {
-		err := client.UpdateEdgeNEO(ctx, edgeNEO)
-		if err != nil {
-			return diag.Errorf("could not update management egress ip prefix list, WAN/LAN/VLAN interfaces, "+
-				"DNS profile name, auto advertise LAN CIDRs, Edge active standby or Edge active standby preemptive "+
-				"during Edge Platform update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_single_ip_snat") {
-		gatewayForGatewayFunctions.GatewayName = edgeNEO.GwName
-
-		if edgeNEO.EnableSingleIpSnat {
-			err := client.EnableSNat(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("failed to enable single IP SNAT during update: %s", err)
-			}
-		} else {
-			err := client.DisableSNat(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("failed to disable single IP SNAT during update: %s", err)
-			}
-		}
-
+	err := updateEdgeGateway(ctx, client, d, edgePlatformPlatform(client, edgeNEO))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Partial(false)
@@ -1031,7 +512,7 @@ func resourceAviatrixEdgePlatformUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgePlatformDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 	gwName := d.Get("gw_name").(string)
@@ -2,11 +2,11 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -139,7 +139,7 @@ func marshalEdgePlatformDeviceOnboardingInput(d *schema.ResourceData) *goaviatri
 }
 
 func resourceAviatrixEdgePlatformDeviceOnboardingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeNEODevice := marshalEdgePlatformDeviceOnboardingInput(d)
 
@@ -197,13 +197,13 @@ func resourceAviatrixEdgePlatformDeviceOnboardingReadIfRequired(ctx context.Cont
 }
 
 func resourceAviatrixEdgePlatformDeviceOnboardingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 	deviceName := d.Get("device_name").(string)
 	if accountName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no account name received", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
 			return diag.Errorf("Invalid Import ID received, ID must be in the format account_name~device_name")
@@ -266,7 +266,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingRead(ctx context.Context, d *sc
 }
 
 func resourceAviatrixEdgePlatformDeviceOnboardingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeNEODevice := marshalEdgePlatformDeviceOnboardingInput(d)
 
@@ -305,7 +305,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingUpdate(ctx context.Context, d *
 			fileName := oldConfigFileDownloadPath.(string) + edgeNEODevice.SerialNumber + "-bootstrap-config.img"
 			err := os.Remove(fileName)
 			if err != nil {
-				log.Printf("[WARN] could not remove the config file: %v", err)
+				tflog.Warn(ctx, "could not remove the config file", map[string]interface{}{"error": err})
 			}
 		}
 	}
@@ -321,7 +321,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingUpdate(ctx context.Context, d *
 }
 
 func resourceAviatrixEdgePlatformDeviceOnboardingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeNEODevice := marshalEdgePlatformDeviceOnboardingInput(d)
 
@@ -334,7 +334,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingDelete(ctx context.Context, d *
 		fileName := edgeNEODevice.ConfigFileDownloadPath + edgeNEODevice.SerialNumber + "-bootstrap-config.img"
 		err = os.Remove(fileName)
 		if err != nil {
-			log.Printf("[WARN] could not remove the config file: %v", err)
+			tflog.Warn(ctx, "could not remove the config file", map[string]interface{}{"error": err})
 		}
 	}
 
@@ -2,9 +2,9 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -13,14 +13,20 @@ import (
 
 func resourceAviatrixEdgePlatformHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgePlatformHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgePlatformHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgePlatformHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgePlatformHaDelete,
+		CreateContext: resourceAviatrixEdgePlatformHaCreate,
+		ReadContext:   resourceAviatrixEdgePlatformHaRead,
+		UpdateContext: resourceAviatrixEdgePlatformHaUpdate,
+		DeleteContext: resourceAviatrixEdgePlatformHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -153,7 +159,7 @@ func marshalEdgePlatformHaInput(d *schema.ResourceData) *goaviatrix.EdgeNEOHa {
 }
 
 func resourceAviatrixEdgePlatformHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeNEOHa := marshalEdgePlatformHaInput(d)
 
@@ -167,11 +173,11 @@ func resourceAviatrixEdgePlatformHaCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixEdgePlatformHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.Get("primary_gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "-hagw")
 		d.Set("primary_gw_name", parts[0])
 		d.SetId(id)
@@ -223,7 +229,7 @@ func resourceAviatrixEdgePlatformHaRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgePlatformHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeNEOHa := marshalEdgePlatformHaInput(d)
 
@@ -248,7 +254,7 @@ func resourceAviatrixEdgePlatformHaUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixEdgePlatformHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 
@@ -2,9 +2,9 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -94,7 +94,7 @@ func edgePlatformProxyProfileFromProxyProfile(proxy *proxyProfile) *goaviatrix.E
 }
 
 func resourceAviatrixEdgeProxyProfileConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	proxy := marshalEdgeProxyProfileConfigInput(d)
 	createdProxy, err := client.CreateEdgeProxyProfile(ctx, edgePlatformProxyProfileFromProxyProfile(proxy))
@@ -110,7 +110,7 @@ func resourceAviatrixEdgeProxyProfileConfigCreate(ctx context.Context, d *schema
 func resourceAviatrixEdgeProxyProfileConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	proxy := marshalEdgeProxyProfileConfigInput(d)
 
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 	existingProxy, err := client.GetEdgePlatformProxyProfile(ctx, proxy.AccountName, proxy.Name)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
@@ -150,13 +150,13 @@ func resourceAviatrixEdgeProxyProfileConfigUpdate(ctx context.Context, d *schema
 }
 
 func resourceAviatrixEdgeProxyProfileConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 	proxyProfileName := d.Get("proxy_profile_name").(string)
 	if accountName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no account name received", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
 			return diag.Errorf("Invalid Import ID received, ID must be in the format account_name~proxy_profile_name")
@@ -186,7 +186,7 @@ func resourceAviatrixEdgeProxyProfileConfigRead(ctx context.Context, d *schema.R
 }
 
 func resourceAviatrixEdgeProxyProfileConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.DeleteEdgePlatformProxyProfile(ctx, d.Get("account_name").(string), d.Get("proxy_profile_name").(string))
 	if err != nil {
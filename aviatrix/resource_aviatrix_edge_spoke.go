@@ -3,11 +3,11 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"os"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,14 +18,20 @@ import (
 
 func resourceAviatrixEdgeSpoke() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeSpokeCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeSpokeRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeSpokeUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeSpokeDelete,
+		CreateContext: resourceAviatrixEdgeSpokeCreate,
+		ReadContext:   resourceAviatrixEdgeSpokeRead,
+		UpdateContext: resourceAviatrixEdgeSpokeUpdate,
+		DeleteContext: resourceAviatrixEdgeSpokeDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
 				Type:        schema.TypeString,
@@ -313,7 +319,7 @@ func marshalEdgeSpokeInput(d *schema.ResourceData) *goaviatrix.EdgeSpoke {
 }
 
 func resourceAviatrixEdgeSpokeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeSpoke := marshalEdgeSpokeInput(d)
@@ -475,12 +481,12 @@ func resourceAviatrixEdgeSpokeReadIfRequired(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixEdgeSpokeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// handle import
 	if d.Get("gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no name received", map[string]interface{}{"id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -583,7 +589,7 @@ func resourceAviatrixEdgeSpokeRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixEdgeSpokeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeSpoke := marshalEdgeSpokeInput(d)
@@ -773,7 +779,7 @@ func resourceAviatrixEdgeSpokeUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeSpokeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	gwName := d.Get("gw_name").(string)
 	siteId := d.Get("site_id").(string)
@@ -795,7 +801,7 @@ func resourceAviatrixEdgeSpokeDelete(ctx context.Context, d *schema.ResourceData
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, "could not remove the ztp file", map[string]interface{}{"error": err})
 	}
 
 	return nil
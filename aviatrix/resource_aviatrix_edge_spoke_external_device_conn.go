@@ -3,11 +3,11 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -304,7 +304,7 @@ func marshalEdgeSpokeExternalDeviceConnInput(d *schema.ResourceData) (*goaviatri
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	externalDeviceConn, err := marshalEdgeSpokeExternalDeviceConnInput(d)
 	if err != nil {
@@ -355,7 +355,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnCreate(ctx context.Context, d *s
 	for i := 0; ; i++ {
 		if externalDeviceConn.EnableEdgeUnderlay {
 			connName, err = client.CreateEdgeExternalDeviceConn(&edgeExternalDeviceConn)
-			log.Printf("[DEBUG] Created underlay connection %s", connName)
+			tflog.Debug(ctx, "Created underlay connection", map[string]interface{}{"conn_name": connName})
 		} else {
 			err = client.CreateExternalDeviceConn(externalDeviceConn)
 		}
@@ -451,7 +451,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnReadIfRequired(ctx context.Conte
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	connectionName, ok := d.Get("connection_name").(string)
 	if !ok {
@@ -460,7 +460,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnRead(ctx context.Context, d *sch
 	vpcID := d.Get("site_id").(string)
 	if connectionName == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no 'site_id' received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no 'site_id' received", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 3 {
 			return diag.Errorf("expected import ID in the form 'connection_name~site_id~gw_name' instead got %q", id)
@@ -488,7 +488,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnRead(ctx context.Context, d *sch
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("couldn't find Edge as a Spoke external device conn: %s, %#v", err, externalDeviceConn)
+		return diag.Errorf("couldn't find Edge as a Spoke external device conn %s: %s", externalDeviceConn.ConnectionName, err)
 	}
 
 	// If we are dealing with a HAGW, we're interested in the BGP backup
@@ -579,7 +579,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnRead(ctx context.Context, d *sch
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 	d.Partial(true)
 
 	externalDeviceConn, err := marshalEdgeSpokeExternalDeviceConnInput(d)
@@ -726,7 +726,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnUpdate(ctx context.Context, d *s
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	externalDeviceConn, err := marshalEdgeSpokeExternalDeviceConnInput(d)
 	if err != nil {
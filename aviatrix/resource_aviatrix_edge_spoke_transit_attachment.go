@@ -2,10 +2,10 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -19,7 +19,7 @@ func resourceAviatrixEdgeSpokeTransitAttachment() *schema.Resource {
 		UpdateWithoutTimeout: resourceAviatrixEdgeSpokeTransitAttachmentUpdate,
 		DeleteWithoutTimeout: resourceAviatrixEdgeSpokeTransitAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -163,7 +163,7 @@ func marshalEdgeSpokeTransitAttachmentInput(d *schema.ResourceData) *goaviatrix.
 }
 
 func resourceAviatrixEdgeSpokeTransitAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	attachment := marshalEdgeSpokeTransitAttachmentInput(d)
 
@@ -256,13 +256,13 @@ func resourceAviatrixEdgeSpokeTransitAttachmentReadIfRequired(ctx context.Contex
 }
 
 func resourceAviatrixEdgeSpokeTransitAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	spokeGwName := d.Get("spoke_gw_name").(string)
 	transitGwName := d.Get("transit_gw_name").(string)
 	if spokeGwName == "" || transitGwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no spoke_gw_name or transit_gw_name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no spoke_gw_name or transit_gw_name received", map[string]interface{}{"id": id})
 		d.SetId(id)
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
@@ -363,7 +363,7 @@ func resourceAviatrixEdgeSpokeTransitAttachmentRead(ctx context.Context, d *sche
 }
 
 func resourceAviatrixEdgeSpokeTransitAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	enableInsaneMode := d.Get("enable_insane_mode").(bool)
 	enableOverPrivateNetwork := d.Get("enable_over_private_network").(bool)
@@ -428,7 +428,7 @@ func resourceAviatrixEdgeSpokeTransitAttachmentUpdate(ctx context.Context, d *sc
 }
 
 func resourceAviatrixEdgeSpokeTransitAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	attachment := &goaviatrix.SpokeTransitAttachment{
 		SpokeGwName:   d.Get("spoke_gw_name").(string),
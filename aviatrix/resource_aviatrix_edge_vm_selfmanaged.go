@@ -2,12 +2,10 @@ package aviatrix
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"os"
-	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -17,15 +15,21 @@ import (
 
 func resourceAviatrixEdgeVmSelfmanaged() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeVmSelfmanagedRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedDelete,
+		CreateContext: resourceAviatrixEdgeVmSelfmanagedCreate,
+		ReadContext:   resourceAviatrixEdgeVmSelfmanagedRead,
+		UpdateContext: resourceAviatrixEdgeVmSelfmanagedUpdate,
+		DeleteContext: resourceAviatrixEdgeVmSelfmanagedDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
+		Schema: edgeGatewaySchema(map[string]*schema.Schema{
 			"gw_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -38,37 +42,6 @@ func resourceAviatrixEdgeVmSelfmanaged() *schema.Resource {
 				ForceNew:    true,
 				Description: "Site ID.",
 			},
-			"management_egress_ip_prefix_list": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: "Set of management egress gateway IP/prefix.",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-			"enable_management_over_private_network": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     false,
-				Description: "Enable management over private network.",
-			},
-			"dns_server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "DNS server IP.",
-				ValidateFunc: validation.IsIPAddress,
-				Deprecated:   "DNS server ip attribute will be removed in the future release.",
-			},
-			"secondary_dns_server_ip": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "Secondary DNS server IP.",
-				ValidateFunc: validation.IsIPAddress,
-				Deprecated:   "Secondary DNS server ip attribute will be removed in the future release.",
-			},
 			"ztp_file_type": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -78,123 +51,18 @@ func resourceAviatrixEdgeVmSelfmanaged() *schema.Resource {
 			},
 			"ztp_file_download_path": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old != ""
 				},
-				Description: "The location where the ZTP file will be stored.",
-			},
-			"local_as_number": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "Local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
-			},
-			"prepend_as_path": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
-				},
-				MaxItems: 25,
-			},
-			"enable_edge_active_standby": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enables Edge Active-Standby Mode.",
-			},
-			"enable_edge_active_standby_preemptive": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enables Preemptive Mode for Edge Active-Standby, available only with Active-Standby enabled.",
-			},
-			"enable_learned_cidrs_approval": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Switch to enable/disable learned CIDR approval for BGP Spoke Gateway. Valid values: true, false.",
-			},
-			"approved_learned_cidrs": {
-				Type: schema.TypeSet,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.IsCIDR,
-				},
-				Optional:    true,
-				Description: "Approved learned CIDRs for BGP Spoke Gateway.",
-			},
-			"spoke_bgp_manual_advertise_cidrs": {
-				Type: schema.TypeSet,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-				Optional:    true,
-				Description: "Intended CIDR list to be advertised to external BGP router.",
-			},
-			"enable_preserve_as_path": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable preserve as path when advertising manual summary CIDRs on BGP spoke gateway.",
-			},
-			"bgp_polling_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpPollingTime,
-				ValidateFunc: validation.IntBetween(10, 50),
-				Description:  "BGP route polling time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 10 and 50.",
-			},
-			"bgp_hold_time": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      defaultBgpHoldTime,
-				ValidateFunc: validation.IntBetween(12, 360),
-				Description:  "BGP Hold Time for BGP Spoke Gateway. Unit is in seconds. Valid values are between 12 and 360.",
-			},
-			"enable_edge_transitive_routing": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable Edge transitive routing.",
-			},
-			"enable_jumbo_frame": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Enable jumbo frame.",
-			},
-			"latitude": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
-				Description:      "The latitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				Description: "The location where the ZTP file will be stored. If not set, the ZTP file is not written to disk and is only exported in ztp_file_content.",
 			},
-			"longitude": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
-				Description:      "The longitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
-			},
-			"rx_queue_size": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"1K", "2K", "4K"}, false),
-				Description:  "Ethernet interface RX queue size.",
-			},
-			"state": {
+			"ztp_file_content": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "State of Edge VM Selfmanaged.",
+				Sensitive:   true,
+				Description: "Content of the ZTP file. Base64 encoded if ztp_file_type is 'iso'.",
 			},
 			"interfaces": {
 				Type:        schema.TypeSet,
@@ -246,7 +114,7 @@ func resourceAviatrixEdgeVmSelfmanaged() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 		DeprecationMessage: "Since V3.1.2+, please use resource aviatrix_edge_gateway_selfmanaged instead. Resource " +
 			"aviatrix_edge_vm_selfmanaged will be deprecated in the V3.2.0 release.",
 	}
@@ -300,33 +168,56 @@ func marshalEdgeVmSelfmanagedInput(d *schema.ResourceData) *goaviatrix.EdgeSpoke
 	return edgeSpoke
 }
 
+// edgeVmSelfmanagedPlatform connects the shared edge gateway logic to the Edge VM Selfmanaged API.
+func edgeVmSelfmanagedPlatform(client goaviatrix.ClientInterface, edgeSpoke *goaviatrix.EdgeSpoke) *edgeGatewayPlatform {
+	return &edgeGatewayPlatform{
+		name: "Edge VM Selfmanaged",
+		update: func(ctx context.Context) error {
+			return client.UpdateEdgeSpoke(ctx, edgeSpoke)
+		},
+		updateAttributes: []string{"management_egress_ip_prefix_list", "interfaces", "enable_edge_active_standby", "enable_edge_active_standby_preemptive"},
+	}
+}
+
+// edgeVmSelfmanagedState returns the shared edge gateway attributes of an Edge VM Selfmanaged.
+func edgeVmSelfmanagedState(edgeSpokeResp *goaviatrix.EdgeSpokeResp) *edgeGatewayState {
+	return &edgeGatewayState{
+		GwName:                             edgeSpokeResp.GwName,
+		ManagementEgressIpPrefix:           edgeSpokeResp.ManagementEgressIpPrefix,
+		EnableManagementOverPrivateNetwork: edgeSpokeResp.EnableManagementOverPrivateNetwork,
+		DnsServerIp:                        edgeSpokeResp.DnsServerIp,
+		SecondaryDnsServerIp:               edgeSpokeResp.SecondaryDnsServerIp,
+		LocalAsNumber:                      edgeSpokeResp.LocalAsNumber,
+		PrependAsPath:                      edgeSpokeResp.PrependAsPath,
+		EnableEdgeActiveStandby:            edgeSpokeResp.EnableEdgeActiveStandby,
+		EnableEdgeActiveStandbyPreemptive:  edgeSpokeResp.EnableEdgeActiveStandbyPreemptive,
+		EnableLearnedCidrsApproval:         edgeSpokeResp.EnableLearnedCidrsApproval,
+		SpokeBgpManualAdvertisedCidrs:      edgeSpokeResp.SpokeBgpManualAdvertisedCidrs,
+		EnablePreserveAsPath:               edgeSpokeResp.EnablePreserveAsPath,
+		BgpPollingTime:                     edgeSpokeResp.BgpPollingTime,
+		BgpBfdPollingTime:                  edgeSpokeResp.BgpBfdPollingTime,
+		BgpHoldTime:                        edgeSpokeResp.BgpHoldTime,
+		EnableEdgeTransitiveRouting:        edgeSpokeResp.EnableEdgeTransitiveRouting,
+		EnableJumboFrame:                   edgeSpokeResp.EnableJumboFrame,
+		Latitude:                           edgeSpokeResp.Latitude,
+		Longitude:                          edgeSpokeResp.Longitude,
+		RxQueueSize:                        edgeSpokeResp.RxQueueSize,
+		State:                              edgeSpokeResp.State,
+		EnableNat:                          edgeSpokeResp.EnableNat,
+		SnatMode:                           edgeSpokeResp.SnatMode,
+		AdvertisedCidrList:                 edgeSpokeResp.AdvertisedCidrList,
+	}
+}
+
 func resourceAviatrixEdgeVmSelfmanagedCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeSpoke := marshalEdgeVmSelfmanagedInput(d)
 
 	// checks before creation
-	if !edgeSpoke.EnableEdgeActiveStandby && edgeSpoke.EnableEdgeActiveStandbyPreemptive {
-		return diag.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
-	}
-
-	if !edgeSpoke.EnableLearnedCidrsApproval && len(edgeSpoke.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
-	}
-
-	if len(edgeSpoke.PrependAsPath) != 0 {
-		if edgeSpoke.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
-		}
-	}
-
-	if edgeSpoke.Latitude != "" && edgeSpoke.Longitude != "" {
-		latitude, _ := strconv.ParseFloat(edgeSpoke.Latitude, 64)
-		longitude, _ := strconv.ParseFloat(edgeSpoke.Longitude, 64)
-		if latitude == 0 && longitude == 0 {
-			return diag.Errorf("latitude and longitude must not be zero at the same time")
-		}
+	if err := validateEdgeGatewayConfig(expandEdgeGatewayConfig(d)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// create
@@ -337,111 +228,12 @@ func resourceAviatrixEdgeVmSelfmanagedCreate(ctx context.Context, d *schema.Reso
 	if err := client.CreateEdgeSpoke(ctx, edgeSpoke); err != nil {
 		return diag.Errorf("could not create Edge as a Spoke: %v", err)
 	}
+	d.Set("ztp_file_content", edgeSpoke.ZtpFileContent)
 
 	// advanced configs
-	// use following variables to reuse functions for transit, spoke and gateway
-	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
-		GwName: edgeSpoke.GwName,
-	}
-	gatewayForSpokeFunctions := &goaviatrix.SpokeVpc{
-		GwName: edgeSpoke.GwName,
-	}
-	gatewayForGatewayFunctions := &goaviatrix.Gateway{
-		GwName: edgeSpoke.GwName,
-	}
-
-	if edgeSpoke.LocalAsNumber != "" {
-		err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeSpoke.LocalAsNumber)
-		if err != nil {
-			return diag.Errorf("could not set 'local_as_number' after Edge VM Selfmanaged creation: %v", err)
-		}
-	}
-
-	if len(edgeSpoke.PrependAsPath) != 0 {
-		err := client.SetPrependASPath(gatewayForTransitFunctions, edgeSpoke.PrependAsPath)
-		if err != nil {
-			return diag.Errorf("could not set 'prepend_as_path' after Edge VM Selfmanaged creation: %v", err)
-		}
-	}
-
-	if edgeSpoke.EnableLearnedCidrsApproval {
-		err := client.EnableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not enable learned CIDRs approval after Edge VM Selfmanaged creation: %v", err)
-		}
-	}
-
-	if len(edgeSpoke.ApprovedLearnedCidrs) != 0 {
-		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeSpoke.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not update approved CIDRs after Edge VM Selfmanaged creation: %v", err)
-		}
-	}
-
-	if len(edgeSpoke.SpokeBgpManualAdvertisedCidrs) != 0 {
-		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeSpoke.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not set spoke BGP manual advertised CIDRs after Edge as a Spoke creation: %v", err)
-		}
-	}
-
-	if edgeSpoke.EnablePreserveAsPath {
-		err := client.EnableSpokePreserveAsPath(gatewayForSpokeFunctions)
-		if err != nil {
-			return diag.Errorf("could not enable spoke preserve as path after Edge VM Selfmanaged creation: %v", err)
-		}
-	}
-
-	if edgeSpoke.BgpPollingTime >= 10 && edgeSpoke.BgpPollingTime != defaultBgpPollingTime {
-		err := client.SetBgpPollingTimeSpoke(gatewayForSpokeFunctions, edgeSpoke.BgpPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp polling time after Edge VM Selfmanaged creation: %v", err)
-		}
-	}
-
-	if edgeSpoke.BgpHoldTime >= 12 && edgeSpoke.BgpHoldTime != defaultBgpHoldTime {
-		err := client.ChangeBgpHoldTime(gatewayForSpokeFunctions.GwName, edgeSpoke.BgpHoldTime)
-		if err != nil {
-			return diag.Errorf("could not change BGP Hold Time after Edge VM Selfmanaged creation: %v", err)
-		}
-	}
-
-	if edgeSpoke.EnableEdgeTransitiveRouting {
-		err := client.EnableEdgeSpokeTransitiveRouting(ctx, edgeSpoke.GwName)
-		if err != nil {
-			return diag.Errorf("could not enable Edge transitive routing after Edge VM Selfmanaged creation: %v", err)
-		}
-	}
-
-	if edgeSpoke.EnableJumboFrame {
-		err := client.EnableJumboFrame(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not disable jumbo frame after Edge VM Selfmanaged creation: %v", err)
-		}
-	}
-
-	if edgeSpoke.Latitude != "" || edgeSpoke.Longitude != "" {
-		err := client.UpdateEdgeSpokeGeoCoordinate(ctx, edgeSpoke)
-		if err != nil {
-			return diag.Errorf("could not update geo coordinate after Edge VM Selfmanaged creation: %v", err)
-		}
-	}
-
-	if edgeSpoke.RxQueueSize != "" {
-		gatewayForGatewayFunctions.RxQueueSize = edgeSpoke.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not set rx queue size after Edge VM Selfmanaged creation: %v", err)
-		}
-	}
-
-	if edgeSpoke.EnableEdgeActiveStandby || edgeSpoke.EnableEdgeActiveStandbyPreemptive {
-		err := client.UpdateEdgeSpoke(ctx, edgeSpoke)
-		if err != nil {
-			return diag.Errorf("could not update Edge active standby or Edge active standby preemptive after Edge Gateway creation: %v", err)
-		}
+	err := configureEdgeGatewayAfterCreate(ctx, client, d, edgeVmSelfmanagedPlatform(client, edgeSpoke))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceAviatrixEdgeVmSelfmanagedReadIfRequired(ctx, d, meta, &flag)
@@ -456,12 +248,12 @@ func resourceAviatrixEdgeVmSelfmanagedReadIfRequired(ctx context.Context, d *sch
 }
 
 func resourceAviatrixEdgeVmSelfmanagedRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// handle import
 	if d.Get("gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no name received", map[string]interface{}{"id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -477,14 +269,6 @@ func resourceAviatrixEdgeVmSelfmanagedRead(ctx context.Context, d *schema.Resour
 
 	d.Set("gw_name", edgeSpoke.GwName)
 	d.Set("site_id", edgeSpoke.SiteId)
-	d.Set("enable_management_over_private_network", edgeSpoke.EnableManagementOverPrivateNetwork)
-	d.Set("dns_server_ip", edgeSpoke.DnsServerIp)
-	d.Set("secondary_dns_server_ip", edgeSpoke.SecondaryDnsServerIp)
-	d.Set("local_as_number", edgeSpoke.LocalAsNumber)
-	d.Set("prepend_as_path", edgeSpoke.PrependAsPath)
-	d.Set("enable_edge_active_standby", edgeSpoke.EnableEdgeActiveStandby)
-	d.Set("enable_edge_active_standby_preemptive", edgeSpoke.EnableEdgeActiveStandbyPreemptive)
-	d.Set("enable_learned_cidrs_approval", edgeSpoke.EnableLearnedCidrsApproval)
 
 	if edgeSpoke.ZtpFileType == "iso" || edgeSpoke.ZtpFileType == "cloud-init" {
 		d.Set("ztp_file_type", edgeSpoke.ZtpFileType)
@@ -494,50 +278,10 @@ func resourceAviatrixEdgeVmSelfmanagedRead(ctx context.Context, d *schema.Resour
 		d.Set("ztp_file_type", "cloud-init")
 	}
 
-	if edgeSpoke.ManagementEgressIpPrefix == "" {
-		d.Set("management_egress_ip_prefix_list", nil)
-	} else {
-		d.Set("management_egress_ip_prefix_list", strings.Split(edgeSpoke.ManagementEgressIpPrefix, ","))
-	}
-
-	if edgeSpoke.EnableLearnedCidrsApproval {
-		spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfig(&goaviatrix.SpokeVpc{GwName: edgeSpoke.GwName})
-		if err != nil {
-			return diag.Errorf("could not get advanced config for Edge as a Spoke: %v", err)
-		}
-
-		err = d.Set("approved_learned_cidrs", spokeAdvancedConfig.ApprovedLearnedCidrs)
-		if err != nil {
-			return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
-		}
-	} else {
-		d.Set("approved_learned_cidrs", nil)
-	}
-
-	spokeBgpManualAdvertisedCidrs := getStringSet(d, "spoke_bgp_manual_advertise_cidrs")
-	if len(goaviatrix.Difference(spokeBgpManualAdvertisedCidrs, edgeSpoke.SpokeBgpManualAdvertisedCidrs)) != 0 ||
-		len(goaviatrix.Difference(edgeSpoke.SpokeBgpManualAdvertisedCidrs, spokeBgpManualAdvertisedCidrs)) != 0 {
-		d.Set("spoke_bgp_manual_advertise_cidrs", edgeSpoke.SpokeBgpManualAdvertisedCidrs)
-	} else {
-		d.Set("spoke_bgp_manual_advertise_cidrs", spokeBgpManualAdvertisedCidrs)
-	}
-
-	d.Set("enable_preserve_as_path", edgeSpoke.EnablePreserveAsPath)
-	d.Set("bgp_polling_time", edgeSpoke.BgpPollingTime)
-	d.Set("bgp_hold_time", edgeSpoke.BgpHoldTime)
-	d.Set("enable_edge_transitive_routing", edgeSpoke.EnableEdgeTransitiveRouting)
-	d.Set("enable_jumbo_frame", edgeSpoke.EnableJumboFrame)
-	if edgeSpoke.Latitude != 0 || edgeSpoke.Longitude != 0 {
-		d.Set("latitude", fmt.Sprintf("%.6f", edgeSpoke.Latitude))
-		d.Set("longitude", fmt.Sprintf("%.6f", edgeSpoke.Longitude))
-	} else {
-		d.Set("latitude", "")
-		d.Set("longitude", "")
+	if err := flattenEdgeGateway(client, d, edgeVmSelfmanagedState(edgeSpoke)); err != nil {
+		return diag.Errorf("could not read Edge VM Selfmanaged: %v", err)
 	}
 
-	d.Set("rx_queue_size", edgeSpoke.RxQueueSize)
-	d.Set("state", edgeSpoke.State)
-
 	var interfaces []map[string]interface{}
 
 	for _, if0 := range edgeSpoke.InterfaceList {
@@ -563,181 +307,21 @@ func resourceAviatrixEdgeVmSelfmanagedRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceAviatrixEdgeVmSelfmanagedUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	// read configs
 	edgeSpoke := marshalEdgeVmSelfmanagedInput(d)
 
 	// checks before update
-	if !edgeSpoke.EnableEdgeActiveStandby && edgeSpoke.EnableEdgeActiveStandbyPreemptive {
-		return diag.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
-	}
-
-	if !edgeSpoke.EnableLearnedCidrsApproval && len(edgeSpoke.ApprovedLearnedCidrs) != 0 {
-		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
-	}
-
-	if len(edgeSpoke.PrependAsPath) != 0 {
-		if edgeSpoke.LocalAsNumber == "" {
-			return diag.Errorf("'prepend_as_path' must be empty if 'local_as_number' is not set")
-		}
-	}
-
-	if edgeSpoke.Latitude != "" && edgeSpoke.Longitude != "" {
-		latitude, _ := strconv.ParseFloat(edgeSpoke.Latitude, 64)
-		longitude, _ := strconv.ParseFloat(edgeSpoke.Longitude, 64)
-		if latitude == 0 && longitude == 0 {
-			return diag.Errorf("latitude and longitude must not be zero at the same time")
-		}
+	if err := validateEdgeGatewayConfig(expandEdgeGatewayConfig(d)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Partial(true)
 
-	// update configs
-	// use following variables to reuse functions for transit, spoke and gateway
-	gatewayForTransitFunctions := &goaviatrix.TransitVpc{
-		GwName: edgeSpoke.GwName,
-	}
-	gatewayForSpokeFunctions := &goaviatrix.SpokeVpc{
-		GwName: edgeSpoke.GwName,
-	}
-	gatewayForGatewayFunctions := &goaviatrix.Gateway{
-		GwName: edgeSpoke.GwName,
-	}
-
-	if d.HasChanges("local_as_number", "prepend_as_path") {
-		if (d.HasChange("local_as_number") && d.HasChange("prepend_as_path")) || len(edgeSpoke.PrependAsPath) == 0 {
-			// prependASPath must be deleted from the controller before local_as_number can be changed
-			// Handle the case where prependASPath is empty here so that the API is not called twice
-			err := client.SetPrependASPath(gatewayForTransitFunctions, nil)
-			if err != nil {
-				return diag.Errorf("could not delete prepend_as_path during Edge VM Selfmanaged update: %v", err)
-			}
-		}
-
-		if d.HasChange("local_as_number") {
-			err := client.SetLocalASNumber(gatewayForTransitFunctions, edgeSpoke.LocalAsNumber)
-			if err != nil {
-				return diag.Errorf("could not set local_as_number during Edge VM Selfmanaged update: %v", err)
-			}
-		}
-
-		if d.HasChange("prepend_as_path") && len(edgeSpoke.PrependAsPath) > 0 {
-			err := client.SetPrependASPath(gatewayForTransitFunctions, edgeSpoke.PrependAsPath)
-			if err != nil {
-				return diag.Errorf("could not set prepend_as_path during Edge VM Selfmanaged update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("enable_learned_cidrs_approval") {
-		if edgeSpoke.EnableLearnedCidrsApproval {
-			err := client.EnableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable learned cidrs approval during Edge VM Selfmanaged update: %v", err)
-			}
-		} else {
-			err := client.DisableTransitLearnedCidrsApproval(gatewayForTransitFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable learned cidrs approval during Edge VM Selfmanaged update: %v", err)
-			}
-		}
-	}
-
-	if edgeSpoke.EnableLearnedCidrsApproval && d.HasChange("approved_learned_cidrs") {
-		gatewayForTransitFunctions.ApprovedLearnedCidrs = edgeSpoke.ApprovedLearnedCidrs
-		err := client.UpdateTransitPendingApprovedCidrs(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not update approved learned CIDRs during Edge VM Selfmanaged update: %v", err)
-		}
-	}
-
-	if d.HasChange("spoke_bgp_manual_advertise_cidrs") {
-		gatewayForTransitFunctions.BgpManualSpokeAdvertiseCidrs = strings.Join(edgeSpoke.SpokeBgpManualAdvertisedCidrs, ",")
-		err := client.SetBgpManualSpokeAdvertisedNetworks(gatewayForTransitFunctions)
-		if err != nil {
-			return diag.Errorf("could not set spoke BGP manual advertised CIDRs during Edge VM Selfmanaged update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_preserve_as_path") {
-		if edgeSpoke.EnablePreserveAsPath {
-			err := client.EnableSpokePreserveAsPath(gatewayForSpokeFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable preserve as path during Edge VM Selfmanaged update: %v", err)
-			}
-		} else {
-			err := client.DisableSpokePreserveAsPath(gatewayForSpokeFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable preserve as path during Edge VM Selfmanaged update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("bgp_polling_time") {
-		err := client.SetBgpPollingTimeSpoke(gatewayForSpokeFunctions, edgeSpoke.BgpPollingTime)
-		if err != nil {
-			return diag.Errorf("could not set bgp polling time during Edge VM Selfmanaged update: %v", err)
-		}
-	}
-
-	if d.HasChange("bgp_hold_time") {
-		err := client.ChangeBgpHoldTime(edgeSpoke.GwName, edgeSpoke.BgpHoldTime)
-		if err != nil {
-			return diag.Errorf("could not change bgp hold time during Edge VM Selfmanaged update: %v", err)
-		}
-	}
-
-	if d.HasChange("enable_edge_transitive_routing") {
-		if edgeSpoke.EnableEdgeTransitiveRouting {
-			err := client.EnableEdgeSpokeTransitiveRouting(ctx, edgeSpoke.GwName)
-			if err != nil {
-				return diag.Errorf("could not enable transitive routing during Edge VM Selfmanaged update: %v", err)
-			}
-		} else {
-			err := client.DisableEdgeSpokeTransitiveRouting(ctx, edgeSpoke.GwName)
-			if err != nil {
-				return diag.Errorf("could not disable transitive routing during Edge VM Selfmanaged update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChange("enable_jumbo_frame") {
-		if edgeSpoke.EnableJumboFrame {
-			err := client.EnableJumboFrame(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("could not enable jumbo frame during Edge VM Selfmanaged update: %v", err)
-			}
-		} else {
-			err := client.DisableJumboFrame(gatewayForGatewayFunctions)
-			if err != nil {
-				return diag.Errorf("could not disable jumbo frame during Edge VM Selfmanaged update: %v", err)
-			}
-		}
-	}
-
-	if d.HasChanges("latitude", "longitude") {
-		err := client.UpdateEdgeSpokeGeoCoordinate(ctx, edgeSpoke)
-		if err != nil {
-			return diag.Errorf("could not update geo coordinate during Edge VM Selfmanaged update: %v", err)
-		}
-	}
-
-	if d.HasChange("rx_queue_size") {
-		gatewayForGatewayFunctions.RxQueueSize = edgeSpoke.RxQueueSize
-		err := client.SetRxQueueSize(gatewayForGatewayFunctions)
-		if err != nil {
-			return diag.Errorf("could not update rx queue size during Edge VM Selfmanaged update: %v", err)
-		}
-	}
-
-	if d.HasChanges("management_egress_ip_prefix_list", "interfaces", "enable_edge_active_standby",
-		"enable_edge_active_standby_preemptive") {
-		err := client.UpdateEdgeSpoke(ctx, edgeSpoke)
-		if err != nil {
-			return diag.Errorf("could not update management egress ip prefix list, WAN/LAN/MANAGEMENT interfaces, "+
-				"Edge active standby or Edge active standby preemptive during Edge as a Spoke update: %v", err)
-		}
+	err := updateEdgeGateway(ctx, client, d, edgeVmSelfmanagedPlatform(client, edgeSpoke))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.Partial(false)
@@ -746,7 +330,7 @@ func resourceAviatrixEdgeVmSelfmanagedUpdate(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixEdgeVmSelfmanagedDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	gwName := d.Get("gw_name").(string)
 	siteId := d.Get("site_id").(string)
@@ -759,6 +343,10 @@ func resourceAviatrixEdgeVmSelfmanagedDelete(ctx context.Context, d *schema.Reso
 		return diag.Errorf("could not delete Edge VM Selfmanaged: %v", err)
 	}
 
+	if ztpFileDownloadPath == "" {
+		return nil
+	}
+
 	var fileName string
 	if ztpFileType == "iso" {
 		fileName = ztpFileDownloadPath + "/" + gwName + "-" + siteId + ".iso"
@@ -768,7 +356,7 @@ func resourceAviatrixEdgeVmSelfmanagedDelete(ctx context.Context, d *schema.Reso
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, "could not remove the ztp file", map[string]interface{}{"error": err})
 	}
 
 	return nil
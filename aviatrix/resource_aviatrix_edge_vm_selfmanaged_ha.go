@@ -2,10 +2,10 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,14 +16,20 @@ import (
 
 func resourceAviatrixEdgeVmSelfmanagedHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeVmSelfmanagedHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedHaDelete,
+		CreateContext: resourceAviatrixEdgeVmSelfmanagedHaCreate,
+		ReadContext:   resourceAviatrixEdgeVmSelfmanagedHaRead,
+		UpdateContext: resourceAviatrixEdgeVmSelfmanagedHaUpdate,
+		DeleteContext: resourceAviatrixEdgeVmSelfmanagedHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -46,12 +52,18 @@ func resourceAviatrixEdgeVmSelfmanagedHa() *schema.Resource {
 			},
 			"ztp_file_download_path": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old != ""
 				},
-				Description: "The location where the ZTP file will be stored.",
+				Description: "The location where the ZTP file will be stored. If not set, the ZTP file is not written to disk and is only exported in ztp_file_content.",
+			},
+			"ztp_file_content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Content of the ZTP file. Base64 encoded if ztp_file_type is 'iso'.",
 			},
 			"interfaces": {
 				Type:        schema.TypeSet,
@@ -135,7 +147,7 @@ func marshalEdgeVmSelfmanagedHaInput(d *schema.ResourceData) *goaviatrix.EdgeVmS
 }
 
 func resourceAviatrixEdgeVmSelfmanagedHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeVmSelfmanagedHa := marshalEdgeVmSelfmanagedHaInput(d)
 
@@ -143,17 +155,18 @@ func resourceAviatrixEdgeVmSelfmanagedHaCreate(ctx context.Context, d *schema.Re
 	if err != nil {
 		return diag.Errorf("failed to create Edge VM Selfmanaged HA: %s", err)
 	}
+	d.Set("ztp_file_content", edgeVmSelfmanagedHa.ZtpFileContent)
 
 	d.SetId(edgeVmSelfmanagedHaName)
 	return resourceAviatrixEdgeVmSelfmanagedHaRead(ctx, d, meta)
 }
 
 func resourceAviatrixEdgeVmSelfmanagedHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.Get("primary_gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "-hagw")
 		d.Set("primary_gw_name", parts[0])
 		d.SetId(id)
@@ -207,7 +220,7 @@ func resourceAviatrixEdgeVmSelfmanagedHaRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixEdgeVmSelfmanagedHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeVmSelfmanagedHa := marshalEdgeVmSelfmanagedHaInput(d)
 
@@ -232,7 +245,7 @@ func resourceAviatrixEdgeVmSelfmanagedHaUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceAviatrixEdgeVmSelfmanagedHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.DeleteEdgeSpoke(ctx, d.Id())
 	if err != nil {
@@ -241,6 +254,10 @@ func resourceAviatrixEdgeVmSelfmanagedHaDelete(ctx context.Context, d *schema.Re
 
 	edgeVmSelfmanagedHa := marshalEdgeVmSelfmanagedHaInput(d)
 
+	if edgeVmSelfmanagedHa.ZtpFileDownloadPath == "" {
+		return nil
+	}
+
 	var fileName string
 	if edgeVmSelfmanagedHa.ZtpFileType == "iso" {
 		fileName = edgeVmSelfmanagedHa.ZtpFileDownloadPath + "/" + edgeVmSelfmanagedHa.PrimaryGwName + "-" + edgeVmSelfmanagedHa.SiteId + "-ha.iso"
@@ -250,7 +267,7 @@ func resourceAviatrixEdgeVmSelfmanagedHaDelete(ctx context.Context, d *schema.Re
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, "could not remove the ztp file", map[string]interface{}{"error": err})
 	}
 
 	return nil
@@ -42,7 +42,7 @@ func TestAccAviatrixEdgeVmSelfmanagedHa_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ztp_file_download_path"},
+				ImportStateVerifyIgnore: []string{"ztp_file_download_path", "ztp_file_content"},
 			},
 		},
 	})
@@ -47,7 +47,7 @@ func TestAccAviatrixEdgeVmSelfmanaged_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ztp_file_type", "ztp_file_download_path"},
+				ImportStateVerifyIgnore: []string{"ztp_file_type", "ztp_file_download_path", "ztp_file_content"},
 			},
 		},
 	})
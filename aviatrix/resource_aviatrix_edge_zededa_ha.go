@@ -2,9 +2,9 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -13,14 +13,20 @@ import (
 
 func resourceAviatrixEdgeZededaHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeZededaHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeZededaHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeZededaHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeZededaHaDelete,
+		CreateContext: resourceAviatrixEdgeZededaHaCreate,
+		ReadContext:   resourceAviatrixEdgeZededaHaRead,
+		UpdateContext: resourceAviatrixEdgeZededaHaUpdate,
+		DeleteContext: resourceAviatrixEdgeZededaHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -141,7 +147,7 @@ func marshalEdgeZededaHaInput(d *schema.ResourceData) *goaviatrix.EdgeCSPHa {
 }
 
 func resourceAviatrixEdgeZededaHaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeCSPHa := marshalEdgeZededaHaInput(d)
 
@@ -155,11 +161,11 @@ func resourceAviatrixEdgeZededaHaCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgeZededaHaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.Get("primary_gw_name").(string) == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "-hagw")
 		d.Set("primary_gw_name", parts[0])
 		d.SetId(id)
@@ -209,7 +215,7 @@ func resourceAviatrixEdgeZededaHaRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixEdgeZededaHaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	edgeCSPHa := marshalEdgeZededaHaInput(d)
 
@@ -234,7 +240,7 @@ func resourceAviatrixEdgeZededaHaUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgeZededaHaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	accountName := d.Get("account_name").(string)
 
@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 	"strconv"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixFilebeatForwarder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFilebeatForwarderCreate,
-		Read:   resourceAviatrixFilebeatForwarderRead,
-		Delete: resourceAviatrixFilebeatForwarderDelete,
+		CreateContext: resourceAviatrixFilebeatForwarderCreate,
+		ReadContext:   resourceAviatrixFilebeatForwarderRead,
+		DeleteContext: resourceAviatrixFilebeatForwarderDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,31 +68,31 @@ func resourceAviatrixFilebeatForwarder() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFilebeatForwarderCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFilebeatForwarderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	_, err := client.GetFilebeatForwarderStatus()
+	_, err := client.GetFilebeatForwarderStatusContext(ctx)
 	if err != goaviatrix.ErrNotFound {
-		return fmt.Errorf("the filebeat_forwarder is already enabled, please import to manage with Terraform")
+		return diag.Errorf("the filebeat_forwarder is already enabled, please import to manage with Terraform")
 	} else {
-		return fmt.Errorf("the support for filebeat forwarder is deprecated")
+		return diag.Errorf("the support for filebeat forwarder is deprecated")
 	}
 }
 
-func resourceAviatrixFilebeatForwarderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFilebeatForwarderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.Id() != "filebeat_forwarder" {
-		return fmt.Errorf("invalid ID, expected ID \"filebeat_forwarder\", instead got %s", d.Id())
+		return diag.Errorf("invalid ID, expected ID \"filebeat_forwarder\", instead got %s", d.Id())
 	}
 
-	filebeatForwarderStatus, err := client.GetFilebeatForwarderStatus()
+	filebeatForwarderStatus, err := client.GetFilebeatForwarderStatusContext(ctx)
 	if err == goaviatrix.ErrNotFound {
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get filebeat forwarder status: %v", err)
+		return diag.Errorf("could not get filebeat forwarder status: %v", err)
 	}
 
 	d.Set("server", filebeatForwarderStatus.Server)
@@ -106,11 +107,11 @@ func resourceAviatrixFilebeatForwarderRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAviatrixFilebeatForwarderDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFilebeatForwarderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	if err := client.DisableFilebeatForwarder(); err != nil {
-		return fmt.Errorf("could not disable filebeat forwarder: %v", err)
+	if err := client.DisableFilebeatForwarderContext(ctx); err != nil {
+		return diag.Errorf("could not disable filebeat forwarder: %v", err)
 	}
 
 	return nil
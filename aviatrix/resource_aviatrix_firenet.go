@@ -1,10 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -13,12 +15,12 @@ import (
 
 func resourceAviatrixFireNet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFireNetCreate,
-		Read:   resourceAviatrixFireNetRead,
-		Update: resourceAviatrixFireNetUpdate,
-		Delete: resourceAviatrixFireNetDelete,
+		CreateContext: resourceAviatrixFireNetCreate,
+		ReadContext:   resourceAviatrixFireNetRead,
+		UpdateContext: resourceAviatrixFireNetUpdate,
+		DeleteContext: resourceAviatrixFireNetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -78,14 +80,23 @@ func resourceAviatrixFireNet() *schema.Resource {
 					ValidateFunc: validation.IsCIDR,
 				},
 			},
+			"secondary_firenet_gw_names": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Names of the secondary FireNet gateways attached to the primary FireNet gateway of this VPC for centralized transit FireNet.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
 
-func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFireNetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	log.Printf("[INFO] Creating an Aviatrix Firenet on vpc: %s", d.Get("vpc_id"))
+	tflog.Info(ctx, "Creating an Aviatrix Firenet on vpc", map[string]interface{}{"vpc_id": d.Get("vpc_id")})
 
 	fireNet := &goaviatrix.FireNet{
 		VpcID: d.Get("vpc_id").(string),
@@ -94,44 +105,44 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta interface{}) err
 	d.SetId(fireNet.VpcID)
 
 	flag := false
-	defer resourceAviatrixFireNetReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixFireNetReadIfRequired(ctx, d, meta, &flag)
 
 	if d.Get("hashing_algorithm").(string) == "2-Tuple" {
 		fireNet.HashingAlgorithm = d.Get("hashing_algorithm").(string)
-		err := client.EditFireNetHashingAlgorithm(fireNet)
+		err := client.EditFireNetHashingAlgorithmContext(ctx, fireNet)
 		if err != nil {
-			return fmt.Errorf("failed to edit hashing algorithm: %s", err)
+			return diag.Errorf("failed to edit hashing algorithm: %s", err)
 		}
 	}
 
 	if inspectionEnabled := d.Get("inspection_enabled").(bool); !inspectionEnabled {
 		fireNet.Inspection = false
-		err := client.EditFireNetInspection(fireNet)
+		err := client.EditFireNetInspectionContext(ctx, fireNet)
 		if err != nil {
 			if strings.Contains(err.Error(), "[AVXERR-FIRENET-0011] Unsupported for Egress Transit.") {
-				log.Printf("[INFO] Ignoring error from disabling traffic inspection: %v\n", err)
+				tflog.Info(ctx, "Ignoring error from disabling traffic inspection", map[string]interface{}{"error": err})
 			} else {
-				return fmt.Errorf("couldn't disable inspection due to %v", err)
+				return diag.Errorf("couldn't disable inspection due to %v", err)
 			}
 		}
 	}
 
 	if egressEnabled := d.Get("egress_enabled").(bool); egressEnabled {
 		fireNet.FirewallEgress = true
-		err := client.EditFireNetEgress(fireNet)
+		err := client.EditFireNetEgressContext(ctx, fireNet)
 		if err != nil {
 			if strings.Contains(err.Error(), "[AVXERR-FIRENET-0011] Unsupported for Egress Transit.") {
-				log.Printf("[INFO] Ignoring error from enabling egress: %v\n", err)
+				tflog.Info(ctx, "Ignoring error from enabling egress", map[string]interface{}{"error": err})
 			} else {
-				return fmt.Errorf("couldn't enable egress due to %v", err)
+				return diag.Errorf("couldn't enable egress due to %v", err)
 			}
 		}
 	}
 
 	if d.Get("tgw_segmentation_for_egress_enabled").(bool) {
-		err := client.EnableTgwSegmentationForEgress(fireNet)
+		err := client.EnableTgwSegmentationForEgressContext(ctx, fireNet)
 		if err != nil {
-			return fmt.Errorf("could not enable tgw segmentation for egress: %v", err)
+			return diag.Errorf("could not enable tgw segmentation for egress: %v", err)
 		}
 	}
 
@@ -142,14 +153,14 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta interface{}) err
 
 	if len(egressStaticCidrs) != 0 {
 		if !d.Get("egress_enabled").(bool) {
-			return fmt.Errorf("egress must be enabled to edit 'egress_static_cidrs'")
+			return diag.Errorf("egress must be enabled to edit 'egress_static_cidrs'")
 		}
 
 		fireNet.EgressStaticCidrs = strings.Join(egressStaticCidrs, ",")
 
-		err := client.EditFirenetEgressStaticCidr(fireNet)
+		err := client.EditFirenetEgressStaticCidrContext(ctx, fireNet)
 		if err != nil {
-			return fmt.Errorf("could not edit egress static cidrs: %v", err)
+			return diag.Errorf("could not edit egress static cidrs: %v", err)
 		}
 	}
 
@@ -159,30 +170,39 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	if len(excludedCidrs) != 0 {
 		fireNet.ExcludedCidrs = strings.Join(excludedCidrs, ",")
-		err := client.EditFirenetExcludedCidr(fireNet)
+		err := client.EditFirenetExcludedCidrContext(ctx, fireNet)
 		if err != nil {
-			return fmt.Errorf("could not edit east-west inspection excluded cidrs: %v", err)
+			return diag.Errorf("could not edit east-west inspection excluded cidrs: %v", err)
 		}
 	}
 
-	return resourceAviatrixFireNetReadIfRequired(d, meta, &flag)
+	if d.HasChange("secondary_firenet_gw_names") {
+		o, n := d.GetChange("secondary_firenet_gw_names")
+		toDetach := goaviatrix.ExpandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		toAttach := goaviatrix.ExpandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+		if err := updateFireNetSecondaryGateways(ctx, client, d.Get("vpc_id").(string), toDetach, toAttach); err != nil {
+			return diag.Errorf("could not attach secondary firenet gateways: %v", err)
+		}
+	}
+
+	return resourceAviatrixFireNetReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFireNetReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixFireNetReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFireNetRead(d, meta)
+		return resourceAviatrixFireNetRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFireNetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFireNetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpcID := d.Get("vpc_id").(string)
 	if vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc_id received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no vpc_id received", map[string]interface{}{"id": id})
 		d.Set("vpc_id", id)
 		d.SetId(id)
 	}
@@ -190,16 +210,16 @@ func resourceAviatrixFireNetRead(d *schema.ResourceData, meta interface{}) error
 		VpcID: d.Get("vpc_id").(string),
 	}
 
-	fireNetDetail, err := client.GetFireNet(fireNet)
+	fireNetDetail, err := client.GetFireNetContext(ctx, fireNet)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find FireNet: %s", err)
+		return diag.Errorf("couldn't find FireNet: %s", err)
 	}
 
-	log.Printf("[INFO] Found FireNet: %#v", fireNetDetail.VpcID)
+	tflog.Info(ctx, "Found FireNet", map[string]interface{}{"vpc_id": fireNetDetail.VpcID})
 
 	d.Set("vpc_id", fireNetDetail.VpcID)
 	d.Set("hashing_algorithm", fireNetDetail.HashingAlgorithm)
@@ -209,18 +229,30 @@ func resourceAviatrixFireNetRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("inspection_enabled", fireNetDetail.Inspection == "yes")
 	d.Set("egress_enabled", fireNetDetail.FirewallEgress == "yes")
 
+	centralizedFireNets, err := client.ListCentralizedTransitFireNets(ctx)
+	if err != nil {
+		return diag.Errorf("could not get centralized transit firenet: %v", err)
+	}
+	var secondaryGwNames []string
+	if primaryGwName := centralizedFireNetPrimaryGwName(fireNetDetail, centralizedFireNets); primaryGwName != "" {
+		secondaryGwNames = centralizedFireNets[primaryGwName]
+	}
+	if err := d.Set("secondary_firenet_gw_names", secondaryGwNames); err != nil {
+		return diag.Errorf("failed to set secondary_firenet_gw_names: %v", err)
+	}
+
 	d.SetId(fireNetDetail.VpcID)
 	return nil
 }
 
-func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFireNetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	log.Printf("[INFO] Updating Aviatrix FireNet: %#v", d.Get("vpc_id").(string))
+	tflog.Info(ctx, "Updating Aviatrix FireNet", map[string]interface{}{"vpc_id": d.Get("vpc_id").(string)})
 
 	d.Partial(true)
 	if d.HasChange("vpc_id") {
-		return fmt.Errorf("updating vpc_id is not allowed")
+		return diag.Errorf("updating vpc_id is not allowed")
 	}
 
 	if d.HasChange("hashing_algorithm") {
@@ -228,9 +260,9 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 			VpcID:            d.Get("vpc_id").(string),
 			HashingAlgorithm: d.Get("hashing_algorithm").(string),
 		}
-		err := client.EditFireNetHashingAlgorithm(fn)
+		err := client.EditFireNetHashingAlgorithmContext(ctx, fn)
 		if err != nil {
-			return fmt.Errorf("failed to enable inspection on fireNet: %v", err)
+			return diag.Errorf("failed to enable inspection on fireNet: %v", err)
 		}
 	}
 
@@ -241,15 +273,15 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 
 		if inspectionEnabled := d.Get("inspection_enabled").(bool); inspectionEnabled {
 			fn.Inspection = true
-			err := client.EditFireNetInspection(fn)
+			err := client.EditFireNetInspectionContext(ctx, fn)
 			if err != nil {
-				return fmt.Errorf("failed to enable inspection on fireNet: %v", err)
+				return diag.Errorf("failed to enable inspection on fireNet: %v", err)
 			}
 		} else {
 			fn.Inspection = false
-			err := client.EditFireNetInspection(fn)
+			err := client.EditFireNetInspectionContext(ctx, fn)
 			if err != nil {
-				return fmt.Errorf("failed to disable inspection on fireNet: %v", err)
+				return diag.Errorf("failed to disable inspection on fireNet: %v", err)
 			}
 		}
 
@@ -267,23 +299,23 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 
 		if egressEnabled := d.Get("egress_enabled").(bool); egressEnabled {
 			fn.FirewallEgress = true
-			err := client.EditFireNetEgress(fn)
+			err := client.EditFireNetEgressContext(ctx, fn)
 			if err != nil {
-				return fmt.Errorf("failed to enable firewall egress on fireNet: %v", err)
+				return diag.Errorf("failed to enable firewall egress on fireNet: %v", err)
 			}
 		} else {
 			if len(egressStaticCidrs) > 0 {
-				return fmt.Errorf("'egress_static_cidrs' must be empty before disabling egress")
+				return diag.Errorf("'egress_static_cidrs' must be empty before disabling egress")
 			} else if d.HasChange("egress_static_cidrs") && len(egressStaticCidrs) == 0 {
-				err := client.EditFirenetEgressStaticCidr(fn)
+				err := client.EditFirenetEgressStaticCidrContext(ctx, fn)
 				if err != nil {
-					return fmt.Errorf("could not disable egress static cidrs: %v", err)
+					return diag.Errorf("could not disable egress static cidrs: %v", err)
 				}
 			}
 			fn.FirewallEgress = false
-			err := client.EditFireNetEgress(fn)
+			err := client.EditFireNetEgressContext(ctx, fn)
 			if err != nil {
-				return fmt.Errorf("failed to enable firewall egress on fireNet: %v", err)
+				return diag.Errorf("failed to enable firewall egress on fireNet: %v", err)
 			}
 		}
 	}
@@ -292,7 +324,7 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 		egressEnabled := d.Get("egress_enabled").(bool)
 
 		if !d.HasChange("egress_enabled") && !egressEnabled {
-			return fmt.Errorf("egress must be enabled to edit 'egress_static_cidrs'")
+			return diag.Errorf("egress must be enabled to edit 'egress_static_cidrs'")
 		}
 
 		if egressEnabled {
@@ -301,9 +333,9 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 				EgressStaticCidrs: strings.Join(egressStaticCidrs, ","),
 			}
 
-			err := client.EditFirenetEgressStaticCidr(fn)
+			err := client.EditFirenetEgressStaticCidrContext(ctx, fn)
 			if err != nil {
-				return fmt.Errorf("could not update egress static cidrs: %v", err)
+				return diag.Errorf("could not update egress static cidrs: %v", err)
 			}
 		}
 	}
@@ -317,9 +349,9 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 			VpcID:         d.Get("vpc_id").(string),
 			ExcludedCidrs: strings.Join(excludedCidrs, ","),
 		}
-		err := client.EditFirenetExcludedCidr(fn)
+		err := client.EditFirenetExcludedCidrContext(ctx, fn)
 		if err != nil {
-			return fmt.Errorf("could not edit east-west inspection excluded cidrs during update: %v", err)
+			return diag.Errorf("could not edit east-west inspection excluded cidrs during update: %v", err)
 		}
 	}
 
@@ -328,67 +360,160 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta interface{}) err
 			VpcID: d.Get("vpc_id").(string),
 		}
 		if d.Get("tgw_segmentation_for_egress_enabled").(bool) {
-			err := client.EnableTgwSegmentationForEgress(fn)
+			err := client.EnableTgwSegmentationForEgressContext(ctx, fn)
 			if err != nil {
-				return fmt.Errorf("could not enable tgw_segmentation_for_egress: %v", err)
+				return diag.Errorf("could not enable tgw_segmentation_for_egress: %v", err)
 			}
 		} else {
-			err := client.DisableTgwSegmentationForEgress(fn)
+			err := client.DisableTgwSegmentationForEgressContext(ctx, fn)
 			if err != nil {
-				return fmt.Errorf("could not disable tgw_segmentation_for_egress: %v", err)
+				return diag.Errorf("could not disable tgw_segmentation_for_egress: %v", err)
 			}
 		}
 	}
 
+	if d.HasChange("secondary_firenet_gw_names") {
+		o, n := d.GetChange("secondary_firenet_gw_names")
+		toDetach := goaviatrix.ExpandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		toAttach := goaviatrix.ExpandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+		if err := updateFireNetSecondaryGateways(ctx, client, d.Get("vpc_id").(string), toDetach, toAttach); err != nil {
+			return diag.Errorf("could not update secondary firenet gateways: %v", err)
+		}
+	}
+
 	d.Partial(false)
-	return resourceAviatrixFireNetRead(d, meta)
+	return resourceAviatrixFireNetRead(ctx, d, meta)
 }
 
-func resourceAviatrixFireNetDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFireNetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	fireNet := &goaviatrix.FireNet{
 		VpcID: d.Get("vpc_id").(string),
 	}
 
 	if len(d.Get("egress_static_cidrs").(*schema.Set).List()) != 0 {
-		err := client.EditFirenetEgressStaticCidr(fireNet)
+		err := client.EditFirenetEgressStaticCidrContext(ctx, fireNet)
 		if err != nil {
-			return fmt.Errorf("could not disable egress static cidrs: %v", err)
+			return diag.Errorf("could not disable egress static cidrs: %v", err)
 		}
 	}
 
 	if len(d.Get("east_west_inspection_excluded_cidrs").(*schema.Set).List()) != 0 {
-		err := client.EditFirenetExcludedCidr(fireNet)
+		err := client.EditFirenetExcludedCidrContext(ctx, fireNet)
 		if err != nil {
-			return fmt.Errorf("could not disable east-west inspection excluded cidrs during firenet destroy: %v", err)
+			return diag.Errorf("could not disable east-west inspection excluded cidrs during firenet destroy: %v", err)
 		}
 	}
 
 	if egressEnabled := d.Get("egress_enabled").(bool); egressEnabled {
 		fireNet.FirewallEgress = false
-		err := client.EditFireNetEgress(fireNet)
+		err := client.EditFireNetEgressContext(ctx, fireNet)
 		if err != nil {
 			if strings.Contains(err.Error(), "[AVXERR-FIRENET-0011] Unsupported for Egress Transit.") {
-				log.Printf("[INFO] Ignoring error from disabling egress: %v\n", err)
+				tflog.Info(ctx, "Ignoring error from disabling egress", map[string]interface{}{"error": err})
 			} else {
-				return fmt.Errorf("failed to disable firewall egress on fireNet: %v", err)
+				return diag.Errorf("failed to disable firewall egress on fireNet: %v", err)
 			}
 		}
 	}
 
 	if d.Get("tgw_segmentation_for_egress_enabled").(bool) {
-		err := client.DisableTgwSegmentationForEgress(fireNet)
+		err := client.DisableTgwSegmentationForEgressContext(ctx, fireNet)
 		if err != nil {
-			return fmt.Errorf("failed to disable tgw segmentation for egress: %v", err)
+			return diag.Errorf("failed to disable tgw segmentation for egress: %v", err)
 		}
 	}
 
-	log.Printf("[INFO] Deleting FireNet: %#v", fireNet)
+	if secondaryGwNames := getStringSet(d, "secondary_firenet_gw_names"); len(secondaryGwNames) != 0 {
+		if err := updateFireNetSecondaryGateways(ctx, client, fireNet.VpcID, secondaryGwNames, nil); err != nil {
+			return diag.Errorf("failed to detach secondary firenet gateways: %v", err)
+		}
+	}
+
+	tflog.Info(ctx, "Deleting FireNet", map[string]interface{}{"gw_name": fireNet.GwName})
+
+	_, err := client.GetFireNetContext(ctx, fireNet)
+	if err != nil {
+		return diag.Errorf("failed to delete FireNet: %s", err)
+	}
+
+	return nil
+}
+
+// centralizedFireNetPrimaryGwName returns the gateway of the FireNet that has
+// secondary FireNet gateways attached, or "" if there is none.
+func centralizedFireNetPrimaryGwName(fireNetDetail *goaviatrix.FireNetDetail, centralizedFireNets map[string][]string) string {
+	for _, gw := range fireNetDetail.Gateway {
+		if _, ok := centralizedFireNets[gw.GwName]; ok {
+			return gw.GwName
+		}
+	}
+	return ""
+}
 
-	_, err := client.GetFireNet(fireNet)
+// updateFireNetSecondaryGateways detaches toDetach from and attaches toAttach
+// to the primary FireNet gateway of the FireNet VPC.
+func updateFireNetSecondaryGateways(ctx context.Context, client goaviatrix.ClientInterface, vpcID string, toDetach, toAttach []string) error {
+	fireNetDetail, err := client.GetFireNetContext(ctx, &goaviatrix.FireNet{VpcID: vpcID})
 	if err != nil {
-		return fmt.Errorf("failed to delete FireNet: %s", err)
+		return fmt.Errorf("could not get FireNet: %w", err)
+	}
+	centralizedFireNets, err := client.ListCentralizedTransitFireNets(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get centralized transit firenet: %w", err)
+	}
+	primaryGwName := centralizedFireNetPrimaryGwName(fireNetDetail, centralizedFireNets)
+	if primaryGwName == "" {
+		primaryFirenetList, err := client.GetPrimaryFireNet(ctx)
+		if err != nil {
+			return fmt.Errorf("could not get the list of primary firenet: %w", err)
+		}
+		for _, gw := range fireNetDetail.Gateway {
+			if goaviatrix.Contains(primaryFirenetList, gw.GwName) {
+				primaryGwName = gw.GwName
+				break
+			}
+		}
+	}
+
+	if primaryGwName == "" {
+		if len(toAttach) == 0 {
+			return nil
+		}
+		return fmt.Errorf("no gateway of FireNet VPC %s meets all the conditions for primary firenet", vpcID)
+	}
+
+	for _, v := range toDetach {
+		err := client.DeleteCentralizedTransitFireNet(ctx, &goaviatrix.CentralizedTransitFirenet{
+			PrimaryGwName:   primaryGwName,
+			SecondaryGwName: v,
+		})
+		if err != nil && !strings.Contains(err.Error(), "not attached") {
+			return fmt.Errorf("could not detach secondary firenet gateway %s from %s: %w", v, primaryGwName, err)
+		}
+	}
+
+	if len(toAttach) != 0 {
+		secondaryFirenetList, err := client.GetSecondaryFireNet(ctx)
+		if err != nil {
+			return fmt.Errorf("could not get the list of secondary firenet: %w", err)
+		}
+		for _, v := range toAttach {
+			if !goaviatrix.Contains(secondaryFirenetList, v) {
+				return fmt.Errorf("gateway %s doesn't meet all the conditions for secondary firenet", v)
+			}
+		}
+	}
+	for _, v := range toAttach {
+		tflog.Info(ctx, "Attaching secondary FireNet gateway", map[string]interface{}{"primary_gw_name": primaryGwName, "secondary_gw_name": v})
+		err := client.CreateCentralizedTransitFireNet(ctx, &goaviatrix.CentralizedTransitFirenet{
+			PrimaryGwName:   primaryGwName,
+			SecondaryGwName: v,
+		})
+		if err != nil {
+			return fmt.Errorf("could not attach secondary firenet gateway %s to %s: %w", v, primaryGwName, err)
+		}
 	}
 
 	return nil
@@ -0,0 +1,194 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAviatrixFireNetTgwConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixFireNetTgwConnectionCreate,
+		ReadWithoutTimeout:   resourceAviatrixFireNetTgwConnectionRead,
+		UpdateWithoutTimeout: resourceAviatrixFireNetTgwConnectionUpdate,
+		DeleteWithoutTimeout: resourceAviatrixFireNetTgwConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tgw_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the AWS TGW.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "VPC ID of the FireNet.",
+			},
+			"network_domain_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the Aviatrix firewall network domain of the AWS TGW to connect the FireNet to.",
+			},
+			"edge_attachment": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Edge attachment ID. Set it to enable Management Access From Onprem, which advertises " +
+					"the private MGMT subnet of the firewalls to the Edge domain.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Region of the FireNet VPC.",
+			},
+			"vpc_account_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Access account of the FireNet VPC.",
+			},
+			"gw_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the FireNet gateway.",
+			},
+		},
+	}
+}
+
+func resourceAviatrixFireNetTgwConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	tgwName := d.Get("tgw_name").(string)
+	vpcID := d.Get("vpc_id").(string)
+	domainName := d.Get("network_domain_name").(string)
+
+	isFirewallDomain, err := client.IsFirewallSecurityDomainContext(ctx, tgwName, domainName)
+	if err != nil {
+		if errors.Is(err, goaviatrix.ErrNotFound) {
+			return diag.Errorf("could not find network domain %s of AWS TGW %s", domainName, tgwName)
+		}
+		return diag.Errorf("could not get network domain %s of AWS TGW %s: %v", domainName, tgwName, err)
+	}
+	if !isFirewallDomain {
+		return diag.Errorf("network domain %s of AWS TGW %s is not an Aviatrix firewall domain", domainName, tgwName)
+	}
+
+	tflog.Info(ctx, "Connecting FireNet to AWS TGW", map[string]interface{}{"vpc_id": vpcID, "tgw_name": tgwName})
+
+	err = client.ConnectFireNetWithTgwContext(ctx, &goaviatrix.AWSTgw{Name: tgwName}, goaviatrix.VPCSolo{VpcID: vpcID}, domainName)
+	if err != nil {
+		return diag.Errorf("could not connect FireNet %s to AWS TGW %s: %v", vpcID, tgwName, err)
+	}
+
+	d.SetId(tgwName + "~" + vpcID)
+
+	if edgeAttachment := d.Get("edge_attachment").(string); edgeAttachment != "" {
+		err = client.UpdateFirewallAttachmentAccessFromOnpremContext(ctx, &goaviatrix.AwsTgwVpcAttachment{
+			TgwName:        tgwName,
+			VpcID:          vpcID,
+			EdgeAttachment: edgeAttachment,
+		})
+		if err != nil {
+			return diag.Errorf("could not enable management access from onprem for FireNet %s: %v", vpcID, err)
+		}
+	}
+
+	return resourceAviatrixFireNetTgwConnectionRead(ctx, d, meta)
+}
+
+func resourceAviatrixFireNetTgwConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	if d.Get("tgw_name").(string) == "" || d.Get("vpc_id").(string) == "" {
+		id := d.Id()
+		tflog.Debug(ctx, "Looks like an import, no 'tgw_name' or 'vpc_id' received", map[string]interface{}{"id": id})
+		parts := strings.Split(id, "~")
+		if len(parts) != 2 {
+			return diag.Errorf("invalid ID %q, expected ID in the form 'tgw_name~vpc_id'", id)
+		}
+		d.Set("tgw_name", parts[0])
+		d.Set("vpc_id", parts[1])
+		d.SetId(id)
+	}
+
+	tgwName := d.Get("tgw_name").(string)
+	vpcID := d.Get("vpc_id").(string)
+
+	attachment, err := client.GetAwsTgwAttachmentInfoContext(ctx, &goaviatrix.AwsTgwVpcAttachment{
+		TgwName: tgwName,
+		VpcID:   vpcID,
+	})
+	if errors.Is(err, goaviatrix.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("could not get the connection of FireNet %s to AWS TGW %s: %v", vpcID, tgwName, err)
+	}
+
+	d.Set("network_domain_name", attachment.SecurityDomainName)
+	d.Set("region", attachment.Region)
+	d.Set("vpc_account_name", attachment.AccountName)
+	d.Set("gw_name", attachment.GwName)
+	if len(attachment.AccessFromEdge) > 1 {
+		d.Set("edge_attachment", attachment.AccessFromEdge[1])
+	} else {
+		d.Set("edge_attachment", "")
+	}
+
+	d.SetId(tgwName + "~" + vpcID)
+	return nil
+}
+
+func resourceAviatrixFireNetTgwConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	if d.HasChange("edge_attachment") {
+		attachment := &goaviatrix.AwsTgwVpcAttachment{
+			TgwName: d.Get("tgw_name").(string),
+			VpcID:   d.Get("vpc_id").(string),
+		}
+
+		// The controller only switches the edge attachment off and on, so
+		// moving it to another attachment takes two calls.
+		oldEA, newEA := d.GetChange("edge_attachment")
+		if oldEA.(string) != "" && newEA.(string) != "" {
+			if err := client.UpdateFirewallAttachmentAccessFromOnpremContext(ctx, attachment); err != nil {
+				return diag.Errorf("could not disable management access from onprem for FireNet %s: %v", attachment.VpcID, err)
+			}
+		}
+
+		attachment.EdgeAttachment = newEA.(string)
+		if err := client.UpdateFirewallAttachmentAccessFromOnpremContext(ctx, attachment); err != nil {
+			return diag.Errorf("could not update management access from onprem for FireNet %s: %v", attachment.VpcID, err)
+		}
+	}
+
+	return resourceAviatrixFireNetTgwConnectionRead(ctx, d, meta)
+}
+
+func resourceAviatrixFireNetTgwConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	tgwName := d.Get("tgw_name").(string)
+	vpcID := d.Get("vpc_id").(string)
+
+	tflog.Info(ctx, "Disconnecting FireNet from AWS TGW", map[string]interface{}{"vpc_id": vpcID, "tgw_name": tgwName})
+
+	err := client.DisconnectFireNetFromTgwContext(ctx, &goaviatrix.AWSTgw{Name: tgwName}, vpcID)
+	if err != nil {
+		return diag.Errorf("could not disconnect FireNet %s from AWS TGW %s: %v", vpcID, tgwName, err)
+	}
+
+	return nil
+}
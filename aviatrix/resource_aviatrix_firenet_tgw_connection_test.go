@@ -0,0 +1,108 @@
+package aviatrix
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixFireNetTgwConnection_basic(t *testing.T) {
+	if os.Getenv("SKIP_FIRENET_TGW_CONNECTION") == "yes" {
+		t.Skip("Skipping FireNet TGW connection test as SKIP_FIRENET_TGW_CONNECTION is set")
+	}
+
+	resourceName := "aviatrix_firenet_tgw_connection.test_firenet_tgw_connection"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAviatrixFireNetTgwConnectionPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFireNetTgwConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFireNetTgwConnectionBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFireNetTgwConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_domain_name", os.Getenv("AWS_TGW_FIREWALL_DOMAIN_NAME")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFireNetTgwConnectionBasic() string {
+	return fmt.Sprintf(`
+resource "aviatrix_firenet_tgw_connection" "test_firenet_tgw_connection" {
+	tgw_name            = "%s"
+	vpc_id              = "%s"
+	network_domain_name = "%s"
+}
+`, os.Getenv("AWS_TGW_NAME"), os.Getenv("FIRENET_VPC_ID"), os.Getenv("AWS_TGW_FIREWALL_DOMAIN_NAME"))
+}
+
+func testAccCheckFireNetTgwConnectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("firenet_tgw_connection Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no firenet_tgw_connection ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		_, err := client.GetAwsTgwAttachmentInfo(&goaviatrix.AwsTgwVpcAttachment{
+			TgwName: rs.Primary.Attributes["tgw_name"],
+			VpcID:   rs.Primary.Attributes["vpc_id"],
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFireNetTgwConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_firenet_tgw_connection" {
+			continue
+		}
+		_, err := client.GetAwsTgwAttachmentInfo(&goaviatrix.AwsTgwVpcAttachment{
+			TgwName: rs.Primary.Attributes["tgw_name"],
+			VpcID:   rs.Primary.Attributes["vpc_id"],
+		})
+		if err != goaviatrix.ErrNotFound {
+			return fmt.Errorf("firenet_tgw_connection still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccAviatrixFireNetTgwConnectionPreCheck(t *testing.T) {
+	required := []string{
+		"AWS_TGW_NAME",
+		"FIRENET_VPC_ID",
+		"AWS_TGW_FIREWALL_DOMAIN_NAME",
+	}
+	for _, v := range required {
+		if os.Getenv(v) == "" {
+			t.Fatalf("%s must be set for aviatrix_firenet_tgw_connection acceptance test.", v)
+		}
+	}
+}
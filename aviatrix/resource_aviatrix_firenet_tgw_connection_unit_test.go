@@ -0,0 +1,206 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceAviatrixFireNetTgwConnectionCreate(t *testing.T) {
+	var connected []string
+	client := &goaviatrix.ClientInterfaceMock{
+		IsFirewallSecurityDomainContextFunc: func(ctx context.Context, tgwName string, domainName string) (bool, error) {
+			return domainName == "firewall-domain", nil
+		},
+		ConnectFireNetWithTgwContextFunc: func(ctx context.Context, awsTgw *goaviatrix.AWSTgw, vpcSolo goaviatrix.VPCSolo, securityDomainName string) error {
+			connected = append(connected, awsTgw.Name+"/"+vpcSolo.VpcID+"/"+securityDomainName)
+			return nil
+		},
+		GetAwsTgwAttachmentInfoContextFunc: func(ctx context.Context, attachment *goaviatrix.AwsTgwVpcAttachment) (*goaviatrix.AttachmentInfo, error) {
+			return &goaviatrix.AttachmentInfo{VpcID: attachment.VpcID, SecurityDomainName: "firewall-domain", Region: "us-east-1", AccountName: "prod", GwName: "firenet-gw"}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixFireNetTgwConnection().Schema, map[string]interface{}{
+		"tgw_name":            "tgw-1",
+		"vpc_id":              "vpc-firenet",
+		"network_domain_name": "firewall-domain",
+	})
+	diags := resourceAviatrixFireNetTgwConnectionCreate(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, []string{"tgw-1/vpc-firenet/firewall-domain"}, connected)
+	assert.Equal(t, "tgw-1~vpc-firenet", d.Id())
+	assert.Equal(t, "firenet-gw", d.Get("gw_name"))
+	assert.Equal(t, "us-east-1", d.Get("region"))
+}
+
+func TestResourceAviatrixFireNetTgwConnectionCreate_WhenNotFirewallDomain(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		IsFirewallSecurityDomainContextFunc: func(ctx context.Context, tgwName string, domainName string) (bool, error) {
+			return false, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixFireNetTgwConnection().Schema, map[string]interface{}{
+		"tgw_name":            "tgw-1",
+		"vpc_id":              "vpc-firenet",
+		"network_domain_name": "Default_Domain",
+	})
+	diags := resourceAviatrixFireNetTgwConnectionCreate(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("network domain Default_Domain of AWS TGW tgw-1 is not an Aviatrix firewall domain"), diags)
+	assert.Empty(t, client.ConnectFireNetWithTgwContextCalls())
+}
+
+func TestResourceAviatrixFireNetTgwConnectionCreate_WithEdgeAttachment(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		IsFirewallSecurityDomainContextFunc: func(ctx context.Context, tgwName string, domainName string) (bool, error) {
+			return true, nil
+		},
+		ConnectFireNetWithTgwContextFunc: func(ctx context.Context, awsTgw *goaviatrix.AWSTgw, vpcSolo goaviatrix.VPCSolo, securityDomainName string) error {
+			return nil
+		},
+		UpdateFirewallAttachmentAccessFromOnpremContextFunc: func(ctx context.Context, attachment *goaviatrix.AwsTgwVpcAttachment) error {
+			return nil
+		},
+		GetAwsTgwAttachmentInfoContextFunc: func(ctx context.Context, attachment *goaviatrix.AwsTgwVpcAttachment) (*goaviatrix.AttachmentInfo, error) {
+			return &goaviatrix.AttachmentInfo{VpcID: attachment.VpcID, SecurityDomainName: "firewall-domain", AccessFromEdge: []string{"yes", "vpn-1"}}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixFireNetTgwConnection().Schema, map[string]interface{}{
+		"tgw_name":            "tgw-1",
+		"vpc_id":              "vpc-firenet",
+		"network_domain_name": "firewall-domain",
+		"edge_attachment":     "vpn-1",
+	})
+	diags := resourceAviatrixFireNetTgwConnectionCreate(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	calls := client.UpdateFirewallAttachmentAccessFromOnpremContextCalls()
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, "tgw-1", calls[0].AwsTgwVpcAttachment.TgwName)
+		assert.Equal(t, "vpc-firenet", calls[0].AwsTgwVpcAttachment.VpcID)
+		assert.Equal(t, "vpn-1", calls[0].AwsTgwVpcAttachment.EdgeAttachment)
+	}
+	assert.Equal(t, "vpn-1", d.Get("edge_attachment"))
+}
+
+func TestResourceAviatrixFireNetTgwConnectionUpdate_EdgeAttachment(t *testing.T) {
+	var edgeAttachments []string
+	client := &goaviatrix.ClientInterfaceMock{
+		UpdateFirewallAttachmentAccessFromOnpremContextFunc: func(ctx context.Context, attachment *goaviatrix.AwsTgwVpcAttachment) error {
+			edgeAttachments = append(edgeAttachments, attachment.EdgeAttachment)
+			return nil
+		},
+		GetAwsTgwAttachmentInfoContextFunc: func(ctx context.Context, attachment *goaviatrix.AwsTgwVpcAttachment) (*goaviatrix.AttachmentInfo, error) {
+			return &goaviatrix.AttachmentInfo{VpcID: attachment.VpcID, SecurityDomainName: "firewall-domain", AccessFromEdge: []string{"yes", "vpn-2"}}, nil
+		},
+	}
+
+	r := resourceAviatrixFireNetTgwConnection()
+	state := &terraform.InstanceState{
+		ID: "tgw-1~vpc-firenet",
+		Attributes: map[string]string{
+			"id":                  "tgw-1~vpc-firenet",
+			"tgw_name":            "tgw-1",
+			"vpc_id":              "vpc-firenet",
+			"network_domain_name": "firewall-domain",
+			"edge_attachment":     "vpn-1",
+		},
+	}
+	config := map[string]interface{}{
+		"tgw_name":            "tgw-1",
+		"vpc_id":              "vpc-firenet",
+		"network_domain_name": "firewall-domain",
+		"edge_attachment":     "vpn-2",
+	}
+	diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("could not diff resource: %v", err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("could not build resource data: %v", err)
+	}
+
+	diags := resourceAviatrixFireNetTgwConnectionUpdate(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, []string{"", "vpn-2"}, edgeAttachments)
+	assert.Equal(t, "vpn-2", d.Get("edge_attachment"))
+}
+
+func TestResourceAviatrixFireNetTgwConnectionRead(t *testing.T) {
+	t.Run("import", func(t *testing.T) {
+		client := &goaviatrix.ClientInterfaceMock{
+			GetAwsTgwAttachmentInfoContextFunc: func(ctx context.Context, attachment *goaviatrix.AwsTgwVpcAttachment) (*goaviatrix.AttachmentInfo, error) {
+				assert.Equal(t, "tgw-1", attachment.TgwName)
+				assert.Equal(t, "vpc-firenet", attachment.VpcID)
+				return &goaviatrix.AttachmentInfo{VpcID: attachment.VpcID, SecurityDomainName: "firewall-domain"}, nil
+			},
+		}
+
+		d := resourceAviatrixFireNetTgwConnection().Data(nil)
+		d.SetId("tgw-1~vpc-firenet")
+		diags := resourceAviatrixFireNetTgwConnectionRead(context.Background(), d, client)
+
+		assert.Empty(t, diags)
+		assert.Equal(t, "tgw-1", d.Get("tgw_name"))
+		assert.Equal(t, "vpc-firenet", d.Get("vpc_id"))
+		assert.Equal(t, "firewall-domain", d.Get("network_domain_name"))
+	})
+
+	t.Run("invalid import ID", func(t *testing.T) {
+		d := resourceAviatrixFireNetTgwConnection().Data(nil)
+		d.SetId("vpc-firenet")
+		diags := resourceAviatrixFireNetTgwConnectionRead(context.Background(), d, &goaviatrix.ClientInterfaceMock{})
+
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("disconnected", func(t *testing.T) {
+		client := &goaviatrix.ClientInterfaceMock{
+			GetAwsTgwAttachmentInfoContextFunc: func(ctx context.Context, attachment *goaviatrix.AwsTgwVpcAttachment) (*goaviatrix.AttachmentInfo, error) {
+				return nil, goaviatrix.ErrNotFound
+			},
+		}
+
+		d := schema.TestResourceDataRaw(t, resourceAviatrixFireNetTgwConnection().Schema, map[string]interface{}{
+			"tgw_name":            "tgw-1",
+			"vpc_id":              "vpc-firenet",
+			"network_domain_name": "firewall-domain",
+		})
+		d.SetId("tgw-1~vpc-firenet")
+		diags := resourceAviatrixFireNetTgwConnectionRead(context.Background(), d, client)
+
+		assert.Empty(t, diags)
+		assert.Empty(t, d.Id())
+	})
+}
+
+func TestResourceAviatrixCentralizedTransitFireNetCreate_ChecksSecondaryFireNet(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetPrimaryFireNetFunc: func(ctx context.Context) ([]string, error) {
+			return []string{"primary-gw"}, nil
+		},
+		GetSecondaryFireNetFunc: func(ctx context.Context) ([]string, error) {
+			return []string{"other-gw"}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixCentralizedTransitFireNet().Schema, map[string]interface{}{
+		"primary_firenet_gw_name":   "primary-gw",
+		"secondary_firenet_gw_name": "secondary-gw",
+	})
+	diags := resourceAviatrixCentralizedTransitFireNetCreate(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("gateway secondary-gw doesn't meet all the conditions for secondary firenet"), diags)
+	assert.Empty(t, client.CreateCentralizedTransitFireNetCalls())
+}
@@ -0,0 +1,164 @@
+package aviatrix
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// newCentralizedFireNetClientMock returns a client keeping the secondary
+// FireNet gateways of each primary FireNet gateway in centralized.
+func newCentralizedFireNetClientMock(centralized map[string][]string) *goaviatrix.ClientInterfaceMock {
+	return &goaviatrix.ClientInterfaceMock{
+		GetFireNetContextFunc: func(ctx context.Context, fireNet *goaviatrix.FireNet) (*goaviatrix.FireNetDetail, error) {
+			return &goaviatrix.FireNetDetail{
+				VpcID:            fireNet.VpcID,
+				HashingAlgorithm: "5-Tuple",
+				Inspection:       "yes",
+				Gateway: []goaviatrix.GatewayInfo{
+					{GwName: "transit-hagw"},
+					{GwName: "transit"},
+				},
+			}, nil
+		},
+		ListCentralizedTransitFireNetsFunc: func(ctx context.Context) (map[string][]string, error) {
+			return centralized, nil
+		},
+		GetPrimaryFireNetFunc: func(ctx context.Context) ([]string, error) {
+			return []string{"transit"}, nil
+		},
+		GetSecondaryFireNetFunc: func(ctx context.Context) ([]string, error) {
+			return []string{"secondary-1", "secondary-2", "secondary-3"}, nil
+		},
+		CreateCentralizedTransitFireNetFunc: func(ctx context.Context, firenetAttachment *goaviatrix.CentralizedTransitFirenet) error {
+			centralized[firenetAttachment.PrimaryGwName] = append(centralized[firenetAttachment.PrimaryGwName], firenetAttachment.SecondaryGwName)
+			return nil
+		},
+		DeleteCentralizedTransitFireNetFunc: func(ctx context.Context, firenetAttachment *goaviatrix.CentralizedTransitFirenet) error {
+			var secondaries []string
+			for _, gwName := range centralized[firenetAttachment.PrimaryGwName] {
+				if gwName != firenetAttachment.SecondaryGwName {
+					secondaries = append(secondaries, gwName)
+				}
+			}
+			if len(secondaries) == 0 {
+				delete(centralized, firenetAttachment.PrimaryGwName)
+			} else {
+				centralized[firenetAttachment.PrimaryGwName] = secondaries
+			}
+			return nil
+		},
+	}
+}
+
+func TestResourceAviatrixFireNetCreate_SecondaryFireNetGwNames(t *testing.T) {
+	centralized := map[string][]string{}
+	client := newCentralizedFireNetClientMock(centralized)
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixFireNet().Schema, map[string]interface{}{
+		"vpc_id":                     "vpc-firenet",
+		"secondary_firenet_gw_names": []interface{}{"secondary-1", "secondary-2"},
+	})
+	diags := resourceAviatrixFireNetCreate(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Len(t, client.CreateCentralizedTransitFireNetCalls(), 2)
+	sort.Strings(centralized["transit"])
+	assert.Equal(t, []string{"secondary-1", "secondary-2"}, centralized["transit"])
+	assert.ElementsMatch(t, []interface{}{"secondary-1", "secondary-2"}, d.Get("secondary_firenet_gw_names").(*schema.Set).List())
+}
+
+func TestResourceAviatrixFireNetCreate_SecondaryFireNetGwNamesNotEligible(t *testing.T) {
+	client := newCentralizedFireNetClientMock(map[string][]string{})
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixFireNet().Schema, map[string]interface{}{
+		"vpc_id":                     "vpc-firenet",
+		"secondary_firenet_gw_names": []interface{}{"spoke-1"},
+	})
+	diags := resourceAviatrixFireNetCreate(context.Background(), d, client)
+
+	if assert.Len(t, diags, 1) {
+		assert.Contains(t, diags[0].Summary, "gateway spoke-1 doesn't meet all the conditions for secondary firenet")
+	}
+	assert.Empty(t, client.CreateCentralizedTransitFireNetCalls())
+}
+
+func TestResourceAviatrixFireNetRead_SecondaryFireNetGwNames(t *testing.T) {
+	client := newCentralizedFireNetClientMock(map[string][]string{
+		"transit":       {"secondary-1"},
+		"other-transit": {"secondary-2"},
+	})
+
+	d := resourceAviatrixFireNet().Data(nil)
+	d.SetId("vpc-firenet")
+	diags := resourceAviatrixFireNetRead(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "vpc-firenet", d.Get("vpc_id"))
+	assert.Equal(t, []interface{}{"secondary-1"}, d.Get("secondary_firenet_gw_names").(*schema.Set).List())
+}
+
+func TestResourceAviatrixFireNetUpdate_SecondaryFireNetGwNames(t *testing.T) {
+	centralized := map[string][]string{"transit": {"secondary-1", "secondary-2"}}
+	client := newCentralizedFireNetClientMock(centralized)
+
+	r := resourceAviatrixFireNet()
+	state := &terraform.InstanceState{
+		ID: "vpc-firenet",
+		Attributes: map[string]string{
+			"id":                           "vpc-firenet",
+			"vpc_id":                       "vpc-firenet",
+			"inspection_enabled":           "true",
+			"egress_enabled":               "false",
+			"hashing_algorithm":            "5-Tuple",
+			"secondary_firenet_gw_names.#": "2",
+			"secondary_firenet_gw_names.0": "secondary-1",
+			"secondary_firenet_gw_names.1": "secondary-2",
+		},
+	}
+	config := map[string]interface{}{
+		"vpc_id":                     "vpc-firenet",
+		"secondary_firenet_gw_names": []interface{}{"secondary-2", "secondary-3"},
+	}
+	diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("could not diff resource: %v", err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("could not build resource data: %v", err)
+	}
+
+	diags := resourceAviatrixFireNetUpdate(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	if assert.Len(t, client.DeleteCentralizedTransitFireNetCalls(), 1) {
+		assert.Equal(t, "secondary-1", client.DeleteCentralizedTransitFireNetCalls()[0].FirenetAttachment.SecondaryGwName)
+	}
+	if assert.Len(t, client.CreateCentralizedTransitFireNetCalls(), 1) {
+		assert.Equal(t, "secondary-3", client.CreateCentralizedTransitFireNetCalls()[0].FirenetAttachment.SecondaryGwName)
+	}
+	assert.Equal(t, map[string][]string{"transit": {"secondary-2", "secondary-3"}}, centralized)
+	assert.ElementsMatch(t, []interface{}{"secondary-2", "secondary-3"}, d.Get("secondary_firenet_gw_names").(*schema.Set).List())
+}
+
+func TestResourceAviatrixFireNetDelete_SecondaryFireNetGwNames(t *testing.T) {
+	centralized := map[string][]string{"transit": {"secondary-1"}}
+	client := newCentralizedFireNetClientMock(centralized)
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixFireNet().Schema, map[string]interface{}{
+		"vpc_id":                     "vpc-firenet",
+		"secondary_firenet_gw_names": []interface{}{"secondary-1"},
+	})
+	d.SetId("vpc-firenet")
+	diags := resourceAviatrixFireNetDelete(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Len(t, client.DeleteCentralizedTransitFireNetCalls(), 1)
+	assert.Empty(t, centralized)
+}
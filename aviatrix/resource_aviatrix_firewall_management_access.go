@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixFirewallManagementAccess() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallManagementAccessCreate,
-		Read:   resourceAviatrixFirewallManagementAccessRead,
-		Delete: resourceAviatrixFirewallManagementAccessDelete,
+		CreateContext: resourceAviatrixFirewallManagementAccessCreate,
+		ReadContext:   resourceAviatrixFirewallManagementAccessRead,
+		DeleteContext: resourceAviatrixFirewallManagementAccessDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,44 +36,44 @@ func resourceAviatrixFirewallManagementAccess() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFirewallManagementAccessCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallManagementAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	firewallManagementAccess := &goaviatrix.FirewallManagementAccess{
 		TransitFireNetGatewayName:    d.Get("transit_firenet_gateway_name").(string),
 		ManagementAccessResourceName: d.Get("management_access_resource_name").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix firewall management access: %#v", firewallManagementAccess)
+	tflog.Info(ctx, "Creating Aviatrix firewall management access", map[string]interface{}{"transit_fire_net_gateway_name": firewallManagementAccess.TransitFireNetGatewayName})
 
 	d.SetId(firewallManagementAccess.TransitFireNetGatewayName + "~" + firewallManagementAccess.ManagementAccessResourceName)
 	flag := false
-	defer resourceAviatrixFirewallManagementAccessReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixFirewallManagementAccessReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateFirewallManagementAccess(firewallManagementAccess)
+	err := client.CreateFirewallManagementAccessContext(ctx, firewallManagementAccess)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix firewall management access: %s", err)
+		return diag.Errorf("failed to create Aviatrix firewall management access: %s", err)
 	}
 
-	return resourceAviatrixFirewallManagementAccessReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFirewallManagementAccessReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFirewallManagementAccessReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixFirewallManagementAccessReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFirewallManagementAccessRead(d, meta)
+		return resourceAviatrixFirewallManagementAccessRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFirewallManagementAccessRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallManagementAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	transitFireNetGatewayName := d.Get("transit_firenet_gateway_name").(string)
 
 	if transitFireNetGatewayName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no transit firenet gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no transit firenet gateway name received", map[string]interface{}{"id": id})
 		d.Set("transit_firenet_gateway_name", strings.Split(id, "~")[0])
 		d.SetId(id)
 	}
@@ -81,13 +82,13 @@ func resourceAviatrixFirewallManagementAccessRead(d *schema.ResourceData, meta i
 		TransitFireNetGatewayName: d.Get("transit_firenet_gateway_name").(string),
 	}
 
-	firewallManagementAccessRead, err := client.GetFirewallManagementAccess(firewallManagementAccess)
+	firewallManagementAccessRead, err := client.GetFirewallManagementAccessContext(ctx, firewallManagementAccess)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix firewall management access: %s", err)
+		return diag.Errorf("couldn't find Aviatrix firewall management access: %s", err)
 	}
 
 	d.Set("transit_firenet_gateway_name", firewallManagementAccessRead.TransitFireNetGatewayName)
@@ -97,19 +98,19 @@ func resourceAviatrixFirewallManagementAccessRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAviatrixFirewallManagementAccessDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallManagementAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	firewallManagementAccess := &goaviatrix.FirewallManagementAccess{
 		TransitFireNetGatewayName:    d.Get("transit_firenet_gateway_name").(string),
 		ManagementAccessResourceName: "no",
 	}
 
-	log.Printf("[INFO] Destroying Aviatrix firewall management access: %#v", firewallManagementAccess)
+	tflog.Info(ctx, "Destroying Aviatrix firewall management access", map[string]interface{}{"transit_fire_net_gateway_name": firewallManagementAccess.TransitFireNetGatewayName})
 
-	err := client.DestroyFirewallManagementAccess(firewallManagementAccess)
+	err := client.DestroyFirewallManagementAccessContext(ctx, firewallManagementAccess)
 	if err != nil {
-		return fmt.Errorf("failed to destroy Aviatrix firewall management access: %s", err)
+		return diag.Errorf("failed to destroy Aviatrix firewall management access: %s", err)
 	}
 	return nil
 }
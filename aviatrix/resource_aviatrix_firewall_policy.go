@@ -1,10 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -13,11 +15,11 @@ import (
 
 func resourceAviatrixFirewallPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallPolicyCreate,
-		Read:   resourceAviatrixFirewallPolicyRead,
-		Delete: resourceAviatrixFirewallPolicyDelete,
+		CreateContext: resourceAviatrixFirewallPolicyCreate,
+		ReadContext:   resourceAviatrixFirewallPolicyRead,
+		DeleteContext: resourceAviatrixFirewallPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -114,37 +116,37 @@ func marshalFirewallPolicyInput(d *schema.ResourceData) *goaviatrix.Firewall {
 	}
 }
 
-func resourceAviatrixFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	fw := marshalFirewallPolicyInput(d)
 
 	d.SetId(getFirewallPolicyID(fw))
 	flag := false
-	defer resourceAviatrixFirewallPolicyReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixFirewallPolicyReadIfRequired(ctx, d, meta, &flag)
 	if fw.PolicyList[0].Position == 0 {
-		if err := client.AddFirewallPolicy(fw); err != nil {
-			return err
+		if err := client.AddFirewallPolicyContext(ctx, fw); err != nil {
+			return diag.FromErr(err)
 		}
 	} else {
-		if err := client.InsertFirewallPolicy(fw); err != nil {
-			return err
+		if err := client.InsertFirewallPolicyContext(ctx, fw); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceAviatrixFirewallPolicyReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFirewallPolicyReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFirewallPolicyReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixFirewallPolicyReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFirewallPolicyRead(d, meta)
+		return resourceAviatrixFirewallPolicyRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gwName := d.Get("gw_name").(string)
 	srcIP := d.Get("src_ip").(string)
@@ -159,11 +161,11 @@ func resourceAviatrixFirewallPolicyRead(d *schema.ResourceData, meta interface{}
 	description := d.Get("description").(string)
 	if gwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no firewall_policy received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no firewall_policy received", map[string]interface{}{"id": id})
 
 		parts := strings.Split(id, "~")
 		if len(parts) != 6 {
-			return fmt.Errorf("invalid firewall_policy import id: %q, "+
+			return diag.Errorf("invalid firewall_policy import id: %q, "+
 				"import id must be in the form gw_name~src_ip~dst_ip~protocol~port~action", id)
 		}
 		d.SetId(id)
@@ -186,17 +188,17 @@ func resourceAviatrixFirewallPolicyRead(d *schema.ResourceData, meta interface{}
 		},
 	}
 
-	fw, err := client.GetFirewallPolicy(fw)
+	fw, err := client.GetFirewallPolicyContext(ctx, fw)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 	id := getFirewallPolicyID(fw)
 	if err != nil {
-		return fmt.Errorf("could not find firewall_policy %s: %v", id, err)
+		return diag.Errorf("could not find firewall_policy %s: %v", id, err)
 	}
 
 	d.Set("gw_name", fw.GwName)
@@ -213,13 +215,13 @@ func resourceAviatrixFirewallPolicyRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceAviatrixFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	fw := marshalFirewallPolicyInput(d)
 
-	if err := client.DeleteFirewallPolicy(fw); err != nil {
-		return err
+	if err := client.DeleteFirewallPolicyContext(ctx, fw); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(getFirewallPolicyID(fw))
@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixFirewallTag() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallTagCreate,
-		Read:   resourceAviatrixFirewallTagRead,
-		Update: resourceAviatrixFirewallTagUpdate,
-		Delete: resourceAviatrixFirewallTagDelete,
+		CreateContext: resourceAviatrixFirewallTagCreate,
+		ReadContext:   resourceAviatrixFirewallTagRead,
+		UpdateContext: resourceAviatrixFirewallTagUpdate,
+		DeleteContext: resourceAviatrixFirewallTagDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,24 +48,24 @@ func resourceAviatrixFirewallTag() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFirewallTagCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	firewallTag := &goaviatrix.FirewallTag{
 		Name: d.Get("firewall_tag").(string),
 	}
 
 	if firewallTag.Name == "" {
-		return fmt.Errorf("invalid choice: firewall tag can't be empty")
+		return diag.Errorf("invalid choice: firewall tag can't be empty")
 	}
 
 	d.SetId(firewallTag.Name)
 	flag := false
-	defer resourceAviatrixFirewallTagReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixFirewallTagReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateFirewallTag(firewallTag)
+	err := client.CreateFirewallTagContext(ctx, firewallTag)
 	if err != nil {
-		return fmt.Errorf("failed to create firewall tag: %s", err)
+		return diag.Errorf("failed to create firewall tag: %s", err)
 	}
 
 	// If cidr list is present, update cidr list
@@ -77,38 +78,38 @@ func resourceAviatrixFirewallTagCreate(d *schema.ResourceData, meta interface{})
 				CIDR:    cm["cidr"].(string),
 			}
 			if cidrMember.CIDRTag == "" {
-				return fmt.Errorf("invalid choice: cidr_tag_name can't be empty")
+				return diag.Errorf("invalid choice: cidr_tag_name can't be empty")
 			}
 			if cidrMember.CIDR == "" {
-				return fmt.Errorf("invalid choice: cidr can't be empty")
+				return diag.Errorf("invalid choice: cidr can't be empty")
 			}
 			firewallTag.CIDRList = append(firewallTag.CIDRList, cidrMember)
 		}
 
-		err := client.UpdateFirewallTag(firewallTag)
+		err := client.UpdateFirewallTagContext(ctx, firewallTag)
 		if err != nil {
-			return fmt.Errorf("failed to update Aviatrix FirewallTag: %s", err)
+			return diag.Errorf("failed to update Aviatrix FirewallTag: %s", err)
 		}
 	}
 
-	return resourceAviatrixFirewallTagReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFirewallTagReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFirewallTagReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixFirewallTagReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFirewallTagRead(d, meta)
+		return resourceAviatrixFirewallTagRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFirewallTagRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	fTag := d.Get("firewall_tag").(string)
 	if fTag == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no firewall tag name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no firewall tag name received", map[string]interface{}{"id": id})
 		d.Set("firewall_tag", id)
 		d.SetId(id)
 	}
@@ -116,16 +117,16 @@ func resourceAviatrixFirewallTagRead(d *schema.ResourceData, meta interface{}) e
 	firewallTag := &goaviatrix.FirewallTag{
 		Name: d.Get("firewall_tag").(string),
 	}
-	fwt, err := client.GetFirewallTag(firewallTag)
+	fwt, err := client.GetFirewallTagContext(ctx, firewallTag)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("error fetching firewall tag %s: %s", firewallTag.Name, err)
+		return diag.Errorf("error fetching firewall tag %s: %s", firewallTag.Name, err)
 	}
 
-	log.Printf("[TRACE] Reading cidr list for tag %s: %#v", firewallTag.Name, fwt)
+	tflog.Trace(ctx, "Reading cidr list for tag", map[string]interface{}{"name": firewallTag.Name})
 
 	if fwt != nil {
 		var cidrList []map[string]interface{}
@@ -138,15 +139,15 @@ func resourceAviatrixFirewallTagRead(d *schema.ResourceData, meta interface{}) e
 		}
 
 		if err := d.Set("cidr_list", cidrList); err != nil {
-			log.Printf("[WARN] Error setting cidr_list for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, "Error setting cidr_list", map[string]interface{}{"id": d.Id(), "error": err})
 		}
 	}
 
 	return nil
 }
 
-func resourceAviatrixFirewallTagUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallTagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	firewallTag := &goaviatrix.FirewallTag{
 		Name: d.Get("firewall_tag").(string),
@@ -154,7 +155,7 @@ func resourceAviatrixFirewallTagUpdate(d *schema.ResourceData, meta interface{})
 
 	d.Partial(true)
 
-	log.Printf("[INFO] Creating Aviatrix firewall: %#v", firewallTag)
+	tflog.Info(ctx, "Creating Aviatrix firewall", map[string]interface{}{"name": firewallTag.Name})
 
 	// Update cidr list
 	cidrList := d.Get("cidr_list").([]interface{})
@@ -167,25 +168,25 @@ func resourceAviatrixFirewallTagUpdate(d *schema.ResourceData, meta interface{})
 		firewallTag.CIDRList = append(firewallTag.CIDRList, cidrMember)
 	}
 
-	err := client.UpdateFirewallTag(firewallTag)
+	err := client.UpdateFirewallTagContext(ctx, firewallTag)
 	if err != nil {
-		return fmt.Errorf("failed to update Aviatrix FirewallTag: %s", err)
+		return diag.Errorf("failed to update Aviatrix FirewallTag: %s", err)
 	}
 
 	d.Partial(false)
-	return resourceAviatrixFirewallTagRead(d, meta)
+	return resourceAviatrixFirewallTagRead(ctx, d, meta)
 }
 
-func resourceAviatrixFirewallTagDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFirewallTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	firewallTag := &goaviatrix.FirewallTag{
 		Name: d.Get("firewall_tag").(string),
 	}
 
-	err := client.DeleteFirewallTag(firewallTag)
+	err := client.DeleteFirewallTagContext(ctx, firewallTag)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix FirewallTag policy list: %s", err)
+		return diag.Errorf("failed to delete Aviatrix FirewallTag policy list: %s", err)
 	}
 
 	return nil
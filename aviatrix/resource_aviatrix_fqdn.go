@@ -1,9 +1,10 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -12,12 +13,12 @@ import (
 
 func resourceAviatrixFQDN() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFQDNCreate,
-		Read:   resourceAviatrixFQDNRead,
-		Update: resourceAviatrixFQDNUpdate,
-		Delete: resourceAviatrixFQDNDelete,
+		CreateContext: resourceAviatrixFQDNCreate,
+		ReadContext:   resourceAviatrixFQDNRead,
+		UpdateContext: resourceAviatrixFQDNUpdate,
+		DeleteContext: resourceAviatrixFQDNDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 2,
@@ -109,13 +110,13 @@ func resourceAviatrixFQDN() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFQDNCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	_, hasSetDomainNames := d.GetOk("domain_names")
 	enabledInlineDomainNames := d.Get("manage_domain_names").(bool)
 	if hasSetDomainNames && !enabledInlineDomainNames {
-		return fmt.Errorf("manage_domain_names must be set to true to set in-line domain names")
+		return diag.Errorf("manage_domain_names must be set to true to set in-line domain names")
 	}
 
 	fqdn := &goaviatrix.FQDN{
@@ -130,15 +131,15 @@ func resourceAviatrixFQDNCreate(d *schema.ResourceData, meta interface{}) error
 		fqdn.FQDNStatus = "disabled"
 	}
 
-	log.Printf("[INFO] Creating Aviatrix FQDN: %#v", fqdn)
+	tflog.Info(ctx, "Creating Aviatrix FQDN", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
 
 	d.SetId(fqdn.FQDNTag)
 	flag := false
-	defer resourceAviatrixFQDNReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixFQDNReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateFQDN(fqdn)
+	err := client.CreateFQDNContext(ctx, fqdn)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix FQDN: %s", err)
+		return diag.Errorf("failed to create Aviatrix FQDN: %s", err)
 	}
 
 	if hasSetDomainNames && enabledInlineDomainNames {
@@ -155,14 +156,14 @@ func resourceAviatrixFQDNCreate(d *schema.ResourceData, meta interface{}) error
 				}
 				str := fqdnFilter.FQDN + fqdnFilter.Protocol + fqdnFilter.Port + fqdnFilter.Verdict
 				if mapDomains[str] {
-					return fmt.Errorf("validation on domain_names failed: duplicate rules are not allowed")
+					return diag.Errorf("validation on domain_names failed: duplicate rules are not allowed")
 				}
 				mapDomains[str] = true
 				fqdn.DomainList = append(fqdn.DomainList, fqdnFilter)
 			}
 		}
-		if err := client.UpdateDomains(fqdn); err != nil {
-			return fmt.Errorf("failed to set domain names: %s", err)
+		if err := client.UpdateDomainsContext(ctx, fqdn); err != nil {
+			return diag.Errorf("failed to set domain names: %s", err)
 		}
 	}
 
@@ -172,9 +173,9 @@ func resourceAviatrixFQDNCreate(d *schema.ResourceData, meta interface{}) error
 		gateway := &goaviatrix.Gateway{
 			GwName: gFT["gw_name"].(string),
 		}
-		err := client.AttachTagToGw(fqdn, gateway)
+		err := client.AttachTagToGwContext(ctx, fqdn, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to add filter tag to gateway : %s", err)
+			return diag.Errorf("failed to add filter tag to gateway : %s", err)
 		}
 		sourceIPs := make([]string, 0)
 		for _, sourceIP := range gFT["source_ip_list"].(*schema.Set).List() {
@@ -182,50 +183,50 @@ func resourceAviatrixFQDNCreate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if len(sourceIPs) != 0 {
-			err = client.UpdateSourceIPFilters(fqdn, gateway, sourceIPs)
+			err = client.UpdateSourceIPFiltersContext(ctx, fqdn, gateway, sourceIPs)
 			if err != nil {
-				return fmt.Errorf("failed to update source ips to gateway : %s", err)
+				return diag.Errorf("failed to update source ips to gateway : %s", err)
 			}
 		}
 	}
 
 	if fqdnStatus := d.Get("fqdn_enabled").(bool); fqdnStatus {
 		fqdn.FQDNStatus = "enabled"
-		log.Printf("[INFO] Enable FQDN tag status: %#v", fqdn)
+		tflog.Info(ctx, "Enable FQDN tag status", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
 
-		err := client.UpdateFQDNStatus(fqdn)
+		err := client.UpdateFQDNStatusContext(ctx, fqdn)
 		if err != nil {
-			return fmt.Errorf("failed to update FQDN status : %s", err)
+			return diag.Errorf("failed to update FQDN status : %s", err)
 		}
 	}
 
 	// update fqdn_mode when set to non-default "blacklist" mode
 	if fqdnMode := d.Get("fqdn_mode").(string); fqdnMode == "black" {
-		log.Printf("[INFO] Enable FQDN Mode: %#v", fqdn)
-		err := client.UpdateFQDNMode(fqdn)
+		tflog.Info(ctx, "Enable FQDN Mode", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
+		err := client.UpdateFQDNModeContext(ctx, fqdn)
 		if err != nil {
-			return fmt.Errorf("failed to update FQDN mode : %s", err)
+			return diag.Errorf("failed to update FQDN mode : %s", err)
 		}
 	}
 
-	return resourceAviatrixFQDNReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFQDNReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFQDNReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixFQDNReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFQDNRead(d, meta)
+		return resourceAviatrixFQDNRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	fqdnTag := d.Get("fqdn_tag").(string)
 	if fqdnTag == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no fqdn tag received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no fqdn tag received", map[string]interface{}{"id": id})
 		d.Set("fqdn_tag", id)
 		d.Set("manage_domain_names", true)
 		d.SetId(id)
@@ -235,13 +236,13 @@ func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
 		FQDNTag: d.Get("fqdn_tag").(string),
 	}
 
-	fqdn, err := client.GetFQDNTag(fqdn)
+	fqdn, err := client.GetFQDNTagContext(ctx, fqdn)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find FQDN tag: %s", err)
+		return diag.Errorf("couldn't find FQDN tag: %s", err)
 	}
 
 	if fqdn.FQDNStatus == "enabled" {
@@ -252,14 +253,14 @@ func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("fqdn_mode", fqdn.FQDNMode)
 
-	log.Printf("[INFO] Reading Aviatrix FQDN: %#v", fqdn)
-	newfqdn, err := client.GetFQDNTag(fqdn)
+	tflog.Info(ctx, "Reading Aviatrix FQDN", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
+	newfqdn, err := client.GetFQDNTagContext(ctx, fqdn)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find FQDN tag: %s", err)
+		return diag.Errorf("couldn't find FQDN tag: %s", err)
 	}
 
 	if newfqdn != nil {
@@ -274,11 +275,11 @@ func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("fqdn_mode", newfqdn.FQDNMode)
 		}
 	}
-	newfqdn, err = client.ListDomains(fqdn)
+	newfqdn, err = client.ListDomainsContext(ctx, fqdn)
 	if err != nil {
-		return fmt.Errorf("couldn't list FQDN domains: %s", err)
+		return diag.Errorf("couldn't list FQDN domains: %s", err)
 	}
-	log.Printf("[INFO] Enable FQDN tag status: %#v", newfqdn)
+	tflog.Info(ctx, "Enable FQDN tag status", map[string]interface{}{"fqdn_tag": newfqdn.FQDNTag})
 
 	if newfqdn != nil {
 		// This is nothing IF ListDomains return empty
@@ -292,19 +293,19 @@ func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
 			filter = append(filter, dn)
 		}
 
-		log.Printf("[INFO] Enable FQDN tag status: %#v", fqdn)
+		tflog.Info(ctx, "Enable FQDN tag status", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
 
 		// Only write domain names to state if the user has enabled in-line domain names.
 		if d.Get("manage_domain_names").(bool) {
 			if err = d.Set("domain_names", filter); err != nil {
-				log.Printf("[WARN] Error setting domain_names for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, "Error setting domain_names", map[string]interface{}{"id": d.Id(), "error": err})
 			}
 		}
 	}
 
-	newfqdn, err = client.GetGwFilterTagList(newfqdn)
+	newfqdn, err = client.GetGwFilterTagListContext(ctx, newfqdn)
 	if err != nil {
-		return fmt.Errorf("couldn't list FQDN Filter Tags: %s", err)
+		return diag.Errorf("couldn't list FQDN Filter Tags: %s", err)
 	}
 
 	mGwFilterTags := make(map[string]map[string]interface{})
@@ -361,19 +362,19 @@ func resourceAviatrixFQDNRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("gw_filter_tag_list", gwFilterTagList); err != nil {
-		log.Printf("[WARN] Error setting gw_filter_tag_list for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, "Error setting gw_filter_tag_list", map[string]interface{}{"id": d.Id(), "error": err})
 	}
 
 	return nil
 }
 
-func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	_, hasSetDomainNames := d.GetOk("domain_names")
 	enabledInlineDomainNames := d.Get("manage_domain_names").(bool)
 	if hasSetDomainNames && !enabledInlineDomainNames {
-		return fmt.Errorf("manage_domain_names must be set to true to set in-line domain names")
+		return diag.Errorf("manage_domain_names must be set to true to set in-line domain names")
 	}
 
 	fqdn := &goaviatrix.FQDN{
@@ -390,18 +391,18 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 
 	d.Partial(true)
 	if d.HasChange("fqdn_tag") {
-		return fmt.Errorf("updating fqdn_tag is not allowed")
+		return diag.Errorf("updating fqdn_tag is not allowed")
 	}
 	if d.HasChange("fqdn_enabled") {
-		err := client.UpdateFQDNStatus(fqdn)
+		err := client.UpdateFQDNStatusContext(ctx, fqdn)
 		if err != nil {
-			return fmt.Errorf("failed to update FQDN status : %s", err)
+			return diag.Errorf("failed to update FQDN status : %s", err)
 		}
 	}
 	if d.HasChange("fqdn_mode") {
-		err := client.UpdateFQDNMode(fqdn)
+		err := client.UpdateFQDNModeContext(ctx, fqdn)
 		if err != nil {
-			return fmt.Errorf("failed to update FQDN mode : %s", err)
+			return diag.Errorf("failed to update FQDN mode : %s", err)
 		}
 	}
 	// Update Domain list
@@ -419,14 +420,14 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 				}
 				str := fqdnDomain.FQDN + fqdnDomain.Protocol + fqdnDomain.Port + fqdnDomain.Verdict
 				if mapDomains[str] {
-					return fmt.Errorf("validation on domain_names failed in update: duplicate rules are not allowed")
+					return diag.Errorf("validation on domain_names failed in update: duplicate rules are not allowed")
 				}
 				mapDomains[str] = true
 				fqdn.DomainList = append(fqdn.DomainList, fqdnDomain)
 			}
 		}
-		if err := client.UpdateDomains(fqdn); err != nil {
-			return fmt.Errorf("failed to set domain names in update : %s", err)
+		if err := client.UpdateDomainsContext(ctx, fqdn); err != nil {
+			return diag.Errorf("failed to set domain names in update : %s", err)
 		}
 	}
 	if d.HasChange("gw_filter_tag_list") {
@@ -463,21 +464,21 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 
 			val, ok := mapOldTagList[gateway.GwName]
 			if !ok {
-				err := client.AttachTagToGw(fqdn, gateway)
+				err := client.AttachTagToGwContext(ctx, fqdn, gateway)
 				if err != nil {
-					return fmt.Errorf("failed to add filter tag to gateway : %s", err)
+					return diag.Errorf("failed to add filter tag to gateway : %s", err)
 				}
-				err = client.UpdateSourceIPFilters(fqdn, gateway, sourceIPs)
+				err = client.UpdateSourceIPFiltersContext(ctx, fqdn, gateway, sourceIPs)
 				if err != nil {
-					return fmt.Errorf("failed to update source ips to gateway : %s", err)
+					return diag.Errorf("failed to update source ips to gateway : %s", err)
 				}
 				continue
 			}
 
 			if !goaviatrix.Equivalent(val, sourceIPs) {
-				err := client.UpdateSourceIPFilters(fqdn, gateway, sourceIPs)
+				err := client.UpdateSourceIPFiltersContext(ctx, fqdn, gateway, sourceIPs)
 				if err != nil {
-					return fmt.Errorf("failed to update source ips to gateway : %s", err)
+					return diag.Errorf("failed to update source ips to gateway : %s", err)
 				}
 			}
 			delete(mapOldTagList, gateway.GwName)
@@ -488,39 +489,39 @@ func resourceAviatrixFQDNUpdate(d *schema.ResourceData, meta interface{}) error
 			keys = append(keys, key)
 		}
 		if len(keys) != 0 {
-			err := client.DetachGws(fqdn, keys)
+			err := client.DetachGwsContext(ctx, fqdn, keys)
 			if err != nil {
-				return fmt.Errorf("failed to delete GWs for fqdn in update: %s", err)
+				return diag.Errorf("failed to delete GWs for fqdn in update: %s", err)
 			}
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(fqdn.FQDNTag)
-	return resourceAviatrixFQDNRead(d, meta)
+	return resourceAviatrixFQDNRead(ctx, d, meta)
 }
 
-func resourceAviatrixFQDNDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	fqdn := &goaviatrix.FQDN{
 		FQDNTag: d.Get("fqdn_tag").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix FQDN: %#v", fqdn)
+	tflog.Info(ctx, "Deleting Aviatrix FQDN", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
 
-	gwList, err := client.ListGws(fqdn)
+	gwList, err := client.ListGwsContext(ctx, fqdn)
 	if err != nil {
-		return fmt.Errorf("failed to get GW list for fqdn: %s", err)
+		return diag.Errorf("failed to get GW list for fqdn: %s", err)
 	}
-	err = client.DetachGws(fqdn, gwList)
+	err = client.DetachGwsContext(ctx, fqdn, gwList)
 	if err != nil {
-		return fmt.Errorf("failed to delete GWs for fqdn: %s", err)
+		return diag.Errorf("failed to delete GWs for fqdn: %s", err)
 	}
 
-	err = client.DeleteFQDN(fqdn)
+	err = client.DeleteFQDNContext(ctx, fqdn)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix FQDN: %s", err)
+		return diag.Errorf("failed to delete Aviatrix FQDN: %s", err)
 	}
 
 	return nil
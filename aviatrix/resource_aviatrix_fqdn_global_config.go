@@ -65,7 +65,7 @@ func resourceAviatrixFQDNGlobalConfig() *schema.Resource {
 }
 
 func resourceAviatrixFQDNGlobalConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	enablePrivateNetworkFiltering := d.Get("enable_private_network_filtering").(bool)
 	enableCustomNetworkFiltering := d.Get("enable_custom_network_filtering").(bool)
@@ -83,7 +83,7 @@ func resourceAviatrixFQDNGlobalConfigCreate(ctx context.Context, d *schema.Resou
 		}
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	flag := false
 	defer resourceAviatrixFQDNGlobalConfigReadIfRequired(ctx, d, meta, &flag)
 
@@ -158,9 +158,9 @@ func resourceAviatrixFQDNGlobalConfigReadIfRequired(ctx context.Context, d *sche
 }
 
 func resourceAviatrixFQDNGlobalConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -209,12 +209,12 @@ func resourceAviatrixFQDNGlobalConfigRead(ctx context.Context, d *schema.Resourc
 		d.Set("enable_exact_match", false)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixFQDNGlobalConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	d.Partial(true)
 
@@ -320,7 +320,7 @@ func resourceAviatrixFQDNGlobalConfigUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceAviatrixFQDNGlobalConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, ok := meta.(*goaviatrix.Client) // default enabled
+	client, ok := meta.(goaviatrix.ClientInterface) // default enabled
 	if !ok {
 		return diag.Errorf("meta is not a valid Client pointer")
 	}
@@ -1,23 +1,24 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAviatrixFQDNPassThrough() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFQDNPassThroughCreate,
-		Read:   resourceAviatrixFQDNPassThroughRead,
-		Update: resourceAviatrixFQDNPassThroughUpdate,
-		Delete: resourceAviatrixFQDNPassThroughDelete,
+		CreateContext: resourceAviatrixFQDNPassThroughCreate,
+		ReadContext:   resourceAviatrixFQDNPassThroughRead,
+		UpdateContext: resourceAviatrixFQDNPassThroughUpdate,
+		DeleteContext: resourceAviatrixFQDNPassThroughDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,40 +42,40 @@ func resourceAviatrixFQDNPassThrough() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFQDNPassThroughCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNPassThroughCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gw := &goaviatrix.Gateway{GwName: d.Get("gw_name").(string)}
 	var cidrs []string
 	for _, v := range d.Get("pass_through_cidrs").(*schema.Set).List() {
 		cidrs = append(cidrs, v.(string))
 	}
-	if err := client.ConfigureFQDNPassThroughCIDRs(gw, cidrs); err != nil {
-		return err
+	if err := client.ConfigureFQDNPassThroughCIDRsContext(ctx, gw, cidrs); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(gw.GwName)
-	return resourceAviatrixFQDNPassThroughRead(d, meta)
+	return resourceAviatrixFQDNPassThroughRead(ctx, d, meta)
 }
 
-func resourceAviatrixFQDNPassThroughRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNPassThroughRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gwName := d.Get("gw_name").(string)
 	if gwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no fqdn_pass_through gwName received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no fqdn_pass_through gwName received", map[string]interface{}{"id": id})
 		d.SetId(id)
 		gwName = id
 	}
 	gw := &goaviatrix.Gateway{GwName: gwName}
-	cidrs, err := client.GetFQDNPassThroughCIDRs(gw)
+	cidrs, err := client.GetFQDNPassThroughCIDRsContext(ctx, gw)
 	if err == goaviatrix.ErrNotFound {
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not find fqdn_pass_through %s: %v", gwName, err)
+		return diag.Errorf("could not find fqdn_pass_through %s: %v", gwName, err)
 	}
 
 	d.Set("gw_name", gwName)
@@ -88,14 +89,14 @@ func resourceAviatrixFQDNPassThroughRead(d *schema.ResourceData, meta interface{
 
 	err = d.Set("pass_through_cidrs", cidrsWithoutTags)
 	if err != nil {
-		return fmt.Errorf("could not set pass_through_cidrs: %v", err)
+		return diag.Errorf("could not set pass_through_cidrs: %v", err)
 	}
 
 	return nil
 }
 
-func resourceAviatrixFQDNPassThroughUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNPassThroughUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gw := &goaviatrix.Gateway{GwName: d.Get("gw_name").(string)}
 
@@ -104,21 +105,21 @@ func resourceAviatrixFQDNPassThroughUpdate(d *schema.ResourceData, meta interfac
 		for _, v := range d.Get("pass_through_cidrs").(*schema.Set).List() {
 			cidrs = append(cidrs, v.(string))
 		}
-		if err := client.ConfigureFQDNPassThroughCIDRs(gw, cidrs); err != nil {
-			return err
+		if err := client.ConfigureFQDNPassThroughCIDRsContext(ctx, gw, cidrs); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	d.SetId(gw.GwName)
-	return resourceAviatrixFQDNPassThroughRead(d, meta)
+	return resourceAviatrixFQDNPassThroughRead(ctx, d, meta)
 }
 
-func resourceAviatrixFQDNPassThroughDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNPassThroughDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gw := &goaviatrix.Gateway{GwName: d.Get("gw_name").(string)}
-	if err := client.DisableFQDNPassThrough(gw); err != nil {
-		return err
+	if err := client.DisableFQDNPassThroughContext(ctx, gw); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return nil
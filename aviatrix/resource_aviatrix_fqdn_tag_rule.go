@@ -1,22 +1,24 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAviatrixFQDNTagRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFQDNTagRuleCreate,
-		Read:   resourceAviatrixFQDNTagRuleRead,
-		Delete: resourceAviatrixFQDNTagRuleDelete,
+		CreateContext: resourceAviatrixFQDNTagRuleCreate,
+		ReadContext:   resourceAviatrixFQDNTagRuleRead,
+		DeleteContext: resourceAviatrixFQDNTagRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -76,13 +78,13 @@ func getFQDNTagRuleID(fqdn *goaviatrix.FQDN) string {
 		fqdn.FQDNTag, fqdn.DomainList[0].FQDN, fqdn.DomainList[0].Protocol, fqdn.DomainList[0].Port, fqdn.DomainList[0].Verdict)
 }
 
-func resourceAviatrixFQDNTagRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNTagRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	fqdn := marshalFQDNTagRuleInput(d)
 
-	if err := client.AddFQDNTagRule(fqdn); err != nil {
-		return err
+	if err := client.AddFQDNTagRuleContext(ctx, fqdn); err != nil {
+		return diag.FromErr(err)
 	}
 
 	id := getFQDNTagRuleID(fqdn)
@@ -90,8 +92,8 @@ func resourceAviatrixFQDNTagRuleCreate(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAviatrixFQDNTagRuleRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNTagRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	fqdnTag := d.Get("fqdn_tag_name").(string)
 	fqdnDomain := d.Get("fqdn").(string)
@@ -101,11 +103,11 @@ func resourceAviatrixFQDNTagRuleRead(d *schema.ResourceData, meta interface{}) e
 
 	if fqdnTag == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no id received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no id received", map[string]interface{}{"id": id})
 
 		parts := strings.Split(id, "~")
 		if len(parts) != 5 {
-			return fmt.Errorf("invalid fqdn_tag_rule import id: %q, "+
+			return diag.Errorf("invalid fqdn_tag_rule import id: %q, "+
 				"import id must be in the form fqdn_tag_name~fqdn~protocol~port~action", id)
 		}
 		d.SetId(id)
@@ -125,13 +127,13 @@ func resourceAviatrixFQDNTagRuleRead(d *schema.ResourceData, meta interface{}) e
 		},
 	}
 
-	fqdn, err := client.GetFQDNTagRule(fqdn)
+	fqdn, err := client.GetFQDNTagRuleContext(ctx, fqdn)
 	if err == goaviatrix.ErrNotFound {
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not find fqdn_tag_rule %s: %v", fqdnDomain, err)
+		return diag.Errorf("could not find fqdn_tag_rule %s: %v", fqdnDomain, err)
 	}
 
 	d.Set("fqdn_tag_name", fqdnTag)
@@ -145,17 +147,17 @@ func resourceAviatrixFQDNTagRuleRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceAviatrixFQDNTagRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixFQDNTagRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	fqdn := marshalFQDNTagRuleInput(d)
 
-	err := client.DeleteFQDNTagRule(fqdn)
+	err := client.DeleteFQDNTagRuleContext(ctx, fqdn)
 	if err == goaviatrix.ErrNotFound {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
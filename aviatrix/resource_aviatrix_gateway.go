@@ -1,26 +1,28 @@
 package aviatrix
 
 import (
-	"errors"
+	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixGatewayCreate,
-		Read:   resourceAviatrixGatewayRead,
-		Update: resourceAviatrixGatewayUpdate,
-		Delete: resourceAviatrixGatewayDelete,
+		CreateContext: resourceAviatrixGatewayCreate,
+		ReadContext:   resourceAviatrixGatewayRead,
+		UpdateContext: resourceAviatrixGatewayUpdate,
+		DeleteContext: resourceAviatrixGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -32,6 +34,18 @@ func resourceAviatrixGateway() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffTagsAll(goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes),
+			customizeDiffAccountAudit,
+			resourceAviatrixGatewayCustomizeDiff,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cloud_type": {
 				Type:         schema.TypeInt,
@@ -409,6 +423,12 @@ func resourceAviatrixGateway() *schema.Resource {
 				Optional:    true,
 				Description: "A map of tags to assign to the gateway.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "All tags of the gateway, including the provider default tags.",
+			},
 			"enable_spot_instance": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -600,8 +620,150 @@ func resourceAviatrixGateway() *schema.Resource {
 	}
 }
 
-func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+// resourceAviatrixGatewayCustomizeDiff checks the cross-field rules enforced by
+// resourceAviatrixGatewayCreate at plan time, so invalid combinations are
+// reported before a gateway is launched.
+func resourceAviatrixGatewayCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("cloud_type") {
+		return nil
+	}
+	cloudType := d.Get("cloud_type").(int)
+
+	if diffNeedsValidation(d, "cloud_type", "fqdn_lan_cidr", "fqdn_lan_vpc_id") {
+		fqdnLanCidr := d.Get("fqdn_lan_cidr").(string)
+		fqdnLanVpcID := d.Get("fqdn_lan_vpc_id").(string)
+		if !goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) && fqdnLanVpcID != "" {
+			return fmt.Errorf("attribute 'fqdn_lan_vpc_id' is only valid for GCP FQDN Gateways")
+		}
+		if !goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) && fqdnLanCidr != "" {
+			return fmt.Errorf("attribute 'fqdn_lan_cidr' is only valid for GCP and Azure FQDN Gateways")
+		}
+		if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) && (fqdnLanCidr == "") != (fqdnLanVpcID == "") {
+			return fmt.Errorf("to create a GCP FQDN gateway, both 'fqdn_lan_cidr' and 'fqdn_lan_vpc_id' must be set")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "zone", "enable_public_subnet_filtering") {
+		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) && !d.Get("enable_public_subnet_filtering").(bool) && d.Get("zone").(string) != "" {
+			return fmt.Errorf("attribute 'zone' is only valid for Azure, Azure GOV, Azure China or Public Subnet Filtering Gateways")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "insane_mode", "insane_mode_az", "peering_ha_subnet", "peering_ha_insane_mode_az", "subnet") && d.Get("insane_mode").(bool) {
+		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
+			return fmt.Errorf("insane_mode is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+		}
+		if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
+			if d.Get("insane_mode_az").(string) == "" {
+				return fmt.Errorf("insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China(1024), AWS Top Secret (16384) or AWS Secret (32768)")
+			}
+			if d.Get("peering_ha_subnet").(string) != "" && d.Get("peering_ha_insane_mode_az").(string) == "" {
+				return fmt.Errorf("peering_ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China(1024), AWS Top Secret (16384) or AWS Secret (32768) and ha_subnet is set")
+			}
+		}
+		if err := validateInsaneModeSubnet(d.Get("subnet").(string)); err != nil {
+			return err
+		}
+	}
+
+	if diffNeedsValidation(d, "vpn_access", "enable_elb", "saml_enabled", "otp_mode", "enable_ldap", "ldap_server", "ldap_bind_dn",
+		"ldap_password", "ldap_base_dn", "ldap_username_attribute", "duo_integration_key", "duo_secret_key", "duo_api_hostname",
+		"duo_push_mode", "okta_token", "okta_url") {
+		if !d.Get("vpn_access").(bool) {
+			if d.Get("enable_elb").(bool) {
+				return fmt.Errorf("can not enable elb without VPN access enabled")
+			}
+		} else {
+			otpMode := d.Get("otp_mode").(string)
+			enableLdap := d.Get("enable_ldap").(bool)
+			if d.Get("saml_enabled").(bool) && (enableLdap || otpMode != "") {
+				return fmt.Errorf("ldap and mfa can't be configured if saml is enabled")
+			}
+			if otpMode != "" && otpMode != "2" && otpMode != "3" {
+				return fmt.Errorf("otp_mode can only be '2' or '3' or empty string")
+			}
+			if enableLdap && otpMode == "3" {
+				return fmt.Errorf("ldap can't be configured along with okta authentication")
+			}
+			if enableLdap {
+				if d.Get("ldap_server").(string) == "" {
+					return fmt.Errorf("ldap server must be set if ldap is enabled")
+				}
+				if d.Get("ldap_bind_dn").(string) == "" {
+					return fmt.Errorf("ldap bind dn must be set if ldap is enabled")
+				}
+				if d.Get("ldap_password").(string) == "" {
+					return fmt.Errorf("ldap password must be set if ldap is enabled")
+				}
+				if d.Get("ldap_base_dn").(string) == "" {
+					return fmt.Errorf("ldap base dn must be set if ldap is enabled")
+				}
+				if d.Get("ldap_username_attribute").(string) == "" {
+					return fmt.Errorf("ldap user attribute must be set if ldap is enabled")
+				}
+			}
+			if otpMode == "2" {
+				if d.Get("duo_integration_key").(string) == "" {
+					return fmt.Errorf("duo integration key required if otp_mode set to 2")
+				}
+				if d.Get("duo_secret_key").(string) == "" {
+					return fmt.Errorf("duo secret key required if otp_mode set to 2")
+				}
+				if d.Get("duo_api_hostname").(string) == "" {
+					return fmt.Errorf("duo api hostname required if otp_mode set to 2")
+				}
+				if pushMode := d.Get("duo_push_mode").(string); pushMode != "auto" && pushMode != "token" && pushMode != "selective" {
+					return fmt.Errorf("duo push mode must be set to a valid value (auto, selective, or token)")
+				}
+			} else if otpMode == "3" {
+				if d.Get("okta_token").(string) == "" {
+					return fmt.Errorf("okta token must be set if otp_mode is set to 3")
+				}
+				if d.Get("okta_url").(string) == "" {
+					return fmt.Errorf("okta url must be set if otp_mode is set to 3")
+				}
+			}
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "availability_domain", "fault_domain") {
+		if !goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (d.Get("availability_domain").(string) != "" || d.Get("fault_domain").(string) != "") {
+			return fmt.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "peering_ha_subnet", "peering_ha_zone", "peering_ha_gw_size", "enable_public_subnet_filtering", "enable_designated_gateway") {
+		peeringHaSubnet := d.Get("peering_ha_subnet").(string)
+		peeringHaZone := d.Get("peering_ha_zone").(string)
+		if peeringHaZone != "" && !goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) && !d.Get("enable_public_subnet_filtering").(bool) {
+			return fmt.Errorf("'peering_ha_zone' is only valid for GCP, Azure and Public Subnet Filtering Gateway if enabling Peering HA")
+		}
+		if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) && peeringHaZone == "" && peeringHaSubnet != "" {
+			return fmt.Errorf("'peering_ha_zone' must be set to enable Peering HA on GCP, " +
+				"cannot enable Peering HA with only 'peering_ha_subnet' enabled")
+		}
+		if goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) && peeringHaZone != "" && peeringHaSubnet == "" {
+			return fmt.Errorf("'peering_ha_subnet' must be provided to enable HA on Azure, " +
+				"cannot enable HA with only 'peering_ha_zone'")
+		}
+		if peeringHaSubnet == "" && peeringHaZone == "" && d.Get("peering_ha_gw_size").(string) != "" {
+			return fmt.Errorf("'peering_ha_gw_size' is only required if enabling Peering HA")
+		}
+		if d.Get("enable_designated_gateway").(bool) {
+			if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
+				return fmt.Errorf("'designated_gateway' feature is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) providers")
+			}
+			if peeringHaSubnet != "" || peeringHaZone != "" {
+				return fmt.Errorf("can't enable HA for gateway with 'designated_gateway' enabled")
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceAviatrixGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType:          d.Get("cloud_type").(int),
@@ -637,7 +799,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 
 	err := checkPublicSubnetFilteringConfig(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.Get("enable_public_subnet_filtering").(bool) {
 		var routeTables []string
@@ -651,14 +813,14 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	fqdnLanCidr := d.Get("fqdn_lan_cidr").(string)
 	fqdnLanVpcID := d.Get("fqdn_lan_vpc_id").(string)
 	if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) && fqdnLanVpcID != "" {
-		return fmt.Errorf("attribute 'fqdn_lan_vpc_id' is only valid for GCP FQDN Gateways")
+		return diag.Errorf("attribute 'fqdn_lan_vpc_id' is only valid for GCP FQDN Gateways")
 	}
 	if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) && fqdnLanCidr != "" {
-		return fmt.Errorf("attribute 'fqdn_lan_cidr' is only valid for GCP and Azure FQDN Gateways")
+		return diag.Errorf("attribute 'fqdn_lan_cidr' is only valid for GCP and Azure FQDN Gateways")
 	}
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) {
 		if (fqdnLanCidr != "" && fqdnLanVpcID == "") || (fqdnLanCidr == "" && fqdnLanVpcID != "") {
-			return fmt.Errorf("to create a GCP FQDN gateway, both 'fqdn_lan_cidr' and 'fqdn_lan_vpc_id' must be set")
+			return diag.Errorf("to create a GCP FQDN gateway, both 'fqdn_lan_cidr' and 'fqdn_lan_vpc_id' must be set")
 		}
 		if fqdnLanCidr != "" && fqdnLanVpcID != "" {
 			gateway.LanVpcID = fqdnLanVpcID
@@ -672,7 +834,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && !d.Get("enable_public_subnet_filtering").(bool) && d.Get("zone").(string) != "" {
-		return fmt.Errorf("attribute 'zone' is only valid for Azure, Azure GOV, Azure China or Public Subnet Filtering Gateways")
+		return diag.Errorf("attribute 'zone' is only valid for Azure, Azure GOV, Azure China or Public Subnet Filtering Gateways")
 	}
 
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && d.Get("zone").(string) != "" {
@@ -685,7 +847,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 		// for gcp, rest api asks for "zone" rather than vpc region
 		gateway.Zone = d.Get("vpc_reg").(string)
 	} else {
-		return fmt.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
+		return diag.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
 	}
 
 	singleIpNat := d.Get("single_ip_snat").(bool)
@@ -702,21 +864,21 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 		gateway.AllocateNewEip = "off"
 
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-			return fmt.Errorf("failed to create transit gateway: 'allocate_new_eip' can only be set to 'false' when cloud_type is AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048) or AWS Top Secret (16384)")
+			return diag.Errorf("failed to create transit gateway: 'allocate_new_eip' can only be set to 'false' when cloud_type is AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048) or AWS Top Secret (16384)")
 		}
 		if _, ok := d.GetOk("eip"); !ok {
-			return fmt.Errorf("failed to create gateway: 'eip' must be set when 'allocate_new_eip' is false")
+			return diag.Errorf("failed to create gateway: 'eip' must be set when 'allocate_new_eip' is false")
 		}
 		azureEipName, azureEipNameOk := d.GetOk("azure_eip_name_resource_group")
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 			// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 			if !azureEipNameOk {
-				return fmt.Errorf("failed to create gateway: 'azure_eip_name_resource_group' must be set when 'allocate_new_eip' is false and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+				return diag.Errorf("failed to create gateway: 'azure_eip_name_resource_group' must be set when 'allocate_new_eip' is false and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 			}
 			gateway.Eip = fmt.Sprintf("%s:%s", azureEipName.(string), d.Get("eip").(string))
 		} else {
 			if azureEipNameOk {
-				return fmt.Errorf("failed to create gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+				return diag.Errorf("failed to create gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 			}
 			gateway.Eip = d.Get("eip").(string)
 		}
@@ -725,14 +887,14 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	insaneMode := d.Get("insane_mode").(bool)
 	if insaneMode {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("insane_mode is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return diag.Errorf("insane_mode is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 			if d.Get("insane_mode_az").(string) == "" {
-				return fmt.Errorf("insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China(1024), AWS Top Secret (16384) or AWS Secret (32768)")
+				return diag.Errorf("insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China(1024), AWS Top Secret (16384) or AWS Secret (32768)")
 			}
 			if d.Get("peering_ha_subnet").(string) != "" && d.Get("peering_ha_insane_mode_az").(string) == "" {
-				return fmt.Errorf("peering_ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China(1024), AWS Top Secret (16384) or AWS Secret (32768) and ha_subnet is set")
+				return diag.Errorf("peering_ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China(1024), AWS Top Secret (16384) or AWS Secret (32768) and ha_subnet is set")
 			}
 			// Append availability zone to subnet
 			var strs []string
@@ -774,61 +936,61 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 		if enableElb && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 			gateway.VpnProtocol = vpnProtocol
 		} else if enableElb && vpnProtocol == "UDP" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("'UDP' for VPN gateway with ELB is only supported by AWS provider")
+			return diag.Errorf("'UDP' for VPN gateway with ELB is only supported by AWS provider")
 		} else if !enableElb && vpnProtocol == "TCP" {
-			return fmt.Errorf("'vpn_protocol' should be left empty or set to 'UDP' for vpn gateway of AWS provider without elb enabled")
+			return diag.Errorf("'vpn_protocol' should be left empty or set to 'UDP' for vpn gateway of AWS provider without elb enabled")
 		}
 
 		if gateway.SamlEnabled == "yes" {
 			if gateway.EnableLdap || gateway.OtpMode != "" {
-				return fmt.Errorf("ldap and mfa can't be configured if saml is enabled")
+				return diag.Errorf("ldap and mfa can't be configured if saml is enabled")
 			}
 		}
 
 		if gateway.OtpMode != "" && gateway.OtpMode != "2" && gateway.OtpMode != "3" {
-			return fmt.Errorf("otp_mode can only be '2' or '3' or empty string")
+			return diag.Errorf("otp_mode can only be '2' or '3' or empty string")
 		}
 
 		if gateway.EnableLdap && gateway.OtpMode == "3" {
-			return fmt.Errorf("ldap can't be configured along with okta authentication")
+			return diag.Errorf("ldap can't be configured along with okta authentication")
 		}
 		if gateway.EnableLdap {
 			if gateway.LdapServer == "" {
-				return fmt.Errorf("ldap server must be set if ldap is enabled")
+				return diag.Errorf("ldap server must be set if ldap is enabled")
 			}
 			if gateway.LdapBindDn == "" {
-				return fmt.Errorf("ldap bind dn must be set if ldap is enabled")
+				return diag.Errorf("ldap bind dn must be set if ldap is enabled")
 			}
 			if gateway.LdapPassword == "" {
-				return fmt.Errorf("ldap password must be set if ldap is enabled")
+				return diag.Errorf("ldap password must be set if ldap is enabled")
 			}
 			if gateway.LdapBaseDn == "" {
-				return fmt.Errorf("ldap base dn must be set if ldap is enabled")
+				return diag.Errorf("ldap base dn must be set if ldap is enabled")
 			}
 			if gateway.LdapUserAttr == "" {
-				return fmt.Errorf("ldap user attribute must be set if ldap is enabled")
+				return diag.Errorf("ldap user attribute must be set if ldap is enabled")
 			}
 		}
 		if gateway.OtpMode == "2" {
 			if gateway.DuoIntegrationKey == "" {
-				return fmt.Errorf("duo integration key required if otp_mode set to 2")
+				return diag.Errorf("duo integration key required if otp_mode set to 2")
 			}
 			if gateway.DuoSecretKey == "" {
-				return fmt.Errorf("duo secret key required if otp_mode set to 2")
+				return diag.Errorf("duo secret key required if otp_mode set to 2")
 			}
 			if gateway.DuoAPIHostname == "" {
-				return fmt.Errorf("duo api hostname required if otp_mode set to 2")
+				return diag.Errorf("duo api hostname required if otp_mode set to 2")
 			}
 			if gateway.DuoPushMode != "auto" && gateway.DuoPushMode != "token" && gateway.DuoPushMode != "selective" {
-				return fmt.Errorf("duo push mode must be set to a valid value (auto, selective, or token)")
+				return diag.Errorf("duo push mode must be set to a valid value (auto, selective, or token)")
 			}
 			gateway.AuthMethod = "DUO"
 		} else if gateway.OtpMode == "3" {
 			if gateway.OktaToken == "" {
-				return fmt.Errorf("okta token must be set if otp_mode is set to 3")
+				return diag.Errorf("okta token must be set if otp_mode is set to 3")
 			}
 			if gateway.OktaURL == "" {
-				return fmt.Errorf("okta url must be set if otp_mode is set to 3")
+				return diag.Errorf("okta url must be set if otp_mode is set to 3")
 			}
 			gateway.AuthMethod = "okta"
 		}
@@ -836,18 +998,18 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	} else {
 		gateway.VpnStatus = "no"
 		if gateway.EnableElb == "yes" {
-			return fmt.Errorf("can not enable elb without VPN access enabled")
+			return diag.Errorf("can not enable elb without VPN access enabled")
 		}
 		if vpnProtocol != "" {
-			return fmt.Errorf("'vpn_protocol' should be left empty for non-vpn gateway")
+			return diag.Errorf("'vpn_protocol' should be left empty for non-vpn gateway")
 		}
 	}
 
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (gateway.AvailabilityDomain == "" || gateway.FaultDomain == "") {
-		return fmt.Errorf("'availability_domain' and 'fault_domain' are required for OCI")
+		return diag.Errorf("'availability_domain' and 'fault_domain' are required for OCI")
 	}
 	if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (gateway.AvailabilityDomain != "" || gateway.FaultDomain != "") {
-		return fmt.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
+		return diag.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
 	}
 
 	peeringHaGwSize := d.Get("peering_ha_gw_size").(string)
@@ -857,35 +1019,35 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	peeringHaFaultDomain := d.Get("peering_ha_fault_domain").(string)
 
 	if peeringHaZone != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) && !d.Get("enable_public_subnet_filtering").(bool) {
-		return fmt.Errorf("'peering_ha_zone' is only valid for GCP, Azure and Public Subnet Filtering Gateway if enabling Peering HA")
+		return diag.Errorf("'peering_ha_zone' is only valid for GCP, Azure and Public Subnet Filtering Gateway if enabling Peering HA")
 	}
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) && peeringHaZone == "" && peeringHaSubnet != "" {
-		return fmt.Errorf("'peering_ha_zone' must be set to enable Peering HA on GCP, " +
+		return diag.Errorf("'peering_ha_zone' must be set to enable Peering HA on GCP, " +
 			"cannot enable Peering HA with only 'peering_ha_subnet' enabled")
 	}
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && peeringHaZone != "" && peeringHaSubnet == "" {
-		return fmt.Errorf("'peering_ha_subnet' must be provided to enable HA on Azure, " +
+		return diag.Errorf("'peering_ha_subnet' must be provided to enable HA on Azure, " +
 			"cannot enable HA with only 'peering_ha_zone'")
 	}
 	if peeringHaSubnet == "" && peeringHaZone == "" && peeringHaGwSize != "" {
-		return fmt.Errorf("'peering_ha_gw_size' is only required if enabling Peering HA")
+		return diag.Errorf("'peering_ha_gw_size' is only required if enabling Peering HA")
 	}
 	if peeringHaSubnet != "" {
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (peeringHaAvailabilityDomain == "" || peeringHaFaultDomain == "") {
-			return fmt.Errorf("'peering_ha_availability_domain' and 'peering_ha_fault_domain' are required to enable Peering HA on OCI")
+			return diag.Errorf("'peering_ha_availability_domain' and 'peering_ha_fault_domain' are required to enable Peering HA on OCI")
 		}
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (peeringHaAvailabilityDomain != "" || peeringHaFaultDomain != "") {
-			return fmt.Errorf("'peering_ha_availability_domain' and 'peering_ha_fault_domain' are only valid for OCI")
+			return diag.Errorf("'peering_ha_availability_domain' and 'peering_ha_fault_domain' are only valid for OCI")
 		}
 	}
 
 	enableDesignatedGw := d.Get("enable_designated_gateway").(bool)
 	if enableDesignatedGw {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("'designated_gateway' feature is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) providers")
+			return diag.Errorf("'designated_gateway' feature is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) providers")
 		}
 		if peeringHaSubnet != "" || peeringHaZone != "" {
-			return fmt.Errorf("can't enable HA for gateway with 'designated_gateway' enabled")
+			return diag.Errorf("can't enable HA for gateway with 'designated_gateway' enabled")
 		}
 		gateway.EnableDesignatedGateway = "true"
 	}
@@ -893,11 +1055,11 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	enableEncryptVolume := d.Get("enable_encrypt_volume").(bool)
 	customerManagedKeys := d.Get("customer_managed_keys").(string)
 	if enableEncryptVolume && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) providers")
+		return diag.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) providers")
 	}
 	if customerManagedKeys != "" {
 		if !enableEncryptVolume {
-			return fmt.Errorf("'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
+			return diag.Errorf("'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
 		}
 		gateway.CustomerManagedKeys = customerManagedKeys
 	}
@@ -912,24 +1074,24 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	// Enable monitor gateway subnets does not work with AWSChina
 	if enableMonitorSubnets && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes^goaviatrix.AWSChina) {
-		return fmt.Errorf("'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
+		return diag.Errorf("'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
 	}
 	if !enableMonitorSubnets && len(excludedInstances) != 0 {
-		return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
+		return diag.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
 	}
 
 	_, tagsOk := d.GetOk("tags")
-	if tagsOk {
+	if tagsOk || client.GetDefaultTagsConfig().HasTags() && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return errors.New("failed to create gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return diag.Errorf("failed to create gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
-		tagsMap, err := extractTags(d, gateway.CloudType)
+		tagsMap, err := extractTags(d, gateway.CloudType, client.GetDefaultTagsConfig())
 		if err != nil {
-			return fmt.Errorf("error creating tags for gateway: %v", err)
+			return diag.Errorf("error creating tags for gateway: %v", err)
 		}
 		tagJson, err := TagsMapToJson(tagsMap)
 		if err != nil {
-			return fmt.Errorf("failed to add tags when creating gateway: %v", err)
+			return diag.Errorf("failed to add tags when creating gateway: %v", err)
 		}
 		gateway.TagJson = tagJson
 	}
@@ -939,11 +1101,11 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	deleteSpot := d.Get("delete_spot").(bool)
 	if enableSpotInstance {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
+			return diag.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
 		}
 
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && deleteSpot {
-			return fmt.Errorf("delete_spot only supports Azure")
+			return diag.Errorf("delete_spot only supports Azure")
 		}
 
 		gateway.EnableSpotInstance = true
@@ -956,35 +1118,35 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	rxQueueSize := d.Get("rx_queue_size").(string)
 	if rxQueueSize != "" {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("rx_queue_size only supports AWS related cloud types")
+			return diag.Errorf("rx_queue_size only supports AWS related cloud types")
 		} else {
 			gateway.RxQueueSize = rxQueueSize
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix gateway: %#v", gateway)
+	tflog.Info(ctx, "Creating Aviatrix gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
 	d.SetId(gateway.GwName)
 	flag := false
-	defer resourceAviatrixGatewayReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixGatewayReadIfRequired(ctx, d, meta, &flag)
 
 	if d.Get("enable_public_subnet_filtering").(bool) {
 		err := client.CreatePublicSubnetFilteringGateway(gateway)
 		if err != nil {
-			log.Printf("[INFO] failed to create public subnet filtering gateway: %#v", gateway)
-			return fmt.Errorf("could not create public subnet filtering gateway: %v", err)
+			tflog.Info(ctx, "failed to create public subnet filtering gateway", map[string]interface{}{"gw_name": gateway.GwName})
+			return diag.Errorf("could not create public subnet filtering gateway: %v", err)
 		}
 		if !d.Get("public_subnet_filtering_guard_duty_enforced").(bool) {
 			err = client.DisableGuardDutyEnforcement(gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable guard duty enforcement for public subnet filtering gateway: %v", err)
+				return diag.Errorf("could not disable guard duty enforcement for public subnet filtering gateway: %v", err)
 			}
 		}
 	} else {
-		err := client.CreateGateway(gateway)
+		err := client.CreateGatewayContext(ctx, gateway)
 		if err != nil {
-			log.Printf("[INFO] failed to create Aviatrix gateway: %#v", gateway)
-			return fmt.Errorf("failed to create Aviatrix gateway: %s", err)
+			tflog.Info(ctx, "failed to create Aviatrix gateway", map[string]interface{}{"gw_name": gateway.GwName})
+			return diag.Errorf("failed to create Aviatrix gateway: %s", err)
 		}
 	}
 
@@ -993,11 +1155,11 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 		if !enableVpnNat {
 			err := client.DisableVpnNat(gateway)
 			if err != nil {
-				return fmt.Errorf("failed to disable VPN NAT: %s", err)
+				return diag.Errorf("failed to disable VPN NAT: %s", err)
 			}
 		}
 	} else if !enableVpnNat {
-		return fmt.Errorf("'enable_vpc_nat' is only supported for vpn gateway. Can't modify it for non-vpn gateway")
+		return diag.Errorf("'enable_vpc_nat' is only supported for vpn gateway. Can't modify it for non-vpn gateway")
 	}
 
 	singleAZ := d.Get("single_az_ha").(bool)
@@ -1007,11 +1169,11 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 			SingleAZ: "enabled",
 		}
 
-		log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+		tflog.Info(ctx, "Enable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 
 		err := client.EnableSingleAZGateway(singleAZGateway)
 		if err != nil {
-			return fmt.Errorf("failed to create single AZ GW HA: %s", err)
+			return diag.Errorf("failed to create single AZ GW HA: %s", err)
 		}
 	} else if !singleAZ && d.Get("enable_public_subnet_filtering").(bool) {
 		// Public Subnet Filtering Gateways are created with single_az_ha=true by default.
@@ -1022,7 +1184,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 		}
 		err := client.DisableSingleAZGateway(singleAZGateway)
 		if err != nil {
-			return fmt.Errorf("failed to disable single AZ : %v", err)
+			return diag.Errorf("failed to disable single AZ : %v", err)
 		}
 	}
 
@@ -1035,7 +1197,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 			}
 			err := client.EditDesignatedGateway(designatedGw)
 			if err != nil {
-				return fmt.Errorf("failed to edit additional cidrs for 'designated_gateway' feature due to %s", err)
+				return diag.Errorf("failed to edit additional cidrs for 'designated_gateway' feature due to %s", err)
 			}
 		}
 	}
@@ -1043,7 +1205,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	// peering_ha_subnet is for Peering HA Gateway. https://docs.aviatrix.com/HowTos/gateway.html#high-availability
 	if peeringHaSubnet != "" || peeringHaZone != "" {
 		if peeringHaGwSize == "" && !d.Get("enable_public_subnet_filtering").(bool) {
-			return fmt.Errorf("A valid non empty peering_ha_gw_size parameter is mandatory for " +
+			return diag.Errorf("A valid non empty peering_ha_gw_size parameter is mandatory for " +
 				"this resource if peering_ha_subnet or peering_ha_zone is set. Example: t2.micro")
 		}
 		peeringHaGateway := &goaviatrix.Gateway{
@@ -1084,18 +1246,18 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 			if peeringHaGateway.Eip != "" {
 				// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 				if !haAzureEipNameOk {
-					return fmt.Errorf("failed to create Peering HA Gateway: 'peering_ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create Peering HA Gateway: 'peering_ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				peeringHaGateway.Eip = fmt.Sprintf("%s:%s", haAzureEipName.(string), peeringHaGateway.Eip)
 			} else if haAzureEipNameOk {
-				return fmt.Errorf("failed to create Peering HA Gateway: 'peering_ha_azure_eip_name_resource_group' must be empty when 'peering_ha_eip' is empty")
+				return diag.Errorf("failed to create Peering HA Gateway: 'peering_ha_azure_eip_name_resource_group' must be empty when 'peering_ha_eip' is empty")
 			}
 		} else if haAzureEipNameOk {
-			return fmt.Errorf("failed to create Peering HA Gateway: 'peering_ha_azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+			return diag.Errorf("failed to create Peering HA Gateway: 'peering_ha_azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 		}
 
 		if d.Get("enable_public_subnet_filtering").(bool) {
-			log.Printf("[INFO] Enable public subnet filtering HA: %#v", peeringHaGateway)
+			tflog.Info(ctx, "Enable public subnet filtering HA", map[string]interface{}{"gw_name": peeringHaGateway.GwName})
 			var haRouteTables []string
 			for _, v := range d.Get("public_subnet_filtering_ha_route_tables").(*schema.Set).List() {
 				haRouteTables = append(haRouteTables, v.(string))
@@ -1104,20 +1266,20 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 			peeringHaGateway.PeeringHASubnet = fmt.Sprintf("%s~~%s", peeringHaSubnet, peeringHaZone)
 			err := client.EnablePublicSubnetFilteringHAGateway(peeringHaGateway)
 			if err != nil {
-				return fmt.Errorf("could not create public subnet filtering gateway HA: %v", err)
+				return diag.Errorf("could not create public subnet filtering gateway HA: %v", err)
 			}
 		} else {
-			log.Printf("[INFO] Enable peering HA: %#v", peeringHaGateway)
+			tflog.Info(ctx, "Enable peering HA", map[string]interface{}{"gw_name": peeringHaGateway.GwName})
 			err := client.EnablePeeringHaGateway(peeringHaGateway)
 			if err != nil {
-				return fmt.Errorf("failed to create peering HA: %s", err)
+				return diag.Errorf("failed to create peering HA: %s", err)
 			}
 		}
 
-		log.Printf("[INFO] Resizing Peering HA Gateway: %#v", peeringHaGwSize)
+		tflog.Info(ctx, "Resizing Peering HA Gateway", map[string]interface{}{"peering_ha_gw_size": peeringHaGwSize})
 		if peeringHaGwSize != gateway.VpcSize {
 			if peeringHaGwSize == "" {
-				return fmt.Errorf("A valid non empty peering_ha_gw_size parameter is mandatory for " +
+				return diag.Errorf("A valid non empty peering_ha_gw_size parameter is mandatory for " +
 					"this resource if peering_ha_subnet is set. Example: t2.micro")
 			}
 			peeringHaGateway := &goaviatrix.Gateway{
@@ -1126,10 +1288,10 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 				// controller, test out first. just assuming it has that suffix
 			}
 			peeringHaGateway.VpcSize = peeringHaGwSize
-			err := client.UpdateGateway(peeringHaGateway)
-			log.Printf("[INFO] Resizing Peering Ha Gateway size to: %s,", peeringHaGateway.VpcSize)
+			err := client.UpdateGatewayContext(ctx, peeringHaGateway)
+			tflog.Info(ctx, "Resizing Peering Ha Gateway size", map[string]interface{}{"vpc_size": peeringHaGateway.VpcSize})
 			if err != nil {
-				return fmt.Errorf("failed to update Aviatrix Peering HA Gateway size: %s", err)
+				return diag.Errorf("failed to update Aviatrix Peering HA Gateway size: %s", err)
 			}
 		}
 	}
@@ -1140,21 +1302,21 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 			GwName: d.Get("gw_name").(string),
 		}
 
-		log.Printf("[INFO] Enable VPC DNS Server: %#v", gwVpcDnsServer)
+		tflog.Info(ctx, "Enable VPC DNS Server", map[string]interface{}{"gw_name": gwVpcDnsServer.GwName})
 
 		err := client.EnableVpcDnsServer(gwVpcDnsServer)
 		if err != nil {
-			return fmt.Errorf("failed to enable VPC DNS Server: %s", err)
+			return diag.Errorf("failed to enable VPC DNS Server: %s", err)
 		}
 	} else if enableVpcDnsServer {
-		return fmt.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if enableMonitorSubnets {
-		log.Printf("[INFO] Enable Monitor Gateway Subnets")
+		tflog.Info(ctx, "Enable Monitor Gateway Subnets")
 		err := client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
 		if err != nil {
-			return fmt.Errorf("could not enable monitor gateway subnets: %v", err)
+			return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 		}
 	}
 
@@ -1165,12 +1327,12 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	idleTimeoutValue := d.Get("idle_timeout").(int)
 	if idleTimeoutValue != -1 {
 		if d.Get("enable_elb").(bool) {
-			gw, err := client.GetGateway(&goaviatrix.Gateway{
+			gw, err := client.GetGatewayContext(ctx, &goaviatrix.Gateway{
 				AccountName: d.Get("account_name").(string),
 				GwName:      d.Get("gw_name").(string),
 			})
 			if err != nil {
-				return fmt.Errorf("couldn't find Aviatrix Gateway for idle timeout : %s", gw.GwName)
+				return diag.Errorf("couldn't find Aviatrix Gateway for idle timeout : %s", gw.GwName)
 			}
 			gatewayServer.GwName = gw.ElbName
 		} else {
@@ -1180,22 +1342,22 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 			Name:  "Idle timeout",
 			Value: strconv.Itoa(idleTimeoutValue),
 		}
-		log.Printf("[INFO] Enable Modify VPN Config (Idle Timeout)")
+		tflog.Info(ctx, "Enable Modify VPN Config (Idle Timeout)")
 		err := client.EnableVPNConfig(gatewayServer, enableVPNServer)
 		if err != nil {
-			return fmt.Errorf("fail to enable idle timeout: %s", err)
+			return diag.Errorf("fail to enable idle timeout: %s", err)
 		}
 	}
 
 	renegoIntervalValue := d.Get("renegotiation_interval").(int)
 	if renegoIntervalValue != -1 {
 		if d.Get("enable_elb").(bool) {
-			gw, err := client.GetGateway(&goaviatrix.Gateway{
+			gw, err := client.GetGatewayContext(ctx, &goaviatrix.Gateway{
 				AccountName: d.Get("account_name").(string),
 				GwName:      d.Get("gw_name").(string),
 			})
 			if err != nil {
-				return fmt.Errorf("couldn't find Aviatrix Gateway renegotiation interval : %s", gw.GwName)
+				return diag.Errorf("couldn't find Aviatrix Gateway renegotiation interval : %s", gw.GwName)
 			}
 			gatewayServer.GwName = gw.ElbName
 		} else {
@@ -1205,31 +1367,31 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 			Name:  "Renegotiation interval",
 			Value: strconv.Itoa(renegoIntervalValue),
 		}
-		log.Printf("[INFO] Enable Modify VPN Config (Renegotiation Interval)")
+		tflog.Info(ctx, "Enable Modify VPN Config (Renegotiation Interval)")
 		err := client.EnableVPNConfig(gatewayServer, enableVPNServer)
 		if err != nil {
-			return fmt.Errorf("fail to enable renegotiation interval: %s", err)
+			return diag.Errorf("fail to enable renegotiation interval: %s", err)
 		}
 	}
 
 	if !d.Get("enable_jumbo_frame").(bool) {
 		err := client.DisableJumboFrame(gateway)
 		if err != nil {
-			return fmt.Errorf("couldn't disable jumbo frames for Gateway: %s", err)
+			return diag.Errorf("couldn't disable jumbo frames for Gateway: %s", err)
 		}
 	}
 
 	if !d.Get("enable_gro_gso").(bool) {
 		err := client.DisableGroGso(gateway)
 		if err != nil {
-			return fmt.Errorf("couldn't disable GRO/GSO on gateway: %s", err)
+			return diag.Errorf("couldn't disable GRO/GSO on gateway: %s", err)
 		}
 	}
 
 	if detectionTime, ok := d.GetOk("tunnel_detection_time"); ok {
 		err := client.ModifyTunnelDetectionTime(gateway.GwName, detectionTime.(int))
 		if err != nil {
-			return fmt.Errorf("could not set tunnel detection time during Gateway creation: %v", err)
+			return diag.Errorf("could not set tunnel detection time during Gateway creation: %v", err)
 		}
 	}
 
@@ -1244,7 +1406,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 			tags.TagJson = gateway.TagJson
 			err := client.UpdateTags(tags)
 			if err != nil {
-				return fmt.Errorf("failed to set tags for gateway during creation: %s", err)
+				return diag.Errorf("failed to set tags for gateway during creation: %s", err)
 			}
 		}
 	}
@@ -1252,7 +1414,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 	if rxQueueSize != "" {
 		err := client.SetRxQueueSize(gateway)
 		if err != nil {
-			return fmt.Errorf("failed to set rx queue size for gateway %s: %s", gateway.GwName, err)
+			return diag.Errorf("failed to set rx queue size for gateway %s: %s", gateway.GwName, err)
 		}
 		if peeringHaSubnet != "" || peeringHaZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
@@ -1261,32 +1423,31 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta interface{}) err
 			}
 			err := client.SetRxQueueSize(haGwRxQueueSize)
 			if err != nil {
-				return fmt.Errorf("failed to set rx queue size for gateway ha %s : %s", haGwRxQueueSize.GwName, err)
+				return diag.Errorf("failed to set rx queue size for gateway ha %s : %s", haGwRxQueueSize.GwName, err)
 			}
 		}
 	}
 
-	return resourceAviatrixGatewayReadIfRequired(d, meta, &flag)
+	return resourceAviatrixGatewayReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixGatewayReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixGatewayReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixGatewayRead(d, meta)
+		return resourceAviatrixGatewayRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
-	ignoreTagsConfig := client.IgnoreTagsConfig
+func resourceAviatrixGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	var isImport bool
 	gwName := d.Get("gw_name").(string)
 	if gwName == "" {
 		isImport = true
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no gateway name received", map[string]interface{}{"id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -1296,16 +1457,16 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error
 		GwName:      d.Get("gw_name").(string),
 	}
 
-	gw, err := client.GetGateway(gateway)
+	gw, err := client.GetGatewayContext(ctx, gateway)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Gateway %s: %v", gwName, err)
+		return diag.Errorf("couldn't find Aviatrix Gateway %s: %v", gwName, err)
 	}
 
-	log.Printf("[TRACE] reading gateway %s: %#v", d.Get("gw_name").(string), gw)
+	tflog.Trace(ctx, "reading gateway", map[string]interface{}{"gw_name": gw.GwName})
 
 	d.Set("cloud_type", gw.CloudType)
 	d.Set("account_name", gw.AccountName)
@@ -1343,14 +1504,14 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error
 		if len(azureEip) == 3 {
 			d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the Gateway %s", gw.GwName)
+			tflog.Warn(ctx, "could not get Azure EIP name and resource group for the Gateway", map[string]interface{}{"gw_name": gw.GwName})
 		}
 	}
 
 	if gw.IdleTimeout != "NA" {
 		idleTimeout, err := strconv.Atoi(gw.IdleTimeout)
 		if err != nil {
-			return fmt.Errorf("couldn't get idle timeout for the gateway %s: %v", gw.GwName, err)
+			return diag.Errorf("couldn't get idle timeout for the gateway %s: %v", gw.GwName, err)
 		}
 		d.Set("idle_timeout", idleTimeout)
 	} else {
@@ -1360,7 +1521,7 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error
 	if gw.RenegotiationInterval != "NA" {
 		renegotiationInterval, err := strconv.Atoi(gw.RenegotiationInterval)
 		if err != nil {
-			return fmt.Errorf("couldn't get renegotiation interval for the gateway %s: %v", gw.GwName, err)
+			return diag.Errorf("couldn't get renegotiation interval for the gateway %s: %v", gw.GwName, err)
 		}
 		d.Set("renegotiation_interval", renegotiationInterval)
 	} else {
@@ -1474,9 +1635,8 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-		tags := goaviatrix.KeyValueTags(gw.Tags).IgnoreConfig(ignoreTagsConfig)
-		if err := d.Set("tags", tags); err != nil {
-			log.Printf("[WARN] Error setting tags for (%s): %s", d.Id(), err)
+		if err := setTags(d, client, gw.Tags); err != nil {
+			tflog.Warn(ctx, "Error setting tags", map[string]interface{}{"id": d.Id(), "error": err})
 		}
 	}
 
@@ -1492,7 +1652,7 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("enable_monitor_gateway_subnets", gw.MonitorSubnetsAction == "enable")
 	if err := d.Set("monitor_exclude_list", gw.MonitorExcludeGWList); err != nil {
-		return fmt.Errorf("setting 'monitor_exclude_list' to state: %v", err)
+		return diag.Errorf("setting 'monitor_exclude_list' to state: %v", err)
 	}
 
 	fqdnLanCidr, ok := gw.ArmFqdnLanCidr[gw.GwName]
@@ -1513,7 +1673,7 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error
 	} else {
 		d.Set("enable_public_subnet_filtering", true)
 		if err := d.Set("public_subnet_filtering_route_tables", gw.PsfDetails.RouteTableList); err != nil {
-			return fmt.Errorf("could not set public_subnet_filtering_route_tables into state: %v", err)
+			return diag.Errorf("could not set public_subnet_filtering_route_tables into state: %v", err)
 		}
 		d.Set("public_subnet_filtering_guard_duty_enforced", gw.PsfDetails.GuardDutyEnforced == "yes")
 		d.Set("subnet", gw.PsfDetails.GwSubnetCidr)
@@ -1521,11 +1681,11 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error
 		if gw.HaGw.GwSize == "" {
 			err := d.Set("public_subnet_filtering_ha_route_tables", []string{})
 			if err != nil {
-				return fmt.Errorf("could not set public_subnet_filtering_ha_route_tables into state: %v", err)
+				return diag.Errorf("could not set public_subnet_filtering_ha_route_tables into state: %v", err)
 			}
 		} else {
 			if err := d.Set("public_subnet_filtering_ha_route_tables", gw.PsfDetails.HaRouteTableList); err != nil {
-				return fmt.Errorf("could not set public_subnet_filtering_ha_route_tables into state: %v", err)
+				return diag.Errorf("could not set public_subnet_filtering_ha_route_tables into state: %v", err)
 			}
 			d.Set("peering_ha_subnet", gw.PsfDetails.HaGwSubnetCidr)
 			d.Set("peering_ha_zone", gw.PsfDetails.HaGwSubnetAz)
@@ -1551,7 +1711,7 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error
 
 	enableGroGso, err := client.GetGroGsoStatus(gw)
 	if err != nil {
-		return fmt.Errorf("failed to get GRO/GSO status of gateway %s: %v", gw.GwName, err)
+		return diag.Errorf("failed to get GRO/GSO status of gateway %s: %v", gw.GwName, err)
 	}
 	d.Set("enable_gro_gso", enableGroGso)
 
@@ -1598,7 +1758,7 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error
 		if len(azureEip) == 3 {
 			d.Set("peering_ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the Peering HA Gateway %s", gw.GwName)
+			tflog.Warn(ctx, "could not get Azure EIP name and resource group for the Peering HA Gateway", map[string]interface{}{"gw_name": gw.GwName})
 		}
 	}
 
@@ -1633,54 +1793,54 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	log.Printf("[INFO] Updating Aviatrix gateway: %#v", d.Get("gw_name").(string))
+	tflog.Info(ctx, "Updating Aviatrix gateway", map[string]interface{}{"gw_name": d.Get("gw_name").(string)})
 
 	d.Partial(true)
 	if d.HasChange("vpn_access") {
-		return fmt.Errorf("updating vpn_access is not allowed")
+		return diag.Errorf("updating vpn_access is not allowed")
 	}
 	if d.HasChange("enable_elb") {
-		return fmt.Errorf("updating enable_elb is not allowed")
+		return diag.Errorf("updating enable_elb is not allowed")
 	}
 	if d.HasChange("elb_name") {
-		return fmt.Errorf("updating elb_name is not allowed")
+		return diag.Errorf("updating elb_name is not allowed")
 	}
 	if d.HasChange("vpn_protocol") {
-		return fmt.Errorf("updating vpn_protocol is not allowed")
+		return diag.Errorf("updating vpn_protocol is not allowed")
 	}
 	if d.HasChange("allocate_new_eip") {
-		return fmt.Errorf("updating allocate_new_eip is not allowed")
+		return diag.Errorf("updating allocate_new_eip is not allowed")
 	}
 	if d.HasChange("eip") {
-		return fmt.Errorf("updating eip is not allowed")
+		return diag.Errorf("updating eip is not allowed")
 	}
 	if d.HasChange("peering_ha_eip") {
 		o, n := d.GetChange("peering_ha_eip")
 		if o != "" && n != "" {
-			return fmt.Errorf("updating peering_ha_eip is not allowed")
+			return diag.Errorf("updating peering_ha_eip is not allowed")
 		}
 	}
 	if d.HasChange("azure_eip_name_resource_group") {
-		return fmt.Errorf("failed to update gateway: changing 'azure_eip_name_resource_group' is not allowed")
+		return diag.Errorf("failed to update gateway: changing 'azure_eip_name_resource_group' is not allowed")
 	}
 	if d.HasChange("peering_ha_azure_eip_name_resource_group") {
 		o, n := d.GetChange("peering_ha_azure_eip_name_resource_group")
 		if o.(string) != "" && n.(string) != "" {
-			return fmt.Errorf("failed to update gateway: changing 'peering_ha_azure_eip_name_resource_group' is not allowed")
+			return diag.Errorf("failed to update gateway: changing 'peering_ha_azure_eip_name_resource_group' is not allowed")
 		}
 	}
 	if d.HasChange("enable_designated_gateway") {
-		return fmt.Errorf("updating enable_designated_gateway is not allowed")
+		return diag.Errorf("updating enable_designated_gateway is not allowed")
 	}
 	if d.HasChange("enable_public_subnet_filtering") {
-		return fmt.Errorf("updating enable_public_subnet_filtering is not allowed")
+		return diag.Errorf("updating enable_public_subnet_filtering is not allowed")
 	}
 	err := checkPublicSubnetFilteringConfig(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gateway := &goaviatrix.Gateway{
@@ -1695,7 +1855,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		enableElb = d.Get("enable_elb").(bool)
 		if enableElb {
 			gateway.ElbDNSName = d.Get("elb_dns_name").(string)
-			geoVpn, err := client.GetGeoVPNName(gateway)
+			geoVpn, err := client.GetGeoVPNNameContext(ctx, gateway)
 			if err == nil {
 				geoVpnDnsName = geoVpn.ServiceName
 			}
@@ -1704,14 +1864,14 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("peering_ha_zone") {
 		peeringHaZone := d.Get("peering_ha_zone").(string)
 		if peeringHaZone != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) && !d.Get("enable_public_subnet_filtering").(bool) {
-			return fmt.Errorf("'peering_ha_zone' is only valid for GCP, Azure and Public Subnet Filtering Gateway if enabling Peering HA")
+			return diag.Errorf("'peering_ha_zone' is only valid for GCP, Azure and Public Subnet Filtering Gateway if enabling Peering HA")
 		}
 	}
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) {
 		peeringHaSubnet := d.Get("peering_ha_subnet").(string)
 		peeringHaZone := d.Get("peering_ha_zone").(string)
 		if peeringHaZone == "" && peeringHaSubnet != "" {
-			return fmt.Errorf("'peering_ha_zone' must be set to enable Peering HA on GCP, " +
+			return diag.Errorf("'peering_ha_zone' must be set to enable Peering HA on GCP, " +
 				"cannot enable Peering HA with only 'peering_ha_subnet' enabled")
 		}
 	}
@@ -1719,7 +1879,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		peeringHaSubnet := d.Get("peering_ha_subnet").(string)
 		peeringHaZone := d.Get("peering_ha_zone").(string)
 		if peeringHaZone != "" && peeringHaSubnet == "" {
-			return fmt.Errorf("'peering_ha_subnet' must be set to enable Peering HA on Azure, " +
+			return diag.Errorf("'peering_ha_subnet' must be set to enable Peering HA on Azure, " +
 				"cannot enable Peering HA with only 'peering_ha_zone' enabled")
 		}
 	}
@@ -1742,9 +1902,9 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("gw_size") {
 		old, _ := d.GetChange("gw_size")
 		primaryGwSize = old.(string)
-		err := client.UpdateGateway(gateway)
+		err := client.UpdateGatewayContext(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to update Aviatrix Gateway: %s", err)
+			return diag.Errorf("failed to update Aviatrix Gateway: %s", err)
 		}
 	}
 
@@ -1755,7 +1915,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		d.HasChange("ldap_password") || d.HasChange("ldap_base_dn") || d.HasChange("ldap_username_attribute") {
 
 		if !vpnAccess {
-			return fmt.Errorf("vpn_access must be set to yes to modify vpn authentication")
+			return diag.Errorf("vpn_access must be set to yes to modify vpn authentication")
 		}
 
 		vpn_gw := &goaviatrix.VpnGatewayAuth{
@@ -1789,53 +1949,53 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			gw := &goaviatrix.Gateway{
 				GwName: gateway.GwName,
 			}
-			gw1, err := client.GetGateway(gw)
+			gw1, err := client.GetGatewayContext(ctx, gw)
 			if err != nil {
-				return fmt.Errorf("couldn't find Aviatrix Gateway: %s", gw.GwName)
+				return diag.Errorf("couldn't find Aviatrix Gateway: %s", gw.GwName)
 			}
 			vpn_gw.VpcID = gw1.VpcID
 		}
 
 		if vpn_gw.OtpMode != "" && vpn_gw.OtpMode != "2" && vpn_gw.OtpMode != "3" {
-			return fmt.Errorf("otp_mode can only be '2' or '3' or empty string")
+			return diag.Errorf("otp_mode can only be '2' or '3' or empty string")
 		}
 		if vpn_gw.SamlEnabled == "yes" {
 			if vpn_gw.EnableLdap || vpn_gw.OtpMode != "" {
-				return fmt.Errorf("ldap and mfa can't be configured if saml is enabled")
+				return diag.Errorf("ldap and mfa can't be configured if saml is enabled")
 			}
 		}
 		if vpn_gw.EnableLdap && vpn_gw.OtpMode == "3" {
-			return fmt.Errorf("ldap can't be configured along with okta authentication")
+			return diag.Errorf("ldap can't be configured along with okta authentication")
 		}
 		if vpn_gw.EnableLdap {
 			if vpn_gw.LdapServer == "" {
-				return fmt.Errorf("ldap server must be set if ldap is enabled")
+				return diag.Errorf("ldap server must be set if ldap is enabled")
 			}
 			if vpn_gw.LdapBindDn == "" {
-				return fmt.Errorf("ldap bind dn must be set if ldap is enabled")
+				return diag.Errorf("ldap bind dn must be set if ldap is enabled")
 			}
 			if vpn_gw.LdapPassword == "" {
-				return fmt.Errorf("ldap password must be set if ldap is enabled")
+				return diag.Errorf("ldap password must be set if ldap is enabled")
 			}
 			if vpn_gw.LdapBaseDn == "" {
-				return fmt.Errorf("ldap base dn must be set if ldap is enabled")
+				return diag.Errorf("ldap base dn must be set if ldap is enabled")
 			}
 			if vpn_gw.LdapUserAttr == "" {
-				return fmt.Errorf("ldap user attribute must be set if ldap is enabled")
+				return diag.Errorf("ldap user attribute must be set if ldap is enabled")
 			}
 		}
 		if vpn_gw.OtpMode == "2" {
 			if vpn_gw.DuoIntegrationKey == "" {
-				return fmt.Errorf("duo integration key required if otp_mode set to 2")
+				return diag.Errorf("duo integration key required if otp_mode set to 2")
 			}
 			if vpn_gw.DuoSecretKey == "" {
-				return fmt.Errorf("duo secret key required if otp_mode set to 2")
+				return diag.Errorf("duo secret key required if otp_mode set to 2")
 			}
 			if vpn_gw.DuoAPIHostname == "" {
-				return fmt.Errorf("duo api hostname required if otp_mode set to 2")
+				return diag.Errorf("duo api hostname required if otp_mode set to 2")
 			}
 			if vpn_gw.DuoPushMode != "auto" && vpn_gw.DuoPushMode != "token" && vpn_gw.DuoPushMode != "selective" {
-				return fmt.Errorf("duo push mode must be set to a valid value (auto, selective, or token)")
+				return diag.Errorf("duo push mode must be set to a valid value (auto, selective, or token)")
 			}
 			if vpn_gw.EnableLdap {
 				vpn_gw.AuthType = "duo_ldap_auth"
@@ -1844,10 +2004,10 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			}
 		} else if vpn_gw.OtpMode == "3" {
 			if vpn_gw.OktaToken == "" {
-				return fmt.Errorf("okta token must be set if otp_mode is set to 3")
+				return diag.Errorf("okta token must be set if otp_mode is set to 3")
 			}
 			if vpn_gw.OktaURL == "" {
-				return fmt.Errorf("okta url must be set if otp_mode is set to 3")
+				return diag.Errorf("okta url must be set if otp_mode is set to 3")
 			}
 			vpn_gw.AuthType = "okta_auth"
 		} else {
@@ -1867,13 +2027,13 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 
 		err := client.SetVpnGatewayAuthentication(vpn_gw)
 		if err != nil {
-			return fmt.Errorf("failed to update Aviatrix VPN Gateway Authentication: %s", err)
+			return diag.Errorf("failed to update Aviatrix VPN Gateway Authentication: %s", err)
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("failed to update gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov(256) AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return diag.Errorf("failed to update gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov(256) AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 
 		tags := &goaviatrix.Tags{
@@ -1882,19 +2042,19 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			CloudType:    gateway.CloudType,
 		}
 
-		tagsMap, err := extractTags(d, gateway.CloudType)
+		tagsMap, err := extractTags(d, gateway.CloudType, client.GetDefaultTagsConfig())
 		if err != nil {
-			return fmt.Errorf("failed to update tags for gateway: %v", err)
+			return diag.Errorf("failed to update tags for gateway: %v", err)
 		}
 		tags.Tags = tagsMap
 		tagJson, err := TagsMapToJson(tagsMap)
 		if err != nil {
-			return fmt.Errorf("failed to update tags for gateway: %v", err)
+			return diag.Errorf("failed to update tags for gateway: %v", err)
 		}
 		tags.TagJson = tagJson
 		err = client.UpdateTags(tags)
 		if err != nil {
-			return fmt.Errorf("failed to update tags for gateway: %v", err)
+			return diag.Errorf("failed to update tags for gateway: %v", err)
 		}
 	}
 
@@ -1922,9 +2082,9 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 					GwName: gateway.GwName,
 				}
 
-				gw1, err := client.GetGateway(gw)
+				gw1, err := client.GetGatewayContext(ctx, gw)
 				if err != nil {
-					return fmt.Errorf("couldn't find Aviatrix Gateway: %s", gw.GwName)
+					return diag.Errorf("couldn't find Aviatrix Gateway: %s", gw.GwName)
 				}
 				if gw1.ElbState != "enabled" {
 					sTunnel.ElbName = gw1.GwName
@@ -1937,10 +2097,10 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 
 			err := client.ModifySplitTunnel(sTunnel)
 			if err != nil {
-				return fmt.Errorf("failed to modify split tunnel: %s", err)
+				return diag.Errorf("failed to modify split tunnel: %s", err)
 			}
 		} else if !splitTunnel && (d.Get("additional_cidrs").(string) != "" || d.Get("name_servers").(string) != "" || d.Get("search_domains").(string) != "") {
-			return fmt.Errorf("to disable split_tunnel, following three attributes should be null: " +
+			return diag.Errorf("to disable split_tunnel, following three attributes should be null: " +
 				"'additional_cidrs', 'name_servers', and 'search_domains'")
 		} else if !splitTunnel {
 			sTunnel.SplitTunnel = "no"
@@ -1950,7 +2110,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			}
 			err := client.ModifySplitTunnel(sTunnel)
 			if err != nil {
-				return fmt.Errorf("failed to disable split tunnel: %s", err)
+				return diag.Errorf("failed to disable split tunnel: %s", err)
 			}
 		}
 	}
@@ -1971,22 +2131,22 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		if enableNat {
 			err := client.EnableSNat(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable SNAT: %s", err)
+				return diag.Errorf("failed to enable SNAT: %s", err)
 			}
 		} else {
 			err := client.DisableSNat(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable SNAT: %s", err)
+				return diag.Errorf("failed to disable SNAT: %s", err)
 			}
 		}
 
 	}
 	if d.HasChange("additional_cidrs_designated_gateway") {
 		if !d.Get("enable_designated_gateway").(bool) {
-			return fmt.Errorf("failed to edit additional cidrs for 'designated_gateway' since it is not enabled")
+			return diag.Errorf("failed to edit additional cidrs for 'designated_gateway' since it is not enabled")
 		}
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("'designated_gateway' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+			return diag.Errorf("'designated_gateway' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 		designatedGw := &goaviatrix.Gateway{
 			GwName:                      d.Get("gw_name").(string),
@@ -1994,7 +2154,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 		err := client.EditDesignatedGateway(designatedGw)
 		if err != nil {
-			return fmt.Errorf("failed to edit additional cidrs for 'designated_gateway' feature due to %s", err)
+			return diag.Errorf("failed to edit additional cidrs for 'designated_gateway' feature due to %s", err)
 		}
 	}
 	if d.HasChange("vpn_cidr") {
@@ -2007,10 +2167,10 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 
 			err := client.UpdateVpnCidr(gw)
 			if err != nil {
-				return fmt.Errorf("failed to update vpn cidr: %s", err)
+				return diag.Errorf("failed to update vpn cidr: %s", err)
 			}
 		} else {
-			log.Printf("[INFO] can't update vpn cidr because vpn_access is disabled for gateway: %#v", gateway.GwName)
+			tflog.Info(ctx, "can't update vpn cidr because vpn_access is disabled for gateway", map[string]interface{}{"gw_name": gateway.GwName})
 		}
 	}
 	if d.HasChange("max_vpn_conn") {
@@ -2034,10 +2194,10 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			}
 			err := client.UpdateMaxVpnConn(gw)
 			if err != nil {
-				return fmt.Errorf("failed to update max vpn connections: %s", err)
+				return diag.Errorf("failed to update max vpn connections: %s", err)
 			}
 		} else {
-			log.Printf("[INFO] can't update max vpn connections because vpn is disabled for gateway: %#v", gateway.GwName)
+			tflog.Info(ctx, "can't update max vpn connections because vpn is disabled for gateway", map[string]interface{}{"gw_name": gateway.GwName})
 		}
 	}
 
@@ -2045,7 +2205,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("peering_ha_subnet") || d.HasChange("peering_ha_zone") || d.HasChange("peering_ha_insane_mode_az") ||
 		d.HasChange("peering_ha_availability_domain") || d.HasChange("peering_ha_fault_domain") {
 		if d.Get("enable_designated_gateway").(bool) {
-			return fmt.Errorf("can't update HA status for gateway with 'designated_gateway' enabled")
+			return diag.Errorf("can't update HA status for gateway with 'designated_gateway' enabled")
 		}
 		gw := &goaviatrix.Gateway{
 			Eip:       d.Get("peering_ha_eip").(string),
@@ -2060,13 +2220,13 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 				// No change will be detected when peering_ha_eip is set to the empty string because it is computed.
 				// Instead, check peering_ha_gw_size to detect when HA gateway is being deleted.
 				if !haAzureEipNameOk {
-					return fmt.Errorf("failed to create Peering HA Gateway: 'peering_ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create Peering HA Gateway: 'peering_ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 				gw.Eip = fmt.Sprintf("%s:%s", haAzureEipName.(string), gw.Eip)
 			}
 		} else if haAzureEipNameOk {
-			return fmt.Errorf("failed to create Peering HA Gateway: 'peering_ha_azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+			return diag.Errorf("failed to create Peering HA Gateway: 'peering_ha_azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 		}
 
 		oldSubnet, newSubnet := d.GetChange("peering_ha_subnet")
@@ -2083,10 +2243,10 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			peeringHaFaultDomain := d.Get("peering_ha_fault_domain").(string)
 			if newSubnet != "" {
 				if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (peeringHaAvailabilityDomain == "" || peeringHaFaultDomain == "") {
-					return fmt.Errorf("'peering_ha_availability_domain' and 'peering_ha_fault_domain' are required to enable Peering HA on OCI")
+					return diag.Errorf("'peering_ha_availability_domain' and 'peering_ha_fault_domain' are required to enable Peering HA on OCI")
 				}
 				if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (peeringHaAvailabilityDomain != "" || peeringHaFaultDomain != "") {
-					return fmt.Errorf("'peering_ha_availability_domain' and 'peering_ha_fault_domain' are only valid for OCI")
+					return diag.Errorf("'peering_ha_availability_domain' and 'peering_ha_fault_domain' are only valid for OCI")
 				}
 			}
 			if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.OCIRelatedCloudTypes) {
@@ -2120,10 +2280,10 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			peeringHaSubnet := d.Get("peering_ha_subnet").(string)
 
 			if peeringHaInsaneModeAz == "" && peeringHaSubnet != "" {
-				return fmt.Errorf("peering_ha_insane_mode_az needed if insane_mode is enabled and peering_ha_subnet " +
+				return diag.Errorf("peering_ha_insane_mode_az needed if insane_mode is enabled and peering_ha_subnet " +
 					"is set for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 			} else if peeringHaInsaneModeAz != "" && peeringHaSubnet == "" {
-				return fmt.Errorf("peering_ha_subnet needed if insane_mode is enabled and peering_ha_insane_mode_az " +
+				return diag.Errorf("peering_ha_subnet needed if insane_mode is enabled and peering_ha_insane_mode_az " +
 					"is set for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 			}
 
@@ -2132,10 +2292,10 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		if (newHaGwEnabled || changeHaGw) && gw.VpcSize == "" {
-			return fmt.Errorf("A valid non empty peering_ha_gw_size parameter is mandatory for this resource if " +
+			return diag.Errorf("A valid non empty peering_ha_gw_size parameter is mandatory for this resource if " +
 				"peering_ha_subnet or peering_ha_zone is set")
 		} else if deleteHaGw && gw.VpcSize != "" {
-			return fmt.Errorf("peering_ha_gw_size must be empty if transit HA gateway is deleted")
+			return diag.Errorf("peering_ha_gw_size must be empty if transit HA gateway is deleted")
 		}
 
 		if d.Get("enable_public_subnet_filtering").(bool) {
@@ -2148,17 +2308,17 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			if newHaGwEnabled {
 				err := client.EnablePublicSubnetFilteringHAGateway(gw)
 				if err != nil {
-					return fmt.Errorf("failed to enable Aviatrix public subnet filtering HA gateway: %s", err)
+					return diag.Errorf("failed to enable Aviatrix public subnet filtering HA gateway: %s", err)
 				}
 			} else if deleteHaGw {
 				err := client.DeletePublicSubnetFilteringGateway(peeringHaGateway)
 				if err != nil {
-					return fmt.Errorf("failed to delete Aviatrix public subnet filtering HA gateway: %s", err)
+					return diag.Errorf("failed to delete Aviatrix public subnet filtering HA gateway: %s", err)
 				}
 			} else if changeHaGw {
 				err := client.DeletePublicSubnetFilteringGateway(peeringHaGateway)
 				if err != nil {
-					return fmt.Errorf("failed to delete Aviatrix public subnet filtering HA gateway: %s", err)
+					return diag.Errorf("failed to delete Aviatrix public subnet filtering HA gateway: %s", err)
 				}
 
 				gw.Eip = ""
@@ -2166,7 +2326,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 				gateway.GwName = d.Get("gw_name").(string)
 				err = client.EnablePublicSubnetFilteringHAGateway(gw)
 				if err != nil {
-					return fmt.Errorf("failed to enable Aviatrix public subnet filtering HA gateway: %s", err)
+					return diag.Errorf("failed to enable Aviatrix public subnet filtering HA gateway: %s", err)
 				}
 
 				newHaGwEnabled = true
@@ -2175,7 +2335,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			if newHaGwEnabled {
 				err := client.EnablePeeringHaGateway(gw)
 				if err != nil {
-					return fmt.Errorf("failed to enable Aviatrix peering HA gateway: %s", err)
+					return diag.Errorf("failed to enable Aviatrix peering HA gateway: %s", err)
 				}
 				if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 					if d.Get("rx_queue_size").(string) != "" && !d.HasChange("rx_queue_size") {
@@ -2185,19 +2345,19 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 						}
 						err := client.SetRxQueueSize(haGwRxQueueSize)
 						if err != nil {
-							return fmt.Errorf("could not set rx queue size for gateway ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
+							return diag.Errorf("could not set rx queue size for gateway ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
 						}
 					}
 				}
 			} else if deleteHaGw {
-				err := client.DeleteGateway(peeringHaGateway)
+				err := client.DeleteGatewayContext(ctx, peeringHaGateway)
 				if err != nil {
-					return fmt.Errorf("failed to delete Aviatrix peering HA gateway: %s", err)
+					return diag.Errorf("failed to delete Aviatrix peering HA gateway: %s", err)
 				}
 			} else if changeHaGw {
-				err := client.DeleteGateway(peeringHaGateway)
+				err := client.DeleteGatewayContext(ctx, peeringHaGateway)
 				if err != nil {
-					return fmt.Errorf("failed to delete Aviatrix peering HA gateway: %s", err)
+					return diag.Errorf("failed to delete Aviatrix peering HA gateway: %s", err)
 				}
 
 				gw.Eip = ""
@@ -2205,7 +2365,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 				gateway.GwName = d.Get("gw_name").(string)
 				haErr := client.EnablePeeringHaGateway(gw)
 				if haErr != nil {
-					return fmt.Errorf("failed to enable Aviatrix peering HA gateway: %s", haErr)
+					return diag.Errorf("failed to enable Aviatrix peering HA gateway: %s", haErr)
 				}
 
 				newHaGwEnabled = true
@@ -2228,11 +2388,11 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		if singleAZ {
-			log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, "Enable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 
 			err := client.EnableSingleAZGateway(singleAZGateway)
 			if err != nil {
-				return fmt.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
+				return diag.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
 			}
 
 			if haEnabled {
@@ -2241,14 +2401,14 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 				}
 				err := client.EnableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
-					return fmt.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
+					return diag.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
 				}
 			}
 		} else {
-			log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, "Disable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 			err := client.DisableSingleAZGateway(singleAZGateway)
 			if err != nil {
-				return fmt.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
+				return diag.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
 			}
 
 			if haEnabled {
@@ -2257,7 +2417,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 				}
 				err := client.DisableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
-					return fmt.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
+					return diag.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
 				}
 			}
 		}
@@ -2271,21 +2431,21 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			// OR
 			// newly configured peering HA gateway is set to be different size than primary gateway
 			// (when peering ha gateway is enabled, it's size is by default the same as primary gateway)
-			_, err := client.GetGateway(peeringHaGateway)
+			_, err := client.GetGatewayContext(ctx, peeringHaGateway)
 			if err != nil {
 				if err != goaviatrix.ErrNotFound {
-					return fmt.Errorf("couldn't find Aviatrix Peering HA Gateway while trying to update HA Gw "+
+					return diag.Errorf("couldn't find Aviatrix Peering HA Gateway while trying to update HA Gw "+
 						"size: %s", err)
 				}
 			} else {
 				if peeringHaGateway.VpcSize == "" {
-					return fmt.Errorf("A valid non empty peering_ha_gw_size parameter is mandatory for this resource if " +
+					return diag.Errorf("A valid non empty peering_ha_gw_size parameter is mandatory for this resource if " +
 						"peering_ha_subnet or peering_ha_zone is set. Example: t2.micro or us-west1-b respectively")
 				}
-				err = client.UpdateGateway(peeringHaGateway)
-				log.Printf("[INFO] Updating Peering HA Gateway size to: %s ", peeringHaGateway.VpcSize)
+				err = client.UpdateGatewayContext(ctx, peeringHaGateway)
+				tflog.Info(ctx, "Updating Peering HA Gateway size", map[string]interface{}{"vpc_size": peeringHaGateway.VpcSize})
 				if err != nil {
-					return fmt.Errorf("failed to update Aviatrix Peering HA Gw size: %s", err)
+					return diag.Errorf("failed to update Aviatrix Peering HA Gw size: %s", err)
 				}
 			}
 		}
@@ -2301,22 +2461,22 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		if enableVpcDnsServer {
 			err := client.EnableVpcDnsServer(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable VPC DNS Server: %s", err)
+				return diag.Errorf("failed to enable VPC DNS Server: %s", err)
 			}
 		} else {
 			err := client.DisableVpcDnsServer(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable VPC DNS Server: %s", err)
+				return diag.Errorf("failed to disable VPC DNS Server: %s", err)
 			}
 		}
 
 	} else if d.HasChange("enable_vpc_dns_server") {
-		return fmt.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192)")
+		return diag.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192)")
 	}
 
 	if d.HasChange("enable_vpn_nat") {
 		if !vpnAccess {
-			return fmt.Errorf("'enable_vpc_nat' is only supported for vpn gateway. Can't updated it for Non VPN Gateway")
+			return diag.Errorf("'enable_vpc_nat' is only supported for vpn gateway. Can't updated it for Non VPN Gateway")
 		} else {
 			gw := &goaviatrix.Gateway{
 				CloudType:    d.Get("cloud_type").(int),
@@ -2333,12 +2493,12 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			if d.Get("enable_vpn_nat").(bool) {
 				err := client.EnableVpnNat(gw)
 				if err != nil {
-					return fmt.Errorf("failed to enable VPN NAT: %s", err)
+					return diag.Errorf("failed to enable VPN NAT: %s", err)
 				}
 			} else if !d.Get("enable_vpn_nat").(bool) {
 				err := client.DisableVpnNat(gw)
 				if err != nil {
-					return fmt.Errorf("failed to disable VPN NAT: %s", err)
+					return diag.Errorf("failed to disable VPN NAT: %s", err)
 				}
 			}
 		}
@@ -2347,7 +2507,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("enable_encrypt_volume") {
 		if d.Get("enable_encrypt_volume").(bool) {
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) provider")
+				return diag.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) provider")
 			}
 			gwEncVolume := &goaviatrix.Gateway{
 				GwName:              d.Get("gw_name").(string),
@@ -2355,7 +2515,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			}
 			err := client.EnableEncryptVolume(gwEncVolume)
 			if err != nil {
-				return fmt.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwEncVolume.GwName, err)
+				return diag.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwEncVolume.GwName, err)
 			}
 
 			haSubnet := d.Get("peering_ha_subnet").(string)
@@ -2368,14 +2528,14 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 				}
 				err := client.EnableEncryptVolume(gwHAEncVolume)
 				if err != nil {
-					return fmt.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwHAEncVolume.GwName, err)
+					return diag.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwHAEncVolume.GwName, err)
 				}
 			}
 		} else {
-			return fmt.Errorf("can't disable Encrypt Volume for gateway: %s", gateway.GwName)
+			return diag.Errorf("can't disable Encrypt Volume for gateway: %s", gateway.GwName)
 		}
 	} else if d.HasChange("customer_managed_keys") {
-		return fmt.Errorf("updating customer_managed_keys only is not allowed")
+		return diag.Errorf("updating customer_managed_keys only is not allowed")
 	}
 
 	monitorGatewaySubnets := d.Get("enable_monitor_gateway_subnets").(bool)
@@ -2384,28 +2544,28 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		excludedInstances = append(excludedInstances, v.(string))
 	}
 	if !monitorGatewaySubnets && len(excludedInstances) != 0 {
-		return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
+		return diag.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
 	}
 	if d.HasChange("enable_monitor_gateway_subnets") {
 		if monitorGatewaySubnets {
 			err := client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
 			if err != nil {
-				return fmt.Errorf("could not enable monitor gateway subnets: %v", err)
+				return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 			}
 		} else {
 			err := client.DisableMonitorGatewaySubnets(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not disable monitor gateway subnets: %v", err)
+				return diag.Errorf("could not disable monitor gateway subnets: %v", err)
 			}
 		}
 	} else if d.HasChange("monitor_exclude_list") {
 		err := client.DisableMonitorGatewaySubnets(gateway.GwName)
 		if err != nil {
-			return fmt.Errorf("could not disable monitor gateway subnets: %v", err)
+			return diag.Errorf("could not disable monitor gateway subnets: %v", err)
 		}
 		err = client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
 		if err != nil {
-			return fmt.Errorf("could not enable monitor gateway subnets: %v", err)
+			return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 		}
 	}
 
@@ -2425,16 +2585,16 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 		if idleTimeoutValue != -1 {
 			VPNServer.Value = strconv.Itoa(idleTimeoutValue)
-			log.Printf("[INFO] Modify VPN Config (update idle timeout value)")
+			tflog.Info(ctx, "Modify VPN Config (update idle timeout value)")
 			err := client.EnableVPNConfig(gatewayServer, VPNServer)
 			if err != nil {
-				return fmt.Errorf("fail to update idle timeout value due to : %s", err)
+				return diag.Errorf("fail to update idle timeout value due to : %s", err)
 			}
 		} else {
-			log.Printf("[INFO] Modify VPN Config (disable idle timeout)")
+			tflog.Info(ctx, "Modify VPN Config (disable idle timeout)")
 			err := client.DisableVPNConfig(gatewayServer, VPNServer)
 			if err != nil {
-				return fmt.Errorf("fail to disable idle timeout due to : %s", err)
+				return diag.Errorf("fail to disable idle timeout due to : %s", err)
 			}
 		}
 	}
@@ -2451,16 +2611,16 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 		if renegoIntervalValue != -1 {
 			VPNServer.Value = strconv.Itoa(renegoIntervalValue)
-			log.Printf("[INFO] Modify VPN Config (update renegotiation interval value)")
+			tflog.Info(ctx, "Modify VPN Config (update renegotiation interval value)")
 			err := client.EnableVPNConfig(gatewayServer, VPNServer)
 			if err != nil {
-				return fmt.Errorf("fail to enable renegotiation interval due to : %s", err)
+				return diag.Errorf("fail to enable renegotiation interval due to : %s", err)
 			}
 		} else {
-			log.Printf("[INFO] Modify VPN Config (disable renegotiation interval)")
+			tflog.Info(ctx, "Modify VPN Config (disable renegotiation interval)")
 			err := client.DisableVPNConfig(gatewayServer, VPNServer)
 			if err != nil {
-				return fmt.Errorf("fail to disable renegotiation interval due to: %s", err)
+				return diag.Errorf("fail to disable renegotiation interval due to: %s", err)
 			}
 		}
 	}
@@ -2475,11 +2635,11 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			routeTables = append(routeTables, v.(string))
 		}
 		if len(routeTables) == 0 {
-			return fmt.Errorf("attribute 'public_subnet_filtering_route_tables' must not be empty if 'enable_public_subnet_filtering' is set to true")
+			return diag.Errorf("attribute 'public_subnet_filtering_route_tables' must not be empty if 'enable_public_subnet_filtering' is set to true")
 		}
 		err := client.EditPublicSubnetFilteringRouteTableList(gatewayServer, routeTables)
 		if err != nil {
-			return fmt.Errorf("could not edit public subnet filtering route table rules: %v", err)
+			return diag.Errorf("could not edit public subnet filtering route table rules: %v", err)
 		}
 	}
 	if d.HasChange("public_subnet_filtering_ha_route_tables") && !d.HasChange("peering_ha_subnet") && d.Get("peering_ha_subnet").(string) != "" {
@@ -2490,19 +2650,19 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		peeringHaGateway.RouteTable = strings.Join(haRouteTables, ",")
 		err := client.EditPublicSubnetFilteringRouteTableList(peeringHaGateway, haRouteTables)
 		if err != nil {
-			return fmt.Errorf("could not edit HA public subnet filtering route table rules: %v", err)
+			return diag.Errorf("could not edit HA public subnet filtering route table rules: %v", err)
 		}
 	}
 	if d.HasChange("public_subnet_filtering_guard_duty_enforced") {
 		if d.Get("public_subnet_filtering_guard_duty_enforced").(bool) {
 			err := client.EnableGuardDutyEnforcement(gatewayServer)
 			if err != nil {
-				return fmt.Errorf("could not enable public subnet filtering guard duty enforcement: %v", err)
+				return diag.Errorf("could not enable public subnet filtering guard duty enforcement: %v", err)
 			}
 		} else {
 			err := client.DisableGuardDutyEnforcement(gatewayServer)
 			if err != nil {
-				return fmt.Errorf("could not disable public subnet filtering guard duty enforcement: %v", err)
+				return diag.Errorf("could not disable public subnet filtering guard duty enforcement: %v", err)
 			}
 		}
 	}
@@ -2511,12 +2671,12 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		if d.Get("enable_jumbo_frame").(bool) {
 			err := client.EnableJumboFrame(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't enable jumbo frames for Gateway when updating: %s", err)
+				return diag.Errorf("couldn't enable jumbo frames for Gateway when updating: %s", err)
 			}
 		} else {
 			err := client.DisableJumboFrame(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't disable jumbo frames for Gateway when updating: %s", err)
+				return diag.Errorf("couldn't disable jumbo frames for Gateway when updating: %s", err)
 			}
 		}
 	}
@@ -2525,12 +2685,12 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		if d.Get("enable_gro_gso").(bool) {
 			err := client.EnableGroGso(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't enable GRO/GSO on gateway when updating: %s", err)
+				return diag.Errorf("couldn't enable GRO/GSO on gateway when updating: %s", err)
 			}
 		} else {
 			err := client.DisableGroGso(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't disable GRO/GSO on gateway when updating: %s", err)
+				return diag.Errorf("couldn't disable GRO/GSO on gateway when updating: %s", err)
 			}
 		}
 	}
@@ -2543,18 +2703,18 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		} else {
 			detectionTime, err = client.GetTunnelDetectionTime("Controller")
 			if err != nil {
-				return fmt.Errorf("could not get default tunnel detection time during Gateway update: %v", err)
+				return diag.Errorf("could not get default tunnel detection time during Gateway update: %v", err)
 			}
 		}
 		err := client.ModifyTunnelDetectionTime(gateway.GwName, detectionTime)
 		if err != nil {
-			return fmt.Errorf("could not modify tunnel detection time during Gateway update: %v", err)
+			return diag.Errorf("could not modify tunnel detection time during Gateway update: %v", err)
 		}
 	}
 
 	if d.HasChange("rx_queue_size") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("could not update rx_queue_size since it only supports AWS related cloud types")
+			return diag.Errorf("could not update rx_queue_size since it only supports AWS related cloud types")
 		}
 		gw := &goaviatrix.Gateway{
 			GwName:      gateway.GwName,
@@ -2562,7 +2722,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 		err := client.SetRxQueueSize(gw)
 		if err != nil {
-			return fmt.Errorf("could not modify rx queue size for gateway: %s during gateway update: %v", gw.GatewayName, err)
+			return diag.Errorf("could not modify rx queue size for gateway: %s during gateway update: %v", gw.GatewayName, err)
 		}
 		if haSubnet != "" || haZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
@@ -2571,18 +2731,18 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta interface{}) err
 			}
 			err := client.SetRxQueueSize(haGwRxQueueSize)
 			if err != nil {
-				return fmt.Errorf("could not modify rx queue size for gateway ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
+				return diag.Errorf("could not modify rx queue size for gateway ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
 			}
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(gateway.GwName)
-	return resourceAviatrixGatewayRead(d, meta)
+	return resourceAviatrixGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	gateway := &goaviatrix.Gateway{
 		CloudType: d.Get("cloud_type").(int),
 		GwName:    d.Get("gw_name").(string),
@@ -2595,30 +2755,30 @@ func resourceAviatrixGatewayDelete(d *schema.ResourceData, meta interface{}) err
 	if peeringHaSubnet != "" || peeringHaZone != "" {
 		// Delete backup gateway first
 		gateway.GwName += "-hagw"
-		log.Printf("[INFO] Deleting Aviatrix Backup Gateway [-hagw]: %#v", gateway)
+		tflog.Info(ctx, "Deleting Aviatrix Backup Gateway [-hagw]", map[string]interface{}{"gw_name": gateway.GwName})
 
 		if isPublicSubnetFilteringGateway {
 			err = client.DeletePublicSubnetFilteringGateway(gateway)
 		} else {
-			err = client.DeleteGateway(gateway)
+			err = client.DeleteGatewayContext(ctx, gateway)
 		}
 
 		if err != nil {
-			return fmt.Errorf("failed to delete backup [-hgw] gateway: %s", err)
+			return diag.Errorf("failed to delete backup [-hgw] gateway: %s", err)
 		}
 	}
 
 	gateway.GwName = d.Get("gw_name").(string)
 
-	log.Printf("[INFO] Deleting Aviatrix gateway: %#v", gateway)
+	tflog.Info(ctx, "Deleting Aviatrix gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
 	if isPublicSubnetFilteringGateway {
 		err = client.DeletePublicSubnetFilteringGateway(gateway)
 	} else {
-		err = client.DeleteGateway(gateway)
+		err = client.DeleteGatewayContext(ctx, gateway)
 	}
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Gateway: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Gateway: %s", err)
 	}
 
 	return nil
@@ -1,9 +1,10 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -12,12 +13,12 @@ import (
 
 func resourceAviatrixGatewayDNat() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixGatewayDNatCreate,
-		Read:   resourceAviatrixGatewayDNatRead,
-		Update: resourceAviatrixGatewayDNatUpdate,
-		Delete: resourceAviatrixGatewayDNatDelete,
+		CreateContext: resourceAviatrixGatewayDNatCreate,
+		ReadContext:   resourceAviatrixGatewayDNatRead,
+		UpdateContext: resourceAviatrixGatewayDNatUpdate,
+		DeleteContext: resourceAviatrixGatewayDNatDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -231,8 +232,8 @@ func resourceAviatrixGatewayDNat() *schema.Resource {
 	}
 }
 
-func resourceAviatrixGatewayDNatCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGatewayDNatCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		GatewayName: d.Get("gw_name").(string),
@@ -262,31 +263,31 @@ func resourceAviatrixGatewayDNatCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(gateway.GatewayName)
 	flag := false
-	defer resourceAviatrixGatewayDNatReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixGatewayDNatReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.UpdateDNat(gateway)
+	err := client.UpdateDNatContext(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to update DNAT for gateway(name: %s) due to: %s", gateway.GatewayName, err)
+		return diag.Errorf("failed to update DNAT for gateway(name: %s) due to: %s", gateway.GatewayName, err)
 	}
 
-	return resourceAviatrixGatewayDNatReadIfRequired(d, meta, &flag)
+	return resourceAviatrixGatewayDNatReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixGatewayDNatReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixGatewayDNatReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixGatewayDNatRead(d, meta)
+		return resourceAviatrixGatewayDNatRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixGatewayDNatRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGatewayDNatRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gwName := d.Get("gw_name").(string)
 	if gwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no gateway name received", map[string]interface{}{"id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -295,22 +296,22 @@ func resourceAviatrixGatewayDNatRead(d *schema.ResourceData, meta interface{}) e
 		GwName: d.Get("gw_name").(string),
 	}
 
-	gw, err := client.GetGateway(gateway)
+	gw, err := client.GetGatewayContext(ctx, gateway)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix gateway: %s", err)
 	}
 
-	log.Printf("[TRACE] reading gateway %s: %#v", d.Get("gw_name").(string), gw)
+	tflog.Trace(ctx, "reading gateway", map[string]interface{}{"gw_name": d.Get("gw_name").(string)})
 	if gw != nil {
 		d.Set("gw_name", gw.GwName)
 
-		gwDetail, err := client.GetGatewayDetail(gateway)
+		gwDetail, err := client.GetGatewayDetailContext(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("couldn't get detail information of Aviatrix gateway(name: %s) due to: %s", gw.GwName, err)
+			return diag.Errorf("couldn't get detail information of Aviatrix gateway(name: %s) due to: %s", gw.GwName, err)
 		}
 		if len(gwDetail.DnatPolicy) != 0 {
 			var dnatPolicy []map[string]interface{}
@@ -342,15 +343,15 @@ func resourceAviatrixGatewayDNatRead(d *schema.ResourceData, meta interface{}) e
 			}
 
 			if err := d.Set("dnat_policy", dnatPolicy); err != nil {
-				log.Printf("[WARN] Error setting 'dnat_policy' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, "Error setting 'dnat_policy'", map[string]interface{}{"id": d.Id(), "error": err})
 			}
 
 			if err := d.Set("connection_policy", connectionPolicy); err != nil {
-				log.Printf("[WARN] Error setting 'connection_policy' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, "Error setting 'connection_policy'", map[string]interface{}{"id": d.Id(), "error": err})
 			}
 
 			if err := d.Set("interface_policy", interfacePolicy); err != nil {
-				log.Printf("[WARN] Error setting 'interface_policy' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, "Error setting 'interface_policy'", map[string]interface{}{"id": d.Id(), "error": err})
 			}
 
 		} else {
@@ -362,10 +363,10 @@ func resourceAviatrixGatewayDNatRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceAviatrixGatewayDNatUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGatewayDNatUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	log.Printf("[INFO] Updating Aviatrix gateway: %#v", d.Get("gw_name").(string))
+	tflog.Info(ctx, "Updating Aviatrix gateway", map[string]interface{}{"gw_name": d.Get("gw_name").(string)})
 
 	d.Partial(true)
 	gateway := &goaviatrix.Gateway{
@@ -394,27 +395,27 @@ func resourceAviatrixGatewayDNatUpdate(d *schema.ResourceData, meta interface{})
 				gateway.DnatPolicy = append(gateway.DnatPolicy, *customPolicy)
 			}
 		}
-		err := client.UpdateDNat(gateway)
+		err := client.UpdateDNatContext(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to update DNAT for gateway(name: %s) due to: %s", gateway.GatewayName, err)
+			return diag.Errorf("failed to update DNAT for gateway(name: %s) due to: %s", gateway.GatewayName, err)
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(gateway.GatewayName)
-	return resourceAviatrixGatewayDNatRead(d, meta)
+	return resourceAviatrixGatewayDNatRead(ctx, d, meta)
 }
 
-func resourceAviatrixGatewayDNatDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGatewayDNatDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	gateway := &goaviatrix.Gateway{
 		GatewayName: d.Get("gw_name").(string),
 		DnatPolicy:  make([]goaviatrix.PolicyRule, 0),
 	}
 
-	err := client.UpdateDNat(gateway)
+	err := client.UpdateDNatContext(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to update DNAT to nil for Aviatrix gateway(name: %s) due to: %s", gateway.GatewayName, err)
+		return diag.Errorf("failed to update DNAT to nil for Aviatrix gateway(name: %s) due to: %s", gateway.GatewayName, err)
 	}
 
 	return nil
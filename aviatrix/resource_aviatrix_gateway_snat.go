@@ -1,9 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -12,12 +14,12 @@ import (
 
 func resourceAviatrixGatewaySNat() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixGatewaySNatCreate,
-		Read:   resourceAviatrixGatewaySNatRead,
-		Update: resourceAviatrixGatewaySNatUpdate,
-		Delete: resourceAviatrixGatewaySNatDelete,
+		CreateContext: resourceAviatrixGatewaySNatCreate,
+		ReadContext:   resourceAviatrixGatewaySNatRead,
+		UpdateContext: resourceAviatrixGatewaySNatUpdate,
+		DeleteContext: resourceAviatrixGatewaySNatDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -245,15 +247,15 @@ func resourceAviatrixGatewaySNat() *schema.Resource {
 	}
 }
 
-func resourceAviatrixGatewaySNatCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGatewaySNatCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		GatewayName: d.Get("gw_name").(string),
 	}
 
 	if len(d.Get("snat_policy").([]interface{})) == 0 {
-		return fmt.Errorf("please specify 'snat_policy' for 'snat_mode' of 'customized_snat'")
+		return diag.Errorf("please specify 'snat_policy' for 'snat_mode' of 'customized_snat'")
 	}
 	gateway.EnableNat = "yes"
 	gateway.SnatMode = "custom"
@@ -282,31 +284,31 @@ func resourceAviatrixGatewaySNatCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(gateway.GatewayName)
 	flag := false
-	defer resourceAviatrixGatewaySNatReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixGatewaySNatReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.EnableCustomizedSNat(gateway)
+	err := client.EnableCustomizedSNatContext(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to configure policies for 'customized_snat' mode due to: %s", err)
+		return diag.Errorf("failed to configure policies for 'customized_snat' mode due to: %s", err)
 	}
 
-	return resourceAviatrixGatewaySNatReadIfRequired(d, meta, &flag)
+	return resourceAviatrixGatewaySNatReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixGatewaySNatReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixGatewaySNatReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixGatewaySNatRead(d, meta)
+		return resourceAviatrixGatewaySNatRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixGatewaySNatRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGatewaySNatRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gwName := d.Get("gw_name").(string)
 	if gwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no gateway name received", map[string]interface{}{"id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -315,22 +317,22 @@ func resourceAviatrixGatewaySNatRead(d *schema.ResourceData, meta interface{}) e
 		GwName: d.Get("gw_name").(string),
 	}
 
-	gw, err := client.GetGateway(gateway)
+	gw, err := client.GetGatewayContext(ctx, gateway)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix gateway: %s", err)
 	}
 
-	log.Printf("[TRACE] reading gateway %s: %#v", d.Get("gw_name").(string), gw)
+	tflog.Trace(ctx, "reading gateway", map[string]interface{}{"gw_name": d.Get("gw_name").(string)})
 	if gw != nil {
 		d.Set("gw_name", gw.GwName)
 
-		gwDetail, err := client.GetGatewayDetail(gateway)
+		gwDetail, err := client.GetGatewayDetailContext(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("couldn't get detail information of Aviatrix gateway(name: %s) due to: %s", gw.GwName, err)
+			return diag.Errorf("couldn't get detail information of Aviatrix gateway(name: %s) due to: %s", gw.GwName, err)
 		}
 		if gw.NatEnabled && gw.SnatMode == "customized" {
 			d.Set("snat_mode", "customized_snat")
@@ -377,15 +379,15 @@ func resourceAviatrixGatewaySNatRead(d *schema.ResourceData, meta interface{}) e
 			}
 
 			if err := d.Set("snat_policy", snatPolicy); err != nil {
-				log.Printf("[WARN] Error setting 'snat_policy' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, "Error setting 'snat_policy'", map[string]interface{}{"id": d.Id(), "error": err})
 			}
 
 			if err := d.Set("connection_policy", connectionPolicy); err != nil {
-				log.Printf("[WARN] Error setting 'connection_policy' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, "Error setting 'connection_policy'", map[string]interface{}{"id": d.Id(), "error": err})
 			}
 
 			if err := d.Set("interface_policy", interfacePolicy); err != nil {
-				log.Printf("[WARN] Error setting 'interface_policy' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, "Error setting 'interface_policy'", map[string]interface{}{"id": d.Id(), "error": err})
 			}
 		} else {
 			d.SetId("")
@@ -396,10 +398,10 @@ func resourceAviatrixGatewaySNatRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceAviatrixGatewaySNatUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGatewaySNatUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	log.Printf("[INFO] Updating Aviatrix gateway: %#v", d.Get("gw_name").(string))
+	tflog.Info(ctx, "Updating Aviatrix gateway", map[string]interface{}{"gw_name": d.Get("gw_name").(string)})
 
 	d.Partial(true)
 	gateway := &goaviatrix.Gateway{
@@ -408,7 +410,7 @@ func resourceAviatrixGatewaySNatUpdate(d *schema.ResourceData, meta interface{})
 
 	if d.HasChange("snat_policy") {
 		if len(d.Get("snat_policy").([]interface{})) == 0 {
-			return fmt.Errorf("please specify 'snat_policy' for 'snat_mode' of 'customized_snat'")
+			return diag.Errorf("please specify 'snat_policy' for 'snat_mode' of 'customized_snat'")
 		}
 
 		gateway.SnatMode = "custom"
@@ -434,27 +436,27 @@ func resourceAviatrixGatewaySNatUpdate(d *schema.ResourceData, meta interface{})
 			}
 		}
 
-		err := client.EnableCustomizedSNat(gateway)
+		err := client.EnableCustomizedSNatContext(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to enable SNAT of 'customized_snat': %s", err)
+			return diag.Errorf("failed to enable SNAT of 'customized_snat': %s", err)
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(gateway.GatewayName)
-	return resourceAviatrixGatewaySNatRead(d, meta)
+	return resourceAviatrixGatewaySNatRead(ctx, d, meta)
 }
 
-func resourceAviatrixGatewaySNatDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixGatewaySNatDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	gateway := &goaviatrix.Gateway{
 		GatewayName: d.Get("gw_name").(string),
 		SnatMode:    "custom",
 	}
 
-	err := client.DisableCustomSNat(gateway)
+	err := client.DisableCustomSNatContext(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to disable SNAT for Aviatrix gateway(name: %s) due to: %s", gateway.GatewayName, err)
+		return diag.Errorf("failed to disable SNAT for Aviatrix gateway(name: %s) due to: %s", gateway.GatewayName, err)
 	}
 
 	return nil
@@ -0,0 +1,138 @@
+package aviatrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceAviatrixGatewayCustomizeDiff(t *testing.T) {
+	baseConfig := func(changes map[string]interface{}) map[string]interface{} {
+		config := map[string]interface{}{
+			"cloud_type":   1,
+			"account_name": "aws-account",
+			"gw_name":      "gw",
+			"vpc_id":       "vpc-0123456789",
+			"vpc_reg":      "us-west-1",
+			"gw_size":      "t3.small",
+			"subnet":       "10.3.0.0/24",
+		}
+		for k, v := range changes {
+			config[k] = v
+		}
+		return config
+	}
+
+	tests := []struct {
+		name    string
+		state   map[string]string
+		config  map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "valid VPN gateway with LDAP",
+			config: baseConfig(map[string]interface{}{
+				"vpn_access":              true,
+				"vpn_cidr":                "192.168.43.0/24",
+				"enable_ldap":             true,
+				"ldap_server":             "10.10.10.10:389",
+				"ldap_bind_dn":            "CN=admin,DC=example,DC=com",
+				"ldap_password":           "password",
+				"ldap_base_dn":            "DC=example,DC=com",
+				"ldap_username_attribute": "sAMAccountName",
+			}),
+		},
+		{
+			name: "ELB without VPN access",
+			config: baseConfig(map[string]interface{}{
+				"enable_elb": true,
+			}),
+			wantErr: "can not enable elb without VPN access enabled",
+		},
+		{
+			name: "LDAP without server",
+			config: baseConfig(map[string]interface{}{
+				"vpn_access":  true,
+				"enable_ldap": true,
+			}),
+			wantErr: "ldap server must be set if ldap is enabled",
+		},
+		{
+			name: "SAML with MFA",
+			config: baseConfig(map[string]interface{}{
+				"vpn_access":   true,
+				"saml_enabled": true,
+				"otp_mode":     "2",
+			}),
+			wantErr: "ldap and mfa can't be configured if saml is enabled",
+		},
+		{
+			name: "invalid otp_mode",
+			config: baseConfig(map[string]interface{}{
+				"vpn_access": true,
+				"otp_mode":   "1",
+			}),
+			wantErr: "otp_mode can only be '2' or '3' or empty string",
+		},
+		{
+			name: "okta without token",
+			config: baseConfig(map[string]interface{}{
+				"vpn_access": true,
+				"otp_mode":   "3",
+				"okta_url":   "https://example.okta.com",
+			}),
+			wantErr: "okta token must be set if otp_mode is set to 3",
+		},
+		{
+			name: "GCP FQDN gateway with only fqdn_lan_cidr",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type":    4,
+				"fqdn_lan_cidr": "10.3.5.0/24",
+			}),
+			wantErr: "both 'fqdn_lan_cidr' and 'fqdn_lan_vpc_id' must be set",
+		},
+		{
+			name: "GCP peering HA without peering_ha_zone",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type":        4,
+				"peering_ha_subnet": "10.3.1.0/24",
+			}),
+			wantErr: "'peering_ha_zone' must be set to enable Peering HA on GCP",
+		},
+		{
+			name: "designated gateway with peering HA",
+			config: baseConfig(map[string]interface{}{
+				"enable_designated_gateway": true,
+				"peering_ha_subnet":         "10.3.1.0/24",
+			}),
+			wantErr: "can't enable HA for gateway with 'designated_gateway' enabled",
+		},
+		{
+			name: "insane mode on GCP",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type":  4,
+				"insane_mode": true,
+			}),
+			wantErr: "insane_mode is only supported for AWS (1), Azure (8)",
+		},
+		{
+			name: "Azure insane mode without /26 subnet",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type":  8,
+				"insane_mode": true,
+			}),
+			wantErr: "'subnet' must be a /26 CIDR segment",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testPlanDiff(resourceAviatrixGateway(), tt.state, tt.config)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -54,7 +54,7 @@ func marshalGlobalVpcExcludedInstanceInput(d *schema.ResourceData) *goaviatrix.G
 }
 
 func resourceAviatrixGlobalVpcExcludedInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	globalVpcExcludedInstance := marshalGlobalVpcExcludedInstanceInput(d)
 
@@ -79,7 +79,7 @@ func resourceAviatrixGlobalVpcExcludedInstanceReadIfRequired(ctx context.Context
 }
 
 func resourceAviatrixGlobalVpcExcludedInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	uuid := d.Id()
 	d.Set("uuid", uuid)
@@ -101,7 +101,7 @@ func resourceAviatrixGlobalVpcExcludedInstanceRead(ctx context.Context, d *schem
 }
 
 func resourceAviatrixGlobalVpcExcludedInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	uuid := d.Id()
 	d.Partial(true)
@@ -119,7 +119,7 @@ func resourceAviatrixGlobalVpcExcludedInstanceUpdate(ctx context.Context, d *sch
 }
 
 func resourceAviatrixGlobalVpcExcludedInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	uuid := d.Id()
 	err := client.DeleteGlobalVpcExcludedInstance(ctx, uuid)
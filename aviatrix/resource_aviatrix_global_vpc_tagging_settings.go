@@ -47,7 +47,7 @@ func marshalGlobalVpcTaggingSettingsInput(d *schema.ResourceData) *goaviatrix.Gl
 }
 
 func resourceAviatrixGlobalVpcTaggingSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	globalVpcTaggingSettings := marshalGlobalVpcTaggingSettingsInput(d)
 
@@ -59,7 +59,7 @@ func resourceAviatrixGlobalVpcTaggingSettingsCreate(ctx context.Context, d *sche
 		return diag.Errorf("failed to create global vpc tagging settings: %s", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixGlobalVpcTaggingSettingsReadIfRequired(ctx, d, meta, &flag)
 }
 
@@ -72,9 +72,9 @@ func resourceAviatrixGlobalVpcTaggingSettingsReadIfRequired(ctx context.Context,
 }
 
 func resourceAviatrixGlobalVpcTaggingSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -86,12 +86,12 @@ func resourceAviatrixGlobalVpcTaggingSettingsRead(ctx context.Context, d *schema
 	d.Set("service_state", globalVpcTaggingSettings.ServiceState)
 	d.Set("enable_alert", globalVpcTaggingSettings.EnableAlert)
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixGlobalVpcTaggingSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	d.Partial(true)
 	if d.HasChanges("service_state", "enable_alert") {
@@ -108,7 +108,7 @@ func resourceAviatrixGlobalVpcTaggingSettingsUpdate(ctx context.Context, d *sche
 }
 
 func resourceAviatrixGlobalVpcTaggingSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	globalVpcTaggingSettings := &goaviatrix.GlobalVpcTaggingSettings{
 		ServiceState: "semi_automatic",
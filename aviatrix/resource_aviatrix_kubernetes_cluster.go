@@ -182,7 +182,7 @@ func marshalKubernetesClusterInput(d *schema.ResourceData) (*goaviatrix.Kubernet
 }
 
 func resourceAviatrixKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	kubernetesCluster, err := marshalKubernetesClusterInput(d)
 	if err != nil {
@@ -197,7 +197,7 @@ func resourceAviatrixKubernetesClusterCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixKubernetesClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	kubernetesCluster, err := client.GetKubernetesCluster(ctx, d.Id())
 	if err != nil {
@@ -243,7 +243,7 @@ func resourceAviatrixKubernetesClusterRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceAviatrixKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	kubernetesCluster, err := marshalKubernetesClusterInput(d)
 	if err != nil {
@@ -256,7 +256,7 @@ func resourceAviatrixKubernetesClusterUpdate(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixKubernetesClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.DeleteKubernetesCluster(ctx, d.Id())
 	if err != nil {
@@ -93,7 +93,7 @@ func marshalLinkHierarchyInput(d *schema.ResourceData) map[string]interface{} {
 }
 
 func resourceAviatrixLinkHierarchyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	linkHierarchy := marshalLinkHierarchyInput(d)
 
@@ -118,7 +118,7 @@ func resourceAviatrixLinkHierarchyReadIfRequired(ctx context.Context, d *schema.
 }
 
 func resourceAviatrixLinkHierarchyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	uuid := d.Id()
 	d.Set("uuid", uuid)
@@ -159,7 +159,7 @@ func resourceAviatrixLinkHierarchyRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixLinkHierarchyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	uuid := d.Id()
 	d.Partial(true)
@@ -177,7 +177,7 @@ func resourceAviatrixLinkHierarchyUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixLinkHierarchyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	uuid := d.Id()
 	err := client.DeleteLinkHierarchy(ctx, uuid)
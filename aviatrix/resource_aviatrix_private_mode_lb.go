@@ -3,10 +3,10 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -113,7 +113,7 @@ func marshalPrivateModeLb(d *schema.ResourceData) (*goaviatrix.PrivateModeLb, er
 }
 
 func resourceAviatrixPrivateModeLbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	privateModeLb, err := marshalPrivateModeLb(d)
 	if err != nil {
@@ -156,11 +156,11 @@ func resourceAviatrixPrivateModeLbReadIfRequired(ctx context.Context, d *schema.
 }
 
 func resourceAviatrixPrivateModeLbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if _, ok := d.GetOk("vpc_id"); !ok {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc_id received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no vpc_id received", map[string]interface{}{"id": id})
 		d.Set("vpc_id", id)
 	}
 
@@ -206,7 +206,7 @@ func resourceAviatrixPrivateModeLbRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixPrivateModeLbUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	privateModeLb, err := marshalPrivateModeLb(d)
 	if err != nil {
@@ -225,7 +225,7 @@ func resourceAviatrixPrivateModeLbUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixPrivateModeLbDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpcId := d.Get("vpc_id").(string)
 	err := client.DeletePrivateModeLoadBalancer(ctx, vpcId)
@@ -2,9 +2,9 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -53,7 +53,7 @@ func resourceAviatrixPrivateModeMulticloudEndpoint() *schema.Resource {
 }
 
 func resourceAviatrixPrivateModeMulticloudEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	privateModeMulticloudEndpoint := &goaviatrix.PrivateModeMulticloudEndpoint{
 		AccountName:       d.Get("account_name").(string),
@@ -84,11 +84,11 @@ func resourceAviatrixPrivateModeMulticloudEndpointReadIfRequired(ctx context.Con
 }
 
 func resourceAviatrixPrivateModeMulticloudEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if _, ok := d.GetOk("vpc_id"); !ok {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc_id received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no vpc_id received", map[string]interface{}{"id": id})
 		d.Set("vpc_id", id)
 	}
 
@@ -113,7 +113,7 @@ func resourceAviatrixPrivateModeMulticloudEndpointRead(ctx context.Context, d *s
 }
 
 func resourceAviatrixPrivateModeMulticloudEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpcId := d.Get("vpc_id").(string)
 	err := client.DeletePrivateModeMulticloudEndpoint(ctx, vpcId)
@@ -1,20 +1,21 @@
 package aviatrix
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixProxyConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixProxyConfigCreate,
-		Read:   resourceAviatrixProxyConfigRead,
-		Delete: resourceAviatrixProxyConfigDelete,
+		CreateContext: resourceAviatrixProxyConfigCreate,
+		ReadContext:   resourceAviatrixProxyConfigRead,
+		DeleteContext: resourceAviatrixProxyConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -40,32 +41,32 @@ func resourceAviatrixProxyConfig() *schema.Resource {
 	}
 }
 
-func resourceAviatrixProxyConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixProxyConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	proxy := marshalProxyConfigInput(d)
-	if err := client.CreateProxyConfig(proxy); err != nil {
-		return fmt.Errorf("could not config proxy: %v", err)
+	if err := client.CreateProxyConfigContext(ctx, proxy); err != nil {
+		return diag.Errorf("could not config proxy: %v", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
-	return resourceAviatrixProxyConfigRead(d, meta)
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
+	return resourceAviatrixProxyConfigRead(ctx, d, meta)
 }
 
-func resourceAviatrixProxyConfigRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixProxyConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
-		return fmt.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
+		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
-	proxy, err := client.GetProxyConfig()
+	proxy, err := client.GetProxyConfigContext(ctx)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't get proxy configuration: %s", err)
+		return diag.Errorf("couldn't get proxy configuration: %s", err)
 	}
 
 	d.Set("http_proxy", proxy.HttpProxy)
@@ -74,16 +75,16 @@ func resourceAviatrixProxyConfigRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("proxy_ca_certificate", proxy.ProxyCaCertificate)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
-func resourceAviatrixProxyConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixProxyConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	err := client.DeleteProxyConfig()
+	err := client.DeleteProxyConfigContext(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete proxy configuration: %w", err)
+		return diag.Errorf("failed to delete proxy configuration: %v", err)
 	}
 
 	return nil
@@ -78,7 +78,7 @@ func marshalQosPolicyListInput(d *schema.ResourceData) *goaviatrix.QosPolicyList
 }
 
 func resourceAviatrixQosPolicyListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	qosPolicyList := marshalQosPolicyListInput(d)
 
@@ -103,7 +103,7 @@ func resourceAviatrixQosPolicyListReadIfRequired(ctx context.Context, d *schema.
 }
 
 func resourceAviatrixQosPolicyListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	qosPolicyResp, err := client.GetQosPolicyList(ctx)
 	if err != nil {
@@ -136,7 +136,7 @@ func resourceAviatrixQosPolicyListRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixQosPolicyListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	d.Partial(true)
 	if d.HasChanges("policies") {
@@ -153,7 +153,7 @@ func resourceAviatrixQosPolicyListUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixQosPolicyListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	err := client.DeleteQosPolicyList(ctx)
 	if err != nil {
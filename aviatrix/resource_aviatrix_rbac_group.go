@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixRbacGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupCreate,
-		Read:   resourceAviatrixRbacGroupRead,
-		Delete: resourceAviatrixRbacGroupDelete,
-		Update: resourceAviatrixRbacGroupUpdate,
+		CreateContext: resourceAviatrixRbacGroupCreate,
+		ReadContext:   resourceAviatrixRbacGroupRead,
+		DeleteContext: resourceAviatrixRbacGroupDelete,
+		UpdateContext: resourceAviatrixRbacGroupUpdate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,70 +36,70 @@ func resourceAviatrixRbacGroup() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	groupName := d.Get("group_name").(string)
 	group := &goaviatrix.RbacGroup{
 		GroupName: groupName,
 	}
 
-	log.Printf("[INFO] Creating Aviatrix RBAC permission group: %#v", group)
+	tflog.Info(ctx, "Creating Aviatrix RBAC permission group", map[string]interface{}{"group_name": group.GroupName})
 
 	d.SetId(group.GroupName)
 	flag := false
-	defer resourceAviatrixRbacGroupReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixRbacGroupReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreatePermissionGroup(group)
+	err := client.CreatePermissionGroupContext(ctx, group)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix RBAC permission group: %s", err)
+		return diag.Errorf("failed to create Aviatrix RBAC permission group: %s", err)
 	}
 
-	log.Printf("[DEBUG] Aviatrix RBAC permission group %s created", group.GroupName)
+	tflog.Debug(ctx, "Aviatrix RBAC permission group created", map[string]interface{}{"group_name": group.GroupName})
 
 	if d.Get("local_login").(bool) {
-		err := client.EnableLocalLoginForRBACGroup(groupName)
+		err := client.EnableLocalLoginForRBACGroupContext(ctx, groupName)
 		if err != nil {
-			return fmt.Errorf("failed to enable local_login for Aviatrix RBAC permission group: %s", err)
+			return diag.Errorf("failed to enable local_login for Aviatrix RBAC permission group: %s", err)
 		}
 	}
 
-	return resourceAviatrixRbacGroupReadIfRequired(d, meta, &flag)
+	return resourceAviatrixRbacGroupReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixRbacGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	groupName := d.Get("group_name").(string)
 
 	if d.Get("local_login").(bool) {
-		err := client.EnableLocalLoginForRBACGroup(groupName)
+		err := client.EnableLocalLoginForRBACGroupContext(ctx, groupName)
 		if err != nil {
-			return fmt.Errorf("failed to enable local_login for Aviatrix RBAC permission group: %s", err)
+			return diag.Errorf("failed to enable local_login for Aviatrix RBAC permission group: %s", err)
 		}
 	} else {
-		err := client.DisableLocalLoginForRBACGroup(groupName)
+		err := client.DisableLocalLoginForRBACGroupContext(ctx, groupName)
 		if err != nil {
-			return fmt.Errorf("failed to disable local_login for Aviatrix RBAC permission group: %s", err)
+			return diag.Errorf("failed to disable local_login for Aviatrix RBAC permission group: %s", err)
 		}
 	}
-	return resourceAviatrixRbacGroupRead(d, meta)
+	return resourceAviatrixRbacGroupRead(ctx, d, meta)
 }
 
-func resourceAviatrixRbacGroupReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixRbacGroupReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixRbacGroupRead(d, meta)
+		return resourceAviatrixRbacGroupRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	groupName := d.Get("group_name").(string)
 	if groupName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no group name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no group name received", map[string]interface{}{"id": id})
 		d.Set("group_name", id)
 		d.SetId(id)
 		groupName = id
@@ -108,15 +109,15 @@ func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta interface{}) err
 		GroupName: groupName,
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix RBAC permission group: %#v", group)
+	tflog.Info(ctx, "Looking for Aviatrix RBAC permission group", map[string]interface{}{"group_name": group.GroupName})
 
-	rGroup, err := client.GetPermissionGroupDetails(groupName)
+	rGroup, err := client.GetPermissionGroupDetailsContext(ctx, groupName)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix RBAC permission group: %s", err)
+		return diag.Errorf("couldn't find Aviatrix RBAC permission group: %s", err)
 	}
 	if rGroup != nil {
 		d.Set("group_name", rGroup.GroupName)
@@ -127,18 +128,18 @@ func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceAviatrixRbacGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	group := &goaviatrix.RbacGroup{
 		GroupName: d.Get("group_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix RBAC permission group: %#v", group)
+	tflog.Info(ctx, "Deleting Aviatrix RBAC permission group", map[string]interface{}{"group_name": group.GroupName})
 
-	err := client.DeletePermissionGroup(group)
+	err := client.DeletePermissionGroupContext(ctx, group)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix RBAC permission group: %s", err)
+		return diag.Errorf("failed to delete Aviatrix RBAC permission group: %s", err)
 	}
 
 	return nil
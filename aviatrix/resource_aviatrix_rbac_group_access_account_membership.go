@@ -1,24 +1,26 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"sort"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixRbacGroupAccessAccountMembership() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupAccessAccountMembershipCreate,
-		Read:   resourceAviatrixRbacGroupAccessAccountMembershipRead,
-		Update: resourceAviatrixRbacGroupAccessAccountMembershipUpdate,
-		Delete: resourceAviatrixRbacGroupAccessAccountMembershipDelete,
+		CreateContext: resourceAviatrixRbacGroupAccessAccountMembershipCreate,
+		ReadContext:   resourceAviatrixRbacGroupAccessAccountMembershipRead,
+		UpdateContext: resourceAviatrixRbacGroupAccessAccountMembershipUpdate,
+		DeleteContext: resourceAviatrixRbacGroupAccessAccountMembershipDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 				if d.Id() == "" {
 					return nil, fmt.Errorf("import requires group_name as ID")
 				}
@@ -52,24 +54,24 @@ func resourceAviatrixRbacGroupAccessAccountMembership() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupAccessAccountMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupAccessAccountMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	group := d.Get("group_name").(string)
 	accessAccounts := expandStringSet(d.Get("access_account_names").(*schema.Set))
 
-	log.Printf("[INFO] Creating (authoritative) access account membership for group %q: %v", group, accessAccounts)
+	tflog.Info(ctx, "Creating (authoritative) access account membership for group", map[string]interface{}{"group": group, "access_accounts": accessAccounts})
 
-	if err := client.SetRbacGroupAccessAccounts(group, accessAccounts); err != nil {
-		return fmt.Errorf("failed to set access account for RBAC group %q: %w", group, err)
+	if err := client.SetRbacGroupAccessAccountsContext(ctx, group, accessAccounts); err != nil {
+		return diag.Errorf("failed to set access account for RBAC group %q: %v", group, err)
 	}
 
 	d.SetId(group)
-	return resourceAviatrixRbacGroupAccessAccountMembershipRead(d, meta)
+	return resourceAviatrixRbacGroupAccessAccountMembershipRead(ctx, d, meta)
 }
 
-func resourceAviatrixRbacGroupAccessAccountMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupAccessAccountMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	group := d.Get("group_name").(string)
 	if group == "" {
@@ -77,43 +79,43 @@ func resourceAviatrixRbacGroupAccessAccountMembershipRead(d *schema.ResourceData
 		_ = d.Set("group_name", group)
 	}
 
-	log.Printf("[INFO] Reading (authoritative) access account membership for group %q", group)
+	tflog.Info(ctx, "Reading (authoritative) access account membership for group", map[string]interface{}{"group": group})
 
-	current, err := client.ListRbacGroupAccessAccounts(group)
+	current, err := client.ListRbacGroupAccessAccountsContext(ctx, group)
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
-			log.Printf("[WARN] RBAC group %q not found; removing from state", group)
+			tflog.Warn(ctx, "RBAC group not found; removing from state", map[string]interface{}{"group": group})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to list access accounts for RBAC group %q: %w", group, err)
+		return diag.Errorf("failed to list access accounts for RBAC group %q: %v", group, err)
 	}
 
 	sort.Strings(current)
 	if err := d.Set("access_account_names", stringSliceToIfaceSlice(current)); err != nil {
-		return fmt.Errorf("failed to set access_account_names for %q: %w", group, err)
+		return diag.Errorf("failed to set access_account_names for %q: %v", group, err)
 	}
 
 	d.SetId(group)
 	return nil
 }
 
-func resourceAviatrixRbacGroupAccessAccountMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupAccessAccountMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	group := d.Get("group_name").(string)
 
 	if d.HasChange("access_account_names") {
 		desired := expandStringSet(d.Get("access_account_names").(*schema.Set))
-		if err := client.SetRbacGroupAccessAccounts(group, desired); err != nil {
-			return fmt.Errorf("failed to update access accounts for RBAC group %q: %w", group, err)
+		if err := client.SetRbacGroupAccessAccountsContext(ctx, group, desired); err != nil {
+			return diag.Errorf("failed to update access accounts for RBAC group %q: %v", group, err)
 		}
 	}
 
-	return resourceAviatrixRbacGroupAccessAccountMembershipRead(d, meta)
+	return resourceAviatrixRbacGroupAccessAccountMembershipRead(ctx, d, meta)
 }
 
-func resourceAviatrixRbacGroupAccessAccountMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupAccessAccountMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	if v, ok := d.GetOk("remove_access_accounts_on_destroy"); !ok || !v.(bool) {
 		return nil
@@ -121,20 +123,20 @@ func resourceAviatrixRbacGroupAccessAccountMembershipDelete(d *schema.ResourceDa
 
 	group := d.Get("group_name").(string)
 
-	accessAccounts, err := client.ListRbacGroupAccessAccounts(group)
+	accessAccounts, err := client.ListRbacGroupAccessAccountsContext(ctx, group)
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
 			return nil
 		}
-		return fmt.Errorf("failed to list access accounts before delete for group %q: %w", group, err)
+		return diag.Errorf("failed to list access accounts before delete for group %q: %v", group, err)
 	}
 
 	if len(accessAccounts) == 0 {
 		return nil
 	}
 
-	if err := client.DeleteRbacGroupAccessAccounts(group, accessAccounts); err != nil {
-		return fmt.Errorf("failed to remove access accounts for group %q: %w", group, err)
+	if err := client.DeleteRbacGroupAccessAccountsContext(ctx, group, accessAccounts); err != nil {
+		return diag.Errorf("failed to remove access accounts for group %q: %v", group, err)
 	}
 
 	return nil
@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -13,11 +14,11 @@ import (
 
 func resourceAviatrixRbacGroupPermissionAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupPermissionAttachmentCreate,
-		Read:   resourceAviatrixRbacGroupPermissionAttachmentRead,
-		Delete: resourceAviatrixRbacGroupPermissionAttachmentDelete,
+		CreateContext: resourceAviatrixRbacGroupPermissionAttachmentCreate,
+		ReadContext:   resourceAviatrixRbacGroupPermissionAttachmentRead,
+		DeleteContext: resourceAviatrixRbacGroupPermissionAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,46 +54,46 @@ func resourceAviatrixRbacGroupPermissionAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupPermissionAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupPermissionAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	attachment := &goaviatrix.RbacGroupPermissionAttachment{
 		GroupName:      d.Get("group_name").(string),
 		PermissionName: d.Get("permission_name").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix RBAC group permission attachment: %#v", attachment)
+	tflog.Info(ctx, "Creating Aviatrix RBAC group permission attachment", map[string]interface{}{"group_name": attachment.GroupName, "permission_name": attachment.PermissionName})
 
 	d.SetId(attachment.GroupName + "~" + attachment.PermissionName)
 	flag := false
-	defer resourceAviatrixRbacGroupPermissionAttachmentReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixRbacGroupPermissionAttachmentReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateRbacGroupPermissionAttachment(attachment)
+	err := client.CreateRbacGroupPermissionAttachmentContext(ctx, attachment)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix RBAC group permission attachment: %s", err)
+		return diag.Errorf("failed to create Aviatrix RBAC group permission attachment: %s", err)
 	}
 
-	log.Printf("[DEBUG] Aviatrix RBAC group permission attachment created")
+	tflog.Debug(ctx, "Aviatrix RBAC group permission attachment created")
 
-	return resourceAviatrixRbacGroupPermissionAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixRbacGroupPermissionAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixRbacGroupPermissionAttachmentReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixRbacGroupPermissionAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixRbacGroupPermissionAttachmentRead(d, meta)
+		return resourceAviatrixRbacGroupPermissionAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixRbacGroupPermissionAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupPermissionAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	groupName := d.Get("group_name").(string)
 	permissionName := d.Get("permission_name").(string)
 	if groupName == "" || permissionName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no group name or permission name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no group name or permission name received", map[string]interface{}{"id": id})
 		d.Set("group_name", strings.Split(id, "~")[0])
 		d.Set("permission_name", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -103,15 +104,15 @@ func resourceAviatrixRbacGroupPermissionAttachmentRead(d *schema.ResourceData, m
 		PermissionName: d.Get("permission_name").(string),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix RBAC group permission attachment: %#v", attachment)
+	tflog.Info(ctx, "Looking for Aviatrix RBAC group permission attachment", map[string]interface{}{"group_name": attachment.GroupName, "permission_name": attachment.PermissionName})
 
-	permissionAttachment, err := client.GetRbacGroupPermissionAttachment(attachment)
+	permissionAttachment, err := client.GetRbacGroupPermissionAttachmentContext(ctx, attachment)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix RBAC group permission attachment: %s", err)
+		return diag.Errorf("couldn't find Aviatrix RBAC group permission attachment: %s", err)
 	}
 	if permissionAttachment != nil {
 		d.Set("group_name", permissionAttachment.GroupName)
@@ -122,19 +123,19 @@ func resourceAviatrixRbacGroupPermissionAttachmentRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceAviatrixRbacGroupPermissionAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupPermissionAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	attachment := &goaviatrix.RbacGroupPermissionAttachment{
 		GroupName:      d.Get("group_name").(string),
 		PermissionName: d.Get("permission_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix RBAC group permission attachment: %#v", attachment)
+	tflog.Info(ctx, "Deleting Aviatrix RBAC group permission attachment", map[string]interface{}{"group_name": attachment.GroupName, "permission_name": attachment.PermissionName})
 
-	err := client.DeleteRbacGroupPermissionAttachment(attachment)
+	err := client.DeleteRbacGroupPermissionAttachmentContext(ctx, attachment)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix RBAC group permission attachment: %s", err)
+		return diag.Errorf("failed to delete Aviatrix RBAC group permission attachment: %s", err)
 	}
 
 	return nil
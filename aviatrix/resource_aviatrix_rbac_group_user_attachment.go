@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixRbacGroupUserAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupUserAttachmentCreate,
-		Read:   resourceAviatrixRbacGroupUserAttachmentRead,
-		Delete: resourceAviatrixRbacGroupUserAttachmentDelete,
+		CreateContext: resourceAviatrixRbacGroupUserAttachmentCreate,
+		ReadContext:   resourceAviatrixRbacGroupUserAttachmentRead,
+		DeleteContext: resourceAviatrixRbacGroupUserAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,46 +36,46 @@ func resourceAviatrixRbacGroupUserAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupUserAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupUserAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	attachment := &goaviatrix.RbacGroupUserAttachment{
 		GroupName: d.Get("group_name").(string),
 		UserName:  d.Get("user_name").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix RBAC permission group user attachment: %#v", attachment)
+	tflog.Info(ctx, "Creating Aviatrix RBAC permission group user attachment", map[string]interface{}{"group_name": attachment.GroupName, "user_name": attachment.UserName})
 
 	d.SetId(attachment.GroupName + "~" + attachment.UserName)
 	flag := false
-	defer resourceAviatrixRbacGroupUserAttachmentReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixRbacGroupUserAttachmentReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateRbacGroupUserAttachment(attachment)
+	err := client.CreateRbacGroupUserAttachmentContext(ctx, attachment)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix RBAC permission group user attachment: %s", err)
+		return diag.Errorf("failed to create Aviatrix RBAC permission group user attachment: %s", err)
 	}
 
-	log.Printf("[DEBUG] Aviatrix RBAC permission group user attachment created")
+	tflog.Debug(ctx, "Aviatrix RBAC permission group user attachment created")
 
-	return resourceAviatrixRbacGroupUserAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixRbacGroupUserAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixRbacGroupUserAttachmentReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixRbacGroupUserAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixRbacGroupUserAttachmentRead(d, meta)
+		return resourceAviatrixRbacGroupUserAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixRbacGroupUserAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupUserAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	groupName := d.Get("group_name").(string)
 	userName := d.Get("user_name").(string)
 	if groupName == "" || userName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no group name or account user name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no group name or account user name received", map[string]interface{}{"id": id})
 		d.Set("group_name", strings.Split(id, "~")[0])
 		d.Set("user_name", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -85,15 +86,15 @@ func resourceAviatrixRbacGroupUserAttachmentRead(d *schema.ResourceData, meta in
 		UserName:  d.Get("user_name").(string),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix RBAC permission group user attachment: %#v", attachment)
+	tflog.Info(ctx, "Looking for Aviatrix RBAC permission group user attachment", map[string]interface{}{"group_name": attachment.GroupName, "user_name": attachment.UserName})
 
-	userAttachment, err := client.GetRbacGroupUserAttachment(attachment)
+	userAttachment, err := client.GetRbacGroupUserAttachmentContext(ctx, attachment)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix RBAC permission group user attachment: %s", err)
+		return diag.Errorf("couldn't find Aviatrix RBAC permission group user attachment: %s", err)
 	}
 	if userAttachment != nil {
 		d.Set("group_name", userAttachment.GroupName)
@@ -104,19 +105,19 @@ func resourceAviatrixRbacGroupUserAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAviatrixRbacGroupUserAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupUserAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	attachment := &goaviatrix.RbacGroupUserAttachment{
 		GroupName: d.Get("group_name").(string),
 		UserName:  d.Get("user_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix RBAC permission group user attachment: %#v", attachment)
+	tflog.Info(ctx, "Deleting Aviatrix RBAC permission group user attachment", map[string]interface{}{"group_name": attachment.GroupName, "user_name": attachment.UserName})
 
-	err := client.DeleteRbacGroupUserAttachment(attachment)
+	err := client.DeleteRbacGroupUserAttachmentContext(ctx, attachment)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix RBAC permission group user attachment: %s", err)
+		return diag.Errorf("failed to delete Aviatrix RBAC permission group user attachment: %s", err)
 	}
 
 	return nil
@@ -1,24 +1,26 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"sort"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixRbacGroupUserMembership() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupUserMembershipCreate,
-		Read:   resourceAviatrixRbacGroupUserMembershipRead,
-		Update: resourceAviatrixRbacGroupUserMembershipUpdate,
-		Delete: resourceAviatrixRbacGroupUserMembershipDelete,
+		CreateContext: resourceAviatrixRbacGroupUserMembershipCreate,
+		ReadContext:   resourceAviatrixRbacGroupUserMembershipRead,
+		UpdateContext: resourceAviatrixRbacGroupUserMembershipUpdate,
+		DeleteContext: resourceAviatrixRbacGroupUserMembershipDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 				if d.Id() == "" {
 					return nil, fmt.Errorf("import requires group_name as ID")
 				}
@@ -52,24 +54,24 @@ func resourceAviatrixRbacGroupUserMembership() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupUserMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupUserMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	group := d.Get("group_name").(string)
 	users := expandStringSet(d.Get("user_names").(*schema.Set))
 
-	log.Printf("[INFO] Creating (authoritative) user membership for group %q: %v", group, users)
+	tflog.Info(ctx, "Creating (authoritative) user membership for group", map[string]interface{}{"group": group, "users": users})
 
-	if err := client.SetRbacGroupUsers(group, users); err != nil {
-		return fmt.Errorf("failed to set user for RBAC group %q: %w", group, err)
+	if err := client.SetRbacGroupUsersContext(ctx, group, users); err != nil {
+		return diag.Errorf("failed to set user for RBAC group %q: %v", group, err)
 	}
 
 	d.SetId(group)
-	return resourceAviatrixRbacGroupUserMembershipRead(d, meta)
+	return resourceAviatrixRbacGroupUserMembershipRead(ctx, d, meta)
 }
 
-func resourceAviatrixRbacGroupUserMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupUserMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	group := d.Get("group_name").(string)
 	if group == "" {
@@ -77,43 +79,43 @@ func resourceAviatrixRbacGroupUserMembershipRead(d *schema.ResourceData, meta in
 		_ = d.Set("group_name", group)
 	}
 
-	log.Printf("[INFO] Reading (authoritative) user membership for group %q", group)
+	tflog.Info(ctx, "Reading (authoritative) user membership for group", map[string]interface{}{"group": group})
 
-	current, err := client.ListRbacGroupUsers(group)
+	current, err := client.ListRbacGroupUsersContext(ctx, group)
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
-			log.Printf("[WARN] RBAC group %q not found; removing from state", group)
+			tflog.Warn(ctx, "RBAC group not found; removing from state", map[string]interface{}{"group": group})
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("failed to list users for RBAC group %q: %w", group, err)
+		return diag.Errorf("failed to list users for RBAC group %q: %v", group, err)
 	}
 
 	sort.Strings(current)
 	if err := d.Set("user_names", stringSliceToIfaceSlice(current)); err != nil {
-		return fmt.Errorf("failed to set user_names for %q: %w", group, err)
+		return diag.Errorf("failed to set user_names for %q: %v", group, err)
 	}
 
 	d.SetId(group)
 	return nil
 }
 
-func resourceAviatrixRbacGroupUserMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupUserMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	group := d.Get("group_name").(string)
 
 	if d.HasChange("user_names") {
 		desired := expandStringSet(d.Get("user_names").(*schema.Set))
-		if err := client.SetRbacGroupUsers(group, desired); err != nil {
-			return fmt.Errorf("failed to update users for RBAC group %q: %w", group, err)
+		if err := client.SetRbacGroupUsersContext(ctx, group, desired); err != nil {
+			return diag.Errorf("failed to update users for RBAC group %q: %v", group, err)
 		}
 	}
 
-	return resourceAviatrixRbacGroupUserMembershipRead(d, meta)
+	return resourceAviatrixRbacGroupUserMembershipRead(ctx, d, meta)
 }
 
-func resourceAviatrixRbacGroupUserMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixRbacGroupUserMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	if v, ok := d.GetOk("remove_users_on_destroy"); !ok || !v.(bool) {
 		return nil
@@ -121,20 +123,20 @@ func resourceAviatrixRbacGroupUserMembershipDelete(d *schema.ResourceData, meta
 
 	group := d.Get("group_name").(string)
 
-	users, err := client.ListRbacGroupUsers(group)
+	users, err := client.ListRbacGroupUsersContext(ctx, group)
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
 			return nil
 		}
-		return fmt.Errorf("failed to list users before delete for group %q: %w", group, err)
+		return diag.Errorf("failed to list users before delete for group %q: %v", group, err)
 	}
 
 	if len(users) == 0 {
 		return nil
 	}
 
-	if err := client.DeleteRbacGroupUsers(group, users); err != nil {
-		return fmt.Errorf("failed to remove users for group %q: %w", group, err)
+	if err := client.DeleteRbacGroupUsersContext(ctx, group, users); err != nil {
+		return diag.Errorf("failed to remove users for group %q: %v", group, err)
 	}
 
 	return nil
@@ -1,10 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -13,12 +15,12 @@ import (
 
 func resourceAviatrixSamlEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSamlEndpointCreate,
-		Read:   resourceAviatrixSamlEndpointRead,
-		Delete: resourceAviatrixSamlEndpointDelete,
-		Update: resourceAviatrixSamlEndpointUpdate,
+		CreateContext: resourceAviatrixSamlEndpointCreate,
+		ReadContext:   resourceAviatrixSamlEndpointRead,
+		DeleteContext: resourceAviatrixSamlEndpointDelete,
+		UpdateContext: resourceAviatrixSamlEndpointUpdate,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,42 +96,42 @@ func resourceAviatrixSamlEndpoint() *schema.Resource {
 	}
 }
 
-func resourceAviatrixSamlEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSamlEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	samlEndpoint, err := GetAviatrixSamlEndpointInput(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(samlEndpoint.EndPointName)
 	flag := false
-	defer resourceAviatrixSamlEndpointReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSamlEndpointReadIfRequired(ctx, d, meta, &flag)
 
-	err = client.CreateSamlEndpoint(samlEndpoint)
+	err = client.CreateSamlEndpointContext(ctx, samlEndpoint)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix SAML endpoint: %s", err)
+		return diag.Errorf("failed to create Aviatrix SAML endpoint: %s", err)
 	}
 
-	return resourceAviatrixSamlEndpointReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSamlEndpointReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSamlEndpointReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSamlEndpointReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSamlEndpointRead(d, meta)
+		return resourceAviatrixSamlEndpointRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSamlEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	endpointName := d.Get("endpoint_name").(string)
 
 	if endpointName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no SAML endpoint names received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no SAML endpoint names received", map[string]interface{}{"id": id})
 		d.Set("endpoint_name", id)
 		d.SetId(id)
 	}
@@ -138,16 +140,16 @@ func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta interface{})
 		EndPointName: d.Get("endpoint_name").(string),
 	}
 
-	saml, err := client.GetSamlEndpoint(samlEndpoint)
+	saml, err := client.GetSamlEndpointContext(ctx, samlEndpoint)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix SAML Endpoint: %s", err)
+		return diag.Errorf("couldn't find Aviatrix SAML Endpoint: %s", err)
 	}
 
-	log.Printf("[INFO] Found Aviatrix SAML Endpoint: %#v", saml)
+	tflog.Info(ctx, "Found Aviatrix SAML Endpoint", map[string]interface{}{"end_point_name": saml.EndPointName})
 
 	d.Set("endpoint_name", saml.EndPointName)
 	d.Set("idp_metadata_type", saml.IdpMetadataType)
@@ -175,11 +177,11 @@ func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta interface{})
 		rbacGroupsRead := saml.RbacGroupsRead
 		if len(goaviatrix.Difference(rbacGroups, rbacGroupsRead)) == 0 && len(goaviatrix.Difference(rbacGroupsRead, rbacGroups)) == 0 {
 			if err := d.Set("rbac_groups", rbacGroups); err != nil {
-				log.Printf("[WARN] Error setting 'rbac_groups' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, "Error setting 'rbac_groups'", map[string]interface{}{"id": d.Id(), "error": err})
 			}
 		} else {
 			if err := d.Set("rbac_groups", rbacGroupsRead); err != nil {
-				log.Printf("[WARN] Error setting 'rbac_groups' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, "Error setting 'rbac_groups'", map[string]interface{}{"id": d.Id(), "error": err})
 			}
 		}
 	} else {
@@ -190,36 +192,36 @@ func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAviatrixSamlEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSamlEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	samlEndpoint, err := GetAviatrixSamlEndpointInput(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = client.EditSamlEndpoint(samlEndpoint)
+	err = client.EditSamlEndpointContext(ctx, samlEndpoint)
 	if err != nil {
-		return fmt.Errorf("failed to edit Aviatrix SAML endpoint: %s", err)
+		return diag.Errorf("failed to edit Aviatrix SAML endpoint: %s", err)
 	}
 
 	d.SetId(samlEndpoint.EndPointName)
-	return resourceAviatrixSamlEndpointRead(d, meta)
+	return resourceAviatrixSamlEndpointRead(ctx, d, meta)
 }
 
-func resourceAviatrixSamlEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSamlEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	samlEndpoint := &goaviatrix.SamlEndpoint{
 		EndPointName: d.Get("endpoint_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix SAML Endpoint: %#v", samlEndpoint)
+	tflog.Info(ctx, "Deleting Aviatrix SAML Endpoint", map[string]interface{}{"end_point_name": samlEndpoint.EndPointName})
 
 	samlEndpoint.EndPointName = d.Get("endpoint_name").(string)
 
-	err := client.DeleteSamlEndpoint(samlEndpoint)
+	err := client.DeleteSamlEndpointContext(ctx, samlEndpoint)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix SAML Endpoint: %s", err)
+		return diag.Errorf("failed to delete Aviatrix SAML Endpoint: %s", err)
 	}
 
 	return nil
@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixSegmentationNetworkDomainAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSegmentationNetworkDomainAssociationCreate,
-		Read:   resourceAviatrixSegmentationNetworkDomainAssociationRead,
-		Delete: resourceAviatrixSegmentationNetworkDomainAssociationDelete,
+		CreateContext: resourceAviatrixSegmentationNetworkDomainAssociationCreate,
+		ReadContext:   resourceAviatrixSegmentationNetworkDomainAssociationRead,
+		DeleteContext: resourceAviatrixSegmentationNetworkDomainAssociationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -52,38 +53,38 @@ func marshalSegmentationNetworkDomainAssociationInput(d *schema.ResourceData) *g
 	}
 }
 
-func resourceAviatrixSegmentationNetworkDomainAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSegmentationNetworkDomainAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	association := marshalSegmentationNetworkDomainAssociationInput(d)
 
 	d.SetId(association.SecurityDomainName + "~" + association.AttachmentName)
 	flag := false
-	defer resourceAviatrixSegmentationNetworkDomainAssociationReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSegmentationNetworkDomainAssociationReadIfRequired(ctx, d, meta, &flag)
 
-	if err := client.CreateSegmentationSecurityDomainAssociation(association); err != nil {
-		return fmt.Errorf("could not create segmentation network domain association: %v", err)
+	if err := client.CreateSegmentationSecurityDomainAssociationContext(ctx, association); err != nil {
+		return diag.Errorf("could not create segmentation network domain association: %v", err)
 	}
 
-	return resourceAviatrixSegmentationNetworkDomainAssociationReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSegmentationNetworkDomainAssociationReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSegmentationNetworkDomainAssociationReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSegmentationNetworkDomainAssociationReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSegmentationNetworkDomainAssociationRead(d, meta)
+		return resourceAviatrixSegmentationNetworkDomainAssociationRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSegmentationNetworkDomainAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSegmentationNetworkDomainAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	networkDomainName := d.Get("network_domain_name").(string)
 	attachmentName := d.Get("attachment_name").(string)
 	if networkDomainName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no network_domain_name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no network_domain_name received", map[string]interface{}{"id": id})
 		d.SetId(id)
 		parts := strings.Split(id, "~")
 		networkDomainName = parts[0]
@@ -95,13 +96,13 @@ func resourceAviatrixSegmentationNetworkDomainAssociationRead(d *schema.Resource
 		AttachmentName:     attachmentName,
 	}
 
-	_, err := client.GetSegmentationSecurityDomainAssociation(association)
+	_, err := client.GetSegmentationSecurityDomainAssociationContext(ctx, association)
 	if err == goaviatrix.ErrNotFound {
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not find segmentation_network_domain_association %s: %v", networkDomainName+"~"+attachmentName, err)
+		return diag.Errorf("could not find segmentation_network_domain_association %s: %v", networkDomainName+"~"+attachmentName, err)
 	}
 
 	d.Set("network_domain_name", networkDomainName)
@@ -113,13 +114,13 @@ func resourceAviatrixSegmentationNetworkDomainAssociationRead(d *schema.Resource
 	return nil
 }
 
-func resourceAviatrixSegmentationNetworkDomainAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSegmentationNetworkDomainAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	association := marshalSegmentationNetworkDomainAssociationInput(d)
 
-	if err := client.DeleteSegmentationSecurityDomainAssociation(association); err != nil {
-		return fmt.Errorf("could not delete segmentation_network_domain_association: %v", err)
+	if err := client.DeleteSegmentationSecurityDomainAssociationContext(ctx, association); err != nil {
+		return diag.Errorf("could not delete segmentation_network_domain_association: %v", err)
 	}
 
 	return nil
@@ -1,22 +1,23 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"slices"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixSegmentationNetworkDomainConnectionPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate,
-		Read:   resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead,
-		Delete: resourceAviatrixSegmentationNetworkDomainConnectionPolicyDelete,
+		CreateContext: resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate,
+		ReadContext:   resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead,
+		DeleteContext: resourceAviatrixSegmentationNetworkDomainConnectionPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,49 +48,49 @@ func marshalSegmentationNetworkDomainConnectionPolicyInput(d *schema.ResourceDat
 	}
 }
 
-func resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	policy := marshalSegmentationNetworkDomainConnectionPolicyInput(d)
 
 	// validate domain names exist
-	domainNames, err := client.ListSegmentationSecurityDomains()
+	domainNames, err := client.ListSegmentationSecurityDomainsContext(ctx)
 	if err != nil {
-		return fmt.Errorf("could not find segmentation_network_domains: %w", err)
+		return diag.Errorf("could not find segmentation_network_domains: %v", err)
 	}
 	for _, domainName := range []string{policy.Domain1.DomainName, policy.Domain2.DomainName} {
 		if !slices.Contains(domainNames, domainName) {
-			return fmt.Errorf("could not find segmentation_network_domain %s in %v", domainName, domainNames)
+			return diag.Errorf("could not find segmentation_network_domain %s in %v", domainName, domainNames)
 		}
 	}
 
 	d.SetId(policy.Domain1.DomainName + "~" + policy.Domain2.DomainName)
 	flag := false
-	defer resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(ctx, d, meta, &flag)
 
-	if err := client.CreateSegmentationSecurityDomainConnectionPolicy(policy); err != nil {
-		return fmt.Errorf("could not create network domain connection policy: %v", err)
+	if err := client.CreateSegmentationSecurityDomainConnectionPolicyContext(ctx, policy); err != nil {
+		return diag.Errorf("could not create network domain connection policy: %v", err)
 	}
 
-	return resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(d, meta)
+		return resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	domainName1 := d.Get("domain_name_1").(string)
 	domainName2 := d.Get("domain_name_2").(string)
 	if domainName1 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no segmentation_network_domain_connection_policy domain_name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no segmentation_network_domain_connection_policy domain_name received", map[string]interface{}{"id": id})
 		d.SetId(id)
 		parts := strings.Split(id, "~")
 		domainName1 = parts[0]
@@ -105,13 +106,13 @@ func resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(d *schema.Res
 		},
 	}
 
-	_, err := client.GetSegmentationSecurityDomainConnectionPolicy(policy)
+	_, err := client.GetSegmentationSecurityDomainConnectionPolicyContext(ctx, policy)
 	if err == goaviatrix.ErrNotFound {
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not find segmentation_network_domain_connection_policy %s: %v", domainName1+"~"+domainName2, err)
+		return diag.Errorf("could not find segmentation_network_domain_connection_policy %s: %v", domainName1+"~"+domainName2, err)
 	}
 
 	d.Set("domain_name_1", domainName1)
@@ -120,13 +121,13 @@ func resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(d *schema.Res
 	return nil
 }
 
-func resourceAviatrixSegmentationNetworkDomainConnectionPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSegmentationNetworkDomainConnectionPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	policy := marshalSegmentationNetworkDomainConnectionPolicyInput(d)
 
-	if err := client.DeleteSegmentationSecurityDomainConnectionPolicy(policy); err != nil {
-		return fmt.Errorf("could not delete segmentation_network_domain_connection_policy: %v", err)
+	if err := client.DeleteSegmentationSecurityDomainConnectionPolicyContext(ctx, policy); err != nil {
+		return diag.Errorf("could not delete segmentation_network_domain_connection_policy: %v", err)
 	}
 
 	return nil
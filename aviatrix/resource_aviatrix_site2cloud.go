@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -24,12 +25,12 @@ var customMappedAttributeNames = []string{
 
 func resourceAviatrixSite2Cloud() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSite2CloudCreate,
-		Read:   resourceAviatrixSite2CloudRead,
-		Update: resourceAviatrixSite2CloudUpdate,
-		Delete: resourceAviatrixSite2CloudDelete,
+		CreateContext: resourceAviatrixSite2CloudCreate,
+		ReadContext:   resourceAviatrixSite2CloudRead,
+		UpdateContext: resourceAviatrixSite2CloudUpdate,
+		DeleteContext: resourceAviatrixSite2CloudDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -428,8 +429,8 @@ func getCSVFromStringList(d *schema.ResourceData, attributeName string) string {
 	return strings.Join(expandedList, ",")
 }
 
-func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSite2CloudCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	s2c := &goaviatrix.Site2Cloud{
 		GwName:                        d.Get("primary_cloud_gateway_name").(string),
@@ -469,15 +470,15 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	haEnabled := d.Get("ha_enabled").(bool)
 	if s2c.AuthType == "Cert" {
 		if s2c.CaCertTagName == "" || s2c.RemoteIdentifier == "" {
-			return fmt.Errorf("'ca_cert_tag_name' and 'remote_identifier' are both required for Cert based authentication type")
+			return diag.Errorf("'ca_cert_tag_name' and 'remote_identifier' are both required for Cert based authentication type")
 		}
 		if haEnabled && s2c.BackupRemoteIdentifier == "" {
-			return fmt.Errorf("'backup_remote_identifier' is required for Cert based authentication type with HA enabled")
+			return diag.Errorf("'backup_remote_identifier' is required for Cert based authentication type with HA enabled")
 		}
 		s2c.AuthType = "pubkey"
 	} else {
 		if s2c.CaCertTagName != "" || s2c.RemoteIdentifier != "" || s2c.BackupRemoteIdentifier != "" {
-			return fmt.Errorf("'ca_cert_tag_name', 'remote_identifier' and 'backup_remote_identifier' are required to be empty for PSK(Pubkey) based authentication type")
+			return diag.Errorf("'ca_cert_tag_name', 'remote_identifier' and 'backup_remote_identifier' are required to be empty for PSK(Pubkey) based authentication type")
 		}
 	}
 
@@ -486,9 +487,9 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 		s2c.HAEnabled = "yes"
 		// 22021: Remote GW IP is not required when singleIPHA is enabled as only 1 tunnel is created
 		if s2c.BackupGwName == "" || (s2c.RemoteGwIP2 == "" && !singleIpHA) {
-			return fmt.Errorf("'backup_gateway_name' and 'backup_remote_gateway_ip' are required when HA is enabled")
+			return diag.Errorf("'backup_gateway_name' and 'backup_remote_gateway_ip' are required when HA is enabled")
 		} else if s2c.RemoteGwIP2 != "" && singleIpHA {
-			return fmt.Errorf("'backup_remote_gateway_ip' is not required when HA is enabled and single ip ha is enabled")
+			return diag.Errorf("'backup_remote_gateway_ip' is not required when HA is enabled and single ip ha is enabled")
 		}
 		if s2c.RemoteGwIP2 != "" {
 			s2c.RemoteGwIP = s2c.RemoteGwIP + "," + s2c.RemoteGwIP2
@@ -497,87 +498,87 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	} else {
 		s2c.HAEnabled = "no"
 		if s2c.BackupGwName != "" || s2c.RemoteGwIP2 != "" || s2c.BackupLocalTunnelIp != "" || s2c.BackupRemoteTunnelIp != "" {
-			return fmt.Errorf("'backup_gateway_name', 'backup_remote_gateway_ip', 'backup_local_tunnel_ip' " +
+			return diag.Errorf("'backup_gateway_name', 'backup_remote_gateway_ip', 'backup_local_tunnel_ip' " +
 				"and 'backup_remote_tunnel_ip' are only valid when HA is enabled")
 		}
 	}
 
 	if s2c.TunnelType == "policy" {
 		if s2c.LocalTunnelIp != "" || s2c.RemoteTunnelIp != "" || s2c.BackupLocalTunnelIp != "" || s2c.BackupRemoteTunnelIp != "" {
-			return fmt.Errorf("'local_tunnel_ip', 'remote_tunnel_ip', 'backup_local_tunnel_ip' " +
+			return diag.Errorf("'local_tunnel_ip', 'remote_tunnel_ip', 'backup_local_tunnel_ip' " +
 				"and 'backup_remote_tunnel_ip' are only valid for route based connection")
 		}
 	}
 
 	activeActive := d.Get("enable_active_active").(bool)
 	if activeActive && !haEnabled {
-		return fmt.Errorf("active_active_ha can't be enabled if HA isn't enabled for site2cloud connection")
+		return diag.Errorf("active_active_ha can't be enabled if HA isn't enabled for site2cloud connection")
 	}
 
 	if s2c.ConnType != "mapped" && s2c.ConnType != "unmapped" {
-		return fmt.Errorf("'connection_type' should be 'mapped' or 'unmapped'")
+		return diag.Errorf("'connection_type' should be 'mapped' or 'unmapped'")
 	}
 
 	gateway := &goaviatrix.Gateway{
 		GwName: s2c.GwName,
 	}
 
-	gw, err := client.GetGateway(gateway)
+	gw, err := client.GetGatewayContext(ctx, gateway)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
-			return fmt.Errorf("couldn't find Aviatrix Gateway %s", s2c.GwName)
+			return diag.Errorf("couldn't find Aviatrix Gateway %s", s2c.GwName)
 		} else {
-			return fmt.Errorf("couldn't find Aviatrix Gateway %s: %v", s2c.GwName, err)
+			return diag.Errorf("couldn't find Aviatrix Gateway %s: %v", s2c.GwName, err)
 		}
 	}
 
 	if gw.TransitVpc == "yes" {
 		if s2c.ConnType == "unmapped" && s2c.TunnelType == "policy" && haEnabled && !activeActive {
-			return fmt.Errorf("active_active_ha must be enabled if HA is enabled for transit gateway unmapped policy based site2cloud connection")
+			return diag.Errorf("active_active_ha must be enabled if HA is enabled for transit gateway unmapped policy based site2cloud connection")
 		}
 	}
 
 	if singleIpHA {
 		if !haEnabled {
-			return fmt.Errorf("'enable_single_ip_ha' can't be enabled if HA isn't enabled for site2cloud connection")
+			return diag.Errorf("'enable_single_ip_ha' can't be enabled if HA isn't enabled for site2cloud connection")
 		}
 		if s2c.AuthType == "pubkey" {
-			return fmt.Errorf("single IP HA is only supported for PSK authentication type based site2cloud connection")
+			return diag.Errorf("single IP HA is only supported for PSK authentication type based site2cloud connection")
 		}
 		if s2c.RemoteGwIP2 != "" && s2c.RemoteGwIP != s2c.RemoteGwIP2 {
-			return fmt.Errorf("'backup_remote_gateway_ip' is required to be empty or the same as 'remote_gateway_ip' when single IP HA is enabled")
+			return diag.Errorf("'backup_remote_gateway_ip' is required to be empty or the same as 'remote_gateway_ip' when single IP HA is enabled")
 		}
 		if s2c.BackupPreSharedKey != "" && s2c.PreSharedKey != s2c.BackupPreSharedKey {
-			return fmt.Errorf("'backup_pre_shared_key' is required to be empty or the same as 'pre_shared_key' when single IP HA is enabled")
+			return diag.Errorf("'backup_pre_shared_key' is required to be empty or the same as 'pre_shared_key' when single IP HA is enabled")
 		}
 		if s2c.BackupLocalTunnelIp != "" || s2c.BackupRemoteTunnelIp != "" {
-			return fmt.Errorf("'backup_local_tunnel_ip' and 'backup_remote_tunnel_ip' are required to be empty when single IP HA is enabled")
+			return diag.Errorf("'backup_local_tunnel_ip' and 'backup_remote_tunnel_ip' are required to be empty when single IP HA is enabled")
 		}
 		s2c.EnableSingleIpHA = true
 	}
 
 	if !s2c.CustomMap && s2c.RemoteSubnet == "" {
-		return fmt.Errorf("'remote_subnet_cidr' is required unless you are using 'custom_mapped'")
+		return diag.Errorf("'remote_subnet_cidr' is required unless you are using 'custom_mapped'")
 	}
 	if s2c.CustomMap {
 		if s2c.RemoteSubnet != "" {
-			return fmt.Errorf("'remote_subnet_cidr' is not valid for 'custom_mapped' connection")
+			return diag.Errorf("'remote_subnet_cidr' is not valid for 'custom_mapped' connection")
 		}
 		if s2c.RemoteSubnetVirtual != "" {
-			return fmt.Errorf("'remote_subnet_virtual' is not valid for 'custom_mapped' connection")
+			return diag.Errorf("'remote_subnet_virtual' is not valid for 'custom_mapped' connection")
 		}
 		if s2c.LocalSubnet != "" {
-			return fmt.Errorf("'local_subnet_cidr' is not valid for 'custom_mapped' connection")
+			return diag.Errorf("'local_subnet_cidr' is not valid for 'custom_mapped' connection")
 		}
 		if s2c.LocalSubnetVirtual != "" {
-			return fmt.Errorf("'local_subnet_virtual' is not valid for 'custom_mapped' connection")
+			return diag.Errorf("'local_subnet_virtual' is not valid for 'custom_mapped' connection")
 		}
 	}
 	if s2c.ConnType == "mapped" && !s2c.CustomMap && (s2c.RemoteSubnetVirtual == "" || s2c.LocalSubnetVirtual == "") {
-		return fmt.Errorf("'remote_subnet_virtual' and 'local_subnet_virtual' are both required for " +
+		return diag.Errorf("'remote_subnet_virtual' and 'local_subnet_virtual' are both required for " +
 			"connection type: mapped, unless 'custom_mapped' is enabled")
 	} else if s2c.ConnType == "unmapped" && (s2c.RemoteSubnetVirtual != "" || s2c.LocalSubnetVirtual != "") {
-		return fmt.Errorf("'remote_subnet_virtual' and 'local_subnet_virtual' both should be empty for " +
+		return diag.Errorf("'remote_subnet_virtual' and 'local_subnet_virtual' both should be empty for " +
 			"connection type: ummapped")
 	}
 	hasSetAnyCustomMapAttribute := s2c.RemoteSourceRealCIDRs != "" || s2c.RemoteSourceVirtualCIDRs != "" ||
@@ -585,15 +586,15 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 		s2c.LocalSourceRealCIDRs != "" || s2c.LocalSourceVirtualCIDRs != "" ||
 		s2c.LocalDestinationRealCIDRs != "" || s2c.LocalDestinationVirtualCIDRs != ""
 	if !s2c.CustomMap && hasSetAnyCustomMapAttribute {
-		return fmt.Errorf("attributes %v are only valid with 'custom_mapped' enabled", customMappedAttributeNames)
+		return diag.Errorf("attributes %v are only valid with 'custom_mapped' enabled", customMappedAttributeNames)
 	}
 	if s2c.CustomMap && (s2c.ConnType != "mapped" || s2c.TunnelType != "route") {
-		return fmt.Errorf("'connection_type' should be 'mapped' and 'tunnel_type' should be 'route' for 'custom_mapped' enabled connection")
+		return diag.Errorf("'connection_type' should be 'mapped' and 'tunnel_type' should be 'route' for 'custom_mapped' enabled connection")
 	}
 	hasSetAllCustomRemoteCIDRs := s2c.RemoteSourceRealCIDRs != "" && s2c.RemoteSourceVirtualCIDRs != "" && s2c.RemoteDestinationRealCIDRs != "" && s2c.RemoteDestinationVirtualCIDRs != ""
 	hasSetAllCustomLocalCIDRs := s2c.LocalSourceRealCIDRs != "" && s2c.LocalSourceVirtualCIDRs != "" && s2c.LocalDestinationRealCIDRs != "" && s2c.LocalDestinationVirtualCIDRs != ""
 	if s2c.CustomMap && !hasSetAllCustomLocalCIDRs && !hasSetAllCustomRemoteCIDRs {
-		return fmt.Errorf("'custom_mapped' enabled connection requires either all Remote Initiated CIDRs or all Local Initiated CIDRs be provided")
+		return diag.Errorf("'custom_mapped' enabled connection requires either all Remote Initiated CIDRs or all Local Initiated CIDRs be provided")
 	}
 
 	s2c.Phase1Auth = d.Get("phase_1_authentication").(string)
@@ -611,44 +612,44 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 			s2c.Phase2DhGroups == "" ||
 			s2c.Phase1Encryption == "" ||
 			s2c.Phase2Encryption == "" {
-			return fmt.Errorf("custom_algorithms is enabled, please set all of the algorithm parameters")
+			return diag.Errorf("custom_algorithms is enabled, please set all of the algorithm parameters")
 		} else if s2c.Phase1Auth == goaviatrix.Phase1AuthDefault &&
 			s2c.Phase2Auth == goaviatrix.Phase2AuthDefault &&
 			s2c.Phase1DhGroups == goaviatrix.Phase1DhGroupDefault &&
 			s2c.Phase2DhGroups == goaviatrix.Phase2DhGroupDefault &&
 			s2c.Phase1Encryption == goaviatrix.Phase1EncryptionDefault &&
 			s2c.Phase2Encryption == goaviatrix.Phase2EncryptionDefault {
-			return fmt.Errorf("custom_algorithms is enabled, cannot use default values for " +
+			return diag.Errorf("custom_algorithms is enabled, cannot use default values for " +
 				"all six algorithm parameters. Please change the value of at least one of the six algorithm parameters")
 		}
 	} else {
 		if s2c.Phase1Auth != "" {
-			return fmt.Errorf("custom_algorithms is disabled, phase_1_authentication should be empty")
+			return diag.Errorf("custom_algorithms is disabled, phase_1_authentication should be empty")
 		} else {
 			s2c.Phase1Auth = goaviatrix.Phase1AuthDefault
 		}
 		if s2c.Phase1DhGroups != "" {
-			return fmt.Errorf("custom_algorithms is disabled, phase_1_dh_groups should be empty")
+			return diag.Errorf("custom_algorithms is disabled, phase_1_dh_groups should be empty")
 		} else {
 			s2c.Phase1DhGroups = goaviatrix.Phase1DhGroupDefault
 		}
 		if s2c.Phase1Encryption != "" {
-			return fmt.Errorf("custom_algorithms is disabled, phase_1_encryption should be empty")
+			return diag.Errorf("custom_algorithms is disabled, phase_1_encryption should be empty")
 		} else {
 			s2c.Phase1Encryption = goaviatrix.Phase1EncryptionDefault
 		}
 		if s2c.Phase2Auth != "" {
-			return fmt.Errorf("custom_algorithms is disabled, phase_2_authentication should be empty")
+			return diag.Errorf("custom_algorithms is disabled, phase_2_authentication should be empty")
 		} else {
 			s2c.Phase2Auth = goaviatrix.Phase2AuthDefault
 		}
 		if s2c.Phase2DhGroups != "" {
-			return fmt.Errorf("custom_algorithms is disabled, phase_2_dh_groups should be empty")
+			return diag.Errorf("custom_algorithms is disabled, phase_2_dh_groups should be empty")
 		} else {
 			s2c.Phase2DhGroups = goaviatrix.Phase2DhGroupDefault
 		}
 		if s2c.Phase2Encryption != "" {
-			return fmt.Errorf("custom_algorithms is disabled, phase_2_encryption should be empty")
+			return diag.Errorf("custom_algorithms is disabled, phase_2_encryption should be empty")
 		} else {
 			s2c.Phase2Encryption = goaviatrix.Phase2EncryptionDefault
 		}
@@ -671,18 +672,18 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	backupRemoteGwLongitude := d.Get("backup_remote_gateway_longitude").(float64)
 
 	if privateRouteEncryption && len(routeTableList) == 0 {
-		return fmt.Errorf("private_route_encryption is enabled, route_table_list cannot be empty")
+		return diag.Errorf("private_route_encryption is enabled, route_table_list cannot be empty")
 	} else if privateRouteEncryption {
 		s2c.PrivateRouteEncryption = "true"
 		s2c.RouteTableList = routeTableList
 		if remoteGwLatitude == 0 || remoteGwLongitude == 0 {
-			return fmt.Errorf("private_route_encryption is enabled, please set remote_gateway_latitude and remote_gateway_longitude")
+			return diag.Errorf("private_route_encryption is enabled, please set remote_gateway_latitude and remote_gateway_longitude")
 		}
 		s2c.RemoteGwLatitude = remoteGwLatitude
 		s2c.RemoteGwLongitude = remoteGwLongitude
 		if s2c.HAEnabled == "yes" {
 			if backupRemoteGwLatitude == 0 || backupRemoteGwLongitude == 0 {
-				return fmt.Errorf("private_route_encryption is enabled and ha is enabled, please set backup_remote_gateway_latitude and backup_remote_gateway_longitude")
+				return diag.Errorf("private_route_encryption is enabled and ha is enabled, please set backup_remote_gateway_latitude and backup_remote_gateway_longitude")
 			}
 			s2c.BackupRemoteGwLatitude = backupRemoteGwLatitude
 			s2c.BackupRemoteGwLongitude = backupRemoteGwLongitude
@@ -690,10 +691,10 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	} else {
 		s2c.PrivateRouteEncryption = "false"
 		if len(routeTableList) != 0 {
-			return fmt.Errorf("private route encryption is disabled, route_table_list should be empty")
+			return diag.Errorf("private route encryption is disabled, route_table_list should be empty")
 		}
 		if remoteGwLatitude != 0 || remoteGwLongitude != 0 || backupRemoteGwLatitude != 0 || backupRemoteGwLongitude != 0 {
-			return fmt.Errorf("private route encryption is disabled, all of remote_gateway_latitude, " +
+			return diag.Errorf("private route encryption is disabled, all of remote_gateway_latitude, " +
 				"backup_remote_gateway_latitude and backup_remote_gateway_longitude should be empty")
 		}
 	}
@@ -701,14 +702,14 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	sslServerPool := d.Get("ssl_server_pool").(string)
 
 	if s2c.TunnelType == "udp" && sslServerPool != "" {
-		return fmt.Errorf("ssl_server_pool only supports tunnel type 'tcp'")
+		return diag.Errorf("ssl_server_pool only supports tunnel type 'tcp'")
 	}
 	if s2c.TunnelType == "tcp" && s2c.RemoteGwType != "avx" {
-		return fmt.Errorf("only 'avx' remote gateway type is supported for tunnel type 'tcp'")
+		return diag.Errorf("only 'avx' remote gateway type is supported for tunnel type 'tcp'")
 	}
 	if s2c.TunnelType == "tcp" {
 		if sslServerPool == goaviatrix.SslServerPoolDefault {
-			return fmt.Errorf("'192.168.44.0/24' is default, please specify a different value for ssl_server_pool")
+			return diag.Errorf("'192.168.44.0/24' is default, please specify a different value for ssl_server_pool")
 		} else if sslServerPool == "" {
 			s2c.SslServerPool = goaviatrix.SslServerPoolDefault
 		} else {
@@ -720,57 +721,57 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 	ph1RemoteIdList := goaviatrix.ExpandStringList(phase1RemoteIdentifier)
 	if haEnabled && !singleIpHA && len(phase1RemoteIdentifier) != 0 && len(phase1RemoteIdentifier) != 2 {
 		if !(s2c.RemoteGwIP != "" && s2c.RemoteGwIP2 != "" && s2c.RemoteGwIP == s2c.RemoteGwIP2 && len(phase1RemoteIdentifier) == 1) {
-			return fmt.Errorf("please either set two phase 1 remote IDs or none, when HA is enabled and single IP HA is disabled")
+			return diag.Errorf("please either set two phase 1 remote IDs or none, when HA is enabled and single IP HA is disabled")
 		}
 	} else if (!haEnabled || singleIpHA) && len(phase1RemoteIdentifier) > 1 {
-		return fmt.Errorf("please either set one phase 1 remote ID or none, when HA is disabled or single IP HA is enabled")
+		return diag.Errorf("please either set one phase 1 remote ID or none, when HA is disabled or single IP HA is enabled")
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Site2Cloud: %#v", s2c)
+	tflog.Info(ctx, "Creating Aviatrix Site2Cloud", map[string]interface{}{"tunnel_name": s2c.TunnelName})
 
 	d.SetId(s2c.TunnelName + "~" + s2c.VpcID)
 	flag := false
-	defer resourceAviatrixSite2CloudReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSite2CloudReadIfRequired(ctx, d, meta, &flag)
 
-	err = client.CreateSite2Cloud(s2c)
+	err = client.CreateSite2CloudContext(ctx, s2c)
 	if err != nil {
-		return fmt.Errorf("failed Site2Cloud create: %s", err)
+		return diag.Errorf("failed Site2Cloud create: %s", err)
 	}
 
 	enableDeadPeerDetection := d.Get("enable_dead_peer_detection").(bool)
 	if !enableDeadPeerDetection {
-		err := client.DisableDeadPeerDetection(s2c)
+		err := client.DisableDeadPeerDetectionContext(ctx, s2c)
 		if err != nil {
-			return fmt.Errorf("failed to disable dead peer detection: %s", err)
+			return diag.Errorf("failed to disable dead peer detection: %s", err)
 		}
 	}
 
 	if activeActive {
-		err := client.EnableSite2cloudActiveActive(s2c)
+		err := client.EnableSite2cloudActiveActiveContext(ctx, s2c)
 		if err != nil {
-			return fmt.Errorf("failed to enable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
+			return diag.Errorf("failed to enable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
 		}
 	} else {
 		if gw.TransitVpc == "no" && s2c.ConnType == "unmapped" && s2c.TunnelType == "route" && haEnabled {
-			err := client.DisableSite2cloudActiveActive(s2c)
+			err := client.DisableSite2cloudActiveActiveContext(ctx, s2c)
 			if err != nil {
-				return fmt.Errorf("failed to disable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
+				return diag.Errorf("failed to disable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
 			}
 		}
 	}
 
 	forwardToTransit := d.Get("forward_traffic_to_transit").(bool)
 	if forwardToTransit {
-		err := client.EnableSpokeMappedSite2CloudForwarding(s2c)
+		err := client.EnableSpokeMappedSite2CloudForwardingContext(ctx, s2c)
 		if err != nil {
-			return fmt.Errorf("failed to enable traffic forwarding to transit: %v", err)
+			return diag.Errorf("failed to enable traffic forwarding to transit: %v", err)
 		}
 	}
 
 	if d.Get("enable_event_triggered_ha").(bool) {
-		err := client.EnableSite2CloudEventTriggeredHA(s2c.VpcID, s2c.TunnelName)
+		err := client.EnableSite2CloudEventTriggeredHAContext(ctx, s2c.VpcID, s2c.TunnelName)
 		if err != nil {
-			return fmt.Errorf("could not enable event triggered HA for site2cloud after creation: %v", err)
+			return diag.Errorf("could not enable event triggered HA for site2cloud after creation: %v", err)
 		}
 	}
 
@@ -790,9 +791,9 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 			Phase1RemoteIdentifier: ph1RemoteId,
 		}
 
-		err = client.UpdateSite2Cloud(editSite2cloud)
+		err = client.UpdateSite2CloudContext(ctx, editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update Site2Cloud phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -816,9 +817,9 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 			Phase1RemoteIdentifier: ph1RemoteId,
 		}
 
-		err = client.UpdateSite2Cloud(editSite2cloud)
+		err = client.UpdateSite2CloudContext(ctx, editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update Site2Cloud phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -829,35 +830,35 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta interface{})
 		}
 		if phase1LocalIdentifier == "private_ip" {
 			s2c.Phase1LocalIdentifier = "private_ip"
-			err = client.EditSite2CloudPhase1LocalIdentifier(s2c)
+			err = client.EditSite2CloudPhase1LocalIdentifierContext(ctx, s2c)
 			if err != nil {
-				return fmt.Errorf("could not set phase1 local identificer to private_ip for connection: %s: %v", s2c.ConnName, err)
+				return diag.Errorf("could not set phase1 local identificer to private_ip for connection: %s: %v", s2c.ConnName, err)
 			}
 		}
 	}
 
-	return resourceAviatrixSite2CloudReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSite2CloudReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSite2CloudReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSite2CloudReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSite2CloudRead(d, meta)
+		return resourceAviatrixSite2CloudRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSite2CloudRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	tunnelName := d.Get("connection_name").(string)
 	vpcID := d.Get("vpc_id").(string)
 	if tunnelName == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no tunnel name or vpc id names received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no tunnel name or vpc id names received", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-			return fmt.Errorf("invalid import ID format")
+			return diag.Errorf("invalid import ID format")
 		}
 		d.Set("connection_name", parts[0])
 		d.Set("vpc_id", parts[1])
@@ -868,13 +869,13 @@ func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta interface{}) er
 		TunnelName: d.Get("connection_name").(string),
 		VpcID:      d.Get("vpc_id").(string),
 	}
-	s2c, err := client.GetSite2CloudConnDetail(site2cloud)
+	s2c, err := client.GetSite2CloudConnDetailContext(ctx, site2cloud)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Site2Cloud: %s, %#v", err, s2c)
+		return diag.Errorf("couldn't find Aviatrix Site2Cloud %s: %s", site2cloud.TunnelName, err)
 	}
 
 	if s2c != nil {
@@ -940,7 +941,7 @@ func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta interface{}) er
 		if s2c.PrivateRouteEncryption == "true" {
 			d.Set("private_route_encryption", true)
 			if err := d.Set("route_table_list", s2c.RouteTableList); err != nil {
-				log.Printf("[WARN] Error setting route_table_list for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, "Error setting route_table_list", map[string]interface{}{"id": d.Id(), "error": err})
 			}
 			d.Set("remote_gateway_latitude", s2c.RemoteGwLatitude)
 			d.Set("remote_gateway_longitude", s2c.RemoteGwLongitude)
@@ -970,42 +971,42 @@ func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta interface{}) er
 
 		if s2c.RemoteSourceRealCIDRs != "" {
 			if err := d.Set("remote_source_real_cidrs", strings.Split(s2c.RemoteSourceRealCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'remote_source_real_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'remote_source_real_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.RemoteSourceVirtualCIDRs != "" {
 			if err := d.Set("remote_source_virtual_cidrs", strings.Split(s2c.RemoteSourceVirtualCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'remote_source_virtual_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'remote_source_virtual_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.RemoteDestinationRealCIDRs != "" {
 			if err := d.Set("remote_destination_real_cidrs", strings.Split(s2c.RemoteDestinationRealCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'remote_destination_real_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'remote_destination_real_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.RemoteDestinationVirtualCIDRs != "" {
 			if err := d.Set("remote_destination_virtual_cidrs", strings.Split(s2c.RemoteDestinationVirtualCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'remote_destination_virtual_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'remote_destination_virtual_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.LocalSourceRealCIDRs != "" {
 			if err := d.Set("local_source_real_cidrs", strings.Split(s2c.LocalSourceRealCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'local_source_real_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'local_source_real_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.LocalSourceVirtualCIDRs != "" {
 			if err := d.Set("local_source_virtual_cidrs", strings.Split(s2c.LocalSourceVirtualCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'local_source_virtual_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'local_source_virtual_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.LocalDestinationRealCIDRs != "" {
 			if err := d.Set("local_destination_real_cidrs", strings.Split(s2c.LocalDestinationRealCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'local_destination_real_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'local_destination_real_cidrs' to state: %v", err)
 			}
 		}
 		if s2c.LocalDestinationVirtualCIDRs != "" {
 			if err := d.Set("local_destination_virtual_cidrs", strings.Split(s2c.LocalDestinationVirtualCIDRs, ",")); err != nil {
-				return fmt.Errorf("could not write 'local_destination_virtual_cidrs' to state: %v", err)
+				return diag.Errorf("could not write 'local_destination_virtual_cidrs' to state: %v", err)
 			}
 		}
 
@@ -1026,15 +1027,15 @@ func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("phase1_remote_identifier", ph1RemoteId)
 	}
 
-	log.Printf("[TRACE] Reading Aviatrix Site2Cloud %s: %#v", d.Get("connection_name").(string), site2cloud)
-	log.Printf("[TRACE] Reading Aviatrix Site2Cloud connection_type: [%s]", d.Get("connection_type").(string))
+	tflog.Trace(ctx, "Reading Aviatrix Site2Cloud", map[string]interface{}{"connection_name": d.Get("connection_name").(string)})
+	tflog.Trace(ctx, "Reading Aviatrix Site2Cloud connection_type", map[string]interface{}{"connection_type": d.Get("connection_type").(string)})
 
 	d.SetId(site2cloud.TunnelName + "~" + site2cloud.VpcID)
 	return nil
 }
 
-func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSite2CloudUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	editSite2cloud := &goaviatrix.EditSite2Cloud{
 		GwName:   d.Get("primary_cloud_gateway_name").(string),
@@ -1043,65 +1044,65 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.Partial(true)
-	log.Printf("[INFO] Updating Aviatrix Site2Cloud: %#v", editSite2cloud)
+	tflog.Info(ctx, "Updating Aviatrix Site2Cloud", map[string]interface{}{"gw_name": editSite2cloud.GwName})
 
 	if d.HasChange("local_subnet_cidr") {
 		if d.Get("custom_mapped").(bool) && d.Get("local_subnet_cidr").(string) != "" {
-			return fmt.Errorf("'local_subnet_cidr' is not valid when 'custom_mapped' is enabled")
+			return diag.Errorf("'local_subnet_cidr' is not valid when 'custom_mapped' is enabled")
 		}
 		editSite2cloud.CloudSubnetCidr = d.Get("local_subnet_cidr").(string)
 		editSite2cloud.CloudSubnetVirtual = d.Get("local_subnet_virtual").(string)
-		err := client.UpdateSite2Cloud(editSite2cloud)
+		err := client.UpdateSite2CloudContext(ctx, editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud local_subnet_cidr: %s", err)
+			return diag.Errorf("failed to update Site2Cloud local_subnet_cidr: %s", err)
 		}
 	}
 
 	if d.HasChange("local_subnet_virtual") {
 		if d.Get("custom_mapped").(bool) && d.Get("local_subnet_virtual").(string) != "" {
-			return fmt.Errorf("'local_subnet_virtual' is not valid when 'custom_mapped' is enabled")
+			return diag.Errorf("'local_subnet_virtual' is not valid when 'custom_mapped' is enabled")
 		}
 		if d.Get("connection_type").(string) == "mapped" && d.Get("local_subnet_virtual").(string) == "" {
-			return fmt.Errorf("'local_subnet_virtual' is required for connection type: mapped, unless 'custom_mapped' is enabled")
+			return diag.Errorf("'local_subnet_virtual' is required for connection type: mapped, unless 'custom_mapped' is enabled")
 		}
 		if d.Get("connection_type").(string) == "unmapped" && d.Get("local_subnet_virtual").(string) != "" {
-			return fmt.Errorf("'local_subnet_virtual' should be empty for connection type: ummapped")
+			return diag.Errorf("'local_subnet_virtual' should be empty for connection type: ummapped")
 		}
 		editSite2cloud.CloudSubnetCidr = d.Get("local_subnet_cidr").(string)
 		editSite2cloud.CloudSubnetVirtual = d.Get("local_subnet_virtual").(string)
-		err := client.UpdateSite2Cloud(editSite2cloud)
+		err := client.UpdateSite2CloudContext(ctx, editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud local_subnet_virtual: %s", err)
+			return diag.Errorf("failed to update Site2Cloud local_subnet_virtual: %s", err)
 		}
 	}
 
 	if d.HasChange("remote_subnet_cidr") {
 		if d.Get("custom_mapped").(bool) && d.Get("remote_subnet_cidr").(string) != "" {
-			return fmt.Errorf("'remote_subnet_cidr' is not valid when 'custom_mapped' is enabled")
+			return diag.Errorf("'remote_subnet_cidr' is not valid when 'custom_mapped' is enabled")
 		}
 		editSite2cloud.RemoteSubnet = d.Get("remote_subnet_cidr").(string)
 		editSite2cloud.RemoteSubnetVirtual = d.Get("remote_subnet_virtual").(string)
-		err := client.UpdateSite2Cloud(editSite2cloud)
+		err := client.UpdateSite2CloudContext(ctx, editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud remote_subnet_cidr: %s", err)
+			return diag.Errorf("failed to update Site2Cloud remote_subnet_cidr: %s", err)
 		}
 	}
 
 	if d.HasChange("remote_subnet_virtual") {
 		if d.Get("custom_mapped").(bool) && d.Get("remote_subnet_virtual").(string) != "" {
-			return fmt.Errorf("'remote_subnet_virtual' is not valid when 'custom_mapped' is enabled")
+			return diag.Errorf("'remote_subnet_virtual' is not valid when 'custom_mapped' is enabled")
 		}
 		if d.Get("connection_type").(string) == "mapped" && d.Get("remote_subnet_virtual").(string) == "" {
-			return fmt.Errorf("'remote_subnet_virtual' is required for connection type: mapped, unless 'custom_mapped' is enabled")
+			return diag.Errorf("'remote_subnet_virtual' is required for connection type: mapped, unless 'custom_mapped' is enabled")
 		}
 		if d.Get("connection_type").(string) == "unmapped" && d.Get("remote_subnet_virtual").(string) != "" {
-			return fmt.Errorf("'remote_subnet_virtual' should be empty for connection type: ummapped")
+			return diag.Errorf("'remote_subnet_virtual' should be empty for connection type: ummapped")
 		}
 		editSite2cloud.RemoteSubnet = d.Get("remote_subnet_cidr").(string)
 		editSite2cloud.RemoteSubnetVirtual = d.Get("remote_subnet_virtual").(string)
-		err := client.UpdateSite2Cloud(editSite2cloud)
+		err := client.UpdateSite2CloudContext(ctx, editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud remote_subnet_virtual: %s", err)
+			return diag.Errorf("failed to update Site2Cloud remote_subnet_virtual: %s", err)
 		}
 	}
 
@@ -1112,14 +1113,14 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 		}
 		enableDeadPeerDetection := d.Get("enable_dead_peer_detection").(bool)
 		if enableDeadPeerDetection {
-			err := client.EnableDeadPeerDetection(s2c)
+			err := client.EnableDeadPeerDetectionContext(ctx, s2c)
 			if err != nil {
-				return fmt.Errorf("failed to enable deed peer detection: %s", err)
+				return diag.Errorf("failed to enable deed peer detection: %s", err)
 			}
 		} else {
-			err := client.DisableDeadPeerDetection(s2c)
+			err := client.DisableDeadPeerDetectionContext(ctx, s2c)
 			if err != nil {
-				return fmt.Errorf("failed to disable deed peer detection: %s", err)
+				return diag.Errorf("failed to disable deed peer detection: %s", err)
 			}
 		}
 	}
@@ -1132,16 +1133,16 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 		activeActive := d.Get("enable_active_active").(bool)
 		if activeActive {
 			if haEnabled := d.Get("ha_enabled").(bool); !haEnabled {
-				return fmt.Errorf("active_active_ha can't be enabled if HA isn't enabled for site2cloud connection")
+				return diag.Errorf("active_active_ha can't be enabled if HA isn't enabled for site2cloud connection")
 			}
-			err := client.EnableSite2cloudActiveActive(s2c)
+			err := client.EnableSite2cloudActiveActiveContext(ctx, s2c)
 			if err != nil {
-				return fmt.Errorf("failed to enable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
+				return diag.Errorf("failed to enable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
 			}
 		} else {
-			err := client.DisableSite2cloudActiveActive(s2c)
+			err := client.DisableSite2cloudActiveActiveContext(ctx, s2c)
 			if err != nil {
-				return fmt.Errorf("failed to disable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
+				return diag.Errorf("failed to disable active active HA for site2cloud: %s: %s", s2c.TunnelName, err)
 			}
 		}
 	}
@@ -1153,35 +1154,35 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 		}
 		forwardToTransit := d.Get("forward_traffic_to_transit").(bool)
 		if forwardToTransit {
-			err := client.EnableSpokeMappedSite2CloudForwarding(s2c)
+			err := client.EnableSpokeMappedSite2CloudForwardingContext(ctx, s2c)
 			if err != nil {
-				return fmt.Errorf("failed to enable traffic forwarding to transit for site2cloud: %s: %s", s2c.TunnelName, err)
+				return diag.Errorf("failed to enable traffic forwarding to transit for site2cloud: %s: %s", s2c.TunnelName, err)
 			}
 		} else {
-			err := client.DisableSpokeMappedSite2CloudForwarding(s2c)
+			err := client.DisableSpokeMappedSite2CloudForwardingContext(ctx, s2c)
 			if err != nil {
-				return fmt.Errorf("failed to disable traffic forwarding to transit for site2cloud: %s: %s", s2c.TunnelName, err)
+				return diag.Errorf("failed to disable traffic forwarding to transit for site2cloud: %s: %s", s2c.TunnelName, err)
 			}
 		}
 	}
 
 	if d.HasChange("enable_event_triggered_ha") {
 		if d.Get("enable_event_triggered_ha").(bool) {
-			err := client.EnableSite2CloudEventTriggeredHA(editSite2cloud.VpcID, editSite2cloud.ConnName)
+			err := client.EnableSite2CloudEventTriggeredHAContext(ctx, editSite2cloud.VpcID, editSite2cloud.ConnName)
 			if err != nil {
-				return fmt.Errorf("could not enable event triggered HA for site2cloud during update: %v", err)
+				return diag.Errorf("could not enable event triggered HA for site2cloud during update: %v", err)
 			}
 		} else {
-			err := client.DisableSite2CloudEventTriggeredHA(editSite2cloud.VpcID, editSite2cloud.ConnName)
+			err := client.DisableSite2CloudEventTriggeredHAContext(ctx, editSite2cloud.VpcID, editSite2cloud.ConnName)
 			if err != nil {
-				return fmt.Errorf("could not disable event triggered HA for site2cloud during update: %v", err)
+				return diag.Errorf("could not disable event triggered HA for site2cloud during update: %v", err)
 			}
 		}
 	}
 
 	if d.HasChanges(customMappedAttributeNames...) {
 		if !d.Get("custom_mapped").(bool) {
-			return fmt.Errorf("attributes %v are not valid when 'custom_mapped' is disabled", customMappedAttributeNames)
+			return diag.Errorf("attributes %v are not valid when 'custom_mapped' is disabled", customMappedAttributeNames)
 		}
 		s2c := &goaviatrix.EditSite2Cloud{
 			GwName:                        d.Get("primary_cloud_gateway_name").(string),
@@ -1199,11 +1200,11 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 		hasSetAllCustomRemoteCIDRs := s2c.RemoteSourceRealCIDRs != "" && s2c.RemoteSourceVirtualCIDRs != "" && s2c.RemoteDestinationRealCIDRs != "" && s2c.RemoteDestinationVirtualCIDRs != ""
 		hasSetAllCustomLocalCIDRs := s2c.LocalSourceRealCIDRs != "" && s2c.LocalSourceVirtualCIDRs != "" && s2c.LocalDestinationRealCIDRs != "" && s2c.LocalDestinationVirtualCIDRs != ""
 		if !hasSetAllCustomLocalCIDRs && !hasSetAllCustomRemoteCIDRs {
-			return fmt.Errorf("'custom_mapped' enabled connection requires either all Remote Initiated CIDRs or all Local Initiated CIDRs be provided")
+			return diag.Errorf("'custom_mapped' enabled connection requires either all Remote Initiated CIDRs or all Local Initiated CIDRs be provided")
 		}
-		err := client.UpdateSite2Cloud(s2c)
+		err := client.UpdateSite2CloudContext(ctx, s2c)
 		if err != nil {
-			return fmt.Errorf("could not update site2cloud connection Remote or Local CIDRs: %v", err)
+			return diag.Errorf("could not update site2cloud connection Remote or Local CIDRs: %v", err)
 		}
 	}
 
@@ -1217,10 +1218,10 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 
 		if haEnabled && !singleIpHA && len(phase1RemoteIdentifier) != 2 {
 			if !(ip != "" && haIp != "" && ip == haIp && len(phase1RemoteIdentifier) == 1) {
-				return fmt.Errorf("please set two phase 1 remote IDs, when HA is enabled and single IP HA is disabled")
+				return diag.Errorf("please set two phase 1 remote IDs, when HA is enabled and single IP HA is disabled")
 			}
 		} else if (!haEnabled || singleIpHA) && len(phase1RemoteIdentifier) != 1 {
-			return fmt.Errorf("please set one phase 1 remote ID, when HA is disabled or single IP HA is enabled")
+			return diag.Errorf("please set one phase 1 remote ID, when HA is disabled or single IP HA is enabled")
 		}
 
 		var ph1RemoteId string
@@ -1246,9 +1247,9 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 		editSite2cloud.Phase1RemoteIdentifier = ph1RemoteId
-		err := client.UpdateSite2Cloud(editSite2cloud)
+		err := client.UpdateSite2CloudContext(ctx, editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update Site2Cloud phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update Site2Cloud phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -1260,14 +1261,14 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 
 		if authType == "Cert" {
 			if remoteIdentifier == "" {
-				return fmt.Errorf("'ca_cert_tag_name' and 'remote_identifier' are both required for Cert based authentication type")
+				return diag.Errorf("'ca_cert_tag_name' and 'remote_identifier' are both required for Cert based authentication type")
 			}
 			if haEnabled && backupRemoteIdentifier == "" {
-				return fmt.Errorf("'backup_remote_identifier' is required for Cert based authentication type with HA enabled")
+				return diag.Errorf("'backup_remote_identifier' is required for Cert based authentication type with HA enabled")
 			}
 		} else {
 			if remoteIdentifier != "" || backupRemoteIdentifier != "" {
-				return fmt.Errorf("'remote_identifier' and 'backup_remote_identifier' are both required to be empty for PSK(Pubkey) based authentication type")
+				return diag.Errorf("'remote_identifier' and 'backup_remote_identifier' are both required to be empty for PSK(Pubkey) based authentication type")
 			}
 		}
 		if d.HasChanges("remote_identifier") {
@@ -1277,9 +1278,9 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 				RemoteIdentifier: remoteIdentifier,
 			}
 
-			err := client.UpdateSite2Cloud(s2c)
+			err := client.UpdateSite2CloudContext(ctx, s2c)
 			if err != nil {
-				return fmt.Errorf("failed to update remote identifier: %s", err)
+				return diag.Errorf("failed to update remote identifier: %s", err)
 			}
 		}
 		if d.HasChanges("backup_remote_identifier") {
@@ -1289,9 +1290,9 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 				BackupRemoteIdentifier: backupRemoteIdentifier,
 			}
 
-			err := client.UpdateSite2Cloud(s2c)
+			err := client.UpdateSite2CloudContext(ctx, s2c)
 			if err != nil {
-				return fmt.Errorf("failed to update backup remote identifier: %s", err)
+				return diag.Errorf("failed to update backup remote identifier: %s", err)
 			}
 		}
 	}
@@ -1302,38 +1303,38 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta interface{})
 			ConnName:              d.Get("connection_name").(string),
 			Phase1LocalIdentifier: d.Get("phase1_local_identifier").(string),
 		}
-		err := client.EditSite2CloudPhase1LocalIdentifier(s2c)
+		err := client.EditSite2CloudPhase1LocalIdentifierContext(ctx, s2c)
 		if err != nil {
-			return fmt.Errorf("could not update phase1 local identificer for connection: %s: %v", s2c.ConnName, err)
+			return diag.Errorf("could not update phase1 local identificer for connection: %s: %v", s2c.ConnName, err)
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(editSite2cloud.ConnName + "~" + editSite2cloud.VpcID)
-	return resourceAviatrixSite2CloudRead(d, meta)
+	return resourceAviatrixSite2CloudRead(ctx, d, meta)
 }
 
-func resourceAviatrixSite2CloudDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSite2CloudDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	s2c := &goaviatrix.Site2Cloud{
 		VpcID:      d.Get("vpc_id").(string),
 		TunnelName: d.Get("connection_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix s2c: %#v", s2c)
+	tflog.Info(ctx, "Deleting Aviatrix s2c", map[string]interface{}{"gw_name": s2c.GwName})
 
 	forwardToTransit := d.Get("forward_traffic_to_transit").(bool)
 	if forwardToTransit {
-		err := client.DisableSpokeMappedSite2CloudForwarding(s2c)
+		err := client.DisableSpokeMappedSite2CloudForwardingContext(ctx, s2c)
 		if err != nil {
-			log.Println("[WARN] Failed to disable forwarding to transit:", err)
+			tflog.Warn(ctx, "Failed to disable forwarding to transit", map[string]interface{}{"error": err})
 		}
 	}
 
-	err := client.DeleteSite2Cloud(s2c)
+	err := client.DeleteSite2CloudContext(ctx, s2c)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Site2Cloud: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Site2Cloud: %s", err)
 	}
 
 	return nil
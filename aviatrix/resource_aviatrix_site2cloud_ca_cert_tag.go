@@ -2,8 +2,8 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -17,7 +17,7 @@ func resourceAviatrixSite2CloudCaCertTag() *schema.Resource {
 		UpdateWithoutTimeout: resourceAviatrixSite2CloudCaCertTagUpdate,
 		DeleteWithoutTimeout: resourceAviatrixSite2CloudCaCertTagDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,7 +71,7 @@ func resourceAviatrixSite2CloudCaCertTag() *schema.Resource {
 }
 
 func resourceAviatrixSite2CloudCaCertTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	for _, v := range d.Get("ca_certificates").(*schema.Set).List() {
 		certInstance := v.(map[string]interface{})
@@ -90,13 +90,13 @@ func resourceAviatrixSite2CloudCaCertTagCreate(ctx context.Context, d *schema.Re
 }
 
 func resourceAviatrixSite2CloudCaCertTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	tagName := d.Get("tag_name").(string)
 
 	if tagName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 		d.Set("tag_name", id)
 		d.SetId(id)
 	}
@@ -129,7 +129,7 @@ func resourceAviatrixSite2CloudCaCertTagRead(ctx context.Context, d *schema.Reso
 		caCertInstances = append(caCertInstances, instanceInfo)
 	}
 	if err := d.Set("ca_certificates", caCertInstances); err != nil {
-		log.Printf("[WARN] Error setting 'ca_certificates' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, "Error setting 'ca_certificates'", map[string]interface{}{"id": d.Id(), "error": err})
 	}
 
 	d.SetId(s2cCaCertTagResp.TagName)
@@ -137,7 +137,7 @@ func resourceAviatrixSite2CloudCaCertTagRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixSite2CloudCaCertTagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 	d.Partial(true)
 
 	if d.HasChange("ca_certificates") {
@@ -182,7 +182,7 @@ func resourceAviatrixSite2CloudCaCertTagUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceAviatrixSite2CloudCaCertTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	for _, cert := range d.Get("ca_certificates").(*schema.Set).List() {
 		certInstance := cert.(map[string]interface{})
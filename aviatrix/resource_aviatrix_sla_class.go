@@ -60,7 +60,7 @@ func marshalSLAClassInput(d *schema.ResourceData) *goaviatrix.SLAClass {
 }
 
 func resourceAviatrixSLAClassCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	slaClass := marshalSLAClassInput(d)
 
@@ -85,7 +85,7 @@ func resourceAviatrixSLAClassReadIfRequired(ctx context.Context, d *schema.Resou
 }
 
 func resourceAviatrixSLAClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	uuid := d.Id()
 	d.Set("uuid", uuid)
@@ -108,7 +108,7 @@ func resourceAviatrixSLAClassRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAviatrixSLAClassUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	uuid := d.Id()
 	d.Partial(true)
@@ -126,7 +126,7 @@ func resourceAviatrixSLAClassUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixSLAClassDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	uuid := d.Id()
 	err := client.DeleteSLAClass(ctx, uuid)
@@ -191,7 +191,7 @@ func marshalSmartGroupInput(d *schema.ResourceData) (*goaviatrix.SmartGroup, err
 }
 
 func resourceAviatrixSmartGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	smartGroup, err := marshalSmartGroupInput(d)
 	if err != nil {
@@ -218,7 +218,7 @@ func resourceAviatrixSmartGroupReadIfRequired(ctx context.Context, d *schema.Res
 }
 
 func resourceAviatrixSmartGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	uuid := d.Id()
 	d.Set("uuid", uuid)
@@ -254,7 +254,7 @@ func resourceAviatrixSmartGroupRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixSmartGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	uuid := d.Id()
 	d.Partial(true)
@@ -275,7 +275,7 @@ func resourceAviatrixSmartGroupUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixSmartGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	uuid := d.Id()
 	err := client.DeleteSmartGroup(ctx, uuid)
@@ -0,0 +1,57 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceAviatrixSmartGroupRead_WhenNotFound(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetSmartGroupFunc: func(ctx context.Context, uuid string) (*goaviatrix.SmartGroup, error) {
+			assert.Equal(t, "unit-test-uuid", uuid)
+			return nil, goaviatrix.ErrNotFound
+		},
+	}
+	defer func() { assert.Len(t, client.GetSmartGroupCalls(), 1) }()
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSmartGroup().Schema, map[string]interface{}{})
+	d.SetId("unit-test-uuid")
+	res := resourceAviatrixSmartGroupRead(context.Background(), d, client)
+
+	assert.Empty(t, res)
+	assert.Empty(t, d.Id())
+}
+
+func TestResourceAviatrixSmartGroupCreate_WhenCreateSmartGroupFails(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		CreateSmartGroupFunc: func(ctx context.Context, smartGroup *goaviatrix.SmartGroup) (string, error) {
+			assert.Equal(t, "unit-test-group", smartGroup.Name)
+			return "", errors.New("controller API failure")
+		},
+		GetSmartGroupFunc: func(ctx context.Context, uuid string) (*goaviatrix.SmartGroup, error) {
+			return nil, goaviatrix.ErrNotFound
+		},
+	}
+	defer func() { assert.Len(t, client.CreateSmartGroupCalls(), 1) }()
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSmartGroup().Schema, map[string]interface{}{
+		"name": "unit-test-group",
+		"selector": []interface{}{
+			map[string]interface{}{
+				"match_expressions": []interface{}{
+					map[string]interface{}{"cidr": "10.0.0.0/16"},
+				},
+			},
+		},
+	})
+	res := resourceAviatrixSmartGroupCreate(context.Background(), d, client)
+
+	assert.Equal(t, diag.Errorf("failed to create Smart Group: controller API failure"), res)
+	assert.Empty(t, d.Id())
+}
@@ -2,26 +2,27 @@ package aviatrix
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixSpokeGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSpokeGatewayCreate,
-		Read:   resourceAviatrixSpokeGatewayRead,
-		Update: resourceAviatrixSpokeGatewayUpdate,
-		Delete: resourceAviatrixSpokeGatewayDelete,
+		CreateContext: resourceAviatrixSpokeGatewayCreate,
+		ReadContext:   resourceAviatrixSpokeGatewayRead,
+		UpdateContext: resourceAviatrixSpokeGatewayUpdate,
+		DeleteContext: resourceAviatrixSpokeGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 2,
@@ -38,6 +39,18 @@ func resourceAviatrixSpokeGateway() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffTagsAll(goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes),
+			customizeDiffAccountAudit,
+			resourceAviatrixSpokeGatewayCustomizeDiff,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cloud_type": {
 				Type:         schema.TypeInt,
@@ -267,6 +280,12 @@ func resourceAviatrixSpokeGateway() *schema.Resource {
 				Optional:    true,
 				Description: "A map of tags to assign to the spoke gateway.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "All tags of the spoke gateway, including the provider default tags.",
+			},
 			"enable_private_vpc_default_route": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -636,8 +655,148 @@ func resourceAviatrixSpokeGateway() *schema.Resource {
 	}
 }
 
-func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+// resourceAviatrixSpokeGatewayCustomizeDiff checks the cross-field rules
+// enforced by resourceAviatrixSpokeGatewayCreate at plan time, so invalid
+// combinations are reported before a gateway is launched.
+func resourceAviatrixSpokeGatewayCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("cloud_type") {
+		return nil
+	}
+	cloudType := d.Get("cloud_type").(int)
+	if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
+		if diffNeedsValidation(d, "cloud_type") {
+			return fmt.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
+		}
+		return nil
+	}
+
+	if diffNeedsValidation(d, "manage_ha_gateway", "ha_subnet", "ha_zone", "ha_insane_mode_az", "ha_gw_size") && !d.Get("manage_ha_gateway").(bool) {
+		if d.Get("ha_subnet").(string) != "" || d.Get("ha_zone").(string) != "" || d.Get("ha_insane_mode_az").(string) != "" || d.Get("ha_gw_size").(string) != "" {
+			return fmt.Errorf("'manage_ha_gateway' is set to false. Please set it to true, or use 'aviatrix_spoke_ha_gateway' to manage spoke ha gateway")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "enable_private_vpc_default_route", "enable_skip_public_route_table_update") {
+		if d.Get("enable_private_vpc_default_route").(bool) && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
+			return fmt.Errorf("enable_private_vpc_default_route is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		}
+		if d.Get("enable_skip_public_route_table_update").(bool) && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
+			return fmt.Errorf("enable_skip_public_route_update is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "zone") {
+		if d.Get("zone").(string) != "" && !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
+			return fmt.Errorf("attribute 'zone' is only valid for Azure (8), Azure GOV (32) and Azure CHINA (2048)")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "enable_bgp", "disable_route_propagation", "enable_bgp_over_lan") {
+		enableBgp := d.Get("enable_bgp").(bool)
+		if enableBgp && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWS|goaviatrix.Azure) {
+			return fmt.Errorf("enabling BGP is only supported for AWS (1) and Azure (8)")
+		}
+		if !enableBgp && d.Get("disable_route_propagation").(bool) {
+			return fmt.Errorf("disable route propagation is not supported on Non-BGP Spoke")
+		}
+		bgpOverLan := d.Get("enable_bgp_over_lan").(bool)
+		if bgpOverLan && !enableBgp {
+			return fmt.Errorf("'enable_bgp' is required to be true to enable bgp over lan")
+		}
+		if bgpOverLan && !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
+			return fmt.Errorf("'enable_bgp_over_lan' is only valid for Azure (8), AzureGov (32) or AzureChina (2048)")
+		}
+	}
+
+	if diffNeedsValidation(d, "enable_learned_cidrs_approval", "approved_learned_cidrs") {
+		if !d.Get("enable_learned_cidrs_approval").(bool) && d.Get("approved_learned_cidrs").(*schema.Set).Len() != 0 {
+			return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "availability_domain", "fault_domain") {
+		if !goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (d.Get("availability_domain").(string) != "" || d.Get("fault_domain").(string) != "") {
+			return fmt.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "ha_subnet", "ha_zone", "ha_gw_size") {
+		haSubnet := d.Get("ha_subnet").(string)
+		haZone := d.Get("ha_zone").(string)
+		haGwSize := d.Get("ha_gw_size").(string)
+		if haZone != "" && !goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
+			return fmt.Errorf("'ha_zone' is only valid for GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) providers if enabling HA")
+		}
+		if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) && haSubnet != "" && haZone == "" {
+			return fmt.Errorf("'ha_zone' must be set to enable HA on GCP (4), cannot enable HA with only 'ha_subnet'")
+		}
+		if goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) && haSubnet == "" && haZone != "" {
+			return fmt.Errorf("'ha_subnet' must be provided to enable HA on Azure (8), AzureGov (32) or AzureChina (2048), cannot enable HA with only 'ha_zone'")
+		}
+		if haSubnet == "" && haZone == "" && haGwSize != "" {
+			return fmt.Errorf("'ha_gw_size' is only required if enabling HA")
+		}
+		if (haZone != "" || haSubnet != "") && haGwSize == "" {
+			return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+				"ha_subnet or ha_zone is set")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "insane_mode", "insane_mode_az", "ha_subnet", "ha_insane_mode_az", "subnet") && d.Get("insane_mode").(bool) {
+		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
+			return fmt.Errorf("insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+		}
+		if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
+			if d.Get("insane_mode_az").(string) == "" {
+				return fmt.Errorf("insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+			}
+			if d.Get("ha_subnet").(string) != "" && d.Get("ha_insane_mode_az").(string) == "" {
+				return fmt.Errorf("ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768) provider and ha_subnet is set")
+			}
+		}
+		if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
+			if err := validateInsaneModeSubnet(d.Get("subnet").(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "enable_encrypt_volume", "customer_managed_keys") {
+		enableEncryptVolume := d.Get("enable_encrypt_volume").(bool)
+		if enableEncryptVolume && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
+			return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		}
+		if d.Get("customer_managed_keys").(string) != "" && !enableEncryptVolume {
+			return fmt.Errorf("'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "enable_private_oob", "oob_management_subnet", "oob_availability_zone", "ha_subnet",
+		"ha_oob_management_subnet", "ha_oob_availability_zone") && d.Get("enable_private_oob").(bool) {
+		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
+			return fmt.Errorf("'enable_private_oob' is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+		}
+		if d.Get("oob_availability_zone").(string) == "" {
+			return fmt.Errorf("\"oob_availability_zone\" is required if \"enable_private_oob\" is true")
+		}
+		if d.Get("oob_management_subnet").(string) == "" {
+			return fmt.Errorf("\"oob_management_subnet\" is required if \"enable_private_oob\" is true")
+		}
+		if d.Get("ha_subnet").(string) != "" {
+			if d.Get("ha_oob_availability_zone").(string) == "" {
+				return fmt.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+			}
+			if d.Get("ha_oob_management_subnet").(string) == "" {
+				return fmt.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceAviatrixSpokeGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.SpokeVpc{
 		CloudType:            d.Get("cloud_type").(int),
@@ -669,20 +828,20 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		if haSubnet != "" || haZone != "" || haInsaneModeAz != "" || haEip != "" || haAzureEipNameResourceGroup != "" ||
 			haGwSize != "" || haAvailabilityDomain != "" || haFaultDomain != "" || haOobManagementSubnet != "" ||
 			haPrivateModeSubnetZone != "" || haOobAvailabilityZone != "" || haSoftwareVersion != "" || haOobImageVersion != "" {
-			return fmt.Errorf("'manage_ha_gateway' is set to false. Please set it to true, or use 'aviatrix_spoke_ha_gateway' to manage spoke ha gateway")
+			return diag.Errorf("'manage_ha_gateway' is set to false. Please set it to true, or use 'aviatrix_spoke_ha_gateway' to manage spoke ha gateway")
 		}
 	}
 
 	if d.Get("enable_private_vpc_default_route").(bool) && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("enable_private_vpc_default_route is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("enable_private_vpc_default_route is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if d.Get("enable_skip_public_route_table_update").(bool) && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("enable_skip_public_route_update is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("enable_skip_public_route_update is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if _, hasSetZone := d.GetOk("zone"); !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && hasSetZone {
-		return fmt.Errorf("attribute 'zone' is only valid for Azure (8), Azure GOV (32) and Azure CHINA (2048)")
+		return diag.Errorf("attribute 'zone' is only valid for Azure (8), Azure GOV (32) and Azure CHINA (2048)")
 	}
 
 	if _, hasSetZone := d.GetOk("zone"); goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && hasSetZone {
@@ -705,27 +864,27 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	disableRoutePropagation := d.Get("disable_route_propagation").(bool)
 	if enableBgp {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWS|goaviatrix.Azure) {
-			return fmt.Errorf("enabling BGP is only supported for AWS (1) and Azure (8)")
+			return diag.Errorf("enabling BGP is only supported for AWS (1) and Azure (8)")
 		}
 		gateway.EnableBgp = "yes"
 	} else {
 		if disableRoutePropagation {
-			return fmt.Errorf("disable route propagation is not supported on Non-BGP Spoke")
+			return diag.Errorf("disable route propagation is not supported on Non-BGP Spoke")
 		}
 	}
 
 	learnedCidrsApproval := d.Get("enable_learned_cidrs_approval").(bool)
 	if !learnedCidrsApproval && len(gateway.ApprovedLearnedCidrs) != 0 {
-		return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		gateway.VpcID = d.Get("vpc_id").(string)
 		if gateway.VpcID == "" {
-			return fmt.Errorf("'vpc_id' cannot be empty for creating a spoke gw")
+			return diag.Errorf("'vpc_id' cannot be empty for creating a spoke gw")
 		}
 	} else {
-		return fmt.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
+		return diag.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
 	}
 
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
@@ -734,14 +893,14 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		// for gcp, rest api asks for "zone" rather than vpc region
 		gateway.Zone = d.Get("vpc_reg").(string)
 	} else {
-		return fmt.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192) or, AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192) or, AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (gateway.AvailabilityDomain == "" || gateway.FaultDomain == "") {
-		return fmt.Errorf("'availability_domain' and 'fault_domain' are required for OCI")
+		return diag.Errorf("'availability_domain' and 'fault_domain' are required for OCI")
 	}
 	if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (gateway.AvailabilityDomain != "" || gateway.FaultDomain != "") {
-		return fmt.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
+		return diag.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
 	}
 
 	insaneMode := d.Get("insane_mode").(bool)
@@ -752,32 +911,32 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	haFaultDomain := d.Get("ha_fault_domain").(string)
 
 	if haZone != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-		return fmt.Errorf("'ha_zone' is only valid for GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) providers if enabling HA")
+		return diag.Errorf("'ha_zone' is only valid for GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) providers if enabling HA")
 	}
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) && haSubnet != "" && haZone == "" {
-		return fmt.Errorf("'ha_zone' must be set to enable HA on GCP (4), cannot enable HA with only 'ha_subnet'")
+		return diag.Errorf("'ha_zone' must be set to enable HA on GCP (4), cannot enable HA with only 'ha_subnet'")
 	}
 	if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && haSubnet == "" && haZone != "" {
-		return fmt.Errorf("'ha_subnet' must be provided to enable HA on Azure (4), AzureGov (32) or AzureChina (2048), cannot enable HA with only 'ha_zone'")
+		return diag.Errorf("'ha_subnet' must be provided to enable HA on Azure (4), AzureGov (32) or AzureChina (2048), cannot enable HA with only 'ha_zone'")
 	}
 	haGwSize := d.Get("ha_gw_size").(string)
 	if haSubnet == "" && haZone == "" && haGwSize != "" {
-		return fmt.Errorf("'ha_gw_size' is only required if enabling HA")
+		return diag.Errorf("'ha_gw_size' is only required if enabling HA")
 	}
 	haInsaneModeAz := d.Get("ha_insane_mode_az").(string)
 	if insaneMode {
 		// Insane Mode encryption is not supported in Azure China regions
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|
 			goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-			return fmt.Errorf("insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return diag.Errorf("insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 			if insaneModeAz == "" {
-				return fmt.Errorf("insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+				return diag.Errorf("insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 			}
 			if haSubnet != "" && haInsaneModeAz == "" {
-				return fmt.Errorf("ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768) provider and ha_subnet is set")
+				return diag.Errorf("ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768) provider and ha_subnet is set")
 			}
 			// Append availability zone to subnet
 			var strs []string
@@ -790,27 +949,27 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	}
 	if haZone != "" || haSubnet != "" {
 		if haGwSize == "" {
-			return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+			return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 				"ha_subnet or ha_zone is set")
 		}
 	}
 	if haSubnet != "" {
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain == "" || haFaultDomain == "") {
-			return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are required to enable Peering HA on OCI")
+			return diag.Errorf("'ha_availability_domain' and 'ha_fault_domain' are required to enable Peering HA on OCI")
 		}
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain != "" || haFaultDomain != "") {
-			return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
+			return diag.Errorf("'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
 		}
 	}
 
 	enableEncryptVolume := d.Get("enable_encrypt_volume").(bool)
 	customerManagedKeys := d.Get("customer_managed_keys").(string)
 	if enableEncryptVolume && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 	if customerManagedKeys != "" {
 		if !enableEncryptVolume {
-			return fmt.Errorf("'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
+			return diag.Errorf("'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
 		}
 		gateway.CustomerManagedKeys = customerManagedKeys
 	}
@@ -825,24 +984,24 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	}
 	// Enable monitor gateway subnets does not work with AWSChina
 	if enableMonitorSubnets && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes^goaviatrix.AWSChina) {
-		return fmt.Errorf("'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
+		return diag.Errorf("'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
 	}
 	if !enableMonitorSubnets && len(excludedInstances) != 0 {
-		return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
+		return diag.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
 	}
 
 	bgpOverLan := d.Get("enable_bgp_over_lan").(bool)
 	if bgpOverLan && !enableBgp {
-		return fmt.Errorf("'enable_bgp' is required to be true to enable bgp over lan")
+		return diag.Errorf("'enable_bgp' is required to be true to enable bgp over lan")
 	}
 	if bgpOverLan && !(goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes)) {
-		return fmt.Errorf("'enable_bgp_over_lan' is only valid for Azure (8), AzureGov (32) or AzureChina (2048)")
+		return diag.Errorf("'enable_bgp_over_lan' is only valid for Azure (8), AzureGov (32) or AzureChina (2048)")
 	}
 	bgpLanInterfacesCount, isCountSet := d.GetOk("bgp_lan_interfaces_count")
 	if isCountSet && (!bgpOverLan || !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes)) {
-		return fmt.Errorf("'bgp_lan_interfaces_count' is only valid for BGP over LAN enabled spoke for Azure (8), AzureGov (32) or AzureChina (2048)")
+		return diag.Errorf("'bgp_lan_interfaces_count' is only valid for BGP over LAN enabled spoke for Azure (8), AzureGov (32) or AzureChina (2048)")
 	} else if !isCountSet && bgpOverLan && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-		return fmt.Errorf("please specify 'bgp_lan_interfaces_count' for BGP over LAN enabled Azure spoke: %s", gateway.GwName)
+		return diag.Errorf("please specify 'bgp_lan_interfaces_count' for BGP over LAN enabled Azure spoke: %s", gateway.GwName)
 	}
 	if bgpOverLan {
 		gateway.BgpOverLan = true
@@ -857,32 +1016,32 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 
 	if enablePrivateOob {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("'enable_private_oob' is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+			return diag.Errorf("'enable_private_oob' is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 		}
 
 		if oobAvailabilityZone == "" {
-			return fmt.Errorf("\"oob_availability_zone\" is required if \"enable_private_oob\" is true")
+			return diag.Errorf("\"oob_availability_zone\" is required if \"enable_private_oob\" is true")
 		}
 
 		if oobManagementSubnet == "" {
-			return fmt.Errorf("\"oob_management_subnet\" is required if \"enable_private_oob\" is true")
+			return diag.Errorf("\"oob_management_subnet\" is required if \"enable_private_oob\" is true")
 		}
 
 		if haSubnet != "" {
 			if haOobAvailabilityZone == "" {
-				return fmt.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+				return diag.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 			}
 
 			if haOobManagementSubnet == "" {
-				return fmt.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+				return diag.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 			}
 		} else {
 			if haOobAvailabilityZone != "" {
-				return fmt.Errorf("\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
+				return diag.Errorf("\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
 			}
 
 			if haOobManagementSubnet != "" {
-				return fmt.Errorf("\"ha_oob_management_subnet\" must be empty if \"ha_subnet\" is empty")
+				return diag.Errorf("\"ha_oob_management_subnet\" must be empty if \"ha_subnet\" is empty")
 			}
 		}
 
@@ -891,49 +1050,49 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		gateway.OobManagementSubnet = oobManagementSubnet + "~~" + oobAvailabilityZone
 	} else {
 		if oobAvailabilityZone != "" {
-			return fmt.Errorf("\"oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
+			return diag.Errorf("\"oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
 		}
 
 		if oobManagementSubnet != "" {
-			return fmt.Errorf("\"oob_management_subnet\" must be empty if \"enable_private_oob\" is false")
+			return diag.Errorf("\"oob_management_subnet\" must be empty if \"enable_private_oob\" is false")
 		}
 
 		if haOobAvailabilityZone != "" {
-			return fmt.Errorf("\"ha_oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
+			return diag.Errorf("\"ha_oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
 		}
 
 		if haOobManagementSubnet != "" {
-			return fmt.Errorf("\"ha_oob_management_subnet\" must be empty if \"enable_private_oob\" is false")
+			return diag.Errorf("\"ha_oob_management_subnet\" must be empty if \"enable_private_oob\" is false")
 		}
 	}
 
 	_, tagsOk := d.GetOk("tags")
-	if tagsOk {
+	if tagsOk || client.GetDefaultTagsConfig().HasTags() && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return errors.New("failed to create spoke gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) or AWS Secret (32768)")
+			return diag.Errorf("failed to create spoke gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) or AWS Secret (32768)")
 		}
 
-		tagsMap, err := extractTags(d, gateway.CloudType)
+		tagsMap, err := extractTags(d, gateway.CloudType, client.GetDefaultTagsConfig())
 		if err != nil {
-			return fmt.Errorf("error creating tags for spoke gateway: %w", err)
+			return diag.Errorf("error creating tags for spoke gateway: %v", err)
 		}
 		tagJson, err := TagsMapToJson(tagsMap)
 		if err != nil {
-			return fmt.Errorf("failed to add tags whenc creating spoke gateway: %w", err)
+			return diag.Errorf("failed to add tags whenc creating spoke gateway: %v", err)
 		}
 		gateway.TagJson = tagJson
 	}
 
 	enableActiveStandby := d.Get("enable_active_standby").(bool)
 	if haSubnet == "" && haZone == "" && enableActiveStandby {
-		return fmt.Errorf("could not configure Active-Standby as HA is not enabled")
+		return diag.Errorf("could not configure Active-Standby as HA is not enabled")
 	}
 	if !enableBgp && enableActiveStandby {
-		return fmt.Errorf("could not configure Active-Standby as it is not BGP capable gateway")
+		return diag.Errorf("could not configure Active-Standby as it is not BGP capable gateway")
 	}
 	enableActiveStandbyPreemptive := d.Get("enable_active_standby_preemptive").(bool)
 	if !enableActiveStandby && enableActiveStandbyPreemptive {
-		return fmt.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
+		return diag.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
 	}
 
 	enableSpotInstance := d.Get("enable_spot_instance").(bool)
@@ -941,11 +1100,11 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	deleteSpot := d.Get("delete_spot").(bool)
 	if enableSpotInstance {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
+			return diag.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
 		}
 
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && deleteSpot {
-			return fmt.Errorf("delete_spot only supports Azure")
+			return diag.Errorf("delete_spot only supports Azure")
 		}
 
 		gateway.EnableSpotInstance = true
@@ -957,10 +1116,10 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 
 	rxQueueSize := d.Get("rx_queue_size").(string)
 	if rxQueueSize != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("rx_queue_size only supports AWS related cloud types")
+		return diag.Errorf("rx_queue_size only supports AWS related cloud types")
 	}
 
-	privateModeInfo, _ := client.GetPrivateModeInfo(context.Background())
+	privateModeInfo, _ := client.GetPrivateModeInfo(ctx)
 	if !enablePrivateOob && !privateModeInfo.EnablePrivateMode {
 		allocateNewEip := d.Get("allocate_new_eip").(bool)
 		if allocateNewEip {
@@ -969,21 +1128,21 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			gateway.ReuseEip = "on"
 
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-				return fmt.Errorf("failed to create spoke gateway: 'allocate_new_eip' can only be set to 'false' when cloud_type is AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048) or AWS Top Secret (16384)")
+				return diag.Errorf("failed to create spoke gateway: 'allocate_new_eip' can only be set to 'false' when cloud_type is AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048) or AWS Top Secret (16384)")
 			}
 			if _, ok := d.GetOk("eip"); !ok {
-				return fmt.Errorf("failed to create spoke gateway: 'eip' must be set when 'allocate_new_eip' is false")
+				return diag.Errorf("failed to create spoke gateway: 'eip' must be set when 'allocate_new_eip' is false")
 			}
 			azureEipName, azureEipNameOk := d.GetOk("azure_eip_name_resource_group")
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 				// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 				if !azureEipNameOk {
-					return fmt.Errorf("failed to create spoke gateway: 'azure_eip_name_resource_group' must be set when 'allocate_new_eip' is false and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create spoke gateway: 'azure_eip_name_resource_group' must be set when 'allocate_new_eip' is false and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				gateway.Eip = fmt.Sprintf("%s:%s", azureEipName.(string), d.Get("eip").(string))
 			} else {
 				if azureEipNameOk {
-					return fmt.Errorf("failed to create spoke gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create spoke gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				gateway.Eip = d.Get("eip").(string)
 			}
@@ -995,47 +1154,47 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			gateway.Subnet = fmt.Sprintf("%s~~%s", gateway.Subnet, privateModeSubnetZone.(string))
 		} else {
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("%q must be set when creating a Spoke Gateway in AWS with Private Mode enabled on the Controller", "private_mode_subnet_zone")
+				return diag.Errorf("%q must be set when creating a Spoke Gateway in AWS with Private Mode enabled on the Controller", "private_mode_subnet_zone")
 			}
 		}
 
 		if _, ok := d.GetOk("private_mode_lb_vpc_id"); ok {
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-				return fmt.Errorf("private mode is only supported in AWS and Azure. %q must be empty", "private_mode_lb_vpc_id")
+				return diag.Errorf("private mode is only supported in AWS and Azure. %q must be empty", "private_mode_lb_vpc_id")
 			}
 
 			gateway.LbVpcId = d.Get("private_mode_lb_vpc_id").(string)
 		}
 	} else {
 		if _, ok := d.GetOk("private_mode_subnet_zone"); ok {
-			return fmt.Errorf("%q is only valid when Private Mode is enabled on the Controller", "private_mode_subnet_zone")
+			return diag.Errorf("%q is only valid when Private Mode is enabled on the Controller", "private_mode_subnet_zone")
 		}
 		if _, ok := d.GetOk("private_mode_lb_vpc_id"); ok {
-			return fmt.Errorf("%q is only valid when Private Mode is enabled", "private_mode_lb_vpc_id")
+			return diag.Errorf("%q is only valid when Private Mode is enabled", "private_mode_lb_vpc_id")
 		}
 	}
 
 	if gateway.EnableGlobalVpc && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) {
-		return fmt.Errorf("'enable_global_vpc' is only valid for GCP")
+		return diag.Errorf("'enable_global_vpc' is only valid for GCP")
 	}
 
 	enableIpv6 := d.Get("enable_ipv6").(bool)
 	if enableIpv6 {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("error creating gateway: enable_ipv6 is only supported for AWS (1), Azure (8)")
+			return diag.Errorf("error creating gateway: enable_ipv6 is only supported for AWS (1), Azure (8)")
 		}
 		gateway.EnableIPv6 = true
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Spoke Gateway: %#v", gateway)
+	tflog.Info(ctx, "Creating Aviatrix Spoke Gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
 	d.SetId(gateway.GwName)
 	flag := false
-	defer resourceAviatrixSpokeGatewayReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSpokeGatewayReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.LaunchSpokeVpc(gateway)
+	err := client.LaunchSpokeVpcContext(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Spoke Gateway: %s", err)
+		return diag.Errorf("failed to create Aviatrix Spoke Gateway: %s", err)
 	}
 
 	if !singleAZ {
@@ -1044,11 +1203,11 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			SingleAZ: "disabled",
 		}
 
-		log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+		tflog.Info(ctx, "Disable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 
 		err := client.DisableSingleAZGateway(singleAZGateway)
 		if err != nil {
-			return fmt.Errorf("failed to disable single AZ GW HA: %s", err)
+			return diag.Errorf("failed to disable single AZ GW HA: %s", err)
 		}
 	}
 
@@ -1058,7 +1217,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	if ok && acceptComm != commAcceptCurr || err != nil {
 		err := client.SetGatewayBgpCommunitiesAccept(gateway.GwName, acceptComm)
 		if err != nil {
-			return fmt.Errorf("failed to set accept BGP communities for gateway %s: %w", gateway.GwName, err)
+			return diag.Errorf("failed to set accept BGP communities for gateway %s: %v", gateway.GwName, err)
 		}
 	}
 
@@ -1066,7 +1225,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	if ok && sendComm != commSendCurr || err != nil {
 		err := client.SetGatewayBgpCommunitiesSend(gateway.GwName, sendComm)
 		if err != nil {
-			return fmt.Errorf("failed to set send BGP communities for gateway %s: %w", gateway.GwName, err)
+			return diag.Errorf("failed to set send BGP communities for gateway %s: %v", gateway.GwName, err)
 		}
 	}
 
@@ -1103,7 +1262,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		if privateModeInfo.EnablePrivateMode {
 			haPrivateModeSubnetZone := d.Get("ha_private_mode_subnet_zone").(string)
 			if haPrivateModeSubnetZone == "" && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("%q must be set when creating a Spoke HA Gateway in AWS with Private Mode enabled on the Controller", "ha_private_mode_subnet_zone")
+				return diag.Errorf("%q must be set when creating a Spoke HA Gateway in AWS with Private Mode enabled on the Controller", "ha_private_mode_subnet_zone")
 			}
 			spokeHaGw.Subnet = haSubnet + "~~" + haPrivateModeSubnetZone
 		}
@@ -1113,26 +1272,26 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			if spokeHaGw.Eip != "" {
 				// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 				if !haAzureEipNameOk {
-					return fmt.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				spokeHaGw.Eip = fmt.Sprintf("%s:%s", haAzureEipName.(string), spokeHaGw.Eip)
 			} else if haAzureEipNameOk {
-				return fmt.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be empty when 'ha_eip' is empty")
+				return diag.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be empty when 'ha_eip' is empty")
 			}
 		} else if haAzureEipNameOk {
-			return fmt.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+			return diag.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 		}
 
-		_, err := client.CreateSpokeHaGw(spokeHaGw)
+		_, err := client.CreateSpokeHaGwContext(ctx, spokeHaGw)
 		if err != nil {
-			return fmt.Errorf("failed to enable HA Aviatrix Spoke Gateway: %s", err)
+			return diag.Errorf("failed to enable HA Aviatrix Spoke Gateway: %s", err)
 		}
 
-		log.Printf("[INFO]Resizing Spoke HA Gateway: %#v", haGwSize)
+		tflog.Info(ctx, "Resizing Spoke HA Gateway", map[string]interface{}{"ha_gw_size": haGwSize})
 
 		if haGwSize != gateway.VpcSize {
 			if haGwSize == "" {
-				return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+				return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 					"ha_subnet or ha_zone is set")
 			}
 
@@ -1142,11 +1301,11 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 				VpcSize:   d.Get("ha_gw_size").(string),
 			}
 
-			log.Printf("[INFO] Resizing Spoke HA Gateway size to: %s ", haGateway.VpcSize)
+			tflog.Info(ctx, "Resizing Spoke HA Gateway size", map[string]interface{}{"vpc_size": haGateway.VpcSize})
 
-			err := client.UpdateGateway(haGateway)
+			err := client.UpdateGatewayContext(ctx, haGateway)
 			if err != nil {
-				return fmt.Errorf("failed to update Aviatrix Spoke HA Gateway size: %s", err)
+				return diag.Errorf("failed to update Aviatrix Spoke HA Gateway size: %s", err)
 			}
 
 			d.Set("ha_gw_size", haGwSize)
@@ -1159,14 +1318,14 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			GwName: d.Get("gw_name").(string),
 		}
 
-		log.Printf("[INFO] Enable VPC DNS Server: %#v", gwVpcDnsServer)
+		tflog.Info(ctx, "Enable VPC DNS Server", map[string]interface{}{"gw_name": gwVpcDnsServer.GwName})
 
 		err := client.EnableVpcDnsServer(gwVpcDnsServer)
 		if err != nil {
-			return fmt.Errorf("failed to enable VPC DNS Server: %s", err)
+			return diag.Errorf("failed to enable VPC DNS Server: %s", err)
 		}
 	} else if enableVpcDnsServer {
-		return fmt.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
+		return diag.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
 	}
 
 	if customizedSpokeVpcRoutes := d.Get("customized_spoke_vpc_routes").(string); customizedSpokeVpcRoutes != "" {
@@ -1175,7 +1334,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			CustomizedSpokeVpcRoutes: strings.Split(customizedSpokeVpcRoutes, ","),
 		}
 		for i := 0; ; i++ {
-			log.Printf("[INFO] Editing customized routes of spoke gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, "Editing customized routes of spoke gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			err := client.EditGatewayCustomRoutes(transitGateway)
 			if err == nil {
 				break
@@ -1184,7 +1343,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 				strings.Contains(err.Error(), "gateway is down")) {
 				time.Sleep(10 * time.Second)
 			} else {
-				return fmt.Errorf("failed to customize spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to customize spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -1195,7 +1354,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			FilteredSpokeVpcRoutes: strings.Split(filteredSpokeVpcRoutes, ","),
 		}
 		for i := 0; ; i++ {
-			log.Printf("[INFO] Editing filtered routes of spoke gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, "Editing filtered routes of spoke gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			err := client.EditGatewayFilterRoutes(transitGateway)
 			if err == nil {
 				break
@@ -1204,7 +1363,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 				strings.Contains(err.Error(), "gateway is down")) {
 				time.Sleep(10 * time.Second)
 			} else {
-				return fmt.Errorf("failed to edit filtered spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit filtered spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -1215,7 +1374,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			AdvertisedSpokeRoutes: strings.Split(includedAdvertisedSpokeRoutes, ","),
 		}
 		for i := 0; ; i++ {
-			log.Printf("[INFO] Editing customized routes advertisement of spoke gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, "Editing customized routes advertisement of spoke gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			err := client.EditGatewayAdvertisedCidr(transitGateway)
 			if err == nil {
 				break
@@ -1224,7 +1383,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 				strings.Contains(err.Error(), "gateway is down")) {
 				time.Sleep(10 * time.Second)
 			} else {
-				return fmt.Errorf("failed to edit advertised spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit advertised spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -1232,7 +1391,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	if enableMonitorSubnets {
 		err := client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
 		if err != nil {
-			return fmt.Errorf("could not enable monitor gateway subnets: %w", err)
+			return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 		}
 	}
 
@@ -1243,7 +1402,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 
 		err := client.DisableJumboFrame(gw)
 		if err != nil {
-			return fmt.Errorf("could not disable jumbo frame for spoke gateway: %w", err)
+			return diag.Errorf("could not disable jumbo frame for spoke gateway: %v", err)
 		}
 	}
 
@@ -1253,7 +1412,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.DisableGroGso(gw)
 		if err != nil {
-			return fmt.Errorf("couldn't disable GRO/GSO on spoke gateway: %s", err)
+			return diag.Errorf("couldn't disable GRO/GSO on spoke gateway: %s", err)
 		}
 	}
 
@@ -1263,7 +1422,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.EnablePrivateVpcDefaultRoute(gw)
 		if err != nil {
-			return fmt.Errorf("could not enable private vpc default route after spoke gateway creation: %w", err)
+			return diag.Errorf("could not enable private vpc default route after spoke gateway creation: %v", err)
 		}
 	}
 
@@ -1273,7 +1432,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.EnableSkipPublicRouteUpdate(gw)
 		if err != nil {
-			return fmt.Errorf("could not enable skip public route update after spoke gateway creation: %w", err)
+			return diag.Errorf("could not enable skip public route update after spoke gateway creation: %v", err)
 		}
 	}
 
@@ -1283,14 +1442,14 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.EnableAutoAdvertiseS2CCidrs(gw)
 		if err != nil {
-			return fmt.Errorf("could not enable auto advertise s2c cidrs after spoke gateaway creation: %w", err)
+			return diag.Errorf("could not enable auto advertise s2c cidrs after spoke gateaway creation: %v", err)
 		}
 	}
 
 	if detectionTime, ok := d.GetOk("tunnel_detection_time"); ok {
 		err := client.ModifyTunnelDetectionTime(d.Get("gw_name").(string), detectionTime.(int))
 		if err != nil {
-			return fmt.Errorf("could not set tunnel detection time during Spoke Gateway creation: %w", err)
+			return diag.Errorf("could not set tunnel detection time during Spoke Gateway creation: %v", err)
 		}
 	}
 
@@ -1298,13 +1457,13 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		gateway.LearnedCidrsApproval = "on"
 		err := client.EnableSpokeLearnedCidrsApproval(gateway)
 		if err != nil {
-			return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+			return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 		}
 	}
 	if len(gateway.ApprovedLearnedCidrs) != 0 {
 		err := client.UpdateSpokePendingApprovedCidrs(gateway)
 		if err != nil {
-			return fmt.Errorf("failed to update approved CIDRs: %w", err)
+			return diag.Errorf("failed to update approved CIDRs: %v", err)
 		}
 	}
 
@@ -1317,39 +1476,39 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		gateway.BgpManualSpokeAdvertiseCidrs = strings.Join(spokeBgpManualSpokeAdvertiseCidrs, ",")
 		err := client.SetSpokeBgpManualAdvertisedNetworks(gateway)
 		if err != nil {
-			return fmt.Errorf("failed to set spoke BGP Manual Advertise Cidrs: %s", err)
+			return diag.Errorf("failed to set spoke BGP Manual Advertise Cidrs: %s", err)
 		}
 	}
 
 	if val, ok := d.GetOk("bgp_ecmp"); ok {
 		err := client.SetBgpEcmpSpoke(gateway, val.(bool))
 		if err != nil {
-			return fmt.Errorf("could not set bgp_ecmp: %w", err)
+			return diag.Errorf("could not set bgp_ecmp: %v", err)
 		}
 	}
 
 	if enableActiveStandby {
 		if enableActiveStandbyPreemptive {
 			if err := client.EnableActiveStandbyPreemptiveSpoke(gateway); err != nil {
-				return fmt.Errorf("could not enable Preemptive Mode for Active-Standby: %w", err)
+				return diag.Errorf("could not enable Preemptive Mode for Active-Standby: %v", err)
 			}
 		} else {
 			if err := client.EnableActiveStandbySpoke(gateway); err != nil {
-				return fmt.Errorf("could not enable Active-Standby: %w", err)
+				return diag.Errorf("could not enable Active-Standby: %v", err)
 			}
 		}
 	}
 
 	if disableRoutePropagation {
 		if err := client.DisableSpokeOnpremRoutePropagation(gateway); err != nil {
-			return fmt.Errorf("could not disable route propagation for Spoke %s : %v", gateway.GwName, err)
+			return diag.Errorf("could not disable route propagation for Spoke %s : %v", gateway.GwName, err)
 		}
 	}
 
 	if val, ok := d.GetOk("local_as_number"); ok {
 		err := client.SetLocalASNumberSpoke(gateway, val.(string))
 		if err != nil {
-			return fmt.Errorf("could not set local_as_number: %w", err)
+			return diag.Errorf("could not set local_as_number: %v", err)
 		}
 	}
 
@@ -1361,7 +1520,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.SetPrependASPathSpoke(gateway, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path: %w", err)
+			return diag.Errorf("could not set prepend_as_path: %v", err)
 		}
 	}
 
@@ -1370,7 +1529,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		if bgp_polling_time >= 10 && bgp_polling_time != defaultBgpPollingTime {
 			err := client.SetBgpPollingTimeSpoke(gateway, bgp_polling_time)
 			if err != nil {
-				return fmt.Errorf("could not set bgp polling time: %w", err)
+				return diag.Errorf("could not set bgp polling time: %v", err)
 			}
 		}
 	}
@@ -1380,7 +1539,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		if bgp_neighbor_status_polling_time >= 1 && bgp_neighbor_status_polling_time != defaultBgpNeighborStatusPollingTime {
 			err := client.SetBgpBfdPollingTimeSpoke(gateway, val.(int))
 			if err != nil {
-				return fmt.Errorf("could not set bgp neighbor status polling time: %w", err)
+				return diag.Errorf("could not set bgp neighbor status polling time: %v", err)
 			}
 		}
 	}
@@ -1388,7 +1547,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 	if holdTime := d.Get("bgp_hold_time").(int); holdTime != defaultBgpHoldTime {
 		err := client.ChangeBgpHoldTime(gateway.GwName, holdTime)
 		if err != nil {
-			return fmt.Errorf("could not change BGP Hold Time after Spoke Gateway creation: %w", err)
+			return diag.Errorf("could not change BGP Hold Time after Spoke Gateway creation: %v", err)
 		}
 	}
 
@@ -1397,10 +1556,10 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		if enableBgp {
 			err := client.EnableSpokePreserveAsPath(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable spoke preserve as path: %w", err)
+				return diag.Errorf("could not enable spoke preserve as path: %v", err)
 			}
 		} else {
-			return fmt.Errorf("enable_preserve_as_path is not supported for Non-BGP Spoke Gateways")
+			return diag.Errorf("enable_preserve_as_path is not supported for Non-BGP Spoke Gateways")
 		}
 	}
 
@@ -1411,7 +1570,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.SetRxQueueSize(gwRxQueueSize)
 		if err != nil {
-			return fmt.Errorf("failed to set rx queue size for spoke %s: %s", gateway.GwName, err)
+			return diag.Errorf("failed to set rx queue size for spoke %s: %s", gateway.GwName, err)
 		}
 		if haSubnet != "" || haZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
@@ -1420,32 +1579,31 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta interface{}
 			}
 			err := client.SetRxQueueSize(haGwRxQueueSize)
 			if err != nil {
-				return fmt.Errorf("failed to set rx queue size for spoke ha %s : %s", haGwRxQueueSize.GwName, err)
+				return diag.Errorf("failed to set rx queue size for spoke ha %s : %s", haGwRxQueueSize.GwName, err)
 			}
 		}
 	}
 
-	return resourceAviatrixSpokeGatewayReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSpokeGatewayReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSpokeGatewayReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSpokeGatewayReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSpokeGatewayRead(d, meta)
+		return resourceAviatrixSpokeGatewayRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
-	ignoreTagsConfig := client.IgnoreTagsConfig
+func resourceAviatrixSpokeGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	var isImport bool
 	gwName := d.Get("gw_name").(string)
 	if gwName == "" {
 		isImport = true
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no gateway name received", map[string]interface{}{"id": id})
 		d.Set("gw_name", id)
 		d.Set("manage_ha_gateway", true)
 		d.SetId(id)
@@ -1456,16 +1614,16 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 		GwName:      d.Get("gw_name").(string),
 	}
 
-	gw, err := client.GetGateway(gateway)
+	gw, err := client.GetGatewayContext(ctx, gateway)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Spoke Gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Spoke Gateway: %s", err)
 	}
 
-	log.Printf("[TRACE] reading spoke gateway %s: %#v", d.Get("gw_name").(string), gw)
+	tflog.Trace(ctx, "reading spoke gateway", map[string]interface{}{"gw_name": d.Get("gw_name").(string)})
 
 	d.Set("cloud_type", gw.CloudType)
 	d.Set("account_name", gw.AccountName)
@@ -1488,16 +1646,16 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 	d.Set("enable_ipv6", gw.EnableIPv6)
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && gw.EnableBgpOverLan {
-		bgpLanIpInfo, err := client.GetBgpLanIPList(&goaviatrix.TransitVpc{GwName: gateway.GwName})
+		bgpLanIpInfo, err := client.GetBgpLanIPListContext(ctx, &goaviatrix.TransitVpc{GwName: gateway.GwName})
 		if err != nil {
-			return fmt.Errorf("could not get BGP LAN IP info for Azure spoke gateway %s: %v", gateway.GwName, err)
+			return diag.Errorf("could not get BGP LAN IP info for Azure spoke gateway %s: %v", gateway.GwName, err)
 		}
 		if err = d.Set("bgp_lan_ip_list", bgpLanIpInfo.AzureBgpLanIpList); err != nil {
-			log.Printf("[WARN] could not set bgp_lan_ip_list into state: %s", err)
+			tflog.Warn(ctx, "could not set bgp_lan_ip_list into state", map[string]interface{}{"error": err})
 		}
 		if len(bgpLanIpInfo.AzureHaBgpLanIpList) != 0 {
 			if err = d.Set("ha_bgp_lan_ip_list", bgpLanIpInfo.AzureHaBgpLanIpList); err != nil {
-				log.Printf("[WARN] could not set ha_bgp_lan_ip_list into state: %s", err)
+				tflog.Warn(ctx, "could not set ha_bgp_lan_ip_list into state", map[string]interface{}{"error": err})
 			}
 		} else {
 			d.Set("ha_bgp_lan_ip_list", nil)
@@ -1519,13 +1677,13 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 	d.Set("enable_global_vpc", gw.EnableGlobalVpc)
 
 	if gw.EnableLearnedCidrsApproval {
-		spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfig(&goaviatrix.SpokeVpc{GwName: gw.GwName})
+		spokeAdvancedConfig, err := client.GetSpokeGatewayAdvancedConfigContext(ctx, &goaviatrix.SpokeVpc{GwName: gw.GwName})
 		if err != nil {
-			return fmt.Errorf("could not get advanced config for spoke gateway: %w", err)
+			return diag.Errorf("could not get advanced config for spoke gateway: %v", err)
 		}
 
 		if err = d.Set("approved_learned_cidrs", spokeAdvancedConfig.ApprovedLearnedCidrs); err != nil {
-			return fmt.Errorf("could not set approved_learned_cidrs into state: %w", err)
+			return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
 		}
 	} else {
 		d.Set("approved_learned_cidrs", nil)
@@ -1543,7 +1701,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 	}
 	err = d.Set("prepend_as_path", prependAsPath)
 	if err != nil {
-		return fmt.Errorf("could not set prepend_as_path: %w", err)
+		return diag.Errorf("could not set prepend_as_path: %v", err)
 	}
 	if gw.EnableBgp {
 		d.Set("learned_cidrs_approval_mode", gw.LearnedCidrsApprovalMode)
@@ -1565,7 +1723,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 		if len(azureEip) == 3 {
 			d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the Spoke Gateway %s", gw.GwName)
+			tflog.Warn(ctx, "could not get Azure EIP name and resource group for the Spoke Gateway", map[string]interface{}{"gw_name": gw.GwName})
 		}
 	}
 
@@ -1660,13 +1818,12 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("enable_monitor_gateway_subnets", gw.MonitorSubnetsAction == "enable")
 	if err := d.Set("monitor_exclude_list", gw.MonitorExcludeGWList); err != nil {
-		return fmt.Errorf("setting 'monitor_exclude_list' to state: %w", err)
+		return diag.Errorf("setting 'monitor_exclude_list' to state: %v", err)
 	}
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-		tags := goaviatrix.KeyValueTags(gw.Tags).IgnoreConfig(ignoreTagsConfig)
-		if err := d.Set("tags", tags); err != nil {
-			log.Printf("[WARN] Error setting tags for (%s): %s", d.Id(), err)
+		if err := setTags(d, client, gw.Tags); err != nil {
+			tflog.Warn(ctx, "Error setting tags", map[string]interface{}{"id": d.Id(), "error": err})
 		}
 	}
 
@@ -1727,7 +1884,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 
 	enableGroGso, err := client.GetGroGsoStatus(gw)
 	if err != nil {
-		return fmt.Errorf("failed to get GRO/GSO status of spoke gateway %s: %v", gw.GwName, err)
+		return diag.Errorf("failed to get GRO/GSO status of spoke gateway %s: %v", gw.GwName, err)
 	}
 	d.Set("enable_gro_gso", enableGroGso)
 
@@ -1755,7 +1912,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 			return nil
 		}
 
-		log.Printf("[INFO] Spoke HA Gateway size: %s", gw.HaGw.GwSize)
+		tflog.Info(ctx, "Spoke HA Gateway size", map[string]interface{}{"gw_size": gw.HaGw.GwSize})
 		if goaviatrix.IsCloudType(gw.HaGw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
 			d.Set("ha_subnet", gw.HaGw.VpcNet)
 			if zone := d.Get("ha_zone"); goaviatrix.IsCloudType(gw.HaGw.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && (isImport || zone.(string) != "") {
@@ -1817,29 +1974,29 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta interface{})
 			if len(azureEip) == 3 {
 				d.Set("ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName)
+				tflog.Warn(ctx, "could not get Azure EIP name and resource group for the HA Gateway", map[string]interface{}{"gw_name": gw.GwName})
 			}
 		}
 	}
 
 	sendComm, acceptComm, err := client.GetGatewayBgpCommunities(gateway.GwName)
 	if err != nil {
-		return fmt.Errorf("failed to get BGP communities for gateway %s: %w", gateway.GwName, err)
+		return diag.Errorf("failed to get BGP communities for gateway %s: %v", gateway.GwName, err)
 	}
 	err = d.Set("bgp_send_communities", sendComm)
 	if err != nil {
-		return fmt.Errorf("failed to set bgp_send_communities: %w", err)
+		return diag.Errorf("failed to set bgp_send_communities: %v", err)
 	}
 	err = d.Set("bgp_accept_communities", acceptComm)
 	if err != nil {
-		return fmt.Errorf("failed to set bgp_accept_communities: %w", err)
+		return diag.Errorf("failed to set bgp_accept_communities: %v", err)
 	}
 
 	return nil
 }
 
-func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: d.Get("cloud_type").(int),
@@ -1861,7 +2018,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.HasChanges("ha_subnet", "ha_zone", "ha_gw_size", "ha_insane_mode_az", "ha_eip",
 			"ha_azure_eip_name_resource_group", "ha_availability_domain", "ha_fault_domain", "ha_oob_management_subnet",
 			"ha_private_mode_subnet_zone", "ha_oob_availability_zone", "ha_software_version", "ha_image_version") {
-			return fmt.Errorf("'manage_ha_gateway' is set to false. Please set it to true, or use 'aviatrix_spoke_ha_gateway' to manage editing spoke ha gateway")
+			return diag.Errorf("'manage_ha_gateway' is set to false. Please set it to true, or use 'aviatrix_spoke_ha_gateway' to manage editing spoke ha gateway")
 		}
 	}
 
@@ -1871,7 +2028,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		VpcSize:   d.Get("ha_gw_size").(string),
 	}
 
-	log.Printf("[INFO] Updating Aviatrix gateway: %#v", gateway)
+	tflog.Info(ctx, "Updating Aviatrix gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
 	d.Partial(true)
 	commSendCurr, commAcceptCurr, err := client.GetGatewayBgpCommunities(gateway.GwName)
@@ -1880,120 +2037,120 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if ok && acceptComm != commAcceptCurr || err != nil {
 			err := client.SetGatewayBgpCommunitiesAccept(gateway.GwName, acceptComm)
 			if err != nil {
-				return fmt.Errorf("failed to set accept BGP communities for gateway %s: %w", gateway.GwName, err)
+				return diag.Errorf("failed to set accept BGP communities for gateway %s: %v", gateway.GwName, err)
 			}
 		}
 	}
 	if d.HasChange("bgp_send_communities") {
 		sendComm, ok := d.Get("bgp_send_communities").(bool)
 		if !ok {
-			return fmt.Errorf("failed to assert bgp_send_communities as a boolean")
+			return diag.Errorf("failed to assert bgp_send_communities as a boolean")
 		}
 		if sendComm != commSendCurr || err != nil {
 			err := client.SetGatewayBgpCommunitiesSend(gateway.GwName, sendComm)
 			if err != nil {
-				return fmt.Errorf("failed to set send BGP communities for gateway %s: %w", gateway.GwName, err)
+				return diag.Errorf("failed to set send BGP communities for gateway %s: %v", gateway.GwName, err)
 			}
 		}
 	}
 
 	if d.Get("enable_private_vpc_default_route").(bool) && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("enable_private_vpc_default_route is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("enable_private_vpc_default_route is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 	if d.Get("enable_skip_public_route_table_update").(bool) && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("enable_skip_public_route_update is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("enable_skip_public_route_update is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if d.HasChange("ha_zone") {
 		haZone := d.Get("ha_zone").(string)
 		if haZone != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("'ha_zone' is only valid for GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) providers if enabling HA")
+			return diag.Errorf("'ha_zone' is only valid for GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) providers if enabling HA")
 		}
 	}
 	if d.HasChange("ha_zone") || d.HasChange("ha_subnet") {
 		haZone := d.Get("ha_zone").(string)
 		haSubnet := d.Get("ha_subnet").(string)
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) && haSubnet != "" && haZone == "" {
-			return fmt.Errorf("'ha_zone' must be set to enable HA on GCP (4), cannot enable HA with only 'ha_subnet'")
+			return diag.Errorf("'ha_zone' must be set to enable HA on GCP (4), cannot enable HA with only 'ha_subnet'")
 		}
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && haSubnet == "" && haZone != "" {
-			return fmt.Errorf("'ha_subnet' must be provided to enable HA for Azure (8), AzureGov (32) or AzureChina (2048), cannot enable HA with only 'ha_zone'")
+			return diag.Errorf("'ha_subnet' must be provided to enable HA for Azure (8), AzureGov (32) or AzureChina (2048), cannot enable HA with only 'ha_zone'")
 		}
 	}
 	if d.HasChange("allocate_new_eip") {
-		return fmt.Errorf("updating allocate_new_eip is not allowed")
+		return diag.Errorf("updating allocate_new_eip is not allowed")
 	}
 	if d.HasChange("eip") {
-		return fmt.Errorf("updating eip is not allowed")
+		return diag.Errorf("updating eip is not allowed")
 	}
 	if d.HasChange("ha_eip") {
 		o, n := d.GetChange("ha_eip")
 		if o.(string) != "" && n.(string) != "" {
-			return fmt.Errorf("updating ha_eip is not allowed")
+			return diag.Errorf("updating ha_eip is not allowed")
 		}
 	}
 	if d.HasChange("azure_eip_name_resource_group") {
-		return fmt.Errorf("failed to update spoke gateway: changing 'azure_eip_name_resource_group' is not allowed")
+		return diag.Errorf("failed to update spoke gateway: changing 'azure_eip_name_resource_group' is not allowed")
 	}
 	if d.HasChange("ha_azure_eip_name_resource_group") {
 		o, n := d.GetChange("ha_azure_eip_name_resource_group")
 		if o.(string) != "" && n.(string) != "" {
-			return fmt.Errorf("failed to update spoke gateway: changing 'ha_azure_eip_name_resource_group' is not allowed")
+			return diag.Errorf("failed to update spoke gateway: changing 'ha_azure_eip_name_resource_group' is not allowed")
 		}
 	}
 
 	learnedCidrsApproval := d.Get("enable_learned_cidrs_approval").(bool)
 	approvedLearnedCidrs := getStringSet(d, "approved_learned_cidrs")
 	if !learnedCidrsApproval && len(approvedLearnedCidrs) != 0 {
-		return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if d.HasChange("enable_private_oob") {
-		return fmt.Errorf("updating enable_private_oob is not allowed")
+		return diag.Errorf("updating enable_private_oob is not allowed")
 	}
 	enablePrivateOob := d.Get("enable_private_oob").(bool)
-	privateModeInfo, _ := client.GetPrivateModeInfo(context.Background())
+	privateModeInfo, _ := client.GetPrivateModeInfo(ctx)
 	if !enablePrivateOob {
 		if d.HasChange("ha_oob_management_subnet") {
-			return fmt.Errorf("updating ha_oob_management_subnet is not allowed if private oob is disabled")
+			return diag.Errorf("updating ha_oob_management_subnet is not allowed if private oob is disabled")
 		}
 
 		if d.HasChange("ha_oob_availability_zone") {
-			return fmt.Errorf("updating ha_oob_availability_zone is not allowed if private oob is disabled")
+			return diag.Errorf("updating ha_oob_availability_zone is not allowed if private oob is disabled")
 		}
 	}
 	if !privateModeInfo.EnablePrivateMode {
 		if d.HasChange("ha_private_mode_subnet_zone") {
-			return fmt.Errorf("updating %q is not allowed if private mode is disabled", "ha_private_mode_subnet_zone")
+			return diag.Errorf("updating %q is not allowed if private mode is disabled", "ha_private_mode_subnet_zone")
 		}
 	}
 
 	if d.HasChange("enable_global_vpc") && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) {
-		return fmt.Errorf("global vpc can only be enabled for GCP")
+		return diag.Errorf("global vpc can only be enabled for GCP")
 	}
 
 	if d.HasChange("enable_preserve_as_path") {
 		enableBgp := d.Get("enable_bgp").(bool)
 		enableSpokePreserveAsPath := d.Get("enable_preserve_as_path").(bool)
 		if enableSpokePreserveAsPath && !enableBgp {
-			return fmt.Errorf("enable_preserve_as_path is not supported for Non-BGP Spoke during Spoke Gateway update")
+			return diag.Errorf("enable_preserve_as_path is not supported for Non-BGP Spoke during Spoke Gateway update")
 		}
 		if !enableSpokePreserveAsPath {
 			err := client.DisableSpokePreserveAsPath(&goaviatrix.SpokeVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not disable Preserve AS Path during Spoke Gateway update: %w", err)
+				return diag.Errorf("could not disable Preserve AS Path during Spoke Gateway update: %v", err)
 			}
 		} else {
 			err := client.EnableSpokePreserveAsPath(&goaviatrix.SpokeVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not enable Preserve AS Path during Spoke Gateway update: %w", err)
+				return diag.Errorf("could not enable Preserve AS Path during Spoke Gateway update: %v", err)
 			}
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("error updating spoke gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return diag.Errorf("error updating spoke gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 		tags := &goaviatrix.Tags{
 			ResourceType: "gw",
@@ -2001,27 +2158,27 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			CloudType:    gateway.CloudType,
 		}
 
-		tagsMap, err := extractTags(d, gateway.CloudType)
+		tagsMap, err := extractTags(d, gateway.CloudType, client.GetDefaultTagsConfig())
 		if err != nil {
-			return fmt.Errorf("failed to update tags for spoke gateway: %w", err)
+			return diag.Errorf("failed to update tags for spoke gateway: %v", err)
 		}
 		tags.Tags = tagsMap
 		tagJson, err := TagsMapToJson(tagsMap)
 		if err != nil {
-			return fmt.Errorf("failed to update tags for spoke gateway: %w", err)
+			return diag.Errorf("failed to update tags for spoke gateway: %v", err)
 		}
 		tags.TagJson = tagJson
 		err = client.UpdateTags(tags)
 		if err != nil {
-			return fmt.Errorf("failed to update tags for spoke gateway: %w", err)
+			return diag.Errorf("failed to update tags for spoke gateway: %v", err)
 		}
 	}
 
 	if d.HasChange("gw_size") {
 		gateway.VpcSize = d.Get("gw_size").(string)
-		err := client.UpdateGateway(gateway)
+		err := client.UpdateGatewayContext(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to update Aviatrix Spoke Gateway: %s", err)
+			return diag.Errorf("failed to update Aviatrix Spoke Gateway: %s", err)
 		}
 	}
 
@@ -2054,17 +2211,17 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				// No change will be detected when ha_eip is set to the empty string because it is computed.
 				// Instead, check ha_gw_size to detect when HA gateway is being deleted.
 				if !haAzureEipNameOk {
-					return fmt.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create HA Spoke Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 				spokeHaGw.Eip = fmt.Sprintf("%s:%s", haAzureEipName.(string), haEip)
 			}
 		} else if haAzureEipNameOk {
-			return fmt.Errorf("failed to create HA Spoke Gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+			return diag.Errorf("failed to create HA Spoke Gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 		}
 
 		if !d.HasChange("ha_subnet") && d.HasChange("ha_insane_mode_az") {
-			return fmt.Errorf("ha_subnet must change if ha_insane_mode_az changes")
+			return diag.Errorf("ha_subnet must change if ha_insane_mode_az changes")
 		}
 
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
@@ -2077,10 +2234,10 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			haFaultDomain := d.Get("ha_fault_domain").(string)
 			if newSubnet != "" {
 				if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain == "" || haFaultDomain == "") {
-					return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are required to enable HA on OCI")
+					return diag.Errorf("'ha_availability_domain' and 'ha_fault_domain' are required to enable HA on OCI")
 				}
 				if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain != "" || haFaultDomain != "") {
-					return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
+					return diag.Errorf("'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
 				}
 			}
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) {
@@ -2119,10 +2276,10 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				haSubnet := d.Get("ha_subnet").(string)
 
 				if insaneModeHaAz == "" && haSubnet != "" {
-					return fmt.Errorf("ha_insane_mode_az needed if insane_mode is enabled and ha_subnet is set " +
+					return diag.Errorf("ha_insane_mode_az needed if insane_mode is enabled and ha_subnet is set " +
 						"for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 				} else if insaneModeHaAz != "" && haSubnet == "" {
-					return fmt.Errorf("ha_subnet needed if insane_mode is enabled and ha_insane_mode_az is set " +
+					return diag.Errorf("ha_subnet needed if insane_mode is enabled and ha_insane_mode_az is set " +
 						"for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 				}
 
@@ -2133,10 +2290,10 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		}
 
 		if (newHaGwEnabled || changeHaGw) && haGwSize == "" {
-			return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+			return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 				"ha_subnet or ha_zone is set")
 		} else if deleteHaGw && haGwSize != "" {
-			return fmt.Errorf("ha_gw_size must be empty if spoke HA gateway is deleted")
+			return diag.Errorf("ha_gw_size must be empty if spoke HA gateway is deleted")
 		}
 
 		haOobManagementSubnet := d.Get("ha_oob_management_subnet").(string)
@@ -2145,19 +2302,19 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if enablePrivateOob {
 			if newHaGwEnabled || changeHaGw {
 				if haOobAvailabilityZone == "" {
-					return fmt.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+					return diag.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 				}
 
 				if haOobManagementSubnet == "" {
-					return fmt.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+					return diag.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 				}
 			} else if deleteHaGw {
 				if haOobAvailabilityZone != "" {
-					return fmt.Errorf("\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
+					return diag.Errorf("\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
 				}
 
 				if haOobManagementSubnet != "" {
-					return fmt.Errorf("\"ha_oob_management_subnet\" must be empty if \"ha_subnet\" is empty")
+					return diag.Errorf("\"ha_oob_management_subnet\" must be empty if \"ha_subnet\" is empty")
 				}
 			}
 		}
@@ -2165,7 +2322,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if privateModeInfo.EnablePrivateMode {
 			if newHaGwEnabled || changeHaGw {
 				if _, ok := d.GetOk("ha_private_mode_subnet_zone"); !ok && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-					return fmt.Errorf("%q is required when creating a Spoke HA Gateway in AWS if private mode is enabled and %q is provided", "ha_private_mode_subnet_zone", "ha_subnet")
+					return diag.Errorf("%q is required when creating a Spoke HA Gateway in AWS if private mode is enabled and %q is provided", "ha_private_mode_subnet_zone", "ha_subnet")
 				}
 
 				privateModeSubnetZone := d.Get("ha_private_mode_subnet_zone").(string)
@@ -2175,9 +2332,9 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 
 		if newHaGwEnabled {
 			// New configuration to enable HA
-			_, err := client.CreateSpokeHaGw(spokeHaGw)
+			_, err := client.CreateSpokeHaGwContext(ctx, spokeHaGw)
 			if err != nil {
-				return fmt.Errorf("failed to enable HA Aviatrix Spoke Gateway: %s", err)
+				return diag.Errorf("failed to enable HA Aviatrix Spoke Gateway: %s", err)
 			}
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 				if d.Get("rx_queue_size").(string) != "" && !d.HasChange("rx_queue_size") {
@@ -2187,30 +2344,30 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 					}
 					err := client.SetRxQueueSize(haGwRxQueueSize)
 					if err != nil {
-						return fmt.Errorf("could not set rx queue size for spoke ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
+						return diag.Errorf("could not set rx queue size for spoke ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
 					}
 				}
 			}
 			//}
 		} else if deleteHaGw {
 			// Ha configuration has been deleted
-			err := client.DeleteGateway(haGateway)
+			err := client.DeleteGatewayContext(ctx, haGateway)
 			if err != nil {
-				return fmt.Errorf("failed to delete Aviatrix Spoke HA gateway: %s", err)
+				return diag.Errorf("failed to delete Aviatrix Spoke HA gateway: %s", err)
 			}
 		} else if changeHaGw {
 			// HA subnet has been modified. Delete older HA GW,
 			// and launch new HA GW in new subnet.
-			err := client.DeleteGateway(haGateway)
+			err := client.DeleteGatewayContext(ctx, haGateway)
 			if err != nil {
-				return fmt.Errorf("failed to delete Aviatrix Spoke HA gateway: %s", err)
+				return diag.Errorf("failed to delete Aviatrix Spoke HA gateway: %s", err)
 			}
 
 			spokeHaGw.Eip = ""
 
-			_, err = client.CreateSpokeHaGw(spokeHaGw)
+			_, err = client.CreateSpokeHaGwContext(ctx, spokeHaGw)
 			if err != nil {
-				return fmt.Errorf("failed to enable HA Aviatrix Spoke Gateway: %s", err)
+				return diag.Errorf("failed to enable HA Aviatrix Spoke Gateway: %s", err)
 			}
 			newHaGwEnabled = true
 		}
@@ -2233,11 +2390,11 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		}
 
 		if singleAZ {
-			log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, "Enable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 
 			err := client.EnableSingleAZGateway(singleAZGateway)
 			if err != nil {
-				return fmt.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
+				return diag.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
 			}
 
 			if haEnabled && manageHaGw {
@@ -2246,14 +2403,14 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				}
 				err := client.EnableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
-					return fmt.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
+					return diag.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
 				}
 			}
 		} else {
-			log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, "Disable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 			err := client.DisableSingleAZGateway(singleAZGateway)
 			if err != nil {
-				return fmt.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
+				return diag.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
 			}
 
 			if haEnabled && manageHaGw {
@@ -2262,29 +2419,29 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				}
 				err := client.DisableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
-					return fmt.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
+					return diag.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
 				}
 			}
 		}
 	}
 
 	if d.HasChange("ha_gw_size") && !newHaGwEnabled && manageHaGw {
-		_, err := client.GetGateway(haGateway)
+		_, err := client.GetGatewayContext(ctx, haGateway)
 		if err != nil {
 			// If HA gateway does not exist, don't try to change gateway size and continue with the rest of the updates
 			// to the gateway
 			if err != goaviatrix.ErrNotFound {
-				return fmt.Errorf("couldn't find Aviatrix Spoke HA Gateway while trying to update HA Gw size: %s", err)
+				return diag.Errorf("couldn't find Aviatrix Spoke HA Gateway while trying to update HA Gw size: %s", err)
 			}
 		} else {
 			if haGateway.VpcSize == "" {
-				return fmt.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
+				return diag.Errorf("A valid non empty ha_gw_size parameter is mandatory for this resource if " +
 					"ha_subnet or ha_zone is set")
 			}
-			err = client.UpdateGateway(haGateway)
-			log.Printf("[INFO] Updating HA Gateway size to: %s ", haGateway.VpcSize)
+			err = client.UpdateGatewayContext(ctx, haGateway)
+			tflog.Info(ctx, "Updating HA Gateway size", map[string]interface{}{"vpc_size": haGateway.VpcSize})
 			if err != nil {
-				return fmt.Errorf("failed to update Aviatrix Spoke HA Gateway size: %s", err)
+				return diag.Errorf("failed to update Aviatrix Spoke HA Gateway size: %s", err)
 			}
 		}
 	}
@@ -2298,12 +2455,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if enableSNat {
 			err := client.EnableSNat(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable single_ip' mode SNAT: %s", err)
+				return diag.Errorf("failed to enable single_ip' mode SNAT: %s", err)
 			}
 		} else {
 			err := client.DisableSNat(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable 'single_ip' mode SNAT: %s", err)
+				return diag.Errorf("failed to enable 'single_ip' mode SNAT: %s", err)
 			}
 		}
 	}
@@ -2318,17 +2475,17 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if enableVpcDnsServer {
 			err := client.EnableVpcDnsServer(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable VPC DNS Server: %s", err)
+				return diag.Errorf("failed to enable VPC DNS Server: %s", err)
 			}
 		} else {
 			err := client.DisableVpcDnsServer(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable VPC DNS Server: %s", err)
+				return diag.Errorf("failed to disable VPC DNS Server: %s", err)
 			}
 		}
 
 	} else if d.HasChange("enable_vpc_dns_server") {
-		return fmt.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if d.HasChange("enable_learned_cidrs_approval") {
@@ -2339,13 +2496,13 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			gw.LearnedCidrsApproval = "on"
 			err := client.EnableSpokeLearnedCidrsApproval(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 			}
 		} else {
 			gw.LearnedCidrsApproval = "off"
 			err := client.DisableSpokeLearnedCidrsApproval(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to disable learned cidrs approval: %s", err)
 			}
 		}
 	}
@@ -2358,14 +2515,14 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 
 		err := client.UpdateSpokePendingApprovedCidrs(gw)
 		if err != nil {
-			return fmt.Errorf("could not update approved CIDRs: %w", err)
+			return diag.Errorf("could not update approved CIDRs: %v", err)
 		}
 	}
 
 	if d.HasChange("enable_encrypt_volume") {
 		if d.Get("enable_encrypt_volume").(bool) {
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) providers")
+				return diag.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) providers")
 			}
 			gwEncVolume := &goaviatrix.Gateway{
 				GwName:              d.Get("gw_name").(string),
@@ -2373,7 +2530,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			}
 			err := client.EnableEncryptVolume(gwEncVolume)
 			if err != nil {
-				return fmt.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwEncVolume.GwName, err)
+				return diag.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwEncVolume.GwName, err)
 			}
 
 			haSubnet := d.Get("ha_subnet").(string)
@@ -2386,14 +2543,14 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				}
 				err := client.EnableEncryptVolume(gwHAEncVolume)
 				if err != nil {
-					return fmt.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwHAEncVolume.GwName, err)
+					return diag.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwHAEncVolume.GwName, err)
 				}
 			}
 		} else {
-			return fmt.Errorf("can't disable Encrypt Volume for gateway: %s", gateway.GwName)
+			return diag.Errorf("can't disable Encrypt Volume for gateway: %s", gateway.GwName)
 		}
 	} else if d.HasChange("customer_managed_keys") {
-		return fmt.Errorf("updating customer_managed_keys only is not allowed")
+		return diag.Errorf("updating customer_managed_keys only is not allowed")
 	}
 
 	if d.HasChange("customized_spoke_vpc_routes") {
@@ -2406,9 +2563,9 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				CustomizedSpokeVpcRoutes: newRouteList,
 			}
 			err := client.EditGatewayCustomRoutes(transitGateway)
-			log.Printf("[INFO] Customizeing routes of spoke gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, "Customizing routes of spoke gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			if err != nil {
-				return fmt.Errorf("failed to customize spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to customize spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -2423,9 +2580,9 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				FilteredSpokeVpcRoutes: newRouteList,
 			}
 			err := client.EditGatewayFilterRoutes(transitGateway)
-			log.Printf("[INFO] Editing filtered spoke vpc routes of spoke gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, "Editing filtered spoke vpc routes of spoke gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			if err != nil {
-				return fmt.Errorf("failed to edit filtered spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit filtered spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -2440,9 +2597,9 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 				AdvertisedSpokeRoutes: newRouteList,
 			}
 			err := client.EditGatewayAdvertisedCidr(transitGateway)
-			log.Printf("[INFO] Editing included advertised spoke vpc routes of spoke gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, "Editing included advertised spoke vpc routes of spoke gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			if err != nil {
-				return fmt.Errorf("failed to edit included advertised spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit included advertised spoke vpc routes of spoke gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -2453,28 +2610,28 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		excludedInstances = append(excludedInstances, v.(string))
 	}
 	if !monitorGatewaySubnets && len(excludedInstances) != 0 {
-		return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
+		return diag.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
 	}
 	if d.HasChange("enable_monitor_gateway_subnets") {
 		if monitorGatewaySubnets {
 			err := client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
 			if err != nil {
-				return fmt.Errorf("could not enable monitor gateway subnets: %w", err)
+				return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 			}
 		} else {
 			err := client.DisableMonitorGatewaySubnets(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not disable monitor gateway subnets: %w", err)
+				return diag.Errorf("could not disable monitor gateway subnets: %v", err)
 			}
 		}
 	} else if d.HasChange("monitor_exclude_list") {
 		err := client.DisableMonitorGatewaySubnets(gateway.GwName)
 		if err != nil {
-			return fmt.Errorf("could not disable monitor gateway subnets: %w", err)
+			return diag.Errorf("could not disable monitor gateway subnets: %v", err)
 		}
 		err = client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
 		if err != nil {
-			return fmt.Errorf("could not enable monitor gateway subnets: %w", err)
+			return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 		}
 	}
 
@@ -2482,12 +2639,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_jumbo_frame").(bool) {
 			err := client.EnableJumboFrame(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable jumbo frame for spoke gateway when updating: %w", err)
+				return diag.Errorf("could not enable jumbo frame for spoke gateway when updating: %v", err)
 			}
 		} else {
 			err := client.DisableJumboFrame(gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable jumbo frame for spoke gateway when updating: %w", err)
+				return diag.Errorf("could not disable jumbo frame for spoke gateway when updating: %v", err)
 			}
 		}
 	}
@@ -2496,12 +2653,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_gro_gso").(bool) {
 			err := client.EnableGroGso(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't enable GRO/GSO on spoke gateway when updating: %s", err)
+				return diag.Errorf("couldn't enable GRO/GSO on spoke gateway when updating: %s", err)
 			}
 		} else {
 			err := client.DisableGroGso(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't disable GRO/GSO on spoke gateway when updating: %s", err)
+				return diag.Errorf("couldn't disable GRO/GSO on spoke gateway when updating: %s", err)
 			}
 		}
 	}
@@ -2510,12 +2667,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_private_vpc_default_route").(bool) {
 			err := client.EnablePrivateVpcDefaultRoute(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable private vpc default route during spoke gateway update: %w", err)
+				return diag.Errorf("could not enable private vpc default route during spoke gateway update: %v", err)
 			}
 		} else {
 			err := client.DisablePrivateVpcDefaultRoute(gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable private vpc default route during spoke gateway update: %w", err)
+				return diag.Errorf("could not disable private vpc default route during spoke gateway update: %v", err)
 			}
 		}
 	}
@@ -2524,12 +2681,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_skip_public_route_table_update").(bool) {
 			err := client.EnableSkipPublicRouteUpdate(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable skip public route update during spoke gateway update: %w", err)
+				return diag.Errorf("could not enable skip public route update during spoke gateway update: %v", err)
 			}
 		} else {
 			err := client.DisableSkipPublicRouteUpdate(gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable skip public route update during spoke gateway update: %w", err)
+				return diag.Errorf("could not disable skip public route update during spoke gateway update: %v", err)
 			}
 		}
 	}
@@ -2538,12 +2695,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_auto_advertise_s2c_cidrs").(bool) {
 			err := client.EnableAutoAdvertiseS2CCidrs(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable auto advertise s2c cidrs during spoke gateway update: %w", err)
+				return diag.Errorf("could not enable auto advertise s2c cidrs during spoke gateway update: %v", err)
 			}
 		} else {
 			err := client.DisableAutoAdvertiseS2CCidrs(gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable auto advertise s2c cidrs during spoke gateway update: %w", err)
+				return diag.Errorf("could not disable auto advertise s2c cidrs during spoke gateway update: %v", err)
 			}
 		}
 	}
@@ -2557,12 +2714,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			var err error
 			detectionTime, err = client.GetTunnelDetectionTime("Controller")
 			if err != nil {
-				return fmt.Errorf("could not get default tunnel detection time during Spoke Gateway update: %w", err)
+				return diag.Errorf("could not get default tunnel detection time during Spoke Gateway update: %v", err)
 			}
 		}
 		err := client.ModifyTunnelDetectionTime(gateway.GwName, detectionTime)
 		if err != nil {
-			return fmt.Errorf("could not modify tunnel detection time during Spoke Gateway update: %w", err)
+			return diag.Errorf("could not modify tunnel detection time during Spoke Gateway update: %v", err)
 		}
 	}
 
@@ -2577,7 +2734,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		spokeGw.BgpManualSpokeAdvertiseCidrs = strings.Join(spokeBgpManualSpokeAdvertiseCidrs, ",")
 		err := client.SetSpokeBgpManualAdvertisedNetworks(spokeGw)
 		if err != nil {
-			return fmt.Errorf("failed to set spoke bgp manual advertise CIDRs during Spoke Gateway update: %s", err)
+			return diag.Errorf("failed to set spoke bgp manual advertise CIDRs during Spoke Gateway update: %s", err)
 		}
 	}
 
@@ -2588,7 +2745,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.SetBgpEcmpSpoke(gateway, enabled)
 		if err != nil {
-			return fmt.Errorf("could not set bgp_ecmp during Spoke Gateway update: %w", err)
+			return diag.Errorf("could not set bgp_ecmp during Spoke Gateway update: %v", err)
 		}
 	}
 
@@ -2599,19 +2756,19 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_active_standby").(bool) {
 			if d.Get("enable_active_standby_preemptive").(bool) {
 				if err := client.EnableActiveStandbyPreemptiveSpoke(gateway); err != nil {
-					return fmt.Errorf("could not enable Preemptive Mode for Active-Standby during Spoke Gateway update: %w", err)
+					return diag.Errorf("could not enable Preemptive Mode for Active-Standby during Spoke Gateway update: %v", err)
 				}
 			} else {
 				if err := client.EnableActiveStandbySpoke(gateway); err != nil {
-					return fmt.Errorf("could not enable Active-Standby during Spoke Gateway update: %w", err)
+					return diag.Errorf("could not enable Active-Standby during Spoke Gateway update: %v", err)
 				}
 			}
 		} else {
 			if d.Get("enable_active_standby_preemptive").(bool) {
-				return fmt.Errorf("could not enable Preemptive Mode with Active-Standby disabled")
+				return diag.Errorf("could not enable Preemptive Mode with Active-Standby disabled")
 			}
 			if err := client.DisableActiveStandbySpoke(gateway); err != nil {
-				return fmt.Errorf("could not disable Active-Standby during Spoke Gateway update: %w", err)
+				return diag.Errorf("could not disable Active-Standby during Spoke Gateway update: %v", err)
 			}
 		}
 	}
@@ -2630,7 +2787,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			// Handle the case where prependASPath is empty here so that the API is not called twice
 			err := client.SetPrependASPathSpoke(gateway, nil)
 			if err != nil {
-				return fmt.Errorf("could not delete prepend_as_path during Spoke Gateway update: %w", err)
+				return diag.Errorf("could not delete prepend_as_path during Spoke Gateway update: %v", err)
 			}
 		}
 
@@ -2638,14 +2795,14 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			localAsNumber := d.Get("local_as_number").(string)
 			err := client.SetLocalASNumberSpoke(gateway, localAsNumber)
 			if err != nil {
-				return fmt.Errorf("could not set local_as_number: %w", err)
+				return diag.Errorf("could not set local_as_number: %v", err)
 			}
 		}
 
 		if d.HasChange("prepend_as_path") && len(prependASPath) > 0 {
 			err := client.SetPrependASPathSpoke(gateway, prependASPath)
 			if err != nil {
-				return fmt.Errorf("could not set prepend_as_path during Spoke Gateway update: %w", err)
+				return diag.Errorf("could not set prepend_as_path during Spoke Gateway update: %v", err)
 			}
 		}
 	}
@@ -2657,7 +2814,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.SetBgpPollingTimeSpoke(gateway, bgpPollingTime.(int))
 		if err != nil {
-			return fmt.Errorf("could not update bgp polling time during Spoke Gateway update: %w", err)
+			return diag.Errorf("could not update bgp polling time during Spoke Gateway update: %v", err)
 		}
 	}
 
@@ -2668,14 +2825,14 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.SetBgpBfdPollingTimeSpoke(gateway, bgpBfdPollingTime.(int))
 		if err != nil {
-			return fmt.Errorf("could not update bgp neighbor status polling time during Spoke Gateway update: %w", err)
+			return diag.Errorf("could not update bgp neighbor status polling time during Spoke Gateway update: %v", err)
 		}
 	}
 
 	if d.HasChange("bgp_hold_time") {
 		err := client.ChangeBgpHoldTime(gateway.GwName, d.Get("bgp_hold_time").(int))
 		if err != nil {
-			return fmt.Errorf("could not change BGP Hold Time during Spoke Gateway update: %w", err)
+			return diag.Errorf("could not change BGP Hold Time during Spoke Gateway update: %v", err)
 		}
 	}
 
@@ -2683,7 +2840,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		disableRoutePropagation := d.Get("disable_route_propagation").(bool)
 		enableBgp := d.Get("enable_bgp").(bool)
 		if disableRoutePropagation && !enableBgp {
-			return fmt.Errorf("disable route propagation is not supported for Non-BGP Spoke during Spoke Gateway update")
+			return diag.Errorf("disable route propagation is not supported for Non-BGP Spoke during Spoke Gateway update")
 		}
 		gw := &goaviatrix.SpokeVpc{
 			GwName: d.Get("gw_name").(string),
@@ -2691,19 +2848,19 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if disableRoutePropagation {
 			err := client.DisableSpokeOnpremRoutePropagation(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable route propagation for Spoke %s during Spoke Gateway update: %v", gw.GwName, err)
+				return diag.Errorf("failed to disable route propagation for Spoke %s during Spoke Gateway update: %v", gw.GwName, err)
 			}
 		} else {
 			err := client.EnableSpokeOnpremRoutePropagation(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable route propagation for Spoke %s during Spoke Gateway update: %v", gw.GwName, err)
+				return diag.Errorf("failed to enable route propagation for Spoke %s during Spoke Gateway update: %v", gw.GwName, err)
 			}
 		}
 	}
 
 	if d.HasChange("rx_queue_size") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("could not update rx_queue_size since it only supports AWS related cloud types")
+			return diag.Errorf("could not update rx_queue_size since it only supports AWS related cloud types")
 		}
 		gw := &goaviatrix.Gateway{
 			GwName:      gateway.GwName,
@@ -2711,7 +2868,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		}
 		err := client.SetRxQueueSize(gw)
 		if err != nil {
-			return fmt.Errorf("could not modify rx queue size for spoke: %s during gateway update: %v", gw.GatewayName, err)
+			return diag.Errorf("could not modify rx queue size for spoke: %s during gateway update: %v", gw.GatewayName, err)
 		}
 		if haSubnet != "" || haZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
@@ -2720,7 +2877,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 			}
 			err := client.SetRxQueueSize(haGwRxQueueSize)
 			if err != nil {
-				return fmt.Errorf("could not modify rx queue size for spoke ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
+				return diag.Errorf("could not modify rx queue size for spoke ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
 			}
 		}
 	}
@@ -2729,12 +2886,12 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_global_vpc").(bool) {
 			err := client.EnableGlobalVpc(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable global vpc during spoke gateway update: %w", err)
+				return diag.Errorf("could not enable global vpc during spoke gateway update: %v", err)
 			}
 		} else {
 			err := client.DisableGlobalVpc(gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable global vpc during spoke gateway update: %w", err)
+				return diag.Errorf("could not disable global vpc during spoke gateway update: %v", err)
 			}
 		}
 	}
@@ -2743,30 +2900,30 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta interface{}
 		if d.Get("enable_ipv6").(bool) {
 			err := client.EnableIPv6(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't enable IPv6 on spoke gateway when updating: %w", err)
+				return diag.Errorf("couldn't enable IPv6 on spoke gateway when updating: %v", err)
 			}
 		} else {
 			err := client.DisableIPv6(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't disable IPv6 on spoke gateway when updating: %w", err)
+				return diag.Errorf("couldn't disable IPv6 on spoke gateway when updating: %v", err)
 			}
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(gateway.GwName)
-	return resourceAviatrixSpokeGatewayRead(d, meta)
+	return resourceAviatrixSpokeGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: d.Get("cloud_type").(int),
 		GwName:    d.Get("gw_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Spoke Gateway: %#v", gateway)
+	tflog.Info(ctx, "Deleting Aviatrix Spoke Gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
 	// If HA is enabled, delete HA GW first.
 	if d.Get("manage_ha_gateway").(bool) {
@@ -2775,17 +2932,17 @@ func resourceAviatrixSpokeGatewayDelete(d *schema.ResourceData, meta interface{}
 		if haSubnet != "" || haZone != "" {
 			// Delete HA Gw too
 			gateway.GwName += "-hagw"
-			err := client.DeleteGateway(gateway)
+			err := client.DeleteGatewayContext(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("failed to delete Aviatrix Spoke HA gateway: %s", err)
+				return diag.Errorf("failed to delete Aviatrix Spoke HA gateway: %s", err)
 			}
 		}
 	}
 	gateway.GwName = d.Get("gw_name").(string)
 
-	err := client.DeleteGateway(gateway)
+	err := client.DeleteGatewayContext(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Spoke Gateway: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Spoke Gateway: %s", err)
 	}
 
 	return nil
@@ -2,9 +2,9 @@ package aviatrix
 
 import (
 	"context"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -62,7 +62,7 @@ func marshalSpokeGatewaySubnetGroupInput(d *schema.ResourceData) *goaviatrix.Spo
 }
 
 func resourceAviatrixSpokeGatewaySubnetGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	spokeGatewaySubnetGroup := marshalSpokeGatewaySubnetGroupInput(d)
 
@@ -92,13 +92,13 @@ func resourceAviatrixSpokeGatewaySubnetGroupReadIfRequired(ctx context.Context,
 }
 
 func resourceAviatrixSpokeGatewaySubnetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	name := d.Get("name").(string)
 
 	if name == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
 			return diag.Errorf("invalid ID, expected ID gw_name~name, instead got %s", d.Id())
@@ -137,7 +137,7 @@ func resourceAviatrixSpokeGatewaySubnetGroupUpdate(ctx context.Context, d *schem
 	flag := false
 	defer resourceAviatrixSpokeGatewaySubnetGroupReadIfRequired(ctx, d, meta, &flag)
 
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	if d.HasChange("subnets") {
 		spokeGatewaySubnetGroup := marshalSpokeGatewaySubnetGroupInput(d)
@@ -151,7 +151,7 @@ func resourceAviatrixSpokeGatewaySubnetGroupUpdate(ctx context.Context, d *schem
 }
 
 func resourceAviatrixSpokeGatewaySubnetGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*goaviatrix.Client)
+	client := meta.(goaviatrix.ClientInterface)
 
 	spokeGatewaySubnetGroup := marshalSpokeGatewaySubnetGroupInput(d)
 
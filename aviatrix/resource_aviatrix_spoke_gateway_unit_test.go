@@ -0,0 +1,143 @@
+package aviatrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceAviatrixSpokeGatewayCustomizeDiff(t *testing.T) {
+	baseConfig := func(changes map[string]interface{}) map[string]interface{} {
+		config := map[string]interface{}{
+			"cloud_type":   1,
+			"account_name": "aws-account",
+			"gw_name":      "spoke-gw",
+			"vpc_id":       "vpc-0123456789",
+			"vpc_reg":      "us-west-1",
+			"gw_size":      "t3.small",
+			"subnet":       "10.2.0.0/24",
+		}
+		for k, v := range changes {
+			config[k] = v
+		}
+		return config
+	}
+
+	tests := []struct {
+		name    string
+		state   map[string]string
+		config  map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "valid HA gateway",
+			config: baseConfig(map[string]interface{}{
+				"ha_subnet":  "10.2.1.0/24",
+				"ha_gw_size": "t3.small",
+			}),
+		},
+		{
+			name:    "invalid cloud type",
+			config:  baseConfig(map[string]interface{}{"cloud_type": 4096}),
+			wantErr: "invalid cloud type",
+		},
+		{
+			name: "HA gateway managed separately",
+			config: baseConfig(map[string]interface{}{
+				"manage_ha_gateway": false,
+				"ha_subnet":         "10.2.1.0/24",
+			}),
+			wantErr: "'manage_ha_gateway' is set to false",
+		},
+		{
+			name: "ha_zone without ha_gw_size",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type": 8,
+				"ha_subnet":  "10.2.1.0/24",
+				"ha_zone":    "az-2",
+			}),
+			wantErr: "A valid non empty ha_gw_size parameter is mandatory",
+		},
+		{
+			name: "Azure ha_zone without ha_subnet",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type": 8,
+				"ha_zone":    "az-2",
+				"ha_gw_size": "Standard_B1ms",
+			}),
+			wantErr: "'ha_subnet' must be provided to enable HA on Azure",
+		},
+		{
+			name: "private VPC default route outside AWS",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type":                       4,
+				"enable_private_vpc_default_route": true,
+			}),
+			wantErr: "enable_private_vpc_default_route is only valid for AWS",
+		},
+		{
+			name: "BGP on GCP",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type": 4,
+				"enable_bgp": true,
+			}),
+			wantErr: "enabling BGP is only supported for AWS (1) and Azure (8)",
+		},
+		{
+			name: "route propagation disabled without BGP",
+			config: baseConfig(map[string]interface{}{
+				"disable_route_propagation": true,
+			}),
+			wantErr: "disable route propagation is not supported on Non-BGP Spoke",
+		},
+		{
+			name: "BGP over LAN without BGP",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type":          8,
+				"enable_bgp_over_lan": true,
+			}),
+			wantErr: "'enable_bgp' is required to be true to enable bgp over lan",
+		},
+		{
+			name: "Azure insane mode without /26 subnet",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type":  8,
+				"insane_mode": true,
+			}),
+			wantErr: "'subnet' must be a /26 CIDR segment",
+		},
+		{
+			name: "GCP insane mode does not need /26 subnet",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type":  4,
+				"insane_mode": true,
+			}),
+		},
+		{
+			name: "encrypt volume outside AWS",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type":            8,
+				"enable_encrypt_volume": true,
+			}),
+			wantErr: "'enable_encrypt_volume' is only supported for AWS",
+		},
+		{
+			name: "unknown approval flag is not validated",
+			config: baseConfig(map[string]interface{}{
+				"enable_learned_cidrs_approval": unknownValue,
+				"approved_learned_cidrs":        []interface{}{"10.10.0.0/16"},
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testPlanDiff(resourceAviatrixSpokeGateway(), tt.state, tt.config)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
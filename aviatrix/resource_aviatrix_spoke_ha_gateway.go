@@ -1,10 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -13,12 +15,18 @@ import (
 
 func resourceAviatrixSpokeHaGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSpokeHaGatewayCreate,
-		Read:   resourceAviatrixSpokeHaGatewayRead,
-		Update: resourceAviatrixSpokeHaGatewayUpdate,
-		Delete: resourceAviatrixSpokeHaGatewayDelete,
+		CreateContext: resourceAviatrixSpokeHaGatewayCreate,
+		ReadContext:   resourceAviatrixSpokeHaGatewayRead,
+		UpdateContext: resourceAviatrixSpokeHaGatewayUpdate,
+		DeleteContext: resourceAviatrixSpokeHaGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -148,8 +156,8 @@ func resourceAviatrixSpokeHaGateway() *schema.Resource {
 	}
 }
 
-func resourceAviatrixSpokeHaGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeHaGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.SpokeHaGateway{
 		PrimaryGwName:      d.Get("primary_gw_name").(string),
@@ -165,9 +173,9 @@ func resourceAviatrixSpokeHaGatewayCreate(d *schema.ResourceData, meta interface
 	primaryGw := &goaviatrix.Gateway{
 		GwName: d.Get("primary_gw_name").(string),
 	}
-	gw, err := client.GetGateway(primaryGw)
+	gw, err := client.GetGatewayContext(ctx, primaryGw)
 	if err != nil {
-		return fmt.Errorf("couldn't retrieve Aviatrix primary spoke gateway in spoke ha gateway creation: %s", err)
+		return diag.Errorf("couldn't retrieve Aviatrix primary spoke gateway in spoke ha gateway creation: %s", err)
 	}
 
 	if gateway.GwName == "" {
@@ -182,58 +190,58 @@ func resourceAviatrixSpokeHaGatewayCreate(d *schema.ResourceData, meta interface
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 		if gateway.Zone != "" || gateway.AvailabilityDomain != "" || gateway.FaultDomain != "" {
-			return fmt.Errorf("'zone', 'availability_domain' and 'fault_domain' are required to be empty for creating an AWS related cloud type spoke ha gateway")
+			return diag.Errorf("'zone', 'availability_domain' and 'fault_domain' are required to be empty for creating an AWS related cloud type spoke ha gateway")
 		}
 	}
 
 	azureEipName, azureEipNameOk := d.GetOk("azure_eip_name_resource_group")
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 		if gateway.AvailabilityDomain != "" || gateway.FaultDomain != "" {
-			return fmt.Errorf("'availability_domain' and 'fault_domain' are required to be empty for creating an Azure related cloud type spoke ha gateway")
+			return diag.Errorf("'availability_domain' and 'fault_domain' are required to be empty for creating an Azure related cloud type spoke ha gateway")
 		}
 		if gateway.Zone != "" {
 			gateway.Subnet = fmt.Sprintf("%s~~%s~~", gateway.Subnet, gateway.Zone)
 		}
 		if gateway.Eip != "" {
 			if !azureEipNameOk {
-				return fmt.Errorf("'azure_eip_name_resource_group' must be set when 'eip' is set for Azure (8), AzureGov (32) or AzureChina (2048)")
+				return diag.Errorf("'azure_eip_name_resource_group' must be set when 'eip' is set for Azure (8), AzureGov (32) or AzureChina (2048)")
 			}
 			gateway.Eip = fmt.Sprintf("%s:%s", azureEipName.(string), gateway.Eip)
 		}
 	} else {
 		if azureEipNameOk {
-			return fmt.Errorf("'azure_eip_name_resource_group' only supports Azure clouds including Azure (8), AzureGov (32) or AzureChina (2048)")
+			return diag.Errorf("'azure_eip_name_resource_group' only supports Azure clouds including Azure (8), AzureGov (32) or AzureChina (2048)")
 		}
 	}
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.GCPRelatedCloudTypes) {
 		if gateway.Zone == "" {
-			return fmt.Errorf("'zone' is required for creating an GCP spoke ha gateway")
+			return diag.Errorf("'zone' is required for creating an GCP spoke ha gateway")
 		}
 		if gateway.AvailabilityDomain != "" || gateway.FaultDomain != "" {
-			return fmt.Errorf("'availability_domain' and 'fault_domain' are required to be empty for creating an GCP related cloud type spoke ha gateway")
+			return diag.Errorf("'availability_domain' and 'fault_domain' are required to be empty for creating an GCP related cloud type spoke ha gateway")
 		}
 	}
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.OCIRelatedCloudTypes) {
 		if gateway.AvailabilityDomain == "" || gateway.FaultDomain == "" {
-			return fmt.Errorf("'availability_domain' and 'fault_domain' are required for creating an OCI related cloud type spoke ha gateway")
+			return diag.Errorf("'availability_domain' and 'fault_domain' are required for creating an OCI related cloud type spoke ha gateway")
 		}
 		if gateway.Zone != "" {
-			return fmt.Errorf("'zone' is required to be empty for creating an OCI related cloud type spoke ha gateway")
+			return diag.Errorf("'zone' is required to be empty for creating an OCI related cloud type spoke ha gateway")
 		}
 	}
 
 	if gateway.InsaneMode == "yes" {
 		if !goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|
 			goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-			return fmt.Errorf("insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return diag.Errorf("insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 
 		if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 			insaneModeAz := d.Get("insane_mode_az").(string)
 			if insaneModeAz == "" {
-				return fmt.Errorf("'insane_mode_az' is required if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+				return diag.Errorf("'insane_mode_az' is required if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 			}
 			var insaneModeSubnet []string
 			insaneModeSubnet = append(insaneModeSubnet, gateway.Subnet, insaneModeAz)
@@ -241,24 +249,24 @@ func resourceAviatrixSpokeHaGatewayCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	spokeHaGwName, err := client.CreateSpokeHaGw(gateway)
+	spokeHaGwName, err := client.CreateSpokeHaGwContext(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Spoke HA Gateway: %s", err)
+		return diag.Errorf("failed to create Aviatrix Spoke HA Gateway: %s", err)
 	}
 
 	d.SetId(spokeHaGwName)
-	return resourceAviatrixSpokeHaGatewayRead(d, meta)
+	return resourceAviatrixSpokeHaGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeHaGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeHaGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	var isImport bool
 	gwName := d.Get("gw_name").(string)
 	if gwName == "" {
 		isImport = true
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no gateway name received", map[string]interface{}{"id": id})
 		d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -268,16 +276,16 @@ func resourceAviatrixSpokeHaGatewayRead(d *schema.ResourceData, meta interface{}
 		GwName:      d.Get("gw_name").(string),
 	}
 
-	gw, err := client.GetGateway(gateway)
+	gw, err := client.GetGatewayContext(ctx, gateway)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Spoke Gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Spoke Gateway: %s", err)
 	}
 
-	log.Printf("[TRACE] reading spoke gateway %s: %#v", d.Get("gw_name").(string), gw)
+	tflog.Trace(ctx, "reading spoke gateway", map[string]interface{}{"gw_name": d.Get("gw_name").(string)})
 
 	d.Set("primary_gw_name", gw.PrimaryGwName)
 	d.Set("eip", gw.PublicIP)
@@ -306,7 +314,7 @@ func resourceAviatrixSpokeHaGatewayRead(d *schema.ResourceData, meta interface{}
 		if len(azureEip) == 3 {
 			d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the Spoke HA Gateway %s", gw.GwName)
+			tflog.Warn(ctx, "could not get Azure EIP name and resource group for the Spoke HA Gateway", map[string]interface{}{"gw_name": gw.GwName})
 		}
 	} else if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.OCIRelatedCloudTypes) {
 		d.Set("vpc_reg", gw.VpcRegion)
@@ -335,8 +343,8 @@ func resourceAviatrixSpokeHaGatewayRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceAviatrixSpokeHaGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeHaGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: d.Get("cloud_type").(int),
@@ -346,30 +354,30 @@ func resourceAviatrixSpokeHaGatewayUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange("gw_size") {
 		gateway.GwName = d.Get("gw_name").(string)
 		gateway.VpcSize = d.Get("gw_size").(string)
-		err := client.UpdateGateway(gateway)
+		err := client.UpdateGatewayContext(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to update Aviatrix Spoke HA Gateway %s: %s", gateway.GwName, err)
+			return diag.Errorf("failed to update Aviatrix Spoke HA Gateway %s: %s", gateway.GwName, err)
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(gateway.GwName)
-	return resourceAviatrixSpokeHaGatewayRead(d, meta)
+	return resourceAviatrixSpokeHaGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeHaGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeHaGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: d.Get("cloud_type").(int),
 		GwName:    d.Get("gw_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Spoke Ha Gateway: %#v", gateway)
+	tflog.Info(ctx, "Deleting Aviatrix Spoke Ha Gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
-	err := client.DeleteGateway(gateway)
+	err := client.DeleteGatewayContext(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Spoke HA Gateway %s : %s", gateway.GwName, err)
+		return diag.Errorf("failed to delete Aviatrix Spoke HA Gateway %s : %s", gateway.GwName, err)
 	}
 
 	return nil
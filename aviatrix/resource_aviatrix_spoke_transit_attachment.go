@@ -2,11 +2,11 @@ package aviatrix
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -15,12 +15,18 @@ import (
 
 func resourceAviatrixSpokeTransitAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSpokeTransitAttachmentCreate,
-		Read:   resourceAviatrixSpokeTransitAttachmentRead,
-		Update: resourceAviatrixSpokeTransitAttachmentUpdate,
-		Delete: resourceAviatrixSpokeTransitAttachmentDelete,
+		CreateContext: resourceAviatrixSpokeTransitAttachmentCreate,
+		ReadContext:   resourceAviatrixSpokeTransitAttachmentRead,
+		UpdateContext: resourceAviatrixSpokeTransitAttachmentUpdate,
+		DeleteContext: resourceAviatrixSpokeTransitAttachmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,41 +107,38 @@ func resourceAviatrixSpokeTransitAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixSpokeTransitAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeTransitAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	attachment := marshalSpokeTransitAttachmentInput(d)
 
-	spoke, err := client.GetGateway(&goaviatrix.Gateway{GwName: attachment.SpokeGwName})
+	spoke, err := client.GetGatewayContext(ctx, &goaviatrix.Gateway{GwName: attachment.SpokeGwName})
 	if err != nil {
-		return fmt.Errorf("could not find spoke gateway: %s", err)
+		return diag.Errorf("could not find spoke gateway: %s", err)
 	}
 
 	// get transit gateway details
 	transitGatewayDetails, err := getGatewayDetails(client, attachment.TransitGwName)
 	if err != nil {
-		return fmt.Errorf("could not get transit gateway details for %s: %w", attachment.TransitGwName, err)
+		return diag.Errorf("could not get transit gateway details for %s: %v", attachment.TransitGwName, err)
 	}
 	// get edge transit logical interface names
 	if err := getEdgeTransitLogicalIfNames(d, transitGatewayDetails, attachment); err != nil {
-		return fmt.Errorf("%w", err)
+		return diag.Errorf("%v", err)
 	}
 
 	if !spoke.EnableBgp && (len(attachment.SpokePrependAsPath) != 0 || len(attachment.TransitPrependAsPath) != 0) {
-		return fmt.Errorf("'spoke_prepend_as_path' and 'transit_prepend_as_path' are only valid for BGP enabled spoke gateway")
+		return diag.Errorf("'spoke_prepend_as_path' and 'transit_prepend_as_path' are only valid for BGP enabled spoke gateway")
 	}
 
 	if attachment.NoMaxPerformance && attachment.InsaneModeTunnelNumber > 0 {
-		return fmt.Errorf("'tunnel_count' can only be specified with max performance enabled. Please set 'enable_max_performance' to true")
+		return diag.Errorf("'tunnel_count' can only be specified with max performance enabled. Please set 'enable_max_performance' to true")
 	}
 
 	d.SetId(attachment.SpokeGwName + "~" + attachment.TransitGwName)
 	flag := false
-	defer resourceAviatrixSpokeTransitAttachmentReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixSpokeTransitAttachmentReadIfRequired(ctx, d, meta, &flag)
 
-	timeout := 15 * time.Minute
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
 	try, maxTries, backoff := 0, 10, 1000*time.Millisecond
 	for {
 		try++
@@ -143,14 +146,18 @@ func resourceAviatrixSpokeTransitAttachmentCreate(d *schema.ResourceData, meta i
 		if err != nil {
 			if strings.Contains(err.Error(), "is not up") || strings.Contains(err.Error(), "is not ready") {
 				if try == maxTries {
-					return fmt.Errorf("could not attach spoke: %s to transit %s: %v", attachment.SpokeGwName, attachment.TransitGwName, err)
+					return diag.Errorf("could not attach spoke: %s to transit %s: %v", attachment.SpokeGwName, attachment.TransitGwName, err)
+				}
+				select {
+				case <-ctx.Done():
+					return diag.Errorf("timed out attaching spoke: %s to transit %s: %v", attachment.SpokeGwName, attachment.TransitGwName, err)
+				case <-time.After(backoff):
 				}
-				time.Sleep(backoff)
 				// Double the backoff time after each failed try
 				backoff *= 2
 				continue
 			}
-			return fmt.Errorf("failed to attach spoke: %s to transit %s: %v", attachment.SpokeGwName, attachment.TransitGwName, err)
+			return diag.Errorf("failed to attach spoke: %s to transit %s: %v", attachment.SpokeGwName, attachment.TransitGwName, err)
 		}
 		break
 	}
@@ -161,9 +168,9 @@ func resourceAviatrixSpokeTransitAttachmentCreate(d *schema.ResourceData, meta i
 			TransitGatewayName2: attachment.TransitGwName,
 		}
 
-		err := client.EditTransitConnectionASPathPrepend(transGwPeering, attachment.SpokePrependAsPath)
+		err := client.EditTransitConnectionASPathPrependContext(ctx, transGwPeering, attachment.SpokePrependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set spoke_prepend_as_path: %v", err)
+			return diag.Errorf("could not set spoke_prepend_as_path: %v", err)
 		}
 	}
 
@@ -173,35 +180,35 @@ func resourceAviatrixSpokeTransitAttachmentCreate(d *schema.ResourceData, meta i
 			TransitGatewayName2: attachment.SpokeGwName,
 		}
 
-		err := client.EditTransitConnectionASPathPrepend(transGwPeering, attachment.TransitPrependAsPath)
+		err := client.EditTransitConnectionASPathPrependContext(ctx, transGwPeering, attachment.TransitPrependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set transit_prepend_as_path: %v", err)
+			return diag.Errorf("could not set transit_prepend_as_path: %v", err)
 		}
 	}
 
-	return resourceAviatrixSpokeTransitAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSpokeTransitAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSpokeTransitAttachmentReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixSpokeTransitAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSpokeTransitAttachmentRead(d, meta)
+		return resourceAviatrixSpokeTransitAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeTransitAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	spokeGwName := d.Get("spoke_gw_name").(string)
 	transitGwName := d.Get("transit_gw_name").(string)
 	if spokeGwName == "" || transitGwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no spoke_gw_name or transit_gw_name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no spoke_gw_name or transit_gw_name received", map[string]interface{}{"id": id})
 		d.SetId(id)
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
-			return fmt.Errorf("import id is invalid, expecting spoke_gw_name~transit_gw_name, but received: %s", id)
+			return diag.Errorf("import id is invalid, expecting spoke_gw_name~transit_gw_name, but received: %s", id)
 		}
 		spokeGwName = parts[0]
 		transitGwName = parts[1]
@@ -212,13 +219,13 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta int
 		TransitGwName: transitGwName,
 	}
 
-	attachment, err := client.GetSpokeTransitAttachment(spokeTransitAttachment)
+	attachment, err := client.GetSpokeTransitAttachmentContext(ctx, spokeTransitAttachment)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("could not find spoke_transit_attachment: %v", err)
+		return diag.Errorf("could not find spoke_transit_attachment: %v", err)
 	}
 
 	d.Set("spoke_gw_name", attachment.SpokeGwName)
@@ -233,7 +240,7 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta int
 
 		err = d.Set("route_tables", routeTables)
 		if err != nil {
-			return fmt.Errorf("could not set route_tables: %v", err)
+			return diag.Errorf("could not set route_tables: %v", err)
 		}
 	}
 
@@ -242,7 +249,7 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta int
 		TransitGatewayName2: transitGwName,
 	}
 
-	transitGatewayPeering, err = client.GetTransitGatewayPeeringDetails(transitGatewayPeering)
+	transitGatewayPeering, err = client.GetTransitGatewayPeeringDetailsContext(ctx, transitGatewayPeering)
 	if err == goaviatrix.ErrNotFound {
 		d.SetId("")
 		return nil
@@ -251,14 +258,14 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta int
 	// set the transit gateway logical interface names only for edge gateways
 	transitGateway, err := getGatewayDetails(client, transitGwName)
 	if err != nil {
-		return fmt.Errorf("could not get transit gateway details for %s: %w", transitGwName, err)
+		return diag.Errorf("could not get transit gateway details for %s: %v", transitGwName, err)
 	}
 
 	if goaviatrix.IsCloudType(transitGateway.CloudType, goaviatrix.EdgeRelatedCloudTypes) {
 		if len(attachment.TransitGatewayLogicalIfNames) > 0 {
 			logicalIfNames, err := getLogicalIfNames(transitGateway, attachment.TransitGatewayLogicalIfNames)
 			if err != nil {
-				return fmt.Errorf("could not get logical interface names for edge transit gateway %s: %w", transitGwName, err)
+				return diag.Errorf("could not get logical interface names for edge transit gateway %s: %v", transitGwName, err)
 			}
 			_ = d.Set("transit_gateway_logical_ifnames", logicalIfNames)
 		}
@@ -278,7 +285,7 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta int
 
 			err = d.Set("spoke_prepend_as_path", prependAsPath)
 			if err != nil {
-				return fmt.Errorf("could not set spoke_prepend_as_path: %v", err)
+				return diag.Errorf("could not set spoke_prepend_as_path: %v", err)
 			}
 		}
 
@@ -290,7 +297,7 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta int
 
 			err = d.Set("transit_prepend_as_path", prependAsPath)
 			if err != nil {
-				return fmt.Errorf("could not set transit_prepend_as_path: %v", err)
+				return diag.Errorf("could not set transit_prepend_as_path: %v", err)
 			}
 		}
 	} else {
@@ -302,11 +309,11 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAviatrixSpokeTransitAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeTransitAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	if !d.Get("spoke_bgp_enabled").(bool) && d.HasChanges("spoke_prepend_as_path", "transit_prepend_as_path") {
-		return fmt.Errorf("'spoke_prepend_as_path' and 'transit_prepend_as_path' are only valid for BGP enabled spoke gateway")
+		return diag.Errorf("'spoke_prepend_as_path' and 'transit_prepend_as_path' are only valid for BGP enabled spoke gateway")
 	}
 
 	d.Partial(true)
@@ -320,9 +327,9 @@ func resourceAviatrixSpokeTransitAttachmentUpdate(d *schema.ResourceData, meta i
 			TransitGatewayName2: transitGwName,
 		}
 
-		err := client.EditTransitConnectionASPathPrepend(transitGatewayPeering, getStringList(d, "spoke_prepend_as_path"))
+		err := client.EditTransitConnectionASPathPrependContext(ctx, transitGatewayPeering, getStringList(d, "spoke_prepend_as_path"))
 		if err != nil {
-			return fmt.Errorf("could not update spoke_prepend_as_path: %v", err)
+			return diag.Errorf("could not update spoke_prepend_as_path: %v", err)
 		}
 	}
 
@@ -332,9 +339,9 @@ func resourceAviatrixSpokeTransitAttachmentUpdate(d *schema.ResourceData, meta i
 			TransitGatewayName2: spokeGwName,
 		}
 
-		err := client.EditTransitConnectionASPathPrepend(transitGwPeering, getStringList(d, "transit_prepend_as_path"))
+		err := client.EditTransitConnectionASPathPrependContext(ctx, transitGwPeering, getStringList(d, "transit_prepend_as_path"))
 		if err != nil {
-			return fmt.Errorf("could not update transit_prepend_as_path: %v", err)
+			return diag.Errorf("could not update transit_prepend_as_path: %v", err)
 		}
 	}
 
@@ -346,29 +353,29 @@ func resourceAviatrixSpokeTransitAttachmentUpdate(d *schema.ResourceData, meta i
 		}
 
 		if transitGatewayPeering.TunnelCount > 0 && !d.Get("enable_max_performance").(bool) {
-			return fmt.Errorf("'tunnel_count' can't be updated with max performance disabled")
+			return diag.Errorf("'tunnel_count' can't be updated with max performance disabled")
 		}
 
-		err := client.UpdateTransitGatewayPeeringTunnelCount(transitGatewayPeering)
+		err := client.UpdateTransitGatewayPeeringTunnelCountContext(ctx, transitGatewayPeering)
 		if err != nil {
-			return fmt.Errorf("could not update tunnel_count for spoke transit attachment: %v : %v", spokeGwName+"~"+transitGwName, err)
+			return diag.Errorf("could not update tunnel_count for spoke transit attachment: %v : %v", spokeGwName+"~"+transitGwName, err)
 		}
 	}
 
 	d.Partial(false)
 	d.SetId(spokeGwName + "~" + transitGwName)
-	return resourceAviatrixSpokeTransitAttachmentRead(d, meta)
+	return resourceAviatrixSpokeTransitAttachmentRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeTransitAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixSpokeTransitAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	spokeTransitAttachment := &goaviatrix.SpokeTransitAttachment{
 		SpokeGwName:   d.Get("spoke_gw_name").(string),
 		TransitGwName: d.Get("transit_gw_name").(string),
 	}
-	if err := client.DeleteSpokeTransitAttachment(spokeTransitAttachment); err != nil {
-		return fmt.Errorf("could not detach spoke: %s from transit %s: %v", spokeTransitAttachment.SpokeGwName,
+	if err := client.DeleteSpokeTransitAttachmentContext(ctx, spokeTransitAttachment); err != nil {
+		return diag.Errorf("could not detach spoke: %s from transit %s: %v", spokeTransitAttachment.SpokeGwName,
 			spokeTransitAttachment.TransitGwName, err)
 	}
 
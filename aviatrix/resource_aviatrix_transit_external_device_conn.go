@@ -1,13 +1,14 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"net"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
@@ -28,12 +29,12 @@ var defaultBfdConfig = goaviatrix.BgpBfdConfig{
 
 func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransitExternalDeviceConnCreate,
-		Read:   resourceAviatrixTransitExternalDeviceConnRead,
-		Update: resourceAviatrixTransitExternalDeviceConnUpdate,
-		Delete: resourceAviatrixTransitExternalDeviceConnDelete,
+		CreateContext: resourceAviatrixTransitExternalDeviceConnCreate,
+		ReadContext:   resourceAviatrixTransitExternalDeviceConnRead,
+		UpdateContext: resourceAviatrixTransitExternalDeviceConnUpdate,
+		DeleteContext: resourceAviatrixTransitExternalDeviceConnDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -518,8 +519,8 @@ func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitExternalDeviceConnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	var bgpSendCommunities *goaviatrix.BgpSendCommunities
 
@@ -562,28 +563,28 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 
 	sendComm, ok := d.Get("connection_bgp_send_communities").(string)
 	if !ok {
-		return fmt.Errorf("failed to assert connection_bgp_send_communities as string")
+		return diag.Errorf("failed to assert connection_bgp_send_communities as string")
 	}
 	blockComm, ok := d.Get("connection_bgp_send_communities_block").(bool)
 	if !ok {
-		return fmt.Errorf("failed to assert connection_bgp_send_communities_block as bool")
+		return diag.Errorf("failed to assert connection_bgp_send_communities_block as bool")
 	}
 	setPerConnCommunity := false
 	if sendComm != "" || blockComm {
 		connName, ok := d.Get("connection_name").(string)
 		setPerConnCommunity = true
 		if !ok {
-			return fmt.Errorf("failed to assert connection_name as string")
+			return diag.Errorf("failed to assert connection_name as string")
 		}
 
 		gwName, ok := d.Get("gw_name").(string)
 		if !ok {
-			return fmt.Errorf("failed to assert gw_name as string")
+			return diag.Errorf("failed to assert gw_name as string")
 		}
 
 		sendAdditive, ok := d.Get("connection_bgp_send_communities_additive").(bool)
 		if !ok {
-			return fmt.Errorf("failed to assert connection_bgp_send_communities_additive as bool")
+			return diag.Errorf("failed to assert connection_bgp_send_communities_additive as bool")
 		}
 
 		bgpSendCommunities = &goaviatrix.BgpSendCommunities{
@@ -596,11 +597,11 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 	}
 
 	if !externalDeviceConn.EnableEdgeUnderlay && externalDeviceConn.ConnectionName == "" {
-		return fmt.Errorf("'connection_name' is required when 'enable_edge_underlay' is false")
+		return diag.Errorf("'connection_name' is required when 'enable_edge_underlay' is false")
 	}
 
 	if externalDeviceConn.EnableEdgeUnderlay && externalDeviceConn.ConnectionName != "" {
-		return fmt.Errorf("please set 'connection_name' to empty when 'enable_edge_underlay' is true")
+		return diag.Errorf("please set 'connection_name' to empty when 'enable_edge_underlay' is true")
 	}
 
 	tunnelProtocol := strings.ToUpper(d.Get("tunnel_protocol").(string))
@@ -614,24 +615,24 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 		externalDeviceConn.LocalTunnelCidr != "" ||
 		externalDeviceConn.BackupRemoteGatewayIP != "" ||
 		externalDeviceConn.BackupLocalTunnelCidr != "") && externalDeviceConn.TunnelProtocol == "LAN" {
-		return fmt.Errorf("'remote_gateway_ip', 'local_tunnel_cidr', 'backup_remote_gateway_ip' and 'backup_local_tunnel_cidr' " +
+		return diag.Errorf("'remote_gateway_ip', 'local_tunnel_cidr', 'backup_remote_gateway_ip' and 'backup_local_tunnel_cidr' " +
 			"cannot be set with 'tunnel_protocol' = 'LAN'. Please use the appropriate LAN attributes instead")
 	}
 	if (externalDeviceConn.RemoteLanIP != "" ||
 		externalDeviceConn.LocalLanIP != "" ||
 		externalDeviceConn.BackupRemoteLanIP != "" ||
 		externalDeviceConn.BackupLocalLanIP != "") && externalDeviceConn.TunnelProtocol != "LAN" {
-		return fmt.Errorf("'remote_lan_ip', 'local_lan_ip', 'backup_remote_lan_ip' and 'backup_local_lan_ip' " +
+		return diag.Errorf("'remote_lan_ip', 'local_lan_ip', 'backup_remote_lan_ip' and 'backup_local_lan_ip' " +
 			"can only be set with 'tunnel_protocol' = 'LAN'")
 	}
 	if externalDeviceConn.RemoteLanIP == "" && externalDeviceConn.TunnelProtocol == "LAN" {
-		return fmt.Errorf("'remote_lan_ip' is required when 'tunnel_protocol' = 'LAN'")
+		return diag.Errorf("'remote_lan_ip' is required when 'tunnel_protocol' = 'LAN'")
 	}
 	if externalDeviceConn.EnableIpv6 && externalDeviceConn.RemoteLanIPv6 == "" && externalDeviceConn.TunnelProtocol == "LAN" {
-		return fmt.Errorf("'remote_lan_ipv6_ip' is required when 'tunnel_protocol' = 'LAN'")
+		return diag.Errorf("'remote_lan_ipv6_ip' is required when 'tunnel_protocol' = 'LAN'")
 	}
 	if externalDeviceConn.RemoteGatewayIP == "" && externalDeviceConn.TunnelProtocol != "LAN" {
-		return fmt.Errorf("'remote_gateway_ip' is required when 'tunnel_protocol' != 'LAN'")
+		return diag.Errorf("'remote_gateway_ip' is required when 'tunnel_protocol' != 'LAN'")
 	}
 
 	bgpLocalAsNum, err := strconv.Atoi(d.Get("bgp_local_as_num").(string))
@@ -668,9 +669,9 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 	}
 
 	if externalDeviceConn.ConnectionType == "bgp" && externalDeviceConn.RemoteSubnet != "" {
-		return fmt.Errorf("'remote_subnet' is needed for connection type of 'static' not 'bgp'")
+		return diag.Errorf("'remote_subnet' is needed for connection type of 'static' not 'bgp'")
 	} else if externalDeviceConn.ConnectionType == "static" && (externalDeviceConn.BgpLocalAsNum != 0 || externalDeviceConn.BgpRemoteAsNum != 0) {
-		return fmt.Errorf("'bgp_local_as_num' and 'bgp_remote_as_num' are needed for connection type of 'bgp' not 'static'")
+		return diag.Errorf("'bgp_local_as_num' and 'bgp_remote_as_num' are needed for connection type of 'bgp' not 'static'")
 	}
 
 	customAlgorithms := d.Get("custom_algorithms").(bool)
@@ -681,50 +682,50 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 			externalDeviceConn.Phase2DhGroups == "" ||
 			externalDeviceConn.Phase1Encryption == "" ||
 			externalDeviceConn.Phase2Encryption == "" {
-			return fmt.Errorf("custom_algorithms is enabled, please set all of the algorithm parameters")
+			return diag.Errorf("custom_algorithms is enabled, please set all of the algorithm parameters")
 		} else if externalDeviceConn.Phase1Auth == goaviatrix.Phase1AuthDefault &&
 			externalDeviceConn.Phase2Auth == goaviatrix.Phase2AuthDefault &&
 			externalDeviceConn.Phase1DhGroups == goaviatrix.Phase1DhGroupDefault &&
 			externalDeviceConn.Phase2DhGroups == goaviatrix.Phase2DhGroupDefault &&
 			externalDeviceConn.Phase1Encryption == goaviatrix.Phase1EncryptionDefault &&
 			externalDeviceConn.Phase2Encryption == goaviatrix.Phase2EncryptionDefault {
-			return fmt.Errorf("custom_algorithms is enabled, cannot use default values for " +
+			return diag.Errorf("custom_algorithms is enabled, cannot use default values for " +
 				"all six algorithm parameters. Please change the value of at least one of the six algorithm parameters")
 		}
 	} else {
 		if externalDeviceConn.Phase1Auth != "" || externalDeviceConn.Phase1DhGroups != "" ||
 			externalDeviceConn.Phase1Encryption != "" || externalDeviceConn.Phase2Auth != "" ||
 			externalDeviceConn.Phase2DhGroups != "" || externalDeviceConn.Phase2Encryption != "" {
-			return fmt.Errorf("custom_algorithms is not enabled, all algorithm fields should be left empty")
+			return diag.Errorf("custom_algorithms is not enabled, all algorithm fields should be left empty")
 		}
 	}
 
 	if haEnabled {
 		if externalDeviceConn.TunnelProtocol == "LAN" {
 			if externalDeviceConn.BackupRemoteLanIP == "" {
-				return fmt.Errorf("ha is enabled and 'tunnel_protocol' = 'LAN', please specify 'backup_remote_lan_ip'")
+				return diag.Errorf("ha is enabled and 'tunnel_protocol' = 'LAN', please specify 'backup_remote_lan_ip'")
 			}
 		} else {
 			if externalDeviceConn.BackupRemoteGatewayIP == "" {
-				return fmt.Errorf("ha is enabled, please specify 'backup_remote_gateway_ip'")
+				return diag.Errorf("ha is enabled, please specify 'backup_remote_gateway_ip'")
 			}
 			remoteIP := strings.Split(externalDeviceConn.RemoteGatewayIP, ",")
 			if len(remoteIP) > 1 {
-				return fmt.Errorf("expected 'remote_gateway_ip' to contain only one valid IPv4 address, got: %s", externalDeviceConn.RemoteGatewayIP)
+				return diag.Errorf("expected 'remote_gateway_ip' to contain only one valid IPv4 address, got: %s", externalDeviceConn.RemoteGatewayIP)
 			}
 			ip := net.ParseIP(externalDeviceConn.RemoteGatewayIP)
 			if four := ip.To4(); four == nil {
-				return fmt.Errorf("expected 'remote_gateway_ip' to contain a valid IPv4 address, got: %s", externalDeviceConn.RemoteGatewayIP)
+				return diag.Errorf("expected 'remote_gateway_ip' to contain a valid IPv4 address, got: %s", externalDeviceConn.RemoteGatewayIP)
 			}
 			if externalDeviceConn.BackupRemoteGatewayIP == externalDeviceConn.RemoteGatewayIP {
-				return fmt.Errorf("expected 'backup_remote_gateway_ip' to contain a different valid IPv4 address than 'remote_gateway_ip'")
+				return diag.Errorf("expected 'backup_remote_gateway_ip' to contain a different valid IPv4 address than 'remote_gateway_ip'")
 			}
 			if externalDeviceConn.EnableIpv6 && externalDeviceConn.ExternalDeviceBackupIPv6 == "" {
-				return fmt.Errorf("ha is enabled and 'enable_ipv6' is true, please specify 'external_device_backup_ipv6'")
+				return diag.Errorf("ha is enabled and 'enable_ipv6' is true, please specify 'external_device_backup_ipv6'")
 			}
 		}
 		if externalDeviceConn.BackupBgpRemoteAsNum == 0 && externalDeviceConn.ConnectionType == "bgp" {
-			return fmt.Errorf("ha is enabled, and 'connection_type' is 'bgp', please specify 'backup_bgp_remote_as_num'")
+			return diag.Errorf("ha is enabled, and 'connection_type' is 'bgp', please specify 'backup_bgp_remote_as_num'")
 		}
 		if externalDeviceConn.BackupRemoteGatewayIP != "" {
 			externalDeviceConn.RemoteGatewayIP = externalDeviceConn.RemoteGatewayIP + "," + externalDeviceConn.BackupRemoteGatewayIP
@@ -732,31 +733,31 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 		}
 	} else {
 		if backupDirectConnect {
-			return fmt.Errorf("ha is not enabled, please set 'backup_direct_connect' to false")
+			return diag.Errorf("ha is not enabled, please set 'backup_direct_connect' to false")
 		}
 		if externalDeviceConn.BackupPreSharedKey != "" || externalDeviceConn.BackupLocalTunnelCidr != "" ||
 			externalDeviceConn.BackupRemoteTunnelCidr != "" || externalDeviceConn.BackupRemoteGatewayIP != "" ||
 			externalDeviceConn.BackupRemoteLanIP != "" || externalDeviceConn.BackupLocalLanIP != "" {
-			return fmt.Errorf("ha is not enabled, please set 'backup_pre_shared_key', 'backup_local_tunnel_cidr', " +
+			return diag.Errorf("ha is not enabled, please set 'backup_pre_shared_key', 'backup_local_tunnel_cidr', " +
 				"'backup_remote_gateway_ip', 'backup_remote_tunnel_cidr', 'backup_remote_lan_ip' and 'backup_local_lan_ip' to empty")
 		}
 		if externalDeviceConn.BackupBgpRemoteAsNum != 0 && externalDeviceConn.ConnectionType == "bgp" {
-			return fmt.Errorf("ha is not enabled, and 'connection_type' is 'bgp', please specify 'backup_bgp_remote_as_num' to empty")
+			return diag.Errorf("ha is not enabled, and 'connection_type' is 'bgp', please specify 'backup_bgp_remote_as_num' to empty")
 		}
 	}
 
 	enableLearnedCIDRApproval := d.Get("enable_learned_cidrs_approval").(bool)
 	if externalDeviceConn.ConnectionType != "bgp" && enableLearnedCIDRApproval {
-		return fmt.Errorf("'connection_type' must be 'bgp' if 'enable_learned_cidrs_approval' is set to true")
+		return diag.Errorf("'connection_type' must be 'bgp' if 'enable_learned_cidrs_approval' is set to true")
 	}
 	manualBGPCidrs := getStringSet(d, "manual_bgp_advertised_cidrs")
 	if externalDeviceConn.ConnectionType != "bgp" && len(manualBGPCidrs) != 0 {
-		return fmt.Errorf("'connection_type' must be 'bgp' if 'manual_bgp_advertised_cidrs' is not empty")
+		return diag.Errorf("'connection_type' must be 'bgp' if 'manual_bgp_advertised_cidrs' is not empty")
 	}
 
 	approvedCidrs := getStringSet(d, "approved_cidrs")
 	if !enableLearnedCIDRApproval && len(approvedCidrs) > 0 {
-		return fmt.Errorf("creating transit external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return diag.Errorf("creating transit external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	enableIkev2 := d.Get("enable_ikev2").(bool)
@@ -765,57 +766,57 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 	}
 
 	if externalDeviceConn.ConnectionType != "bgp" && externalDeviceConn.TunnelProtocol != "IPsec" {
-		return fmt.Errorf("'tunnel_protocol' can not be set unless 'connection_type' is 'bgp'")
+		return diag.Errorf("'tunnel_protocol' can not be set unless 'connection_type' is 'bgp'")
 	}
 	greOrLan := externalDeviceConn.TunnelProtocol == "GRE" || externalDeviceConn.TunnelProtocol == "LAN"
 	if greOrLan && customAlgorithms {
-		return fmt.Errorf("custom algorithm parameters are not valid with 'tunnel_protocol' = GRE or LAN")
+		return diag.Errorf("custom algorithm parameters are not valid with 'tunnel_protocol' = GRE or LAN")
 	}
 	if greOrLan && enableIkev2 {
-		return fmt.Errorf("enable_ikev2 is not supported with 'tunnel_protocol' = GRE or LAN")
+		return diag.Errorf("enable_ikev2 is not supported with 'tunnel_protocol' = GRE or LAN")
 	}
 	if greOrLan && externalDeviceConn.PreSharedKey != "" {
-		return fmt.Errorf("'pre_shared_key' is not valid with 'tunnel_protocol' = GRE or LAN")
+		return diag.Errorf("'pre_shared_key' is not valid with 'tunnel_protocol' = GRE or LAN")
 	}
 	if externalDeviceConn.PeerVnetID != "" && (externalDeviceConn.ConnectionType != "bgp" || externalDeviceConn.TunnelProtocol != "LAN") {
-		return fmt.Errorf("'remote_vpc_name' is only valid for 'connection_type' = 'bgp' and 'tunnel_protocol' = 'LAN'")
+		return diag.Errorf("'remote_vpc_name' is only valid for 'connection_type' = 'bgp' and 'tunnel_protocol' = 'LAN'")
 	}
 	if externalDeviceConn.TunnelProtocol == "LAN" {
 		if externalDeviceConn.DirectConnect == "true" || externalDeviceConn.BackupDirectConnect == "true" {
-			return fmt.Errorf("enabling 'direct_connect' or 'backup_direct_connect' is not allowed for BGP over LAN connections")
+			return diag.Errorf("enabling 'direct_connect' or 'backup_direct_connect' is not allowed for BGP over LAN connections")
 		}
 	}
 
 	phase1RemoteIdentifier := d.Get("phase1_remote_identifier").([]interface{})
 	ph1RemoteIdList := goaviatrix.ExpandStringList(phase1RemoteIdentifier)
 	if haEnabled && len(phase1RemoteIdentifier) != 0 && len(phase1RemoteIdentifier) != 2 {
-		return fmt.Errorf("please either set two phase 1 remote IDs or none, when HA is enabled")
+		return diag.Errorf("please either set two phase 1 remote IDs or none, when HA is enabled")
 	} else if !haEnabled && len(phase1RemoteIdentifier) > 1 {
-		return fmt.Errorf("please either set one phase 1 remote ID or none, when HA is disabled")
+		return diag.Errorf("please either set one phase 1 remote ID or none, when HA is disabled")
 	}
 
 	if _, ok := d.GetOk("prepend_as_path"); ok {
 		if externalDeviceConn.ConnectionType != "bgp" {
-			return fmt.Errorf("'prepend_as_path' only supports 'bgp' connection. Please update 'connection_type' to 'bgp'")
+			return diag.Errorf("'prepend_as_path' only supports 'bgp' connection. Please update 'connection_type' to 'bgp'")
 		}
 	}
 
 	if d.Get("enable_bgp_lan_activemesh").(bool) {
 		if externalDeviceConn.ConnectionType != "bgp" || externalDeviceConn.TunnelProtocol != "LAN" {
-			return fmt.Errorf("'enable_bgp_lan_activemesh' only supports 'bgp' connection with 'LAN' tunnel protocol")
+			return diag.Errorf("'enable_bgp_lan_activemesh' only supports 'bgp' connection with 'LAN' tunnel protocol")
 		}
 		if externalDeviceConn.HAEnabled != "true" {
-			return fmt.Errorf("'enable_bgp_lan_activemesh' can only be enabled with Remote Gateway HA enabled")
+			return diag.Errorf("'enable_bgp_lan_activemesh' can only be enabled with Remote Gateway HA enabled")
 		}
 		externalDeviceConn.EnableBgpLanActiveMesh = true
 	}
 
 	if externalDeviceConn.BgpMd5Key != "" || externalDeviceConn.BackupBgpMd5Key != "" {
 		if externalDeviceConn.ConnectionType != "bgp" {
-			return fmt.Errorf("BGP MD5 authentication key is only supported for BGP connection")
+			return diag.Errorf("BGP MD5 authentication key is only supported for BGP connection")
 		}
 		if externalDeviceConn.BackupBgpMd5Key != "" && !haEnabled {
-			return fmt.Errorf("couldn't configure backup BGP MD5 authentication key since HA is not enabled for BGP connection: %s", externalDeviceConn.ConnectionName)
+			return diag.Errorf("couldn't configure backup BGP MD5 authentication key since HA is not enabled for BGP connection: %s", externalDeviceConn.ConnectionName)
 		}
 
 		if externalDeviceConn.BgpMd5Key != "" {
@@ -827,7 +828,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 				bgpRemoteIp = strings.Split(externalDeviceConn.RemoteTunnelCidr, ",")
 			}
 			if len(md5KeyList) != len(bgpRemoteIp) {
-				return fmt.Errorf("can't apply BGP MD5 authentication key since it is not set correctly for BGP connection: %s", externalDeviceConn.ConnectionName)
+				return diag.Errorf("can't apply BGP MD5 authentication key since it is not set correctly for BGP connection: %s", externalDeviceConn.ConnectionName)
 			}
 		}
 
@@ -840,7 +841,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 				backupBgpRemoteIp = strings.Split(externalDeviceConn.BackupRemoteTunnelCidr, ",")
 			}
 			if len(backupMd5KeyList) != len(backupBgpRemoteIp) {
-				return fmt.Errorf("can't apply Backup BGP MD5 authentication key since it is not set correctly for BGP connection: %s", externalDeviceConn.ConnectionName)
+				return diag.Errorf("can't apply Backup BGP MD5 authentication key since it is not set correctly for BGP connection: %s", externalDeviceConn.ConnectionName)
 			}
 		}
 	}
@@ -848,7 +849,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 	enableJumboFrame := d.Get("enable_jumbo_frame").(bool)
 	if enableJumboFrame {
 		if externalDeviceConn.ConnectionType != "bgp" {
-			return fmt.Errorf("jumbo frame is only supported on bgp connection")
+			return diag.Errorf("jumbo frame is only supported on bgp connection")
 		}
 	}
 	if val, ok := d.Get("tunnel_src_ip").(string); ok && val != "" {
@@ -859,11 +860,11 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 	}
 
 	if !externalDeviceConn.EnableBgpMultihop && externalDeviceConn.ConnectionType != "bgp" {
-		return fmt.Errorf("multihop can only be configured for BGP connections")
+		return diag.Errorf("multihop can only be configured for BGP connections")
 	}
 
 	flag := false
-	defer resourceAviatrixTransitExternalDeviceConnReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixTransitExternalDeviceConnReadIfRequired(ctx, d, meta, &flag)
 
 	var edgeExternalDeviceConn goaviatrix.EdgeExternalDeviceConn
 	if externalDeviceConn.EnableEdgeUnderlay {
@@ -875,29 +876,29 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 	for {
 		try++
 		if externalDeviceConn.EnableEdgeUnderlay {
-			connName, err = client.CreateEdgeExternalDeviceConn(&edgeExternalDeviceConn)
-			log.Printf("[DEBUG] Created underlay connection %s", connName)
+			connName, err = client.CreateEdgeExternalDeviceConnContext(ctx, &edgeExternalDeviceConn)
+			tflog.Debug(ctx, "Created underlay connection", map[string]interface{}{"conn_name": connName})
 
 		} else {
-			err = client.CreateExternalDeviceConn(externalDeviceConn)
+			err = client.CreateExternalDeviceConnContext(ctx, externalDeviceConn)
 		}
 		if err != nil {
 			if strings.Contains(err.Error(), "is not up") {
 				if try == maxTries {
-					return fmt.Errorf("couldn't create Aviatrix transit external device connection: %s", err)
+					return diag.Errorf("couldn't create Aviatrix transit external device connection: %s", err)
 				}
 				time.Sleep(backoff)
 				// Double the backoff time after each failed try
 				backoff *= 2
 				continue
 			}
-			return fmt.Errorf("failed to create Aviatrix transit external device connection: %s", err)
+			return diag.Errorf("failed to create Aviatrix transit external device connection: %s", err)
 		}
 		if setPerConnCommunity {
-			err = client.ConnectionBGPSendCommunities(bgpSendCommunities)
+			err = client.ConnectionBGPSendCommunitiesContext(ctx, bgpSendCommunities)
 		}
 		if err != nil {
-			return fmt.Errorf("failed to set/block BGP communities for connection %s: %w", externalDeviceConn.ConnectionName, err)
+			return diag.Errorf("failed to set/block BGP communities for connection %s: %v", externalDeviceConn.ConnectionName, err)
 		}
 		break
 	}
@@ -909,16 +910,16 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 
 	enableBFD, ok := d.Get("enable_bfd").(bool)
 	if !ok {
-		return fmt.Errorf("expected enable_bfd to be a boolean, but got %T", d.Get("enable_bfd"))
+		return diag.Errorf("expected enable_bfd to be a boolean, but got %T", d.Get("enable_bfd"))
 	}
 
 	if d.Get("connection_type").(string) != "bgp" && enableBFD {
-		return fmt.Errorf("BFD is only supported for BGP connection")
+		return diag.Errorf("BFD is only supported for BGP connection")
 	}
 	externalDeviceConn.EnableBfd = enableBFD
 	bgp_bfd, ok := d.Get("bgp_bfd").([]interface{})
 	if !ok {
-		return fmt.Errorf("expected bgp_bfd to be a list of maps, but got %T", d.Get("bgp_bfd"))
+		return diag.Errorf("expected bgp_bfd to be a list of maps, but got %T", d.Get("bgp_bfd"))
 	}
 	// set the bgp bfd config details only if the user has enabled BFD
 	if enableBFD {
@@ -927,7 +928,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 			for _, bfd0 := range bgp_bfd {
 				bfd1, ok := bfd0.(map[string]interface{})
 				if !ok {
-					return fmt.Errorf("expected bgp_bfd to be a map, but got %T", bfd0)
+					return diag.Errorf("expected bgp_bfd to be a map, but got %T", bfd0)
 				}
 				externalDeviceConn.BgpBfdConfig = goaviatrix.CreateBgpBfdConfig(bfd1)
 			}
@@ -935,55 +936,55 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 			// set the bgp bfd config using the default values
 			externalDeviceConn.BgpBfdConfig = defaultBfdConfig
 		}
-		err := client.EditConnectionBgpBfd(externalDeviceConn)
+		err := client.EditConnectionBgpBfdContext(ctx, externalDeviceConn)
 		if err != nil {
-			return fmt.Errorf("could not enable BGP BFD connection: %v", err)
+			return diag.Errorf("could not enable BGP BFD connection: %v", err)
 		}
 	} else {
 		// if BFD is disabled and BGP BFD config is provided then throw an error
 		if len(bgp_bfd) > 0 {
-			return fmt.Errorf("bgp_bfd config can't be set when BFD is disabled")
+			return diag.Errorf("bgp_bfd config can't be set when BFD is disabled")
 		}
 	}
 
-	transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfig(&goaviatrix.TransitVpc{GwName: externalDeviceConn.GwName})
+	transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfigContext(ctx, &goaviatrix.TransitVpc{GwName: externalDeviceConn.GwName})
 	if err != nil {
-		return fmt.Errorf("could not get advanced config for transit gateway: %v", err)
+		return diag.Errorf("could not get advanced config for transit gateway: %v", err)
 	}
 	if d.Get("switch_to_ha_standby_gateway").(bool) && !transitAdvancedConfig.ActiveStandbyEnabled {
-		return fmt.Errorf("can not set 'switch_to_ha_standby_gateway' unless Active-Standby Mode is enabled on " +
+		return diag.Errorf("can not set 'switch_to_ha_standby_gateway' unless Active-Standby Mode is enabled on " +
 			"the transit gateway. Please enable Active-Standby Mode on the transit gateway and try again")
 	}
 
 	if d.Get("switch_to_ha_standby_gateway").(bool) {
-		if err := client.SwitchActiveTransitGateway(externalDeviceConn.GwName, externalDeviceConn.ConnectionName); err != nil {
-			return fmt.Errorf("could not switch active transit gateway to HA: %v", err)
+		if err := client.SwitchActiveTransitGatewayContext(ctx, externalDeviceConn.GwName, externalDeviceConn.ConnectionName); err != nil {
+			return diag.Errorf("could not switch active transit gateway to HA: %v", err)
 		}
 	}
 
 	if d.Get("enable_event_triggered_ha").(bool) {
-		if err := client.EnableSite2CloudEventTriggeredHA(externalDeviceConn.VpcID, externalDeviceConn.ConnectionName); err != nil {
-			return fmt.Errorf("could not enable event triggered HA for external device conn after create: %v", err)
+		if err := client.EnableSite2CloudEventTriggeredHAContext(ctx, externalDeviceConn.VpcID, externalDeviceConn.ConnectionName); err != nil {
+			return diag.Errorf("could not enable event triggered HA for external device conn after create: %v", err)
 		}
 	}
 
 	if enableLearnedCIDRApproval {
-		err = client.EnableTransitConnectionLearnedCIDRApproval(externalDeviceConn.GwName, externalDeviceConn.ConnectionName)
+		err = client.EnableTransitConnectionLearnedCIDRApprovalContext(ctx, externalDeviceConn.GwName, externalDeviceConn.ConnectionName)
 		if err != nil {
-			return fmt.Errorf("could not enable learned cidr approval: %v", err)
+			return diag.Errorf("could not enable learned cidr approval: %v", err)
 		}
 		if len(approvedCidrs) > 0 {
-			err = client.UpdateTransitConnectionPendingApprovedCidrs(externalDeviceConn.GwName, externalDeviceConn.ConnectionName, approvedCidrs)
+			err = client.UpdateTransitConnectionPendingApprovedCidrsContext(ctx, externalDeviceConn.GwName, externalDeviceConn.ConnectionName, approvedCidrs)
 			if err != nil {
-				return fmt.Errorf("could not update transit external device conn approved cidrs after creation: %v", err)
+				return diag.Errorf("could not update transit external device conn approved cidrs after creation: %v", err)
 			}
 		}
 	}
 
 	if len(manualBGPCidrs) > 0 {
-		err = client.EditTransitConnectionBGPManualAdvertiseCIDRs(externalDeviceConn.GwName, externalDeviceConn.ConnectionName, manualBGPCidrs)
+		err = client.EditTransitConnectionBGPManualAdvertiseCIDRsContext(ctx, externalDeviceConn.GwName, externalDeviceConn.ConnectionName, manualBGPCidrs)
 		if err != nil {
-			return fmt.Errorf("could not edit manual advertised BGP cidrs: %v", err)
+			return diag.Errorf("could not edit manual advertised BGP cidrs: %v", err)
 		}
 	}
 
@@ -1003,9 +1004,9 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 			Phase1RemoteIdentifier: ph1RemoteId,
 		}
 
-		err = client.UpdateSite2Cloud(editSite2cloud)
+		err = client.UpdateSite2CloudContext(ctx, editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -1029,9 +1030,9 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 			Phase1RemoteIdentifier: ph1RemoteId,
 		}
 
-		err = client.UpdateSite2Cloud(editSite2cloud)
+		err = client.UpdateSite2CloudContext(ctx, editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -1041,9 +1042,9 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 			prependASPath = append(prependASPath, v.(string))
 		}
 
-		err = client.EditTransitExternalDeviceConnASPathPrepend(externalDeviceConn, prependASPath)
+		err = client.EditTransitExternalDeviceConnASPathPrependContext(ctx, externalDeviceConn, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path: %v", err)
+			return diag.Errorf("could not set prepend_as_path: %v", err)
 		}
 	}
 
@@ -1054,35 +1055,35 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 		}
 		if phase1LocalIdentifier == "private_ip" {
 			s2c.Phase1LocalIdentifier = "private_ip"
-			err = client.EditSite2CloudPhase1LocalIdentifier(s2c)
+			err = client.EditSite2CloudPhase1LocalIdentifierContext(ctx, s2c)
 			if err != nil {
-				return fmt.Errorf("could not set phase1 local identificer to private_ip for connection: %s: %v", s2c.ConnName, err)
+				return diag.Errorf("could not set phase1 local identificer to private_ip for connection: %s: %v", s2c.ConnName, err)
 			}
 		}
 	}
 
-	return resourceAviatrixTransitExternalDeviceConnReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransitExternalDeviceConnReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransitExternalDeviceConnReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixTransitExternalDeviceConnReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransitExternalDeviceConnRead(d, meta)
+		return resourceAviatrixTransitExternalDeviceConnRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitExternalDeviceConnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	connectionName := d.Get("connection_name").(string)
 	vpcID := d.Get("vpc_id").(string)
 	if connectionName == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no 'connection_name' or 'vpc_id' received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no 'connection_name' or 'vpc_id' received", map[string]interface{}{"id": id})
 		parts := strings.SplitN(id, "~", 2)
 		if len(parts) != 2 {
-			return fmt.Errorf("expected import ID in the form 'connection_name~vpc_id' instead got %q", id)
+			return diag.Errorf("expected import ID in the form 'connection_name~vpc_id' instead got %q", id)
 		}
 		d.Set("connection_name", parts[0])
 		d.Set("vpc_id", parts[1])
@@ -1097,18 +1098,18 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 
 	localGateway, err := getGatewayDetails(client, externalDeviceConn.GwName)
 	if err != nil {
-		return fmt.Errorf("could not get local gateway details: %w", err)
+		return diag.Errorf("could not get local gateway details: %v", err)
 	}
 
-	conn, err := client.GetExternalDeviceConnDetail(externalDeviceConn, localGateway)
-	log.Printf("[TRACE] Reading Aviatrix external device conn: %s : %#v", d.Get("connection_name").(string), externalDeviceConn)
+	conn, err := client.GetExternalDeviceConnDetailContext(ctx, externalDeviceConn, localGateway)
+	tflog.Trace(ctx, "Reading Aviatrix external device conn", map[string]interface{}{"connection_name": d.Get("connection_name").(string)})
 
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix external device conn: %s, %#v", err, externalDeviceConn)
+		return diag.Errorf("couldn't find Aviatrix external device conn: %s, %#v", err, externalDeviceConn)
 	}
 
 	if conn != nil {
@@ -1124,7 +1125,7 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 
 		if conn.TunnelSrcIP != "" {
 			if err := d.Set("tunnel_src_ip", conn.TunnelSrcIP); err != nil {
-				return fmt.Errorf("error setting tunnel_src_ip: %w", err)
+				return diag.Errorf("error setting tunnel_src_ip: %v", err)
 			}
 		}
 
@@ -1133,13 +1134,13 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 			d.Set("local_lan_ip", conn.LocalLanIP)
 			d.Set("enable_bgp_lan_activemesh", conn.EnableBgpLanActiveMesh)
 			if err := d.Set("enable_edge_underlay", conn.EnableEdgeUnderlay); err != nil {
-				return fmt.Errorf("error setting enable_edge_underlay: %w", err)
+				return diag.Errorf("error setting enable_edge_underlay: %v", err)
 			}
 		} else {
 			d.Set("remote_gateway_ip", conn.RemoteGatewayIP)
 			d.Set("local_tunnel_cidr", conn.LocalTunnelCidr)
 			if err := d.Set("disable_activemesh", conn.DisableActivemesh); err != nil {
-				return fmt.Errorf("error setting disable_activemesh: %w", err)
+				return diag.Errorf("error setting disable_activemesh: %v", err)
 			}
 		}
 		if conn.ConnectionType == "bgp" {
@@ -1200,9 +1201,9 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 			d.Set("enable_edge_segmentation", false)
 		}
 
-		transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfig(&goaviatrix.TransitVpc{GwName: externalDeviceConn.GwName})
+		transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfigContext(ctx, &goaviatrix.TransitVpc{GwName: externalDeviceConn.GwName})
 		if err != nil {
-			return fmt.Errorf("could not get advanced config for transit gateway: %v", err)
+			return diag.Errorf("could not get advanced config for transit gateway: %v", err)
 		}
 
 		activeGatewayType := "Primary"
@@ -1219,7 +1220,7 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 				d.Set("enable_learned_cidrs_approval", v.EnabledApproval == "yes")
 				err := d.Set("approved_cidrs", v.ApprovedLearnedCidrs)
 				if err != nil {
-					return fmt.Errorf("could not set 'approved_cidrs' in state: %v", err)
+					return diag.Errorf("could not set 'approved_cidrs' in state: %v", err)
 				}
 				break
 			}
@@ -1236,7 +1237,7 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 		}
 
 		if err := d.Set("manual_bgp_advertised_cidrs", conn.ManualBGPCidrs); err != nil {
-			return fmt.Errorf("setting 'manual_bgp_advertised_cidrs' into state: %v", err)
+			return diag.Errorf("setting 'manual_bgp_advertised_cidrs' into state: %v", err)
 		}
 		if conn.TunnelProtocol == "" {
 			d.Set("tunnel_protocol", "IPsec")
@@ -1246,7 +1247,7 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 		if conn.TunnelProtocol == "LAN" {
 			err = d.Set("remote_vpc_name", conn.PeerVnetID)
 			if err != nil {
-				return fmt.Errorf("could not set value for remote_vpc_name: %w", err)
+				return diag.Errorf("could not set value for remote_vpc_name: %v", err)
 			}
 		}
 
@@ -1292,25 +1293,25 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 
 			err = d.Set("prepend_as_path", prependAsPath)
 			if err != nil {
-				return fmt.Errorf("could not set value for prepend_as_path: %v", err)
+				return diag.Errorf("could not set value for prepend_as_path: %v", err)
 			}
 		}
 		err = d.Set("enable_bgp_multihop", conn.EnableBgpMultihop)
 		if err != nil {
-			return fmt.Errorf("could not set value for enable_bgp_multihop: %w", err)
+			return diag.Errorf("could not set value for enable_bgp_multihop: %v", err)
 		}
 
 		err = d.Set("connection_bgp_send_communities", conn.BgpSendCommunities)
 		if err != nil {
-			return fmt.Errorf("could not set value for connection_bgp_send_communities: %w", err)
+			return diag.Errorf("could not set value for connection_bgp_send_communities: %v", err)
 		}
 		err = d.Set("connection_bgp_send_communities_additive", conn.BgpSendCommunitiesAdditive)
 		if err != nil {
-			return fmt.Errorf("could not set value for connection_bgp_send_communities: %w", err)
+			return diag.Errorf("could not set value for connection_bgp_send_communities: %v", err)
 		}
 		err = d.Set("connection_bgp_send_communities_block", conn.BgpSendCommunitiesBlock)
 		if err != nil {
-			return fmt.Errorf("could not set value for connection_bgp_send_communities: %w", err)
+			return diag.Errorf("could not set value for connection_bgp_send_communities: %v", err)
 		}
 
 		if conn.EnableIpv6 {
@@ -1331,68 +1332,68 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitExternalDeviceConnUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	d.Partial(true)
 
 	approvedCidrs := getStringSet(d, "approved_cidrs")
 	enableLearnedCIDRApproval := d.Get("enable_learned_cidrs_approval").(bool)
 	if !enableLearnedCIDRApproval && len(approvedCidrs) > 0 {
-		return fmt.Errorf("updating transit external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return diag.Errorf("updating transit external device conn: 'approved_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	gwName := d.Get("gw_name").(string)
 	connName := d.Get("connection_name").(string)
 	connType := d.Get("connection_type").(string)
-	transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfig(&goaviatrix.TransitVpc{GwName: gwName})
+	transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfigContext(ctx, &goaviatrix.TransitVpc{GwName: gwName})
 	if err != nil {
-		return fmt.Errorf("could not get advanced config for transit gateway: %v", err)
+		return diag.Errorf("could not get advanced config for transit gateway: %v", err)
 	}
 	if d.Get("switch_to_ha_standby_gateway").(bool) && !transitAdvancedConfig.ActiveStandbyEnabled {
-		return fmt.Errorf("can not set 'switch_to_ha_standby_gateway' unless Active-Standby Mode is enabled on " +
+		return diag.Errorf("can not set 'switch_to_ha_standby_gateway' unless Active-Standby Mode is enabled on " +
 			"the transit gateway. Please enable Active-Standby Mode on the transit gateway and try again")
 	}
 
 	if d.HasChange("switch_to_ha_standby_gateway") {
-		if err := client.SwitchActiveTransitGateway(gwName, connName); err != nil {
-			return fmt.Errorf("could not switch active transit gateway: %v", err)
+		if err := client.SwitchActiveTransitGatewayContext(ctx, gwName, connName); err != nil {
+			return diag.Errorf("could not switch active transit gateway: %v", err)
 		}
 	}
 
 	if d.HasChange("enable_learned_cidrs_approval") {
 		enableLearnedCIDRApproval := d.Get("enable_learned_cidrs_approval").(bool)
 		if enableLearnedCIDRApproval {
-			err = client.EnableTransitConnectionLearnedCIDRApproval(gwName, connName)
+			err = client.EnableTransitConnectionLearnedCIDRApprovalContext(ctx, gwName, connName)
 			if err != nil {
-				return fmt.Errorf("could not enable learned cidr approval: %v", err)
+				return diag.Errorf("could not enable learned cidr approval: %v", err)
 			}
 		} else {
-			err = client.DisableTransitConnectionLearnedCIDRApproval(gwName, connName)
+			err = client.DisableTransitConnectionLearnedCIDRApprovalContext(ctx, gwName, connName)
 			if err != nil {
-				return fmt.Errorf("could not disable learned cidr approval: %v", err)
+				return diag.Errorf("could not disable learned cidr approval: %v", err)
 			}
 		}
 	}
 
 	if d.HasChange("manual_bgp_advertised_cidrs") {
 		manualBGPCidrs := getStringSet(d, "manual_bgp_advertised_cidrs")
-		err := client.EditTransitConnectionBGPManualAdvertiseCIDRs(gwName, connName, manualBGPCidrs)
+		err := client.EditTransitConnectionBGPManualAdvertiseCIDRsContext(ctx, gwName, connName, manualBGPCidrs)
 		if err != nil {
-			return fmt.Errorf("could not edit manual advertise manual cidrs: %v", err)
+			return diag.Errorf("could not edit manual advertise manual cidrs: %v", err)
 		}
 	}
 
 	if d.HasChange("enable_event_triggered_ha") {
 		vpcID := d.Get("vpc_id").(string)
 		if d.Get("enable_event_triggered_ha").(bool) {
-			err := client.EnableSite2CloudEventTriggeredHA(vpcID, connName)
+			err := client.EnableSite2CloudEventTriggeredHAContext(ctx, vpcID, connName)
 			if err != nil {
-				return fmt.Errorf("could not enable event triggered HA for external device conn during update: %v", err)
+				return diag.Errorf("could not enable event triggered HA for external device conn during update: %v", err)
 			}
 		} else {
-			err := client.DisableSite2CloudEventTriggeredHA(vpcID, connName)
+			err := client.DisableSite2CloudEventTriggeredHAContext(ctx, vpcID, connName)
 			if err != nil {
-				return fmt.Errorf("could not disable event triggered HA for external device conn during update: %v", err)
+				return diag.Errorf("could not disable event triggered HA for external device conn during update: %v", err)
 			}
 		}
 	}
@@ -1408,22 +1409,22 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		}
 		if externalDeviceConn.EnableJumboFrame {
 			if externalDeviceConn.ConnectionType != "bgp" {
-				return fmt.Errorf("jumbo frame is only supported on BGP connection")
+				return diag.Errorf("jumbo frame is only supported on BGP connection")
 			}
-			if err := client.EnableJumboFrameExternalDeviceConn(externalDeviceConn); err != nil {
-				return fmt.Errorf("failed to enable jumbo frame for external device connection %q: %w", externalDeviceConn.ConnectionName, err)
+			if err := client.EnableJumboFrameExternalDeviceConnContext(ctx, externalDeviceConn); err != nil {
+				return diag.Errorf("failed to enable jumbo frame for external device connection %q: %v", externalDeviceConn.ConnectionName, err)
 			}
 		} else {
-			if err := client.DisableJumboFrameExternalDeviceConn(externalDeviceConn); err != nil {
-				return fmt.Errorf("failed to disable jumbo frame for external device connection %q: %w", externalDeviceConn.ConnectionName, err)
+			if err := client.DisableJumboFrameExternalDeviceConnContext(ctx, externalDeviceConn); err != nil {
+				return diag.Errorf("failed to disable jumbo frame for external device connection %q: %v", externalDeviceConn.ConnectionName, err)
 			}
 		}
 	}
 
 	if d.HasChange("approved_cidrs") {
-		err := client.UpdateTransitConnectionPendingApprovedCidrs(gwName, connName, approvedCidrs)
+		err := client.UpdateTransitConnectionPendingApprovedCidrsContext(ctx, gwName, connName, approvedCidrs)
 		if err != nil {
-			return fmt.Errorf("could not update transit external device conn learned cidrs during update: %v", err)
+			return diag.Errorf("could not update transit external device conn learned cidrs during update: %v", err)
 		}
 	}
 
@@ -1438,9 +1439,9 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		phase1RemoteIdentifier := d.Get("phase1_remote_identifier").([]interface{})
 		ph1RemoteIdList := goaviatrix.ExpandStringList(phase1RemoteIdentifier)
 		if haEnabled && len(phase1RemoteIdentifier) != 2 {
-			return fmt.Errorf("please either set two phase 1 remote IDs, when HA is enabled")
+			return diag.Errorf("please either set two phase 1 remote IDs, when HA is enabled")
 		} else if !haEnabled && len(phase1RemoteIdentifier) != 1 {
-			return fmt.Errorf("please set one phase 1 remote ID, when HA is disabled")
+			return diag.Errorf("please set one phase 1 remote ID, when HA is disabled")
 		}
 
 		var ph1RemoteId string
@@ -1467,9 +1468,9 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 
 		editSite2cloud.Phase1RemoteIdentifier = ph1RemoteId
 
-		err = client.UpdateSite2Cloud(editSite2cloud)
+		err = client.UpdateSite2CloudContext(ctx, editSite2cloud)
 		if err != nil {
-			return fmt.Errorf("failed to update phase 1 remote identifier: %s", err)
+			return diag.Errorf("failed to update phase 1 remote identifier: %s", err)
 		}
 	}
 
@@ -1480,31 +1481,31 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 			ConnectionType: d.Get("connection_type").(string),
 		}
 		if externalDeviceConn.ConnectionType != "bgp" {
-			return fmt.Errorf("'prepend_as_path' only supports 'bgp' connection. Can't update 'prepend_as_path' for '%s' connection", externalDeviceConn.ConnectionType)
+			return diag.Errorf("'prepend_as_path' only supports 'bgp' connection. Can't update 'prepend_as_path' for '%s' connection", externalDeviceConn.ConnectionType)
 		}
 
 		var prependASPath []string
 		for _, v := range d.Get("prepend_as_path").([]interface{}) {
 			prependASPath = append(prependASPath, v.(string))
 		}
-		err = client.EditTransitExternalDeviceConnASPathPrepend(externalDeviceConn, prependASPath)
+		err = client.EditTransitExternalDeviceConnASPathPrependContext(ctx, externalDeviceConn, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not update prepend_as_path: %v", err)
+			return diag.Errorf("could not update prepend_as_path: %v", err)
 		}
 	}
 
 	if d.HasChange("remote_subnet") {
 		vpcID := d.Get("vpc_id").(string)
 		remoteSubnet := d.Get("remote_subnet").(string)
-		err = client.EditTransitConnectionRemoteSubnet(vpcID, connName, remoteSubnet)
+		err = client.EditTransitConnectionRemoteSubnetContext(ctx, vpcID, connName, remoteSubnet)
 		if err != nil {
-			return fmt.Errorf("could not update transit external device conn remote subnet: %v", err)
+			return diag.Errorf("could not update transit external device conn remote subnet: %v", err)
 		}
 	}
 
 	if d.HasChange("bgp_md5_key") {
 		if d.Get("connection_type").(string) != "bgp" {
-			return fmt.Errorf("can't update BGP MD5 authentication key since it is only supported for BGP connection")
+			return diag.Errorf("can't update BGP MD5 authentication key since it is only supported for BGP connection")
 		}
 
 		oldKey, newKey := d.GetChange("bgp_md5_key")
@@ -1517,7 +1518,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 			bgpRemoteIp = strings.Split(d.Get("remote_tunnel_cidr").(string), ",")
 		}
 		if newKey.(string) != "" && len(newKeyList) != len(bgpRemoteIp) {
-			return fmt.Errorf("can't update BGP MD5 authentication key since it is not set correctly for BGP connection: %s", d.Get("connection_name").(string))
+			return diag.Errorf("can't update BGP MD5 authentication key since it is not set correctly for BGP connection: %s", d.Get("connection_name").(string))
 		}
 		for i, v := range bgpRemoteIp {
 			bgpMd5Key := ""
@@ -1533,24 +1534,24 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 				BgpRemoteIP:    v,
 				BgpMd5Key:      bgpMd5Key,
 			}
-			err := client.EditBgpMd5Key(editBgpMd5Key)
+			err := client.EditBgpMd5KeyContext(ctx, editBgpMd5Key)
 			if err != nil {
-				return fmt.Errorf("failed to update BGP MD5 authentication key: %v", err)
+				return diag.Errorf("failed to update BGP MD5 authentication key: %v", err)
 			}
 		}
 	}
 
 	enableBfd, ok := d.Get("enable_bfd").(bool)
 	if !ok {
-		return fmt.Errorf("expected enable_bfd to be a boolean, but got %T", d.Get("enable_bfd"))
+		return diag.Errorf("expected enable_bfd to be a boolean, but got %T", d.Get("enable_bfd"))
 	}
 	if connType != "bgp" && enableBfd {
-		return fmt.Errorf("cannot enable BFD for non-BGP connection")
+		return diag.Errorf("cannot enable BFD for non-BGP connection")
 	}
 	// get the BGP BFD config
 	bgpBfdConfig, ok := d.Get("bgp_bfd").([]interface{})
 	if !ok {
-		return fmt.Errorf("expected bgp_bfd to be a list of maps, but got %T", d.Get("bgp_bfd"))
+		return diag.Errorf("expected bgp_bfd to be a list of maps, but got %T", d.Get("bgp_bfd"))
 	}
 	if d.HasChanges("enable_bfd", "bgp_bfd") {
 		// bgp bfd is enabled
@@ -1562,23 +1563,23 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 				EnableBfd:      enableBfd,
 				BgpBfdConfig:   bgpBfd,
 			}
-			err := client.EditConnectionBgpBfd(externalDeviceConn)
+			err := client.EditConnectionBgpBfdContext(ctx, externalDeviceConn)
 			if err != nil {
-				return fmt.Errorf("could not update BGP BFD config: %v", err)
+				return diag.Errorf("could not update BGP BFD config: %v", err)
 			}
 		} else {
 			// bgp bfd is disabled
 			if len(bgpBfdConfig) > 0 {
-				return fmt.Errorf("bgp_bfd config can't be set when BFD is disabled")
+				return diag.Errorf("bgp_bfd config can't be set when BFD is disabled")
 			}
 			externalDeviceConn := &goaviatrix.ExternalDeviceConn{
 				GwName:         d.Get("gw_name").(string),
 				ConnectionName: d.Get("connection_name").(string),
 				EnableBfd:      enableBfd,
 			}
-			err := client.EditConnectionBgpBfd(externalDeviceConn)
+			err := client.EditConnectionBgpBfdContext(ctx, externalDeviceConn)
 			if err != nil {
-				return fmt.Errorf("could not disable BGP BFD config: %v", err)
+				return diag.Errorf("could not disable BGP BFD config: %v", err)
 			}
 		}
 	}
@@ -1586,24 +1587,24 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 	if d.HasChanges("enable_bgp_multihop") {
 		enableMultihop, ok := d.Get("enable_bgp_multihop").(bool)
 		if !ok {
-			return fmt.Errorf("Can't make bool from enable_bgp_multihop value: '%v'", d.Get("enable_bgp_multihop"))
+			return diag.Errorf("Can't make bool from enable_bgp_multihop value: '%v'", d.Get("enable_bgp_multihop"))
 		}
 		externalDeviceConn := &goaviatrix.ExternalDeviceConn{
 			GwName:            gwName,
 			ConnectionName:    connName,
 			EnableBgpMultihop: enableMultihop,
 		}
-		if err := client.EditConnectionBgpMultihop(externalDeviceConn); err != nil {
-			return fmt.Errorf("could not update multihop: %w", err)
+		if err := client.EditConnectionBgpMultihopContext(ctx, externalDeviceConn); err != nil {
+			return diag.Errorf("could not update multihop: %v", err)
 		}
 	}
 
 	if d.HasChange("backup_bgp_md5_key") {
 		if d.Get("connection_type").(string) != "bgp" {
-			return fmt.Errorf("can't update backup BGP MD5 authentication key since it is only supported for BGP connection")
+			return diag.Errorf("can't update backup BGP MD5 authentication key since it is only supported for BGP connection")
 		}
 		if !d.Get("ha_enabled").(bool) {
-			return fmt.Errorf("can't update BGP backup MD5 authentication key since ha is not enabled")
+			return diag.Errorf("can't update BGP backup MD5 authentication key since ha is not enabled")
 		}
 
 		oldKey, newKey := d.GetChange("backup_bgp_md5_key")
@@ -1616,7 +1617,7 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 			bgpRemoteIp = strings.Split(d.Get("backup_remote_tunnel_cidr").(string), ",")
 		}
 		if newKey.(string) != "" && len(newKeyList) != len(bgpRemoteIp) {
-			return fmt.Errorf("can't update backup BGP MD5 authentication key since it is not set correctly for BGP connection: %s", d.Get("connection_name").(string))
+			return diag.Errorf("can't update backup BGP MD5 authentication key since it is not set correctly for BGP connection: %s", d.Get("connection_name").(string))
 		}
 		for i, v := range bgpRemoteIp {
 			bgpMd5Key := ""
@@ -1632,9 +1633,9 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 				BgpRemoteIP:    v,
 				BgpMd5Key:      bgpMd5Key,
 			}
-			err := client.EditBgpMd5Key(editBgpMd5Key)
+			err := client.EditBgpMd5KeyContext(ctx, editBgpMd5Key)
 			if err != nil {
-				return fmt.Errorf("failed to update backup BGP MD5 authentication key: %v", err)
+				return diag.Errorf("failed to update backup BGP MD5 authentication key: %v", err)
 			}
 		}
 	}
@@ -1645,9 +1646,9 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 			ConnName:              d.Get("connection_name").(string),
 			Phase1LocalIdentifier: d.Get("phase1_local_identifier").(string),
 		}
-		err := client.EditSite2CloudPhase1LocalIdentifier(s2c)
+		err := client.EditSite2CloudPhase1LocalIdentifierContext(ctx, s2c)
 		if err != nil {
-			return fmt.Errorf("could not update phase1 local identificer for connection: %s: %v", s2c.ConnName, err)
+			return diag.Errorf("could not update phase1 local identificer for connection: %s: %v", s2c.ConnName, err)
 		}
 	}
 
@@ -1657,17 +1658,17 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 		// or block the communities entirely, depending on the user's choice
 		sendComm, ok := d.Get("connection_bgp_send_communities").(string)
 		if !ok {
-			return fmt.Errorf("failed to assert connection_bgp_send_communities as string")
+			return diag.Errorf("failed to assert connection_bgp_send_communities as string")
 		}
 
 		sendAdditive, ok := d.Get("connection_bgp_send_communities_additive").(bool)
 		if !ok {
-			return fmt.Errorf("failed to assert connection_bgp_send_communities_additive as bool")
+			return diag.Errorf("failed to assert connection_bgp_send_communities_additive as bool")
 		}
 
 		sendBlock, ok := d.Get("connection_bgp_send_communities_block").(bool)
 		if !ok {
-			return fmt.Errorf("failed to assert connection_bgp_send_communities_block as bool")
+			return diag.Errorf("failed to assert connection_bgp_send_communities_block as bool")
 		}
 
 		bgpSendCommunities := &goaviatrix.BgpSendCommunities{
@@ -1677,18 +1678,18 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 			ConnSendAdditive:    sendAdditive,
 			ConnSendBlock:       sendBlock,
 		}
-		if err := client.ConnectionBGPSendCommunities(bgpSendCommunities); err != nil {
-			return fmt.Errorf("failed to update bgp connection based communities for connection %q", bgpSendCommunities.ConnectionName)
+		if err := client.ConnectionBGPSendCommunitiesContext(ctx, bgpSendCommunities); err != nil {
+			return diag.Errorf("failed to update bgp connection based communities for connection %q", bgpSendCommunities.ConnectionName)
 		}
 	}
 
 	d.Partial(false)
 
-	return resourceAviatrixTransitExternalDeviceConnRead(d, meta)
+	return resourceAviatrixTransitExternalDeviceConnRead(ctx, d, meta)
 }
 
-func resourceAviatrixTransitExternalDeviceConnDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitExternalDeviceConnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	externalDeviceConn := &goaviatrix.ExternalDeviceConn{
 		VpcID:              d.Get("vpc_id").(string),
@@ -1696,27 +1697,27 @@ func resourceAviatrixTransitExternalDeviceConnDelete(d *schema.ResourceData, met
 		EnableEdgeUnderlay: d.Get("enable_edge_underlay").(bool),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix external device connection: %#v", externalDeviceConn)
+	tflog.Info(ctx, "Deleting Aviatrix external device connection", map[string]interface{}{"gw_name": externalDeviceConn.GwName})
 	if externalDeviceConn.EnableEdgeUnderlay {
 		edgeExternalDeviceConn := goaviatrix.EdgeExternalDeviceConn(*externalDeviceConn)
 		if val, ok := d.Get("local_lan_ip").(string); ok {
 			edgeExternalDeviceConn.LocalLanIP = val
 		} else {
-			return fmt.Errorf("failed to get 'local_lan_ip' as string")
+			return diag.Errorf("failed to get 'local_lan_ip' as string")
 		}
 		if gwName, ok := d.Get("gw_name").(string); ok {
 			edgeExternalDeviceConn.GwName = gwName
 		} else {
-			return fmt.Errorf("failed to get 'gw_name' as string")
+			return diag.Errorf("failed to get 'gw_name' as string")
 		}
-		err := client.DeleteEdgeExternalDeviceConn(&edgeExternalDeviceConn)
+		err := client.DeleteEdgeExternalDeviceConnContext(ctx, &edgeExternalDeviceConn)
 		if err != nil {
-			return fmt.Errorf("failed to delete Aviatrix external device connection: %w", err)
+			return diag.Errorf("failed to delete Aviatrix external device connection: %v", err)
 		}
 	} else {
-		err := client.DeleteExternalDeviceConn(externalDeviceConn)
+		err := client.DeleteExternalDeviceConnContext(ctx, externalDeviceConn)
 		if err != nil {
-			return fmt.Errorf("failed to delete Aviatrix external device connection: %w", err)
+			return diag.Errorf("failed to delete Aviatrix external device connection: %v", err)
 		}
 	}
 
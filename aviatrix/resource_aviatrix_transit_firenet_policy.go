@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixTransitFireNetPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransitFireNetPolicyCreate,
-		Read:   resourceAviatrixTransitFireNetPolicyRead,
-		Delete: resourceAviatrixTransitFireNetPolicyDelete,
+		CreateContext: resourceAviatrixTransitFireNetPolicyCreate,
+		ReadContext:   resourceAviatrixTransitFireNetPolicyRead,
+		DeleteContext: resourceAviatrixTransitFireNetPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -35,45 +36,45 @@ func resourceAviatrixTransitFireNetPolicy() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransitFireNetPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitFireNetPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	transitFireNetPolicy := &goaviatrix.TransitFireNetPolicy{
 		TransitFireNetGatewayName: d.Get("transit_firenet_gateway_name").(string),
 		InspectedResourceName:     d.Get("inspected_resource_name").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix transit firenet policy: %#v", transitFireNetPolicy)
+	tflog.Info(ctx, "Creating Aviatrix transit firenet policy", map[string]interface{}{"transit_fire_net_gateway_name": transitFireNetPolicy.TransitFireNetGatewayName})
 
 	d.SetId(transitFireNetPolicy.TransitFireNetGatewayName + "~" + transitFireNetPolicy.InspectedResourceName)
 	flag := false
-	defer resourceAviatrixTransitFireNetPolicyReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixTransitFireNetPolicyReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateTransitFireNetPolicy(transitFireNetPolicy)
+	err := client.CreateTransitFireNetPolicyContext(ctx, transitFireNetPolicy)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix transit firenet Policy: %s", err)
+		return diag.Errorf("failed to create Aviatrix transit firenet Policy: %s", err)
 	}
 
-	return resourceAviatrixTransitFireNetPolicyReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransitFireNetPolicyReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransitFireNetPolicyReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixTransitFireNetPolicyReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransitFireNetPolicyRead(d, meta)
+		return resourceAviatrixTransitFireNetPolicyRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransitFireNetPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitFireNetPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	transitFireNetGatewayName := d.Get("transit_firenet_gateway_name").(string)
 	inspectedResourceName := d.Get("inspected_resource_name").(string)
 
 	if transitFireNetGatewayName == "" || inspectedResourceName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no transit firenet name or inspected resource name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no transit firenet name or inspected resource name received", map[string]interface{}{"id": id})
 		d.Set("transit_firenet_gateway_name", strings.Split(id, "~")[0])
 		d.Set("inspected_resource_name", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -84,32 +85,32 @@ func resourceAviatrixTransitFireNetPolicyRead(d *schema.ResourceData, meta inter
 		InspectedResourceName:     d.Get("inspected_resource_name").(string),
 	}
 
-	err := client.GetTransitFireNetPolicy(transitFireNetPolicy)
+	err := client.GetTransitFireNetPolicyContext(ctx, transitFireNetPolicy)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix transit gateway policy: %s", err)
+		return diag.Errorf("couldn't find Aviatrix transit gateway policy: %s", err)
 	}
 
 	d.SetId(transitFireNetPolicy.TransitFireNetGatewayName + "~" + transitFireNetPolicy.InspectedResourceName)
 	return nil
 }
 
-func resourceAviatrixTransitFireNetPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitFireNetPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	transitFireNetPolicy := &goaviatrix.TransitFireNetPolicy{
 		TransitFireNetGatewayName: d.Get("transit_firenet_gateway_name").(string),
 		InspectedResourceName:     d.Get("inspected_resource_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix transit firenet policy %#v", transitFireNetPolicy)
+	tflog.Info(ctx, "Deleting Aviatrix transit firenet policy", map[string]interface{}{"transit_fire_net_gateway_name": transitFireNetPolicy.TransitFireNetGatewayName})
 
-	err := client.DeleteTransitFireNetPolicy(transitFireNetPolicy)
+	err := client.DeleteTransitFireNetPolicyContext(ctx, transitFireNetPolicy)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix transit firenet policy: %s", err)
+		return diag.Errorf("failed to delete Aviatrix transit firenet policy: %s", err)
 	}
 
 	return nil
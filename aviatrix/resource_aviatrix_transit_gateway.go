@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
-	"log"
 	"os"
 	"regexp"
 	"sort"
@@ -16,6 +15,9 @@ import (
 	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -34,17 +36,30 @@ var typeMapping = map[string]string{
 
 func resourceAviatrixTransitGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransitGatewayCreate,
-		Read:   resourceAviatrixTransitGatewayRead,
-		Update: resourceAviatrixTransitGatewayUpdate,
-		Delete: resourceAviatrixTransitGatewayDelete,
+		CreateContext: resourceAviatrixTransitGatewayCreate,
+		ReadContext:   resourceAviatrixTransitGatewayRead,
+		UpdateContext: resourceAviatrixTransitGatewayUpdate,
+		DeleteContext: resourceAviatrixTransitGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
 		MigrateState:  resourceAviatrixTransitGatewayMigrateState,
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffTagsAll(goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes),
+			customizeDiffAccountAudit,
+			resourceAviatrixTransitGatewayCustomizeDiff,
+			customizeDiffTransitFireNetGwSize,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cloud_type": {
 				Type:         schema.TypeInt,
@@ -523,6 +538,12 @@ func resourceAviatrixTransitGateway() *schema.Resource {
 				Optional:    true,
 				Description: "A map of tags to assign to the transit gateway.",
 			},
+			"tags_all": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "All tags of the transit gateway, including the provider default tags.",
+			},
 			"enable_spot_instance": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -952,18 +973,207 @@ func resourceAviatrixTransitGateway() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+// resourceAviatrixTransitGatewayCustomizeDiff checks the cross-field rules
+// enforced by resourceAviatrixTransitGatewayCreate at plan time, so invalid
+// combinations are reported before a gateway is launched.
+func resourceAviatrixTransitGatewayCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("cloud_type") {
+		return nil
+	}
+	cloudType := d.Get("cloud_type").(int)
+	if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
+		// edge transit gateways are validated separately on create
+		return nil
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "zone") {
+		if d.Get("zone").(string) != "" && !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
+			return fmt.Errorf("attribute 'zone' is only for use with Azure (8), Azure GOV (32) and Azure CHINA (2048)")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "insane_mode", "insane_mode_az", "ha_subnet", "ha_insane_mode_az", "subnet") && d.Get("insane_mode").(bool) {
+		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
+			return fmt.Errorf("insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+		}
+		if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
+			if d.Get("insane_mode_az").(string) == "" {
+				return fmt.Errorf("insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+			}
+			if d.Get("ha_subnet").(string) != "" && d.Get("ha_insane_mode_az").(string) == "" {
+				return fmt.Errorf("ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768) clouds and ha_subnet is set")
+			}
+		}
+		if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
+			if err := validateInsaneModeSubnet(d.Get("subnet").(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "availability_domain", "fault_domain") {
+		if !goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (d.Get("availability_domain").(string) != "" || d.Get("fault_domain").(string) != "") {
+			return fmt.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "ha_subnet", "ha_zone", "ha_gw_size") {
+		haSubnet := d.Get("ha_subnet").(string)
+		haZone := d.Get("ha_zone").(string)
+		haGwSize := d.Get("ha_gw_size").(string)
+		if haZone != "" && !goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
+			return fmt.Errorf("'ha_zone' is only valid for GCP and Azure providers when enabling HA")
+		}
+		if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) && haSubnet != "" && haZone == "" {
+			return fmt.Errorf("'ha_zone' must be set to enable HA on GCP, cannot enable HA with only 'ha_subnet'")
+		}
+		if goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) && haSubnet == "" && haZone != "" {
+			return fmt.Errorf("'ha_subnet' must be provided to enable HA on Azure, cannot enable HA with only 'ha_zone'")
+		}
+		if haSubnet == "" && haZone == "" && haGwSize != "" {
+			return fmt.Errorf("'ha_gw_size' is only required if enabling HA")
+		}
+		if haGwSize == "" && haSubnet != "" {
+			return fmt.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
+				"ha_subnet is set")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "enable_encrypt_volume", "customer_managed_keys") {
+		enableEncryptVolume := d.Get("enable_encrypt_volume").(bool)
+		if enableEncryptVolume && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
+			return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768) providers")
+		}
+		if d.Get("customer_managed_keys").(string) != "" && !enableEncryptVolume {
+			return fmt.Errorf("'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "enable_firenet", "enable_transit_firenet", "enable_gateway_load_balancer",
+		"enable_egress_transit_firenet", "connected_transit", "lan_vpc_id", "lan_private_subnet") {
+		enableFireNet := d.Get("enable_firenet").(bool)
+		enableTransitFireNet := d.Get("enable_transit_firenet").(bool)
+		enableGatewayLoadBalancer := d.Get("enable_gateway_load_balancer").(bool)
+		enableEgressTransitFireNet := d.Get("enable_egress_transit_firenet").(bool)
+		lanVpcID := d.Get("lan_vpc_id").(string)
+		lanPrivateSubnet := d.Get("lan_private_subnet").(string)
+		if enableFireNet && enableTransitFireNet {
+			return fmt.Errorf("can't enable firenet function and transit firenet function at the same time")
+		}
+		if enableFireNet && goaviatrix.IsCloudType(cloudType, goaviatrix.AWSChina|goaviatrix.AzureChina) {
+			return fmt.Errorf("'enable_firenet' is not supported in AWSChina (1024) or AzureChina (2048)")
+		}
+		if enableTransitFireNet {
+			if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
+				return fmt.Errorf("'enable_transit_firenet' is only supported in AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), Azure China (2048)")
+			}
+			if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) && (lanVpcID == "" || lanPrivateSubnet == "") {
+				return fmt.Errorf("'lan_vpc_id' and 'lan_private_subnet' are required when 'cloud_type' = 4 (GCP) and 'enable_transit_firenet' = true")
+			}
+		}
+		if (!enableTransitFireNet || !goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes)) && (lanVpcID != "" || lanPrivateSubnet != "") {
+			return fmt.Errorf("'lan_vpc_id' and 'lan_private_subnet' are only valid when 'cloud_type' = 4 (GCP) and 'enable_transit_firenet' = true")
+		}
+		if enableGatewayLoadBalancer && !enableFireNet && !enableTransitFireNet {
+			return fmt.Errorf("'enable_gateway_load_balancer' is only valid when 'enable_firenet' or 'enable_transit_firenet' is set to true")
+		}
+		if enableGatewayLoadBalancer && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWS) {
+			return fmt.Errorf("'enable_gateway_load_balancer' is only supported by AWS (1)")
+		}
+		if enableEgressTransitFireNet && !enableTransitFireNet {
+			return fmt.Errorf("'enable_egress_transit_firenet' requires 'enable_transit_firenet' to be set to true")
+		}
+		if enableEgressTransitFireNet && d.Get("connected_transit").(bool) {
+			return fmt.Errorf("'enable_egress_transit_firenet' requires 'connected_transit' to be set to false")
+		}
+	}
+
+	if diffNeedsValidation(d, "enable_learned_cidrs_approval", "learned_cidrs_approval_mode", "approved_learned_cidrs") {
+		learnedCidrsApproval := d.Get("enable_learned_cidrs_approval").(bool)
+		if learnedCidrsApproval && d.Get("learned_cidrs_approval_mode").(string) == "connection" {
+			return fmt.Errorf("'enable_learned_cidrs_approval' must be false if 'learned_cidrs_approval_mode' is set to 'connection'")
+		}
+		if !learnedCidrsApproval && d.Get("approved_learned_cidrs").(*schema.Set).Len() != 0 {
+			return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "enable_bgp_over_lan") {
+		if d.Get("enable_bgp_over_lan").(bool) && !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.GCP) {
+			return fmt.Errorf("'enable_bgp_over_lan' is only valid for GCP (4), Azure (8), AzureGov (32) or AzureChina (2048)")
+		}
+	}
+
+	if diffNeedsValidation(d, "cloud_type", "enable_private_oob", "oob_management_subnet", "oob_availability_zone", "ha_subnet",
+		"ha_oob_management_subnet", "ha_oob_availability_zone") && d.Get("enable_private_oob").(bool) {
+		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
+			return fmt.Errorf("'enable_private_oob' is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+		}
+		if d.Get("oob_availability_zone").(string) == "" {
+			return fmt.Errorf("\"oob_availability_zone\" is required if \"enable_private_oob\" is true")
+		}
+		if d.Get("oob_management_subnet").(string) == "" {
+			return fmt.Errorf("\"oob_management_subnet\" is required if \"enable_private_oob\" is true")
+		}
+		if d.Get("ha_subnet").(string) != "" {
+			if d.Get("ha_oob_availability_zone").(string) == "" {
+				return fmt.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+			}
+			if d.Get("ha_oob_management_subnet").(string) == "" {
+				return fmt.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+			}
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffTransitFireNetGwSize rejects burstable instance sizes for
+// FireNet enabled transit gateways. The controller only reports them as
+// unsupported once the gateway has been launched.
+func customizeDiffTransitFireNetGwSize(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !diffNeedsValidation(d, "cloud_type", "gw_size", "ha_gw_size", "enable_firenet", "enable_transit_firenet") {
+		return nil
+	}
+	if !d.Get("enable_firenet").(bool) && !d.Get("enable_transit_firenet").(bool) {
+		return nil
+	}
+
+	cloudType := d.Get("cloud_type").(int)
+	for _, key := range []string{"gw_size", "ha_gw_size"} {
+		size := d.Get(key).(string)
+		if size != "" && isBurstableGwSize(cloudType, size) {
+			return fmt.Errorf("%q = %q is not supported with FireNet enabled, burstable instance sizes can't be used for FireNet gateways", key, size)
+		}
+	}
+	return nil
+}
+
+// isBurstableGwSize reports whether size is a burstable AWS (t2, t3, t3a and
+// t4g) or Azure (B-series) instance size.
+func isBurstableGwSize(cloudType int, size string) bool {
+	switch {
+	case goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes):
+		family, _, _ := strings.Cut(size, ".")
+		return goaviatrix.Contains([]string{"t2", "t3", "t3a", "t4g"}, family)
+	case goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes):
+		return strings.HasPrefix(size, "Standard_B")
+	}
+	return false
+}
+
+func resourceAviatrixTransitGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	cloudType := d.Get("cloud_type").(int)
 	flag := false
 	// create edge transit gateway for AEP & Equinix
 	if goaviatrix.IsCloudType(cloudType, goaviatrix.EdgeRelatedCloudTypes) {
-		err := createEdgeTransitGateway(d, client, cloudType)
+		err := createEdgeTransitGateway(ctx, d, client, cloudType)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
-		defer resourceAviatrixTransitGatewayReadIfRequired(d, meta, &flag)
+		defer resourceAviatrixTransitGatewayReadIfRequired(ctx, d, meta, &flag)
 	} else {
 		gateway := &goaviatrix.TransitVpc{
 			CloudType:                d.Get("cloud_type").(int),
@@ -984,7 +1194,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		if val, ok := d.GetOk("enable_jumbo_frame"); ok {
 			enableJumboFrame, ok := val.(bool)
 			if !ok {
-				return fmt.Errorf("enable_jumbo_frame must be a boolean")
+				return diag.Errorf("enable_jumbo_frame must be a boolean")
 			}
 			gateway.JumboFrame = enableJumboFrame // set to user-provided value
 		} else {
@@ -1015,7 +1225,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 
 		zone := d.Get("zone").(string)
 		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) && zone != "" {
-			return fmt.Errorf("attribute 'zone' is only for use with Azure (8), Azure GOV (32) and Azure CHINA (2048)")
+			return diag.Errorf("attribute 'zone' is only for use with Azure (8), Azure GOV (32) and Azure CHINA (2048)")
 		}
 		if zone != "" {
 			// The API uses the same string field to hold both subnet and zone
@@ -1026,10 +1236,10 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 			gateway.VpcID = d.Get("vpc_id").(string)
 			if gateway.VpcID == "" {
-				return fmt.Errorf("'vpc_id' cannot be empty for creating a transit gw")
+				return diag.Errorf("'vpc_id' cannot be empty for creating a transit gw")
 			}
 		} else {
-			return fmt.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
+			return diag.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
 		}
 
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
@@ -1038,7 +1248,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			// for gcp, rest api asks for "zone" rather than vpc region
 			gateway.Zone = d.Get("vpc_reg").(string)
 		} else {
-			return fmt.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
+			return diag.Errorf("invalid cloud type, it can only be AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
 		}
 
 		insaneMode := d.Get("insane_mode").(bool)
@@ -1046,14 +1256,14 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			// Insane Mode encryption is not supported in China regions
 			if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|
 				goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-				return fmt.Errorf("insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+				return diag.Errorf("insane_mode is only supported for AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 			}
 			if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
 				if d.Get("insane_mode_az").(string) == "" {
-					return fmt.Errorf("insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+					return diag.Errorf("insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 				}
 				if d.Get("ha_subnet").(string) != "" && d.Get("ha_insane_mode_az").(string) == "" {
-					return fmt.Errorf("ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768) clouds and ha_subnet is set")
+					return diag.Errorf("ha_insane_mode_az needed if insane_mode is enabled for AWS (1), AWSGov (256), AWS China (1024), AWS Top Secret (16384) or AWS Secret (32768) clouds and ha_subnet is set")
 				}
 				// Append availability zone to subnet
 				var strs []string
@@ -1067,10 +1277,10 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		}
 
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (gateway.AvailabilityDomain == "" || gateway.FaultDomain == "") {
-			return fmt.Errorf("'availability_domain' and 'fault_domain' are required for OCI")
+			return diag.Errorf("'availability_domain' and 'fault_domain' are required for OCI")
 		}
 		if !goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (gateway.AvailabilityDomain != "" || gateway.FaultDomain != "") {
-			return fmt.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
+			return diag.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
 		}
 
 		haSubnet := d.Get("ha_subnet").(string)
@@ -1079,39 +1289,39 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		haFaultDomain := d.Get("ha_fault_domain").(string)
 
 		if haZone != "" && !goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("'ha_zone' is only valid for GCP and Azure providers when enabling HA")
+			return diag.Errorf("'ha_zone' is only valid for GCP and Azure providers when enabling HA")
 		}
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) && haSubnet != "" && haZone == "" {
-			return fmt.Errorf("'ha_zone' must be set to enable HA on GCP, cannot enable HA with only 'ha_subnet'")
+			return diag.Errorf("'ha_zone' must be set to enable HA on GCP, cannot enable HA with only 'ha_subnet'")
 		}
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) && haSubnet == "" && haZone != "" {
-			return fmt.Errorf("'ha_subnet' must be provided to enable HA on Azure, cannot enable HA with only 'ha_zone'")
+			return diag.Errorf("'ha_subnet' must be provided to enable HA on Azure, cannot enable HA with only 'ha_zone'")
 		}
 		haGwSize := d.Get("ha_gw_size").(string)
 		if haSubnet == "" && haZone == "" && haGwSize != "" {
-			return fmt.Errorf("'ha_gw_size' is only required if enabling HA")
+			return diag.Errorf("'ha_gw_size' is only required if enabling HA")
 		}
 		if haGwSize == "" && haSubnet != "" {
-			return fmt.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
+			return diag.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
 				"ha_subnet is set")
 		}
 		if haSubnet != "" {
 			if goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain == "" || haFaultDomain == "") {
-				return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are required to enable Peering HA on OCI")
+				return diag.Errorf("'ha_availability_domain' and 'ha_fault_domain' are required to enable Peering HA on OCI")
 			}
 			if !goaviatrix.IsCloudType(cloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain != "" || haFaultDomain != "") {
-				return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
+				return diag.Errorf("'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
 			}
 		}
 
 		enableEncryptVolume := d.Get("enable_encrypt_volume").(bool)
 		customerManagedKeys := d.Get("customer_managed_keys").(string)
 		if enableEncryptVolume && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768) providers")
+			return diag.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768) providers")
 		}
 		if customerManagedKeys != "" {
 			if !enableEncryptVolume {
-				return fmt.Errorf("'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
+				return diag.Errorf("'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
 			}
 			gateway.CustomerManagedKeys = customerManagedKeys
 		}
@@ -1123,47 +1333,47 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		enableGatewayLoadBalancer := d.Get("enable_gateway_load_balancer").(bool)
 		enableTransitFireNet := d.Get("enable_transit_firenet").(bool)
 		if enableFireNet && enableTransitFireNet {
-			return fmt.Errorf("can't enable firenet function and transit firenet function at the same time")
+			return diag.Errorf("can't enable firenet function and transit firenet function at the same time")
 		}
 		lanVpcID := d.Get("lan_vpc_id").(string)
 		lanPrivateSubnet := d.Get("lan_private_subnet").(string)
 		// Transit FireNet function is not supported for AWS China or Azure China
 		if enableFireNet && goaviatrix.IsCloudType(cloudType, goaviatrix.AWSChina|goaviatrix.AzureChina) {
-			return fmt.Errorf("'enable_firenet' is not supported in AWSChina (1024) or AzureChina (2048)")
+			return diag.Errorf("'enable_firenet' is not supported in AWSChina (1024) or AzureChina (2048)")
 		}
 		if enableTransitFireNet {
 			if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-				return fmt.Errorf("'enable_transit_firenet' is only supported in AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), Azure China (2048)")
+				return diag.Errorf("'enable_transit_firenet' is only supported in AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), Azure China (2048)")
 			}
 			if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 				gateway.EnableTransitFireNet = true
 			}
 			if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) {
 				if lanVpcID == "" || lanPrivateSubnet == "" {
-					return fmt.Errorf("'lan_vpc_id' and 'lan_private_subnet' are required when 'cloud_type' = 4 (GCP) and 'enable_transit_firenet' = true")
+					return diag.Errorf("'lan_vpc_id' and 'lan_private_subnet' are required when 'cloud_type' = 4 (GCP) and 'enable_transit_firenet' = true")
 				}
 				gateway.LanVpcID = lanVpcID
 				gateway.LanPrivateSubnet = lanPrivateSubnet
 			}
 		}
 		if (!enableTransitFireNet || !goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes)) && (lanVpcID != "" || lanPrivateSubnet != "") {
-			return fmt.Errorf("'lan_vpc_id' and 'lan_private_subnet' are only valid when 'cloud_type' = 4 (GCP) and 'enable_transit_firenet' = true")
+			return diag.Errorf("'lan_vpc_id' and 'lan_private_subnet' are only valid when 'cloud_type' = 4 (GCP) and 'enable_transit_firenet' = true")
 		}
 		if enableGatewayLoadBalancer && !enableFireNet && !enableTransitFireNet {
-			return fmt.Errorf("'enable_gateway_load_balancer' is only valid when 'enable_firenet' or 'enable_transit_firenet' is set to true")
+			return diag.Errorf("'enable_gateway_load_balancer' is only valid when 'enable_firenet' or 'enable_transit_firenet' is set to true")
 		}
 		if enableGatewayLoadBalancer && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWS) {
-			return fmt.Errorf("'enable_gateway_load_balancer' is only supported by AWS (1)")
+			return diag.Errorf("'enable_gateway_load_balancer' is only supported by AWS (1)")
 		}
 		enableEgressTransitFireNet := d.Get("enable_egress_transit_firenet").(bool)
 		if enableEgressTransitFireNet && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-			return fmt.Errorf("'enable_egress_transit_firenet' is only supported by AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), Azure China(2048)")
+			return diag.Errorf("'enable_egress_transit_firenet' is only supported by AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), Azure China(2048)")
 		}
 		if enableEgressTransitFireNet && !enableTransitFireNet {
-			return fmt.Errorf("'enable_egress_transit_firenet' requires 'enable_transit_firenet' to be set to true")
+			return diag.Errorf("'enable_egress_transit_firenet' requires 'enable_transit_firenet' to be set to true")
 		}
 		if enableEgressTransitFireNet && connectedTransit {
-			return fmt.Errorf("'enable_egress_transit_firenet' requires 'connected_transit' to be set to false")
+			return diag.Errorf("'enable_egress_transit_firenet' requires 'connected_transit' to be set to false")
 		}
 
 		enableTransitPreserveAsPath := d.Get("enable_preserve_as_path").(bool)
@@ -1174,11 +1384,11 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		}
 
 		if learnedCidrsApproval && d.Get("learned_cidrs_approval_mode").(string) == "connection" {
-			return fmt.Errorf("'enable_learned_cidrs_approval' must be false if 'learned_cidrs_approval_mode' is set to 'connection'")
+			return diag.Errorf("'enable_learned_cidrs_approval' must be false if 'learned_cidrs_approval_mode' is set to 'connection'")
 		}
 
 		if !learnedCidrsApproval && len(gateway.ApprovedLearnedCidrs) != 0 {
-			return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+			return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 		}
 
 		enableMonitorSubnets := d.Get("enable_monitor_gateway_subnets").(bool)
@@ -1188,21 +1398,21 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		}
 		// Enable monitor gateway subnets does not work with AWSChina
 		if enableMonitorSubnets && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes^goaviatrix.AWSChina) {
-			return fmt.Errorf("'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
+			return diag.Errorf("'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
 		}
 		if !enableMonitorSubnets && len(excludedInstances) != 0 {
-			return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
+			return diag.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
 		}
 
 		bgpOverLan := d.Get("enable_bgp_over_lan").(bool)
 		if bgpOverLan && !(goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.GCP)) {
-			return fmt.Errorf("'enable_bgp_over_lan' is only valid for GCP (4), Azure (8), AzureGov (32) or AzureChina (2048)")
+			return diag.Errorf("'enable_bgp_over_lan' is only valid for GCP (4), Azure (8), AzureGov (32) or AzureChina (2048)")
 		}
 		bgpLanInterfacesCount, isCountSet := d.GetOk("bgp_lan_interfaces_count")
 		if isCountSet && (!bgpOverLan || !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes)) {
-			return fmt.Errorf("'bgp_lan_interfaces_count' is only valid for BGP over LAN enabled transit for Azure (8), AzureGov (32) or AzureChina (2048)")
+			return diag.Errorf("'bgp_lan_interfaces_count' is only valid for BGP over LAN enabled transit for Azure (8), AzureGov (32) or AzureChina (2048)")
 		} else if !isCountSet && bgpOverLan && goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("please specify 'bgp_lan_interfaces_count' for BGP over LAN enabled Azure transit: %s", gateway.GwName)
+			return diag.Errorf("please specify 'bgp_lan_interfaces_count' for BGP over LAN enabled Azure transit: %s", gateway.GwName)
 		}
 		var bgpLanVpcID []string
 		var bgpLanSpecifySubnet []string
@@ -1219,22 +1429,22 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			haBgpLanSpecifySubnet = append(haBgpLanSpecifySubnet, item["subnet"].(string))
 		}
 		if !bgpOverLan && len(bgpLanVpcID) != 0 {
-			return fmt.Errorf("'bgp_lan_interfaces' is only valid with enable_bgp_over_lan being set true")
+			return diag.Errorf("'bgp_lan_interfaces' is only valid with enable_bgp_over_lan being set true")
 		}
 		if (!bgpOverLan || haSubnet == "") && len(haBgpLanVpcID) != 0 {
-			return fmt.Errorf("'ha_bgp_lan_interfaces' is only valid with enable_bgp_over_lan is set true and HA is enabled")
+			return diag.Errorf("'ha_bgp_lan_interfaces' is only valid with enable_bgp_over_lan is set true and HA is enabled")
 		}
 		if (len(bgpLanVpcID) != 0 || len(haBgpLanVpcID) != 0) && !(goaviatrix.IsCloudType(cloudType, goaviatrix.GCP)) {
-			return fmt.Errorf("'bgp_lan_interfaces' and 'ha_bgp_lan_interfaces' are only valid for GCP (4)")
+			return diag.Errorf("'bgp_lan_interfaces' and 'ha_bgp_lan_interfaces' are only valid for GCP (4)")
 		}
 		if bgpOverLan {
 			gateway.BgpOverLan = true
 			if goaviatrix.IsCloudType(cloudType, goaviatrix.GCP) {
 				if len(bgpLanVpcID) == 0 {
-					return fmt.Errorf("missing bgp_lan_interfaces for creating GCP transit gateway with BGP over LAN enabled")
+					return diag.Errorf("missing bgp_lan_interfaces for creating GCP transit gateway with BGP over LAN enabled")
 				}
 				if (haSubnet != "" || haZone != "") && len(haBgpLanVpcID) == 0 {
-					return fmt.Errorf("missing ha_bgp_lan_interfaces for creating GCP HA transit gateway with BGP over LAN enabled")
+					return diag.Errorf("missing ha_bgp_lan_interfaces for creating GCP HA transit gateway with BGP over LAN enabled")
 				}
 				gateway.BgpLanVpcID = strings.Join(bgpLanVpcID, ",")
 				gateway.BgpLanSpecifySubnet = strings.Join(bgpLanSpecifySubnet, ",")
@@ -1251,32 +1461,32 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 
 		if enablePrivateOob {
 			if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("'enable_private_oob' is only valid for AWS (1), AWSGov (256) AWSChina (1024),, AWS Top Secret (16384) or AWS Secret (32768)")
+				return diag.Errorf("'enable_private_oob' is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 			}
 
 			if oobAvailabilityZone == "" {
-				return fmt.Errorf("\"oob_availability_zone\" is required if \"enable_private_oob\" is true")
+				return diag.Errorf("\"oob_availability_zone\" is required if \"enable_private_oob\" is true")
 			}
 
 			if oobManagementSubnet == "" {
-				return fmt.Errorf("\"oob_management_subnet\" is required if \"enable_private_oob\" is true")
+				return diag.Errorf("\"oob_management_subnet\" is required if \"enable_private_oob\" is true")
 			}
 
 			if haSubnet != "" {
 				if haOobAvailabilityZone == "" {
-					return fmt.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+					return diag.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 				}
 
 				if haOobManagementSubnet == "" {
-					return fmt.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+					return diag.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 				}
 			} else {
 				if haOobAvailabilityZone != "" {
-					return fmt.Errorf("\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
+					return diag.Errorf("\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
 				}
 
 				if haOobManagementSubnet != "" {
-					return fmt.Errorf("\"ha_oob_management_sbunet\" must be empty if \"ha_subnet\" is empty")
+					return diag.Errorf("\"ha_oob_management_sbunet\" must be empty if \"ha_subnet\" is empty")
 				}
 			}
 
@@ -1285,52 +1495,52 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			gateway.OobManagementSubnet = oobManagementSubnet + "~~" + oobAvailabilityZone
 		} else {
 			if oobAvailabilityZone != "" {
-				return fmt.Errorf("\"oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
+				return diag.Errorf("\"oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
 			}
 
 			if oobManagementSubnet != "" {
-				return fmt.Errorf("\"oob_management_subnet\" must be empty if \"enable_private_oob\" is false")
+				return diag.Errorf("\"oob_management_subnet\" must be empty if \"enable_private_oob\" is false")
 			}
 
 			if haOobAvailabilityZone != "" {
-				return fmt.Errorf("\"ha_oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
+				return diag.Errorf("\"ha_oob_availability_zone\" must be empty if \"enable_private_oob\" is false")
 			}
 
 			if haOobManagementSubnet != "" {
-				return fmt.Errorf("\"ha_oob_management_sbunet\" must be empty if \"enable_private_oob\" is false")
+				return diag.Errorf("\"ha_oob_management_sbunet\" must be empty if \"enable_private_oob\" is false")
 			}
 		}
 
 		enableMultitierTransit := d.Get("enable_multi_tier_transit").(bool)
 		if enableMultitierTransit {
 			if d.Get("local_as_number") == "" {
-				return fmt.Errorf("local_as_number required to enable multi tier transit")
+				return diag.Errorf("local_as_number required to enable multi tier transit")
 			}
 		}
 
 		_, tagsOk := d.GetOk("tags")
-		if tagsOk {
+		if tagsOk || client.GetDefaultTagsConfig().HasTags() && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 			if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-				return errors.New("error creating transit gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+				return diag.Errorf("error creating transit gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 			}
-			tagsMap, err := extractTags(d, gateway.CloudType)
+			tagsMap, err := extractTags(d, gateway.CloudType, client.GetDefaultTagsConfig())
 			if err != nil {
-				return fmt.Errorf("error creating tags for transit gateway: %w", err)
+				return diag.Errorf("error creating tags for transit gateway: %v", err)
 			}
 			tagJson, err := TagsMapToJson(tagsMap)
 			if err != nil {
-				return fmt.Errorf("failed to add tags when creating transit gateway: %w", err)
+				return diag.Errorf("failed to add tags when creating transit gateway: %v", err)
 			}
 			gateway.TagJson = tagJson
 		}
 
 		enableActiveStandby := d.Get("enable_active_standby").(bool)
 		if haSubnet == "" && haZone == "" && enableActiveStandby {
-			return fmt.Errorf("could not configure Active-Standby as HA is not enabled")
+			return diag.Errorf("could not configure Active-Standby as HA is not enabled")
 		}
 		enableActiveStandbyPreemptive := d.Get("enable_active_standby_preemptive").(bool)
 		if !enableActiveStandby && enableActiveStandbyPreemptive {
-			return fmt.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
+			return diag.Errorf("could not configure Preemptive Mode with Active-Standby disabled")
 		}
 
 		enableSpotInstance := d.Get("enable_spot_instance").(bool)
@@ -1338,11 +1548,11 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		deleteSpot := d.Get("delete_spot").(bool)
 		if enableSpotInstance {
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-				return fmt.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
+				return diag.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
 			}
 
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && deleteSpot {
-				return fmt.Errorf("delete_spot only supports Azure")
+				return diag.Errorf("delete_spot only supports Azure")
 			}
 
 			gateway.EnableSpotInstance = true
@@ -1354,10 +1564,10 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 
 		rxQueueSize := d.Get("rx_queue_size").(string)
 		if rxQueueSize != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("rx_queue_size only supports AWS related cloud types")
+			return diag.Errorf("rx_queue_size only supports AWS related cloud types")
 		}
 
-		privateModeInfo, _ := client.GetPrivateModeInfo(context.Background())
+		privateModeInfo, _ := client.GetPrivateModeInfo(ctx)
 		if !enablePrivateOob && !privateModeInfo.EnablePrivateMode {
 			allocateNewEip := d.Get("allocate_new_eip").(bool)
 			if allocateNewEip {
@@ -1366,21 +1576,21 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				gateway.ReuseEip = "on"
 
 				if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-					return fmt.Errorf("failed to create transit gateway: 'allocate_new_eip' can only be set to 'false' when cloud_type is AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048) or AWS Top Secret (16384)")
+					return diag.Errorf("failed to create transit gateway: 'allocate_new_eip' can only be set to 'false' when cloud_type is AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048) or AWS Top Secret (16384)")
 				}
 				if _, ok := d.GetOk("eip"); !ok {
-					return fmt.Errorf("failed to create transit gateway: 'eip' must be set when 'allocate_new_eip' is false")
+					return diag.Errorf("failed to create transit gateway: 'eip' must be set when 'allocate_new_eip' is false")
 				}
 				azureEipName, azureEipNameOk := d.GetOk("azure_eip_name_resource_group")
 				if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 					// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 					if !azureEipNameOk {
-						return fmt.Errorf("failed to create transit gateway: 'azure_eip_name_resource_group' must be set when 'allocate_new_eip' is false and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+						return diag.Errorf("failed to create transit gateway: 'azure_eip_name_resource_group' must be set when 'allocate_new_eip' is false and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 					}
 					gateway.Eip = fmt.Sprintf("%s:%s", azureEipName.(string), d.Get("eip").(string))
 				} else {
 					if azureEipNameOk {
-						return fmt.Errorf("failed to create transit gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+						return diag.Errorf("failed to create transit gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 					}
 					gateway.Eip = d.Get("eip").(string)
 				}
@@ -1392,44 +1602,44 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				gateway.Subnet = fmt.Sprintf("%s~~%s", gateway.Subnet, privateModeSubnetZone.(string))
 			} else {
 				if goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-					return fmt.Errorf("%q must be set when creating a Transit Gateway in AWS with Private Mode enabled on the Controller", "private_mode_subnet_zone")
+					return diag.Errorf("%q must be set when creating a Transit Gateway in AWS with Private Mode enabled on the Controller", "private_mode_subnet_zone")
 				}
 			}
 
 			if _, ok := d.GetOk("private_mode_lb_vpc_id"); ok {
 				if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-					return fmt.Errorf("private mode is only supported in AWS and Azure. %q must be empty", "private_mode_lb_vpc_id")
+					return diag.Errorf("private mode is only supported in AWS and Azure. %q must be empty", "private_mode_lb_vpc_id")
 				}
 
 				gateway.LbVpcID, ok = d.Get("private_mode_lb_vpc_id").(string)
 				if !ok {
-					return fmt.Errorf("%q must be set when creating a Transit Gateway in AWS or Azure with Private Mode enabled on the Controller", "private_mode_lb_vpc_id")
+					return diag.Errorf("%q must be set when creating a Transit Gateway in AWS or Azure with Private Mode enabled on the Controller", "private_mode_lb_vpc_id")
 				}
 			}
 		} else {
 			if _, ok := d.GetOk("private_mode_subnet_zone"); ok {
-				return fmt.Errorf("%q is only valid when Private Mode is enabled on the Controller", "private_mode_subnet_zone")
+				return diag.Errorf("%q is only valid when Private Mode is enabled on the Controller", "private_mode_subnet_zone")
 			}
 			if _, ok := d.GetOk("private_mode_lb_vpc_id"); ok {
-				return fmt.Errorf("%q is only valid on when Private Mode is enabled", "private_mode_lb_vpc_id")
+				return diag.Errorf("%q is only valid on when Private Mode is enabled", "private_mode_lb_vpc_id")
 			}
 		}
 
 		enableIpv6 := d.Get("enable_ipv6").(bool)
 		if enableIpv6 {
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("error creating gateway: enable_ipv6 is only supported for AWS (1), Azure (8)")
+				return diag.Errorf("error creating gateway: enable_ipv6 is only supported for AWS (1), Azure (8)")
 			}
 			gateway.EnableIPv6 = true
 		}
 
-		log.Printf("[INFO] Creating Aviatrix Transit Gateway: %#v", gateway)
+		tflog.Info(ctx, "Creating Aviatrix Transit Gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
 		d.SetId(gateway.GwName)
-		defer resourceAviatrixTransitGatewayReadIfRequired(d, meta, &flag)
-		err := client.LaunchTransitVpc(gateway)
+		defer resourceAviatrixTransitGatewayReadIfRequired(ctx, d, meta, &flag)
+		err := client.LaunchTransitVpcContext(ctx, gateway)
 		if err != nil {
-			return fmt.Errorf("failed to create Aviatrix Transit Gateway: %s", err)
+			return diag.Errorf("failed to create Aviatrix Transit Gateway: %s", err)
 		}
 
 		if !singleAZ {
@@ -1438,11 +1648,11 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				SingleAZ: "disabled",
 			}
 
-			log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, "Disable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 
 			err := client.DisableSingleAZGateway(singleAZGateway)
 			if err != nil {
-				return fmt.Errorf("failed to disable single AZ GW HA: %s", err)
+				return diag.Errorf("failed to disable single AZ GW HA: %s", err)
 			}
 		}
 
@@ -1452,7 +1662,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		if ok && acceptComm != commAcceptCurr || err != nil {
 			err := client.SetGatewayBgpCommunitiesAccept(gateway.GwName, acceptComm)
 			if err != nil {
-				return fmt.Errorf("failed to set accept BGP communities for gateway %s: %w", gateway.GwName, err)
+				return diag.Errorf("failed to set accept BGP communities for gateway %s: %v", gateway.GwName, err)
 			}
 		}
 
@@ -1460,7 +1670,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		if ok && sendComm != commSendCurr || err != nil {
 			err := client.SetGatewayBgpCommunitiesSend(gateway.GwName, sendComm)
 			if err != nil {
-				return fmt.Errorf("failed to set send BGP communities for gateway %s: %w", gateway.GwName, err)
+				return diag.Errorf("failed to set send BGP communities for gateway %s: %v", gateway.GwName, err)
 			}
 		}
 
@@ -1490,7 +1700,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 
 			if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) {
 				if haZone == "" {
-					return fmt.Errorf("no ha_zone is provided for enabling Transit HA gateway: %s", transitHaGw.GwName)
+					return diag.Errorf("no ha_zone is provided for enabling Transit HA gateway: %s", transitHaGw.GwName)
 				}
 			} else if goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 				if haZone != "" {
@@ -1504,7 +1714,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			if privateModeInfo.EnablePrivateMode {
 				haPrivateModeSubnetZone := d.Get("ha_private_mode_subnet_zone").(string)
 				if haPrivateModeSubnetZone == "" && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-					return fmt.Errorf("%q must be set when creating a Transit HA Gateway in AWS with Private Mode enabled on the Controller", "ha_private_mode_subnet_zone")
+					return diag.Errorf("%q must be set when creating a Transit HA Gateway in AWS with Private Mode enabled on the Controller", "ha_private_mode_subnet_zone")
 				}
 				transitHaGw.Subnet = haSubnet + "~~" + haPrivateModeSubnetZone
 			}
@@ -1514,17 +1724,17 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				if transitHaGw.Eip != "" {
 					// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 					if !haAzureEipNameOk {
-						return fmt.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+						return diag.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 					}
 					transitHaGw.Eip = fmt.Sprintf("%s:%s", haAzureEipName.(string), transitHaGw.Eip)
 				} else {
 					if haAzureEipNameOk {
-						return fmt.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be empty when 'ha_eip' is empty")
+						return diag.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be empty when 'ha_eip' is empty")
 					}
 				}
 			} else {
 				if haAzureEipNameOk {
-					return fmt.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 			}
 
@@ -1533,19 +1743,19 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				transitHaGw.BgpLanSubnet = strings.Join(haBgpLanSpecifySubnet, ",")
 			}
 
-			log.Printf("[INFO] Enabling HA on Transit Gateway: %#v", haSubnet)
+			tflog.Info(ctx, "Enabling HA on Transit Gateway", map[string]interface{}{"ha_subnet": haSubnet})
 
-			_, err := client.CreateTransitHaGw(transitHaGw)
+			_, err := client.CreateTransitHaGwContext(ctx, transitHaGw)
 			if err != nil {
-				return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
+				return diag.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
 			}
 
 			// Resize HA Gateway
-			log.Printf("[INFO]Resizing Transit HA Gateway: %#v", haGwSize)
+			tflog.Info(ctx, "Resizing Transit HA Gateway", map[string]interface{}{"ha_gw_size": haGwSize})
 
 			if haGwSize != gateway.VpcSize {
 				if haGwSize == "" {
-					return fmt.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
+					return diag.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
 						"ha_subnet is set")
 				}
 
@@ -1555,11 +1765,11 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 					VpcSize:   d.Get("ha_gw_size").(string),
 				}
 
-				log.Printf("[INFO] Resizing Transit HA GAteway size to: %s ", haGateway.VpcSize)
+				tflog.Info(ctx, "Resizing Transit HA Gateway size", map[string]interface{}{"vpc_size": haGateway.VpcSize})
 
-				err = client.UpdateGateway(haGateway)
+				err = client.UpdateGatewayContext(ctx, haGateway)
 				if err != nil {
-					return fmt.Errorf("failed to update Aviatrix Transit HA Gateway size: %s", err)
+					return diag.Errorf("failed to update Aviatrix Transit HA Gateway size: %s", err)
 				}
 			}
 		}
@@ -1567,19 +1777,19 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		enableHybridConnection := d.Get("enable_hybrid_connection").(bool)
 		if enableHybridConnection {
 			if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("'enable_hybrid_connection' is only supported by AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+				return diag.Errorf("'enable_hybrid_connection' is only supported by AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 			}
 
 			err := client.AttachTransitGWForHybrid(gateway)
 			if err != nil {
-				return fmt.Errorf("failed to enable transit GW for Hybrid: %s", err)
+				return diag.Errorf("failed to enable transit GW for Hybrid: %s", err)
 			}
 		}
 
 		if connectedTransit {
 			err := client.EnableConnectedTransit(gateway)
 			if err != nil {
-				return fmt.Errorf("failed to enable connected transit: %s", err)
+				return diag.Errorf("failed to enable connected transit: %s", err)
 			}
 		}
 
@@ -1587,12 +1797,12 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			if enableGatewayLoadBalancer {
 				err := client.EnableGatewayFireNetInterfacesWithGWLB(gateway)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit GW for FireNet Interfaces with Gateway Load Balancer enabled: %s", err)
+					return diag.Errorf("failed to enable transit GW for FireNet Interfaces with Gateway Load Balancer enabled: %s", err)
 				}
 			} else {
 				err := client.EnableGatewayFireNetInterfaces(gateway)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit GW for FireNet Interfaces: %s", err)
+					return diag.Errorf("failed to enable transit GW for FireNet Interfaces: %s", err)
 				}
 			}
 		}
@@ -1603,21 +1813,21 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				GwName: d.Get("gw_name").(string),
 			}
 
-			log.Printf("[INFO] Enable VPC DNS Server: %#v", gwVpcDnsServer)
+			tflog.Info(ctx, "Enable VPC DNS Server", map[string]interface{}{"gw_name": gwVpcDnsServer.GwName})
 
 			err := client.EnableVpcDnsServer(gwVpcDnsServer)
 			if err != nil {
-				return fmt.Errorf("failed to enable VPC DNS Server: %s", err)
+				return diag.Errorf("failed to enable VPC DNS Server: %s", err)
 			}
 		} else if enableVpcDnsServer {
-			return fmt.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
+			return diag.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) or AWS Secret (32768)")
 		}
 
 		enableAdvertiseTransitCidr := d.Get("enable_advertise_transit_cidr").(bool)
 		if enableAdvertiseTransitCidr {
 			err := client.EnableAdvertiseTransitCidr(gateway)
 			if err != nil {
-				return fmt.Errorf("failed to enable advertise transit CIDR: %s", err)
+				return diag.Errorf("failed to enable advertise transit CIDR: %s", err)
 			}
 		}
 
@@ -1626,7 +1836,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			gateway.BgpManualSpokeAdvertiseCidrs = bgpManualSpokeAdvertiseCidrs
 			err := client.SetBgpManualSpokeAdvertisedNetworks(gateway)
 			if err != nil {
-				return fmt.Errorf("failed to set BGP Manual Spoke Advertise Cidrs: %s", err)
+				return diag.Errorf("failed to set BGP Manual Spoke Advertise Cidrs: %s", err)
 			}
 		}
 
@@ -1636,7 +1846,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				CustomizedSpokeVpcRoutes: strings.Split(customizedSpokeVpcRoutes, ","),
 			}
 			for i := 0; ; i++ {
-				log.Printf("[INFO] Editing customized routes of transit gateway: %s ", transitGateway.GwName)
+				tflog.Info(ctx, "Editing customized routes of transit gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 				err := client.EditGatewayCustomRoutes(transitGateway)
 				if err == nil {
 					break
@@ -1644,7 +1854,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				if i <= 10 && strings.Contains(err.Error(), "when it is down") {
 					time.Sleep(10 * time.Second)
 				} else {
-					return fmt.Errorf("failed to customize spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+					return diag.Errorf("failed to customize spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
 				}
 			}
 		}
@@ -1655,7 +1865,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				FilteredSpokeVpcRoutes: strings.Split(filteredSpokeVpcRoutes, ","),
 			}
 			for i := 0; ; i++ {
-				log.Printf("[INFO] Editing filtered routes of transit gateway: %s ", transitGateway.GwName)
+				tflog.Info(ctx, "Editing filtered routes of transit gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 				err := client.EditGatewayFilterRoutes(transitGateway)
 				if err == nil {
 					break
@@ -1663,7 +1873,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				if i <= 10 && strings.Contains(err.Error(), "when it is down") {
 					time.Sleep(10 * time.Second)
 				} else {
-					return fmt.Errorf("failed to edit filtered spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+					return diag.Errorf("failed to edit filtered spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
 				}
 			}
 		}
@@ -1674,7 +1884,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				AdvertisedSpokeRoutes: strings.Split(advertisedSpokeRoutesExclude, ","),
 			}
 			for i := 0; ; i++ {
-				log.Printf("[INFO] Editing customized routes advertisement of transit gateway: %s ", transitGateway.GwName)
+				tflog.Info(ctx, "Editing customized routes advertisement of transit gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 				err := client.EditGatewayAdvertisedCidr(transitGateway)
 				if err == nil {
 					break
@@ -1682,7 +1892,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				if i <= 10 && strings.Contains(err.Error(), "when it is down") {
 					time.Sleep(10 * time.Second)
 				} else {
-					return fmt.Errorf("failed to edit advertised spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+					return diag.Errorf("failed to edit advertised spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
 				}
 			}
 		}
@@ -1694,12 +1904,12 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			if enableGatewayLoadBalancer {
 				err := client.EnableTransitFireNetWithGWLB(gwTransitFireNet)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit firenet with Gateway Load Balancer enabled: %w", err)
+					return diag.Errorf("failed to enable transit firenet with Gateway Load Balancer enabled: %v", err)
 				}
 			} else {
 				err := client.EnableTransitFireNet(gwTransitFireNet)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
+					return diag.Errorf("failed to enable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
 				}
 			}
 		}
@@ -1707,7 +1917,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		if val, ok := d.GetOk("bgp_polling_time"); ok {
 			err := client.SetBgpPollingTime(gateway, val.(int))
 			if err != nil {
-				return fmt.Errorf("could not set bgp polling time: %w", err)
+				return diag.Errorf("could not set bgp polling time: %v", err)
 			}
 		}
 
@@ -1716,7 +1926,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			if bgp_neighbor_status_polling_time >= 1 && bgp_neighbor_status_polling_time != defaultBgpNeighborStatusPollingTime {
 				err := client.SetBgpBfdPollingTime(gateway, bgp_neighbor_status_polling_time)
 				if err != nil {
-					return fmt.Errorf("could not set bgp neighbor status polling time: %w", err)
+					return diag.Errorf("could not set bgp neighbor status polling time: %v", err)
 				}
 			}
 		}
@@ -1724,7 +1934,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		if val, ok := d.GetOk("local_as_number"); ok {
 			err := client.SetLocalASNumber(gateway, val.(string))
 			if err != nil {
-				return fmt.Errorf("could not set local_as_number: %w", err)
+				return diag.Errorf("could not set local_as_number: %v", err)
 			}
 		}
 
@@ -1736,45 +1946,45 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			}
 			err := client.SetPrependASPath(gateway, prependASPath)
 			if err != nil {
-				return fmt.Errorf("could not set prepend_as_path: %w", err)
+				return diag.Errorf("could not set prepend_as_path: %v", err)
 			}
 		}
 
 		if val, ok := d.GetOk("bgp_ecmp"); ok {
 			err := client.SetBgpEcmp(gateway, val.(bool))
 			if err != nil {
-				return fmt.Errorf("could not set bgp_ecmp: %w", err)
+				return diag.Errorf("could not set bgp_ecmp: %v", err)
 			}
 		}
 
 		if d.Get("enable_segmentation").(bool) {
 			if err := client.EnableSegmentation(gateway); err != nil {
-				return fmt.Errorf("could not enable segmentation: %w", err)
+				return diag.Errorf("could not enable segmentation: %v", err)
 			}
 		}
 
 		if enableEgressTransitFireNet {
 			err := client.EnableEgressTransitFirenet(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable egress transit firenet: %w", err)
+				return diag.Errorf("could not enable egress transit firenet: %v", err)
 			}
 		}
 
 		if enableTransitPreserveAsPath {
 			err := client.EnableTransitPreserveAsPath(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable transit preserve as path: %w", err)
+				return diag.Errorf("could not enable transit preserve as path: %v", err)
 			}
 		}
 
 		if enableActiveStandby {
 			if enableActiveStandbyPreemptive {
 				if err := client.EnableActiveStandbyPreemptive(gateway); err != nil {
-					return fmt.Errorf("could not enable Preemptive Mode for Active-Standby: %w", err)
+					return diag.Errorf("could not enable Preemptive Mode for Active-Standby: %v", err)
 				}
 			} else {
 				if err := client.EnableActiveStandby(gateway); err != nil {
-					return fmt.Errorf("could not enable Active-Standby: %w", err)
+					return diag.Errorf("could not enable Active-Standby: %v", err)
 				}
 			}
 		}
@@ -1783,14 +1993,14 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		if approvalMode != defaultLearnedCidrApprovalMode {
 			err := client.SetTransitLearnedCIDRsApprovalMode(gateway, approvalMode)
 			if err != nil {
-				return fmt.Errorf("could not set learned CIDRs approval mode to %q: %v", approvalMode, err)
+				return diag.Errorf("could not set learned CIDRs approval mode to %q: %v", approvalMode, err)
 			}
 		}
 
 		if learnedCidrsApproval && len(gateway.ApprovedLearnedCidrs) != 0 {
 			err = client.UpdateTransitPendingApprovedCidrs(gateway)
 			if err != nil {
-				return fmt.Errorf("could not update approved CIDRs: %w", err)
+				return diag.Errorf("could not update approved CIDRs: %v", err)
 			}
 		}
 
@@ -1801,14 +2011,14 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		if len(customizedTransitVpcRoutes) != 0 {
 			err := client.UpdateTransitGatewayCustomizedVpcRoute(gateway.GwName, customizedTransitVpcRoutes)
 			if err != nil {
-				return fmt.Errorf("couldn't update transit gateway customized vpc route: %s", err)
+				return diag.Errorf("couldn't update transit gateway customized vpc route: %s", err)
 			}
 		}
 
 		if enableMonitorSubnets {
 			err := client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
 			if err != nil {
-				return fmt.Errorf("could not enable monitor gateway subnets: %w", err)
+				return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 			}
 		}
 
@@ -1819,7 +2029,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 
 			err := client.DisableJumboFrame(gw)
 			if err != nil {
-				return fmt.Errorf("could not disable jumbo frame for transit gateway: %w", err)
+				return diag.Errorf("could not disable jumbo frame for transit gateway: %v", err)
 			}
 		}
 
@@ -1829,28 +2039,28 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			}
 			err := client.DisableGroGso(gw)
 			if err != nil {
-				return fmt.Errorf("couldn't disable GRO/GSO on transit gateway: %s", err)
+				return diag.Errorf("couldn't disable GRO/GSO on transit gateway: %s", err)
 			}
 		}
 
 		if holdTime := d.Get("bgp_hold_time").(int); holdTime != defaultBgpHoldTime {
 			err := client.ChangeBgpHoldTime(gateway.GwName, holdTime)
 			if err != nil {
-				return fmt.Errorf("could not change BGP Hold Time after Transit Gateway creation: %w", err)
+				return diag.Errorf("could not change BGP Hold Time after Transit Gateway creation: %v", err)
 			}
 		}
 
 		if gateway.EnableSummarizeCidrToTgw {
 			err = client.EnableSummarizeCidrToTgw(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not enable summarize cidr to tgw: %w", err)
+				return diag.Errorf("could not enable summarize cidr to tgw: %v", err)
 			}
 		}
 
 		if enableMultitierTransit {
 			err = client.EnableMultitierTransit(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not enable multi tier transit: %w", err)
+				return diag.Errorf("could not enable multi tier transit: %v", err)
 			}
 		}
 
@@ -1858,14 +2068,14 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 		if enableS2CRxBalancing {
 			err = client.EnableS2CRxBalancing(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not enable S2C receive packet CPU re-balancing on transit %s: %v", gateway.GwName, err)
+				return diag.Errorf("could not enable S2C receive packet CPU re-balancing on transit %s: %v", gateway.GwName, err)
 			}
 		}
 
 		if detectionTime, ok := d.GetOk("tunnel_detection_time"); ok {
 			err := client.ModifyTunnelDetectionTime(gateway.GwName, detectionTime.(int))
 			if err != nil {
-				return fmt.Errorf("could not set tunnel detection time during Transit Gateway creation: %w", err)
+				return diag.Errorf("could not set tunnel detection time during Transit Gateway creation: %v", err)
 			}
 		}
 
@@ -1876,7 +2086,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 			}
 			err := client.SetRxQueueSize(gwRxQueueSize)
 			if err != nil {
-				return fmt.Errorf("failed to set rx queue size for transit %s: %s", gateway.GwName, err)
+				return diag.Errorf("failed to set rx queue size for transit %s: %s", gateway.GwName, err)
 			}
 			if haSubnet != "" || haZone != "" {
 				haGwRxQueueSize := &goaviatrix.Gateway{
@@ -1885,32 +2095,31 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta interface
 				}
 				err := client.SetRxQueueSize(haGwRxQueueSize)
 				if err != nil {
-					return fmt.Errorf("failed to set rx queue size for transit ha %s : %s", haGwRxQueueSize.GwName, err)
+					return diag.Errorf("failed to set rx queue size for transit ha %s : %s", haGwRxQueueSize.GwName, err)
 				}
 			}
 		}
 	}
-	return resourceAviatrixTransitGatewayReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransitGatewayReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransitGatewayReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixTransitGatewayReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransitGatewayRead(d, meta)
+		return resourceAviatrixTransitGatewayRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
-	ignoreTagsConfig := client.IgnoreTagsConfig
+func resourceAviatrixTransitGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	var isImport bool
 	gwName := d.Get("gw_name").(string)
 	if gwName == "" {
 		isImport = true
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no gateway name received", map[string]interface{}{"id": id})
 		d.Set("gw_name", id)
 		gwName = id
 		d.SetId(id)
@@ -1921,16 +2130,16 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 		GwName:      gwName,
 	}
 
-	gw, err := client.GetGateway(gateway)
+	gw, err := client.GetGatewayContext(ctx, gateway)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Transit Gateway: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Transit Gateway: %s", err)
 	}
 
-	log.Printf("[TRACE] reading gateway %s: %#v", d.Get("gw_name").(string), gw)
+	tflog.Trace(ctx, "reading gateway", map[string]interface{}{"gw_name": d.Get("gw_name").(string)})
 
 	d.Set("cloud_type", gw.CloudType)
 	d.Set("account_name", gw.AccountName)
@@ -1943,15 +2152,15 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 	if gw.GwSize != "UNKNOWN" && gw.GwSize != "" {
 		sendComm, acceptComm, err := client.GetGatewayBgpCommunities(gateway.GwName)
 		if err != nil {
-			return fmt.Errorf("failed to get BGP communities for gateway %s: %w", gateway.GwName, err)
+			return diag.Errorf("failed to get BGP communities for gateway %s: %v", gateway.GwName, err)
 		}
 		err = d.Set("bgp_send_communities", sendComm)
 		if err != nil {
-			return fmt.Errorf("failed to set bgp_send_communities: %w", err)
+			return diag.Errorf("failed to set bgp_send_communities: %v", err)
 		}
 		err = d.Set("bgp_accept_communities", acceptComm)
 		if err != nil {
-			return fmt.Errorf("failed to set bgp_accept_communities: %w", err)
+			return diag.Errorf("failed to set bgp_accept_communities: %v", err)
 		}
 	}
 
@@ -1968,33 +2177,33 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 			// get the order of interfaces
 			userInterfaces, ok := d.Get("interfaces").([]interface{})
 			if !ok {
-				return fmt.Errorf("could not get user interfaces")
+				return diag.Errorf("could not get user interfaces")
 			}
 			userInterfaceOrder, err := getUserInterfaceOrder(userInterfaces)
 			if err != nil {
-				return fmt.Errorf("could not get user interface order: %w", err)
+				return diag.Errorf("could not get user interface order: %v", err)
 			}
 			interfaces := setInterfaceDetails(gw.Interfaces, userInterfaceOrder)
 			if err = d.Set("interfaces", interfaces); err != nil {
-				return fmt.Errorf("could not set interfaces into state: %w", err)
+				return diag.Errorf("could not set interfaces into state: %v", err)
 			}
 		}
 		// set interface mapping
 		if len(gw.InterfaceMapping) != 0 {
 			interfaceMapping := setInterfaceMappingDetails(gw.InterfaceMapping)
 			if err = d.Set("interface_mapping", interfaceMapping); err != nil {
-				return fmt.Errorf("could not set interface mapping into state: %w", err)
+				return diag.Errorf("could not set interface mapping into state: %v", err)
 			}
 		}
 		// set eip map
 		if gw.EipMap != nil {
-			log.Printf("[TRACE] eip map: %#v", gw.EipMap)
+			tflog.Trace(ctx, "eip map")
 			eipMap, err := setEipMapDetails(gw.EipMap, gw.IfNamesTranslation)
 			if err != nil {
-				return fmt.Errorf("could not set eip map details: %w", err)
+				return diag.Errorf("could not set eip map details: %v", err)
 			}
 			if err = d.Set("eip_map", eipMap); err != nil {
-				return fmt.Errorf("could not set eip map into state: %w", err)
+				return diag.Errorf("could not set eip map into state: %v", err)
 			}
 		}
 
@@ -2036,11 +2245,11 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 		if gw.HaGw.GwName == "" && backupLinkInfo != nil {
 			backupLink, ok := backupLinkInfo[haGwName]
 			if !ok {
-				return fmt.Errorf("BackupLinkInfo does not contain HA Gateway: %s", haGwName)
+				return diag.Errorf("BackupLinkInfo does not contain HA Gateway: %s", haGwName)
 			}
 			peerBackupLogicalName := backupLink.PeerLogicalIntfName
 			if err = d.Set("peer_backup_logical_ifname", peerBackupLogicalName); err != nil {
-				return fmt.Errorf("could not set peer backup logical interface name into state: %w", err)
+				return diag.Errorf("could not set peer backup logical interface name into state: %v", err)
 			}
 			connectionTypePrivate := backupLink.ConnectionTypePublic
 			if connectionTypePrivate {
@@ -2053,15 +2262,15 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 		if len(gw.HaGw.Interfaces) != 0 {
 			userInterfaces, ok := d.Get("ha_interfaces").([]interface{})
 			if !ok {
-				return fmt.Errorf("could not get user interfaces")
+				return diag.Errorf("could not get user interfaces")
 			}
 			userInterfaceOrder, err := getUserInterfaceOrder(userInterfaces)
 			if err != nil {
-				return fmt.Errorf("could not get user interface order: %w", err)
+				return diag.Errorf("could not get user interface order: %v", err)
 			}
 			haInterfaces := setInterfaceDetails(gw.HaGw.Interfaces, userInterfaceOrder)
 			if err = d.Set("ha_interfaces", haInterfaces); err != nil {
-				return fmt.Errorf("could not set interfaces into state: %w", err)
+				return diag.Errorf("could not set interfaces into state: %v", err)
 			}
 		}
 		// set device id
@@ -2076,8 +2285,8 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 		}
 		// for EAT gateway, set the eip_map, bgp polling time, bgp neighbor status polling time, local_as_number and prepend_as_path after the transit is created. AEP gateways take ~15 mins to be up and running. Set these attributes to gateway default values when the transit is coming up.
 		if err := setGatewayResourceData(d, gw); err != nil {
-			log.Printf("[ERROR] %v", err)
-			return err
+			tflog.Error(ctx, "could not set gateway resource data", map[string]interface{}{"error": err})
+			return diag.FromErr(err)
 		}
 	} else {
 		d.Set("enable_encrypt_volume", gw.EnableEncryptVolume)
@@ -2106,7 +2315,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 		}
 		err = d.Set("prepend_as_path", prependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path: %w", err)
+			return diag.Errorf("could not set prepend_as_path: %v", err)
 		}
 		d.Set("local_as_number", gw.LocalASNumber)
 		d.Set("bgp_ecmp", gw.BgpEcmp)
@@ -2130,7 +2339,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 					interfaces = append(interfaces, interfaceDict)
 				}
 				if err = d.Set("bgp_lan_interfaces", interfaces); err != nil {
-					return fmt.Errorf("could not set bgp_lan_interfaces into state: %w", err)
+					return diag.Errorf("could not set bgp_lan_interfaces into state: %v", err)
 				}
 			}
 
@@ -2143,35 +2352,35 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 					haInterfaces = append(haInterfaces, interfaceDict)
 				}
 				if err = d.Set("ha_bgp_lan_interfaces", haInterfaces); err != nil {
-					return fmt.Errorf("could not set ha_bgp_lan_interfaces into state: %w", err)
+					return diag.Errorf("could not set ha_bgp_lan_interfaces into state: %v", err)
 				}
 			}
 
-			bgpLanIpInfo, err := client.GetBgpLanIPList(&goaviatrix.TransitVpc{GwName: gateway.GwName})
+			bgpLanIpInfo, err := client.GetBgpLanIPListContext(ctx, &goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not get BGP LAN IP info for GCP transit gateway %s: %v", gateway.GwName, err)
+				return diag.Errorf("could not get BGP LAN IP info for GCP transit gateway %s: %v", gateway.GwName, err)
 			}
 			if err = d.Set("bgp_lan_ip_list", bgpLanIpInfo.BgpLanIpList); err != nil {
-				return fmt.Errorf("could not set bgp_lan_ip_list into state: %w", err)
+				return diag.Errorf("could not set bgp_lan_ip_list into state: %v", err)
 			}
 			if len(bgpLanIpInfo.HaBgpLanIpList) != 0 {
 				if err = d.Set("ha_bgp_lan_ip_list", bgpLanIpInfo.HaBgpLanIpList); err != nil {
-					return fmt.Errorf("could not set ha_bgp_lan_ip_list into state: %w", err)
+					return diag.Errorf("could not set ha_bgp_lan_ip_list into state: %v", err)
 				}
 			} else {
 				d.Set("ha_bgp_lan_ip_list", nil)
 			}
 		} else if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && gw.EnableBgpOverLan {
-			bgpLanIpInfo, err := client.GetBgpLanIPList(&goaviatrix.TransitVpc{GwName: gateway.GwName})
+			bgpLanIpInfo, err := client.GetBgpLanIPListContext(ctx, &goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not get BGP LAN IP info for Azure transit gateway %s: %v", gateway.GwName, err)
+				return diag.Errorf("could not get BGP LAN IP info for Azure transit gateway %s: %v", gateway.GwName, err)
 			}
 			if err = d.Set("bgp_lan_ip_list", bgpLanIpInfo.AzureBgpLanIpList); err != nil {
-				return fmt.Errorf("could not set bgp_lan_ip_list into state: %w", err)
+				return diag.Errorf("could not set bgp_lan_ip_list into state: %v", err)
 			}
 			if len(bgpLanIpInfo.AzureHaBgpLanIpList) != 0 {
 				if err = d.Set("ha_bgp_lan_ip_list", bgpLanIpInfo.AzureHaBgpLanIpList); err != nil {
-					return fmt.Errorf("could not set ha_bgp_lan_ip_list into state: %w", err)
+					return diag.Errorf("could not set ha_bgp_lan_ip_list into state: %v", err)
 				}
 			} else {
 				d.Set("ha_bgp_lan_ip_list", nil)
@@ -2208,20 +2417,20 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 		d.Set("enable_advertise_transit_cidr", gw.EnableAdvertiseTransitCidr)
 		d.Set("enable_learned_cidrs_approval", gw.EnableLearnedCidrsApproval)
 		if gw.EnableLearnedCidrsApproval {
-			transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfig(&goaviatrix.TransitVpc{GwName: gw.GwName})
+			transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfigContext(ctx, &goaviatrix.TransitVpc{GwName: gw.GwName})
 			if err != nil {
-				return fmt.Errorf("could not get advanced config for transit gateway: %w", err)
+				return diag.Errorf("could not get advanced config for transit gateway: %v", err)
 			}
 
 			if err = d.Set("approved_learned_cidrs", transitAdvancedConfig.ApprovedLearnedCidrs); err != nil {
-				return fmt.Errorf("could not set approved_learned_cidrs into state: %w", err)
+				return diag.Errorf("could not set approved_learned_cidrs into state: %v", err)
 			}
 		} else {
 			d.Set("approved_learned_cidrs", nil)
 		}
 		d.Set("enable_monitor_gateway_subnets", gw.MonitorSubnetsAction == "enable")
 		if err := d.Set("monitor_exclude_list", gw.MonitorExcludeGWList); err != nil {
-			return fmt.Errorf("setting 'monitor_exclude_list' to state: %w", err)
+			return diag.Errorf("setting 'monitor_exclude_list' to state: %v", err)
 		}
 		d.Set("enable_multi_tier_transit", gw.EnableMultitierTransit)
 		d.Set("tunnel_detection_time", gw.TunnelDetectionTime)
@@ -2231,7 +2440,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 			if len(azureEip) == 3 {
 				d.Set("azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName)
+				tflog.Warn(ctx, "could not get Azure EIP name and resource group for the Transit Gateway", map[string]interface{}{"gw_name": gw.GwName})
 			}
 		}
 
@@ -2341,16 +2550,15 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 			d.Set("bgp_manual_spoke_advertise_cidrs", d.Get("bgp_manual_spoke_advertise_cidrs").(string))
 		}
 
-		lanCidr, err := client.GetTransitGatewayLanCidr(gw.GwName)
+		lanCidr, err := client.GetTransitGatewayLanCidrContext(ctx, gw.GwName)
 		if err != nil && err != goaviatrix.ErrNotFound {
-			log.Printf("[WARN] Error getting lan cidr for transit gateway %s due to %s", gw.GwName, err)
+			tflog.Warn(ctx, "Error getting lan cidr for transit gateway", map[string]interface{}{"gw_name": gw.GwName, "error": err})
 		}
 		d.Set("lan_interface_cidr", lanCidr)
 
 		if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			tags := goaviatrix.KeyValueTags(gw.Tags).IgnoreConfig(ignoreTagsConfig)
-			if err := d.Set("tags", tags); err != nil {
-				log.Printf("[WARN] Error setting tags for (%s): %s", d.Id(), err)
+			if err := setTags(d, client, gw.Tags); err != nil {
+				tflog.Warn(ctx, "Error setting tags", map[string]interface{}{"id": d.Id(), "error": err})
 			}
 		}
 
@@ -2384,7 +2592,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 
 		enableGroGso, err := client.GetGroGsoStatus(gw)
 		if err != nil {
-			return fmt.Errorf("failed to get GRO/GSO status of transit gateway %s: %v", gw.GwName, err)
+			return diag.Errorf("failed to get GRO/GSO status of transit gateway %s: %v", gw.GwName, err)
 		}
 		d.Set("enable_gro_gso", enableGroGso)
 		if gw.HaGw.GwSize == "" {
@@ -2445,9 +2653,9 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 		d.Set("ha_software_version", gw.HaGw.SoftwareVersion)
 		d.Set("ha_image_version", gw.HaGw.ImageVersion)
 		d.Set("ha_security_group_id", gw.HaGw.GwSecurityGroupID)
-		lanCidr, err = client.GetTransitGatewayLanCidr(gw.HaGw.GwName)
+		lanCidr, err = client.GetTransitGatewayLanCidrContext(ctx, gw.HaGw.GwName)
 		if err != nil && err != goaviatrix.ErrNotFound {
-			log.Printf("[WARN] Error getting lan cidr for HA transit gateway %s due to %s", gw.HaGw.GwName, err)
+			tflog.Warn(ctx, "Error getting lan cidr for HA transit gateway", map[string]interface{}{"gw_name": gw.HaGw.GwName, "error": err})
 		}
 		d.Set("ha_lan_interface_cidr", lanCidr)
 
@@ -2477,15 +2685,15 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta interface{}
 			if len(azureEip) == 3 {
 				d.Set("ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName)
+				tflog.Warn(ctx, "could not get Azure EIP name and resource group for the HA Gateway", map[string]interface{}{"gw_name": gw.GwName})
 			}
 		}
 	}
 	return nil
 }
 
-func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: d.Get("cloud_type").(int),
@@ -2496,7 +2704,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		GwName:    d.Get("gw_name").(string) + "-hagw",
 		VpcSize:   d.Get("ha_gw_size").(string),
 	}
-	log.Printf("[INFO] Updating Aviatrix Transit Gateway: %#v", gateway)
+	tflog.Info(ctx, "Updating Aviatrix Transit Gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
 	// Clarification : Can the user update EAT interface after its created. Add/Delete EAT interface
 	d.Partial(true)
@@ -2506,7 +2714,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if ok && acceptComm != commAcceptCurr || err != nil {
 			err := client.SetGatewayBgpCommunitiesAccept(gateway.GwName, acceptComm)
 			if err != nil {
-				return fmt.Errorf("failed to set accept BGP communities for gateway %s: %w", gateway.GwName, err)
+				return diag.Errorf("failed to set accept BGP communities for gateway %s: %v", gateway.GwName, err)
 			}
 		}
 	}
@@ -2515,68 +2723,68 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if ok && sendComm != commSendCurr || err != nil {
 			err := client.SetGatewayBgpCommunitiesSend(gateway.GwName, sendComm)
 			if err != nil {
-				return fmt.Errorf("failed to set send BGP communities for gateway %s: %w", gateway.GwName, err)
+				return diag.Errorf("failed to set send BGP communities for gateway %s: %v", gateway.GwName, err)
 			}
 		}
 	}
 	if d.HasChange("ha_zone") {
 		haZone := d.Get("ha_zone").(string)
 		if haZone != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("'ha_zone' is only valid for GCP and Azure providers when enabling HA")
+			return diag.Errorf("'ha_zone' is only valid for GCP and Azure providers when enabling HA")
 		}
 	}
 	if d.HasChange("ha_zone") || d.HasChange("ha_subnet") {
 		haZone := d.Get("ha_zone").(string)
 		haSubnet := d.Get("ha_subnet").(string)
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) && haSubnet != "" && haZone == "" {
-			return fmt.Errorf("'ha_zone' must be set to enable HA on GCP, cannot enable HA with only 'ha_subnet'")
+			return diag.Errorf("'ha_zone' must be set to enable HA on GCP, cannot enable HA with only 'ha_subnet'")
 		}
 		if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && haSubnet == "" && haZone != "" {
-			return fmt.Errorf("'ha_subnet' must be provided to enable HA on Azure, cannot enable HA with only 'ha_zone'")
+			return diag.Errorf("'ha_subnet' must be provided to enable HA on Azure, cannot enable HA with only 'ha_zone'")
 		}
 	}
 	if d.HasChange("allocate_new_eip") {
-		return fmt.Errorf("updating allocate_new_eip is not allowed")
+		return diag.Errorf("updating allocate_new_eip is not allowed")
 	}
 	if d.HasChange("eip") {
-		return fmt.Errorf("updating eip is not allowed")
+		return diag.Errorf("updating eip is not allowed")
 	}
 	if d.HasChange("ha_eip") {
 		o, n := d.GetChange("ha_eip")
 		if o.(string) != "" && n.(string) != "" {
-			return fmt.Errorf("updating ha_eip is not allowed")
+			return diag.Errorf("updating ha_eip is not allowed")
 		}
 	}
 	if d.HasChange("azure_eip_name_resource_group") {
-		return fmt.Errorf("failed to update transit gateway: changing 'azure_eip_name_resource_group' is not allowed")
+		return diag.Errorf("failed to update transit gateway: changing 'azure_eip_name_resource_group' is not allowed")
 	}
 	if d.HasChange("ha_azure_eip_name_resource_group") {
 		o, n := d.GetChange("ha_azure_eip_name_resource_group")
 		if o.(string) != "" && n.(string) != "" {
-			return fmt.Errorf("failed to update transit gateway: changing 'ha_azure_eip_name_resource_group' is not allowed")
+			return diag.Errorf("failed to update transit gateway: changing 'ha_azure_eip_name_resource_group' is not allowed")
 		}
 	}
 	if d.HasChange("lan_vpc_id") {
-		return fmt.Errorf("updating lan_vpc_id is not allowed")
+		return diag.Errorf("updating lan_vpc_id is not allowed")
 	}
 	if d.HasChange("lan_private_subnet") {
-		return fmt.Errorf("updating lan_private_subnet is not allowed")
+		return diag.Errorf("updating lan_private_subnet is not allowed")
 	}
 
 	// Transit FireNet function is not supported for AWS China and Azure China
 	if d.HasChange("enable_firenet") && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSChina|goaviatrix.AzureChina) {
-		return fmt.Errorf("editing 'enable_firenet' in AWSChina (1024) and AzureChina (2048) is not supported")
+		return diag.Errorf("editing 'enable_firenet' in AWSChina (1024) and AzureChina (2048) is not supported")
 	}
 	// Transit FireNet function is not supported for Azure China
 	if d.HasChange("enable_transit_firenet") && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-		return fmt.Errorf("editing 'enable_transit_firenet' in GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) is not supported")
+		return diag.Errorf("editing 'enable_transit_firenet' in GCP (4), Azure (8), AzureGov (32) and AzureChina (2048) is not supported")
 	}
 	if d.Get("enable_egress_transit_firenet").(bool) && !d.Get("enable_transit_firenet").(bool) {
-		return fmt.Errorf("'enable_egress_transit_firenet' requires 'enable_transit_firenet' to be set to true")
+		return diag.Errorf("'enable_egress_transit_firenet' requires 'enable_transit_firenet' to be set to true")
 	}
 	// Transit FireNet function is not supported for Azure China
 	if d.Get("enable_egress_transit_firenet").(bool) && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes^goaviatrix.AzureChina|goaviatrix.OCIRelatedCloudTypes) {
-		return fmt.Errorf("'enable_egress_transit_firenet' is currently only supported in AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("'enable_egress_transit_firenet' is currently only supported in AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWS China (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	learnedCidrsApproval := d.Get("enable_learned_cidrs_approval").(bool)
@@ -2584,32 +2792,32 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 	approvedLearnedCidrs := getStringSet(d, "approved_learned_cidrs")
 
 	if learnedCidrsApproval && learnedCidrsApprovedMode == "connection" {
-		return fmt.Errorf("'enable_learned_cidrs_approval' must be false if 'learned_cidrs_approval_mode' is set to 'connection'")
+		return diag.Errorf("'enable_learned_cidrs_approval' must be false if 'learned_cidrs_approval_mode' is set to 'connection'")
 	}
 	if !learnedCidrsApproval && len(approvedLearnedCidrs) != 0 {
-		return fmt.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
+		return diag.Errorf("'approved_learned_cidrs' must be empty if 'enable_learned_cidrs_approval' is false")
 	}
 
 	if d.HasChange("enable_private_oob") {
-		return fmt.Errorf("updating enable_private_oob is not allowed")
+		return diag.Errorf("updating enable_private_oob is not allowed")
 	}
 
 	enablePrivateOob := d.Get("enable_private_oob").(bool)
-	privateModeInfo, _ := client.GetPrivateModeInfo(context.Background())
+	privateModeInfo, _ := client.GetPrivateModeInfo(ctx)
 
 	if !enablePrivateOob {
 		if d.HasChange("ha_oob_management_subnet") {
-			return fmt.Errorf("updating ha_oob_manage_subnet is not allowed if private oob is disabled")
+			return diag.Errorf("updating ha_oob_manage_subnet is not allowed if private oob is disabled")
 		}
 
 		if d.HasChange("ha_oob_availability_zone") {
-			return fmt.Errorf("updating ha_oob_availability_zone is not allowed if private oob is disabled")
+			return diag.Errorf("updating ha_oob_availability_zone is not allowed if private oob is disabled")
 		}
 	}
 
 	if !privateModeInfo.EnablePrivateMode {
 		if d.HasChange("ha_private_mode_subnet_zone") {
-			return fmt.Errorf("updating %q is not allowed if private mode is disabled", "ha_private_mode_subnet_zone")
+			return diag.Errorf("updating %q is not allowed if private mode is disabled", "ha_private_mode_subnet_zone")
 		}
 	}
 
@@ -2622,20 +2830,20 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 	}
 	if d.HasChange("ha_bgp_lan_interfaces") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.GCPRelatedCloudTypes) {
-			return fmt.Errorf("updating ha_bgp_lan_interfaces is not allowed for non-GCP Transit Gateway")
+			return diag.Errorf("updating ha_bgp_lan_interfaces is not allowed for non-GCP Transit Gateway")
 		}
 		if !d.Get("enable_bgp_over_lan").(bool) {
-			return fmt.Errorf("updating ha_bgp_lan_interfaces is not allowed for non-BGP over LAN GCP Transit Gateway")
+			return diag.Errorf("updating ha_bgp_lan_interfaces is not allowed for non-BGP over LAN GCP Transit Gateway")
 		}
 		if !d.HasChange("ha_subnet") {
-			return fmt.Errorf("ha_bgp_lan_interfaces can only be updated along with BGP over LAN enabled GCP HA Transit Gateway's creation or deletion")
+			return diag.Errorf("ha_bgp_lan_interfaces can only be updated along with BGP over LAN enabled GCP HA Transit Gateway's creation or deletion")
 		}
 
 		newHaSubnet := d.Get("ha_subnet").(string)
 		if len(haBgpLanVpcID) == 0 && newHaSubnet != "" {
-			return fmt.Errorf("missing ha_bgp_lan_interfaces for creating GCP HA transit gateway with BGP over LAN enabled in update")
+			return diag.Errorf("missing ha_bgp_lan_interfaces for creating GCP HA transit gateway with BGP over LAN enabled in update")
 		} else if len(haBgpLanVpcID) != 0 && newHaSubnet == "" {
-			return fmt.Errorf("ha_bgp_lan_interfaces should be set empty for destroying GCP HA transit gateway with BGP over LAN enabled in update")
+			return diag.Errorf("ha_bgp_lan_interfaces should be set empty for destroying GCP HA transit gateway with BGP over LAN enabled in update")
 		}
 	}
 
@@ -2664,7 +2872,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 
 		if !d.HasChange("ha_subnet") && d.HasChange("ha_insane_mode_az") {
-			return fmt.Errorf("ha_subnet must change if ha_insane_mode_az changes")
+			return diag.Errorf("ha_subnet must change if ha_insane_mode_az changes")
 		}
 
 		oldSubnet, newSubnet := d.GetChange("ha_subnet")
@@ -2682,10 +2890,10 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			haFaultDomain := d.Get("ha_fault_domain").(string)
 			if newSubnet != "" {
 				if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain == "" || haFaultDomain == "") {
-					return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are required to enable HA on OCI")
+					return diag.Errorf("'ha_availability_domain' and 'ha_fault_domain' are required to enable HA on OCI")
 				}
 				if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) && (haAvailabilityDomain != "" || haFaultDomain != "") {
-					return fmt.Errorf("'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
+					return diag.Errorf("'ha_availability_domain' and 'ha_fault_domain' are only valid for OCI")
 				}
 			}
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.OCIRelatedCloudTypes) {
@@ -2723,17 +2931,17 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			if haEip != "" && (newHaGwEnabled || changeHaGw) {
 				// No change will be detected when ha_eip is set to the empty string because it is computed.
 				if !haAzureEipNameOk {
-					return fmt.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
+					return diag.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be set when a custom EIP is provided and cloud_type is Azure (8), AzureGov (32) or AzureChina (2048)")
 				}
 				// AVX-9874 Azure EIP has a different format e.g. 'test_ip:rg:104.45.186.20'
 				haAzureEipNameString, assertOk := haAzureEipName.(string)
 				if !assertOk {
-					return fmt.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be a string")
+					return diag.Errorf("failed to create HA Transit Gateway: 'ha_azure_eip_name_resource_group' must be a string")
 				}
 				transitHaGw.Eip = fmt.Sprintf("%s:%s", haAzureEipNameString, haEip)
 			}
 		} else if haAzureEipNameOk {
-			return fmt.Errorf("failed to create HA Spoke Gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
+			return diag.Errorf("failed to create HA Spoke Gateway: 'azure_eip_name_resource_group' must be empty when cloud_type is not one of Azure (8), AzureGov (32) or AzureChina (2048)")
 		}
 
 		if d.Get("insane_mode").(bool) {
@@ -2743,10 +2951,10 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				haSubnet := d.Get("ha_subnet").(string)
 
 				if insaneModeHaAz == "" && haSubnet != "" {
-					return fmt.Errorf("ha_insane_mode_az needed if insane_mode is enabled and ha_subnet is set for " +
+					return diag.Errorf("ha_insane_mode_az needed if insane_mode is enabled and ha_subnet is set for " +
 						"AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 				} else if insaneModeHaAz != "" && haSubnet == "" {
-					return fmt.Errorf("ha_subnet needed if insane_mode is enabled and ha_insane_mode_az is set for " +
+					return diag.Errorf("ha_subnet needed if insane_mode is enabled and ha_insane_mode_az is set for " +
 						"AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
 				}
 
@@ -2757,10 +2965,10 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 
 		if (newHaGwEnabled || changeHaGw) && haGwSize == "" {
-			return fmt.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
+			return diag.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
 				"ha_subnet or ha_zone is set")
 		} else if deleteHaGw && haGwSize != "" {
-			return fmt.Errorf("ha_gw_size must be empty if transit HA gateway is deleted")
+			return diag.Errorf("ha_gw_size must be empty if transit HA gateway is deleted")
 		}
 
 		haOobManagementSubnet := d.Get("ha_oob_management_subnet").(string)
@@ -2769,19 +2977,19 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if enablePrivateOob {
 			if newHaGwEnabled || changeHaGw {
 				if haOobAvailabilityZone == "" {
-					return fmt.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+					return diag.Errorf("\"ha_oob_availability_zone\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 				}
 
 				if haOobManagementSubnet == "" {
-					return fmt.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
+					return diag.Errorf("\"ha_oob_management_subnet\" is required if \"enable_private_oob\" is true and \"ha_subnet\" is provided")
 				}
 			} else if deleteHaGw {
 				if haOobAvailabilityZone != "" {
-					return fmt.Errorf("\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
+					return diag.Errorf("\"ha_oob_availability_zone\" must be empty if \"ha_subnet\" is empty")
 				}
 
 				if haOobManagementSubnet != "" {
-					return fmt.Errorf("\"ha_oob_management_subnet\" must be empty if \"ha_subnet\" is empty")
+					return diag.Errorf("\"ha_oob_management_subnet\" must be empty if \"ha_subnet\" is empty")
 				}
 			}
 		}
@@ -2789,7 +2997,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if privateModeInfo.EnablePrivateMode {
 			if newHaGwEnabled || changeHaGw {
 				if _, ok := d.GetOk("ha_private_mode_subnet_zone"); !ok && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-					return fmt.Errorf("%q is required when creating a Transit HA Gateway in AWS if private mode is enabled and %q is provided", "ha_private_mode_subnet_zone", "ha_subnet")
+					return diag.Errorf("%q is required when creating a Transit HA Gateway in AWS if private mode is enabled and %q is provided", "ha_private_mode_subnet_zone", "ha_subnet")
 				}
 
 				privateModeSubnetZone := d.Get("ha_private_mode_subnet_zone").(string)
@@ -2798,9 +3006,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 
 		if newHaGwEnabled {
-			_, err := client.CreateTransitHaGw(transitHaGw)
+			_, err := client.CreateTransitHaGwContext(ctx, transitHaGw)
 			if err != nil {
-				return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
+				return diag.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
 			}
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 				if d.Get("rx_queue_size").(string) != "" && !d.HasChange("rx_queue_size") {
@@ -2810,25 +3018,25 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 					}
 					err := client.SetRxQueueSize(haGwRxQueueSize)
 					if err != nil {
-						return fmt.Errorf("could not set rx queue size for transit ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
+						return diag.Errorf("could not set rx queue size for transit ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
 					}
 				}
 			}
 		} else if deleteHaGw {
-			err := client.DeleteGateway(haGateway)
+			err := client.DeleteGatewayContext(ctx, haGateway)
 			if err != nil {
-				return fmt.Errorf("failed to delete Aviatrix Transit HA gateway: %s", err)
+				return diag.Errorf("failed to delete Aviatrix Transit HA gateway: %s", err)
 			}
 		} else if changeHaGw {
-			err := client.DeleteGateway(haGateway)
+			err := client.DeleteGatewayContext(ctx, haGateway)
 			if err != nil {
-				return fmt.Errorf("failed to delete Aviatrix Transit HA gateway: %s", err)
+				return diag.Errorf("failed to delete Aviatrix Transit HA gateway: %s", err)
 			}
 
 			transitHaGw.Eip = ""
-			_, err = client.CreateTransitHaGw(transitHaGw)
+			_, err = client.CreateTransitHaGwContext(ctx, transitHaGw)
 			if err != nil {
-				return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
+				return diag.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
 			}
 			newHaGwEnabled = true
 		}
@@ -2849,11 +3057,11 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 
 		if singleAZ {
-			log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, "Enable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 
 			err := client.EnableSingleAZGateway(singleAZGateway)
 			if err != nil {
-				return fmt.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
+				return diag.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
 			}
 
 			if haEnabled {
@@ -2862,14 +3070,14 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				}
 				err := client.EnableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
-					return fmt.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
+					return diag.Errorf("failed to enable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
 				}
 			}
 		} else {
-			log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, "Disable Single AZ GW HA", map[string]interface{}{"gw_name": singleAZGateway.GwName})
 			err := client.DisableSingleAZGateway(singleAZGateway)
 			if err != nil {
-				return fmt.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
+				return diag.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGateway.GwName, err)
 			}
 
 			if haEnabled {
@@ -2878,15 +3086,15 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				}
 				err := client.DisableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
-					return fmt.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
+					return diag.Errorf("failed to disable single AZ GW HA for %s: %s", singleAZGatewayHA.GwName, err)
 				}
 			}
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("failed to update transit gateway: adding tags is only supported for AWS (1), Azure (8), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
+			return diag.Errorf("failed to update transit gateway: adding tags is only supported for AWS (1), Azure (8), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
 		tags := &goaviatrix.Tags{
 			ResourceType: "gw",
@@ -2894,20 +3102,20 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			CloudType:    gateway.CloudType,
 		}
 
-		if d.HasChange("tags") {
-			tagsMap, err := extractTags(d, gateway.CloudType)
+		if d.HasChanges("tags", "tags_all") {
+			tagsMap, err := extractTags(d, gateway.CloudType, client.GetDefaultTagsConfig())
 			if err != nil {
-				return fmt.Errorf("failed to update tags for transit gateway: %w", err)
+				return diag.Errorf("failed to update tags for transit gateway: %v", err)
 			}
 			tags.Tags = tagsMap
 			tagJson, err := TagsMapToJson(tagsMap)
 			if err != nil {
-				return fmt.Errorf("failed to update tags for transit gateway: %w", err)
+				return diag.Errorf("failed to update tags for transit gateway: %v", err)
 			}
 			tags.TagJson = tagJson
 			err = client.UpdateTags(tags)
 			if err != nil {
-				return fmt.Errorf("failed to update tags for transit gateway: %w", err)
+				return diag.Errorf("failed to update tags for transit gateway: %v", err)
 			}
 		}
 	}
@@ -2924,12 +3132,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if connectedTransit {
 			err := client.EnableConnectedTransit(transitGateway)
 			if err != nil {
-				return fmt.Errorf("failed to enable connected transit: %s", err)
+				return diag.Errorf("failed to enable connected transit: %s", err)
 			}
 		} else {
 			err := client.DisableConnectedTransit(transitGateway)
 			if err != nil {
-				return fmt.Errorf("failed to disable connected transit: %s", err)
+				return diag.Errorf("failed to disable connected transit: %s", err)
 			}
 		}
 	}
@@ -2940,9 +3148,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			old, _ := d.GetChange("gw_size")
 			primaryGwSize = old.(string)
 			gateway.VpcSize = d.Get("gw_size").(string)
-			err := client.UpdateGateway(gateway)
+			err := client.UpdateGatewayContext(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("failed to update Aviatrix Transit Gateway: %s", err)
+				return diag.Errorf("failed to update Aviatrix Transit Gateway: %s", err)
 			}
 		}
 
@@ -2954,22 +3162,22 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				// OR
 				// newly configured Ha gateway is set to be different size than primary gateway
 				// (when ha gateway is enabled, it's size is by default the same as primary gateway)
-				_, err := client.GetGateway(haGateway)
+				_, err := client.GetGatewayContext(ctx, haGateway)
 				if err != nil {
 					// If HA gateway does not exist, don't try to change HA gateway size and continue with the rest of the updates
 					// to the gateway
 					if err != goaviatrix.ErrNotFound {
-						return fmt.Errorf("couldn't find Aviatrix Transit HA Gateway while trying to update HA Gw size: %s", err)
+						return diag.Errorf("couldn't find Aviatrix Transit HA Gateway while trying to update HA Gw size: %s", err)
 					}
 				} else {
 					if haGateway.VpcSize == "" {
-						return fmt.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
+						return diag.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
 							"ha_subnet or ha_zone is set")
 					}
-					err = client.UpdateGateway(haGateway)
-					log.Printf("[INFO] Updating HA Gateway size to: %s ", haGateway.VpcSize)
+					err = client.UpdateGatewayContext(ctx, haGateway)
+					tflog.Info(ctx, "Updating HA Gateway size", map[string]interface{}{"vpc_size": haGateway.VpcSize})
 					if err != nil {
-						return fmt.Errorf("failed to update Aviatrix Transit HA Gateway size: %s", err)
+						return diag.Errorf("failed to update Aviatrix Transit HA Gateway size: %s", err)
 					}
 				}
 			}
@@ -2986,12 +3194,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if enableNat {
 			err := client.EnableSNat(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable 'single_ip' mode SNAT feature: %s", err)
+				return diag.Errorf("failed to enable 'single_ip' mode SNAT feature: %s", err)
 			}
 		} else {
 			err := client.DisableSNat(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable 'single_ip' mode SNAT: %s", err)
+				return diag.Errorf("failed to disable 'single_ip' mode SNAT: %s", err)
 			}
 		}
 
@@ -3001,27 +3209,27 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		// get wan count from interfaces
 		interfaceList, ok := d.Get("interfaces").([]interface{})
 		if !ok {
-			return fmt.Errorf("invalid interfaces for EAT gateway")
+			return diag.Errorf("invalid interfaces for EAT gateway")
 		}
 		wanCount, err := countInterfaceTypes(interfaceList)
 		if err != nil {
-			return fmt.Errorf("failed to get wan interface count: %w", err)
+			return diag.Errorf("failed to get wan interface count: %v", err)
 		}
 		// get the cloud type
 		cloudType, ok := d.Get("cloud_type").(int)
 		if !ok {
-			return fmt.Errorf("failed to get cloud type for EAT gateway")
+			return diag.Errorf("failed to get cloud type for EAT gateway")
 		}
 
 		// update the edge transit gateway interfaces
 		if d.HasChanges("interfaces", "management_egress_ip_prefix_list") {
 			interfaceList, ok := d.Get("interfaces").([]interface{})
 			if !ok {
-				return fmt.Errorf("invalid interfaces for EAT gateway")
+				return diag.Errorf("invalid interfaces for EAT gateway")
 			}
 			interfaces, err := getInterfaceDetails(interfaceList, cloudType)
 			if err != nil {
-				return fmt.Errorf("failed to get interface details: %s", err)
+				return diag.Errorf("failed to get interface details: %s", err)
 			}
 
 			gateway := &goaviatrix.TransitVpc{
@@ -3033,32 +3241,32 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			if len(managementEgressIPPrefixList) > 0 {
 				gateway.ManagementEgressIPPrefix = strings.Join(managementEgressIPPrefixList, ",")
 			}
-			err = client.UpdateEdgeGateway(gateway)
+			err = client.UpdateEdgeGatewayContext(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("failed to update edge gateway: %s", err)
+				return diag.Errorf("failed to update edge gateway: %s", err)
 			}
 		}
 
 		// updating device id for edge transit gateway is not supported
 		if d.HasChanges("device_id") {
-			return fmt.Errorf("updating device_id is not supported for edge transit gateway")
+			return diag.Errorf("updating device_id is not supported for edge transit gateway")
 		}
 
 		// updating gw_size for edge transit gateway is not supported
 		if d.HasChange("gw_size") {
-			return fmt.Errorf("updating gw_size is not supported for edge transit gateway")
+			return diag.Errorf("updating gw_size is not supported for edge transit gateway")
 		}
 
 		// update eip mapping for edge transit gateway
 		if d.HasChange("eip_map") {
 			eipMap, ok := d.Get("eip_map").([]interface{})
 			if !ok {
-				return fmt.Errorf("failed to get eip_map detail for Edge Transit Gateway")
+				return diag.Errorf("failed to get eip_map detail for Edge Transit Gateway")
 			}
 			if len(eipMap) > 0 {
 				eipMapList, err := getEipMapDetails(eipMap, wanCount, cloudType)
 				if err != nil {
-					return fmt.Errorf("failed to get the eip map details: %w", err)
+					return diag.Errorf("failed to get the eip map details: %v", err)
 				}
 				gateway := &goaviatrix.TransitVpc{
 					GwName: d.Get("gw_name").(string),
@@ -3066,29 +3274,29 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 
 				if cloudType == goaviatrix.EDGEMEGAPORT {
 					// print eip map for edge mega port
-					log.Printf("[INFO] EIP Map for Edge Mega Port: %#v", eipMapList)
+					tflog.Info(ctx, "EIP Map for Edge Mega Port")
 					gateway.LogicalEipMap = eipMapList
 					gateway.CloudType = cloudType
-					ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+					ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 					defer cancel()
 					err = client.UpdateEdgeGatewayV2(ctx, gateway)
 					if err != nil {
-						return fmt.Errorf("failed to update logical eip map for edge gateway: %w", err)
+						return diag.Errorf("failed to update logical eip map for edge gateway: %v", err)
 					}
 				} else {
 					eipMapJSON, err := json.Marshal(eipMapList)
 					if err != nil {
-						return fmt.Errorf("failed to marshal eip_map to JSON: %w", err)
+						return diag.Errorf("failed to marshal eip_map to JSON: %v", err)
 					}
 					gateway.EipMap = string(eipMapJSON)
-					err = client.UpdateEdgeGateway(gateway)
+					err = client.UpdateEdgeGatewayContext(ctx, gateway)
 					if err != nil {
-						return fmt.Errorf("failed to update eip map for edge gateway: %w", err)
+						return diag.Errorf("failed to update eip map for edge gateway: %v", err)
 					}
 				}
 
 				if err != nil {
-					return fmt.Errorf("failed to update edge gateway: %s", err)
+					return diag.Errorf("failed to update edge gateway: %s", err)
 				}
 			}
 		}
@@ -3099,20 +3307,20 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				AccountName: d.Get("account_name").(string),
 				GwName:      d.Get("gw_name").(string) + "-hagw",
 			}
-			resultHaGw, err := client.GetGateway(haGateway)
+			resultHaGw, err := client.GetGatewayContext(ctx, haGateway)
 			if err == nil && resultHaGw != nil {
-				return fmt.Errorf("cannot update the backup link info for edge transit gateway when HA gateway already exists")
+				return diag.Errorf("cannot update the backup link info for edge transit gateway when HA gateway already exists")
 			}
 			cloudType := d.Get("cloud_type").(int)
 			// create transit ha gw with backup link info
 			transitHaGw, err := getTransitHaGatewayDetails(d, wanCount, cloudType)
 			if err != nil {
-				return fmt.Errorf("failed to get transit ha gateway details: %w", err)
+				return diag.Errorf("failed to get transit ha gateway details: %v", err)
 			}
-			log.Printf("[INFO] Enabling HA on Transit Gateway")
-			_, err = client.CreateTransitHaGw(transitHaGw)
+			tflog.Info(ctx, "Enabling HA on Transit Gateway")
+			_, err = client.CreateTransitHaGwContext(ctx, transitHaGw)
 			if err != nil {
-				return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
+				return diag.Errorf("failed to enable HA Aviatrix Transit Gateway: %s", err)
 			}
 		}
 
@@ -3120,12 +3328,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if d.HasChanges("ha_interfaces", "ha_management_egress_ip_prefix_list") {
 			haInterfaceList, ok := d.Get("ha_interfaces").([]interface{})
 			if !ok {
-				return fmt.Errorf("invalid ha_interfaces for EAT HA gateway")
+				return diag.Errorf("invalid ha_interfaces for EAT HA gateway")
 			}
 			if len(haInterfaceList) > 0 {
 				haInterfaces, err := getInterfaceDetails(haInterfaceList, cloudType)
 				if err != nil {
-					return fmt.Errorf("failed to get interface details: %s", err)
+					return diag.Errorf("failed to get interface details: %s", err)
 				}
 				gateway := &goaviatrix.TransitVpc{
 					GwName:     d.Get("gw_name").(string) + "-hagw",
@@ -3135,15 +3343,15 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				if len(haManagementEgressIPPrefixList) > 0 {
 					gateway.ManagementEgressIPPrefix = strings.Join(haManagementEgressIPPrefixList, ",")
 				}
-				err = client.UpdateEdgeGateway(gateway)
+				err = client.UpdateEdgeGatewayContext(ctx, gateway)
 				if err != nil {
-					return fmt.Errorf("failed to update edge gateway: %s", err)
+					return diag.Errorf("failed to update edge gateway: %s", err)
 				}
 			} else {
 				// delete the HA gateway if ha_interfaces is empty
-				err := client.DeleteGateway(haGateway)
+				err := client.DeleteGatewayContext(ctx, haGateway)
 				if err != nil {
-					return fmt.Errorf("failed to delete HA gateway: %s", err)
+					return diag.Errorf("failed to delete HA gateway: %s", err)
 				}
 			}
 		}
@@ -3162,18 +3370,18 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			if enableHybridConnection {
 				err := client.AttachTransitGWForHybrid(transitGateway)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit GW for Hybrid: %s", err)
+					return diag.Errorf("failed to enable transit GW for Hybrid: %s", err)
 				}
 			} else {
 				err := client.DetachTransitGWForHybrid(transitGateway)
 				if err != nil {
-					return fmt.Errorf("failed to disable transit GW for Hybrid: %s", err)
+					return diag.Errorf("failed to disable transit GW for Hybrid: %s", err)
 				}
 			}
 		}
 	} else {
 		if d.HasChange("enable_hybrid_connection") {
-			return fmt.Errorf("'enable_hybrid_connection' is only supported for AWS/AWSGov providers")
+			return diag.Errorf("'enable_hybrid_connection' is only supported for AWS/AWSGov providers")
 		}
 	}
 
@@ -3187,34 +3395,34 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			if learnedCidrsApproval {
 				err := client.EnableTransitLearnedCidrsApproval(gw)
 				if err != nil {
-					return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+					return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 				}
 			} else {
 				err := client.DisableTransitLearnedCidrsApproval(gw)
 				if err != nil {
-					return fmt.Errorf("failed to disable learned cidrs approval: %s", err)
+					return diag.Errorf("failed to disable learned cidrs approval: %s", err)
 				}
 			}
 			mode := d.Get("learned_cidrs_approval_mode").(string)
 			err := client.SetTransitLearnedCIDRsApprovalMode(gw, mode)
 			if err != nil {
-				return fmt.Errorf("could not set learned CIDRs approval mode to %q: %v", mode, err)
+				return diag.Errorf("could not set learned CIDRs approval mode to %q: %v", mode, err)
 			}
 		} else {
 			mode := d.Get("learned_cidrs_approval_mode").(string)
 			err := client.SetTransitLearnedCIDRsApprovalMode(gw, mode)
 			if err != nil {
-				return fmt.Errorf("could not set learned CIDRs approval mode to %q: %v", mode, err)
+				return diag.Errorf("could not set learned CIDRs approval mode to %q: %v", mode, err)
 			}
 			if learnedCidrsApproval {
 				err = client.EnableTransitLearnedCidrsApproval(gw)
 				if err != nil {
-					return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+					return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 				}
 			} else {
 				err = client.DisableTransitLearnedCidrsApproval(gw)
 				if err != nil {
-					return fmt.Errorf("failed to disable learned cidrs approval: %s", err)
+					return diag.Errorf("failed to disable learned cidrs approval: %s", err)
 				}
 			}
 		}
@@ -3225,7 +3433,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		mode := d.Get("learned_cidrs_approval_mode").(string)
 		err := client.SetTransitLearnedCIDRsApprovalMode(gw, mode)
 		if err != nil {
-			return fmt.Errorf("could not set learned CIDRs approval mode to %q: %v", mode, err)
+			return diag.Errorf("could not set learned CIDRs approval mode to %q: %v", mode, err)
 		}
 	} else if d.HasChange("enable_learned_cidrs_approval") {
 		gw := &goaviatrix.TransitVpc{
@@ -3235,13 +3443,13 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			gw.LearnedCidrsApproval = "yes"
 			err := client.EnableTransitLearnedCidrsApproval(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to enable learned cidrs approval: %s", err)
 			}
 		} else {
 			gw.LearnedCidrsApproval = "no"
 			err := client.DisableTransitLearnedCidrsApproval(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable learned cidrs approval: %s", err)
+				return diag.Errorf("failed to disable learned cidrs approval: %s", err)
 			}
 		}
 	}
@@ -3254,7 +3462,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 
 		err := client.UpdateTransitPendingApprovedCidrs(gw)
 		if err != nil {
-			return fmt.Errorf("could not update approved CIDRs: %w", err)
+			return diag.Errorf("could not update approved CIDRs: %v", err)
 		}
 	}
 
@@ -3262,13 +3470,13 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 	enableGatewayLoadBalancer := d.Get("enable_gateway_load_balancer").(bool)
 	enableTransitFireNet := d.Get("enable_transit_firenet").(bool)
 	if enableGatewayLoadBalancer && !enableFireNet && !enableTransitFireNet {
-		return fmt.Errorf("'enable_gateway_load_balancer' is only valid when 'enable_firenet' or 'enable_transit_firenet' is set to true")
+		return diag.Errorf("'enable_gateway_load_balancer' is only valid when 'enable_firenet' or 'enable_transit_firenet' is set to true")
 	}
 	if enableGatewayLoadBalancer && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWS) {
-		return fmt.Errorf("'enable_gateway_load_balancer' is only valid when 'cloud_type' = 1 (AWS)")
+		return diag.Errorf("'enable_gateway_load_balancer' is only valid when 'cloud_type' = 1 (AWS)")
 	}
 	if enableFireNet && enableTransitFireNet {
-		return fmt.Errorf("can't enable firenet function and transit firenet function at the same time")
+		return diag.Errorf("can't enable firenet function and transit firenet function at the same time")
 	}
 
 	if d.HasChange("enable_egress_transit_firenet") {
@@ -3276,7 +3484,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if !enableEgressTransitFirenet {
 			err := client.DisableEgressTransitFirenet(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not disable egress transit firenet: %w", err)
+				return diag.Errorf("could not disable egress transit firenet: %v", err)
 			}
 		}
 	}
@@ -3286,12 +3494,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if !enableTransitPreserveAsPath {
 			err := client.DisableTransitPreserveAsPath(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not disable Preserve AS Path during Transit Gateway update: %w", err)
+				return diag.Errorf("could not disable Preserve AS Path during Transit Gateway update: %v", err)
 			}
 		} else {
 			err := client.EnableTransitPreserveAsPath(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not enable Preserve AS Path during Transit Gateway update: %w", err)
+				return diag.Errorf("could not enable Preserve AS Path during Transit Gateway update: %v", err)
 			}
 		}
 	}
@@ -3304,7 +3512,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if !enableFireNet {
 			err := client.DisableGatewayFireNetInterfaces(transitGW)
 			if err != nil {
-				return fmt.Errorf("failed to disable transit GW for FireNet Interfaces: %s", err)
+				return diag.Errorf("failed to disable transit GW for FireNet Interfaces: %s", err)
 			}
 		}
 		if !enableTransitFireNet {
@@ -3313,19 +3521,19 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			}
 			err := client.DisableTransitFireNet(gwTransitFireNet)
 			if err != nil {
-				return fmt.Errorf("failed to disable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
+				return diag.Errorf("failed to disable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
 			}
 		}
 		if enableFireNet {
 			if enableGatewayLoadBalancer {
 				err := client.EnableGatewayFireNetInterfacesWithGWLB(transitGW)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit GW for FireNet Interfaces with Gateway Load Balancer enabled: %s", err)
+					return diag.Errorf("failed to enable transit GW for FireNet Interfaces with Gateway Load Balancer enabled: %s", err)
 				}
 			} else {
 				err := client.EnableGatewayFireNetInterfaces(transitGW)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit GW for FireNet Interfaces: %s", err)
+					return diag.Errorf("failed to enable transit GW for FireNet Interfaces: %s", err)
 				}
 			}
 		}
@@ -3336,12 +3544,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			if enableGatewayLoadBalancer {
 				err := client.EnableTransitFireNetWithGWLB(gwTransitFireNet)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit firenet with Gateway Load Balancer for %s due to %s", gwTransitFireNet.GwName, err)
+					return diag.Errorf("failed to enable transit firenet with Gateway Load Balancer for %s due to %s", gwTransitFireNet.GwName, err)
 				}
 			} else {
 				err := client.EnableTransitFireNet(gwTransitFireNet)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
+					return diag.Errorf("failed to enable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
 				}
 			}
 		}
@@ -3354,18 +3562,18 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			if enableGatewayLoadBalancer {
 				err := client.EnableGatewayFireNetInterfacesWithGWLB(transitGW)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit GW for FireNet Interfaces with Gateway Load Balancer enabled: %s", err)
+					return diag.Errorf("failed to enable transit GW for FireNet Interfaces with Gateway Load Balancer enabled: %s", err)
 				}
 			} else {
 				err := client.EnableGatewayFireNetInterfaces(transitGW)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit GW for FireNet Interfaces: %s", err)
+					return diag.Errorf("failed to enable transit GW for FireNet Interfaces: %s", err)
 				}
 			}
 		} else {
 			err := client.DisableGatewayFireNetInterfaces(transitGW)
 			if err != nil {
-				return fmt.Errorf("failed to disable transit GW for FireNet Interfaces: %s", err)
+				return diag.Errorf("failed to disable transit GW for FireNet Interfaces: %s", err)
 			}
 		}
 	} else if d.HasChange("enable_transit_firenet") {
@@ -3376,12 +3584,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			if enableGatewayLoadBalancer {
 				err := client.EnableTransitFireNetWithGWLB(gwTransitFireNet)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit firenet with Gateway Load Balancer for %s due to %s", gwTransitFireNet.GwName, err)
+					return diag.Errorf("failed to enable transit firenet with Gateway Load Balancer for %s due to %s", gwTransitFireNet.GwName, err)
 				}
 			} else {
 				err := client.EnableTransitFireNet(gwTransitFireNet)
 				if err != nil {
-					return fmt.Errorf("failed to enable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
+					return diag.Errorf("failed to enable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
 				}
 			}
 		} else {
@@ -3390,7 +3598,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			}
 			err := client.DisableTransitFireNet(gwTransitFireNet)
 			if err != nil {
-				return fmt.Errorf("failed to disable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
+				return diag.Errorf("failed to disable transit firenet for %s due to %s", gwTransitFireNet.GwName, err)
 			}
 		}
 	} else if d.HasChange("enable_gateway_load_balancer") {
@@ -3398,11 +3606,11 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		// Due to the backend design it is not possible to disable or enable 'enable_gateway_load_balancer' without
 		// also disabling or enabling FireNet, so we force the user to disable or enable both at the same time.
 		if enableGatewayLoadBalancer {
-			return fmt.Errorf("can not enable 'enable_gateway_load_balancer' when 'enable_firenet' or 'enable_transit_firenet' is " +
+			return diag.Errorf("can not enable 'enable_gateway_load_balancer' when 'enable_firenet' or 'enable_transit_firenet' is " +
 				"already enabled. Changing from non-GWLB FireNet to GWLB FireNet requires 2 separate " +
 				"`terraform apply` steps, once to disable non-GWLB FireNet, then again to enable GWLB FireNet")
 		} else {
-			return fmt.Errorf("can not disable 'enable_gateway_load_balancer' when 'enable_firenet' or 'enable_transit_firenet' is " +
+			return diag.Errorf("can not disable 'enable_gateway_load_balancer' when 'enable_firenet' or 'enable_transit_firenet' is " +
 				"still enabled. Changing from GWLB FireNet to non-GWLB FireNet requires 2 separate " +
 				"`terraform apply` steps, once to disable GWLB FireNet, then again to enable non-GWLB FireNet")
 		}
@@ -3414,9 +3622,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			old, _ := d.GetChange("gw_size")
 			primaryGwSize = old.(string)
 			gateway.VpcSize = d.Get("gw_size").(string)
-			err := client.UpdateGateway(gateway)
+			err := client.UpdateGatewayContext(ctx, gateway)
 			if err != nil {
-				return fmt.Errorf("failed to update Aviatrix Transit Gateway: %s", err)
+				return diag.Errorf("failed to update Aviatrix Transit Gateway: %s", err)
 			}
 		}
 
@@ -3428,22 +3636,22 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				// OR
 				// newly configured Ha gateway is set to be different size than primary gateway
 				// (when ha gateway is enabled, it's size is by default the same as primary gateway)
-				_, err := client.GetGateway(haGateway)
+				_, err := client.GetGatewayContext(ctx, haGateway)
 				if err != nil {
 					// If HA gateway does not exist, don't try to change gateway size and continue with the rest of the updates
 					// to the gateway
 					if err != goaviatrix.ErrNotFound {
-						return fmt.Errorf("couldn't find Aviatrix Transit HA Gateway while trying to update HA Gw size: %s", err)
+						return diag.Errorf("couldn't find Aviatrix Transit HA Gateway while trying to update HA Gw size: %s", err)
 					}
 				} else {
 					if haGateway.VpcSize == "" {
-						return fmt.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
+						return diag.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
 							"ha_subnet or ha_zone is set")
 					}
-					err = client.UpdateGateway(haGateway)
-					log.Printf("[INFO] Updating HA Gateway size to: %s ", haGateway.VpcSize)
+					err = client.UpdateGatewayContext(ctx, haGateway)
+					tflog.Info(ctx, "Updating HA Gateway size", map[string]interface{}{"vpc_size": haGateway.VpcSize})
 					if err != nil {
-						return fmt.Errorf("failed to update Aviatrix Transit HA Gateway size: %s", err)
+						return diag.Errorf("failed to update Aviatrix Transit HA Gateway size: %s", err)
 					}
 				}
 			}
@@ -3455,7 +3663,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if enableEgressTransitFirenet {
 			err := client.EnableEgressTransitFirenet(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 			if err != nil {
-				return fmt.Errorf("could not enable egress transit firenet: %w", err)
+				return diag.Errorf("could not enable egress transit firenet: %v", err)
 			}
 		}
 	}
@@ -3470,16 +3678,16 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if enableVpcDnsServer {
 			err := client.EnableVpcDnsServer(gw)
 			if err != nil {
-				return fmt.Errorf("failed to enable VPC DNS Server: %s", err)
+				return diag.Errorf("failed to enable VPC DNS Server: %s", err)
 			}
 		} else {
 			err := client.DisableVpcDnsServer(gw)
 			if err != nil {
-				return fmt.Errorf("failed to disable VPC DNS Server: %s", err)
+				return diag.Errorf("failed to disable VPC DNS Server: %s", err)
 			}
 		}
 	} else if d.HasChange("enable_vpc_dns_server") {
-		return fmt.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) and AWS Secret (32768)")
+		return diag.Errorf("'enable_vpc_dns_server' only supported by AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384) and AWS Secret (32768)")
 	}
 
 	if d.HasChange("enable_advertise_transit_cidr") {
@@ -3491,13 +3699,13 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			transitGw.EnableAdvertiseTransitCidr = true
 			err := client.EnableAdvertiseTransitCidr(transitGw)
 			if err != nil {
-				return fmt.Errorf("failed to enable advertise transit CIDR: %s", err)
+				return diag.Errorf("failed to enable advertise transit CIDR: %s", err)
 			}
 		} else {
 			transitGw.EnableAdvertiseTransitCidr = false
 			err := client.DisableAdvertiseTransitCidr(transitGw)
 			if err != nil {
-				return fmt.Errorf("failed to disable advertise transit CIDR: %s", err)
+				return diag.Errorf("failed to disable advertise transit CIDR: %s", err)
 			}
 		}
 	}
@@ -3510,14 +3718,14 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		transitGw.BgpManualSpokeAdvertiseCidrs = bgpManualSpokeAdvertiseCidrs
 		err := client.SetBgpManualSpokeAdvertisedNetworks(transitGw)
 		if err != nil {
-			return fmt.Errorf("failed to set bgp manual spoke advertise CIDRs: %s", err)
+			return diag.Errorf("failed to set bgp manual spoke advertise CIDRs: %s", err)
 		}
 	}
 
 	if d.HasChange("enable_encrypt_volume") {
 		if d.Get("enable_encrypt_volume").(bool) {
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("'enable_encrypt_volume' is only supported by AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+				return diag.Errorf("'enable_encrypt_volume' is only supported by AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
 			}
 			gwEncVolume := &goaviatrix.Gateway{
 				GwName:              d.Get("gw_name").(string),
@@ -3525,7 +3733,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			}
 			err := client.EnableEncryptVolume(gwEncVolume)
 			if err != nil {
-				return fmt.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwEncVolume.GwName, err)
+				return diag.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwEncVolume.GwName, err)
 			}
 
 			haSubnet := d.Get("ha_subnet").(string)
@@ -3538,14 +3746,14 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				}
 				err := client.EnableEncryptVolume(gwHAEncVolume)
 				if err != nil {
-					return fmt.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwHAEncVolume.GwName, err)
+					return diag.Errorf("failed to enable encrypt gateway volume for %s due to %s", gwHAEncVolume.GwName, err)
 				}
 			}
 		} else {
-			return fmt.Errorf("can't disable Encrypt Volume for gateway: %s", gateway.GwName)
+			return diag.Errorf("can't disable Encrypt Volume for gateway: %s", gateway.GwName)
 		}
 	} else if d.HasChange("customer_managed_keys") {
-		return fmt.Errorf("updating customer_managed_keys only is not allowed")
+		return diag.Errorf("updating customer_managed_keys only is not allowed")
 	}
 
 	if d.HasChange("customized_spoke_vpc_routes") {
@@ -3558,9 +3766,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				CustomizedSpokeVpcRoutes: newRouteList,
 			}
 			err := client.EditGatewayCustomRoutes(transitGateway)
-			log.Printf("[INFO] Customizeing routes of transit gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, "Customizing routes of transit gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			if err != nil {
-				return fmt.Errorf("failed to customize spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to customize spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -3575,9 +3783,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				FilteredSpokeVpcRoutes: newRouteList,
 			}
 			err := client.EditGatewayFilterRoutes(transitGateway)
-			log.Printf("[INFO] Editing filtered spoke vpc routes of transit gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, "Editing filtered spoke vpc routes of transit gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			if err != nil {
-				return fmt.Errorf("failed to edit filtered spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit filtered spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -3592,9 +3800,9 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 				AdvertisedSpokeRoutes: newRouteList,
 			}
 			err := client.EditGatewayAdvertisedCidr(transitGateway)
-			log.Printf("[INFO] Editing excluded advertised spoke vpc routes of transit gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, "Editing excluded advertised spoke vpc routes of transit gateway", map[string]interface{}{"gw_name": transitGateway.GwName})
 			if err != nil {
-				return fmt.Errorf("failed to edit excluded advertised spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
+				return diag.Errorf("failed to edit excluded advertised spoke vpc routes of transit gateway: %s due to: %s", transitGateway.GwName, err)
 			}
 		}
 	}
@@ -3606,7 +3814,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 		err := client.SetBgpPollingTime(gateway, bgpPollingTime)
 		if err != nil {
-			return fmt.Errorf("could not update bgp polling time: %w", err)
+			return diag.Errorf("could not update bgp polling time: %v", err)
 		}
 	}
 
@@ -3617,7 +3825,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 		err := client.SetBgpBfdPollingTime(gateway, bgpBfdPollingTime)
 		if err != nil {
-			return fmt.Errorf("could not update bgp neighbor status polling time: %w", err)
+			return diag.Errorf("could not update bgp neighbor status polling time: %v", err)
 		}
 	}
 
@@ -3635,7 +3843,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			// Handle the case where prependASPath is empty here so that the API is not called twice
 			err := client.SetPrependASPath(gateway, nil)
 			if err != nil {
-				return fmt.Errorf("could not delete prepend_as_path during Transit Gateway update: %w", err)
+				return diag.Errorf("could not delete prepend_as_path during Transit Gateway update: %v", err)
 			}
 		}
 
@@ -3643,14 +3851,14 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			localAsNumber := d.Get("local_as_number").(string)
 			err := client.SetLocalASNumber(gateway, localAsNumber)
 			if err != nil {
-				return fmt.Errorf("could not set local_as_number during Transit Gateway update: %w", err)
+				return diag.Errorf("could not set local_as_number during Transit Gateway update: %v", err)
 			}
 		}
 
 		if d.HasChange("prepend_as_path") && len(prependASPath) > 0 {
 			err := client.SetPrependASPath(gateway, prependASPath)
 			if err != nil {
-				return fmt.Errorf("could not set prepend_as_path during Transit Gateway update: %w", err)
+				return diag.Errorf("could not set prepend_as_path during Transit Gateway update: %v", err)
 			}
 		}
 	}
@@ -3662,7 +3870,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 		err := client.SetBgpEcmp(gateway, enabled)
 		if err != nil {
-			return fmt.Errorf("could not set bgp_ecmp: %w", err)
+			return diag.Errorf("could not set bgp_ecmp: %v", err)
 		}
 	}
 
@@ -3673,11 +3881,11 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 		if enabled {
 			if err := client.EnableSegmentation(gateway); err != nil {
-				return fmt.Errorf("could not enable segmentation: %w", err)
+				return diag.Errorf("could not enable segmentation: %v", err)
 			}
 		} else {
 			if err := client.DisableSegmentation(gateway); err != nil {
-				return fmt.Errorf("could not disable segmentation: %w", err)
+				return diag.Errorf("could not disable segmentation: %v", err)
 			}
 		}
 	}
@@ -3689,19 +3897,19 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if d.Get("enable_active_standby").(bool) {
 			if d.Get("enable_active_standby_preemptive").(bool) {
 				if err := client.EnableActiveStandbyPreemptive(gateway); err != nil {
-					return fmt.Errorf("could not enable Preemptive Mode for Active-Standby during Transit Gateway update: %w", err)
+					return diag.Errorf("could not enable Preemptive Mode for Active-Standby during Transit Gateway update: %v", err)
 				}
 			} else {
 				if err := client.EnableActiveStandby(gateway); err != nil {
-					return fmt.Errorf("could not enable Active-Standby during Transit Gateway update: %w", err)
+					return diag.Errorf("could not enable Active-Standby during Transit Gateway update: %v", err)
 				}
 			}
 		} else {
 			if d.Get("enable_active_standby_preemptive").(bool) {
-				return fmt.Errorf("could not enable Preemptive Mode with Active-Standby disabled")
+				return diag.Errorf("could not enable Preemptive Mode with Active-Standby disabled")
 			}
 			if err := client.DisableActiveStandby(gateway); err != nil {
-				return fmt.Errorf("could not disable Active-Standby during Transit Gateway update: %w", err)
+				return diag.Errorf("could not disable Active-Standby during Transit Gateway update: %v", err)
 			}
 		}
 	}
@@ -3714,7 +3922,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 
 		err := client.UpdateTransitGatewayCustomizedVpcRoute(gateway.GwName, customizedTransitVpcRoutes)
 		if err != nil {
-			return fmt.Errorf("couldn't update transit gateway customized vpc route: %s", err)
+			return diag.Errorf("couldn't update transit gateway customized vpc route: %s", err)
 		}
 	}
 
@@ -3724,28 +3932,28 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		excludedInstances = append(excludedInstances, v.(string))
 	}
 	if !monitorGatewaySubnets && len(excludedInstances) != 0 {
-		return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
+		return diag.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
 	}
 	if d.HasChange("enable_monitor_gateway_subnets") {
 		if monitorGatewaySubnets {
 			err := client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
 			if err != nil {
-				return fmt.Errorf("could not enable monitor gateway subnets: %w", err)
+				return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 			}
 		} else {
 			err := client.DisableMonitorGatewaySubnets(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not disable monitor gateway subnets: %w", err)
+				return diag.Errorf("could not disable monitor gateway subnets: %v", err)
 			}
 		}
 	} else if d.HasChange("monitor_exclude_list") {
 		err := client.DisableMonitorGatewaySubnets(gateway.GwName)
 		if err != nil {
-			return fmt.Errorf("could not disable monitor gateway subnets: %w", err)
+			return diag.Errorf("could not disable monitor gateway subnets: %v", err)
 		}
 		err = client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
 		if err != nil {
-			return fmt.Errorf("could not enable monitor gateway subnets: %w", err)
+			return diag.Errorf("could not enable monitor gateway subnets: %v", err)
 		}
 	}
 
@@ -3753,12 +3961,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if d.Get("enable_jumbo_frame").(bool) {
 			err := client.EnableJumboFrame(gateway)
 			if err != nil {
-				return fmt.Errorf("could not enable jumbo frame for transit gateway when updating: %w", err)
+				return diag.Errorf("could not enable jumbo frame for transit gateway when updating: %v", err)
 			}
 		} else {
 			err := client.DisableJumboFrame(gateway)
 			if err != nil {
-				return fmt.Errorf("could not disable jumbo frame for transit gateway when updating: %w", err)
+				return diag.Errorf("could not disable jumbo frame for transit gateway when updating: %v", err)
 			}
 		}
 	}
@@ -3767,12 +3975,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if d.Get("enable_gro_gso").(bool) {
 			err := client.EnableGroGso(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't enable GRO/GSO on transit gateway when updating: %s", err)
+				return diag.Errorf("couldn't enable GRO/GSO on transit gateway when updating: %s", err)
 			}
 		} else {
 			err := client.DisableGroGso(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't disable GRO/GSO on transit gateway when updating: %s", err)
+				return diag.Errorf("couldn't disable GRO/GSO on transit gateway when updating: %s", err)
 			}
 		}
 	}
@@ -3780,7 +3988,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange("bgp_hold_time") {
 		err := client.ChangeBgpHoldTime(gateway.GwName, d.Get("bgp_hold_time").(int))
 		if err != nil {
-			return fmt.Errorf("could not change BGP Hold Time during Transit Gateway update: %w", err)
+			return diag.Errorf("could not change BGP Hold Time during Transit Gateway update: %v", err)
 		}
 	}
 
@@ -3788,12 +3996,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if d.Get("enable_transit_summarize_cidr_to_tgw").(bool) {
 			err := client.EnableSummarizeCidrToTgw(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not enable summarize cidr to tgw when updating: %w", err)
+				return diag.Errorf("could not enable summarize cidr to tgw when updating: %v", err)
 			}
 		} else {
 			err := client.DisableSummarizeCidrToTgw(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not disable summarize cidr to tgw when updating: %w", err)
+				return diag.Errorf("could not disable summarize cidr to tgw when updating: %v", err)
 			}
 		}
 	}
@@ -3801,16 +4009,16 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange("enable_multi_tier_transit") {
 		if d.Get("enable_multi_tier_transit").(bool) {
 			if d.Get("local_as_number") == "" {
-				return fmt.Errorf("local_as_number required to enable multi tier transit")
+				return diag.Errorf("local_as_number required to enable multi tier transit")
 			}
 			err := client.EnableMultitierTransit(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not enable multi tier transit when updating: %w", err)
+				return diag.Errorf("could not enable multi tier transit when updating: %v", err)
 			}
 		} else {
 			err := client.DisableMultitierTransit(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not disable multi tier transit when updating: %w", err)
+				return diag.Errorf("could not disable multi tier transit when updating: %v", err)
 			}
 		}
 	}
@@ -3819,12 +4027,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if d.Get("enable_s2c_rx_balancing").(bool) {
 			err := client.EnableS2CRxBalancing(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not enable S2C receive packet CPU re-balancing during Transit Gateway update: %w", err)
+				return diag.Errorf("could not enable S2C receive packet CPU re-balancing during Transit Gateway update: %v", err)
 			}
 		} else {
 			err := client.DisableS2CRxBalancing(gateway.GwName)
 			if err != nil {
-				return fmt.Errorf("could not disable S2C receive packet CPU re-balancing during Transit Gateway update: %w", err)
+				return diag.Errorf("could not disable S2C receive packet CPU re-balancing during Transit Gateway update: %v", err)
 			}
 		}
 	}
@@ -3838,18 +4046,18 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			var err error
 			detectionTime, err = client.GetTunnelDetectionTime("Controller")
 			if err != nil {
-				return fmt.Errorf("could not get default tunnel detection time during Transit Gateway update: %w", err)
+				return diag.Errorf("could not get default tunnel detection time during Transit Gateway update: %v", err)
 			}
 		}
 		err := client.ModifyTunnelDetectionTime(gateway.GwName, detectionTime)
 		if err != nil {
-			return fmt.Errorf("could not modify tunnel detection time during Transit Gateway update: %w", err)
+			return diag.Errorf("could not modify tunnel detection time during Transit Gateway update: %v", err)
 		}
 	}
 
 	if d.HasChange("rx_queue_size") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("could not update rx_queue_size since it only supports AWS related cloud types")
+			return diag.Errorf("could not update rx_queue_size since it only supports AWS related cloud types")
 		}
 		gw := &goaviatrix.Gateway{
 			GwName:      gateway.GwName,
@@ -3857,7 +4065,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 		err := client.SetRxQueueSize(gw)
 		if err != nil {
-			return fmt.Errorf("could not modify rx queue size for transit: %s during gateway update: %v", gw.GatewayName, err)
+			return diag.Errorf("could not modify rx queue size for transit: %s during gateway update: %v", gw.GatewayName, err)
 		}
 		if haSubnet != "" || haZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
@@ -3866,7 +4074,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			}
 			err := client.SetRxQueueSize(haGwRxQueueSize)
 			if err != nil {
-				return fmt.Errorf("could not modify rx queue size for transit ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
+				return diag.Errorf("could not modify rx queue size for transit ha: %s during gateway update: %v", haGwRxQueueSize.GwName, err)
 			}
 		}
 	}
@@ -3874,22 +4082,22 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 	if d.HasChanges("enable_bgp_over_lan", "bgp_lan_interfaces_count") {
 		if d.HasChange("enable_bgp_over_lan") {
 			if !d.Get("enable_bgp_over_lan").(bool) {
-				return fmt.Errorf("disabling BGP over LAN during update is not supported for transit: %s", gateway.GwName)
+				return diag.Errorf("disabling BGP over LAN during update is not supported for transit: %s", gateway.GwName)
 			}
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-				return fmt.Errorf("enabling BGP over LAN during update is only supported for Azure transit")
+				return diag.Errorf("enabling BGP over LAN during update is only supported for Azure transit")
 			}
 			if _, ok := d.GetOk("bgp_lan_interfaces_count"); !ok {
-				return fmt.Errorf("please specify 'bgp_lan_interfaces_count' to enable BGP over LAN during update for Azure transit: %s", gateway.GwName)
+				return diag.Errorf("please specify 'bgp_lan_interfaces_count' to enable BGP over LAN during update for Azure transit: %s", gateway.GwName)
 			}
 		}
 		if d.HasChange("bgp_lan_interfaces_count") {
 			if !d.Get("enable_bgp_over_lan").(bool) || !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-				return fmt.Errorf("could not update BGP over LAN interface count since it only supports BGP over LAN enabled transit for Azure (8), AzureGov (32) or AzureChina (2048)")
+				return diag.Errorf("could not update BGP over LAN interface count since it only supports BGP over LAN enabled transit for Azure (8), AzureGov (32) or AzureChina (2048)")
 			}
 			oldCount, newCount := d.GetChange("bgp_lan_interfaces_count")
 			if oldCount.(int) > newCount.(int) {
-				return fmt.Errorf("deleting BGP over LAN interface during update is not supported for transit: %s", gateway.GwName)
+				return diag.Errorf("deleting BGP over LAN interface during update is not supported for transit: %s", gateway.GwName)
 			}
 		}
 		gw := &goaviatrix.Gateway{
@@ -3898,7 +4106,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		}
 		err := client.ChangeBgpOverLanIntfCnt(gw)
 		if err != nil {
-			return fmt.Errorf("could not modify BGP over LAN interface count for transit: %s during gateway update: %v", gw.GatewayName, err)
+			return diag.Errorf("could not modify BGP over LAN interface count for transit: %s during gateway update: %v", gw.GatewayName, err)
 		}
 		if haSubnet != "" {
 			haGw := &goaviatrix.Gateway{
@@ -3907,7 +4115,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 			}
 			err := client.ChangeBgpOverLanIntfCnt(haGw)
 			if err != nil {
-				return fmt.Errorf("could not modify BGP over LAN interface count for transit ha: %s during gateway update: %v", haGw.GwName, err)
+				return diag.Errorf("could not modify BGP over LAN interface count for transit ha: %s during gateway update: %v", haGw.GwName, err)
 			}
 		}
 	}
@@ -3916,22 +4124,22 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta interface
 		if d.Get("enable_ipv6").(bool) {
 			err := client.EnableIPv6(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't enable IPv6 on spoke gateway when updating: %w", err)
+				return diag.Errorf("couldn't enable IPv6 on spoke gateway when updating: %v", err)
 			}
 		} else {
 			err := client.DisableIPv6(gateway)
 			if err != nil {
-				return fmt.Errorf("couldn't disable IPv6 on spoke gateway when updating: %w", err)
+				return diag.Errorf("couldn't disable IPv6 on spoke gateway when updating: %v", err)
 			}
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixTransitGatewayRead(d, meta)
+	return resourceAviatrixTransitGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	cloudType := d.Get("cloud_type").(int)
 
 	gateway := &goaviatrix.Gateway{
@@ -3939,13 +4147,13 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface
 		GwName:    d.Get("gw_name").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Transit Gateway: %#v", gateway)
+	tflog.Info(ctx, "Deleting Aviatrix Transit Gateway", map[string]interface{}{"gw_name": gateway.GwName})
 
 	enableEgressTransitFirenet := d.Get("enable_egress_transit_firenet").(bool)
 	if enableEgressTransitFirenet {
 		err := client.DisableEgressTransitFirenet(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 		if err != nil {
-			return fmt.Errorf("could not disable egress transit firenet: %w", err)
+			return diag.Errorf("could not disable egress transit firenet: %v", err)
 		}
 	}
 
@@ -3953,7 +4161,7 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface
 	if enableTransitPreserveAsPath {
 		err := client.DisableTransitPreserveAsPath(&goaviatrix.TransitVpc{GwName: gateway.GwName})
 		if err != nil {
-			return fmt.Errorf("could not disable transit preserve as path: %w", err)
+			return diag.Errorf("could not disable transit preserve as path: %v", err)
 		}
 	}
 
@@ -3966,7 +4174,7 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface
 
 		err := client.DisableGatewayFireNetInterfaces(gw)
 		if err != nil {
-			return fmt.Errorf("failed to disable Aviatrix Transit Gateway for FireNet Interfaces: %s", err)
+			return diag.Errorf("failed to disable Aviatrix Transit Gateway for FireNet Interfaces: %s", err)
 		}
 	}
 
@@ -3974,12 +4182,12 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface
 	if enableTransitFireNet && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
 		err := client.DisableTransitFireNet(gateway)
 		if err != nil {
-			return fmt.Errorf("failed to disable transit firenet for %s due to %s", gateway.GwName, err)
+			return diag.Errorf("failed to disable transit firenet for %s due to %s", gateway.GwName, err)
 		}
 	} else if enableTransitFireNet && goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 		err := client.IsTransitFireNetReadyToBeDisabled(gateway)
 		if err != nil {
-			return fmt.Errorf("failed to disable transit firenet for %s due to %s", gateway.GwName, err)
+			return diag.Errorf("failed to disable transit firenet for %s due to %s", gateway.GwName, err)
 		}
 	}
 
@@ -3995,21 +4203,21 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface
 
 		for {
 			try++
-			err := client.DeleteGateway(gateway)
+			err := client.DeleteGatewayContext(ctx, gateway)
 			if err != nil {
 				if strings.Contains(err.Error(), "not found") {
 					break
 				}
 
 				if !strings.Contains(err.Error(), "EOF") {
-					return fmt.Errorf("failed to delete Aviatrix Edge Transit Gateway HA gateway: %s", err)
+					return diag.Errorf("failed to delete Aviatrix Edge Transit Gateway HA gateway: %s", err)
 				}
 			} else {
 				break
 			}
 
 			if try == maxTries {
-				return fmt.Errorf("failed to delete Aviatrix Edge Transit Gateway HA gateway: %s", err)
+				return diag.Errorf("failed to delete Aviatrix Edge Transit Gateway HA gateway: %s", err)
 			}
 			time.Sleep(backoff)
 			// Double the backoff time after each failed try
@@ -4018,35 +4226,35 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta interface
 		if goaviatrix.IsCloudType(cloudType, goaviatrix.EDGEEQUINIX|goaviatrix.EDGEMEGAPORT|goaviatrix.EDGESELFMANAGED) {
 			vpcID, ok := d.Get("vpc_id").(string)
 			if !ok {
-				return fmt.Errorf("vpc_id is not a string")
+				return diag.Errorf("vpc_id is not a string")
 			}
 			ztpFileDownloadPath, ok := d.Get("ztp_file_download_path").(string)
 			if !ok {
-				return fmt.Errorf("ztp_file_download_path is not a string")
+				return diag.Errorf("ztp_file_download_path is not a string")
 			}
 			err := deleteZtpFile(gateway.GwName, vpcID, ztpFileDownloadPath)
 			if err != nil {
-				return fmt.Errorf("failed to delete ZTP file: %w", err)
+				return diag.Errorf("failed to delete ZTP file: %v", err)
 			}
 		}
 	}
 	gateway.GwName = d.Get("gw_name").(string)
-	err := client.DeleteGateway(gateway)
+	err := client.DeleteGatewayContext(ctx, gateway)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Edge Transit Gateway: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Edge Transit Gateway: %s", err)
 	}
 	if goaviatrix.IsCloudType(cloudType, goaviatrix.EDGEEQUINIX|goaviatrix.EDGEMEGAPORT|goaviatrix.EDGESELFMANAGED) {
 		vpcID, ok := d.Get("vpc_id").(string)
 		if !ok {
-			return fmt.Errorf("vpc_id is not a string")
+			return diag.Errorf("vpc_id is not a string")
 		}
 		ztpFileDownloadPath, ok := d.Get("ztp_file_download_path").(string)
 		if !ok {
-			return fmt.Errorf("ztp_file_download_path is not a string")
+			return diag.Errorf("ztp_file_download_path is not a string")
 		}
 		err := deleteZtpFile(gateway.GwName, vpcID, ztpFileDownloadPath)
 		if err != nil {
-			return fmt.Errorf("failed to delete ZTP file: %w", err)
+			return diag.Errorf("failed to delete ZTP file: %v", err)
 		}
 	}
 
@@ -4066,7 +4274,7 @@ func deleteZtpFile(gatewayName, vpcID, ztpFileDownloadPath string) error {
 	return nil
 }
 
-func createEdgeTransitGateway(d *schema.ResourceData, client *goaviatrix.Client, cloudType int) error {
+func createEdgeTransitGateway(ctx context.Context, d *schema.ResourceData, client goaviatrix.ClientInterface, cloudType int) error {
 	gateway := &goaviatrix.TransitVpc{
 		CloudType:   d.Get("cloud_type").(int),
 		AccountName: d.Get("account_name").(string),
@@ -4141,9 +4349,9 @@ func createEdgeTransitGateway(d *schema.ResourceData, client *goaviatrix.Client,
 	}
 
 	// create the transit gateway
-	log.Printf("[INFO] Creating Aviatrix Transit Gateway: %#v", gateway)
+	tflog.Info(ctx, "Creating Aviatrix Transit Gateway", map[string]interface{}{"gw_name": gateway.GwName})
 	d.SetId(gateway.GwName)
-	err = client.LaunchTransitVpc(gateway)
+	err = client.LaunchTransitVpcContext(ctx, gateway)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix Transit Gateway: %w", err)
 	}
@@ -4155,8 +4363,8 @@ func createEdgeTransitGateway(d *schema.ResourceData, client *goaviatrix.Client,
 			return fmt.Errorf("failed to get the HA gateway details: %w", err)
 		}
 		// log transit ha gateway details
-		log.Printf("[INFO] Creating HA Aviatrix Transit Gateway: %#v", transitHaGw)
-		_, err = client.CreateTransitHaGw(transitHaGw)
+		tflog.Info(ctx, "Creating HA Aviatrix Transit Gateway", map[string]interface{}{"gw_name": transitHaGw.GwName})
+		_, err = client.CreateTransitHaGwContext(ctx, transitHaGw)
 		if err != nil {
 			return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %w", err)
 		}
@@ -4197,9 +4405,9 @@ func createEdgeTransitGateway(d *schema.ResourceData, client *goaviatrix.Client,
 
 		if cloudType == goaviatrix.EDGEMEGAPORT {
 			// print eip map for edge mega port
-			log.Printf("[INFO] EIP Map for Edge Mega Port: %#v", eipMapList)
+			tflog.Info(ctx, "EIP Map for Edge Mega Port")
 			gateway.LogicalEipMap = eipMapList
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 			defer cancel()
 			// update logical eip map
 			err = client.UpdateEdgeGatewayV2(ctx, gateway)
@@ -4213,7 +4421,7 @@ func createEdgeTransitGateway(d *schema.ResourceData, client *goaviatrix.Client,
 			}
 			gateway.EipMap = string(eipMapJSON)
 			// update EIP map
-			err = client.UpdateEdgeGateway(gateway)
+			err = client.UpdateEdgeGatewayContext(ctx, gateway)
 			if err != nil {
 				return fmt.Errorf("failed to update edge gateway: %w", err)
 			}
@@ -4327,9 +4535,7 @@ func calculateInterfaceName(intfType string, intfIndex, wanCount int) (string, e
 		return "", errors.New("unexpected interface type")
 	}
 
-	interfaceName := fmt.Sprintf("eth%d", num)
-	log.Printf("Mapping interface %s%d to port %s", intfType, intfIndex, interfaceName)
-	return interfaceName, nil
+	return fmt.Sprintf("eth%d", num), nil
 }
 
 func calculateWANInterfaceNumber(intfIndex int) int {
@@ -2,24 +2,29 @@ package aviatrix
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"strings"
-	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAviatrixTransitGatewayPeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransitGatewayPeeringCreate,
-		Read:   resourceAviatrixTransitGatewayPeeringRead,
-		Update: resourceAviatrixTransitGatewayPeeringUpdate,
-		Delete: resourceAviatrixTransitGatewayPeeringDelete,
+		CreateContext: resourceAviatrixTransitGatewayPeeringCreate,
+		ReadContext:   resourceAviatrixTransitGatewayPeeringRead,
+		UpdateContext: resourceAviatrixTransitGatewayPeeringUpdate,
+		DeleteContext: resourceAviatrixTransitGatewayPeeringDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -177,17 +182,17 @@ func resourceAviatrixTransitGatewayPeering() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayPeeringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	flag := false
 	transitGatewayName1, ok := d.Get("transit_gateway_name1").(string)
 	if !ok {
-		return fmt.Errorf("transit_gateway_name1 is required")
+		return diag.Errorf("transit_gateway_name1 is required")
 	}
 
 	transitGatewayName2, ok := d.Get("transit_gateway_name2").(string)
 	if !ok {
-		return fmt.Errorf("transit_gateway_name2 is required")
+		return diag.Errorf("transit_gateway_name2 is required")
 	}
 
 	transitGatewayPeering := &goaviatrix.TransitGatewayPeering{
@@ -198,39 +203,39 @@ func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta in
 
 	transitGatewayPeering.EnableOverPrivateNetwork, ok = d.Get("enable_peering_over_private_network").(bool)
 	if !ok {
-		return fmt.Errorf("enable_peering_over_private_network is required for edge gateway peering")
+		return diag.Errorf("enable_peering_over_private_network is required for edge gateway peering")
 	}
 	transitGatewayPeering.EnableJumboFrame, ok = d.Get("jumbo_frame").(bool)
 	if !ok {
-		return fmt.Errorf("jumbo_frame is required for edge gateway peering")
+		return diag.Errorf("jumbo_frame is required for edge gateway peering")
 	}
 	transitGatewayPeering.EnableInsaneMode, ok = d.Get("insane_mode").(bool)
 	if !ok {
-		return fmt.Errorf("insane_mode is required for edge gateway peering")
+		return diag.Errorf("insane_mode is required for edge gateway peering")
 	}
 
 	gateway1Details, err := getGatewayDetails(client, transitGatewayName1)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	gateway1CloudType := gateway1Details.CloudType
 	gateway2Details, err := getGatewayDetails(client, transitGatewayName2)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	gateway2CloudType := gateway2Details.CloudType
 	// Set source WAN interface names for gateway1
 	if goaviatrix.IsCloudType(gateway1CloudType, goaviatrix.EdgeRelatedCloudTypes) {
 		transit1InterfaceRaw, ok := d.GetOk("gateway1_logical_ifnames")
 		if !ok {
-			return fmt.Errorf("gateway1_logical_ifnames is required for edge gateway peering")
+			return diag.Errorf("gateway1_logical_ifnames is required for edge gateway peering")
 		}
 		if _, ok := transit1InterfaceRaw.([]interface{}); !ok {
-			return fmt.Errorf("gateway1_logical_ifnames must be a list of strings")
+			return diag.Errorf("gateway1_logical_ifnames must be a list of strings")
 		}
 		gw1LogicalIfNames := getStringList(d, "gateway1_logical_ifnames")
-		if err := setWanInterfaceNames(gw1LogicalIfNames, gateway1CloudType, gateway1Details, "gateway1", transitGatewayPeering); err != nil {
-			return err
+		if err := setWanInterfaceNames(ctx, gw1LogicalIfNames, gateway1CloudType, gateway1Details, "gateway1", transitGatewayPeering); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -238,78 +243,75 @@ func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta in
 	if goaviatrix.IsCloudType(gateway2CloudType, goaviatrix.EdgeRelatedCloudTypes) {
 		transit2InterfaceRaw, ok := d.GetOk("gateway2_logical_ifnames")
 		if !ok {
-			return fmt.Errorf("gateway2_logical_ifnames is required for edge gateway peering")
+			return diag.Errorf("gateway2_logical_ifnames is required for edge gateway peering")
 		}
 		if _, ok := transit2InterfaceRaw.([]interface{}); !ok {
-			return fmt.Errorf("gateway2_logical_ifnames must be a list of strings")
+			return diag.Errorf("gateway2_logical_ifnames must be a list of strings")
 		}
 		gw2LogicalIfNames := getStringList(d, "gateway2_logical_ifnames")
-		if err := setWanInterfaceNames(gw2LogicalIfNames, gateway2CloudType, gateway2Details, "gateway2", transitGatewayPeering); err != nil {
-			return err
+		if err := setWanInterfaceNames(ctx, gw2LogicalIfNames, gateway2CloudType, gateway2Details, "gateway2", transitGatewayPeering); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if err := setExcludedResources(d, transitGatewayPeering); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// options only supported for non EAT peerings
 	if setNonEATPeering(gateway1CloudType, gateway2CloudType) {
-		log.Printf("[INFO] Setting non EAT peering options")
+		tflog.Info(ctx, "Setting non EAT peering options")
 		if err := setNonEATPeeringOptions(d, transitGatewayPeering); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Transit Gateway peering: %#v", transitGatewayPeering)
+	tflog.Info(ctx, "Creating Aviatrix Transit Gateway peering", map[string]interface{}{"transit_gateway_name1": transitGatewayPeering.TransitGatewayName1, "transit_gateway_name2": transitGatewayPeering.TransitGatewayName2})
 	d.SetId(transitGatewayPeering.TransitGatewayName1 + "~" + transitGatewayPeering.TransitGatewayName2)
 	defer func() {
-		if err := resourceAviatrixTransitGatewayPeeringReadIfRequired(d, meta, &flag); err != nil {
-			log.Printf("[ERROR] Failed to read Aviatrix Transit Gateway peering: %v", err)
+		if err := resourceAviatrixTransitGatewayPeeringReadIfRequired(ctx, d, meta, &flag); err != nil {
+			tflog.Error(ctx, "Failed to read Aviatrix Transit Gateway peering", map[string]interface{}{"error": err})
 		}
 	}()
 
-	const timeoutDuration = 180 * time.Second
-	ctx, cancel := context.WithTimeout(context.Background(), timeoutDuration)
-	defer cancel()
 	err = client.CreateTransitGatewayPeering(ctx, transitGatewayPeering)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Transit Gateway peering: %w", err)
+		return diag.Errorf("failed to create Aviatrix Transit Gateway peering: %v", err)
 	}
 
 	// Set AS Path prepend for gateway1 (prepend_as_path1)
 	if err := setASPathPrepend(d, client, "prepend_as_path1", transitGatewayName1, transitGatewayName2); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Set AS Path prepend for gateway2 (prepend_as_path2)
 	if err := setASPathPrepend(d, client, "prepend_as_path2", transitGatewayName2, transitGatewayName1); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	return resourceAviatrixTransitGatewayPeeringReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransitGatewayPeeringReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransitGatewayPeeringReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixTransitGatewayPeeringReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransitGatewayPeeringRead(d, meta)
+		return resourceAviatrixTransitGatewayPeeringRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayPeeringRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	transitGwName1 := d.Get("transit_gateway_name1").(string)
 	transitGwName2 := d.Get("transit_gateway_name2").(string)
 
 	if transitGwName1 == "" || transitGwName2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no transit gateway names received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no transit gateway names received", map[string]interface{}{"id": id})
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
-			return fmt.Errorf("invalid import id expected transit_gateway_name1~transit_gateway_name2")
+			return diag.Errorf("invalid import id expected transit_gateway_name1~transit_gateway_name2")
 		}
 		d.Set("transit_gateway_name1", parts[0])
 		d.Set("transit_gateway_name2", parts[1])
@@ -321,33 +323,33 @@ func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta inte
 		TransitGatewayName2: d.Get("transit_gateway_name2").(string),
 	}
 
-	transitGatewayPeering, err := client.GetTransitGatewayPeeringDetails(transitGatewayPeering)
+	transitGatewayPeering, err := client.GetTransitGatewayPeeringDetailsContext(ctx, transitGatewayPeering)
 	if err == goaviatrix.ErrNotFound {
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("could not get transit peering details: %v", err)
+		return diag.Errorf("could not get transit peering details: %v", err)
 	}
 
 	if err := d.Set("enable_peering_over_private_network", transitGatewayPeering.EnableOverPrivateNetwork); err != nil {
-		return fmt.Errorf("failed to set enable_peering_over_private_network: %w", err)
+		return diag.Errorf("failed to set enable_peering_over_private_network: %v", err)
 	}
 	if err := d.Set("jumbo_frame", transitGatewayPeering.EnableJumboFrame); err != nil {
-		return fmt.Errorf("failed to set jumbo_frame: %w", err)
+		return diag.Errorf("failed to set jumbo_frame: %v", err)
 	}
 	if err := d.Set("insane_mode", transitGatewayPeering.EnableInsaneMode); err != nil {
-		return fmt.Errorf("failed to set insane_mode: %w", err)
+		return diag.Errorf("failed to set insane_mode: %v", err)
 	}
 	// Set the gateway1 logical interface names for the edge gateways
 	if len(transitGatewayPeering.Gateway1LogicalIfNames) > 0 {
 		gateway1Details, err := getGatewayDetails(client, transitGatewayPeering.TransitGatewayName1)
 		if err != nil {
-			return fmt.Errorf("failed to get gateway1 details for logical ifnames: %w", err)
+			return diag.Errorf("failed to get gateway1 details for logical ifnames: %v", err)
 		}
 		logicalIfNames, err := getLogicalIfNames(gateway1Details, transitGatewayPeering.Gateway1LogicalIfNames)
 		if err != nil {
-			return fmt.Errorf("failed to set gateway1_logical_ifnames: %w", err)
+			return diag.Errorf("failed to set gateway1_logical_ifnames: %v", err)
 		}
 		_ = d.Set("gateway1_logical_ifnames", logicalIfNames)
 	}
@@ -356,37 +358,37 @@ func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta inte
 	if len(transitGatewayPeering.Gateway2LogicalIfNames) > 0 {
 		gateway2Details, err := getGatewayDetails(client, transitGatewayPeering.TransitGatewayName2)
 		if err != nil {
-			return fmt.Errorf("failed to get gateway2 details for logical ifnames: %w", err)
+			return diag.Errorf("failed to get gateway2 details for logical ifnames: %v", err)
 		}
 		logicalIfNames, err := getLogicalIfNames(gateway2Details, transitGatewayPeering.Gateway2LogicalIfNames)
 		if err != nil {
-			return fmt.Errorf("failed to set gateway2 logical ifnames: %w", err)
+			return diag.Errorf("failed to set gateway2 logical ifnames: %v", err)
 		}
 		_ = d.Set("gateway2_logical_ifnames", logicalIfNames)
 	}
 	if err := d.Set("gateway2_logical_ifnames", transitGatewayPeering.Gateway2LogicalIfNames); err != nil {
-		return fmt.Errorf("failed to set gateway2_logical_ifnames: %w", err)
+		return diag.Errorf("failed to set gateway2_logical_ifnames: %v", err)
 	}
 
 	gw1CidrsFromConfig := getStringList(d, "gateway1_excluded_network_cidrs")
 	err = setConfigValueIfEquivalent(d, "gateway1_excluded_network_cidrs", gw1CidrsFromConfig, transitGatewayPeering.Gateway1ExcludedCIDRsSlice)
 	if err != nil {
-		return fmt.Errorf("could not write gateway1_excluded_network_cidrs to state: %w", err)
+		return diag.Errorf("could not write gateway1_excluded_network_cidrs to state: %v", err)
 	}
 	gw2CidrsFromConfig := getStringList(d, "gateway2_excluded_network_cidrs")
 	err = setConfigValueIfEquivalent(d, "gateway2_excluded_network_cidrs", gw2CidrsFromConfig, transitGatewayPeering.Gateway2ExcludedCIDRsSlice)
 	if err != nil {
-		return fmt.Errorf("could not write gateway2_excluded_network_cidrs to state: %w", err)
+		return diag.Errorf("could not write gateway2_excluded_network_cidrs to state: %v", err)
 	}
 	gw1TgwsFromConfig := getStringList(d, "gateway1_excluded_tgw_connections")
 	err = setConfigValueIfEquivalent(d, "gateway1_excluded_tgw_connections", gw1TgwsFromConfig, transitGatewayPeering.Gateway1ExcludedTGWConnectionsSlice)
 	if err != nil {
-		return fmt.Errorf("could not write gateway1_excluded_tgw_connections to state: %w", err)
+		return diag.Errorf("could not write gateway1_excluded_tgw_connections to state: %v", err)
 	}
 	gw2TgwsFromConfig := getStringList(d, "gateway2_excluded_tgw_connections")
 	err = setConfigValueIfEquivalent(d, "gateway2_excluded_tgw_connections", gw2TgwsFromConfig, transitGatewayPeering.Gateway2ExcludedTGWConnectionsSlice)
 	if err != nil {
-		return fmt.Errorf("could not write gateway2_excluded_tgw_connections to state: %w", err)
+		return diag.Errorf("could not write gateway2_excluded_tgw_connections to state: %v", err)
 	}
 
 	if transitGatewayPeering.PrependAsPath1 != "" {
@@ -397,7 +399,7 @@ func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta inte
 
 		err = d.Set("prepend_as_path1", prependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path1: %w", err)
+			return diag.Errorf("could not set prepend_as_path1: %v", err)
 		}
 	}
 	if transitGatewayPeering.PrependAsPath2 != "" {
@@ -408,39 +410,39 @@ func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta inte
 
 		err = d.Set("prepend_as_path2", prependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path2: %w", err)
+			return diag.Errorf("could not set prepend_as_path2: %v", err)
 		}
 	}
 	if err := d.Set("enable_peering_over_private_network", transitGatewayPeering.PrivateIPPeering == "yes"); err != nil {
-		return fmt.Errorf("failed to set enable_peering_over_private_network: %w", err)
+		return diag.Errorf("failed to set enable_peering_over_private_network: %v", err)
 	}
 
 	enableSingleTunnel := transitGatewayPeering.PrivateIPPeering == "yes" && transitGatewayPeering.SingleTunnel == "yes"
 	if err := d.Set("enable_single_tunnel_mode", enableSingleTunnel); err != nil {
-		return fmt.Errorf("failed to set enable_single_tunnel_mode: %w", err)
+		return diag.Errorf("failed to set enable_single_tunnel_mode: %v", err)
 	}
 	if err := d.Set("enable_insane_mode_encryption_over_internet", transitGatewayPeering.InsaneModeOverInternet); err != nil {
-		return fmt.Errorf("failed to set enable_insane_mode_encryption_over_internet: %w", err)
+		return diag.Errorf("failed to set enable_insane_mode_encryption_over_internet: %v", err)
 	}
 
 	if transitGatewayPeering.InsaneModeOverInternet {
 		if err := d.Set("tunnel_count", transitGatewayPeering.TunnelCount); err != nil {
-			return fmt.Errorf("failed to set tunnel_count: %w", err)
+			return diag.Errorf("failed to set tunnel_count: %v", err)
 		}
 	}
 
 	if err := d.Set("enable_max_performance", !transitGatewayPeering.NoMaxPerformance); err != nil {
-		return fmt.Errorf("failed to set enable_max_performance: %w", err)
+		return diag.Errorf("failed to set enable_max_performance: %v", err)
 	}
 	if err := d.Set("disable_activemesh", transitGatewayPeering.DisableActivemesh); err != nil {
-		return fmt.Errorf("error setting disable_activemesh: %w", err)
+		return diag.Errorf("error setting disable_activemesh: %v", err)
 	}
 	d.SetId(transitGatewayPeering.TransitGatewayName1 + "~" + transitGatewayPeering.TransitGatewayName2)
 	return nil
 }
 
-func resourceAviatrixTransitGatewayPeeringUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayPeeringUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	d.Partial(true)
 
@@ -472,10 +474,10 @@ func resourceAviatrixTransitGatewayPeeringUpdate(d *schema.ResourceData, meta in
 		transitGatewayPeering.Gateway1ExcludedTGWConnections = strings.Join(gw1Tgws, ",")
 		transitGatewayPeering.Gateway2ExcludedTGWConnections = strings.Join(gw2Tgws, ",")
 
-		log.Printf("[INFO] Updating Aviatrix Transit Gateway peering: %#v", transitGatewayPeering)
-		err := client.UpdateTransitGatewayPeering(transitGatewayPeering)
+		tflog.Info(ctx, "Updating Aviatrix Transit Gateway peering", map[string]interface{}{"transit_gateway_name1": transitGatewayPeering.TransitGatewayName1, "transit_gateway_name2": transitGatewayPeering.TransitGatewayName2})
+		err := client.UpdateTransitGatewayPeeringContext(ctx, transitGatewayPeering)
 		if err != nil {
-			return fmt.Errorf("failed to update Aviatrix Transit Gateway peering: %s", err)
+			return diag.Errorf("failed to update Aviatrix Transit Gateway peering: %s", err)
 		}
 	}
 
@@ -485,9 +487,9 @@ func resourceAviatrixTransitGatewayPeeringUpdate(d *schema.ResourceData, meta in
 			prependASPath = append(prependASPath, v.(string))
 		}
 
-		err := client.EditTransitConnectionASPathPrepend(transitGatewayPeering, prependASPath)
+		err := client.EditTransitConnectionASPathPrependContext(ctx, transitGatewayPeering, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not update prepend_as_path1: %v", err)
+			return diag.Errorf("could not update prepend_as_path1: %v", err)
 		}
 
 	}
@@ -501,35 +503,35 @@ func resourceAviatrixTransitGatewayPeeringUpdate(d *schema.ResourceData, meta in
 			TransitGatewayName1: d.Get("transit_gateway_name2").(string),
 			TransitGatewayName2: d.Get("transit_gateway_name1").(string),
 		}
-		err := client.EditTransitConnectionASPathPrepend(transitGwPeering, prependASPath)
+		err := client.EditTransitConnectionASPathPrependContext(ctx, transitGwPeering, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not update prepend_as_path2: %v", err)
+			return diag.Errorf("could not update prepend_as_path2: %v", err)
 		}
 
 	}
 
 	if d.HasChanges("gateway1_logical_ifnames") || d.HasChange("gateway2_logical_ifnames") {
-		return fmt.Errorf("cannot update logical interface names for edge transit peerings")
+		return diag.Errorf("cannot update logical interface names for edge transit peerings")
 	}
 
 	d.Partial(false)
 	d.SetId(transitGatewayPeering.TransitGatewayName1 + "~" + transitGatewayPeering.TransitGatewayName2)
-	return resourceAviatrixTransitGatewayPeeringRead(d, meta)
+	return resourceAviatrixTransitGatewayPeeringRead(ctx, d, meta)
 }
 
-func resourceAviatrixTransitGatewayPeeringDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTransitGatewayPeeringDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	transitGatewayPeering := &goaviatrix.TransitGatewayPeering{
 		TransitGatewayName1: d.Get("transit_gateway_name1").(string),
 		TransitGatewayName2: d.Get("transit_gateway_name2").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Transit Gateway peering: %#v", transitGatewayPeering)
+	tflog.Info(ctx, "Deleting Aviatrix Transit Gateway peering", map[string]interface{}{"transit_gateway_name1": transitGatewayPeering.TransitGatewayName1, "transit_gateway_name2": transitGatewayPeering.TransitGatewayName2})
 
-	err := client.DeleteTransitGatewayPeering(transitGatewayPeering)
+	err := client.DeleteTransitGatewayPeeringContext(ctx, transitGatewayPeering)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Transit Gateway peering: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Transit Gateway peering: %s", err)
 	}
 
 	return nil
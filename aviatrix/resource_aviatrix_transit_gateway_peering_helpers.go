@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -36,6 +37,7 @@ func SetWanInterfaces(logicalIfNames []string, reversedInterfaceNames map[string
 
 // setWanInterfaceNames sets the WAN interface names and logical interface names based on the cloud type.
 func setWanInterfaceNames(
+	ctx context.Context,
 	logicalIfNames []string,
 	cloudType int,
 	gatewayDetails *goaviatrix.Gateway,
@@ -66,10 +68,10 @@ func setWanInterfaceNames(
 	case goaviatrix.IsCloudType(cloudType, goaviatrix.EDGEMEGAPORT|goaviatrix.EDGESELFMANAGED):
 		if gatewayPrefix == "gateway1" {
 			transitGatewayPeering.Gateway1LogicalIfNames = logicalIfNames
-			log.Printf("[INFO] Gateway1 Logical Interface Names: %#v", transitGatewayPeering.Gateway1LogicalIfNames)
+			tflog.Info(ctx, "Gateway1 Logical Interface Names", map[string]interface{}{"gateway1logical_if_names": transitGatewayPeering.Gateway1LogicalIfNames})
 		} else {
 			transitGatewayPeering.Gateway2LogicalIfNames = logicalIfNames
-			log.Printf("[INFO] Gateway2 Logical Interface Names: %#v", transitGatewayPeering.Gateway2LogicalIfNames)
+			tflog.Info(ctx, "Gateway2 Logical Interface Names", map[string]interface{}{"gateway2logical_if_names": transitGatewayPeering.Gateway2LogicalIfNames})
 		}
 	default:
 		return nil
@@ -101,7 +103,7 @@ func getStringListFromResource(d *schema.ResourceData, key string) ([]string, er
 }
 
 // setASPathPrepend sets the AS Path Prepend for the transit connection.
-func setASPathPrepend(d *schema.ResourceData, client *goaviatrix.Client, prependField, transitGatewayName1, transitGatewayName2 string) error {
+func setASPathPrepend(d *schema.ResourceData, client goaviatrix.TransitClient, prependField, transitGatewayName1, transitGatewayName2 string) error {
 	if _, ok := d.GetOk(prependField); ok {
 		prependASPath, err := getStringListFromResource(d, prependField)
 		if err != nil {
@@ -242,7 +244,7 @@ func setExcludedResources(d *schema.ResourceData, transitGatewayPeering *goaviat
 }
 
 // getGatewayDetails gets the gateway details from the client.
-func getGatewayDetails(client *goaviatrix.Client, gatewayName string) (*goaviatrix.Gateway, error) {
+func getGatewayDetails(client goaviatrix.GatewayClient, gatewayName string) (*goaviatrix.Gateway, error) {
 	gateway := &goaviatrix.Gateway{
 		GwName: gatewayName,
 	}
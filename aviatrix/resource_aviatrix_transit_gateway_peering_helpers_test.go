@@ -1,6 +1,7 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -136,7 +137,7 @@ func TestSetWanInterfaceNames(t *testing.T) {
 			transitGatewayPeering := &goaviatrix.TransitGatewayPeering{}
 
 			// Call the function with actual cloud type
-			err := setWanInterfaceNames(tt.logicalIfNames, tt.cloudType, tt.gatewayDetails, tt.gatewayPrefix, transitGatewayPeering)
+			err := setWanInterfaceNames(context.Background(), tt.logicalIfNames, tt.cloudType, tt.gatewayDetails, tt.gatewayPrefix, transitGatewayPeering)
 
 			if tt.expectedError {
 				assert.Error(t, err)
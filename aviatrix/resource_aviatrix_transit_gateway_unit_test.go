@@ -0,0 +1,279 @@
+package aviatrix
+
+import (
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceAviatrixTransitGatewayCustomizeDiff(t *testing.T) {
+	baseConfig := func(changes map[string]interface{}) map[string]interface{} {
+		config := map[string]interface{}{
+			"cloud_type":   1,
+			"account_name": "aws-account",
+			"gw_name":      "transit-gw",
+			"vpc_id":       "vpc-0123456789",
+			"vpc_reg":      "us-west-1",
+			"gw_size":      "c5.xlarge",
+			"subnet":       "10.1.0.0/24",
+		}
+		for k, v := range changes {
+			config[k] = v
+		}
+		return config
+	}
+
+	tests := []struct {
+		name    string
+		state   map[string]string
+		config  map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "valid HA gateway",
+			config: baseConfig(map[string]interface{}{
+				"ha_subnet":  "10.1.1.0/24",
+				"ha_gw_size": "c5.xlarge",
+			}),
+		},
+		{
+			name: "ha_subnet without ha_gw_size",
+			config: baseConfig(map[string]interface{}{
+				"ha_subnet": "10.1.1.0/24",
+			}),
+			wantErr: "a valid non empty ha_gw_size parameter is mandatory",
+		},
+		{
+			name: "unknown ha_gw_size is not validated",
+			config: baseConfig(map[string]interface{}{
+				"ha_subnet":  "10.1.1.0/24",
+				"ha_gw_size": unknownValue,
+			}),
+		},
+		{
+			name: "GCP ha_subnet without ha_zone",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type": 4,
+				"ha_subnet":  "10.1.1.0/24",
+				"ha_gw_size": "n1-standard-1",
+			}),
+			wantErr: "'ha_zone' must be set to enable HA on GCP",
+		},
+		{
+			name: "ha_zone on AWS",
+			config: baseConfig(map[string]interface{}{
+				"ha_zone": "us-west-1a",
+			}),
+			wantErr: "'ha_zone' is only valid for GCP and Azure providers",
+		},
+		{
+			name: "zone on AWS",
+			config: baseConfig(map[string]interface{}{
+				"zone": "az-1",
+			}),
+			wantErr: "attribute 'zone' is only for use with Azure",
+		},
+		{
+			name: "AWS insane mode with /26 subnet",
+			config: baseConfig(map[string]interface{}{
+				"insane_mode":    true,
+				"insane_mode_az": "us-west-1a",
+				"subnet":         "10.1.0.64/26",
+			}),
+		},
+		{
+			name: "AWS insane mode without /26 subnet",
+			config: baseConfig(map[string]interface{}{
+				"insane_mode":    true,
+				"insane_mode_az": "us-west-1a",
+			}),
+			wantErr: "'subnet' must be a /26 CIDR segment",
+		},
+		{
+			name: "AWS insane mode without insane_mode_az",
+			config: baseConfig(map[string]interface{}{
+				"insane_mode": true,
+				"subnet":      "10.1.0.64/26",
+			}),
+			wantErr: "insane_mode_az needed",
+		},
+		{
+			name: "AWS insane mode HA without ha_insane_mode_az",
+			config: baseConfig(map[string]interface{}{
+				"insane_mode":    true,
+				"insane_mode_az": "us-west-1a",
+				"subnet":         "10.1.0.64/26",
+				"ha_subnet":      "10.1.0.128/26",
+				"ha_gw_size":     "c5.xlarge",
+			}),
+			wantErr: "ha_insane_mode_az needed",
+		},
+		{
+			name: "firenet and transit firenet",
+			config: baseConfig(map[string]interface{}{
+				"enable_firenet":         true,
+				"enable_transit_firenet": true,
+			}),
+			wantErr: "can't enable firenet function and transit firenet function at the same time",
+		},
+		{
+			name: "GCP transit firenet without LAN VPC",
+			config: baseConfig(map[string]interface{}{
+				"cloud_type":             4,
+				"enable_transit_firenet": true,
+			}),
+			wantErr: "'lan_vpc_id' and 'lan_private_subnet' are required",
+		},
+		{
+			name: "gateway load balancer without firenet",
+			config: baseConfig(map[string]interface{}{
+				"enable_gateway_load_balancer": true,
+			}),
+			wantErr: "'enable_gateway_load_balancer' is only valid when 'enable_firenet' or 'enable_transit_firenet' is set to true",
+		},
+		{
+			name: "egress transit firenet without transit firenet",
+			config: baseConfig(map[string]interface{}{
+				"enable_egress_transit_firenet": true,
+			}),
+			wantErr: "'enable_egress_transit_firenet' requires 'enable_transit_firenet'",
+		},
+		{
+			name: "approved learned cidrs without approval",
+			config: baseConfig(map[string]interface{}{
+				"approved_learned_cidrs": []interface{}{"10.10.0.0/16"},
+			}),
+			wantErr: "'approved_learned_cidrs' must be empty",
+		},
+		{
+			name: "customer managed keys without encryption",
+			config: baseConfig(map[string]interface{}{
+				"customer_managed_keys": "key",
+			}),
+			wantErr: "'customer_managed_keys' should be empty",
+		},
+		{
+			name: "private oob without oob_availability_zone",
+			config: baseConfig(map[string]interface{}{
+				"enable_private_oob":    true,
+				"oob_management_subnet": "10.1.2.0/24",
+			}),
+			wantErr: "\"oob_availability_zone\" is required",
+		},
+		{
+			name: "edge transit gateway is not validated",
+			config: map[string]interface{}{
+				"cloud_type":  goaviatrix.EDGEEQUINIX,
+				"ha_gw_size":  "small",
+				"insane_mode": true,
+			},
+		},
+		{
+			name: "unchanged rule is not revalidated on update",
+			state: map[string]string{
+				"id":         "transit-gw",
+				"cloud_type": "1",
+				"gw_size":    "c5.xlarge",
+				"subnet":     "10.1.0.0/24",
+				"ha_subnet":  "10.1.1.0/24",
+				"ha_gw_size": "",
+			},
+			config: baseConfig(map[string]interface{}{
+				"ha_subnet": "10.1.1.0/24",
+				"gw_size":   "c5.2xlarge",
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testPlanDiff(resourceAviatrixTransitGateway(), tt.state, tt.config)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCustomizeDiffTransitFireNetGwSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "AWS transit firenet with c5.xlarge",
+			config: map[string]interface{}{
+				"cloud_type":             1,
+				"gw_size":                "c5.xlarge",
+				"enable_transit_firenet": true,
+			},
+		},
+		{
+			name: "AWS transit firenet with t3.small",
+			config: map[string]interface{}{
+				"cloud_type":             1,
+				"gw_size":                "t3.small",
+				"enable_transit_firenet": true,
+			},
+			wantErr: `"gw_size" = "t3.small" is not supported with FireNet enabled`,
+		},
+		{
+			name: "AWS firenet with t2.micro HA gateway",
+			config: map[string]interface{}{
+				"cloud_type":     1,
+				"gw_size":        "c5n.xlarge",
+				"ha_subnet":      "10.1.1.0/24",
+				"ha_gw_size":     "t2.micro",
+				"enable_firenet": true,
+			},
+			wantErr: `"ha_gw_size" = "t2.micro" is not supported with FireNet enabled`,
+		},
+		{
+			name: "AWS t3.small without firenet",
+			config: map[string]interface{}{
+				"cloud_type": 1,
+				"gw_size":    "t3.small",
+			},
+		},
+		{
+			name: "Azure transit firenet with Standard_D3_v2",
+			config: map[string]interface{}{
+				"cloud_type":             8,
+				"gw_size":                "Standard_D3_v2",
+				"enable_transit_firenet": true,
+			},
+		},
+		{
+			name: "Azure transit firenet with Standard_B2ms",
+			config: map[string]interface{}{
+				"cloud_type":             8,
+				"gw_size":                "Standard_B2ms",
+				"enable_transit_firenet": true,
+			},
+			wantErr: `"gw_size" = "Standard_B2ms" is not supported with FireNet enabled`,
+		},
+		{
+			name: "unknown gw_size is not validated",
+			config: map[string]interface{}{
+				"cloud_type":             1,
+				"gw_size":                unknownValue,
+				"enable_transit_firenet": true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testPlanDiff(resourceAviatrixTransitGateway(), nil, tt.config)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
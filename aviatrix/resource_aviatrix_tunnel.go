@@ -1,22 +1,23 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixTunnel() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTunnelCreate,
-		Read:   resourceAviatrixTunnelRead,
-		Update: resourceAviatrixTunnelUpdate,
-		Delete: resourceAviatrixTunnelDelete,
+		CreateContext: resourceAviatrixTunnelCreate,
+		ReadContext:   resourceAviatrixTunnelRead,
+		UpdateContext: resourceAviatrixTunnelUpdate,
+		DeleteContext: resourceAviatrixTunnelDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -55,8 +56,8 @@ func resourceAviatrixTunnel() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTunnelCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTunnelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	tunnel := &goaviatrix.Tunnel{
 		VpcName1:        d.Get("gw_name1").(string),
@@ -73,37 +74,37 @@ func resourceAviatrixTunnelCreate(d *schema.ResourceData, meta interface{}) erro
 		tunnel.EnableHA = "no"
 	}
 
-	log.Printf("[INFO] Creating Aviatrix tunnel: %#v", tunnel)
+	tflog.Info(ctx, "Creating Aviatrix tunnel", map[string]interface{}{"vpc_name1": tunnel.VpcName1, "vpc_name2": tunnel.VpcName2})
 
 	d.SetId(tunnel.VpcName1 + "~" + tunnel.VpcName2)
 	flag := false
-	defer resourceAviatrixTunnelReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixTunnelReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateTunnel(tunnel)
+	err := client.CreateTunnelContext(ctx, tunnel)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Tunnel: %s", err)
+		return diag.Errorf("failed to create Aviatrix Tunnel: %s", err)
 	}
 
-	return resourceAviatrixTunnelReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTunnelReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTunnelReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixTunnelReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTunnelRead(d, meta)
+		return resourceAviatrixTunnelRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTunnelRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpcName1 := d.Get("gw_name1").(string)
 	vpcName2 := d.Get("gw_name2").(string)
 
 	if vpcName1 == "" || vpcName2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc names received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no vpc names received", map[string]interface{}{"id": id})
 		d.Set("gw_name1", strings.Split(id, "~")[0])
 		d.Set("gw_name2", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -113,15 +114,15 @@ func resourceAviatrixTunnelRead(d *schema.ResourceData, meta interface{}) error
 		VpcName1: d.Get("gw_name1").(string),
 		VpcName2: d.Get("gw_name2").(string),
 	}
-	tun, err := client.GetTunnel(tunnel)
+	tun, err := client.GetTunnelContext(ctx, tunnel)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix Tunnel: %s", err)
+		return diag.Errorf("couldn't find Aviatrix Tunnel: %s", err)
 	}
-	log.Printf("[INFO] Found Aviatrix tunnel: %#v", tun)
+	tflog.Info(ctx, "Found Aviatrix tunnel", map[string]interface{}{"vpc_name1": tun.VpcName1, "vpc_name2": tun.VpcName2})
 
 	d.Set("peering_hastatus", tun.PeeringHaStatus)
 	d.Set("peering_state", tun.PeeringState)
@@ -137,8 +138,8 @@ func resourceAviatrixTunnelRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceAviatrixTunnelUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	tunnel := &goaviatrix.Tunnel{
 		VpcName1:        d.Get("gw_name1").(string),
@@ -148,43 +149,43 @@ func resourceAviatrixTunnelUpdate(d *schema.ResourceData, meta interface{}) erro
 		PeeringLink:     d.Get("peering_link").(string),
 	}
 
-	log.Printf("[INFO] Updating Aviatrix tunnel: %#v", tunnel)
+	tflog.Info(ctx, "Updating Aviatrix tunnel", map[string]interface{}{"vpc_name1": tunnel.VpcName1, "vpc_name2": tunnel.VpcName2})
 
 	err := client.UpdateTunnel(tunnel)
 	if err != nil {
-		return fmt.Errorf("failed to update Aviatrix Tunnel: %s", err)
+		return diag.Errorf("failed to update Aviatrix Tunnel: %s", err)
 	}
 
 	d.SetId(tunnel.VpcName1 + "~" + tunnel.VpcName2)
-	return resourceAviatrixTunnelRead(d, meta)
+	return resourceAviatrixTunnelRead(ctx, d, meta)
 }
 
-func resourceAviatrixTunnelDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixTunnelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	tunnel := &goaviatrix.Tunnel{
 		VpcName1: d.Get("gw_name1").(string),
 		VpcName2: d.Get("gw_name2").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix tunnel: %#v", tunnel)
+	tflog.Info(ctx, "Deleting Aviatrix tunnel", map[string]interface{}{"vpc_name1": tunnel.VpcName1, "vpc_name2": tunnel.VpcName2})
 
 	if peeringHaStatus := d.Get("peering_hastatus").(string); peeringHaStatus == "active" {
 		// parse the hagw name
 		tunnel.VpcName1 += "-hagw"
 		tunnel.VpcName2 += "-hagw"
-		err := client.DeleteTunnel(tunnel)
+		err := client.DeleteTunnelContext(ctx, tunnel)
 		if err != nil {
-			return fmt.Errorf("failed to delete Aviatrix HA gateway: %s", err)
+			return diag.Errorf("failed to delete Aviatrix HA gateway: %s", err)
 		}
 	}
 
 	tunnel.VpcName1 = d.Get("gw_name1").(string)
 	tunnel.VpcName2 = d.Get("gw_name2").(string)
 
-	err := client.DeleteTunnel(tunnel)
+	err := client.DeleteTunnelContext(ctx, tunnel)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Tunnel: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Tunnel: %s", err)
 	}
 
 	return nil
@@ -1,24 +1,25 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 	"time"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAviatrixVGWConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixVGWConnCreate,
-		Read:   resourceAviatrixVGWConnRead,
-		Update: resourceAviatrixVGWConnUpdate,
-		Delete: resourceAviatrixVGWConnDelete,
+		CreateContext: resourceAviatrixVGWConnCreate,
+		ReadContext:   resourceAviatrixVGWConnRead,
+		UpdateContext: resourceAviatrixVGWConnUpdate,
+		DeleteContext: resourceAviatrixVGWConnDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -104,8 +105,8 @@ func resourceAviatrixVGWConn() *schema.Resource {
 	}
 }
 
-func resourceAviatrixVGWConnCreate(d *schema.ResourceData, meta interface{}) (err error) {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVGWConnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vgwConn := &goaviatrix.VGWConn{
 		ConnName:      d.Get("conn_name").(string),
@@ -117,50 +118,50 @@ func resourceAviatrixVGWConnCreate(d *schema.ResourceData, meta interface{}) (er
 		BgpLocalAsNum: d.Get("bgp_local_as_num").(string),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix VGW Connection: %#v", vgwConn)
+	tflog.Info(ctx, "Creating Aviatrix VGW Connection", map[string]interface{}{"gw_name": vgwConn.GwName})
 
 	d.SetId(vgwConn.ConnName + "~" + vgwConn.VPCId)
 	flag := false
-	defer resourceAviatrixVGWConnReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixVGWConnReadIfRequired(ctx, d, meta, &flag)
 
 	try, maxTries, backoff := 0, 8, 1000*time.Millisecond
 	for {
 		try++
-		err := client.CreateVGWConn(vgwConn)
+		err := client.CreateVGWConnContext(ctx, vgwConn)
 		if err != nil {
 			if strings.Contains(err.Error(), "is not up") {
 				if try == maxTries {
-					return fmt.Errorf("couldn't create Aviatrix VGWConn: %s", err)
+					return diag.Errorf("couldn't create Aviatrix VGWConn: %s", err)
 				}
 				time.Sleep(backoff)
 				// Double the backoff time after each failed try
 				backoff *= 2
 				continue
 			}
-			return fmt.Errorf("failed to create Aviatrix VGWConn: %s", err)
+			return diag.Errorf("failed to create Aviatrix VGWConn: %s", err)
 		}
 		break
 	}
 
 	enableLearnedCIDRApproval := d.Get("enable_learned_cidrs_approval").(bool)
 	if enableLearnedCIDRApproval {
-		err := client.EnableTransitConnectionLearnedCIDRApproval(vgwConn.GwName, vgwConn.ConnName)
+		err := client.EnableTransitConnectionLearnedCIDRApprovalContext(ctx, vgwConn.GwName, vgwConn.ConnName)
 		if err != nil {
-			return fmt.Errorf("could not enable learned cidr approval: %v", err)
+			return diag.Errorf("could not enable learned cidr approval: %v", err)
 		}
 	}
 
 	manualBGPCidrs := getStringSet(d, "manual_bgp_advertised_cidrs")
 	if len(manualBGPCidrs) > 0 {
-		err = client.EditTransitConnectionBGPManualAdvertiseCIDRs(vgwConn.GwName, vgwConn.ConnName, manualBGPCidrs)
+		err := client.EditTransitConnectionBGPManualAdvertiseCIDRsContext(ctx, vgwConn.GwName, vgwConn.ConnName, manualBGPCidrs)
 		if err != nil {
-			return fmt.Errorf("could not edit manual bgp cidrs: %v", err)
+			return diag.Errorf("could not edit manual bgp cidrs: %v", err)
 		}
 	}
 
 	if d.Get("enable_event_triggered_ha").(bool) {
-		if err := client.EnableSite2CloudEventTriggeredHA(vgwConn.VPCId, vgwConn.ConnName); err != nil {
-			return fmt.Errorf("could not enable event triggered HA for vgw conn after create: %v", err)
+		if err := client.EnableSite2CloudEventTriggeredHAContext(ctx, vgwConn.VPCId, vgwConn.ConnName); err != nil {
+			return diag.Errorf("could not enable event triggered HA for vgw conn after create: %v", err)
 		}
 	}
 
@@ -170,31 +171,31 @@ func resourceAviatrixVGWConnCreate(d *schema.ResourceData, meta interface{}) (er
 			prependASPath = append(prependASPath, v.(string))
 		}
 
-		err = client.EditVgwConnectionASPathPrepend(vgwConn, prependASPath)
+		err := client.EditVgwConnectionASPathPrependContext(ctx, vgwConn, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not set prepend_as_path: %v", err)
+			return diag.Errorf("could not set prepend_as_path: %v", err)
 		}
 	}
 
-	return resourceAviatrixVGWConnReadIfRequired(d, meta, &flag)
+	return resourceAviatrixVGWConnReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixVGWConnReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixVGWConnReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixVGWConnRead(d, meta)
+		return resourceAviatrixVGWConnRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixVGWConnRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVGWConnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	connName := d.Get("conn_name").(string)
 	vpcID := d.Get("vpc_id").(string)
 	if connName == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no connection name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no connection name received", map[string]interface{}{"id": id})
 		d.Set("conn_name", strings.Split(id, "~")[0])
 		d.Set("vpc_id", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -204,15 +205,15 @@ func resourceAviatrixVGWConnRead(d *schema.ResourceData, meta interface{}) error
 		ConnName: d.Get("conn_name").(string),
 		VPCId:    d.Get("vpc_id").(string),
 	}
-	vConn, err := client.GetVGWConnDetail(vgwConn)
+	vConn, err := client.GetVGWConnDetailContext(ctx, vgwConn)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix VGW Connection: %s", err)
+		return diag.Errorf("couldn't find Aviatrix VGW Connection: %s", err)
 	}
-	log.Printf("[INFO] Found Aviatrix VGW Connection: %#v", vConn)
+	tflog.Info(ctx, "Found Aviatrix VGW Connection", map[string]interface{}{"gw_name": vConn.GwName})
 
 	d.Set("conn_name", vConn.ConnName)
 	d.Set("gw_name", vConn.GwName)
@@ -223,7 +224,7 @@ func resourceAviatrixVGWConnRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("bgp_local_as_num", vConn.BgpLocalAsNum)
 	d.Set("enable_event_triggered_ha", vConn.EventTriggeredHA)
 	if err := d.Set("manual_bgp_advertised_cidrs", vConn.ManualBGPCidrs); err != nil {
-		return fmt.Errorf("setting 'manual_bgp_advertised_cidrs' into state: %v", err)
+		return diag.Errorf("setting 'manual_bgp_advertised_cidrs' into state: %v", err)
 	}
 
 	if vConn.PrependAsPath != "" {
@@ -234,14 +235,14 @@ func resourceAviatrixVGWConnRead(d *schema.ResourceData, meta interface{}) error
 
 		err = d.Set("prepend_as_path", prependAsPath)
 		if err != nil {
-			return fmt.Errorf("could not set value for prepend_as_path: %v", err)
+			return diag.Errorf("could not set value for prepend_as_path: %v", err)
 		}
 	}
 	d.SetId(vConn.ConnName + "~" + vConn.VPCId)
 
-	transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfig(&goaviatrix.TransitVpc{GwName: vConn.GwName})
+	transitAdvancedConfig, err := client.GetTransitGatewayAdvancedConfigContext(ctx, &goaviatrix.TransitVpc{GwName: vConn.GwName})
 	if err != nil {
-		return fmt.Errorf("could not get advanced config for transit gateway when trying to read learned CIDR approval status: %v", err)
+		return diag.Errorf("could not get advanced config for transit gateway when trying to read learned CIDR approval status: %v", err)
 	}
 	for _, v := range transitAdvancedConfig.ConnectionLearnedCIDRApprovalInfo {
 		if v.ConnName == vConn.ConnName {
@@ -253,8 +254,8 @@ func resourceAviatrixVGWConnRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceAviatrixVGWConnUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVGWConnUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 	d.Partial(true)
 
 	gwName := d.Get("gw_name").(string)
@@ -262,35 +263,35 @@ func resourceAviatrixVGWConnUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("enable_learned_cidrs_approval") {
 		enableLearnedCIDRApproval := d.Get("enable_learned_cidrs_approval").(bool)
 		if enableLearnedCIDRApproval {
-			err := client.EnableTransitConnectionLearnedCIDRApproval(gwName, connName)
+			err := client.EnableTransitConnectionLearnedCIDRApprovalContext(ctx, gwName, connName)
 			if err != nil {
-				return fmt.Errorf("could not enable learned cidr approval: %v", err)
+				return diag.Errorf("could not enable learned cidr approval: %v", err)
 			}
 		} else {
-			err := client.DisableTransitConnectionLearnedCIDRApproval(gwName, connName)
+			err := client.DisableTransitConnectionLearnedCIDRApprovalContext(ctx, gwName, connName)
 			if err != nil {
-				return fmt.Errorf("could not disable learned cidr approval: %v", err)
+				return diag.Errorf("could not disable learned cidr approval: %v", err)
 			}
 		}
 	}
 	if d.HasChange("manual_bgp_advertised_cidrs") {
 		manualBGPCidrs := getStringSet(d, "manual_bgp_advertised_cidrs")
-		err := client.EditTransitConnectionBGPManualAdvertiseCIDRs(gwName, connName, manualBGPCidrs)
+		err := client.EditTransitConnectionBGPManualAdvertiseCIDRsContext(ctx, gwName, connName, manualBGPCidrs)
 		if err != nil {
-			return fmt.Errorf("could not edit manual advertise manual cidrs: %v", err)
+			return diag.Errorf("could not edit manual advertise manual cidrs: %v", err)
 		}
 	}
 	if d.HasChange("enable_event_triggered_ha") {
 		vpcID := d.Get("vpc_id").(string)
 		if d.Get("enable_event_triggered_ha").(bool) {
-			err := client.EnableSite2CloudEventTriggeredHA(vpcID, connName)
+			err := client.EnableSite2CloudEventTriggeredHAContext(ctx, vpcID, connName)
 			if err != nil {
-				return fmt.Errorf("could not enable event triggered HA for vgw conn during update: %v", err)
+				return diag.Errorf("could not enable event triggered HA for vgw conn during update: %v", err)
 			}
 		} else {
-			err := client.DisableSite2CloudEventTriggeredHA(vpcID, connName)
+			err := client.DisableSite2CloudEventTriggeredHAContext(ctx, vpcID, connName)
 			if err != nil {
-				return fmt.Errorf("could not disable event triggered HA for vgw conn during update: %v", err)
+				return diag.Errorf("could not disable event triggered HA for vgw conn during update: %v", err)
 			}
 		}
 	}
@@ -303,32 +304,32 @@ func resourceAviatrixVGWConnUpdate(d *schema.ResourceData, meta interface{}) err
 			ConnName: connName,
 			GwName:   gwName,
 		}
-		err := client.EditVgwConnectionASPathPrepend(vgwConn, prependASPath)
+		err := client.EditVgwConnectionASPathPrependContext(ctx, vgwConn, prependASPath)
 		if err != nil {
-			return fmt.Errorf("could not update prepend_as_path: %v", err)
+			return diag.Errorf("could not update prepend_as_path: %v", err)
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixVGWConnRead(d, meta)
+	return resourceAviatrixVGWConnRead(ctx, d, meta)
 }
 
-func resourceAviatrixVGWConnDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVGWConnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vgwConn := &goaviatrix.VGWConn{
 		ConnName: d.Get("conn_name").(string),
 		VPCId:    d.Get("vpc_id").(string),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix vgw_conn: %#v", vgwConn)
+	tflog.Info(ctx, "Deleting Aviatrix vgw_conn", map[string]interface{}{"gw_name": vgwConn.GwName})
 
-	err := client.DeleteVGWConn(vgwConn)
+	err := client.DeleteVGWConnContext(ctx, vgwConn)
 	if err != nil {
 		if strings.Contains(err.Error(), "does not exist") {
 			return nil
 		}
-		return fmt.Errorf("failed to delete Aviatrix VGWConn: %s", err)
+		return diag.Errorf("failed to delete Aviatrix VGWConn: %s", err)
 	}
 
 	return nil
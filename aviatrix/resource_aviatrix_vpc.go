@@ -1,28 +1,36 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"net"
 	"strings"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixVpc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixVpcCreate,
-		Read:   resourceAviatrixVpcRead,
-		Update: resourceAviatrixVpcUpdate,
-		Delete: resourceAviatrixVpcDelete,
+		CreateContext: resourceAviatrixVpcCreate,
+		ReadContext:   resourceAviatrixVpcRead,
+		UpdateContext: resourceAviatrixVpcUpdate,
+		DeleteContext: resourceAviatrixVpcDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
 		MigrateState:  resourceAviatrixVpcMigrateState,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Update: schema.DefaultTimeout(defaultInfrastructureTimeout),
+			Delete: schema.DefaultTimeout(defaultInfrastructureTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cloud_type": {
 				Type:         schema.TypeInt,
@@ -269,8 +277,8 @@ func resourceAviatrixVpc() *schema.Resource {
 	}
 }
 
-func resourceAviatrixVpcCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVpcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpc := &goaviatrix.Vpc{
 		CloudType:              d.Get("cloud_type").(int),
@@ -284,30 +292,30 @@ func resourceAviatrixVpcCreate(d *schema.ResourceData, meta interface{}) error {
 		PrivateModeSubnets:     d.Get("private_mode_subnets").(bool),
 	}
 	if vpc.Region == "" && !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.GCPRelatedCloudTypes) {
-		return fmt.Errorf("please specify 'region'")
+		return diag.Errorf("please specify 'region'")
 	} else if vpc.Region != "" && vpc.CloudType == goaviatrix.GCP {
-		return fmt.Errorf("please specify 'region' in 'subnets' for GCP provider")
+		return diag.Errorf("please specify 'region' in 'subnets' for GCP provider")
 	}
 
 	if vpc.Cidr == "" && !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.GCPRelatedCloudTypes) {
-		return fmt.Errorf("please specify 'cidr'")
+		return diag.Errorf("please specify 'cidr'")
 	} else if vpc.Cidr != "" && goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.GCPRelatedCloudTypes) {
-		return fmt.Errorf("please specify 'cidr' in 'subnets' for GCP provider")
+		return diag.Errorf("please specify 'cidr' in 'subnets' for GCP provider")
 	}
 	if vpc.SubnetSize != 0 && vpc.NumOfSubnetPairs != 0 {
 		if !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("advanced option('subnet_size' and 'num_of_subnet_pairs') is only supported by AWS (1), Azure (8), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
+			return diag.Errorf("advanced option('subnet_size' and 'num_of_subnet_pairs') is only supported by AWS (1), Azure (8), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
 		}
 	} else if vpc.SubnetSize != 0 || vpc.NumOfSubnetPairs != 0 {
 		if goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("please specify both 'subnet_size' and 'num_of_subnet_pairs' to enable advanced options")
+			return diag.Errorf("please specify both 'subnet_size' and 'num_of_subnet_pairs' to enable advanced options")
 		} else {
-			return fmt.Errorf("advanced option('subnet_size' and 'num_of_subnet_pairs') is only supported by AWS (1), Azure (8), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
+			return diag.Errorf("advanced option('subnet_size' and 'num_of_subnet_pairs') is only supported by AWS (1), Azure (8), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
 		}
 	}
 	if vpc.EnablePrivateOobSubnet {
 		if !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("advanced option('enable_private_oob_subnet') is only supported by AWS (1), AWSGov (256), and AWSChina (1024)")
+			return diag.Errorf("advanced option('enable_private_oob_subnet') is only supported by AWS (1), AWSGov (256), and AWSChina (1024)")
 		}
 	}
 
@@ -315,34 +323,34 @@ func resourceAviatrixVpcCreate(d *schema.ResourceData, meta interface{}) error {
 	aviatrixFireNetVpc := d.Get("aviatrix_firenet_vpc").(bool)
 
 	if aviatrixTransitVpc && !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
-		return fmt.Errorf("currently 'aviatrix_transit_vpc' is only supported by AWS (1), AWSGov (256), AWSChina (1024) and Alibaba Cloud (8192)")
+		return diag.Errorf("currently 'aviatrix_transit_vpc' is only supported by AWS (1), AWSGov (256), AWSChina (1024) and Alibaba Cloud (8192)")
 	}
 	if aviatrixFireNetVpc && !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
-		return fmt.Errorf("currently'aviatrix_firenet_vpc' is only supported by AWS (1), Azure (8), OCI (16), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
+		return diag.Errorf("currently'aviatrix_firenet_vpc' is only supported by AWS (1), Azure (8), OCI (16), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
 	}
 	if aviatrixTransitVpc && aviatrixFireNetVpc {
-		return fmt.Errorf("vpc cannot be aviatrix transit vpc and aviatrix firenet vpc at the same time")
+		return diag.Errorf("vpc cannot be aviatrix transit vpc and aviatrix firenet vpc at the same time")
 	}
 
 	nativeGwlb := d.Get("enable_native_gwlb").(bool)
 	if nativeGwlb && !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AWS) {
-		return fmt.Errorf("'enable_native_gwlb' is only valid with cloud_type = 1 (AWS)")
+		return diag.Errorf("'enable_native_gwlb' is only valid with cloud_type = 1 (AWS)")
 	}
 
 	if aviatrixTransitVpc {
 		vpc.AviatrixTransitVpc = "yes"
-		log.Printf("[INFO] Creating a new Aviatrix Transit VPC: %#v", vpc)
+		tflog.Info(ctx, "Creating a new Aviatrix Transit VPC", map[string]interface{}{"name": vpc.Name})
 	} else {
 		vpc.AviatrixTransitVpc = "no"
 	}
 	if aviatrixFireNetVpc {
 		vpc.AviatrixFireNetVpc = "yes"
-		log.Printf("[INFO] Creating a new Aviatrix FireNet VPC: %#v", vpc)
+		tflog.Info(ctx, "Creating a new Aviatrix FireNet VPC", map[string]interface{}{"name": vpc.Name})
 	} else {
 		vpc.AviatrixFireNetVpc = "no"
 	}
 	if !aviatrixTransitVpc && !aviatrixFireNetVpc {
-		log.Printf("[INFO] Creating a new VPC: %#v", vpc)
+		tflog.Info(ctx, "Creating a new VPC", map[string]interface{}{"name": vpc.Name})
 	}
 
 	if goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.GCPRelatedCloudTypes) {
@@ -358,15 +366,15 @@ func resourceAviatrixVpcCreate(d *schema.ResourceData, meta interface{}) error {
 				vpc.Subnets = append(vpc.Subnets, subnetInfo)
 			}
 		} else {
-			return fmt.Errorf("subnets is required to be non-empty for GCP provider")
+			return diag.Errorf("subnets is required to be non-empty for GCP provider")
 		}
 	} else if _, ok := d.GetOk("subnets"); ok {
-		return fmt.Errorf("subnets is required to be empty for providers other than GCP")
+		return diag.Errorf("subnets is required to be empty for providers other than GCP")
 	}
 
 	if resourceGroup, ok := d.GetOk("resource_group"); ok {
 		if !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("error creating vpc: resource_group is required to be empty for providers other than Azure (8), AzureGov (32) and AzureChina (2048)")
+			return diag.Errorf("error creating vpc: resource_group is required to be empty for providers other than Azure (8), AzureGov (32) and AzureChina (2048)")
 		}
 		vpc.ResourceGroup = resourceGroup.(string)
 	}
@@ -375,74 +383,73 @@ func resourceAviatrixVpcCreate(d *schema.ResourceData, meta interface{}) error {
 	enableIpv6 := d.Get("enable_ipv6").(bool)
 	if enableIpv6 {
 		if !goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("error creating vpc: enable_ipv6 is only supported for AWS (1), Azure (8)")
+			return diag.Errorf("error creating vpc: enable_ipv6 is only supported for AWS (1), Azure (8)")
 		}
 		vpc.EnableIpv6 = true
-		log.Printf("[INFO] Enabling IPv6 in VPC: %#v", vpc)
+		tflog.Info(ctx, "Enabling IPv6 in VPC", map[string]interface{}{"name": vpc.Name})
 
 		// Handle ipv6_access_type for Azure
 		if goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
 			if vpcIpv6Cidr, ok := d.GetOk("vpc_ipv6_cidr"); ok {
 				vpc.VpcIpv6Cidr = vpcIpv6Cidr.(string)
 			} else {
-				return fmt.Errorf("error creating vpc: valid vpc_ipv6_cidr is required when enable_ipv6 is true for Azure")
+				return diag.Errorf("error creating vpc: valid vpc_ipv6_cidr is required when enable_ipv6 is true for Azure")
 			}
 		}
 	}
 
-	err := client.CreateVpc(vpc)
+	err := client.CreateVpcContext(ctx, vpc)
 	if err != nil {
 		if vpc.AviatrixTransitVpc == "yes" {
-			return fmt.Errorf("failed to create a new Aviatrix Transit VPC: %s", err)
+			return diag.Errorf("failed to create a new Aviatrix Transit VPC: %s", err)
 		}
-		return fmt.Errorf("failed to create a new VPC: %s", err)
+		return diag.Errorf("failed to create a new VPC: %s", err)
 	}
 
 	d.SetId(vpc.Name)
 
 	// We intentionally call the Read function early here to load 'vpc_id' for later use
-	err = resourceAviatrixVpcRead(d, meta)
-	if err != nil {
-		return err
+	if diags := resourceAviatrixVpcRead(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 	vpc.VpcID = d.Get("vpc_id").(string)
 
 	if nativeGwlb {
 		err = client.EnableNativeAwsGwlbFirenet(vpc)
 		if err != nil {
-			return fmt.Errorf("could not enable native AWS Gwlb: %v", err)
+			return diag.Errorf("could not enable native AWS Gwlb: %v", err)
 		}
 	}
 
 	return nil
 }
 
-func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVpcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpcName := d.Get("name").(string)
 	if vpcName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc names received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no vpc names received", map[string]interface{}{"id": id})
 		d.Set("name", id)
 		d.SetId(id)
-		return resourceAviatrixVpcRead(d, meta)
+		return resourceAviatrixVpcRead(ctx, d, meta)
 	}
 
 	vpc := &goaviatrix.Vpc{
 		Name: d.Get("name").(string),
 	}
 
-	vC, err := client.GetVpc(vpc)
+	vC, err := client.GetVpcContext(ctx, vpc)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find VPC: %s", err)
+		return diag.Errorf("couldn't find VPC: %s", err)
 	}
 
-	log.Printf("[INFO] Found VPC: %#v", vpc)
+	tflog.Info(ctx, "Found VPC", map[string]interface{}{"name": vpc.Name})
 
 	d.Set("cloud_type", vC.CloudType)
 	d.Set("account_name", vC.AccountName)
@@ -481,10 +488,10 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 			AccountName: d.Get("account_name").(string),
 		}
 
-		acc, err := client.GetAccount(account)
+		acc, err := client.GetAccountContext(ctx, account)
 		if err != nil {
 			if err != goaviatrix.ErrNotFound {
-				return fmt.Errorf("aviatrix Account: %s", err)
+				return diag.Errorf("aviatrix Account: %s", err)
 			}
 		}
 
@@ -554,7 +561,7 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("subnets", subnetsFromFile); err != nil {
-		log.Printf("[WARN] Error setting 'subnets' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, "Error setting 'subnets'", map[string]interface{}{"id": d.Id(), "error": err})
 	}
 
 	var privateSubnets []map[string]interface{}
@@ -567,7 +574,7 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		privateSubnets = append(privateSubnets, subnetInfo)
 	}
 	if err := d.Set("private_subnets", privateSubnets); err != nil {
-		log.Printf("[WARN] Error setting 'private_subnets' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, "Error setting 'private_subnets'", map[string]interface{}{"id": d.Id(), "error": err})
 	}
 
 	var publicSubnets []map[string]interface{}
@@ -580,17 +587,17 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 		publicSubnets = append(publicSubnets, subnetInfo)
 	}
 	if err := d.Set("public_subnets", publicSubnets); err != nil {
-		log.Printf("[WARN] Error setting 'public_subnets' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, "Error setting 'public_subnets'", map[string]interface{}{"id": d.Id(), "error": err})
 	}
 
 	d.SetId(vC.Name)
 
 	if goaviatrix.IsCloudType(vC.CloudType, goaviatrix.AWS) {
-		firenetDetail, err := client.GetFireNet(&goaviatrix.FireNet{VpcID: vC.VpcID})
+		firenetDetail, err := client.GetFireNetContext(ctx, &goaviatrix.FireNet{VpcID: vC.VpcID})
 		if err == goaviatrix.ErrNotFound {
 			d.Set("enable_native_gwlb", false)
 		} else if err != nil {
-			return fmt.Errorf("could not get FireNet details to read enable_native_gwlb: %v", err)
+			return diag.Errorf("could not get FireNet details to read enable_native_gwlb: %v", err)
 		} else {
 			d.Set("enable_native_gwlb", firenetDetail.NativeGwlb)
 		}
@@ -600,28 +607,28 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 
 	if goaviatrix.IsCloudType(vC.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		var rtbs []string
-		rtbs, err = getAllRouteTables(vpc, client)
+		rtbs, err = getAllRouteTables(ctx, vpc, client)
 		if err != nil {
-			return fmt.Errorf("could not get vpc route table ids: %v", err)
+			return diag.Errorf("could not get vpc route table ids: %v", err)
 		}
 
 		if err := d.Set("route_tables", rtbs); err != nil {
-			log.Printf("[WARN] Error setting route tables for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, "Error setting route tables", map[string]interface{}{"id": d.Id(), "error": err})
 		}
 	} else {
 		d.Set("route_tables", []string{})
 	}
 
 	if goaviatrix.IsCloudType(vC.CloudType, goaviatrix.OCIRelatedCloudTypes) {
-		availabilityDomains, err := client.ListOciVpcAvailabilityDomains(vC)
+		availabilityDomains, err := client.ListOciVpcAvailabilityDomainsContext(ctx, vC)
 		if err != nil {
-			return fmt.Errorf("could not get OCI availability domains: %v", err)
+			return diag.Errorf("could not get OCI availability domains: %v", err)
 		}
 		d.Set("availability_domains", availabilityDomains)
 
-		faultDomains, err := client.ListOciVpcFaultDomains(vC)
+		faultDomains, err := client.ListOciVpcFaultDomainsContext(ctx, vC)
 		if err != nil {
-			return fmt.Errorf("could not get OCI fault domains: %v", err)
+			return diag.Errorf("could not get OCI fault domains: %v", err)
 		}
 		d.Set("fault_domains", faultDomains)
 	} else {
@@ -638,8 +645,8 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceAviatrixVpcUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVpcUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpc := &goaviatrix.Vpc{
 		AccountName: d.Get("account_name").(string),
@@ -652,12 +659,12 @@ func resourceAviatrixVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 		if nativeGwlb {
 			err := client.EnableNativeAwsGwlbFirenet(vpc)
 			if err != nil {
-				return fmt.Errorf("could not enable native AWS gwlb firenet: %v", err)
+				return diag.Errorf("could not enable native AWS gwlb firenet: %v", err)
 			}
 		} else {
 			err := client.DisableNativeAwsGwlbFirenet(vpc)
 			if err != nil {
-				return fmt.Errorf("could not disable native AWS gwlb firenet: %v", err)
+				return diag.Errorf("could not disable native AWS gwlb firenet: %v", err)
 			}
 		}
 	}
@@ -665,8 +672,8 @@ func resourceAviatrixVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceAviatrixVpcDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVpcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpc := &goaviatrix.Vpc{
 		AccountName: d.Get("account_name").(string),
@@ -674,18 +681,18 @@ func resourceAviatrixVpcDelete(d *schema.ResourceData, meta interface{}) error {
 		VpcID:       d.Get("vpc_id").(string),
 	}
 
-	log.Printf("[INFO] Deleting VPC: %#v", vpc)
+	tflog.Info(ctx, "Deleting VPC", map[string]interface{}{"name": vpc.Name})
 
 	if d.Get("enable_native_gwlb").(bool) {
 		err := client.DisableNativeAwsGwlbFirenet(vpc)
 		if err != nil {
-			return fmt.Errorf("could not disable native AWS gwlb: %v", err)
+			return diag.Errorf("could not disable native AWS gwlb: %v", err)
 		}
 	}
 
-	err := client.DeleteVpc(vpc)
+	err := client.DeleteVpcContext(ctx, vpc)
 	if err != nil {
-		return fmt.Errorf("failed to delete VPC: %s", err)
+		return diag.Errorf("failed to delete VPC: %s", err)
 	}
 
 	return nil
@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixProfileCreate,
-		Read:   resourceAviatrixProfileRead,
-		Update: resourceAviatrixProfileUpdate,
-		Delete: resourceAviatrixProfileDelete,
+		CreateContext: resourceAviatrixProfileCreate,
+		ReadContext:   resourceAviatrixProfileRead,
+		UpdateContext: resourceAviatrixProfileUpdate,
+		DeleteContext: resourceAviatrixProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -82,10 +83,10 @@ func resourceAviatrixProfile() *schema.Resource {
 	}
 }
 
-func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
-	log.Printf("[INFO] Creating Aviatrix Profile: %v %T", d.Get("users"), d.Get("users"))
+	tflog.Info(ctx, "Creating Aviatrix Profile", map[string]interface{}{"name": d.Get("name"), "users": d.Get("users")})
 
 	profile := &goaviatrix.Profile{
 		Name:     d.Get("name").(string),
@@ -93,7 +94,7 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 		Policy:   make([]goaviatrix.ProfileRule, 0),
 	}
 	if profile.Name == "" {
-		return fmt.Errorf("profile name can't be empty string")
+		return diag.Errorf("profile name can't be empty string")
 	}
 
 	manageUserAttachment := d.Get("manage_user_attachment").(bool)
@@ -103,11 +104,11 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 		}
 	} else {
 		if len(d.Get("users").([]interface{})) != 0 {
-			return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
+			return diag.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Profile with users: %v", profile.UserList)
+	tflog.Info(ctx, "Creating Aviatrix Profile with users", map[string]interface{}{"user_list": profile.UserList})
 
 	names := d.Get("policy").([]interface{})
 	for _, domain := range names {
@@ -121,41 +122,41 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta interface{}) err
 			}
 			err := client.ValidateProfileRule(profileRule)
 			if err != nil {
-				return fmt.Errorf("policy validation failed: %v", err)
+				return diag.Errorf("policy validation failed: %v", err)
 			}
 			profile.Policy = append(profile.Policy, *profileRule)
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Profile with Policy: %v", profile.Policy)
+	tflog.Info(ctx, "Creating Aviatrix Profile with Policy")
 
 	d.SetId(profile.Name)
 	flag := false
-	defer resourceAviatrixProfileReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixProfileReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateProfile(profile)
+	err := client.CreateProfileContext(ctx, profile)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix Profile: %s", err)
+		return diag.Errorf("failed to create Aviatrix Profile: %s", err)
 	}
 
-	return resourceAviatrixProfileReadIfRequired(d, meta, &flag)
+	return resourceAviatrixProfileReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixProfileReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixProfileReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixProfileRead(d, meta)
+		return resourceAviatrixProfileRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	profileName := d.Get("name").(string)
 	if profileName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no profile name received. Import Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, no profile name received", map[string]interface{}{"id": id})
 		d.Set("name", id)
 		d.Set("manage_user_attachment", true)
 		d.SetId(id)
@@ -166,23 +167,23 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 		Policy: make([]goaviatrix.ProfileRule, 0),
 	}
 
-	profileBase, errBase := client.GetProfileBasePolicy(profile)
+	profileBase, errBase := client.GetProfileBasePolicyContext(ctx, profile)
 	if errBase != nil {
-		return fmt.Errorf("can't get profile base policy for profile: %s", profile.Name)
+		return diag.Errorf("can't get profile base policy for profile: %s", profile.Name)
 	}
 	d.Set("base_rule", profileBase.BaseRule)
 
-	log.Printf("[INFO] Reading Aviatrix Profile: %#v", profile)
-	profile, err := client.GetProfile(profile)
+	tflog.Info(ctx, "Reading Aviatrix Profile", map[string]interface{}{"name": profile.Name})
+	profile, err := client.GetProfileContext(ctx, profile)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find profile: %s", err)
+		return diag.Errorf("couldn't find profile: %s", err)
 	}
 	d.Set("name", profile.Name)
-	log.Printf("[TRACE] Profile policy %v", profile.Policy)
+	tflog.Trace(ctx, "Profile policy")
 
 	manageUserAttachment := d.Get("manage_user_attachment").(bool)
 	if manageUserAttachment {
@@ -195,10 +196,10 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 			d.Set("users", users)
 		} else {
 			d.Set("users", profile.UserList)
-			log.Printf("[TRACE] Profile userlistnew %v", profile.UserList)
+			tflog.Trace(ctx, "Profile userlistnew", map[string]interface{}{"user_list": profile.UserList})
 		}
 	}
-	log.Printf("[TRACE] Profile policy %v", profile.Policy)
+	tflog.Trace(ctx, "Profile policy")
 
 	var Policies []map[string]interface{}
 	if profile != nil {
@@ -212,17 +213,17 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if err := d.Set("policy", Policies); err != nil {
-			log.Printf("[WARN] Error setting policy for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, "Error setting policy", map[string]interface{}{"id": d.Id(), "error": err})
 		}
 	}
-	log.Printf("[INFO] Generated policies: %v", Policies)
+	tflog.Info(ctx, "Generated policies", map[string]interface{}{"policies": Policies})
 
 	d.SetId(profile.Name)
 	return nil
 }
 
-func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	profile := &goaviatrix.Profile{
 		Name: d.Get("name").(string),
@@ -243,7 +244,7 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 		for _, user := range d.Get("users").([]interface{}) {
 			profile.UserList = append(profile.UserList, user.(string))
 		}
-		log.Printf("[INFO] Creating Aviatrix Profile with users: %v", profile.UserList)
+		tflog.Info(ctx, "Creating Aviatrix Profile with users", map[string]interface{}{"user_list": profile.UserList})
 	}
 	names := d.Get("policy").([]interface{})
 	for _, domain := range names {
@@ -256,23 +257,23 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 		err := client.ValidateProfileRule(profileRule)
 		if err != nil {
-			return fmt.Errorf("policy validation failed: %v", err)
+			return diag.Errorf("policy validation failed: %v", err)
 		}
 		profile.Policy = append(profile.Policy, *profileRule)
 	}
 
-	log.Printf("[INFO] Reading Aviatrix Profile: %#v", profile)
+	tflog.Info(ctx, "Reading Aviatrix Profile", map[string]interface{}{"name": profile.Name})
 
 	if d.HasChange("name") {
-		return fmt.Errorf("cannot change name of a profile")
+		return diag.Errorf("cannot change name of a profile")
 	}
 	if d.HasChange("base_rule") {
-		return fmt.Errorf("cannot change base rule of a profile")
+		return diag.Errorf("cannot change base rule of a profile")
 	}
 	if manageUserAttachment {
 		if d.HasChange("users") {
 			oldU, newU := d.GetChange("users")
-			log.Printf("[INFO] Users to be attached : %#v %#v ", oldU, newU)
+			tflog.Info(ctx, "Users to be attached", map[string]interface{}{"old_u": oldU, "new_u": newU})
 
 			if oldU == nil {
 				oldU = new([]interface{})
@@ -286,32 +287,32 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 			newUserList := goaviatrix.ExpandStringList(newString)
 			// Attach all the newly added Users
 			toAddUsers := goaviatrix.Difference(newUserList, oldUserList)
-			log.Printf("[INFO] Users to be attached : %#v", toAddUsers)
+			tflog.Info(ctx, "Users to be attached", map[string]interface{}{"to_add_users": toAddUsers})
 			profile.UserList = toAddUsers
-			err := client.AttachUsers(profile)
+			err := client.AttachUsersContext(ctx, profile)
 			if err != nil {
-				return fmt.Errorf("failed to attach User : %s", err)
+				return diag.Errorf("failed to attach User : %s", err)
 			}
 			// Detach all the removed Users
 			toDelGws := goaviatrix.Difference(oldUserList, newUserList)
-			log.Printf("[INFO] Users to be detached : %#v", toDelGws)
+			tflog.Info(ctx, "Users to be detached", map[string]interface{}{"to_del_gws": toDelGws})
 			profile.UserList = toDelGws
-			err = client.DetachUsers(profile)
+			err = client.DetachUsersContext(ctx, profile)
 			if err != nil {
-				return fmt.Errorf("failed to detach user : %s", err)
+				return diag.Errorf("failed to detach user : %s", err)
 			}
 		}
 	} else {
 		if len(d.Get("users").([]interface{})) != 0 {
-			return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
+			return diag.Errorf("'manage_user_attachment' is set false. Please empty 'users' and manage user attachment in other resource")
 		}
 	}
 
-	log.Printf("[INFO] Checking for policy changes")
+	tflog.Info(ctx, "Checking for policy changes")
 	if d.HasChange("policy") {
-		err := client.UpdateProfilePolicy(profile)
+		err := client.UpdateProfilePolicyContext(ctx, profile)
 		if err != nil {
-			return fmt.Errorf("failed to create Aviatrix Profile: %s", err)
+			return diag.Errorf("failed to create Aviatrix Profile: %s", err)
 		}
 	}
 
@@ -319,26 +320,26 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceAviatrixProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	profile := &goaviatrix.Profile{
 		Name: d.Get("name").(string),
 	}
-	log.Printf("[INFO] Deleting Aviatrix Profile: %#v", profile)
+	tflog.Info(ctx, "Deleting Aviatrix Profile", map[string]interface{}{"name": profile.Name})
 	if _, ok := d.GetOk("users"); ok {
-		log.Printf("[INFO] Found users: %#v", d.Get("users"))
+		tflog.Info(ctx, "Found users", map[string]interface{}{"users": d.Get("users")})
 
 		profile.UserList = goaviatrix.ExpandStringList(d.Get("users").([]interface{}))
-		err := client.DetachUsers(profile)
+		err := client.DetachUsersContext(ctx, profile)
 		if err != nil {
-			return fmt.Errorf("failed to detach Users: %s", err)
+			return diag.Errorf("failed to detach Users: %s", err)
 		}
 	}
 
-	err := client.DeleteProfile(profile)
+	err := client.DeleteProfileContext(ctx, profile)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix Profile: %s", err)
+		return diag.Errorf("failed to delete Aviatrix Profile: %s", err)
 	}
 
 	return nil
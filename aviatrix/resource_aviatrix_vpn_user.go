@@ -1,21 +1,22 @@
 package aviatrix
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixVPNUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixVPNUserCreate,
-		Read:   resourceAviatrixVPNUserRead,
-		Update: resourceAviatrixVPNUserUpdate,
-		Delete: resourceAviatrixVPNUserDelete,
+		CreateContext: resourceAviatrixVPNUserCreate,
+		ReadContext:   resourceAviatrixVPNUserRead,
+		UpdateContext: resourceAviatrixVPNUserUpdate,
+		DeleteContext: resourceAviatrixVPNUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -56,14 +57,12 @@ func resourceAviatrixVPNUser() *schema.Resource {
 			"user_email": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "VPN User's email.",
 			},
 			"saml_endpoint": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "",
-				ForceNew:    true,
 				Description: "This is the name of the SAML endpoint to which the user will be associated.",
 			},
 			"profiles": {
@@ -81,8 +80,8 @@ func resourceAviatrixVPNUser() *schema.Resource {
 	}
 }
 
-func resourceAviatrixVPNUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpnUser := &goaviatrix.VPNUser{
 		VpcID:        d.Get("vpc_id").(string),
@@ -95,32 +94,32 @@ func resourceAviatrixVPNUserCreate(d *schema.ResourceData, meta interface{}) err
 
 	if vpnUser.DnsName == "" {
 		if vpnUser.VpcID == "" && vpnUser.GwName == "" {
-			return fmt.Errorf("please set 'vpc_id' and 'gw_name', or 'dns_name' alone")
+			return diag.Errorf("please set 'vpc_id' and 'gw_name', or 'dns_name' alone")
 		} else if vpnUser.VpcID == "" || vpnUser.GwName == "" {
-			return fmt.Errorf("please set both 'vpc_id' and 'gw_name'")
+			return diag.Errorf("please set both 'vpc_id' and 'gw_name'")
 		}
 	} else {
 		if vpnUser.VpcID != "" || vpnUser.GwName != "" {
-			return fmt.Errorf("DNS is enabled. Please set 'vpc_id' and 'gw_name' to be empty")
+			return diag.Errorf("DNS is enabled. Please set 'vpc_id' and 'gw_name' to be empty")
 		}
 		vpnUser.DnsEnabled = true
 	}
 
 	manageUserAttachment := d.Get("manage_user_attachment").(bool)
 	if !manageUserAttachment && len(d.Get("profiles").([]interface{})) != 0 {
-		return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'profiles' and manage user attachment in other resource")
+		return diag.Errorf("'manage_user_attachment' is set false. Please empty 'profiles' and manage user attachment in other resource")
 	}
 
-	log.Printf("[INFO] Creating Aviatrix VPN User: %#v", vpnUser)
+	tflog.Info(ctx, "Creating Aviatrix VPN User", map[string]interface{}{"user_name": vpnUser.UserName, "gw_name": vpnUser.GwName})
 
 	d.SetId(vpnUser.UserName)
 
 	flag := false
-	defer resourceAviatrixVPNUserReadIfRequired(d, meta, &flag)
+	defer resourceAviatrixVPNUserReadIfRequired(ctx, d, meta, &flag)
 
-	err := client.CreateVPNUser(vpnUser)
+	err := client.CreateVPNUserContext(ctx, vpnUser)
 	if err != nil {
-		return fmt.Errorf("failed to create Aviatrix VPNUser: %s", err)
+		return diag.Errorf("failed to create Aviatrix VPNUser: %s", err)
 	}
 
 	if manageUserAttachment && len(d.Get("profiles").([]interface{})) != 0 {
@@ -129,31 +128,31 @@ func resourceAviatrixVPNUserCreate(d *schema.ResourceData, meta interface{}) err
 				Name: profileName.(string),
 			}
 			profile.UserList = append(profile.UserList, vpnUser.UserName)
-			err := client.AttachUsers(profile)
+			err := client.AttachUsersContext(ctx, profile)
 			if err != nil {
-				return fmt.Errorf("failed to attach User(%s) to Profile(%s) due to: %s", vpnUser.UserName, profile.Name, err)
+				return diag.Errorf("failed to attach User(%s) to Profile(%s) due to: %s", vpnUser.UserName, profile.Name, err)
 			}
 		}
 	}
 
-	return resourceAviatrixVPNUserReadIfRequired(d, meta, &flag)
+	return resourceAviatrixVPNUserReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixVPNUserReadIfRequired(d *schema.ResourceData, meta interface{}, flag *bool) error {
+func resourceAviatrixVPNUserReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixVPNUserRead(d, meta)
+		return resourceAviatrixVPNUserRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixVPNUserRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	userName := d.Get("user_name").(string)
 	if userName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, user_name is empty. Id is %s", id)
+		tflog.Debug(ctx, "Looks like an import, user_name is empty", map[string]interface{}{"id": id})
 		d.Set("user_name", id)
 		d.Set("manage_user_attachment", true)
 		d.SetId(id)
@@ -162,16 +161,16 @@ func resourceAviatrixVPNUserRead(d *schema.ResourceData, meta interface{}) error
 	vpnUser := &goaviatrix.VPNUser{
 		UserName: d.Get("user_name").(string),
 	}
-	vu, err := client.GetVPNUser(vpnUser)
+	vu, err := client.GetVPNUserContext(ctx, vpnUser)
 	if err != nil {
 		if err == goaviatrix.ErrNotFound {
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("couldn't find Aviatrix VPNUser: %s", err)
+		return diag.Errorf("couldn't find Aviatrix VPNUser: %s", err)
 	}
 
-	log.Printf("[TRACE] Reading vpn_user %s: %#v", userName, vu)
+	tflog.Trace(ctx, "Reading vpn_user", map[string]interface{}{"user_name": userName, "gw_name": vu.GwName})
 
 	if vu != nil {
 		if vu.DnsEnabled {
@@ -196,12 +195,12 @@ func resourceAviatrixVPNUserRead(d *schema.ResourceData, meta interface{}) error
 			if len(goaviatrix.Difference(profiles, profileFromRead)) == 0 && len(goaviatrix.Difference(profileFromRead, profiles)) == 0 {
 				err := d.Set("profiles", profiles)
 				if err != nil {
-					return fmt.Errorf("couldn't set 'profiles' for vpn user: %s", vpnUser.UserName)
+					return diag.Errorf("couldn't set 'profiles' for vpn user: %s", vpnUser.UserName)
 				}
 			} else {
 				err := d.Set("profiles", profileFromRead)
 				if err != nil {
-					return fmt.Errorf("couldn't set 'profiles' for vpn user: %s", vpnUser.UserName)
+					return diag.Errorf("couldn't set 'profiles' for vpn user: %s", vpnUser.UserName)
 				}
 			}
 		}
@@ -210,14 +209,32 @@ func resourceAviatrixVPNUserRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceAviatrixVPNUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpnUser := &goaviatrix.VPNUser{
 		UserName: d.Get("user_name").(string),
 	}
 	d.Partial(true)
 
+	if d.HasChanges("user_email", "saml_endpoint") {
+		editUser := &goaviatrix.VPNUser{
+			VpcID:        d.Get("vpc_id").(string),
+			GwName:       d.Get("gw_name").(string),
+			DnsName:      d.Get("dns_name").(string),
+			UserName:     vpnUser.UserName,
+			UserEmail:    d.Get("user_email").(string),
+			SamlEndpoint: d.Get("saml_endpoint").(string),
+		}
+		if editUser.DnsName != "" {
+			editUser.DnsEnabled = true
+		}
+		err := client.UpdateVPNUserContext(ctx, editUser)
+		if err != nil {
+			return diag.Errorf("failed to update email and SAML endpoint of Aviatrix VPNUser %s: %s", vpnUser.UserName, err)
+		}
+	}
+
 	manageUserAttachment := d.Get("manage_user_attachment").(bool)
 	if d.HasChange("manage_user_attachment") {
 		_, nMUA := d.GetChange("manage_user_attachment")
@@ -247,9 +264,9 @@ func resourceAviatrixVPNUserUpdate(d *schema.ResourceData, meta interface{}) err
 					Name: profileName,
 				}
 				profile.UserList = []string{vpnUser.UserName}
-				err := client.AttachUsers(profile)
+				err := client.AttachUsersContext(ctx, profile)
 				if err != nil {
-					return fmt.Errorf("failed to attach User: %s", err)
+					return diag.Errorf("failed to attach User: %s", err)
 				}
 			}
 			toDelProfiles := goaviatrix.Difference(oldProfileList, newProfileList)
@@ -258,24 +275,24 @@ func resourceAviatrixVPNUserUpdate(d *schema.ResourceData, meta interface{}) err
 					Name: profileName,
 				}
 				profile.UserList = []string{vpnUser.UserName}
-				err := client.DetachUsers(profile)
+				err := client.DetachUsersContext(ctx, profile)
 				if err != nil {
-					return fmt.Errorf("failed to detach User: %s", err)
+					return diag.Errorf("failed to detach User: %s", err)
 				}
 			}
 		}
 	} else {
 		if len(d.Get("profiles").([]interface{})) != 0 {
-			return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'profiles' and manage user attachment in other resource")
+			return diag.Errorf("'manage_user_attachment' is set false. Please empty 'profiles' and manage user attachment in other resource")
 		}
 	}
 
 	d.Partial(false)
-	return resourceAviatrixVPNUserRead(d, meta)
+	return resourceAviatrixVPNUserRead(ctx, d, meta)
 }
 
-func resourceAviatrixVPNUserDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*goaviatrix.Client)
+func resourceAviatrixVPNUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
 
 	vpnUser := &goaviatrix.VPNUser{
 		UserName: d.Get("user_name").(string),
@@ -286,11 +303,11 @@ func resourceAviatrixVPNUserDelete(d *schema.ResourceData, meta interface{}) err
 		vpnUser.DnsEnabled = true
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix VPNUser: %#v", vpnUser)
+	tflog.Info(ctx, "Deleting Aviatrix VPNUser", map[string]interface{}{"user_name": vpnUser.UserName, "gw_name": vpnUser.GwName})
 
-	err := client.DeleteVPNUser(vpnUser)
+	err := client.DeleteVPNUserContext(ctx, vpnUser)
 	if err != nil {
-		return fmt.Errorf("failed to delete Aviatrix VPNUser: %s", err)
+		return diag.Errorf("failed to delete Aviatrix VPNUser: %s", err)
 	}
 
 	return nil
@@ -0,0 +1,85 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceAviatrixVPNUserUpdate(t *testing.T) {
+	r := resourceAviatrixVPNUser()
+	state := &terraform.InstanceState{
+		ID: "alice",
+		Attributes: map[string]string{
+			"id":                     "alice",
+			"vpc_id":                 "vpc-1",
+			"gw_name":                "vpn-elb",
+			"user_name":              "alice",
+			"user_email":             "alice@example.com",
+			"saml_endpoint":          "",
+			"manage_user_attachment": "true",
+			"profiles.#":             "1",
+			"profiles.0":             "dev",
+		},
+	}
+	config := map[string]interface{}{
+		"vpc_id":                 "vpc-1",
+		"gw_name":                "vpn-elb",
+		"user_name":              "alice",
+		"user_email":             "alice@example.org",
+		"saml_endpoint":          "okta",
+		"manage_user_attachment": true,
+		"profiles":               []interface{}{"prod"},
+	}
+
+	diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("could not diff resource: %v", err)
+	}
+	assert.False(t, diff.RequiresNew())
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("could not build resource data: %v", err)
+	}
+
+	client := &goaviatrix.ClientInterfaceMock{
+		UpdateVPNUserContextFunc: func(ctx context.Context, vpnUser *goaviatrix.VPNUser) error { return nil },
+		AttachUsersContextFunc:   func(ctx context.Context, profile *goaviatrix.Profile) error { return nil },
+		DetachUsersContextFunc:   func(ctx context.Context, profile *goaviatrix.Profile) error { return nil },
+		GetVPNUserContextFunc: func(ctx context.Context, vpnUser *goaviatrix.VPNUser) (*goaviatrix.VPNUser, error) {
+			return &goaviatrix.VPNUser{
+				VpcID:        "vpc-1",
+				GwName:       "vpn-elb",
+				UserName:     "alice",
+				UserEmail:    "alice@example.org",
+				SamlEndpoint: "okta",
+				Profiles:     []string{"prod"},
+			}, nil
+		},
+	}
+
+	diags := resourceAviatrixVPNUserUpdate(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	if assert.Len(t, client.UpdateVPNUserContextCalls(), 1) {
+		assert.Equal(t, &goaviatrix.VPNUser{
+			VpcID:        "vpc-1",
+			GwName:       "vpn-elb",
+			UserName:     "alice",
+			UserEmail:    "alice@example.org",
+			SamlEndpoint: "okta",
+		}, client.UpdateVPNUserContextCalls()[0].VpnUser)
+	}
+	if assert.Len(t, client.AttachUsersContextCalls(), 1) {
+		assert.Equal(t, "prod", client.AttachUsersContextCalls()[0].Profile.Name)
+	}
+	if assert.Len(t, client.DetachUsersContextCalls(), 1) {
+		assert.Equal(t, "dev", client.DetachUsersContextCalls()[0].Profile.Name)
+	}
+	assert.Equal(t, "alice@example.org", d.Get("user_email"))
+	assert.Equal(t, "okta", d.Get("saml_endpoint"))
+}
@@ -0,0 +1,346 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAviatrixVPNUsers() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAviatrixVPNUsersCreate,
+		ReadWithoutTimeout:   resourceAviatrixVPNUsersRead,
+		UpdateWithoutTimeout: resourceAviatrixVPNUsersUpdate,
+		DeleteWithoutTimeout: resourceAviatrixVPNUsersDelete,
+		CustomizeDiff:        resourceAviatrixVPNUsersCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"gw_name"},
+				Description:  "VPC ID of the Aviatrix VPN gateway.",
+			},
+			"gw_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"gw_name", "dns_name"},
+				RequiredWith: []string{"vpc_id"},
+				Description: "If ELB is enabled, this will be the name of the ELB, " +
+					"else it will be the name of the Aviatrix VPN gateway.",
+			},
+			"dns_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "FQDN of a DNS based VPN service such as GeoVPN or UDP load balancer.",
+			},
+			"manage_user_attachment": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the profiles of the users are managed by this resource.",
+			},
+			"users": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "All VPN users of the VPN gateway or DNS based VPN service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "VPN user name.",
+						},
+						"user_email": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "VPN user's email.",
+						},
+						"saml_endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the SAML endpoint to which the user will be associated.",
+						},
+						"profiles": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Set of profiles for the user to attach to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// vpnUsersTarget returns a VPN user holding the VPN gateway or DNS based VPN
+// service of the resource.
+func vpnUsersTarget(d *schema.ResourceData) *goaviatrix.VPNUser {
+	target := &goaviatrix.VPNUser{
+		VpcID:   d.Get("vpc_id").(string),
+		GwName:  d.Get("gw_name").(string),
+		DnsName: d.Get("dns_name").(string),
+	}
+	if target.DnsName != "" {
+		target.DnsEnabled = true
+	}
+	return target
+}
+
+// expandVPNUsers returns the users of a users set, keyed by user name.
+func expandVPNUsers(target *goaviatrix.VPNUser, users *schema.Set) map[string]*goaviatrix.VPNUser {
+	vpnUsers := make(map[string]*goaviatrix.VPNUser, users.Len())
+	for _, v := range users.List() {
+		user := v.(map[string]interface{})
+		vpnUser := *target
+		vpnUser.UserName = user["user_name"].(string)
+		vpnUser.UserEmail = user["user_email"].(string)
+		vpnUser.SamlEndpoint = user["saml_endpoint"].(string)
+		vpnUser.Profiles = goaviatrix.ExpandStringList(user["profiles"].(*schema.Set).List())
+		vpnUsers[vpnUser.UserName] = &vpnUser
+	}
+	return vpnUsers
+}
+
+// resourceAviatrixVPNUsersCustomizeDiff reports invalid users at plan time.
+// Users whose name is not known yet are left out.
+func resourceAviatrixVPNUsersCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diffNeedsValidation(diff, "users", "manage_user_attachment") {
+		return nil
+	}
+
+	users := diff.Get("users").(*schema.Set)
+	var knownUsers []interface{}
+	for _, v := range users.List() {
+		// Elements holding unknown values are keyed by their hash prefixed with "~".
+		if diff.NewValueKnown(fmt.Sprintf("users.~%d.user_name", users.F(v))) {
+			knownUsers = append(knownUsers, v)
+		}
+	}
+	return validateVPNUsers(knownUsers, diff.Get("manage_user_attachment").(bool))
+}
+
+// validateVPNUsers checks that user names are unique and that profiles are
+// only set when user attachments are managed.
+func validateVPNUsers(users []interface{}, manageUserAttachment bool) error {
+	seen := make(map[string]bool)
+	for _, v := range users {
+		user := v.(map[string]interface{})
+		userName := user["user_name"].(string)
+		if seen[userName] {
+			return fmt.Errorf("VPN user %q is set more than once in 'users'", userName)
+		}
+		seen[userName] = true
+		if !manageUserAttachment && user["profiles"].(*schema.Set).Len() != 0 {
+			return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'profiles' of VPN user %q and manage user attachment in other resource", userName)
+		}
+	}
+	return nil
+}
+
+// reconcileVPNUsers creates, updates and deletes VPN users so the users of
+// the VPN gateway match newUsers, given its current users oldUsers. Profile
+// attachments are only changed if manageUserAttachment is set.
+func reconcileVPNUsers(ctx context.Context, client goaviatrix.ClientInterface, oldUsers, newUsers map[string]*goaviatrix.VPNUser, manageUserAttachment bool) error {
+	attach := make(map[string][]string)
+	detach := make(map[string][]string)
+
+	for _, userName := range sortedVPNUserNames(oldUsers) {
+		if _, ok := newUsers[userName]; ok {
+			continue
+		}
+		tflog.Info(ctx, "Deleting Aviatrix VPN user", map[string]interface{}{"user_name": userName})
+		if err := client.DeleteVPNUserContext(ctx, oldUsers[userName]); err != nil {
+			return fmt.Errorf("failed to delete Aviatrix VPN user %s: %w", userName, err)
+		}
+	}
+
+	for _, userName := range sortedVPNUserNames(newUsers) {
+		newUser := newUsers[userName]
+		oldUser, ok := oldUsers[userName]
+		if !ok {
+			tflog.Info(ctx, "Creating Aviatrix VPN user", map[string]interface{}{"user_name": userName})
+			if err := client.CreateVPNUserContext(ctx, newUser); err != nil {
+				return fmt.Errorf("failed to create Aviatrix VPN user %s: %w", userName, err)
+			}
+			oldUser = &goaviatrix.VPNUser{UserEmail: newUser.UserEmail, SamlEndpoint: newUser.SamlEndpoint}
+		}
+
+		if oldUser.UserEmail != newUser.UserEmail || oldUser.SamlEndpoint != newUser.SamlEndpoint {
+			tflog.Info(ctx, "Updating Aviatrix VPN user", map[string]interface{}{"user_name": userName})
+			if err := client.UpdateVPNUserContext(ctx, newUser); err != nil {
+				return fmt.Errorf("failed to update Aviatrix VPN user %s: %w", userName, err)
+			}
+		}
+
+		if manageUserAttachment {
+			for _, profile := range goaviatrix.Difference(newUser.Profiles, oldUser.Profiles) {
+				attach[profile] = append(attach[profile], userName)
+			}
+			for _, profile := range goaviatrix.Difference(oldUser.Profiles, newUser.Profiles) {
+				detach[profile] = append(detach[profile], userName)
+			}
+		}
+	}
+
+	for _, profileName := range sortedProfileNames(detach) {
+		profile := &goaviatrix.Profile{Name: profileName, UserList: detach[profileName]}
+		if err := client.DetachUsersContext(ctx, profile); err != nil {
+			return fmt.Errorf("failed to detach users %v from profile %s: %w", profile.UserList, profileName, err)
+		}
+	}
+	for _, profileName := range sortedProfileNames(attach) {
+		profile := &goaviatrix.Profile{Name: profileName, UserList: attach[profileName]}
+		if err := client.AttachUsersContext(ctx, profile); err != nil {
+			return fmt.Errorf("failed to attach users %v to profile %s: %w", profile.UserList, profileName, err)
+		}
+	}
+	return nil
+}
+
+func sortedVPNUserNames(users map[string]*goaviatrix.VPNUser) []string {
+	names := make([]string, 0, len(users))
+	for name := range users {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedProfileNames(profiles map[string][]string) []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func resourceAviatrixVPNUsersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	target := vpnUsersTarget(d)
+	if target.DnsEnabled {
+		d.SetId(target.DnsName)
+	} else {
+		d.SetId(target.GwName)
+	}
+
+	flag := false
+	defer resourceAviatrixVPNUsersReadIfRequired(ctx, d, meta, &flag)
+
+	newUsers := expandVPNUsers(target, d.Get("users").(*schema.Set))
+	err := reconcileVPNUsers(ctx, client, nil, newUsers, d.Get("manage_user_attachment").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAviatrixVPNUsersReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixVPNUsersReadIfRequired(ctx context.Context, d *schema.ResourceData, meta interface{}, flag *bool) diag.Diagnostics {
+	if !(*flag) {
+		*flag = true
+		return resourceAviatrixVPNUsersRead(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceAviatrixVPNUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	vpnUsers, err := client.ListVPNUsersContext(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list Aviatrix VPN users: %s", err)
+	}
+
+	if d.Get("gw_name").(string) == "" && d.Get("dns_name").(string) == "" {
+		id := d.Id()
+		tflog.Debug(ctx, "Looks like an import, gw_name and dns_name are empty", map[string]interface{}{"id": id})
+		d.Set("manage_user_attachment", true)
+		d.Set("gw_name", id)
+		for _, vpnUser := range vpnUsers {
+			if vpnUser.DnsEnabled && vpnUser.DnsName == id {
+				d.Set("gw_name", "")
+				d.Set("dns_name", id)
+				break
+			}
+		}
+	}
+
+	target := vpnUsersTarget(d)
+	manageUserAttachment := d.Get("manage_user_attachment").(bool)
+
+	var users []map[string]interface{}
+	for _, vpnUser := range vpnUsers {
+		if vpnUser.DnsEnabled != target.DnsEnabled {
+			continue
+		}
+		if target.DnsEnabled && vpnUser.DnsName != target.DnsName {
+			continue
+		}
+		if !target.DnsEnabled {
+			if vpnUser.GwName != target.GwName {
+				continue
+			}
+			d.Set("vpc_id", vpnUser.VpcID)
+		}
+
+		user := map[string]interface{}{
+			"user_name":     vpnUser.UserName,
+			"user_email":    vpnUser.UserEmail,
+			"saml_endpoint": vpnUser.SamlEndpoint,
+		}
+		if manageUserAttachment {
+			user["profiles"] = vpnUser.Profiles
+		}
+		users = append(users, user)
+	}
+	if err := d.Set("users", users); err != nil {
+		return diag.Errorf("failed to set users: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAviatrixVPNUsersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	flag := false
+	defer resourceAviatrixVPNUsersReadIfRequired(ctx, d, meta, &flag)
+
+	if d.HasChanges("users", "manage_user_attachment") {
+		target := vpnUsersTarget(d)
+		oldUsers, newUsers := d.GetChange("users")
+		err := reconcileVPNUsers(ctx, client, expandVPNUsers(target, oldUsers.(*schema.Set)), expandVPNUsers(target, newUsers.(*schema.Set)), d.Get("manage_user_attachment").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAviatrixVPNUsersReadIfRequired(ctx, d, meta, &flag)
+}
+
+func resourceAviatrixVPNUsersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(goaviatrix.ClientInterface)
+
+	oldUsers := expandVPNUsers(vpnUsersTarget(d), d.Get("users").(*schema.Set))
+	err := reconcileVPNUsers(ctx, client, oldUsers, nil, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
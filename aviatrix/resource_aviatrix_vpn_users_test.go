@@ -0,0 +1,152 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAviatrixVPNUsers_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aviatrix_vpn_users.test_vpn_users"
+
+	skipAcc := os.Getenv("SKIP_VPN_USERS")
+	if skipAcc == "yes" {
+		t.Skip("Skipping VPN Users test as SKIP_VPN_USERS is set")
+	}
+	msg := ". Set SKIP_VPN_USERS to yes to skip VPN Users tests"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			preGatewayCheck(t, msg)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPNUsersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPNUsersConfigBasic(rName, "user@xyz.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPNUsersExists(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "gw_name", fmt.Sprintf("tfl-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+				),
+			},
+			{
+				Config: testAccVPNUsersConfigBasic(rName, "user@abc.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPNUsersExists(resourceName, 2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "users.*", map[string]string{
+						"user_name":  fmt.Sprintf("tfu1-%s", rName),
+						"user_email": "user@abc.com",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manage_user_attachment"},
+			},
+		},
+	})
+}
+
+func testAccVPNUsersConfigBasic(rName string, userEmail string) string {
+	return fmt.Sprintf(`
+resource "aviatrix_account" "test_account" {
+	account_name       = "tfa-%s"
+	cloud_type         = 1
+	aws_account_number = "%s"
+	aws_iam            = false
+	aws_access_key     = "%s"
+	aws_secret_key     = "%s"
+}
+resource "aviatrix_gateway" "test_gw" {
+	cloud_type   = 1
+	account_name = aviatrix_account.test_account.account_name
+	gw_name      = "tfg-%s"
+	vpc_id       = "%s"
+	vpc_reg      = "%s"
+	gw_size      = "t2.micro"
+	subnet       = "%s"
+	vpn_access   = true
+	vpn_cidr     = "192.168.43.0/24"
+	max_vpn_conn = "100"
+	enable_elb   = true
+	elb_name     = "tfl-%s"
+}
+resource "aviatrix_vpn_users" "test_vpn_users" {
+	vpc_id  = aviatrix_gateway.test_gw.vpc_id
+	gw_name = aviatrix_gateway.test_gw.elb_name
+
+	users {
+		user_name  = "tfu1-%s"
+		user_email = "%s"
+	}
+	users {
+		user_name  = "tfu2-%s"
+		user_email = "user@xyz.com"
+	}
+}
+	`, rName, os.Getenv("AWS_ACCOUNT_NUMBER"), os.Getenv("AWS_ACCESS_KEY"), os.Getenv("AWS_SECRET_KEY"),
+		rName, os.Getenv("AWS_VPC_ID"), os.Getenv("AWS_REGION"), os.Getenv("AWS_SUBNET"), rName,
+		rName, userEmail, rName)
+}
+
+func testAccCheckVPNUsersExists(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("VPN Users Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no VPN Users ID is set")
+		}
+
+		client := testAccProvider.Meta().(*goaviatrix.Client)
+
+		vpnUsers, err := client.ListVPNUsersContext(context.Background())
+		if err != nil {
+			return err
+		}
+		found := 0
+		for _, vpnUser := range vpnUsers {
+			if vpnUser.GwName == rs.Primary.Attributes["gw_name"] {
+				found++
+			}
+		}
+		if found != count {
+			return fmt.Errorf("expected %d VPN users, found %d", count, found)
+		}
+		return nil
+	}
+}
+
+func testAccCheckVPNUsersDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*goaviatrix.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aviatrix_vpn_users" {
+			continue
+		}
+
+		vpnUsers, err := client.ListVPNUsersContext(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, vpnUser := range vpnUsers {
+			if vpnUser.GwName == rs.Primary.Attributes["gw_name"] {
+				return fmt.Errorf("VPN user %s still exists", vpnUser.UserName)
+			}
+		}
+	}
+
+	return nil
+}
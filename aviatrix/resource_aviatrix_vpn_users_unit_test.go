@@ -0,0 +1,269 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// newVPNUsersClientMock returns a client keeping the VPN users in users, with
+// the profile attachments of every user.
+func newVPNUsersClientMock(users map[string]*goaviatrix.VPNUser) *goaviatrix.ClientInterfaceMock {
+	return &goaviatrix.ClientInterfaceMock{
+		ListVPNUsersContextFunc: func(ctx context.Context) ([]goaviatrix.VPNUser, error) {
+			var list []goaviatrix.VPNUser
+			for _, user := range users {
+				list = append(list, *user)
+			}
+			sort.Slice(list, func(i, j int) bool { return list[i].UserName < list[j].UserName })
+			return list, nil
+		},
+		CreateVPNUserContextFunc: func(ctx context.Context, vpnUser *goaviatrix.VPNUser) error {
+			if _, ok := users[vpnUser.UserName]; ok {
+				return errors.New("user already exists")
+			}
+			user := *vpnUser
+			user.Profiles = nil
+			users[user.UserName] = &user
+			return nil
+		},
+		UpdateVPNUserContextFunc: func(ctx context.Context, vpnUser *goaviatrix.VPNUser) error {
+			users[vpnUser.UserName].UserEmail = vpnUser.UserEmail
+			users[vpnUser.UserName].SamlEndpoint = vpnUser.SamlEndpoint
+			return nil
+		},
+		DeleteVPNUserContextFunc: func(ctx context.Context, vpnUser *goaviatrix.VPNUser) error {
+			delete(users, vpnUser.UserName)
+			return nil
+		},
+		AttachUsersContextFunc: func(ctx context.Context, profile *goaviatrix.Profile) error {
+			for _, userName := range profile.UserList {
+				users[userName].Profiles = append(users[userName].Profiles, profile.Name)
+			}
+			return nil
+		},
+		DetachUsersContextFunc: func(ctx context.Context, profile *goaviatrix.Profile) error {
+			for _, userName := range profile.UserList {
+				users[userName].Profiles = goaviatrix.Difference(users[userName].Profiles, []string{profile.Name})
+			}
+			return nil
+		},
+	}
+}
+
+// testVPNUsers returns the users block of the given user names, each attached
+// to the given profiles.
+func testVPNUsers(profiles []interface{}, userNames ...string) []interface{} {
+	var users []interface{}
+	for _, userName := range userNames {
+		users = append(users, map[string]interface{}{
+			"user_name":  userName,
+			"user_email": userName + "@example.com",
+			"profiles":   profiles,
+		})
+	}
+	return users
+}
+
+func TestResourceAviatrixVPNUsersCreate(t *testing.T) {
+	users := map[string]*goaviatrix.VPNUser{
+		"other": {UserName: "other", VpcID: "vpc-2", GwName: "other-elb"},
+	}
+	client := newVPNUsersClientMock(users)
+	d := schema.TestResourceDataRaw(t, resourceAviatrixVPNUsers().Schema, map[string]interface{}{
+		"vpc_id":                 "vpc-1",
+		"gw_name":                "vpn-elb",
+		"manage_user_attachment": true,
+		"users":                  testVPNUsers([]interface{}{"dev"}, "alice", "bob"),
+	})
+
+	diags := resourceAviatrixVPNUsersCreate(context.Background(), d, client)
+
+	assert.Empty(t, diags)
+	assert.Equal(t, "vpn-elb", d.Id())
+	assert.Len(t, client.CreateVPNUserContextCalls(), 2)
+	assert.Empty(t, client.UpdateVPNUserContextCalls())
+	if assert.Len(t, client.AttachUsersContextCalls(), 1) {
+		assert.Equal(t, []string{"alice", "bob"}, client.AttachUsersContextCalls()[0].Profile.UserList)
+	}
+	assert.Equal(t, "vpc-1", users["alice"].VpcID)
+	assert.Equal(t, "bob@example.com", users["bob"].UserEmail)
+	assert.Equal(t, 2, d.Get("users").(*schema.Set).Len())
+}
+
+func TestResourceAviatrixVPNUsersRead(t *testing.T) {
+	users := map[string]*goaviatrix.VPNUser{
+		"alice": {UserName: "alice", VpcID: "vpc-1", GwName: "vpn-elb", UserEmail: "alice@example.com", Profiles: []string{"dev"}},
+		"bob":   {UserName: "bob", DnsEnabled: true, DnsName: "vpn.example.com"},
+		"carol": {UserName: "carol", VpcID: "vpc-2", GwName: "other-elb"},
+	}
+
+	t.Run("import by gateway", func(t *testing.T) {
+		d := resourceAviatrixVPNUsers().TestResourceData()
+		d.SetId("vpn-elb")
+
+		diags := resourceAviatrixVPNUsersRead(context.Background(), d, newVPNUsersClientMock(users))
+
+		assert.Empty(t, diags)
+		assert.Equal(t, "vpn-elb", d.Get("gw_name"))
+		assert.Equal(t, "vpc-1", d.Get("vpc_id"))
+		assert.Equal(t, true, d.Get("manage_user_attachment"))
+		list := d.Get("users").(*schema.Set).List()
+		if assert.Len(t, list, 1) {
+			user := list[0].(map[string]interface{})
+			assert.Equal(t, "alice", user["user_name"])
+			assert.Equal(t, "alice@example.com", user["user_email"])
+			assert.Equal(t, []interface{}{"dev"}, user["profiles"].(*schema.Set).List())
+		}
+	})
+
+	t.Run("import by DNS name", func(t *testing.T) {
+		d := resourceAviatrixVPNUsers().TestResourceData()
+		d.SetId("vpn.example.com")
+
+		diags := resourceAviatrixVPNUsersRead(context.Background(), d, newVPNUsersClientMock(users))
+
+		assert.Empty(t, diags)
+		assert.Equal(t, "", d.Get("gw_name"))
+		assert.Equal(t, "vpn.example.com", d.Get("dns_name"))
+		list := d.Get("users").(*schema.Set).List()
+		if assert.Len(t, list, 1) {
+			assert.Equal(t, "bob", list[0].(map[string]interface{})["user_name"])
+		}
+	})
+}
+
+func TestReconcileVPNUsers(t *testing.T) {
+	users := map[string]*goaviatrix.VPNUser{
+		"alice": {UserName: "alice", VpcID: "vpc-1", GwName: "vpn-elb", UserEmail: "alice@example.com", Profiles: []string{"dev"}},
+		"bob":   {UserName: "bob", VpcID: "vpc-1", GwName: "vpn-elb", UserEmail: "bob@example.com", Profiles: []string{"dev"}},
+		"carol": {UserName: "carol", VpcID: "vpc-1", GwName: "vpn-elb", UserEmail: "carol@example.com", Profiles: []string{"dev"}},
+	}
+	client := newVPNUsersClientMock(users)
+	oldUsers := map[string]*goaviatrix.VPNUser{}
+	for name, user := range users {
+		u := *user
+		oldUsers[name] = &u
+	}
+	newUsers := map[string]*goaviatrix.VPNUser{
+		// unchanged
+		"alice": {UserName: "alice", VpcID: "vpc-1", GwName: "vpn-elb", UserEmail: "alice@example.com", Profiles: []string{"dev"}},
+		// new email and profile
+		"bob": {UserName: "bob", VpcID: "vpc-1", GwName: "vpn-elb", UserEmail: "bob@example.org", Profiles: []string{"prod"}},
+		// carol removed, dave added
+		"dave": {UserName: "dave", VpcID: "vpc-1", GwName: "vpn-elb", Profiles: []string{"prod"}},
+	}
+
+	err := reconcileVPNUsers(context.Background(), client, oldUsers, newUsers, true)
+
+	assert.NoError(t, err)
+	if assert.Len(t, client.DeleteVPNUserContextCalls(), 1) {
+		assert.Equal(t, "carol", client.DeleteVPNUserContextCalls()[0].VpnUser.UserName)
+	}
+	if assert.Len(t, client.CreateVPNUserContextCalls(), 1) {
+		assert.Equal(t, "dave", client.CreateVPNUserContextCalls()[0].VpnUser.UserName)
+	}
+	if assert.Len(t, client.UpdateVPNUserContextCalls(), 1) {
+		assert.Equal(t, "bob", client.UpdateVPNUserContextCalls()[0].VpnUser.UserName)
+	}
+	if assert.Len(t, client.DetachUsersContextCalls(), 1) {
+		assert.Equal(t, &goaviatrix.Profile{Name: "dev", UserList: []string{"bob"}}, client.DetachUsersContextCalls()[0].Profile)
+	}
+	if assert.Len(t, client.AttachUsersContextCalls(), 1) {
+		assert.Equal(t, &goaviatrix.Profile{Name: "prod", UserList: []string{"bob", "dave"}}, client.AttachUsersContextCalls()[0].Profile)
+	}
+	assert.Equal(t, "bob@example.org", users["bob"].UserEmail)
+	assert.NotContains(t, users, "carol")
+}
+
+func TestReconcileVPNUsers_WithoutUserAttachment(t *testing.T) {
+	client := newVPNUsersClientMock(map[string]*goaviatrix.VPNUser{})
+	newUsers := map[string]*goaviatrix.VPNUser{
+		"alice": {UserName: "alice", VpcID: "vpc-1", GwName: "vpn-elb", Profiles: []string{"dev"}},
+	}
+
+	err := reconcileVPNUsers(context.Background(), client, nil, newUsers, false)
+
+	assert.NoError(t, err)
+	assert.Len(t, client.CreateVPNUserContextCalls(), 1)
+	assert.Empty(t, client.AttachUsersContextCalls())
+}
+
+func TestResourceAviatrixVPNUsersCustomizeDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "valid",
+			config: map[string]interface{}{
+				"gw_name":                "vpn-elb",
+				"vpc_id":                 "vpc-0123",
+				"manage_user_attachment": true,
+				"users":                  testVPNUsers([]interface{}{"dev"}, "alice", "bob"),
+			},
+		},
+		{
+			name: "duplicate user name",
+			config: map[string]interface{}{
+				"gw_name": "vpn-elb",
+				"vpc_id":  "vpc-0123",
+				"users": []interface{}{
+					map[string]interface{}{"user_name": "alice", "user_email": "alice@example.com"},
+					map[string]interface{}{"user_name": "alice", "user_email": "alice@example.org"},
+				},
+			},
+			wantErr: `VPN user "alice" is set more than once in 'users'`,
+		},
+		{
+			name: "profiles without user attachment",
+			config: map[string]interface{}{
+				"gw_name": "vpn-elb",
+				"vpc_id":  "vpc-0123",
+				"users":   testVPNUsers([]interface{}{"dev"}, "alice"),
+			},
+			wantErr: `'manage_user_attachment' is set false. Please empty 'profiles' of VPN user "alice" and manage user attachment in other resource`,
+		},
+		{
+			name: "unknown user names",
+			config: map[string]interface{}{
+				"gw_name": "vpn-elb",
+				"vpc_id":  "vpc-0123",
+				"users": []interface{}{
+					map[string]interface{}{"user_name": unknownValue, "user_email": "alice@example.com"},
+					map[string]interface{}{"user_name": unknownValue, "user_email": "bob@example.com"},
+				},
+			},
+		},
+		{
+			name: "duplicate user name next to an unknown one",
+			config: map[string]interface{}{
+				"gw_name": "vpn-elb",
+				"vpc_id":  "vpc-0123",
+				"users": []interface{}{
+					map[string]interface{}{"user_name": unknownValue},
+					map[string]interface{}{"user_name": "bob", "user_email": "bob@example.com"},
+					map[string]interface{}{"user_name": "bob", "user_email": "bob@example.org"},
+				},
+			},
+			wantErr: `VPN user "bob" is set more than once in 'users'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testPlanDiff(resourceAviatrixVPNUsers(), nil, tt.config)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
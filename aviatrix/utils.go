@@ -1,25 +1,32 @@
 package aviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net"
 	"regexp"
 	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/go-version"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+// defaultInfrastructureTimeout is the default create, update and delete
+// timeout of resources which launch or tear down cloud instances.
+const defaultInfrastructureTimeout = 90 * time.Minute
+
 // validateAzureAZ is a SchemaValidateFunc for Azure Availability Zone
 // parameters.
 func validateAzureAZ(i interface{}, k string) (warnings []string, errors []error) {
@@ -48,10 +55,10 @@ func DiffSuppressFuncString(k, old, new string, d *schema.ResourceData) bool {
 	return goaviatrix.Equivalent(oldValue, newValue)
 }
 
-func captureErr(fn func(*schema.ResourceData, interface{}) error,
-	d *schema.ResourceData, meta interface{}, err *error,
+func captureDiags(ctx context.Context, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics,
+	d *schema.ResourceData, meta interface{}, diags *diag.Diagnostics,
 ) {
-	*err = fn(d, meta)
+	*diags = append(*diags, fn(ctx, d, meta)...)
 }
 
 func DiffSuppressFuncIgnoreSpaceInString(k, old, new string, d *schema.ResourceData) bool {
@@ -111,6 +118,15 @@ func getStringSet(d *schema.ResourceData, k string) []string {
 	return sl
 }
 
+// getStringMap will convert a TypeMap attribute of strings to a map of string
+func getStringMap(d *schema.ResourceData, k string) map[string]string {
+	m := make(map[string]string)
+	for key, v := range d.Get(k).(map[string]interface{}) {
+		m[key] = v.(string)
+	}
+	return m
+}
+
 func stringInSlice(needle string, haystack []string) bool {
 	for _, element := range haystack {
 		if element == needle {
@@ -126,16 +142,27 @@ var (
 	gcpTagMatcher   = regexp.MustCompile(`^[\p{Ll}\p{Lo}\p{N}_-]*$`)
 )
 
-func extractTags(d *schema.ResourceData, cloudType int) (map[string]string, error) {
+// extractTags returns the tags of the resource merged with the provider default
+// tags. Tags set on the resource take precedence over default tags with the
+// same key. Default tags are only added in clouds supporting tags.
+func extractTags(d *schema.ResourceData, cloudType int, defaultTags *goaviatrix.DefaultTagsConfig) (map[string]string, error) {
 	tags, ok := d.GetOk("tags")
-	if !ok {
+	if !ok && !defaultTags.HasTags() {
 		return nil, nil
 	}
 	if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
+		if !ok {
+			return nil, nil
+		}
 		return nil, fmt.Errorf("adding tags is only supported for AWS (1), GCP (4), Azure (8), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
 	}
-	tagsMap := tags.(map[string]interface{})
-	tagsStrMap := make(map[string]string, len(tagsMap))
+	resourceTags := make(goaviatrix.KeyValueTags)
+	if ok {
+		for key, val := range tags.(map[string]interface{}) {
+			resourceTags[key] = fmt.Sprint(val)
+		}
+	}
+	tagsStrMap := defaultTags.MergeTags(resourceTags)
 	var matcher *regexp.Regexp
 	if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) {
 		matcher = gcpTagMatcher
@@ -145,17 +172,119 @@ func extractTags(d *schema.ResourceData, cloudType int) (map[string]string, erro
 		matcher = awsTagMatcher
 	}
 
-	for key, val := range tagsMap {
-		valStr := fmt.Sprint(val)
-		matched := matcher.MatchString(key + valStr)
+	for key, val := range tagsStrMap {
+		matched := matcher.MatchString(key + val)
 		if !matched {
 			return nil, fmt.Errorf("illegal characters in tags")
 		}
-		tagsStrMap[key] = valStr
 	}
 	return tagsStrMap, nil
 }
 
+// setTags sets tags and tags_all from the tags read from the controller.
+// tags_all holds every tag of the resource, while tags leaves out the
+// provider default tags that are not set on the resource itself.
+func setTags(d *schema.ResourceData, client goaviatrix.ClientInterface, tags map[string]string) error {
+	tagsAll := goaviatrix.KeyValueTags(tags).IgnoreConfig(client.GetIgnoreTagsConfig())
+	keep := make(goaviatrix.KeyValueTags)
+	for key := range d.Get("tags").(map[string]interface{}) {
+		keep[key] = ""
+	}
+	if err := d.Set("tags", tagsAll.RemoveDefaultConfig(client.GetDefaultTagsConfig(), keep)); err != nil {
+		return err
+	}
+	return d.Set("tags_all", tagsAll)
+}
+
+// customizeDiffTagsAll returns a CustomizeDiffFunc planning tags_all as the
+// resource tags merged with the provider default tags, for resources that
+// support tags in the given cloud types. This makes a change to the default
+// tags alone show up as an update of the resource.
+func customizeDiffTagsAll(cloudTypes int) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown("tags") || !diff.NewValueKnown("cloud_type") {
+			return diff.SetNewComputed("tags_all")
+		}
+		if !goaviatrix.IsCloudType(diff.Get("cloud_type").(int), cloudTypes) {
+			return nil
+		}
+
+		var defaultTags *goaviatrix.DefaultTagsConfig
+		var ignoreTags *goaviatrix.IgnoreTagsConfig
+		if client, ok := meta.(goaviatrix.ClientInterface); ok && client != nil {
+			defaultTags = client.GetDefaultTagsConfig()
+			ignoreTags = client.GetIgnoreTagsConfig()
+		}
+
+		tags := make(goaviatrix.KeyValueTags)
+		for key, val := range diff.Get("tags").(map[string]interface{}) {
+			tags[key] = fmt.Sprint(val)
+		}
+		tagsAll := defaultTags.MergeTags(tags).IgnoreConfig(ignoreTags)
+		return diff.SetNew("tags_all", map[string]string(tagsAll))
+	}
+}
+
+// accountAuditClients holds the clients of the provider configurations
+// setting the check_account_audit provider argument.
+var accountAuditClients sync.Map
+
+// customizeDiffAccountAudit fails the plan of a resource whose access
+// account did not pass the controller's account audit, when the
+// check_account_audit provider argument is set. Only new resources and
+// changed accounts are checked.
+func customizeDiffAccountAudit(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if meta == nil {
+		return nil
+	}
+	if _, ok := accountAuditClients.Load(meta); !ok {
+		return nil
+	}
+	client := meta.(goaviatrix.ClientInterface)
+	if !diff.NewValueKnown("account_name") || (diff.Id() != "" && !diff.HasChange("account_name")) {
+		return nil
+	}
+
+	accountName := diff.Get("account_name").(string)
+	records, err := client.GetCachedAccountAuditRecords(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get audit records to check account %q: %w", accountName, err)
+	}
+	for _, record := range records {
+		if record.AccountName == accountName && !record.IsAuditPassed() {
+			return fmt.Errorf("account %q did not pass the account audit (status %q): %s", accountName, record.Status, record.Comment)
+		}
+	}
+	return nil
+}
+
+// diffNeedsValidation reports whether a plan-time cross-field rule over the
+// given keys should be checked. All planned values must be known, since an
+// unknown value reads as its zero value, and for existing resources at least
+// one of the keys must be changing so rules only enforced on create do not
+// start failing plans of resources that are already deployed.
+func diffNeedsValidation(diff *schema.ResourceDiff, keys ...string) bool {
+	for _, key := range keys {
+		if !diff.NewValueKnown(key) {
+			return false
+		}
+	}
+	return diff.Id() == "" || diff.HasChanges(keys...)
+}
+
+// validateInsaneModeSubnet checks that subnet is a /26 CIDR segment of the
+// VPC, from which the controller creates the Insane Mode gateway subnet.
+func validateInsaneModeSubnet(subnet string) error {
+	_, ipNet, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return fmt.Errorf("'subnet' must be a valid /26 CIDR segment of the VPC if insane_mode is enabled: %v", err)
+	}
+	if ones, _ := ipNet.Mask.Size(); ones != 26 {
+		return fmt.Errorf("'subnet' must be a /26 CIDR segment of the VPC if insane_mode is enabled, got %q", subnet)
+	}
+	return nil
+}
+
 func TagsMapToJson(tagsMap map[string]string) (string, error) {
 	bytes, err := json.Marshal(tagsMap)
 	if err != nil {
@@ -189,6 +318,26 @@ func validateAzureEipNameResourceGroup(i interface{}, k string) (warnings []stri
 	return
 }
 
+// validateDuration is a SchemaValidateFunc for durations such as "500ms" or "10m".
+func validateDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %s to be a duration such as \"500ms\" or \"10m\": %v", k, err))
+		return
+	}
+	if d < 0 {
+		errors = append(errors, fmt.Errorf("expected %s to not be negative, got %s", k, v))
+	}
+
+	return
+}
+
 func DiffSuppressFuncGatewayVpcId(k, old, new string, d *schema.ResourceData) bool {
 	cloudType := d.Get("cloud_type").(int)
 	if goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
@@ -301,14 +450,13 @@ func checkPAVMVersionFormat(version string) string {
 
 // compareVersion compares two Semantic Versions
 func compareVersion(version1, version2 string) bool {
+	// a version in an unsupported format never compares greater
 	v1, err := version.NewVersion(version1)
 	if err != nil {
-		log.Printf("unsupported firewall image version format: %s\n", version1)
 		return false
 	}
 	v2, err := version.NewVersion(version2)
 	if err != nil {
-		log.Printf("unsupported firewall image version format: %s\n", version2)
 		return false
 	}
 	return v1.GreaterThan(v2)
@@ -1,11 +1,14 @@
 package aviatrix
 
 import (
+	"context"
 	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -227,3 +230,207 @@ func TestValidateCIDRRule(t *testing.T) {
 func contains(s, substr string) bool {
 	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
 }
+
+func TestCustomizeDiffTagsAll(t *testing.T) {
+	client := &goaviatrix.Client{
+		DefaultTagsConfig: &goaviatrix.DefaultTagsConfig{Tags: goaviatrix.KeyValueTags{"owner": "netops", "env": "dev"}},
+		IgnoreTagsConfig:  &goaviatrix.IgnoreTagsConfig{KeyPrefixes: goaviatrix.KeyValueTags{"aws:": ""}},
+	}
+
+	tests := []struct {
+		name     string
+		state    map[string]string
+		config   map[string]interface{}
+		expected map[string]string
+	}{
+		{
+			name: "defaults merged on create",
+			config: map[string]interface{}{
+				"cloud_type": 1,
+				"tags":       map[string]interface{}{"env": "prod"},
+			},
+			expected: map[string]string{
+				"tags_all.%":     "2",
+				"tags_all.owner": "netops",
+				"tags_all.env":   "prod",
+			},
+		},
+		{
+			name: "defaults not added for clouds without tags",
+			config: map[string]interface{}{
+				"cloud_type": 16,
+			},
+			expected: map[string]string{},
+		},
+		{
+			name: "changed default alone updates tags_all",
+			state: map[string]string{
+				"id":             "gw",
+				"cloud_type":     "1",
+				"tags.%":         "0",
+				"tags_all.%":     "2",
+				"tags_all.owner": "security",
+				"tags_all.env":   "dev",
+			},
+			config: map[string]interface{}{
+				"cloud_type": 1,
+			},
+			expected: map[string]string{
+				"tags_all.owner": "netops",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var state *terraform.InstanceState
+			if tt.state != nil {
+				state = &terraform.InstanceState{ID: tt.state["id"], Attributes: tt.state}
+			}
+			diff, err := resourceAviatrixGateway().SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(tt.config), client)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			actual := make(map[string]string)
+			if diff != nil {
+				for k, v := range diff.Attributes {
+					if strings.HasPrefix(k, "tags_all") && !v.NewComputed {
+						actual[k] = v.New
+					}
+				}
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+// unknownValue is the placeholder Terraform uses for values not known until apply.
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// testPlanDiff runs the plan-time diff of r for the given prior state (nil for
+// a new resource) and config, returning any CustomizeDiff error.
+func testPlanDiff(r *schema.Resource, state map[string]string, config map[string]interface{}) error {
+	var s *terraform.InstanceState
+	if state != nil {
+		s = &terraform.InstanceState{ID: state["id"], Attributes: state}
+	}
+	_, err := r.SimpleDiff(context.Background(), s, terraform.NewResourceConfigRaw(config), &goaviatrix.Client{})
+	return err
+}
+
+func TestValidateInsaneModeSubnet(t *testing.T) {
+	tests := []struct {
+		name    string
+		subnet  string
+		wantErr string
+	}{
+		{name: "valid /26", subnet: "10.0.0.64/26"},
+		{name: "wrong prefix length", subnet: "10.0.0.0/24", wantErr: "must be a /26 CIDR segment"},
+		{name: "not a CIDR", subnet: "subnet-1234", wantErr: "must be a valid /26 CIDR segment"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateInsaneModeSubnet(tt.subnet)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCustomizeDiffAccountAudit(t *testing.T) {
+	config := func(accountName string) map[string]interface{} {
+		return map[string]interface{}{
+			"cloud_type":   1,
+			"account_name": accountName,
+			"gw_name":      "gw",
+			"vpc_id":       "vpc-0123456789",
+			"vpc_reg":      "us-west-1",
+			"gw_size":      "t3.small",
+			"subnet":       "10.3.0.0/24",
+		}
+	}
+
+	tests := []struct {
+		name       string
+		enabled    bool
+		state      map[string]string
+		config     map[string]interface{}
+		wantErr    string
+		wantChecks int
+	}{
+		{
+			name:   "disabled",
+			config: config("azure-dev"),
+		},
+		{
+			name:       "new gateway with passed account",
+			enabled:    true,
+			config:     config("aws-prod"),
+			wantChecks: 1,
+		},
+		{
+			name:       "new gateway with failed account",
+			enabled:    true,
+			config:     config("azure-dev"),
+			wantErr:    `account "azure-dev" did not pass the account audit (status "Fail"): client secret expired`,
+			wantChecks: 1,
+		},
+		{
+			name:       "new gateway with account missing from the audit",
+			enabled:    true,
+			config:     config("oci-dev"),
+			wantChecks: 1,
+		},
+		{
+			name:    "existing gateway with unchanged account",
+			enabled: true,
+			state: map[string]string{
+				"id":           "gw",
+				"cloud_type":   "1",
+				"account_name": "azure-dev",
+				"gw_name":      "gw",
+				"vpc_id":       "vpc-0123456789",
+				"vpc_reg":      "us-west-1",
+				"gw_size":      "t3.small",
+				"subnet":       "10.3.0.0/24",
+			},
+			config: config("azure-dev"),
+		},
+		{
+			name:       "unknown account",
+			enabled:    true,
+			config:     config(unknownValue),
+			wantChecks: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newAccountAuditClientMock()
+			client.GetCachedAccountAuditRecordsFunc = client.GetAccountAuditRecordsFunc
+			if tt.enabled {
+				accountAuditClients.Store(client, true)
+				t.Cleanup(func() { accountAuditClients.Delete(client) })
+			}
+			client.GetDefaultTagsConfigFunc = func() *goaviatrix.DefaultTagsConfig { return nil }
+			client.GetIgnoreTagsConfigFunc = func() *goaviatrix.IgnoreTagsConfig { return nil }
+
+			var state *terraform.InstanceState
+			if tt.state != nil {
+				state = &terraform.InstanceState{ID: tt.state["id"], Attributes: tt.state}
+			}
+			_, err := resourceAviatrixGateway().SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(tt.config), client)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+			assert.Len(t, client.GetCachedAccountAuditRecordsCalls(), tt.wantChecks)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+// exportObject is an object found on the controller. ID is the import ID of
+// the object and Name the name its resource label is derived from.
+type exportObject struct {
+	ID   string
+	Name string
+}
+
+// exportType lists the objects of one resource type found on the controller.
+type exportType struct {
+	ResourceType string
+	List         func(ctx context.Context, client *goaviatrix.Client) ([]exportObject, error)
+}
+
+// exportTypes are the supported resource types, in the order they are
+// written.
+var exportTypes = []exportType{
+	{"aviatrix_account", listAccounts},
+	{"aviatrix_vpc", listVpcs},
+	{"aviatrix_transit_gateway", listTransitGateways},
+	{"aviatrix_spoke_gateway", listSpokeGateways},
+	{"aviatrix_smart_group", listSmartGroups},
+	{"aviatrix_fqdn", listFQDNTags},
+	{"aviatrix_distributed_firewalling_policy_list", listDistributedFirewallingPolicyList},
+}
+
+// exportTypesByName returns the export types with the given resource types,
+// or all of them when resourceTypes is empty.
+func exportTypesByName(resourceTypes []string) ([]exportType, error) {
+	if len(resourceTypes) == 0 {
+		return exportTypes, nil
+	}
+
+	var types []exportType
+	for _, exportType := range exportTypes {
+		if goaviatrix.Contains(resourceTypes, exportType.ResourceType) {
+			types = append(types, exportType)
+		}
+	}
+	if len(types) != len(resourceTypes) {
+		var supported []string
+		for _, exportType := range exportTypes {
+			supported = append(supported, exportType.ResourceType)
+		}
+		return nil, fmt.Errorf("unsupported or duplicate resource type in %q, supported types are: %s",
+			strings.Join(resourceTypes, ","), strings.Join(supported, ", "))
+	}
+	return types, nil
+}
+
+func listAccounts(ctx context.Context, client *goaviatrix.Client) ([]exportObject, error) {
+	accounts, err := client.ListAccountsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var objects []exportObject
+	for _, account := range accounts {
+		objects = append(objects, exportObject{ID: account.AccountName, Name: account.AccountName})
+	}
+	return objects, nil
+}
+
+// listVpcs lists the VPCs known to the VPC tracker that were created by the
+// controller, as only those can be managed by aviatrix_vpc.
+func listVpcs(ctx context.Context, client *goaviatrix.Client) ([]exportObject, error) {
+	vpcs, err := client.GetVpcTrackerContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var objects []exportObject
+	for _, vpc := range vpcs {
+		if vpc.Name == "" {
+			continue
+		}
+		_, err := client.GetVpcContext(ctx, &goaviatrix.Vpc{Name: vpc.Name})
+		if errors.Is(err, goaviatrix.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("could not get VPC %s: %w", vpc.Name, err)
+		}
+		objects = append(objects, exportObject{ID: vpc.Name, Name: vpc.Name})
+	}
+	return objects, nil
+}
+
+func listTransitGateways(ctx context.Context, client *goaviatrix.Client) ([]exportObject, error) {
+	gateways, err := client.GetTransitGatewayList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return gatewayObjects(gateways), nil
+}
+
+func listSpokeGateways(ctx context.Context, client *goaviatrix.Client) ([]exportObject, error) {
+	gateways, err := client.GetSpokeGatewayList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return gatewayObjects(gateways), nil
+}
+
+// gatewayObjects skips HA gateways, which are managed by the resource of
+// their primary gateway.
+func gatewayObjects(gateways []goaviatrix.Gateway) []exportObject {
+	var objects []exportObject
+	for _, gw := range gateways {
+		if strings.HasSuffix(gw.GwName, "-hagw") {
+			continue
+		}
+		objects = append(objects, exportObject{ID: gw.GwName, Name: gw.GwName})
+	}
+	return objects
+}
+
+func listSmartGroups(ctx context.Context, client *goaviatrix.Client) ([]exportObject, error) {
+	smartGroups, err := client.GetSmartGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var objects []exportObject
+	for _, smartGroup := range smartGroups {
+		objects = append(objects, exportObject{ID: smartGroup.UUID, Name: smartGroup.Name})
+	}
+	return objects, nil
+}
+
+func listFQDNTags(ctx context.Context, client *goaviatrix.Client) ([]exportObject, error) {
+	tags, err := client.ListFQDNTagsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var objects []exportObject
+	for _, tag := range tags {
+		objects = append(objects, exportObject{ID: tag.FQDNTag, Name: tag.FQDNTag})
+	}
+	return objects, nil
+}
+
+// listDistributedFirewallingPolicyList returns the policy list of the
+// controller unless it is empty.
+func listDistributedFirewallingPolicyList(ctx context.Context, client *goaviatrix.Client) ([]exportObject, error) {
+	_, err := client.GetDistributedFirewallingPolicyList(ctx)
+	if errors.Is(err, goaviatrix.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return []exportObject{{ID: strings.Replace(client.GetControllerIP(), ".", "-", -1), Name: "policy_list"}}, nil
+}
+
+// exportedResource is an object read through its provider resource.
+type exportedResource struct {
+	Type     string
+	Label    string
+	ID       string
+	Resource *schema.Resource
+	Data     *schema.ResourceData
+}
+
+// exportResult holds the exported resources and a warning for every object
+// that could not be read.
+type exportResult struct {
+	Resources []*exportedResource
+	Warnings  []string
+}
+
+// export reads the objects of types found on the controller with the
+// resources of p, which must be configured.
+func export(ctx context.Context, p *schema.Provider, types []exportType) (*exportResult, error) {
+	client, ok := p.Meta().(*goaviatrix.Client)
+	if !ok {
+		return nil, errors.New("provider is not configured")
+	}
+
+	result := &exportResult{}
+	for _, exportType := range types {
+		r, ok := p.ResourcesMap[exportType.ResourceType]
+		if !ok {
+			return nil, fmt.Errorf("resource type %s is not supported by the provider", exportType.ResourceType)
+		}
+
+		objects, err := exportType.List(ctx, client)
+		if err != nil {
+			return nil, fmt.Errorf("could not list %s: %w", exportType.ResourceType, err)
+		}
+		sort.Slice(objects, func(i, j int) bool {
+			if objects[i].Name != objects[j].Name {
+				return objects[i].Name < objects[j].Name
+			}
+			return objects[i].ID < objects[j].ID
+		})
+
+		labels := map[string]bool{}
+		for _, object := range objects {
+			d, err := importResource(ctx, r, object.ID, client)
+			if err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("skipping %s %q: %v", exportType.ResourceType, object.ID, err))
+				continue
+			}
+			if d == nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("skipping %s %q: not found", exportType.ResourceType, object.ID))
+				continue
+			}
+
+			label := resourceLabel(object.Name)
+			for i := 2; labels[label]; i++ {
+				label = fmt.Sprintf("%s_%d", resourceLabel(object.Name), i)
+			}
+			labels[label] = true
+
+			result.Resources = append(result.Resources, &exportedResource{
+				Type:     exportType.ResourceType,
+				Label:    label,
+				ID:       object.ID,
+				Resource: r,
+				Data:     d,
+			})
+		}
+	}
+	return result, nil
+}
+
+// importResource imports and reads the object with the given import ID the
+// same way terraform import does. It returns nil when the object is gone.
+func importResource(ctx context.Context, r *schema.Resource, id string, meta interface{}) (*schema.ResourceData, error) {
+	d := r.Data(nil)
+	d.SetId(id)
+
+	if r.Importer != nil {
+		var imported []*schema.ResourceData
+		var err error
+		switch {
+		case r.Importer.StateContext != nil:
+			imported, err = r.Importer.StateContext(ctx, d, meta)
+		case r.Importer.State != nil:
+			imported, err = r.Importer.State(d, meta)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(imported) > 0 {
+			d = imported[0]
+		}
+	}
+
+	state, diags := r.RefreshWithoutUpgrade(ctx, d.State(), meta)
+	if diags.HasError() {
+		return nil, diagnosticsError(diags)
+	}
+	if state == nil || state.ID == "" {
+		return nil, nil
+	}
+	return r.Data(state), nil
+}
+
+// diagnosticsError joins the error summaries of diags.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var msgs []string
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			msgs = append(msgs, d.Summary)
+		}
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceLabel returns a valid resource label derived from name.
+func resourceLabel(name string) string {
+	label := invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_")
+	if label == "" || label[0] >= '0' && label[0] <= '9' {
+		label = "_" + label
+	}
+	return label
+}
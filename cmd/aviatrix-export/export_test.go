@@ -0,0 +1,294 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix/fakecontroller"
+)
+
+// testExportController returns a fake controller with an account, a VPC, a
+// transit and a spoke gateway, a smart group, a policy list and an FQDN tag,
+// and a provider configured to reach it.
+func testExportController(t *testing.T) (*fakecontroller.Server, *schema.Provider) {
+	ctx := context.Background()
+	srv := fakecontroller.New()
+	t.Cleanup(srv.Close)
+
+	srv.AddAccount(goaviatrix.Account{AccountName: "aws-account", CloudType: goaviatrix.AWS, AwsAccountNumber: "123456789012"})
+	srv.AddVpc(goaviatrix.VpcEdit{CloudType: goaviatrix.AWS, AccountName: "aws-account", Region: "us-west-1", Name: "spoke-vpc", Cidr: "10.1.0.0/16", VpcID: []string{"vpc-0123"}})
+	srv.AddGateway(goaviatrix.Gateway{GwName: "transit-gw", CloudType: goaviatrix.AWS, AccountName: "aws-account", VpcID: "vpc-0456", TransitVpc: "yes"})
+	srv.AddGateway(goaviatrix.Gateway{GwName: "spoke-gw", CloudType: goaviatrix.AWS, AccountName: "aws-account", VpcID: "vpc-0123"})
+	srv.AddFQDNTag(goaviatrix.FQDN{
+		FQDNTag:    "egress",
+		FQDNMode:   "white",
+		FQDNStatus: "enabled",
+		DomainList: []*goaviatrix.Filters{{FQDN: "*.example.com", Protocol: "tcp", Port: "443", Verdict: "Allow"}},
+		GwFilterTagList: []goaviatrix.GwFilterTag{
+			{Name: "spoke-gw", SourceIPList: []string{"10.1.0.0/24"}},
+		},
+	})
+
+	p, err := configureProvider(ctx, map[string]interface{}{
+		"controller_ip": srv.Host(),
+		"username":      srv.Username,
+		"password":      srv.Password,
+	})
+	if err != nil {
+		t.Fatalf("could not configure provider: %v", err)
+	}
+
+	client := p.Meta().(*goaviatrix.Client)
+	uuid, err := client.CreateSmartGroup(ctx, &goaviatrix.SmartGroup{
+		Name: "Web Tier",
+		Selector: goaviatrix.SmartGroupSelector{
+			Expressions: []*goaviatrix.SmartGroupMatchExpression{{CIDR: "10.0.0.0/16"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("could not create smart group: %v", err)
+	}
+	err = client.CreateDistributedFirewallingPolicyList(ctx, &goaviatrix.DistributedFirewallingPolicyList{
+		Policies: []goaviatrix.DistributedFirewallingPolicy{{
+			Name:           "allow-web",
+			Action:         "PERMIT",
+			Priority:       1,
+			Protocol:       "TCP",
+			SrcSmartGroups: []string{uuid},
+			DstSmartGroups: []string{uuid},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("could not create policy list: %v", err)
+	}
+	return srv, p
+}
+
+// testParseHCL parses src and returns the attributes of its import blocks
+// and its resource blocks by address.
+func testParseHCL(t *testing.T, src []byte) (map[string]string, map[string]*hclsyntax.Body) {
+	f, diags := hclsyntax.ParseConfig(src, "export.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("invalid HCL: %v\n%s", diags, src)
+	}
+
+	imports := map[string]string{}
+	resources := map[string]*hclsyntax.Body{}
+	for _, block := range f.Body.(*hclsyntax.Body).Blocks {
+		switch block.Type {
+		case "import":
+			to, diags := hcl.AbsTraversalForExpr(block.Body.Attributes["to"].Expr)
+			assert.False(t, diags.HasErrors(), "%v", diags)
+			id, _ := block.Body.Attributes["id"].Expr.Value(nil)
+			imports[to.RootName()+"."+to[1].(hcl.TraverseAttr).Name] = id.AsString()
+		case "resource":
+			resources[strings.Join(block.Labels, ".")] = block.Body
+		}
+	}
+	return imports, resources
+}
+
+// testAttr returns the value of the attribute name of body as a string.
+func testAttr(t *testing.T, body *hclsyntax.Body, name string) string {
+	attr, ok := body.Attributes[name]
+	if !ok {
+		return ""
+	}
+	v, diags := attr.Expr.Value(nil)
+	assert.False(t, diags.HasErrors(), "%v", diags)
+	if v.Type() == cty.String {
+		return v.AsString()
+	}
+	return v.GoString()
+}
+
+func TestExport(t *testing.T) {
+	srv, p := testExportController(t)
+
+	result, err := export(context.Background(), p, exportTypes)
+	assert.NoError(t, err)
+	var buf bytes.Buffer
+	assert.NoError(t, writeHCL(&buf, result.Resources))
+
+	imports, resources := testParseHCL(t, buf.Bytes())
+	policyListID := strings.Replace(srv.Host(), ".", "-", -1)
+	smartGroupID := imports["aviatrix_smart_group.web_tier"]
+	assert.Equal(t, map[string]string{
+		"aviatrix_account.aws_account":                             "aws-account",
+		"aviatrix_vpc.spoke_vpc":                                   "spoke-vpc",
+		"aviatrix_transit_gateway.transit_gw":                      "transit-gw",
+		"aviatrix_spoke_gateway.spoke_gw":                          "spoke-gw",
+		"aviatrix_fqdn.egress":                                     "egress",
+		"aviatrix_smart_group.web_tier":                            smartGroupID,
+		"aviatrix_distributed_firewalling_policy_list.policy_list": policyListID,
+	}, imports)
+	assert.NotEmpty(t, smartGroupID)
+	assert.Len(t, resources, len(imports))
+
+	account := resources["aviatrix_account.aws_account"]
+	assert.Equal(t, "aws-account", testAttr(t, account, "account_name"))
+	assert.Equal(t, "123456789012", testAttr(t, account, "aws_account_number"))
+	assert.NotContains(t, account.Attributes, "aws_iam", "default values are not exported")
+
+	vpc := resources["aviatrix_vpc.spoke_vpc"]
+	assert.Equal(t, "10.1.0.0/16", testAttr(t, vpc, "cidr"))
+	assert.NotContains(t, vpc.Attributes, "vpc_id", "computed attributes are not exported")
+
+	assert.Equal(t, "vpc-0456", testAttr(t, resources["aviatrix_transit_gateway.transit_gw"], "vpc_id"))
+	assert.Equal(t, "vpc-0123", testAttr(t, resources["aviatrix_spoke_gateway.spoke_gw"], "vpc_id"))
+
+	smartGroup := resources["aviatrix_smart_group.web_tier"]
+	assert.Equal(t, "Web Tier", testAttr(t, smartGroup, "name"))
+	if assert.Len(t, smartGroup.Blocks, 1) && assert.Len(t, smartGroup.Blocks[0].Body.Blocks, 1) {
+		assert.Equal(t, "10.0.0.0/16", testAttr(t, smartGroup.Blocks[0].Body.Blocks[0].Body, "cidr"))
+	}
+
+	policyList := resources["aviatrix_distributed_firewalling_policy_list.policy_list"]
+	if assert.Len(t, policyList.Blocks, 1) {
+		assert.Equal(t, "allow-web", testAttr(t, policyList.Blocks[0].Body, "name"))
+	}
+
+	fqdn := resources["aviatrix_fqdn.egress"]
+	assert.Equal(t, "egress", testAttr(t, fqdn, "fqdn_tag"))
+	assert.Equal(t, "white", testAttr(t, fqdn, "fqdn_mode"))
+	if assert.Len(t, fqdn.Blocks, 2) {
+		assert.Equal(t, "domain_names", fqdn.Blocks[0].Type)
+		assert.Equal(t, "*.example.com", testAttr(t, fqdn.Blocks[0].Body, "fqdn"))
+		assert.Equal(t, "443", testAttr(t, fqdn.Blocks[0].Body, "port"))
+		assert.Equal(t, "gw_filter_tag_list", fqdn.Blocks[1].Type)
+		assert.Equal(t, "spoke-gw", testAttr(t, fqdn.Blocks[1].Body, "gw_name"))
+	}
+
+	assert.Empty(t, result.Warnings)
+}
+
+func TestExport_WhenListFails(t *testing.T) {
+	srv, p := testExportController(t)
+	srv.HandleAction("list_accounts", func(req *fakecontroller.Request) (interface{}, error) {
+		return nil, errors.New("boom")
+	})
+
+	_, err := export(context.Background(), p, exportTypes)
+
+	assert.ErrorContains(t, err, "could not list aviatrix_account: ")
+	assert.ErrorContains(t, err, "boom")
+}
+
+func TestRun(t *testing.T) {
+	srv, _ := testExportController(t)
+	t.Setenv("AVIATRIX_USERNAME", srv.Username)
+	t.Setenv("AVIATRIX_PASSWORD", srv.Password)
+	out := filepath.Join(t.TempDir(), "export.tf")
+
+	err := run(context.Background(), srv.Host(), "", out, "aviatrix_account,aviatrix_vpc", false)
+
+	assert.NoError(t, err)
+	src, err := os.ReadFile(out)
+	assert.NoError(t, err)
+	imports, _ := testParseHCL(t, src)
+	assert.Equal(t, map[string]string{
+		"aviatrix_account.aws_account": "aws-account",
+		"aviatrix_vpc.spoke_vpc":       "spoke-vpc",
+	}, imports)
+}
+
+func TestExportTypesByName(t *testing.T) {
+	types, err := exportTypesByName(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, len(exportTypes), len(types))
+
+	types, err = exportTypesByName([]string{"aviatrix_smart_group", "aviatrix_account"})
+	assert.NoError(t, err)
+	if assert.Len(t, types, 2) {
+		assert.Equal(t, "aviatrix_account", types[0].ResourceType)
+		assert.Equal(t, "aviatrix_smart_group", types[1].ResourceType)
+	}
+
+	_, err = exportTypesByName([]string{"aviatrix_gateway"})
+	assert.ErrorContains(t, err, `unsupported or duplicate resource type in "aviatrix_gateway"`)
+}
+
+func TestWriteHCL(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":       {Type: schema.TypeString, Required: true},
+			"secret":     {Type: schema.TypeString, Required: true, Sensitive: true},
+			"enabled":    {Type: schema.TypeBool, Optional: true, Default: true},
+			"disabled":   {Type: schema.TypeBool, Optional: true, Default: true},
+			"size":       {Type: schema.TypeInt, Optional: true},
+			"old_name":   {Type: schema.TypeString, Optional: true, Deprecated: "Use name instead."},
+			"public_ip":  {Type: schema.TypeString, Computed: true},
+			"cidrs":      {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"tags":       {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"empty_tags": {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port":     {Type: schema.TypeInt, Optional: true},
+						"protocol": {Type: schema.TypeString, Optional: true, Default: "TCP"},
+					},
+				},
+			},
+		},
+	}
+	d := r.Data(nil)
+	d.SetId("1")
+	d.Set("name", "gw 1")
+	d.Set("secret", "hunter2")
+	d.Set("enabled", true)
+	d.Set("disabled", false)
+	d.Set("old_name", "gw")
+	d.Set("public_ip", "1.2.3.4")
+	d.Set("cidrs", []interface{}{"10.0.0.0/16"})
+	d.Set("tags", map[string]interface{}{"Name": "gw"})
+	d.Set("rule", []interface{}{
+		map[string]interface{}{"port": 443, "protocol": "TCP"},
+		map[string]interface{}{"protocol": "TCP"},
+	})
+
+	var buf bytes.Buffer
+	err := writeHCL(&buf, []*exportedResource{{Type: "test_resource", Label: resourceLabel("gw 1"), ID: "1", Resource: r, Data: d}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, fileHeader+`
+import {
+  to = test_resource.gw_1
+  id = "1"
+}
+
+# Sensitive arguments are not exported: secret
+resource "test_resource" "gw_1" {
+  cidrs    = ["10.0.0.0/16"]
+  disabled = false
+  name     = "gw 1"
+  tags = {
+    Name = "gw"
+  }
+
+  rule {
+    port = 443
+  }
+}
+`, buf.String())
+}
+
+func TestResourceLabel(t *testing.T) {
+	assert.Equal(t, "aws_account", resourceLabel("aws-account"))
+	assert.Equal(t, "web_tier", resourceLabel("Web Tier"))
+	assert.Equal(t, "_10_0_0_0_16", resourceLabel("10.0.0.0/16"))
+	assert.Equal(t, "_", resourceLabel(""))
+}
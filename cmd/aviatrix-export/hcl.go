@@ -0,0 +1,190 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const fileHeader = `# Generated by aviatrix-export. Review the configuration and set the
+# sensitive arguments before running terraform plan.
+`
+
+// writeHCL writes an import block and a resource block for every resource.
+func writeHCL(w io.Writer, resources []*exportedResource) error {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	body.AppendUnstructuredTokens(commentTokens(fileHeader))
+
+	for _, res := range resources {
+		body.AppendNewline()
+		importBody := body.AppendNewBlock("import", nil).Body()
+		importBody.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: res.Type},
+			hcl.TraverseAttr{Name: res.Label},
+		})
+		importBody.SetAttributeValue("id", cty.StringVal(res.ID))
+		body.AppendNewline()
+
+		values := map[string]interface{}{}
+		for k := range res.Resource.Schema {
+			values[k] = res.Data.Get(k)
+		}
+		if sensitive := sensitiveArguments(res.Resource.Schema, values); len(sensitive) > 0 {
+			body.AppendUnstructuredTokens(commentTokens(fmt.Sprintf("# Sensitive arguments are not exported: %s\n", strings.Join(sensitive, ", "))))
+		}
+		resourceBody := body.AppendNewBlock("resource", []string{res.Type, res.Label}).Body()
+		writeBody(resourceBody, res.Resource.Schema, values)
+	}
+
+	_, err := w.Write(hclwrite.Format(f.Bytes()))
+	return err
+}
+
+// writeBody sets the arguments in schemaMap to values, attributes first and
+// then nested blocks. Computed-only, deprecated and sensitive attributes are
+// left out, as are optional ones set to their default or zero value.
+func writeBody(body *hclwrite.Body, schemaMap map[string]*schema.Schema, values map[string]interface{}) {
+	var blockKeys []string
+	for _, k := range sortedKeys(schemaMap) {
+		s := schemaMap[k]
+		v := values[k]
+		if !isArgument(s) || s.Sensitive || v == nil || !s.Required && isDefaultValue(s, v) {
+			continue
+		}
+		if _, ok := s.Elem.(*schema.Resource); ok {
+			blockKeys = append(blockKeys, k)
+			continue
+		}
+		body.SetAttributeValue(k, ctyValue(s, v))
+	}
+
+	separated := len(body.Attributes()) == 0
+	for _, k := range blockKeys {
+		s := schemaMap[k]
+		for _, item := range listValue(values[k]) {
+			itemValues, _ := item.(map[string]interface{})
+			block := hclwrite.NewBlock(k, nil)
+			writeBody(block.Body(), s.Elem.(*schema.Resource).Schema, itemValues)
+			if !s.Required && len(block.Body().Attributes()) == 0 && len(block.Body().Blocks()) == 0 {
+				continue
+			}
+			if !separated {
+				body.AppendNewline()
+				separated = true
+			}
+			body.AppendBlock(block)
+		}
+	}
+}
+
+// sensitiveArguments returns the sensitive arguments that are required or
+// set in values.
+func sensitiveArguments(schemaMap map[string]*schema.Schema, values map[string]interface{}) []string {
+	var sensitive []string
+	for _, k := range sortedKeys(schemaMap) {
+		s := schemaMap[k]
+		if isArgument(s) && s.Sensitive && (s.Required || !isDefaultValue(s, values[k])) {
+			sensitive = append(sensitive, k)
+		}
+	}
+	return sensitive
+}
+
+func isArgument(s *schema.Schema) bool {
+	return (s.Required || s.Optional) && s.Deprecated == ""
+}
+
+// isDefaultValue reports whether v is the default or the zero value of s.
+func isDefaultValue(s *schema.Schema, v interface{}) bool {
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		return len(listValue(v)) == 0
+	case schema.TypeMap:
+		m, _ := v.(map[string]interface{})
+		return len(m) == 0
+	}
+	if def, err := s.DefaultValue(); err == nil && def != nil {
+		return fmt.Sprint(def) == fmt.Sprint(v)
+	}
+	return v == nil || v == s.ZeroValue()
+}
+
+func listValue(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+// ctyValue converts the value v of a primitive, list, set or map attribute.
+func ctyValue(s *schema.Schema, v interface{}) cty.Value {
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		var elems []cty.Value
+		for _, elem := range listValue(v) {
+			elems = append(elems, ctyPrimitive(elem))
+		}
+		if len(elems) == 0 {
+			return cty.EmptyTupleVal
+		}
+		return cty.TupleVal(elems)
+	case schema.TypeMap:
+		m, _ := v.(map[string]interface{})
+		if len(m) == 0 {
+			return cty.EmptyObjectVal
+		}
+		attrs := map[string]cty.Value{}
+		for key, elem := range m {
+			attrs[key] = ctyPrimitive(elem)
+		}
+		return cty.ObjectVal(attrs)
+	}
+	return ctyPrimitive(v)
+}
+
+func ctyPrimitive(v interface{}) cty.Value {
+	switch v := v.(type) {
+	case bool:
+		return cty.BoolVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case float64:
+		return cty.NumberFloatVal(v)
+	case string:
+		return cty.StringVal(v)
+	case nil:
+		return cty.NullVal(cty.String)
+	}
+	return cty.StringVal(fmt.Sprint(v))
+}
+
+// commentTokens returns a comment token for every line of comment.
+func commentTokens(comment string) hclwrite.Tokens {
+	var tokens hclwrite.Tokens
+	for _, line := range strings.SplitAfter(comment, "\n") {
+		if line != "" {
+			tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenComment, Bytes: []byte(line)})
+		}
+	}
+	return tokens
+}
+
+func sortedKeys(schemaMap map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(schemaMap))
+	for k := range schemaMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,116 @@
+// Command aviatrix-export writes the Terraform configuration of the objects
+// found on an Aviatrix Controller, together with the Terraform 1.5 import
+// blocks that adopt them into the state.
+//
+// The controller is reached with the same settings as the provider, read
+// from the AVIATRIX_CONTROLLER_IP, AVIATRIX_USERNAME, AVIATRIX_PASSWORD and
+// AVIATRIX_API_TOKEN environment variables unless overridden by the flags.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/aviatrix"
+)
+
+func main() {
+	var (
+		controllerIP          string
+		username              string
+		out                   string
+		types                 string
+		skipVersionValidation bool
+		verbose               bool
+	)
+
+	flag.StringVar(&controllerIP, "controller-ip", "", "IP address or hostname of the controller, instead of AVIATRIX_CONTROLLER_IP")
+	flag.StringVar(&username, "username", "", "controller username, instead of AVIATRIX_USERNAME")
+	flag.StringVar(&out, "out", "", "file the configuration is written to, instead of stdout")
+	flag.StringVar(&types, "types", "", "comma separated resource types to export, all supported types by default")
+	flag.BoolVar(&skipVersionValidation, "skip-version-validation", false, "skip the controller version validation")
+	flag.BoolVar(&verbose, "verbose", false, "log the requests sent to the controller")
+	flag.Parse()
+
+	ctx := context.Background()
+	if verbose {
+		// The provider logs through tflog, which needs a root logger in the
+		// context. Outside of Terraform there is no SDK logging sink, so the
+		// root logger writes the logs to stderr as JSON lines.
+		ctx = tfsdklog.NewRootProviderLogger(ctx, tfsdklog.WithLevel(hclog.Debug), tfsdklog.WithoutLocation())
+	} else {
+		log.SetOutput(io.Discard)
+	}
+
+	if err := run(ctx, controllerIP, username, out, types, skipVersionValidation); err != nil {
+		fmt.Fprintf(os.Stderr, "aviatrix-export: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, controllerIP, username, out, types string, skipVersionValidation bool) error {
+	var resourceTypes []string
+	if types != "" {
+		resourceTypes = strings.Split(types, ",")
+	}
+	exportTypes, err := exportTypesByName(resourceTypes)
+	if err != nil {
+		return err
+	}
+
+	config := map[string]interface{}{
+		"skip_version_validation": skipVersionValidation,
+	}
+	if controllerIP != "" {
+		config["controller_ip"] = controllerIP
+	}
+	if username != "" {
+		config["username"] = username
+	}
+	p, err := configureProvider(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	result, err := export(ctx, p, exportTypes)
+	if err != nil {
+		return err
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
+	}
+
+	if out == "" {
+		return writeHCL(os.Stdout, result.Resources)
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	if err := writeHCL(f, result.Resources); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// configureProvider returns the provider configured with config, completed
+// by the environment variables the provider reads.
+func configureProvider(ctx context.Context, config map[string]interface{}) (*schema.Provider, error) {
+	p := aviatrix.Provider()
+	diags := p.Configure(ctx, terraform.NewResourceConfigRaw(config))
+	if diags.HasError() {
+		return nil, diagnosticsError(diags)
+	}
+	return p, nil
+}
@@ -4,9 +4,8 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Account struct {
@@ -142,6 +141,10 @@ func (c *Client) InvalidateCache() {
 }
 
 func (c *Client) ListAccounts() ([]Account, error) {
+	return c.ListAccountsContext(context.Background())
+}
+
+func (c *Client) ListAccountsContext(ctx context.Context) ([]Account, error) {
 	// If we have cached accounts, return them.
 	c.cacheMutex.Lock()
 	defer c.cacheMutex.Unlock()
@@ -155,7 +158,7 @@ func (c *Client) ListAccounts() ([]Account, error) {
 		"action": "list_accounts",
 	}
 	var resp AccountListResp
-	err := c.GetAPI(&resp, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &resp, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +173,11 @@ func (c *Client) ListAccounts() ([]Account, error) {
 // potential issues with unintended modifications to the cached account data by
 // the caller.
 func (c *Client) GetAccount(account *Account) (Account, error) {
-	accList, err := c.ListAccounts()
+	return c.GetAccountContext(context.Background(), account)
+}
+
+func (c *Client) GetAccountContext(ctx context.Context, account *Account) (Account, error) {
+	accList, err := c.ListAccountsContext(ctx)
 	if err != nil {
 		return Account{}, err
 	}
@@ -178,11 +185,11 @@ func (c *Client) GetAccount(account *Account) (Account, error) {
 	defer c.cacheMutex.Unlock()
 	for i := range accList {
 		if accList[i].AccountName == account.AccountName {
-			log.Infof("Found Aviatrix Account %s", account.AccountName)
+			tflog.Info(ctx, "Found Aviatrix Account", map[string]interface{}{"account_name": account.AccountName})
 			return accList[i], nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix account %s", account.AccountName)
+	tflog.Error(ctx, "Couldn't find Aviatrix account", map[string]interface{}{"account_name": account.AccountName})
 	return Account{}, ErrNotFound
 }
 
@@ -223,31 +230,69 @@ func (c *Client) UploadOciApiPrivateKeyFile(account *Account) error {
 	return c.PostAPI(account.Action, account, BasicCheck)
 }
 
-func (c *Client) AuditAccount(ctx context.Context, account *Account) error {
+// AccountAuditRecord is the result of the controller's audit of a cloud
+// account.
+type AccountAuditRecord struct {
+	AccountName string `json:"account_name"`
+	Status      string `json:"status"`
+	Comment     string `json:"comment"`
+}
+
+// GetAccountAuditRecords returns the audit records of all onboarded cloud
+// accounts.
+func (c *Client) GetAccountAuditRecords(ctx context.Context) ([]AccountAuditRecord, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "get_account_audit_records",
 	}
 
-	type AccountAuditResult struct {
-		AccountName string `json:"account_name"`
-		Status      string `json:"status"`
-		Comment     string `json:"comment"`
-	}
-
 	type AccountAuditResponse struct {
 		Return  bool                 `json:"return"`
-		Results []AccountAuditResult `json:"results"`
+		Results []AccountAuditRecord `json:"results"`
 	}
 
 	var resp AccountAuditResponse
 	err := c.GetAPIContext(ctx, &resp, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// GetCachedAccountAuditRecords returns the audit records of all onboarded
+// cloud accounts, fetched once for the lifetime of the client. Plans check
+// the account of every gateway, the audit only needs to run once for them.
+func (c *Client) GetCachedAccountAuditRecords(ctx context.Context) ([]AccountAuditRecord, error) {
+	c.auditMutex.Lock()
+	defer c.auditMutex.Unlock()
+	if c.auditRecords != nil {
+		return c.auditRecords, nil
+	}
+
+	records, err := c.GetAccountAuditRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if records == nil {
+		records = []AccountAuditRecord{}
+	}
+	c.auditRecords = records
+	return c.auditRecords, nil
+}
+
+// IsAuditPassed reports whether the account passed the audit.
+func (r AccountAuditRecord) IsAuditPassed() bool {
+	return r.Status == "Pass"
+}
+
+func (c *Client) AuditAccount(ctx context.Context, account *Account) error {
+	records, err := c.GetAccountAuditRecords(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, accountAuditResult := range resp.Results {
-		if accountAuditResult.AccountName == account.AccountName && !strings.Contains(accountAuditResult.Status, "Pass") {
+	for _, accountAuditResult := range records {
+		if accountAuditResult.AccountName == account.AccountName && !accountAuditResult.IsAuditPassed() {
 			return fmt.Errorf("%s", accountAuditResult.Comment)
 		}
 	}
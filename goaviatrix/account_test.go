@@ -2,9 +2,12 @@ package goaviatrix
 
 import (
 	"bytes"
+	"context"
 	"io/ioutil"
 	"net/http"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 // MockRoundTripper is a mock implementation of http.RoundTripper.
@@ -83,3 +86,45 @@ func TestListAccountsCallCount(t *testing.T) {
 		t.Fatalf("expected 1 ListAccounts call to make an HTTP round trip, got %d", roundTripper.CallCount)
 	}
 }
+
+func TestGetCachedAccountAuditRecords(t *testing.T) {
+	calls := 0
+	client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			_, _ = w.Write([]byte(`{"return": false, "reason": "audit is running"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"return": true, "results": [{"account_name": "aws-prod", "status": "Pass"}]}`))
+	})
+
+	// A failed fetch is not cached.
+	_, err := client.GetCachedAccountAuditRecords(context.Background())
+	assert.Error(t, err)
+
+	for i := 0; i < 3; i++ {
+		records, err := client.GetCachedAccountAuditRecords(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []AccountAuditRecord{{AccountName: "aws-prod", Status: "Pass"}}, records)
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestIsAuditPassed(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{status: "Pass", want: true},
+		{status: "Fail"},
+		{status: "Not Pass"},
+		{status: "Passing"},
+		{status: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			assert.Equal(t, tt.want, AccountAuditRecord{Status: tt.status}.IsAuditPassed())
+		})
+	}
+}
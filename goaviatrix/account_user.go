@@ -1,7 +1,9 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type AccountUser struct {
@@ -31,40 +33,56 @@ type AccountUserListResp struct {
 }
 
 func (c *Client) CreateAccountUser(user *AccountUser) error {
+	return c.CreateAccountUserContext(context.Background(), user)
+}
+
+func (c *Client) CreateAccountUserContext(ctx context.Context, user *AccountUser) error {
 	user.CID = c.CID
 	user.Action = "add_account_user"
-	return c.PostAPI(user.Action, user, BasicCheck)
+	return c.PostAPIContext(ctx, user.Action, user, BasicCheck)
 }
 
 func (c *Client) GetAccountUser(user *AccountUser) (*AccountUser, error) {
+	return c.GetAccountUserContext(context.Background(), user)
+}
+
+func (c *Client) GetAccountUserContext(ctx context.Context, user *AccountUser) (*AccountUser, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_account_users",
 	}
 	var data AccountUserListResp
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
 	users := data.AccountUserList
 	for i := range users {
 		if users[i].UserName == user.UserName {
-			log.Infof("Found Aviatrix user account %s", user.UserName)
+			tflog.Info(ctx, "Found Aviatrix user account", map[string]interface{}{"user_name": user.UserName})
 			return &users[i], nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix user account %s", user.UserName)
+	tflog.Error(ctx, "Couldn't find Aviatrix user account", map[string]interface{}{"user_name": user.UserName})
 	return nil, ErrNotFound
 }
 
 func (c *Client) UpdateAccountUserObject(user *AccountUserEdit) error {
+	return c.UpdateAccountUserObjectContext(context.Background(), user)
+}
+
+func (c *Client) UpdateAccountUserObjectContext(ctx context.Context, user *AccountUserEdit) error {
 	user.CID = c.CID
 	user.Action = "edit_account_user"
-	return c.PostAPI(user.Action, user, BasicCheck)
+	return c.PostAPIContext(ctx, user.Action, user, BasicCheck)
 }
 
 func (c *Client) DeleteAccountUser(user *AccountUser) error {
+	return c.DeleteAccountUserContext(context.Background(), user)
+}
+
+func (c *Client) DeleteAccountUserContext(ctx context.Context, user *AccountUser) error {
 	user.CID = c.CID
 	user.Action = "delete_account_user"
-	return c.PostAPI(user.Action, user, BasicCheck)
+	return c.PostAPIContext(ctx, user.Action, user, BasicCheck)
 }
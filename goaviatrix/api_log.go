@@ -0,0 +1,65 @@
+package goaviatrix
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// APILogSubsystem is the tflog subsystem of the controller API requests. Its
+// level is set with the TF_LOG_PROVIDER_AVIATRIX_API environment variable.
+const APILogSubsystem = "aviatrix_api"
+
+// Fields of the APILogSubsystem logs.
+const (
+	APILogFieldAction          = "aviatrix_action"
+	APILogFieldAPIVersion      = "aviatrix_api_version"
+	APILogFieldRequestID       = "aviatrix_request_id"
+	APILogFieldVerb            = "http_verb"
+	APILogFieldURL             = "http_url"
+	APILogFieldRequestBody     = "http_request_body"
+	APILogFieldResponseHeaders = "http_response_headers"
+	APILogFieldStatusCode      = "http_status_code"
+	APILogFieldLatency         = "latency_ms"
+	APILogFieldAttempt         = "attempt"
+	APILogFieldRetryCount      = "retry_count"
+	APILogFieldWait            = "retry_wait"
+	APILogFieldError           = "error"
+)
+
+type apiLogContextKey struct{}
+
+// apiLogContext returns ctx with the APILogSubsystem logger set up and the
+// verb, action and API version of a request attached as fields. The
+// subsystem logger is only created once per context, and is a no-op when
+// the provider root logger is not set up, such as in unit tests.
+func apiLogContext(ctx context.Context, verb, action, path string) context.Context {
+	if ctx.Value(apiLogContextKey{}) == nil {
+		ctx = tflog.NewSubsystem(ctx, APILogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_AVIATRIX", "API"))
+		ctx = context.WithValue(ctx, apiLogContextKey{}, true)
+	}
+	ctx = tflog.SubsystemSetField(ctx, APILogSubsystem, APILogFieldVerb, verb)
+	if v := apiVersion(path); v != "" {
+		ctx = tflog.SubsystemSetField(ctx, APILogSubsystem, APILogFieldAPIVersion, v)
+	}
+	if action != "" {
+		ctx = tflog.SubsystemSetField(ctx, APILogSubsystem, APILogFieldAction, action)
+	}
+	return ctx
+}
+
+// apiVersion returns the version of the controller API a request path
+// belongs to, such as v2 or v2.5.
+func apiVersion(path string) string {
+	if u, err := url.Parse(path); err == nil {
+		path = u.Path
+	}
+	for _, part := range strings.Split(path, "/") {
+		if strings.HasPrefix(part, "v2") {
+			return part
+		}
+	}
+	return ""
+}
@@ -0,0 +1,70 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIVersion(t *testing.T) {
+	assert.Equal(t, "v2", apiVersion("https://10.0.0.1/v2/api?action=list_accounts"))
+	assert.Equal(t, "v2.5", apiVersion("https://10.0.0.1/v2.5/api/fqdn/settings"))
+	assert.Equal(t, "v2.5", apiVersion("/v2.5/api/edge/sites"))
+	assert.Equal(t, "", apiVersion("https://10.0.0.1/"))
+}
+
+func TestClientRequestLogFields(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_AVIATRIX_API", "TRACE")
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+
+	var calls int32
+	client := newTestControllerClient(t, failingHandler(&calls, 1, http.StatusServiceUnavailable, "503 Service Unavailable"))
+
+	err := client.PostAPIContext(ctx, "add_account", map[string]string{"CID": client.CID, "action": "add_account"}, BasicCheck)
+	assert.NoError(t, err)
+
+	entries, err := tflogtest.MultilineJSONDecode(&buf)
+	assert.NoError(t, err)
+
+	var sent, retried, done []map[string]interface{}
+	for _, e := range entries {
+		if e["@module"] != "provider."+APILogSubsystem {
+			continue
+		}
+		assert.Equal(t, "add_account", e[APILogFieldAction])
+		assert.Equal(t, http.MethodPost, e[APILogFieldVerb])
+		assert.Equal(t, "v2", e[APILogFieldAPIVersion])
+		switch e["@message"] {
+		case "Sending controller API request":
+			sent = append(sent, e)
+		case "Controller API request failed with a transient error, retrying":
+			retried = append(retried, e)
+		case "Received controller API response":
+			done = append(done, e)
+		}
+	}
+	assert.Len(t, sent, 2)
+	assert.Len(t, retried, 1)
+	if assert.Len(t, done, 1) {
+		assert.Equal(t, float64(1), done[0][APILogFieldRetryCount])
+		assert.Equal(t, float64(http.StatusOK), done[0][APILogFieldStatusCode])
+		assert.Contains(t, done[0], APILogFieldLatency)
+		assert.NotContains(t, done[0][APILogFieldURL], "mockCID")
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestClientRequestLogsWithoutRootLogger(t *testing.T) {
+	client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"return": true, "results": "done"}`))
+	})
+
+	assert.NoError(t, client.PostAPIContext(context.Background(), "list_accounts", map[string]string{"action": "list_accounts"}, BasicCheck))
+}
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -22,6 +23,10 @@ func (acc *AwsGuardDutyAccount) ID() string {
 }
 
 func (c *Client) UpdateAwsGuardDutyPollInterval(scanningInterval int) error {
+	return c.UpdateAwsGuardDutyPollIntervalContext(context.Background(), scanningInterval)
+}
+
+func (c *Client) UpdateAwsGuardDutyPollIntervalContext(ctx context.Context, scanningInterval int) error {
 	data := map[string]string{
 		"action":   "update_aws_guard_duty_poll_interval",
 		"CID":      c.CID,
@@ -34,10 +39,14 @@ func (c *Client) UpdateAwsGuardDutyPollInterval(scanningInterval int) error {
 		}
 		return nil
 	}
-	return c.PostAPI(data["action"], data, checkFunc)
+	return c.PostAPIContext(ctx, data["action"], data, checkFunc)
 }
 
 func (c *Client) EnableAwsGuardDuty(account *AwsGuardDutyAccount) error {
+	return c.EnableAwsGuardDutyContext(context.Background(), account)
+}
+
+func (c *Client) EnableAwsGuardDutyContext(ctx context.Context, account *AwsGuardDutyAccount) error {
 	data := map[string]string{
 		"action":       "enable_aws_guard_duty",
 		"CID":          c.CID,
@@ -50,20 +59,28 @@ func (c *Client) EnableAwsGuardDuty(account *AwsGuardDutyAccount) error {
 		}
 		return nil
 	}
-	return c.PostAPI(data["action"], data, checkFunc)
+	return c.PostAPIContext(ctx, data["action"], data, checkFunc)
 }
 
 func (c *Client) DisableAwsGuardDuty(account *AwsGuardDutyAccount) error {
+	return c.DisableAwsGuardDutyContext(context.Background(), account)
+}
+
+func (c *Client) DisableAwsGuardDutyContext(ctx context.Context, account *AwsGuardDutyAccount) error {
 	data := map[string]string{
 		"action":       "disable_aws_guard_duty",
 		"CID":          c.CID,
 		"account_name": account.AccountName,
 		"region":       account.Region,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) UpdateAwsGuardDutyExcludedIPs(account *AwsGuardDutyAccount) error {
+	return c.UpdateAwsGuardDutyExcludedIPsContext(context.Background(), account)
+}
+
+func (c *Client) UpdateAwsGuardDutyExcludedIPsContext(ctx context.Context, account *AwsGuardDutyAccount) error {
 	data := map[string]string{
 		"action":       "update_aws_guard_duty_excluded_ips",
 		"CID":          c.CID,
@@ -88,7 +105,7 @@ func (c *Client) UpdateAwsGuardDutyExcludedIPs(account *AwsGuardDutyAccount) err
 		}
 		return nil
 	}
-	return c.PostAPI(data["action"], data, checkFunc)
+	return c.PostAPIContext(ctx, data["action"], data, checkFunc)
 }
 
 type ListAwsGuardDutyResp struct {
@@ -106,12 +123,16 @@ type ListAwsGuardDutyIntervalInfo struct {
 }
 
 func (c *Client) GetAwsGuardDuty() (*AwsGuardDuty, error) {
+	return c.GetAwsGuardDutyContext(context.Background())
+}
+
+func (c *Client) GetAwsGuardDutyContext(ctx context.Context) (*AwsGuardDuty, error) {
 	formData := map[string]string{
 		"action": "list_aws_guard_duty",
 		"CID":    c.CID,
 	}
 	var data ListAwsGuardDutyResp
-	err := c.GetAPI(&data, formData["action"], formData, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, formData["action"], formData, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +143,11 @@ func (c *Client) GetAwsGuardDuty() (*AwsGuardDuty, error) {
 }
 
 func (c *Client) GetAwsGuardDutyAccount(accountName, region string) (*AwsGuardDutyAccount, error) {
-	guardDuty, err := c.GetAwsGuardDuty()
+	return c.GetAwsGuardDutyAccountContext(context.Background(), accountName, region)
+}
+
+func (c *Client) GetAwsGuardDutyAccountContext(ctx context.Context, accountName, region string) (*AwsGuardDutyAccount, error) {
+	guardDuty, err := c.GetAwsGuardDutyContext(ctx)
 	if err != nil {
 		return nil, err
 	}
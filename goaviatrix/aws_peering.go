@@ -2,15 +2,15 @@ package goaviatrix
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/url"
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	log "github.com/sirupsen/logrus"
 )
 
 // AWSPeer simple struct to hold aws_peer details
@@ -56,9 +56,13 @@ type AwsVpcInfo struct {
 }
 
 func (c *Client) CreateAWSPeer(awsPeer *AWSPeer) (string, error) {
+	return c.CreateAWSPeerContext(context.Background(), awsPeer)
+}
+
+func (c *Client) CreateAWSPeerContext(ctx context.Context, awsPeer *AWSPeer) (string, error) {
 	awsPeer.CID = c.CID
 	awsPeer.Action = "create_aws_peering"
-	resp, err := c.Post(c.baseURL, awsPeer)
+	resp, err := c.PostContext(ctx, c.baseURL, awsPeer)
 	if err != nil {
 		return "", errors.New("HTTP Post create_aws_peering failed: " + err.Error())
 	}
@@ -82,6 +86,10 @@ func (c *Client) CreateAWSPeer(awsPeer *AWSPeer) (string, error) {
 }
 
 func (c *Client) GetAWSPeer(awsPeer *AWSPeer) (*AWSPeer, error) {
+	return c.GetAWSPeerContext(context.Background(), awsPeer)
+}
+
+func (c *Client) GetAWSPeerContext(ctx context.Context, awsPeer *AWSPeer) (*AWSPeer, error) {
 	Url, err := url.Parse(c.baseURL)
 	if err != nil {
 		return nil, errors.New(("url Parsing failed for list_aws_peerings ") + err.Error())
@@ -90,7 +98,7 @@ func (c *Client) GetAWSPeer(awsPeer *AWSPeer) (*AWSPeer, error) {
 	listAwsPeering.Add("CID", c.CID)
 	listAwsPeering.Add("action", "list_aws_peerings")
 	Url.RawQuery = listAwsPeering.Encode()
-	resp, err := c.Get(Url.String(), nil)
+	resp, err := c.GetContext(ctx, Url.String(), nil)
 	if err != nil {
 		return nil, errors.New("HTTP Get list_aws_peerings failed: " + err.Error())
 	}
@@ -107,7 +115,7 @@ func (c *Client) GetAWSPeer(awsPeer *AWSPeer) (*AWSPeer, error) {
 		return nil, errors.New("Json Decode list_aws_peerings failed: " + err.Error() + "\n Body: " + bodyString)
 	}
 	if !data.Return {
-		log.Errorf("Couldn't find AWS peering between VPCs %s and %s: %s", awsPeer.VpcID1, awsPeer.VpcID2, data.Reason)
+		tflog.Error(ctx, "Couldn't find AWS peering between VPCs", map[string]interface{}{"vpc_id1": awsPeer.VpcID1, "vpc_id2": awsPeer.VpcID2, "reason": data.Reason})
 		return nil, errors.New("Rest API list_aws_peerings Get failed: " + data.Reason)
 	}
 	for i := range data.Results.PairLists {
@@ -125,7 +133,7 @@ func (c *Client) GetAWSPeer(awsPeer *AWSPeer) (*AWSPeer, error) {
 			return awsPeer, nil
 		}
 	}
-	log.Errorf("No AWS peering between VPC %s and %s is present.", awsPeer.VpcID1, awsPeer.VpcID2)
+	tflog.Error(ctx, "No AWS peering between VPCs is present", map[string]interface{}{"vpc_id1": awsPeer.VpcID1, "vpc_id2": awsPeer.VpcID2})
 	return nil, ErrNotFound
 }
 
@@ -134,9 +142,13 @@ func (c *Client) UpdateAWSPeer(awsPeer *AWSPeer) error {
 }
 
 func (c *Client) DeleteAWSPeer(awsPeer *AWSPeer) error {
+	return c.DeleteAWSPeerContext(context.Background(), awsPeer)
+}
+
+func (c *Client) DeleteAWSPeerContext(ctx context.Context, awsPeer *AWSPeer) error {
 	awsPeer.CID = c.CID
 	awsPeer.Action = "delete_aws_peering"
-	resp, err := c.Post(c.baseURL, awsPeer)
+	resp, err := c.PostContext(ctx, c.baseURL, awsPeer)
 	if err != nil {
 		return errors.New("HTTP Post delete_aws_peering failed: " + err.Error())
 	}
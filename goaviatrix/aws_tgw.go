@@ -1,12 +1,13 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // AwsTGW simple struct to hold aws_tgw details
@@ -154,10 +155,14 @@ type AttachmentDetail struct {
 }
 
 func (c *Client) CreateAWSTgw(awsTgw *AWSTgw) error {
+	return c.CreateAWSTgwContext(context.Background(), awsTgw)
+}
+
+func (c *Client) CreateAWSTgwContext(ctx context.Context, awsTgw *AWSTgw) error {
 	awsTgw.CID = c.CID
 	awsTgw.Action = "add_aws_tgw"
 	awsTgw.Async = true
-	return c.PostAsyncAPI(awsTgw.Action, awsTgw, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, awsTgw.Action, awsTgw, BasicCheck)
 }
 
 func (c *Client) GetAWSTgw(awsTgw *AWSTgw) (*AWSTgw, error) {
@@ -278,7 +283,60 @@ func (c *Client) GetAWSTgw(awsTgw *AWSTgw) (*AWSTgw, error) {
 	return awsTgw, nil
 }
 
+// ListAwsTgwRouteDomainDetails returns the details of the network domains of
+// an AWS TGW, including their attached VPCs and the routes of their TGW route
+// tables. The domains of TGW peerings are skipped.
+func (c *Client) ListAwsTgwRouteDomainDetails(tgwName string) ([]RouteDomainDetail, error) {
+	return c.ListAwsTgwRouteDomainDetailsContext(context.Background(), tgwName)
+}
+
+func (c *Client) ListAwsTgwRouteDomainDetailsContext(ctx context.Context, tgwName string) ([]RouteDomainDetail, error) {
+	form := map[string]string{
+		"CID":      c.CID,
+		"action":   "list_route_domain_names",
+		"tgw_name": tgwName,
+	}
+	var data AWSTgwAPIResp
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	domains := data.Results
+	if !Contains(domains, "Aviatrix_Edge_Domain") {
+		domains = append([]string{"Aviatrix_Edge_Domain"}, domains...)
+	}
+
+	var routeDomains []RouteDomainDetail
+	for _, dm := range domains {
+		if strings.HasPrefix(dm, "peering_") || strings.Contains(dm, ":") {
+			continue
+		}
+
+		form = map[string]string{
+			"CID":               c.CID,
+			"action":            "view_route_domain_details",
+			"tgw_name":          tgwName,
+			"route_domain_name": dm,
+		}
+		var detail RouteDomainAPIResp
+		err = c.GetAPIContext(ctx, &detail, form["action"], form, BasicCheck)
+		if err != nil {
+			return nil, err
+		}
+		if len(detail.Results) == 0 {
+			continue
+		}
+		routeDomains = append(routeDomains, detail.Results[0])
+	}
+	return routeDomains, nil
+}
+
 func (c *Client) IsFirewallSecurityDomain(tgwName string, domainName string) (bool, error) {
+	return c.IsFirewallSecurityDomainContext(context.Background(), tgwName, domainName)
+}
+
+func (c *Client) IsFirewallSecurityDomainContext(ctx context.Context, tgwName string, domainName string) (bool, error) {
 	form := map[string]string{
 		"CID":               c.CID,
 		"action":            "view_route_domain_details",
@@ -286,7 +344,7 @@ func (c *Client) IsFirewallSecurityDomain(tgwName string, domainName string) (bo
 		"route_domain_name": domainName,
 	}
 	var data RouteDomainAPIResp
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return false, err
 	}
@@ -301,9 +359,13 @@ func (c *Client) IsFirewallSecurityDomain(tgwName string, domainName string) (bo
 }
 
 func (c *Client) DeleteAWSTgw(awsTgw *AWSTgw) error {
+	return c.DeleteAWSTgwContext(context.Background(), awsTgw)
+}
+
+func (c *Client) DeleteAWSTgwContext(ctx context.Context, awsTgw *AWSTgw) error {
 	awsTgw.CID = c.CID
 	awsTgw.Action = "delete_aws_tgw"
-	return c.PostAPI(awsTgw.Action, awsTgw, BasicCheck)
+	return c.PostAPIContext(ctx, awsTgw.Action, awsTgw, BasicCheck)
 }
 
 func (c *Client) ValidateAWSTgwDomains(domainsAll []string, domainConnAll [][]string, attachedVPCAll [][]string,
@@ -501,12 +563,16 @@ func (c *Client) DetachVpcFromAWSTgw(awsTgw *AWSTgw, vpcID string) error {
 }
 
 func (c *Client) GetTransitGwFromVpcID(awsTgw *AWSTgw, gateway *Gateway) (*Gateway, error) {
+	return c.GetTransitGwFromVpcIDContext(context.Background(), awsTgw, gateway)
+}
+
+func (c *Client) GetTransitGwFromVpcIDContext(ctx context.Context, awsTgw *AWSTgw, gateway *Gateway) (*Gateway, error) {
 	var data ListAwsTgwAttachmentAPIResp
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_all_tgw_attachments",
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -517,11 +583,15 @@ func (c *Client) GetTransitGwFromVpcID(awsTgw *AWSTgw, gateway *Gateway) (*Gatew
 			return gateway, nil
 		}
 	}
-	log.Errorf("Couldn't find transit gateway attached to vpc %s", gateway.VpcID)
+	tflog.Error(ctx, "Couldn't find transit gateway attached to vpc", map[string]interface{}{"vpc_id": gateway.VpcID})
 	return nil, ErrNotFound
 }
 
 func (c *Client) ListTgwDetails(awsTgw *AWSTgw) (*AWSTgw, error) {
+	return c.ListTgwDetailsContext(context.Background(), awsTgw)
+}
+
+func (c *Client) ListTgwDetailsContext(ctx context.Context, awsTgw *AWSTgw) (*AWSTgw, error) {
 	var data TGWInfoResp
 	form := map[string]string{
 		"CID":      c.CID,
@@ -537,7 +607,7 @@ func (c *Client) ListTgwDetails(awsTgw *AWSTgw) (*AWSTgw, error) {
 		}
 		return nil
 	}
-	err := c.GetAPI(&data, form["action"], form, check)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, check)
 	if err != nil {
 		return nil, err
 	}
@@ -584,6 +654,10 @@ func (c *Client) IsVpcAttachedToTgw(awsTgw *AWSTgw, vpcSolo *VPCSolo) (bool, err
 }
 
 func (c *Client) GetAttachmentRouteTableDetails(tgwName string, attachmentName string) (*AttachmentRouteTableDetails, error) {
+	return c.GetAttachmentRouteTableDetailsContext(context.Background(), tgwName, attachmentName)
+}
+
+func (c *Client) GetAttachmentRouteTableDetailsContext(ctx context.Context, tgwName string, attachmentName string) (*AttachmentRouteTableDetails, error) {
 	var data AttachmentRouteTableDetailsAPIResp
 	form := map[string]string{
 		"CID":             c.CID,
@@ -591,7 +665,7 @@ func (c *Client) GetAttachmentRouteTableDetails(tgwName string, attachmentName s
 		"tgw_name":        tgwName,
 		"attachment_name": attachmentName,
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -599,16 +673,24 @@ func (c *Client) GetAttachmentRouteTableDetails(tgwName string, attachmentName s
 }
 
 func (c *Client) UpdateTGWCidrs(tgwName string, cidrs []string) error {
+	return c.UpdateTGWCidrsContext(context.Background(), tgwName, cidrs)
+}
+
+func (c *Client) UpdateTGWCidrsContext(ctx context.Context, tgwName string, cidrs []string) error {
 	data := map[string]string{
 		"action":    "update_tgw_cidrs",
 		"CID":       c.CID,
 		"tgw_name":  tgwName,
 		"cidr_list": strings.Join(cidrs, ","),
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) UpdateTGWInspectionMode(tgwName, inspectionMode string) error {
+	return c.UpdateTGWInspectionModeContext(context.Background(), tgwName, inspectionMode)
+}
+
+func (c *Client) UpdateTGWInspectionModeContext(ctx context.Context, tgwName, inspectionMode string) error {
 	data := map[string]string{
 		"action":   "edit_aws_tgw_inspection_mode",
 		"CID":      c.CID,
@@ -616,5 +698,5 @@ func (c *Client) UpdateTGWInspectionMode(tgwName, inspectionMode string) error {
 		"mode":     inspectionMode,
 	}
 
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
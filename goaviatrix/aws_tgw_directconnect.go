@@ -1,10 +1,11 @@
 package goaviatrix
 
 import (
+	"context"
 	"errors"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type AwsTgwDirectConnect struct {
@@ -37,20 +38,28 @@ type AwsTgwDirectConnResp struct {
 }
 
 func (c *Client) CreateAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect) error {
+	return c.CreateAwsTgwDirectConnectContext(context.Background(), awsTgwDirectConnect)
+}
+
+func (c *Client) CreateAwsTgwDirectConnectContext(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) error {
 	awsTgwDirectConnect.CID = c.CID
 	awsTgwDirectConnect.Action = "attach_direct_connect_to_tgw"
 	awsTgwDirectConnect.Async = true
-	return c.PostAsyncAPI(awsTgwDirectConnect.Action, awsTgwDirectConnect, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, awsTgwDirectConnect.Action, awsTgwDirectConnect, BasicCheck)
 }
 
 func (c *Client) GetAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect) (*AwsTgwDirectConnect, error) {
+	return c.GetAwsTgwDirectConnectContext(context.Background(), awsTgwDirectConnect)
+}
+
+func (c *Client) GetAwsTgwDirectConnectContext(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) (*AwsTgwDirectConnect, error) {
 	var data AwsTgwDirectConnResp
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "list_all_tgw_attachments",
 		"tgw_name": awsTgwDirectConnect.TgwName,
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, errors.New("HTTP Get list_all_tgw_attachments failed: " + err.Error())
 	}
@@ -61,7 +70,7 @@ func (c *Client) GetAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect
 			awsTgwDirectConnect.SecurityDomainName = allAwsTgwDirectConn[i].SecurityDomainName
 			awsTgwDirectConnect.AllowedPrefix = strings.Join(allAwsTgwDirectConn[i].AllowedPrefix, ",")
 			awsTgwDirectConnect.LearnedCidrsApproval = allAwsTgwDirectConn[i].LearnedCidrsApproval
-			log.Debugf("Found Aws Tgw Direct Conn: %#v", awsTgwDirectConnect)
+			tflog.Debug(ctx, "Found Aws Tgw Direct Conn", map[string]interface{}{"tgw_name": awsTgwDirectConnect.TgwName})
 			return awsTgwDirectConnect, nil
 		}
 	}
@@ -69,18 +78,30 @@ func (c *Client) GetAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect
 }
 
 func (c *Client) UpdateDirectConnAllowedPrefix(awsTgwDirectConnect *AwsTgwDirectConnect) error {
+	return c.UpdateDirectConnAllowedPrefixContext(context.Background(), awsTgwDirectConnect)
+}
+
+func (c *Client) UpdateDirectConnAllowedPrefixContext(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) error {
 	awsTgwDirectConnect.CID = c.CID
 	awsTgwDirectConnect.Action = "update_tgw_directconnect_allowed_prefix"
-	return c.PostAPI(awsTgwDirectConnect.Action, awsTgwDirectConnect, BasicCheck)
+	return c.PostAPIContext(ctx, awsTgwDirectConnect.Action, awsTgwDirectConnect, BasicCheck)
 }
 
 func (c *Client) DeleteAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect) error {
+	return c.DeleteAwsTgwDirectConnectContext(context.Background(), awsTgwDirectConnect)
+}
+
+func (c *Client) DeleteAwsTgwDirectConnectContext(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) error {
 	awsTgwDirectConnect.CID = c.CID
 	awsTgwDirectConnect.Action = "detach_directconnect_from_tgw"
-	return c.PostAPI(awsTgwDirectConnect.Action, awsTgwDirectConnect, BasicCheck)
+	return c.PostAPIContext(ctx, awsTgwDirectConnect.Action, awsTgwDirectConnect, BasicCheck)
 }
 
 func (c *Client) EnableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect *AwsTgwDirectConnect) error {
+	return c.EnableDirectConnectLearnedCidrsApprovalContext(context.Background(), awsTgwDirectConnect)
+}
+
+func (c *Client) EnableDirectConnectLearnedCidrsApprovalContext(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) error {
 	form := map[string]string{
 		"CID":                    c.CID,
 		"action":                 "enable_learned_cidrs_approval",
@@ -88,10 +109,14 @@ func (c *Client) EnableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect *Aw
 		"attachment_name":        awsTgwDirectConnect.DxGatewayName,
 		"learned_cidrs_approval": awsTgwDirectConnect.LearnedCidrsApproval,
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DisableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect *AwsTgwDirectConnect) error {
+	return c.DisableDirectConnectLearnedCidrsApprovalContext(context.Background(), awsTgwDirectConnect)
+}
+
+func (c *Client) DisableDirectConnectLearnedCidrsApprovalContext(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) error {
 	form := map[string]string{
 		"CID":                    c.CID,
 		"action":                 "disable_learned_cidrs_approval",
@@ -99,5 +124,5 @@ func (c *Client) DisableDirectConnectLearnedCidrsApproval(awsTgwDirectConnect *A
 		"attachment_name":        awsTgwDirectConnect.DxGatewayName,
 		"learned_cidrs_approval": awsTgwDirectConnect.LearnedCidrsApproval,
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
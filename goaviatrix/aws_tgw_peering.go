@@ -1,12 +1,12 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type AwsTgwPeering struct {
@@ -24,13 +24,21 @@ type AwsTgwPeeringAPIResp struct {
 }
 
 func (c *Client) CreateAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error {
+	return c.CreateAwsTgwPeeringContext(context.Background(), awsTgwPeering)
+}
+
+func (c *Client) CreateAwsTgwPeeringContext(ctx context.Context, awsTgwPeering *AwsTgwPeering) error {
 	awsTgwPeering.CID = c.CID
 	awsTgwPeering.Action = "add_tgw_peering"
 	awsTgwPeering.Async = true
-	return c.PostAsyncAPI(awsTgwPeering.Action, awsTgwPeering, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, awsTgwPeering.Action, awsTgwPeering, BasicCheck)
 }
 
 func (c *Client) GetAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error {
+	return c.GetAwsTgwPeeringContext(context.Background(), awsTgwPeering)
+}
+
+func (c *Client) GetAwsTgwPeeringContext(ctx context.Context, awsTgwPeering *AwsTgwPeering) error {
 	var data AwsTgwPeeringAPIResp
 	form := map[string]string{
 		"CID":      c.CID,
@@ -46,12 +54,12 @@ func (c *Client) GetAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error {
 		}
 		return nil
 	}
-	err := c.GetAPI(&data, form["action"], form, check)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, check)
 	if err != nil {
 		return err
 	}
 	if len(data.Results) == 0 {
-		log.Errorf("Aws tgw peering with tgw: %s and tgw: %s not found", awsTgwPeering.TgwName1, awsTgwPeering.TgwName2)
+		tflog.Error(ctx, "AWS TGW peering not found", map[string]interface{}{"tgw_name1": awsTgwPeering.TgwName1, "tgw_name2": awsTgwPeering.TgwName2})
 		return ErrNotFound
 	}
 	peeringList := data.Results
@@ -64,10 +72,14 @@ func (c *Client) GetAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error {
 }
 
 func (c *Client) DeleteAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error {
+	return c.DeleteAwsTgwPeeringContext(context.Background(), awsTgwPeering)
+}
+
+func (c *Client) DeleteAwsTgwPeeringContext(ctx context.Context, awsTgwPeering *AwsTgwPeering) error {
 	awsTgwPeering.CID = c.CID
 	awsTgwPeering.Action = "delete_tgw_peering"
 	awsTgwPeering.Async = true
-	return c.PostAsyncAPI(awsTgwPeering.Action, awsTgwPeering, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, awsTgwPeering.Action, awsTgwPeering, BasicCheck)
 }
 
 func DiffSuppressFuncAwsTgwPeeringTgwName1(k, old, new string, d *schema.ResourceData) bool {
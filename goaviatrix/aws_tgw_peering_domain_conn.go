@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -29,6 +30,10 @@ type ConnectedRouteDomainDetail struct {
 }
 
 func (c *Client) CreateDomainConn(domainConn *DomainConn) error {
+	return c.CreateDomainConnContext(context.Background(), domainConn)
+}
+
+func (c *Client) CreateDomainConnContext(ctx context.Context, domainConn *DomainConn) error {
 	form := map[string]string{
 		"CID":                           c.CID,
 		"action":                        "add_connection_between_route_domains",
@@ -37,10 +42,14 @@ func (c *Client) CreateDomainConn(domainConn *DomainConn) error {
 		"destination_route_domain_name": domainConn.TgwName2 + ":" + domainConn.DomainName2,
 		"async":                         "true",
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetDomainConn(domainConn *DomainConn) error {
+	return c.GetDomainConnContext(context.Background(), domainConn)
+}
+
+func (c *Client) GetDomainConnContext(ctx context.Context, domainConn *DomainConn) error {
 	var data ListConnectedRouteDomainsResp
 	form := map[string]string{
 		"CID":               c.CID,
@@ -57,7 +66,7 @@ func (c *Client) GetDomainConn(domainConn *DomainConn) error {
 		}
 		return nil
 	}
-	err := c.GetAPI(&data, form["action"], form, check)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, check)
 	if err != nil {
 		return err
 	}
@@ -71,6 +80,10 @@ func (c *Client) GetDomainConn(domainConn *DomainConn) error {
 }
 
 func (c *Client) DeleteDomainConn(domainConn *DomainConn) error {
+	return c.DeleteDomainConnContext(context.Background(), domainConn)
+}
+
+func (c *Client) DeleteDomainConnContext(ctx context.Context, domainConn *DomainConn) error {
 	form := map[string]string{
 		"CID":                           c.CID,
 		"action":                        "delete_connection_between_route_domains",
@@ -79,7 +92,7 @@ func (c *Client) DeleteDomainConn(domainConn *DomainConn) error {
 		"destination_route_domain_name": domainConn.TgwName2 + ":" + domainConn.DomainName2,
 		"async":                         "true",
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func DiffSuppressFuncAwsTgwPeeringDomainConnTgwName1(k, old, new string, d *schema.ResourceData) bool {
@@ -0,0 +1,56 @@
+package goaviatrix
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAwsTgwRouteDomainDetails(t *testing.T) {
+	var viewed []string
+	client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		q := r.URL.Query()
+		assert.Equal(t, "tgw-1", q.Get("tgw_name"))
+		switch q.Get("action") {
+		case "list_route_domain_names":
+			_ = json.NewEncoder(w).Encode(AWSTgwAPIResp{Return: true, Results: []string{"prod", "peering_tgw-2", "tgw-2:prod", "empty"}})
+		case "view_route_domain_details":
+			domain := q.Get("route_domain_name")
+			viewed = append(viewed, domain)
+			resp := RouteDomainAPIResp{Return: true}
+			if domain != "empty" {
+				resp.Results = []RouteDomainDetail{{Name: domain, RouteTableId: "tgw-rtb-" + domain}}
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+		default:
+			t.Errorf("unexpected action %q", q.Get("action"))
+		}
+	})
+
+	domains, err := client.ListAwsTgwRouteDomainDetailsContext(context.Background(), "tgw-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Aviatrix_Edge_Domain", "prod", "empty"}, viewed)
+	assert.Equal(t, []RouteDomainDetail{
+		{Name: "Aviatrix_Edge_Domain", RouteTableId: "tgw-rtb-Aviatrix_Edge_Domain"},
+		{Name: "prod", RouteTableId: "tgw-rtb-prod"},
+	}, domains)
+}
+
+func TestGetAwsTgwDetailWhenNotFound(t *testing.T) {
+	client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"return": false, "reason": "TGW tgw-1 does not exist"}`))
+	})
+
+	awsTgw, err := client.GetAwsTgwDetailContext(context.Background(), &AWSTgw{Name: "tgw-1"})
+
+	assert.Nil(t, awsTgw)
+	assert.True(t, errors.Is(err, ErrNotFound), "expected ErrNotFound, got %v", err)
+	assert.EqualError(t, err, "couldn't find AWS TGW tgw-1: "+ErrNotFound.Error())
+}
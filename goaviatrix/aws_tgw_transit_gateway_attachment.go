@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -37,6 +38,10 @@ type AttachmentInfo struct {
 }
 
 func (c *Client) CreateAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) error {
+	return c.CreateAwsTgwTransitGwAttachmentContext(context.Background(), awsTgwTransitGwAttachment)
+}
+
+func (c *Client) CreateAwsTgwTransitGwAttachmentContext(ctx context.Context, awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) error {
 	form := map[string]string{
 		"CID":               c.CID,
 		"action":            "attach_vpc_to_tgw",
@@ -48,15 +53,19 @@ func (c *Client) CreateAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsT
 		"gateway_name":      awsTgwTransitGwAttachment.TransitGatewayName,
 		"async":             "true",
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) (*AwsTgwTransitGwAttachment, error) {
+	return c.GetAwsTgwTransitGwAttachmentContext(context.Background(), awsTgwTransitGwAttachment)
+}
+
+func (c *Client) GetAwsTgwTransitGwAttachmentContext(ctx context.Context, awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) (*AwsTgwTransitGwAttachment, error) {
 	tgwVpcAttachment := &AwsTgwVpcAttachment{
 		TgwName: awsTgwTransitGwAttachment.TgwName,
 		VpcID:   awsTgwTransitGwAttachment.VpcID,
 	}
-	tgwAttachmentInfo, err := c.GetAwsTgwAttachmentInfo(tgwVpcAttachment)
+	tgwAttachmentInfo, err := c.GetAwsTgwAttachmentInfoContext(ctx, tgwVpcAttachment)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +79,10 @@ func (c *Client) GetAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsTgwT
 }
 
 func (c *Client) DeleteAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) error {
+	return c.DeleteAwsTgwTransitGwAttachmentContext(context.Background(), awsTgwTransitGwAttachment)
+}
+
+func (c *Client) DeleteAwsTgwTransitGwAttachmentContext(ctx context.Context, awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) error {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "detach_vpc_from_tgw",
@@ -86,5 +99,5 @@ func (c *Client) DeleteAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment *AwsT
 		}
 		return nil
 	}
-	return c.PostAsyncAPI(form["action"], form, check)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, check)
 }
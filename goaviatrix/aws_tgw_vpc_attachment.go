@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -29,6 +30,10 @@ type DomainListResp struct {
 }
 
 func (c *Client) CreateAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
+	return c.CreateAwsTgwVpcAttachmentContext(context.Background(), awsTgwVpcAttachment)
+}
+
+func (c *Client) CreateAwsTgwVpcAttachmentContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	form := map[string]string{
 		"CID":               c.CID,
 		"action":            "attach_vpc_to_tgw",
@@ -55,10 +60,14 @@ func (c *Client) CreateAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachm
 	if awsTgwVpcAttachment.RouteTables != "" {
 		form["route_table_list"] = awsTgwVpcAttachment.RouteTables
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) CreateAwsTgwVpcAttachmentForFireNet(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
+	return c.CreateAwsTgwVpcAttachmentForFireNetContext(context.Background(), awsTgwVpcAttachment)
+}
+
+func (c *Client) CreateAwsTgwVpcAttachmentForFireNetContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	form := map[string]string{
 		"CID":         c.CID,
 		"action":      "connect_firenet_with_tgw",
@@ -66,11 +75,15 @@ func (c *Client) CreateAwsTgwVpcAttachmentForFireNet(awsTgwVpcAttachment *AwsTgw
 		"tgw_name":    awsTgwVpcAttachment.TgwName,
 		"domain_name": awsTgwVpcAttachment.SecurityDomainName,
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 // GetAwsTgwAttachment returns the details of an AWS TGW generic attachment for given attachment name.
 func (c *Client) GetAwsTgwAttachmentInfo(awsTgwVpcAttachment *AwsTgwVpcAttachment) (*AttachmentInfo, error) {
+	return c.GetAwsTgwAttachmentInfoContext(context.Background(), awsTgwVpcAttachment)
+}
+
+func (c *Client) GetAwsTgwAttachmentInfoContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) (*AttachmentInfo, error) {
 	var data TgwAttachmentResp
 	params := map[string]string{
 		"action":          "get_tgw_attachment_details",
@@ -87,7 +100,7 @@ func (c *Client) GetAwsTgwAttachmentInfo(awsTgwVpcAttachment *AwsTgwVpcAttachmen
 		}
 		return nil
 	}
-	err := c.GetAPI(&data, params["action"], params, check)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, check)
 	if err != nil {
 		return nil, err
 	}
@@ -103,14 +116,18 @@ func (c *Client) GetAwsTgwAttachmentInfo(awsTgwVpcAttachment *AwsTgwVpcAttachmen
 //
 //nolint:cyclop,funlen
 func (c *Client) GetAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachment) (*AwsTgwVpcAttachment, error) {
-	tgwAttachmentInfo, err := c.GetAwsTgwAttachmentInfo(awsTgwVpcAttachment)
+	return c.GetAwsTgwVpcAttachmentContext(context.Background(), awsTgwVpcAttachment)
+}
+
+func (c *Client) GetAwsTgwVpcAttachmentContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) (*AwsTgwVpcAttachment, error) {
+	tgwAttachmentInfo, err := c.GetAwsTgwAttachmentInfoContext(ctx, awsTgwVpcAttachment)
 	if err != nil {
 		return nil, err
 	}
 	awsTgwVpcAttachment.Region = tgwAttachmentInfo.Region
 	awsTgwVpcAttachment.VpcAccountName = tgwAttachmentInfo.AccountName
 
-	err = c.GetAwsTgwDomain(awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.SecurityDomainName)
+	err = c.GetAwsTgwDomainContext(ctx, awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.SecurityDomainName)
 	if err != nil {
 		if err == ErrNotFound {
 			return nil, err
@@ -118,7 +135,7 @@ func (c *Client) GetAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachment
 		return nil, errors.New("aws tgw does not have security domain: " + err.Error())
 	}
 
-	aTVA, err := c.GetVPCAttachmentRouteTableDetails(awsTgwVpcAttachment)
+	aTVA, err := c.GetVPCAttachmentRouteTableDetailsContext(ctx, awsTgwVpcAttachment)
 	if err != nil {
 		if err == ErrNotFound {
 			return nil, err
@@ -126,7 +143,7 @@ func (c *Client) GetAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachment
 		return nil, errors.New("could not get security domain details: " + err.Error())
 	}
 
-	ARTDetail, err := c.GetAttachmentRouteTableDetails(awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.VpcID)
+	ARTDetail, err := c.GetAttachmentRouteTableDetailsContext(ctx, awsTgwVpcAttachment.TgwName, awsTgwVpcAttachment.VpcID)
 	if err != nil {
 		return nil, errors.New("could not get Attachment Route Table Details: " + err.Error())
 	}
@@ -174,6 +191,10 @@ func (c *Client) GetAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachment
 }
 
 func (c *Client) DeleteAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
+	return c.DeleteAwsTgwVpcAttachmentContext(context.Background(), awsTgwVpcAttachment)
+}
+
+func (c *Client) DeleteAwsTgwVpcAttachmentContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "detach_vpc_from_tgw",
@@ -181,36 +202,48 @@ func (c *Client) DeleteAwsTgwVpcAttachment(awsTgwVpcAttachment *AwsTgwVpcAttachm
 		"vpc_name": awsTgwVpcAttachment.VpcID,
 		"async":    "true",
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DeleteAwsTgwVpcAttachmentForFireNet(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
+	return c.DeleteAwsTgwVpcAttachmentForFireNetContext(context.Background(), awsTgwVpcAttachment)
+}
+
+func (c *Client) DeleteAwsTgwVpcAttachmentForFireNetContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "disconnect_firenet_with_tgw",
 		"vpc_id": awsTgwVpcAttachment.VpcID,
 		"async":  "true",
 	}
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetAwsTgwDetail(awsTgw *AWSTgw) (*AWSTgw, error) {
-	awsTgw, err := c.ListTgwDetails(awsTgw)
+	return c.GetAwsTgwDetailContext(context.Background(), awsTgw)
+}
+
+func (c *Client) GetAwsTgwDetailContext(ctx context.Context, awsTgw *AWSTgw) (*AWSTgw, error) {
+	detail, err := c.ListTgwDetailsContext(ctx, awsTgw)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't find AWS TGW %s: %v", awsTgw.Name, err)
+		return nil, fmt.Errorf("couldn't find AWS TGW %s: %w", awsTgw.Name, err)
 	}
 
-	return awsTgw, nil
+	return detail, nil
 }
 
 func (c *Client) GetAwsTgwDomain(awsTgwName string, sDM string) error {
+	return c.GetAwsTgwDomainContext(context.Background(), awsTgwName, sDM)
+}
+
+func (c *Client) GetAwsTgwDomainContext(ctx context.Context, awsTgwName string, sDM string) error {
 	var data DomainListResp
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "list_route_domain_names",
 		"tgw_name": awsTgwName,
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return err
 	}
@@ -226,6 +259,10 @@ func (c *Client) GetAwsTgwDomain(awsTgwName string, sDM string) error {
 }
 
 func (c *Client) GetVPCAttachmentRouteTableDetails(awsTgwVpcAttachment *AwsTgwVpcAttachment) (*AwsTgwVpcAttachment, error) {
+	return c.GetVPCAttachmentRouteTableDetailsContext(context.Background(), awsTgwVpcAttachment)
+}
+
+func (c *Client) GetVPCAttachmentRouteTableDetailsContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) (*AwsTgwVpcAttachment, error) {
 	var data RouteDomainAPIResp
 	form := map[string]string{
 		"CID":               c.CID,
@@ -234,7 +271,7 @@ func (c *Client) GetVPCAttachmentRouteTableDetails(awsTgwVpcAttachment *AwsTgwVp
 		"route_domain_name": awsTgwVpcAttachment.SecurityDomainName,
 	}
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -251,6 +288,10 @@ func (c *Client) GetVPCAttachmentRouteTableDetails(awsTgwVpcAttachment *AwsTgwVp
 }
 
 func (c *Client) EditTgwSpokeVpcCustomizedRoutes(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
+	return c.EditTgwSpokeVpcCustomizedRoutesContext(context.Background(), awsTgwVpcAttachment)
+}
+
+func (c *Client) EditTgwSpokeVpcCustomizedRoutesContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "edit_tgw_spoke_vpc_customized_routes",
@@ -258,10 +299,14 @@ func (c *Client) EditTgwSpokeVpcCustomizedRoutes(awsTgwVpcAttachment *AwsTgwVpcA
 		"vpc_id":     awsTgwVpcAttachment.VpcID,
 		"route_list": awsTgwVpcAttachment.CustomizedRoutes,
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EditTgwSpokeVpcCustomizedRouteAdvertisement(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
+	return c.EditTgwSpokeVpcCustomizedRouteAdvertisementContext(context.Background(), awsTgwVpcAttachment)
+}
+
+func (c *Client) EditTgwSpokeVpcCustomizedRouteAdvertisementContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	form := map[string]string{
 		"CID":             c.CID,
 		"action":          "update_customized_route_advertisement",
@@ -269,10 +314,14 @@ func (c *Client) EditTgwSpokeVpcCustomizedRouteAdvertisement(awsTgwVpcAttachment
 		"attachment_name": awsTgwVpcAttachment.VpcID,
 		"cidr_list":       awsTgwVpcAttachment.CustomizedRouteAdvertisement,
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
+	return c.UpdateFirewallAttachmentAccessFromOnpremContext(context.Background(), awsTgwVpcAttachment)
+}
+
+func (c *Client) UpdateFirewallAttachmentAccessFromOnpremContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error {
 	params := map[string]string{
 		"action":          "update_firewall_attachment_access_from_onprem",
 		"CID":             c.CID,
@@ -285,5 +334,5 @@ func (c *Client) UpdateFirewallAttachmentAccessFromOnprem(awsTgwVpcAttachment *A
 		params["edge_attachment"] = "no"
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
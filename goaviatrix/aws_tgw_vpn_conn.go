@@ -1,13 +1,14 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // VGWConn simple struct to hold VGW Connection details
@@ -82,6 +83,10 @@ type GetAwsTgwVpnConnVpnIdResp struct {
 }
 
 func (c *Client) CreateAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (string, error) {
+	return c.CreateAwsTgwVpnConnContext(context.Background(), awsTgwVpnConn)
+}
+
+func (c *Client) CreateAwsTgwVpnConnContext(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) (string, error) {
 	var data AwsTgwVpnConnCreateResp
 	form := map[string]string{
 		"CID":                        c.CID,
@@ -107,7 +112,7 @@ func (c *Client) CreateAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (string, erro
 	if awsTgwVpnConn.PreSharedKeyTun2 != "" {
 		form["pre_shared_key_tun_2"] = awsTgwVpnConn.PreSharedKeyTun2
 	}
-	err := c.PostAPIWithResponse(&data, form["action"], form, BasicCheck)
+	err := c.PostAPIContextWithResponse(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return "", err
 	}
@@ -118,6 +123,10 @@ func (c *Client) CreateAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (string, erro
 }
 
 func (c *Client) GetAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConn, error) {
+	return c.GetAwsTgwVpnConnContext(context.Background(), awsTgwVpnConn)
+}
+
+func (c *Client) GetAwsTgwVpnConnContext(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConn, error) {
 	var data AwsTgwVpnConnResp
 	form := map[string]string{
 		"CID":           c.CID,
@@ -125,7 +134,7 @@ func (c *Client) GetAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConn,
 		"tgw_name":      awsTgwVpnConn.TgwName,
 		"resource_type": "vpn",
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +181,7 @@ func (c *Client) GetAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConn,
 			}
 			awsTgwVpnConn.OnpremASN = asnString
 
-			log.Debugf("Found AwsTgwVpnConn: %#v", awsTgwVpnConn)
+			tflog.Debug(ctx, "Found AwsTgwVpnConn", map[string]interface{}{"tgw_name": awsTgwVpnConn.TgwName})
 
 			return awsTgwVpnConn, nil
 		}
@@ -182,13 +191,21 @@ func (c *Client) GetAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConn,
 }
 
 func (c *Client) DeleteAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) error {
+	return c.DeleteAwsTgwVpnConnContext(context.Background(), awsTgwVpnConn)
+}
+
+func (c *Client) DeleteAwsTgwVpnConnContext(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) error {
 	awsTgwVpnConn.CID = c.CID
 	awsTgwVpnConn.Action = "detach_vpn_from_tgw"
 	awsTgwVpnConn.Async = true
-	return c.PostAsyncAPI(awsTgwVpnConn.Action, awsTgwVpnConn, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, awsTgwVpnConn.Action, awsTgwVpnConn, BasicCheck)
 }
 
 func (c *Client) EnableVpnConnectionLearnedCidrsApproval(awsTgwVpnConn *AwsTgwVpnConn) error {
+	return c.EnableVpnConnectionLearnedCidrsApprovalContext(context.Background(), awsTgwVpnConn)
+}
+
+func (c *Client) EnableVpnConnectionLearnedCidrsApprovalContext(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) error {
 	form := map[string]string{
 		"CID":                    c.CID,
 		"action":                 "enable_learned_cidrs_approval",
@@ -196,10 +213,14 @@ func (c *Client) EnableVpnConnectionLearnedCidrsApproval(awsTgwVpnConn *AwsTgwVp
 		"attachment_name":        awsTgwVpnConn.VpnID,
 		"learned_cidrs_approval": awsTgwVpnConn.LearnedCidrsApproval,
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DisableVpnConnectionLearnedCidrsApproval(awsTgwVpnConn *AwsTgwVpnConn) error {
+	return c.DisableVpnConnectionLearnedCidrsApprovalContext(context.Background(), awsTgwVpnConn)
+}
+
+func (c *Client) DisableVpnConnectionLearnedCidrsApprovalContext(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) error {
 	form := map[string]string{
 		"CID":                    c.CID,
 		"action":                 "disable_learned_cidrs_approval",
@@ -207,10 +228,14 @@ func (c *Client) DisableVpnConnectionLearnedCidrsApproval(awsTgwVpnConn *AwsTgwV
 		"attachment_name":        awsTgwVpnConn.VpnID,
 		"learned_cidrs_approval": awsTgwVpnConn.LearnedCidrsApproval,
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetAwsTgwVpnTunnelData(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConnEdit, error) {
+	return c.GetAwsTgwVpnTunnelDataContext(context.Background(), awsTgwVpnConn)
+}
+
+func (c *Client) GetAwsTgwVpnTunnelDataContext(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConnEdit, error) {
 	params := map[string]string{
 		"action":          "list_attachment_route_table_details",
 		"CID":             c.CID,
@@ -226,7 +251,7 @@ func (c *Client) GetAwsTgwVpnTunnelData(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVp
 
 	var data Resp
 
-	err := c.GetAPI(&data, params["action"], params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -1,5 +1,7 @@
 package goaviatrix
 
+import "context"
+
 type AzurePeer struct {
 	Action       string `form:"action,omitempty"`
 	CID          string `form:"CID,omitempty"`
@@ -20,18 +22,26 @@ type AzurePeerAPIResp struct {
 }
 
 func (c *Client) CreateAzurePeer(azurePeer *AzurePeer) error {
+	return c.CreateAzurePeerContext(context.Background(), azurePeer)
+}
+
+func (c *Client) CreateAzurePeerContext(ctx context.Context, azurePeer *AzurePeer) error {
 	azurePeer.CID = c.CID
 	azurePeer.Action = "arm_peer_vnet_pair"
-	return c.PostAPI(azurePeer.Action, azurePeer, BasicCheck)
+	return c.PostAPIContext(ctx, azurePeer.Action, azurePeer, BasicCheck)
 }
 
 func (c *Client) GetAzurePeer(azurePeer *AzurePeer) (*AzurePeer, error) {
+	return c.GetAzurePeerContext(context.Background(), azurePeer)
+}
+
+func (c *Client) GetAzurePeerContext(ctx context.Context, azurePeer *AzurePeer) (*AzurePeer, error) {
 	var data map[string]interface{}
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_arm_peer_vnet_pairs",
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -71,11 +81,15 @@ func (c *Client) GetAzurePeer(azurePeer *AzurePeer) (*AzurePeer, error) {
 }
 
 func (c *Client) DeleteAzurePeer(azurePeer *AzurePeer) error {
+	return c.DeleteAzurePeerContext(context.Background(), azurePeer)
+}
+
+func (c *Client) DeleteAzurePeerContext(ctx context.Context, azurePeer *AzurePeer) error {
 	form := map[string]string{
 		"CID":       c.CID,
 		"action":    "arm_unpeer_vnet_pair",
 		"vpc_name1": azurePeer.VNet1,
 		"vpc_name2": azurePeer.VNet2,
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
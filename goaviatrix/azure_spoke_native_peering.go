@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"strings"
 )
 
@@ -27,12 +28,20 @@ type AzureSpokeNativePeeringEdit struct {
 }
 
 func (c *Client) CreateAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpokeNativePeering) error {
+	return c.CreateAzureSpokeNativePeeringContext(context.Background(), azureSpokeNativePeering)
+}
+
+func (c *Client) CreateAzureSpokeNativePeeringContext(ctx context.Context, azureSpokeNativePeering *AzureSpokeNativePeering) error {
 	azureSpokeNativePeering.CID = c.CID
 	azureSpokeNativePeering.Action = "attach_arm_native_spoke_to_transit"
-	return c.PostAPI(azureSpokeNativePeering.Action, azureSpokeNativePeering, BasicCheck)
+	return c.PostAPIContext(ctx, azureSpokeNativePeering.Action, azureSpokeNativePeering, BasicCheck)
 }
 
 func (c *Client) GetAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpokeNativePeering) (*AzureSpokeNativePeering, error) {
+	return c.GetAzureSpokeNativePeeringContext(context.Background(), azureSpokeNativePeering)
+}
+
+func (c *Client) GetAzureSpokeNativePeeringContext(ctx context.Context, azureSpokeNativePeering *AzureSpokeNativePeering) (*AzureSpokeNativePeering, error) {
 	var data AzureSpokeNativePeeringAPIResp
 	form := map[string]string{
 		"CID":                  c.CID,
@@ -40,7 +49,7 @@ func (c *Client) GetAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpokeN
 		"transit_gateway_name": azureSpokeNativePeering.TransitGatewayName,
 		"details":              "true",
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -65,11 +74,15 @@ func (c *Client) GetAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpokeN
 }
 
 func (c *Client) DeleteAzureSpokeNativePeering(azureSpokeNativePeering *AzureSpokeNativePeering) error {
+	return c.DeleteAzureSpokeNativePeeringContext(context.Background(), azureSpokeNativePeering)
+}
+
+func (c *Client) DeleteAzureSpokeNativePeeringContext(ctx context.Context, azureSpokeNativePeering *AzureSpokeNativePeering) error {
 	form := map[string]string{
 		"CID":                  c.CID,
 		"action":               "detach_arm_native_spoke_to_transit",
 		"transit_gateway_name": azureSpokeNativePeering.TransitGatewayName,
 		"spoke_name":           azureSpokeNativePeering.SpokeAccountName + ":" + strings.Replace(azureSpokeNativePeering.SpokeVpcID, ".", "-", -1),
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
@@ -1,5 +1,7 @@
 package goaviatrix
 
+import "context"
+
 type AzureVngConn struct {
 	CID                string
 	PrimaryGatewayName string
@@ -15,6 +17,10 @@ type AzureVngConnResp struct {
 }
 
 func (c *Client) ConnectAzureVng(r *AzureVngConn) error {
+	return c.ConnectAzureVngContext(context.Background(), r)
+}
+
+func (c *Client) ConnectAzureVngContext(ctx context.Context, r *AzureVngConn) error {
 	params := map[string]string{
 		"action":               "attach_vng_to_transit_gateway",
 		"CID":                  c.CID,
@@ -22,10 +28,14 @@ func (c *Client) ConnectAzureVng(r *AzureVngConn) error {
 		"connection_name":      r.ConnectionName,
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
 
 func (c *Client) GetAzureVngConnStatus(connectionName string) (*AzureVngConnResp, error) {
+	return c.GetAzureVngConnStatusContext(context.Background(), connectionName)
+}
+
+func (c *Client) GetAzureVngConnStatusContext(ctx context.Context, connectionName string) (*AzureVngConnResp, error) {
 	params := map[string]string{
 		"action": "list_vnets_with_vng",
 		"CID":    c.CID,
@@ -39,7 +49,7 @@ func (c *Client) GetAzureVngConnStatus(connectionName string) (*AzureVngConnResp
 
 	var data Resp
 
-	err := c.GetAPI(&data, params["action"], params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -54,6 +64,10 @@ func (c *Client) GetAzureVngConnStatus(connectionName string) (*AzureVngConnResp
 }
 
 func (c *Client) DisconnectAzureVng(vpcId string, connectionName string) error {
+	return c.DisconnectAzureVngContext(context.Background(), vpcId, connectionName)
+}
+
+func (c *Client) DisconnectAzureVngContext(ctx context.Context, vpcId string, connectionName string) error {
 	params := map[string]string{
 		"action":          "disconnect_transit_gw",
 		"CID":             c.CID,
@@ -61,5 +75,5 @@ func (c *Client) DisconnectAzureVng(vpcId string, connectionName string) error {
 		"connection_name": connectionName,
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
@@ -75,7 +75,9 @@ func (c *Client) CreateCentralizedTransitFireNet(ctx context.Context, firenetAtt
 	return c.PostAPIContext(ctx, firenetAttachment.Action, firenetAttachment, BasicCheck)
 }
 
-func (c *Client) GetCentralizedTransitFireNet(ctx context.Context, centralizedTransitFirenet *CentralizedTransitFirenet) error {
+// ListCentralizedTransitFireNets returns the names of the secondary FireNet
+// gateways attached to each primary FireNet gateway.
+func (c *Client) ListCentralizedTransitFireNets(ctx context.Context) (map[string][]string, error) {
 	form := map[string]string{
 		"action":      "list_transit_firenet",
 		"CID":         c.CID,
@@ -103,22 +105,28 @@ func (c *Client) GetCentralizedTransitFireNet(ctx context.Context, centralizedTr
 	var resp Resp
 	err := c.GetAPIContext(ctx, &resp, form["action"], form, BasicCheck)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	centralizedFireNets := make(map[string][]string)
 	for _, cf := range resp.Results {
-		if centralizedTransitFirenet.PrimaryGwName == cf.PrimaryFirenet.GwName {
-			var SFList []string
-			for _, sf := range cf.SecondaryFirenetList {
-				SFList = append(SFList, sf.GwName)
-			}
-
-			if !Contains(SFList, centralizedTransitFirenet.SecondaryGwName) {
-				return ErrNotFound
-			}
+		for _, sf := range cf.SecondaryFirenetList {
+			centralizedFireNets[cf.PrimaryFirenet.GwName] = append(centralizedFireNets[cf.PrimaryFirenet.GwName], sf.GwName)
 		}
 	}
 
+	return centralizedFireNets, nil
+}
+
+func (c *Client) GetCentralizedTransitFireNet(ctx context.Context, centralizedTransitFirenet *CentralizedTransitFirenet) error {
+	centralizedFireNets, err := c.ListCentralizedTransitFireNets(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !Contains(centralizedFireNets[centralizedTransitFirenet.PrimaryGwName], centralizedTransitFirenet.SecondaryGwName) {
+		return ErrNotFound
+	}
 	return nil
 }
 
@@ -0,0 +1,58 @@
+package goaviatrix
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCentralizedTransitFireNet(t *testing.T) {
+	client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "list_transit_firenet", r.URL.Query().Get("action"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"return": true, "results": [
+			{"primary_firenet": {"gw_name": "primary-1"}, "secondary_firenet_list": [{"gw_name": "secondary-1"}]},
+			{"primary_firenet": {"gw_name": "primary-2"}, "secondary_firenet_list": []}
+		]}`))
+	})
+
+	tests := []struct {
+		name      string
+		primary   string
+		secondary string
+		wantErr   error
+	}{
+		{name: "attached", primary: "primary-1", secondary: "secondary-1"},
+		{name: "other secondary", primary: "primary-1", secondary: "secondary-2", wantErr: ErrNotFound},
+		{name: "no secondary", primary: "primary-2", secondary: "secondary-1", wantErr: ErrNotFound},
+		{name: "unknown primary", primary: "primary-3", secondary: "secondary-1", wantErr: ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.GetCentralizedTransitFireNet(context.Background(), &CentralizedTransitFirenet{
+				PrimaryGwName:   tt.primary,
+				SecondaryGwName: tt.secondary,
+			})
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestListCentralizedTransitFireNets(t *testing.T) {
+	client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "list_transit_firenet", r.URL.Query().Get("action"))
+		assert.Equal(t, "true", r.URL.Query().Get("centralized"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"return": true, "results": [
+			{"primary_firenet": {"gw_name": "primary-1"}, "secondary_firenet_list": [{"gw_name": "secondary-1"}, {"gw_name": "secondary-2"}]},
+			{"primary_firenet": {"gw_name": "primary-2"}, "secondary_firenet_list": []}
+		]}`))
+	})
+
+	got, err := client.ListCentralizedTransitFireNets(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"primary-1": {"secondary-1", "secondary-2"}}, got)
+}
@@ -1,5 +1,7 @@
 package goaviatrix
 
+import "context"
+
 type HTTPSCertConfig struct {
 	CACertificateFilePath     string
 	ServerCertificateFilePath string
@@ -10,6 +12,10 @@ type HTTPSCertConfig struct {
 }
 
 func (c *Client) ImportNewHTTPSCerts(certConfig *HTTPSCertConfig) error {
+	return c.ImportNewHTTPSCertsContext(context.Background(), certConfig)
+}
+
+func (c *Client) ImportNewHTTPSCertsContext(ctx context.Context, certConfig *HTTPSCertConfig) error {
 	data := map[string]string{
 		"action": "import_new_https_certs",
 		"CID":    c.CID,
@@ -55,15 +61,19 @@ func (c *Client) ImportNewHTTPSCerts(certConfig *HTTPSCertConfig) error {
 		}
 	}
 
-	return c.PostFileAPI(data, files, BasicCheck)
+	return c.PostFileAPIContext(ctx, data, files, BasicCheck)
 }
 
 func (c *Client) DisableImportedHTTPSCerts() error {
+	return c.DisableImportedHTTPSCertsContext(context.Background())
+}
+
+func (c *Client) DisableImportedHTTPSCertsContext(ctx context.Context) error {
 	data := map[string]string{
 		"action": "disable_imported_certificate",
 		"CID":    c.CID,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 type GetHTTPSCertsStatusResp struct {
@@ -76,12 +86,16 @@ type GetHTTPSCertsStatus struct {
 }
 
 func (c *Client) GetHTTPSCertsStatus() (bool, error) {
+	return c.GetHTTPSCertsStatusContext(context.Background())
+}
+
+func (c *Client) GetHTTPSCertsStatusContext(ctx context.Context) (bool, error) {
 	data := map[string]string{
 		"action": "get_https_certs_status",
 		"CID":    c.CID,
 	}
 	var respData GetHTTPSCertsStatusResp
-	err := c.GetAPI(&respData, data["action"], data, BasicCheck)
+	err := c.GetAPIContext(ctx, &respData, data["action"], data, BasicCheck)
 	if err != nil {
 		return false, err
 	}
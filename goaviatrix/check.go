@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -12,11 +13,15 @@ const (
 )
 
 func (c *Client) ControllerVersionValidation(supportedVersions []string) error {
+	return c.ControllerVersionValidationContext(context.Background(), supportedVersions)
+}
+
+func (c *Client) ControllerVersionValidationContext(ctx context.Context, supportedVersions []string) error {
 	if len(supportedVersions) == 0 {
 		return errors.New("supportedVersions is not provided")
 	}
 
-	currentVersion, err := c.GetCurrentVersion()
+	currentVersion, err := c.GetCurrentVersionContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -17,10 +17,9 @@ import (
 	"reflect"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/ajg/form"
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // LoginResp represents the response object from the `login` action
@@ -43,26 +42,79 @@ type APIRequest struct {
 	Action string `form:"action,omitempty" json:"action" url:"action"`
 }
 
+// ClientInterface is the set of controller operations used by the provider
+// resources. It is composed of the per-domain interfaces declared in
+// client_interfaces.go, so that helpers depending on a single domain can
+// accept the narrower interface. Resources are tested with ClientInterfaceMock.
+//
 //go:generate moq -rm -out client_mock.go . ClientInterface
 type ClientInterface interface {
-	DeleteAccount(account *Account) error
-	GetAccount(account *Account) (Account, error)
-	AuditAccount(ctx context.Context, account *Account) error
-	InvalidateCache()
-}
+	AccountClient
+	RBACClient
+	ControllerClient
+	LoggingClient
+	VpcClient
+	GatewayClient
+	TransitClient
+	SpokeClient
+	EdgeClient
+	DCFClient
+	FQDNClient
+	FireNetClient
+	Site2CloudClient
+	VPNClient
+	AWSTgwClient
+	PeeringClient
+	SegmentationClient
+
+	GetCID() string
+	GetControllerIP() string
+	GetDefaultTagsConfig() *DefaultTagsConfig
+	GetIgnoreTagsConfig() *IgnoreTagsConfig
+}
+
+var _ ClientInterface = (*Client)(nil)
 
 // Client for accessing the Aviatrix Controller
 type Client struct {
-	ClientInterface
-	HTTPClient       *http.Client
-	Username         string
-	Password         string
-	CID              string
-	ControllerIP     string
-	baseURL          string
-	IgnoreTagsConfig *IgnoreTagsConfig
-	cachedAccounts   []Account
-	cacheMutex       sync.Mutex
+	HTTPClient        *http.Client
+	Username          string
+	Password          string
+	PasswordSource    PasswordSource
+	APIToken          string
+	CID               string
+	ControllerIP      string
+	baseURL           string
+	IgnoreTagsConfig  *IgnoreTagsConfig
+	DefaultTagsConfig *DefaultTagsConfig
+	RetryPolicy       *RetryPolicy
+	Redactor          *Redactor
+	rateLimiter       *rateLimiter
+	inFlight          chan struct{}
+	cachedAccounts    []Account
+	cacheMutex        sync.Mutex
+	auditRecords      []AccountAuditRecord
+	auditMutex        sync.Mutex
+}
+
+// GetCID returns the session ID of the logged in client.
+func (c *Client) GetCID() string {
+	return c.CID
+}
+
+// GetControllerIP returns the IP address or hostname of the controller.
+func (c *Client) GetControllerIP() string {
+	return c.ControllerIP
+}
+
+// GetDefaultTagsConfig returns the tags added to every taggable resource.
+func (c *Client) GetDefaultTagsConfig() *DefaultTagsConfig {
+	return c.DefaultTagsConfig
+}
+
+// GetIgnoreTagsConfig returns the tags ignored when reading resources.
+func (c *Client) GetIgnoreTagsConfig() *IgnoreTagsConfig {
+	return c.IgnoreTagsConfig
 }
 
 type GetApiTokenResp struct {
@@ -78,13 +130,17 @@ type ApiTokenInfo struct {
 }
 
 func (c *Client) GetApiToken() (string, error) {
+	return c.GetApiTokenContext(context.Background())
+}
+
+func (c *Client) GetApiTokenContext(ctx context.Context) (string, error) {
 	apiToken := make(map[string]interface{})
 	apiToken["action"] = "get_api_token"
 	apiToken["log_enable"] = true
 
-	log.Infof("Getting API token...")
+	tflog.Info(ctx, "Getting API token...")
 	Url := fmt.Sprintf("https://%s/v2/api", c.ControllerIP)
-	resp, err := c.Get(Url, apiToken)
+	resp, err := c.GetContext(ctx, Url, apiToken)
 	if err != nil {
 		return "", err
 	}
@@ -95,7 +151,7 @@ func (c *Client) GetApiToken() (string, error) {
 	if !data.Return {
 		return "", errors.New(data.Reason)
 	}
-	log.Tracef("Token is '%s'.", data.Results.ApiToken)
+	tflog.Trace(ctx, "Received API token.")
 	return data.Results.ApiToken, nil
 }
 
@@ -109,7 +165,19 @@ func (c *Client) GetApiToken() (string, error) {
 //
 //	error - if any
 func (c *Client) Login() error {
-	ApiToken, err := c.GetApiToken()
+	return c.LoginContext(context.Background())
+}
+
+func (c *Client) LoginContext(ctx context.Context) error {
+	if c.APIToken != "" && !c.hasPasswordCredentials() {
+		return ErrAPITokenExpired
+	}
+	password, err := c.password()
+	if err != nil {
+		return err
+	}
+
+	ApiToken, err := c.GetApiTokenContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -117,11 +185,11 @@ func (c *Client) Login() error {
 	account := make(map[string]interface{})
 	account["action"] = "login"
 	account["username"] = c.Username
-	account["password"] = c.Password
+	account["password"] = password
 
 	Url := fmt.Sprintf("https://%s/v2/api", c.ControllerIP)
-	log.Infof("Parsed Aviatrix login: %s", account["username"])
-	resp, err := c.RequestContextLogin(context.Background(), "POST", Url, account, ApiToken)
+	tflog.Info(ctx, "Parsed Aviatrix login", map[string]interface{}{"username": account["username"]})
+	resp, err := c.RequestContextLogin(ctx, "POST", Url, account, ApiToken)
 	if err != nil {
 		return err
 	}
@@ -137,13 +205,25 @@ func (c *Client) Login() error {
 }
 
 func (c *Client) LoginForCloudn() error {
+	return c.LoginForCloudnContext(context.Background())
+}
+
+func (c *Client) LoginForCloudnContext(ctx context.Context) error {
+	if c.APIToken != "" && !c.hasPasswordCredentials() {
+		return ErrAPITokenExpired
+	}
+	password, err := c.password()
+	if err != nil {
+		return err
+	}
+
 	account := make(map[string]interface{})
 	account["action"] = "login"
 	account["username"] = c.Username
-	account["password"] = c.Password
+	account["password"] = password
 
-	log.Infof("Parsed Aviatrix login: %s", account["username"])
-	resp, err := c.Post(c.baseURL, account)
+	tflog.Info(ctx, "Parsed Aviatrix login", map[string]interface{}{"username": account["username"]})
+	resp, err := c.PostContext(ctx, c.baseURL, account)
 	if err != nil {
 		return err
 	}
@@ -154,7 +234,7 @@ func (c *Client) LoginForCloudn() error {
 	if !data.Return {
 		return errors.New(data.Reason)
 	}
-	log.Tracef("CID is '%s'.", data.CID)
+	tflog.Trace(ctx, "Received CID.")
 	c.CID = data.CID
 	return nil
 }
@@ -166,6 +246,7 @@ func (c *Client) LoginForCloudn() error {
 //	password - the controller password
 //	controllerIP - the controller IP/host
 //	HTTPClient - the http client object
+//	opts - optional settings such as the retry policy or rate limits
 //
 // Returns:
 //
@@ -175,22 +256,33 @@ func (c *Client) LoginForCloudn() error {
 // See Also:
 //
 //	init()
-func NewClient(username string, password string, controllerIP string, HTTPClient *http.Client, ignoreTagsConfig *IgnoreTagsConfig) (*Client, error) {
+func NewClient(username string, password string, controllerIP string, HTTPClient *http.Client, ignoreTagsConfig *IgnoreTagsConfig, opts ...ClientOption) (*Client, error) {
+	return NewClientContext(context.Background(), username, password, controllerIP, HTTPClient, ignoreTagsConfig, opts...)
+}
+
+// NewClientContext is NewClient with the context the login request is sent
+// and logged with.
+func NewClientContext(ctx context.Context, username string, password string, controllerIP string, HTTPClient *http.Client, ignoreTagsConfig *IgnoreTagsConfig, opts ...ClientOption) (*Client, error) {
 	client := &Client{Username: username, Password: password, HTTPClient: HTTPClient, ControllerIP: controllerIP, IgnoreTagsConfig: ignoreTagsConfig}
-	return client.init(controllerIP)
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client.init(ctx, controllerIP)
 }
 
 // init initializes the new client with the given controller IP/host.  Logs
-// in to the controller and sets up the http client.
+// in to the controller, unless an API token is set, and sets up the http
+// client.
 // Arguments:
 //
+//	ctx - the context of the login request
 //	controllerIP - the controller host/IP
 //
 // Returns:
 //
 //	Client - the updated client object
 //	error - if any
-func (c *Client) init(controllerIP string) (*Client, error) {
+func (c *Client) init(ctx context.Context, controllerIP string) (*Client, error) {
 	if len(controllerIP) == 0 {
 		return nil, fmt.Errorf("Aviatrix: Client: Controller IP is not set")
 	}
@@ -206,7 +298,13 @@ func (c *Client) init(controllerIP string) (*Client, error) {
 		}
 		c.HTTPClient = &http.Client{Transport: tr}
 	}
-	if err := c.Login(); err != nil {
+	if c.APIToken != "" {
+		// Use the pre-issued token as the session, Login is only needed
+		// once the controller rejects it.
+		c.CID = c.APIToken
+		return c, nil
+	}
+	if err := c.LoginContext(ctx); err != nil {
 		return nil, err
 	}
 
@@ -333,7 +431,8 @@ func (c *Client) PostAsyncAPI(action string, i interface{}, checkFunc CheckAPIRe
 }
 
 func (c *Client) PostAsyncAPIContext(ctx context.Context, action string, i interface{}, checkFunc CheckAPIResponseFunc) error {
-	log.Printf("[DEBUG] Post AsyncAPI %s: %v", action, i)
+	ctx = apiLogContext(ctx, http.MethodPost, action, c.baseURL)
+	tflog.SubsystemDebug(ctx, APILogSubsystem, "Starting controller async task", map[string]interface{}{APILogFieldRequestBody: c.redactor().Value(i)})
 	resp, err := c.PostContext(ctx, c.baseURL, i)
 	if err != nil {
 		return fmt.Errorf("HTTP POST %s failed: %v", action, err)
@@ -357,47 +456,55 @@ func (c *Client) PostAsyncAPIContext(ctx context.Context, action string, i inter
 	}
 
 	requestID := data.Result
+	ctx = tflog.SubsystemSetField(ctx, APILogSubsystem, APILogFieldRequestID, requestID)
+	tflog.SubsystemDebug(ctx, APILogSubsystem, "Waiting for controller async task")
 	form := map[string]string{
 		"action":     "check_task_status",
 		"CID":        c.CID,
 		"request_id": requestID,
 	}
 
-	const maxPoll = 360
-	sleepDuration := time.Second * 10
-	var j int
-	for ; j < maxPoll; j++ {
-		resp, err = c.PostContext(ctx, c.baseURL, form)
-		if err != nil {
-			// Could be transient HTTP error, e.g. EOF error
-			time.Sleep(sleepDuration)
-			continue
-		}
-		buf = new(bytes.Buffer)
-		buf.ReadFrom(resp.Body)
-		err = json.Unmarshal(buf.Bytes(), &data)
-		if err != nil {
-			if strings.Contains(buf.String(), "502 Proxy Error") || strings.Contains(buf.String(), "503 Service Unavailable") {
-				time.Sleep(sleepDuration)
-				continue
+	p := c.retryPolicy()
+	sleepDuration := p.AsyncPollInterval
+	if sleepDuration <= 0 {
+		sleepDuration = DefaultAsyncPollInterval
+	}
+	timeout := p.AsyncPollTimeout
+	if timeout <= 0 {
+		timeout = DefaultAsyncPollTimeout
+	}
+	pollCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		resp, err = c.PostContext(pollCtx, c.baseURL, form)
+		if err == nil {
+			buf = new(bytes.Buffer)
+			buf.ReadFrom(resp.Body)
+			err = json.Unmarshal(buf.Bytes(), &data)
+			if err != nil && !p.IsRetryableReason(buf.String()) {
+				return fmt.Errorf("decode check_task_status failed: %v\n Body: %s", err, buf.String())
 			}
-			return fmt.Errorf("decode check_task_status failed: %v\n Body: %s", err, buf.String())
 		}
-		if !data.Return {
+		if err == nil {
+			if data.Return {
+				// Async API is done, return result of checkFunc
+				return checkFunc(action, "Post", data.Result, data.Return)
+			}
 			if data.Reason != "" && data.Reason != "REQUEST_IN_PROGRESS" {
 				return fmt.Errorf("rest API %s POST failed: %s", action, data.Reason)
 			}
-
-			// Not done yet
-			time.Sleep(sleepDuration)
-			continue
 		}
 
-		// Async API is done, return result of checkFunc
-		return checkFunc(action, "Post", data.Result, data.Return)
+		// Not done yet, or a transient error such as EOF or a proxy error page
+		if err := sleepContext(pollCtx, sleepDuration); err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("stopped waiting for %s to finish: %v. Please manually verify the status", action, ctx.Err())
+			}
+			// Waited for too long and async API never finished
+			return fmt.Errorf("waited %s but %s never finished. Please manually verify the status", timeout, action)
+		}
 	}
-	// Waited for too long and async API never finished
-	return fmt.Errorf("waited %s but upgrade never finished. Please manually verify the upgrade status", maxPoll*sleepDuration)
 }
 
 // checkAPIResp will decode the response and check for any errors with the provided checkFunc
@@ -456,27 +563,10 @@ func (c *Client) GetAPIContext(ctx context.Context, v interface{}, action string
 		return fmt.Errorf("could not url encode values for action %q: %v", action, err)
 	}
 
-	try, maxTries, backoff := 0, 5, 500*time.Millisecond
-	var resp *http.Response
-	for {
-		try++
-		resp, err = c.GetContext(ctx, Url, nil)
-		if err == nil {
-			break
-		}
-
-		log.WithFields(log.Fields{
-			"try":    try,
-			"action": action,
-			"err":    err.Error(),
-		}).Warnf("HTTP GET request failed")
-
-		if try == maxTries {
-			return fmt.Errorf("HTTP Get %s failed: %v", action, err)
-		}
-		time.Sleep(backoff)
-		// Double the backoff time after each failed try
-		backoff *= 2
+	// Transient failures are retried by the client's RetryPolicy
+	resp, err := c.GetContext(ctx, Url, nil)
+	if err != nil {
+		return fmt.Errorf("HTTP Get %s failed: %v", action, err)
 	}
 
 	buf := new(bytes.Buffer)
@@ -510,12 +600,20 @@ func (c *Client) urlEncode(d map[string]string) (string, error) {
 
 // Put issues an HTTP PUT request with the given interface form-encoded.
 func (c *Client) Put(path string, i interface{}) (*http.Response, error) {
-	return c.Request("PUT", path, i)
+	return c.PutContext(context.Background(), path, i)
+}
+
+func (c *Client) PutContext(ctx context.Context, path string, i interface{}) (*http.Response, error) {
+	return c.RequestContext(ctx, "PUT", path, i)
 }
 
 // Delete issues an HTTP DELETE request.
 func (c *Client) Delete(path string, i interface{}) (*http.Response, error) {
-	return c.Request("GET", path, i)
+	return c.DeleteContext(context.Background(), path, i)
+}
+
+func (c *Client) DeleteContext(ctx context.Context, path string, i interface{}) (*http.Response, error) {
+	return c.RequestContext(ctx, "GET", path, i)
 }
 
 type File struct {
@@ -533,13 +631,14 @@ func (c *Client) PostFile(path string, params map[string]string, files []File) (
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", path, body)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", contentType)
-
-	return c.HTTPClient.Do(req)
+	return c.do(context.Background(), "POST", params["action"], func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", path, bytes.NewReader(body.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", contentType)
+		return req, nil
+	})
 }
 
 // PostFileContext will encode the files and parameters with multipart form encoding.
@@ -549,13 +648,14 @@ func (c *Client) PostFileContext(ctx context.Context, path string, params map[st
 		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", path, body)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", contentType)
-
-	return c.HTTPClient.Do(req)
+	return c.do(ctx, "POST", params["action"], func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "POST", path, bytes.NewReader(body.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", contentType)
+		return req, nil
+	})
 }
 
 func encodeMultipartFormData(params map[string]string, files []File) (*bytes.Buffer, string, error) {
@@ -634,10 +734,9 @@ func (c *Client) Request(verb string, path string, i interface{}) (*http.Respons
 // RequestContext makes an HTTP request with the given interface being encoded as
 // form data.
 func (c *Client) RequestContext(ctx context.Context, verb string, path string, i interface{}) (*http.Response, error) {
-	log.Tracef("%s %s", verb, path)
-
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
+	try, maxTries := 0, 2
+	action := requestAction(i)
+	ctx = apiLogContext(ctx, verb, action, path)
 	var err error
 	var data *APIResp
 	var resp *http.Response
@@ -645,25 +744,21 @@ func (c *Client) RequestContext(ctx context.Context, verb string, path string, i
 	for {
 		try++
 
-		if i != nil {
+		resp, err = c.do(ctx, verb, action, func() (*http.Request, error) {
+			if i == nil {
+				return http.NewRequestWithContext(ctx, verb, path, nil)
+			}
 			body, err := form.EncodeToValues(i, true)
 			if err != nil {
 				return nil, err
 			}
-			reader := strings.NewReader(body.Encode())
-			req, err = http.NewRequestWithContext(ctx, verb, path, reader)
-			if err == nil {
-				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req, err := http.NewRequestWithContext(ctx, verb, path, strings.NewReader(body.Encode()))
+			if err != nil {
+				return nil, err
 			}
-		} else {
-			req, err = http.NewRequestWithContext(ctx, verb, path, nil)
-		}
-
-		if err != nil {
-			return nil, err
-		}
-
-		resp, err = c.HTTPClient.Do(req)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			return req, nil
+		})
 		if err != nil {
 			return resp, err
 		}
@@ -687,8 +782,8 @@ func (c *Client) RequestContext(ctx context.Context, verb string, path string, i
 				return resp, err
 			}
 
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
+			tflog.SubsystemDebug(ctx, APILogSubsystem, "CID invalid or expired, logging in again")
+			if err = c.LoginContext(ctx); err != nil {
 				return resp, err
 			}
 
@@ -717,17 +812,14 @@ func (c *Client) RequestContext(ctx context.Context, verb string, path string, i
 				path = Url.String()
 			}
 
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
+			tflog.SubsystemWarn(ctx, APILogSubsystem, "Controller API request failed with expired CID", map[string]interface{}{APILogFieldAttempt: try})
 
 			if try == maxTries {
 				return resp, fmt.Errorf("%v", data.Reason)
 			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
+			if err := sleepContext(ctx, c.retryPolicy().Backoff(try)); err != nil {
+				return resp, err
+			}
 		} else {
 			return resp, nil
 		}
@@ -735,10 +827,9 @@ func (c *Client) RequestContext(ctx context.Context, verb string, path string, i
 }
 
 func (c *Client) RequestContextLogin(ctx context.Context, verb string, path string, i interface{}, token string) (*http.Response, error) {
-	log.Tracef("%s %s", verb, path)
-
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
+	try, maxTries := 0, 2
+	action := requestAction(i)
+	ctx = apiLogContext(ctx, verb, action, path)
 	var err error
 	var data *APIResp
 	var resp *http.Response
@@ -746,29 +837,28 @@ func (c *Client) RequestContextLogin(ctx context.Context, verb string, path stri
 	for {
 		try++
 
+		var body string
 		if i != nil {
 			buf := new(bytes.Buffer)
 			if err = form.NewEncoder(buf).Encode(i); err != nil {
 				return nil, err
 			}
-			body := buf.String()
-			log.Tracef("%s %s Body: %s", verb, path, body)
-
-			reader := strings.NewReader(body)
-			req, err = http.NewRequestWithContext(ctx, verb, path, reader)
-			if err == nil {
-				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-				req.Header.Add("X-Access-Key", token)
-			}
-		} else {
-			req, err = http.NewRequestWithContext(ctx, verb, path, nil)
-		}
-
-		if err != nil {
-			return nil, err
+			body = buf.String()
+			tflog.SubsystemTrace(ctx, APILogSubsystem, "Controller API request body", map[string]interface{}{APILogFieldRequestBody: c.redactor().Form(body)})
 		}
 
-		resp, err = c.HTTPClient.Do(req)
+		resp, err = c.do(ctx, verb, action, func() (*http.Request, error) {
+			if i == nil {
+				return http.NewRequestWithContext(ctx, verb, path, nil)
+			}
+			req, err := http.NewRequestWithContext(ctx, verb, path, strings.NewReader(body))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.Header.Add("X-Access-Key", token)
+			return req, nil
+		})
 		if err != nil {
 			return resp, err
 		}
@@ -792,8 +882,8 @@ func (c *Client) RequestContextLogin(ctx context.Context, verb string, path stri
 				return resp, err
 			}
 
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
+			tflog.SubsystemDebug(ctx, APILogSubsystem, "CID invalid or expired, logging in again")
+			if err = c.LoginContext(ctx); err != nil {
 				return resp, err
 			}
 
@@ -822,17 +912,14 @@ func (c *Client) RequestContextLogin(ctx context.Context, verb string, path stri
 				path = Url.String()
 			}
 
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
+			tflog.SubsystemWarn(ctx, APILogSubsystem, "Controller API request failed with expired CID", map[string]interface{}{APILogFieldAttempt: try})
 
 			if try == maxTries {
 				return resp, fmt.Errorf("%v", data.Reason)
 			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
+			if err := sleepContext(ctx, c.retryPolicy().Backoff(try)); err != nil {
+				return resp, err
+			}
 		} else {
 			return resp, nil
 		}
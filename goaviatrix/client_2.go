@@ -10,9 +10,8 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
-	"time"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 func checkAndReturnAPIResp2(resp *http.Response, v interface{}, method, action string, checkFunc CheckAPIResponseFunc) error {
@@ -43,7 +42,11 @@ func checkAndReturnAPIResp2(resp *http.Response, v interface{}, method, action s
 // PostAPI2 makes a post request to V2 API, decodes the response and checks for any errors
 // form data, no context
 func (c *Client) PostAPI2(action string, d interface{}, checkFunc CheckAPIResponseFunc) error {
-	return c.PostAPIContext2Form(context.Background(), action, d, checkFunc)
+	return c.PostAPI2Context(context.Background(), action, d, checkFunc)
+}
+
+func (c *Client) PostAPI2Context(ctx context.Context, action string, d interface{}, checkFunc CheckAPIResponseFunc) error {
+	return c.PostAPIContext2Form(ctx, action, d, checkFunc)
 }
 
 func (c *Client) PostAPIContext2Download(ctx context.Context, action string, d interface{}, checkFunc CheckAPIResponseFunc) (io.ReadCloser, error) {
@@ -89,10 +92,9 @@ func (c *Client) DoAPIContext2(ctx context.Context, verb string, v interface{},
 }
 
 func (c *Client) RequestContext2(ctx context.Context, verb string, path string, i interface{}) (*http.Response, error) {
-	log.Tracef("%s %s", verb, path)
-
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
+	try, maxTries := 0, 2
+	action := requestAction(i)
+	ctx = apiLogContext(ctx, verb, action, path)
 	var err error
 	var data *APIResp
 	var resp *http.Response
@@ -100,27 +102,26 @@ func (c *Client) RequestContext2(ctx context.Context, verb string, path string,
 	for {
 		try++
 
+		var body []byte
 		if i != nil {
-			body, err := json.Marshal(i)
+			body, err = json.Marshal(i)
 			if err != nil {
 				return nil, err
 			}
-			log.Tracef("%s %s Body: %s", verb, path, body)
-			reader := bytes.NewReader(body)
-
-			req, err = http.NewRequestWithContext(ctx, verb, path, reader)
-			if err == nil {
-				req.Header.Set("Content-Type", "application/json")
-			}
-		} else {
-			req, err = http.NewRequestWithContext(ctx, verb, path, nil)
+			tflog.SubsystemTrace(ctx, APILogSubsystem, "Controller API request body", map[string]interface{}{APILogFieldRequestBody: c.redactor().JSON(body)})
 		}
 
-		if err != nil {
-			return nil, err
-		}
-
-		resp, err = c.HTTPClient.Do(req)
+		resp, err = c.do(ctx, verb, action, func() (*http.Request, error) {
+			if i == nil {
+				return http.NewRequestWithContext(ctx, verb, path, nil)
+			}
+			req, err := http.NewRequestWithContext(ctx, verb, path, bytes.NewReader(body))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", "application/json")
+			return req, nil
+		})
 		if err != nil {
 			return resp, err
 		}
@@ -144,8 +145,8 @@ func (c *Client) RequestContext2(ctx context.Context, verb string, path string,
 				return resp, err
 			}
 
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
+			tflog.SubsystemDebug(ctx, APILogSubsystem, "CID invalid or expired, logging in again")
+			if err = c.LoginContext(ctx); err != nil {
 				return resp, err
 			}
 
@@ -174,17 +175,14 @@ func (c *Client) RequestContext2(ctx context.Context, verb string, path string,
 				path = Url.String()
 			}
 
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
+			tflog.SubsystemWarn(ctx, APILogSubsystem, "Controller API request failed with expired CID", map[string]interface{}{APILogFieldAttempt: try})
 
 			if try == maxTries {
 				return resp, fmt.Errorf("%v", data.Reason)
 			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
+			if err := sleepContext(ctx, c.retryPolicy().Backoff(try)); err != nil {
+				return resp, err
+			}
 		} else {
 			return resp, nil
 		}
@@ -9,9 +9,8 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type APIError struct {
@@ -63,27 +62,10 @@ func (c *Client) GetAPIContext25(ctx context.Context, v interface{}, path string
 		return fmt.Errorf("could not url encode values for path %q: %v", path, err)
 	}
 
-	try, maxTries, backoff := 0, 5, 500*time.Millisecond
-	var resp *http.Response
-	for {
-		try++
-		resp, err = c.RequestContext25(ctx, "GET", Url, nil)
-		if err == nil {
-			break
-		}
-
-		log.WithFields(log.Fields{
-			"try":    try,
-			"action": path,
-			"err":    err.Error(),
-		}).Warnf("HTTP GET request failed")
-
-		if try == maxTries {
-			return fmt.Errorf("HTTP Get %s failed: %v", path, err)
-		}
-		time.Sleep(backoff)
-		// Double the backoff time after each failed try
-		backoff *= 2
+	// Transient failures are retried by the client's RetryPolicy
+	resp, err := c.RequestContext25(ctx, "GET", Url, nil)
+	if err != nil {
+		return fmt.Errorf("HTTP Get %s failed: %v", path, err)
 	}
 
 	return checkAndReturnAPIResp25(resp, v, "GET", path)
@@ -112,41 +94,41 @@ func (c *Client) DoAPIContext25(ctx context.Context, verb string, v interface{},
 }
 
 func (c *Client) RequestContext25(ctx context.Context, verb string, Url string, i interface{}) (*http.Response, error) {
-	log.Tracef("%s %s", verb, Url)
+	ctx = apiLogContext(ctx, verb, "", Url)
 
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
+	try, maxTries := 0, 2
 	var err error
 	var apiError *APIError
 	var resp *http.Response
 
+	var body []byte
 	if i != nil {
-		body, err := json.Marshal(i)
+		body, err = json.Marshal(i)
 		if err != nil {
 			return nil, err
 		}
-		log.Tracef("%s %s Body: %s", verb, Url, body)
-		reader := bytes.NewReader(body)
-
-		req, err = http.NewRequestWithContext(ctx, verb, Url, reader)
-		if err == nil {
-			req.Header.Set("Content-Type", "application/json")
-		}
-	} else {
-		req, err = http.NewRequestWithContext(ctx, verb, Url, nil)
-	}
-
-	if err != nil {
-		return nil, err
+		tflog.SubsystemTrace(ctx, APILogSubsystem, "Controller API request body", map[string]interface{}{APILogFieldRequestBody: c.redactor().JSON(body)})
 	}
 
 	for {
 		try++
 
-		// Set CID as Authorization header for v2.5
-		req.Header.Set("Authorization", fmt.Sprintf("cid %s", c.CID))
-
-		resp, err = c.HTTPClient.Do(req)
+		resp, err = c.do(ctx, verb, "", func() (*http.Request, error) {
+			var reader io.Reader
+			if i != nil {
+				reader = bytes.NewReader(body)
+			}
+			req, err := http.NewRequestWithContext(ctx, verb, Url, reader)
+			if err != nil {
+				return nil, err
+			}
+			if i != nil {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			// Set CID as Authorization header for v2.5
+			req.Header.Set("Authorization", fmt.Sprintf("cid %s", c.CID))
+			return req, nil
+		})
 		if err != nil {
 			return resp, err
 		}
@@ -166,27 +148,24 @@ func (c *Client) RequestContext25(ctx context.Context, verb string, Url string,
 			}
 
 			if !strings.Contains(apiError.Message, "Invalid CID") {
-				log.Debugf("[DEBUG] API Response Error: %s\n", apiError.Message)
+				tflog.SubsystemDebug(ctx, APILogSubsystem, "Controller API returned an error", map[string]interface{}{APILogFieldError: apiError.Message})
 				return resp, err
 			}
 
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
+			tflog.SubsystemDebug(ctx, APILogSubsystem, "CID invalid or expired, logging in again")
+			if err = c.LoginContext(ctx); err != nil {
 				return resp, err
 			}
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
+			tflog.SubsystemWarn(ctx, APILogSubsystem, "Controller API request failed with expired CID", map[string]interface{}{APILogFieldAttempt: try})
 
 			if try == maxTries {
 				return resp, fmt.Errorf("%v", apiError.Message)
 			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
+			if err := sleepContext(ctx, c.retryPolicy().Backoff(try)); err != nil {
+				return resp, err
+			}
 		} else {
-			log.Debugf("[DEBUG] HTTP Response: %v\n", resp)
+			tflog.SubsystemTrace(ctx, APILogSubsystem, "Controller API response headers", map[string]interface{}{APILogFieldStatusCode: resp.StatusCode, APILogFieldResponseHeaders: c.redactor().Header(resp.Header)})
 			return resp, err
 		}
 	}
@@ -208,10 +187,9 @@ func (c *Client) DoAPIFileContext25(ctx context.Context, verb string, path strin
 }
 
 func (c *Client) RequestFileContext25(ctx context.Context, verb string, Url string, params map[string]string, files []File) (*http.Response, error) {
-	log.Tracef("%s %s", verb, Url)
+	ctx = apiLogContext(ctx, verb, "", Url)
 
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
+	try, maxTries := 0, 2
 	var err error
 	var apiError *APIError
 	var resp *http.Response
@@ -221,19 +199,19 @@ func (c *Client) RequestFileContext25(ctx context.Context, verb string, Url stri
 		return nil, err
 	}
 
-	req, err = http.NewRequestWithContext(ctx, verb, Url, body)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", contentType)
-
 	for {
 		try++
 
-		// Set CID as Authorization header for v2.5
-		req.Header.Set("Authorization", fmt.Sprintf("cid %s", c.CID))
-
-		resp, err = c.HTTPClient.Do(req)
+		resp, err = c.do(ctx, verb, "", func() (*http.Request, error) {
+			req, err := http.NewRequestWithContext(ctx, verb, Url, bytes.NewReader(body.Bytes()))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", contentType)
+			// Set CID as Authorization header for v2.5
+			req.Header.Set("Authorization", fmt.Sprintf("cid %s", c.CID))
+			return req, nil
+		})
 		if err != nil {
 			return resp, err
 		}
@@ -253,27 +231,24 @@ func (c *Client) RequestFileContext25(ctx context.Context, verb string, Url stri
 			}
 
 			if !strings.Contains(apiError.Message, "Invalid CID") {
-				log.Debugf("[DEBUG] API Response Error: %s\n", apiError.Message)
+				tflog.SubsystemDebug(ctx, APILogSubsystem, "Controller API returned an error", map[string]interface{}{APILogFieldError: apiError.Message})
 				return resp, err
 			}
 
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
+			tflog.SubsystemDebug(ctx, APILogSubsystem, "CID invalid or expired, logging in again")
+			if err = c.LoginContext(ctx); err != nil {
 				return resp, err
 			}
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
+			tflog.SubsystemWarn(ctx, APILogSubsystem, "Controller API request failed with expired CID", map[string]interface{}{APILogFieldAttempt: try})
 
 			if try == maxTries {
 				return resp, fmt.Errorf("%v", apiError.Message)
 			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
+			if err := sleepContext(ctx, c.retryPolicy().Backoff(try)); err != nil {
+				return resp, err
+			}
 		} else {
-			log.Debugf("[DEBUG] HTTP Response: %v\n", resp)
+			tflog.SubsystemTrace(ctx, APILogSubsystem, "Controller API response headers", map[string]interface{}{APILogFieldStatusCode: resp.StatusCode, APILogFieldResponseHeaders: c.redactor().Header(resp.Header)})
 			return resp, err
 		}
 	}
@@ -0,0 +1,858 @@
+package goaviatrix
+
+import (
+	"context"
+	"time"
+)
+
+// AccountClient manages cloud accounts and account users.
+type AccountClient interface {
+	CreateAccount(account *Account) error
+	CreateGCPAccount(account *Account) error
+	CreateOCIAccount(account *Account) error
+	InvalidateCache()
+	GetAccount(account *Account) (Account, error)
+	GetAccountContext(ctx context.Context, account *Account) (Account, error)
+	UpdateAccount(account *Account) error
+	UpdateGCPAccount(account *Account) error
+	DeleteAccount(account *Account) error
+	GetAccountAuditRecords(ctx context.Context) ([]AccountAuditRecord, error)
+	GetCachedAccountAuditRecords(ctx context.Context) ([]AccountAuditRecord, error)
+	AuditAccount(ctx context.Context, account *Account) error
+	CreateEdgeAccount(edgeAccount *EdgeAccount) error
+	UpdateEdgeAccount(edgeAccount *EdgeAccount) error
+
+	CreateAccountUserContext(ctx context.Context, user *AccountUser) error
+	GetAccountUserContext(ctx context.Context, user *AccountUser) (*AccountUser, error)
+	UpdateAccountUserObjectContext(ctx context.Context, user *AccountUserEdit) error
+	DeleteAccountUserContext(ctx context.Context, user *AccountUser) error
+}
+
+// RBACClient manages RBAC permission groups and their attachments.
+type RBACClient interface {
+	CreateRbacGroupAccessAccountAttachmentContext(ctx context.Context, rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) error
+	GetRbacGroupAccessAccountAttachmentContext(ctx context.Context, rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) (*RbacGroupAccessAccountAttachment, error)
+	DeleteRbacGroupAccessAccountAttachmentContext(ctx context.Context, rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) error
+	ListRbacGroupAccessAccountsContext(ctx context.Context, groupName string) ([]string, error)
+	DeleteRbacGroupAccessAccountsContext(ctx context.Context, groupName string, accessAccounts []string) error
+	SetRbacGroupAccessAccountsContext(ctx context.Context, groupName string, desired []string) error
+
+	CreatePermissionGroupContext(ctx context.Context, rbacGroup *RbacGroup) error
+	DeletePermissionGroupContext(ctx context.Context, rbacGroup *RbacGroup) error
+	EnableLocalLoginForRBACGroupContext(ctx context.Context, GroupName string) error
+	DisableLocalLoginForRBACGroupContext(ctx context.Context, GroupName string) error
+	GetPermissionGroupDetailsContext(ctx context.Context, GroupName string) (*RbacGroupResponse, error)
+
+	CreateRbacGroupPermissionAttachmentContext(ctx context.Context, rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) error
+	GetRbacGroupPermissionAttachmentContext(ctx context.Context, rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) (*RbacGroupPermissionAttachment, error)
+	DeleteRbacGroupPermissionAttachmentContext(ctx context.Context, rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) error
+
+	CreateRbacGroupUserAttachmentContext(ctx context.Context, rbacGroupUserAttachment *RbacGroupUserAttachment) error
+	GetRbacGroupUserAttachmentContext(ctx context.Context, rbacGroupUserAttachment *RbacGroupUserAttachment) (*RbacGroupUserAttachment, error)
+	DeleteRbacGroupUserAttachmentContext(ctx context.Context, rbacGroupUserAttachment *RbacGroupUserAttachment) error
+	ListRbacGroupUsersContext(ctx context.Context, groupName string) ([]string, error)
+	DeleteRbacGroupUsersContext(ctx context.Context, groupName string, users []string) error
+	SetRbacGroupUsersContext(ctx context.Context, groupName string, desired []string) error
+}
+
+// ControllerClient manages controller-wide settings, CoPilot and private mode.
+type ControllerClient interface {
+	UpdateAwsGuardDutyPollIntervalContext(ctx context.Context, scanningInterval int) error
+	EnableAwsGuardDutyContext(ctx context.Context, account *AwsGuardDutyAccount) error
+	DisableAwsGuardDutyContext(ctx context.Context, account *AwsGuardDutyAccount) error
+	UpdateAwsGuardDutyExcludedIPsContext(ctx context.Context, account *AwsGuardDutyAccount) error
+	GetAwsGuardDutyContext(ctx context.Context) (*AwsGuardDuty, error)
+	GetAwsGuardDutyAccountContext(ctx context.Context, accountName, region string) (*AwsGuardDutyAccount, error)
+
+	ImportNewHTTPSCertsContext(ctx context.Context, certConfig *HTTPSCertConfig) error
+	DisableImportedHTTPSCertsContext(ctx context.Context) error
+	GetHTTPSCertsStatusContext(ctx context.Context) (bool, error)
+
+	EnableExceptionRuleContext(ctx context.Context) error
+	DisableExceptionRuleContext(ctx context.Context) error
+	GetExceptionRuleStatusContext(ctx context.Context) (bool, error)
+	EnableSecurityGroupManagementContext(ctx context.Context, account string) error
+	DisableSecurityGroupManagementContext(ctx context.Context) error
+	GetSecurityGroupManagementStatusContext(ctx context.Context) (*SecurityGroupInfo, error)
+	EnableCloudnBackupConfigContext(ctx context.Context, cloudnBackupConfiguration *CloudnBackupConfiguration) error
+	DisableCloudnBackupConfigContext(ctx context.Context) error
+	GetCloudnBackupConfigContext(ctx context.Context) (*CloudnBackupConfiguration, error)
+	GetControllerVpcDnsServerStatusContext(ctx context.Context) (bool, error)
+	SetControllerVpcDnsServerContext(ctx context.Context, enabled bool) error
+	SetEmailExceptionNotification(ctx context.Context, enabled bool) error
+	GetEmailExceptionNotificationStatus(ctx context.Context) (bool, error)
+	SetCertDomain(ctx context.Context, certDomain string) error
+	GetCertDomain(ctx context.Context) (*CertDomainConfig, error)
+	GetSleepTime(ctx context.Context) (time.Duration, error)
+
+	CreateControllerAccessAllowList(ctx context.Context, allowList *AllowList) error
+	GetControllerAccessAllowList(ctx context.Context) (*AllowList, error)
+	DeleteControllerAccessAllowList(ctx context.Context) error
+
+	SetControllerBgpCommunitiesAutoCloud(ctx context.Context, commPrefix int) error
+	DisableControllerBgpCommunitiesAutoCloud(ctx context.Context) error
+	GetControllerBgpCommunitiesAutoCloud(ctx context.Context) (int, error)
+
+	SetControllerBgpMaxAsLimit(ctx context.Context, maxAsLimit int) error
+	DisableControllerBgpMaxAsLimit(ctx context.Context) error
+	GetControllerBgpMaxAsLimit(ctx context.Context) (int, error)
+
+	ConfigNotificationEmails(ctx context.Context, emailConfiguration *EmailConfiguration) error
+	GetNotificationEmails(ctx context.Context) (*EmailConfiguration, error)
+	SetStatusChangeNotificationInterval(emailConfiguration *EmailConfiguration) error
+
+	EnableControllerBgpCommunitiesGlobal(ctx context.Context) error
+	DisableControllerBgpCommunitiesGlobal(ctx context.Context) error
+	GetControllerBgpCommunitiesGlobal(ctx context.Context) (bool, error)
+
+	GetControllerMetadata(ctx context.Context) (*ControllerMetadata, error)
+
+	EnablePrivateMode(ctx context.Context) error
+	DisablePrivateMode(ctx context.Context) error
+	UpdatePrivateModeCopilot(ctx context.Context, copilotId string) error
+	GetPrivateModeInfo(ctx context.Context) (*ControllerPrivateModeConfig, error)
+	GetPrivateModeProxies(ctx context.Context, lbVpcId string) ([]*PrivateModeMulticloudProxy, error)
+
+	EnablePrivateOob() error
+	EnablePrivateOobContext(ctx context.Context) error
+	GetPrivateOobStateContext(ctx context.Context) (bool, error)
+	DisablePrivateOobContext(ctx context.Context) error
+
+	EnableCopilotAssociation(ctx context.Context, addr string) error
+	DisableCopilotAssociation(ctx context.Context) error
+	GetCopilotAssociationStatus(ctx context.Context) (*CopilotAssociationStatus, error)
+
+	CreateCopilotSimple(ctx context.Context, copilotDeployment *CopilotSimpleDeployment) error
+	CreateCopilotFaultTolerant(ctx context.Context, copilotFaultTolerantDeployment *CopilotFaultTolerantDeployment) error
+	DeleteCopilotSimple(ctx context.Context) error
+	DeleteCopilotFaultTolerant(ctx context.Context) error
+
+	EnableCopilotSecurityGroupManagement(ctx context.Context, copilotSecurityGroupManagementConfig *CopilotSecurityGroupManagementConfig) error
+	GetCopilotSecurityGroupManagementConfig(ctx context.Context) (*CopilotSecurityGroupManagementConfig, error)
+	DisableCopilotSecurityGroupManagement(ctx context.Context) error
+
+	ConfigureGatewayCertificate(ctx context.Context, gwCert *GatewayCertificate) error
+	DisableGatewayCertificate(ctx context.Context) error
+	GetGatewayCertificateStatus(ctx context.Context) (string, error)
+
+	CreateGlobalVpcExcludedInstance(ctx context.Context, globalVpcExcludedInstance *GlobalVpcExcludedInstance) (string, error)
+	GetGlobalVpcExcludedInstance(ctx context.Context, uuid string) (*GlobalVpcExcludedInstance, error)
+	UpdateGlobalVpcExcludedInstance(ctx context.Context, globalVpcExcludedInstance *GlobalVpcExcludedInstance, uuid string) error
+	DeleteGlobalVpcExcludedInstance(ctx context.Context, uuid string) error
+
+	UpdateGlobalVpcTaggingSettings(ctx context.Context, globalVpcTaggingSettings *GlobalVpcTaggingSettings) error
+	GetGlobalVpcTaggingSettings(ctx context.Context) (*GlobalVpcTaggingSettings, error)
+
+	CreatePrivateModeControllerLoadBalancer(ctx context.Context, privateModeLb *PrivateModeLb) error
+	CreatePrivateModeMulticloudLoadBalancer(ctx context.Context, privateModeLb *PrivateModeLb) error
+	UpdatePrivateModeMulticloudProxies(ctx context.Context, privateModeLb *PrivateModeLb) error
+	GetPrivateModeLoadBalancer(ctx context.Context, loadBalancerVpcId string) (*PrivateModeLbRead, error)
+	DeletePrivateModeLoadBalancer(ctx context.Context, loadBalancerVpcId string) error
+
+	CreatePrivateModeMulticloudEndpoint(ctx context.Context, privateModeMulticloudEndpoint *PrivateModeMulticloudEndpoint) error
+	GetPrivateModeMulticloudEndpoint(ctx context.Context, vpcId string) (*PrivateModeMultiCloudEndpointRead, error)
+	DeletePrivateModeMulticloudEndpoint(ctx context.Context, vpcId string) error
+
+	CreateProxyConfigContext(ctx context.Context, proxyConfig *ProxyConfig) error
+	GetProxyConfigContext(ctx context.Context) (*ProxyConfig, error)
+	DeleteProxyConfigContext(ctx context.Context) error
+
+	CreateSamlEndpointContext(ctx context.Context, samlEndpoint *SamlEndpoint) error
+	GetSamlEndpointContext(ctx context.Context, samlEndpoint *SamlEndpoint) (*SamlEndpointInfo, error)
+	EditSamlEndpointContext(ctx context.Context, samlEndpoint *SamlEndpoint) error
+	DeleteSamlEndpointContext(ctx context.Context, samlEndpoint *SamlEndpoint) error
+
+	GetVersionInfoContext(ctx context.Context) (*VersionInfo, error)
+	GetCompatibleImageVersion(ctx context.Context, cloudType int, softwareVersion string) (string, error)
+}
+
+// LoggingClient manages the log and metric forwarders of the controller and gateways.
+type LoggingClient interface {
+	EnableCloudwatchAgentContext(ctx context.Context, r *CloudwatchAgent) error
+	GetCloudwatchAgentStatusContext(ctx context.Context) (*CloudwatchAgentResp, error)
+	DisableCloudwatchAgentContext(ctx context.Context) error
+
+	EnableDatadogAgentContext(ctx context.Context, r *DatadogAgent) error
+	GetDatadogAgentStatusContext(ctx context.Context) (*DatadogAgentResp, error)
+	DisableDatadogAgentContext(ctx context.Context) error
+
+	GetFilebeatForwarderStatusContext(ctx context.Context) (*FilebeatForwarderResp, error)
+	DisableFilebeatForwarderContext(ctx context.Context) error
+
+	EnableNetflowAgentContext(ctx context.Context, r *NetflowAgent) error
+	GetNetflowAgentStatusContext(ctx context.Context) (*NetflowAgentResp, error)
+	DisableNetflowAgentContext(ctx context.Context) error
+
+	EnableRemoteSyslogContext(ctx context.Context, r *RemoteSyslog) error
+	GetRemoteSyslogStatusContext(ctx context.Context, idx int) (*RemoteSyslogResp, error)
+	DisableRemoteSyslogContext(ctx context.Context, idx int) error
+
+	GetSplunkLoggingStatusContext(ctx context.Context) (*SplunkLoggingResp, error)
+	DisableSplunkLoggingContext(ctx context.Context) error
+
+	GetSumologicForwarderStatusContext(ctx context.Context) (*SumologicForwarderResp, error)
+	DisableSumologicForwarderContext(ctx context.Context) error
+}
+
+// VpcClient manages the VPCs and VNets created through the controller.
+//
+//go:generate moq -rm -out vpc_client_mock.go . VpcClient
+type VpcClient interface {
+	CreateVpcContext(ctx context.Context, vpc *Vpc) error
+	GetCloudTypeFromVpcID(vpcID string) (int, error)
+	GetVpcContext(ctx context.Context, vpc *Vpc) (*Vpc, error)
+	GetVpcRouteTableIDsContext(ctx context.Context, vpc *Vpc) ([]string, error)
+	DeleteVpcContext(ctx context.Context, vpc *Vpc) error
+	EnableNativeAwsGwlbFirenet(vpc *Vpc) error
+	DisableNativeAwsGwlbFirenet(vpc *Vpc) error
+	ListOciVpcAvailabilityDomainsContext(ctx context.Context, vpc *Vpc) ([]string, error)
+	ListOciVpcFaultDomainsContext(ctx context.Context, vpc *Vpc) ([]string, error)
+
+	GetVpcTrackerContext(ctx context.Context) ([]*VpcTracker, error)
+}
+
+// GatewayClient manages gateways and the settings shared by all gateway types.
+type GatewayClient interface {
+	CreateGatewayContext(ctx context.Context, gateway *Gateway) error
+	CreatePublicSubnetFilteringGateway(gateway *Gateway) error
+	DeletePublicSubnetFilteringGateway(gateway *Gateway) error
+	EnablePublicSubnetFilteringHAGateway(gateway *Gateway) error
+	EditPublicSubnetFilteringRouteTableList(gateway *Gateway, routeTables []string) error
+	EnableGuardDutyEnforcement(gateway *Gateway) error
+	DisableGuardDutyEnforcement(gateway *Gateway) error
+	EnableSingleAZGateway(gateway *Gateway) error
+	EnablePeeringHaGateway(gateway *Gateway) error
+	DisableSingleAZGateway(gateway *Gateway) error
+	GetGateway(gateway *Gateway) (*Gateway, error)
+	GetGatewayContext(ctx context.Context, gateway *Gateway) (*Gateway, error)
+	GetTransitGatewayList(ctx context.Context) ([]Gateway, error)
+	GetSpokeGatewayList(ctx context.Context) ([]Gateway, error)
+	GetGatewayDetailContext(ctx context.Context, gateway *Gateway) (*GatewayDetail, error)
+	UpdateGatewayContext(ctx context.Context, gateway *Gateway) error
+	DeleteGatewayContext(ctx context.Context, gateway *Gateway) error
+	EnableSNat(gateway *Gateway) error
+	EnableCustomizedSNatContext(ctx context.Context, gateway *Gateway) error
+	DisableSNat(gateway *Gateway) error
+	DisableCustomSNatContext(ctx context.Context, gateway *Gateway) error
+	UpdateDNatContext(ctx context.Context, gateway *Gateway) error
+	UpdateVpnCidr(gateway *Gateway) error
+	UpdateMaxVpnConn(gateway *Gateway) error
+	SetVpnGatewayAuthentication(gateway *VpnGatewayAuth) error
+	EnableVpcDnsServer(gateway *Gateway) error
+	DisableVpcDnsServer(gateway *Gateway) error
+	EnableVpnNat(gateway *Gateway) error
+	DisableVpnNat(gateway *Gateway) error
+	EditDesignatedGateway(gateway *Gateway) error
+	EnableEncryptVolume(gateway *Gateway) error
+	EditGatewayCustomRoutes(gateway *Gateway) error
+	EditGatewayFilterRoutes(gateway *Gateway) error
+	EditGatewayAdvertisedCidr(gateway *Gateway) error
+	EnableTransitFireNet(gateway *Gateway) error
+	EnableTransitFireNetWithGWLB(gateway *Gateway) error
+	DisableTransitFireNet(gateway *Gateway) error
+	IsTransitFireNetReadyToBeDisabled(gateway *Gateway) error
+	EnableSegmentation(transitGateway *TransitVpc) error
+	DisableSegmentation(transitGateway *TransitVpc) error
+	EnableEgressTransitFirenet(transitGateway *TransitVpc) error
+	DisableEgressTransitFirenet(transitGateway *TransitVpc) error
+	EnableMonitorGatewaySubnets(gwName string, excludedInstances []string) error
+	DisableMonitorGatewaySubnets(gwName string) error
+	EnableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error
+	DisableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error
+	GetVPNConfigListContext(ctx context.Context, gateway *Gateway) ([]VPNConfig, error)
+	EnableActiveStandby(transitGateway *TransitVpc) error
+	DisableActiveStandby(transitGateway *TransitVpc) error
+	SwitchActiveTransitGatewayContext(ctx context.Context, gwName, connName string) error
+	GetTransitGatewayLanCidrContext(ctx context.Context, gatewayName string) (string, error)
+	GetFqdnGatewayInfoContext(ctx context.Context, gateway *Gateway) (*FQDNGatwayInfo, error)
+	UpdateTransitGatewayCustomizedVpcRoute(gateway string, customizedTransitVpcRoutes []string) error
+	EnableJumboFrame(gateway *Gateway) error
+	DisableJumboFrame(gateway *Gateway) error
+	GetJumboFrameStatusContext(ctx context.Context, gateway *Gateway) (bool, error)
+	EnablePrivateVpcDefaultRoute(gw *Gateway) error
+	DisablePrivateVpcDefaultRoute(gw *Gateway) error
+	EnableSkipPublicRouteUpdate(gw *Gateway) error
+	DisableSkipPublicRouteUpdate(gw *Gateway) error
+	GetTunnelDetectionTime(entity string) (int, error)
+	ModifyTunnelDetectionTime(entity string, detectionTime int) error
+	EnableActiveStandbyPreemptive(transitGateway *TransitVpc) error
+	SetRxQueueSize(gateway *Gateway) error
+	ChangeBgpOverLanIntfCnt(gateway *Gateway) error
+	EnableGroGso(gateway *Gateway) error
+	DisableGroGso(gateway *Gateway) error
+	GetGroGsoStatus(gateway *Gateway) (bool, error)
+	EnableIPv6(gateway *Gateway) error
+	DisableIPv6(gateway *Gateway) error
+
+	SetGatewayBgpCommunitiesAccept(gwName string, acceptComm bool) error
+	SetGatewayBgpCommunitiesSend(gwName string, sendComm bool) error
+	GetGatewayBgpCommunities(gwName string) (bool, bool, error)
+
+	SetGatewayKeepaliveConfig(ctx context.Context, speed string) error
+	GetGatewayKeepaliveConfig(ctx context.Context) (string, error)
+
+	CreatePeriodicPingContext(ctx context.Context, pp *PeriodicPing) error
+	GetPeriodicPingContext(ctx context.Context, pp *PeriodicPing) (*PeriodicPing, error)
+	DeletePeriodicPingContext(ctx context.Context, pp *PeriodicPing) error
+
+	GetTagsContext(ctx context.Context, tags *Tags) ([]string, error)
+	UpdateTags(tags *Tags) error
+}
+
+// TransitClient manages transit gateways, transit peerings and transit external connections.
+type TransitClient interface {
+	ConnectAzureVngContext(ctx context.Context, r *AzureVngConn) error
+	GetAzureVngConnStatusContext(ctx context.Context, connectionName string) (*AzureVngConnResp, error)
+	DisconnectAzureVngContext(ctx context.Context, vpcId, connectionName string) error
+
+	CreateCloudnTransitGatewayAttachment(ctx context.Context, attachment *CloudnTransitGatewayAttachment) error
+	GetCloudnTransitGatewayAttachment(ctx context.Context, connName string) (*CloudnTransitGatewayAttachmentResp, error)
+	EnableJumboFrameOnConnectionToCloudn(ctx context.Context, connName, vpcID string) error
+	DisableJumboFrameOnConnectionToCloudn(ctx context.Context, connName, vpcID string) error
+	EditCloudnTransitGatewayAttachmentASPathPrepend(ctx context.Context, attachment *CloudnTransitGatewayAttachment, prependASPath []string) error
+	GetDeviceAttachmentVpcIDContext(ctx context.Context, connectionName string) (string, error)
+	DeleteDeviceAttachmentContext(ctx context.Context, connectionName string) error
+
+	CreateExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error
+	CreateExternalDeviceConnContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn) error
+	GetExternalDeviceConnDetail(externalDeviceConn *ExternalDeviceConn, localGateway *Gateway) (*ExternalDeviceConn, error)
+	GetExternalDeviceConnDetailContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn, localGateway *Gateway) (*ExternalDeviceConn, error)
+	DeleteExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error
+	DeleteExternalDeviceConnContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn) error
+	EditTransitExternalDeviceConnASPathPrependContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn, prependASPath []string) error
+	EditConnectionBgpBfd(externalDeviceConn *ExternalDeviceConn) error
+	EditConnectionBgpBfdContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn) error
+	EditConnectionBgpMultihop(externalDeviceConn *ExternalDeviceConn) error
+	EditConnectionBgpMultihopContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn) error
+	EditBgpMd5Key(editBgpMd5Key *EditBgpMd5Key) error
+	EditBgpMd5KeyContext(ctx context.Context, editBgpMd5Key *EditBgpMd5Key) error
+	EnableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error
+	EnableJumboFrameExternalDeviceConnContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn) error
+	DisableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error
+	DisableJumboFrameExternalDeviceConnContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn) error
+	ConnectionBGPSendCommunitiesContext(ctx context.Context, bgpSendCommunities *BgpSendCommunities) error
+
+	CreateTransitGatewayPeering(ctx context.Context, transitGatewayPeering *TransitGatewayPeering) error
+	GetTransitGatewayPeeringDetailsContext(ctx context.Context, transitGatewayPeering *TransitGatewayPeering) (*TransitGatewayPeering, error)
+	UpdateTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error
+	UpdateTransitGatewayPeeringContext(ctx context.Context, transitGatewayPeering *TransitGatewayPeering) error
+	UpdateTransitGatewayPeeringTunnelCountContext(ctx context.Context, transitGatewayPeering *TransitGatewayPeeringEdit) error
+	DeleteTransitGatewayPeeringContext(ctx context.Context, transitGatewayPeering *TransitGatewayPeering) error
+	EditTransitConnectionASPathPrepend(transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error
+	EditTransitConnectionASPathPrependContext(ctx context.Context, transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error
+
+	CreateTransitHaGwContext(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error)
+
+	LaunchTransitVpcContext(ctx context.Context, gateway *TransitVpc) error
+	AttachTransitGWForHybrid(gateway *TransitVpc) error
+	UpdateEdgeGatewayContext(ctx context.Context, gateway *TransitVpc) error
+	UpdateEdgeGatewayV2(ctx context.Context, gateway *TransitVpc) error
+	DetachTransitGWForHybrid(gateway *TransitVpc) error
+	EnableConnectedTransit(gateway *TransitVpc) error
+	DisableConnectedTransit(gateway *TransitVpc) error
+	EnableGatewayFireNetInterfaces(gateway *TransitVpc) error
+	DisableGatewayFireNetInterfaces(gateway *TransitVpc) error
+	EnableGatewayFireNetInterfacesWithGWLB(gateway *TransitVpc) error
+	EnableAdvertiseTransitCidr(transitGw *TransitVpc) error
+	DisableAdvertiseTransitCidr(transitGw *TransitVpc) error
+	SetBgpManualSpokeAdvertisedNetworks(transitGw *TransitVpc) error
+	EnableTransitLearnedCidrsApproval(gateway *TransitVpc) error
+	DisableTransitLearnedCidrsApproval(gateway *TransitVpc) error
+	UpdateTransitPendingApprovedCidrs(gateway *TransitVpc) error
+	SetBgpPollingTime(transitGateway *TransitVpc, newPollingTime int) error
+	SetBgpBfdPollingTime(transitGateway *TransitVpc, newPollingTime int) error
+	SetPrependASPath(transitGateway *TransitVpc, prependASPath []string) error
+	SetLocalASNumber(transitGateway *TransitVpc, localASNumber string) error
+	SetBgpEcmp(transitGateway *TransitVpc, enabled bool) error
+	GetTransitGatewayAdvancedConfigContext(ctx context.Context, transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error)
+	SetTransitLearnedCIDRsApprovalMode(gw *TransitVpc, mode string) error
+	EnableTransitConnectionLearnedCIDRApprovalContext(ctx context.Context, gwName, connName string) error
+	DisableTransitConnectionLearnedCIDRApprovalContext(ctx context.Context, gwName, connName string) error
+	UpdateTransitConnectionPendingApprovedCidrsContext(ctx context.Context, gwName, connName string, approvedCidrs []string) error
+	EditTransitConnectionBGPManualAdvertiseCIDRs(gwName, connName string, cidrs []string) error
+	EditTransitConnectionBGPManualAdvertiseCIDRsContext(ctx context.Context, gwName, connName string, cidrs []string) error
+	ChangeBgpHoldTime(gwName string, holdTime int) error
+	EnableSummarizeCidrToTgw(gwName string) error
+	DisableSummarizeCidrToTgw(gwName string) error
+	EnableMultitierTransit(gwName string) error
+	DisableMultitierTransit(gwName string) error
+	EditTransitConnectionRemoteSubnetContext(ctx context.Context, vpcId, connName, remoteSubnet string) error
+	GetBgpLanIPListContext(ctx context.Context, transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error)
+	EnableS2CRxBalancing(gwName string) error
+	DisableS2CRxBalancing(gwName string) error
+	EnableTransitPreserveAsPath(transitGateway *TransitVpc) error
+	DisableTransitPreserveAsPath(transitGateway *TransitVpc) error
+
+	CreateVGWConnContext(ctx context.Context, vgwConn *VGWConn) error
+	DeleteVGWConnContext(ctx context.Context, vgwConn *VGWConn) error
+	GetVGWConnDetailContext(ctx context.Context, vgwConn *VGWConn) (*VGWConn, error)
+	EditVgwConnectionASPathPrependContext(ctx context.Context, vgwConn *VGWConn, prependASPath []string) error
+}
+
+// SpokeClient manages spoke gateways and their attachments.
+type SpokeClient interface {
+	EditSpokeExternalDeviceConnASPathPrepend(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error
+	EditSpokeExternalDeviceConnASPathPrependContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn, prependASPath []string) error
+
+	AddSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+	GetSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+	UpdateSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+	DeleteSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+	GetSubnetsForInspectionContext(ctx context.Context, gatewayName string) ([]string, error)
+
+	CreateSpokeHaGwContext(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error)
+
+	CreateSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error
+	GetSpokeTransitAttachmentContext(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error)
+	DeleteSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitAttachment) error
+	DeleteSpokeTransitAttachmentContext(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error
+	GetEdgeSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error)
+
+	LaunchSpokeVpcContext(ctx context.Context, spoke *SpokeVpc) error
+	EnableAutoAdvertiseS2CCidrs(gateway *Gateway) error
+	DisableAutoAdvertiseS2CCidrs(gateway *Gateway) error
+	GetSpokeGatewayAdvancedConfig(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error)
+	GetSpokeGatewayAdvancedConfigContext(ctx context.Context, spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error)
+	EnableSpokeConnectionLearnedCIDRApprovalContext(ctx context.Context, gwName, connName string) error
+	DisableSpokeConnectionLearnedCIDRApprovalContext(ctx context.Context, gwName, connName string) error
+	UpdateSpokeConnectionPendingApprovedCidrsContext(ctx context.Context, gwName, connName string, approvedCidrs []string) error
+	EditSpokeConnectionBGPManualAdvertiseCIDRsContext(ctx context.Context, gwName, connName string, cidrs []string) error
+	SetBgpEcmpSpoke(spokeGateway *SpokeVpc, enabled bool) error
+	EnableActiveStandbySpoke(spokeGateway *SpokeVpc) error
+	DisableActiveStandbySpoke(spokeGateway *SpokeVpc) error
+	SetPrependASPathSpoke(spokeGateway *SpokeVpc, prependASPath []string) error
+	SetBgpPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime int) error
+	SetBgpBfdPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime int) error
+	SetSpokeBgpManualAdvertisedNetworks(spokeGateway *SpokeVpc) error
+	EnableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error
+	DisableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error
+	UpdateSpokePendingApprovedCidrs(gateway *SpokeVpc) error
+	SetLocalASNumberSpoke(spokeGateway *SpokeVpc, localASNumber string) error
+	EnableActiveStandbyPreemptiveSpoke(spokeGateway *SpokeVpc) error
+	EnableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error
+	DisableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error
+	EnableSpokePreserveAsPath(spokeGateway *SpokeVpc) error
+	DisableSpokePreserveAsPath(spokeGateway *SpokeVpc) error
+	EnableGlobalVpc(gateway *Gateway) error
+	DisableGlobalVpc(gateway *Gateway) error
+}
+
+// EdgeClient manages Edge gateways, CloudN devices and Edge traffic policies.
+type EdgeClient interface {
+	GetDeviceContext(ctx context.Context, d *Device) (*Device, error)
+	GetDeviceInterfacesContext(ctx context.Context, deviceName string) (*[]DeviceWanInterface, error)
+	ConfigureDeviceInterfacesContext(ctx context.Context, config *DeviceInterfaceConfig) error
+
+	CreateDeviceAwsTgwAttachmentContext(ctx context.Context, attachment *DeviceAwsTgwAttachment) error
+	GetDeviceAwsTgwAttachmentContext(ctx context.Context, tgwAttachment *DeviceAwsTgwAttachment) (*DeviceAwsTgwAttachment, error)
+
+	CreateDeviceTagContext(ctx context.Context, deviceTag *DeviceTag) error
+	GetDeviceTagContext(ctx context.Context, brt *DeviceTag) (*DeviceTag, error)
+	UpdateDeviceTagConfigContext(ctx context.Context, brt *DeviceTag) error
+	AttachDeviceTagContext(ctx context.Context, brt *DeviceTag) error
+	CommitDeviceTagContext(ctx context.Context, brt *DeviceTag) error
+	DeleteDeviceTagContext(ctx context.Context, brt *DeviceTag) error
+
+	CreateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error
+	GetEdgeCSP(ctx context.Context, gwName string) (*EdgeCSPResp, error)
+	DeleteEdgeCSP(ctx context.Context, accountName, name string) error
+	UpdateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error
+
+	CreateEdgeCSPHa(ctx context.Context, edgeCSPHa *EdgeCSPHa) (string, error)
+	GetEdgeCSPHa(ctx context.Context, gwName string) (*EdgeCSPHaResp, error)
+	UpdateEdgeCSPHa(ctx context.Context, edgeCSP *EdgeCSP) error
+
+	CreateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix) error
+	GetEdgeEquinix(ctx context.Context, gwName string) (*EdgeEquinixResp, error)
+	DeleteEdgeEquinix(ctx context.Context, accountName, name string) error
+	UpdateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix) error
+
+	CreateEdgeEquinixHa(ctx context.Context, edgeEquinixHa *EdgeEquinixHa) (string, error)
+	GetEdgeEquinixHa(ctx context.Context, gwName string) (*EdgeEquinixHaResp, error)
+	UpdateEdgeEquinixHa(ctx context.Context, edgeEquinix *EdgeEquinix) error
+
+	CreateEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error)
+	CreateEdgeExternalDeviceConnContext(ctx context.Context, edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error)
+	DeleteEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) error
+	DeleteEdgeExternalDeviceConnContext(ctx context.Context, edgeExternalDeviceConn *EdgeExternalDeviceConn) error
+
+	GetEdgeGatewayWanIp(ctx context.Context, gwName, wanInterfaceName string) (string, error)
+
+	CreateEdgeMegaport(ctx context.Context, edgeMegaport *EdgeMegaport) error
+	GetEdgeMegaport(ctx context.Context, gwName string) (*EdgeMegaportResp, error)
+	DeleteEdgeMegaport(ctx context.Context, accountName, name string) error
+	UpdateEdgeMegaport(ctx context.Context, edgeMegaport *EdgeMegaport) error
+
+	CreateEdgeMegaportHa(ctx context.Context, edgeMegaportHa *EdgeMegaportHa) (string, error)
+	GetEdgeMegaportHa(ctx context.Context, gwName string) (*EdgeMegaportHaResp, error)
+	UpdateEdgeMegaportHa(ctx context.Context, edgeMegaport *EdgeMegaport) error
+
+	CreateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error
+	GetEdgeNEO(ctx context.Context, gwName string) (*EdgeNEOResp, error)
+	DeleteEdgeNEO(ctx context.Context, accountName, name string) error
+	UpdateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error
+
+	CreateEdgeNEOHa(ctx context.Context, edgeNEOHa *EdgeNEOHa) (string, error)
+	GetEdgeNEOHa(ctx context.Context, gwName string) (*EdgeNEOHaResp, error)
+	UpdateEdgeNEOHa(ctx context.Context, edgeNEO *EdgeNEO) error
+
+	OnboardEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+	GetEdgeNEODevice(ctx context.Context, accountName, deviceName string) (*EdgeNEODeviceResp, error)
+	UpdateEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+	DeleteEdgeNEODevice(ctx context.Context, accountName, serialNumber string) error
+	DownloadEdgeNEOConfigFile(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+
+	CreateEdgeProxyProfile(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfile) (*EdgePlatformProxyProfileResp, error)
+	UpdateEdgeProxyProfile(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfileUpdate) error
+	GetEdgePlatformProxyProfile(ctx context.Context, accountName, profileName string) (*EdgePlatformProxyProfileResp, error)
+	DeleteEdgePlatformProxyProfile(ctx context.Context, accountName, profileName string) error
+
+	CreateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) error
+	GetEdgeSpoke(ctx context.Context, gwName string) (*EdgeSpokeResp, error)
+	UpdateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) error
+	DeleteEdgeSpoke(ctx context.Context, name string) error
+	EnableEdgeSpokeTransitiveRouting(ctx context.Context, name string) error
+	DisableEdgeSpokeTransitiveRouting(ctx context.Context, name string) error
+	UpdateEdgeSpokeGeoCoordinate(ctx context.Context, edgeSpoke *EdgeSpoke) error
+
+	CreateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanagedHa *EdgeVmSelfmanagedHa) (string, error)
+	GetEdgeVmSelfmanagedHa(ctx context.Context, gwName string) (*EdgeVmSelfmanagedHaResp, error)
+	UpdateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanaged *EdgeSpoke) error
+
+	CreateLinkHierarchy(ctx context.Context, linkHierarchy map[string]interface{}) (string, error)
+	GetLinkHierarchy(ctx context.Context, uuid string) (*LinkHierarchy, error)
+	UpdateLinkHierarchy(ctx context.Context, linkHierarchy map[string]interface{}, uuid string) error
+	DeleteLinkHierarchy(ctx context.Context, uuid string) error
+
+	CreateQosClass(ctx context.Context, qosClass *QosClass) (string, error)
+	GetQosClass(ctx context.Context, uuid string) (*QosClass, error)
+	UpdateQosClass(ctx context.Context, qosClass *QosClass, uuid string) error
+	DeleteQosClass(ctx context.Context, uuid string) error
+
+	UpdateQosPolicyList(ctx context.Context, qosPolicyList *QosPolicyList) error
+	GetQosPolicyList(ctx context.Context) (*[]QosPolicyList, error)
+	DeleteQosPolicyList(ctx context.Context) error
+
+	CreateSLAClass(ctx context.Context, slaClass *SLAClass) (string, error)
+	GetSLAClass(ctx context.Context, uuid string) (*SLAClass, error)
+	UpdateSLAClass(ctx context.Context, slaClass *SLAClass, uuid string) error
+	DeleteSLAClass(ctx context.Context, uuid string) error
+
+	CreateTrafficClassifier(ctx context.Context, policyList *PolicyList) error
+	GetTrafficClassifier(ctx context.Context) (*[]PolicyList, error)
+	DeleteTrafficClassifier(ctx context.Context) error
+}
+
+// DCFClient manages distributed firewalling, smart groups and web groups.
+type DCFClient interface {
+	GetDCFAttachmentPoint(ctx context.Context, name string) (*AttachmentPointResp, error)
+
+	CreateDCFPolicyBlock(ctx context.Context, policyBlock *DCFPolicyBlock) (string, error)
+	GetDCFPolicyBlock(ctx context.Context, uuid string) (*DCFPolicyBlock, error)
+	UpdateDCFPolicyBlock(ctx context.Context, policyBlock *DCFPolicyBlock) error
+	DeleteDCFPolicyBlock(ctx context.Context, uuid string) error
+
+	CreateDCFPolicyList(ctx context.Context, policyList *DCFPolicyList) (string, error)
+	GetDCFPolicyList(ctx context.Context, uuid string) (*DCFPolicyList, error)
+	UpdateDCFPolicyList(ctx context.Context, policyList *DCFPolicyList) error
+	DeleteDCFPolicyList(ctx context.Context, uuid string) error
+
+	EnableDistributedFirewalling(ctx context.Context) error
+	DisableDistributedFirewalling(ctx context.Context) error
+	GetDistributedFirewallingStatus(ctx context.Context) (*DistributedFirewallingConfig, error)
+
+	UpdateDistributedFirewallingDefaultActionRule(ctx context.Context, request *DistributedFirewallingDefaultActionRule) error
+	GetDistributedFirewallingDefaultActionRule(ctx context.Context) (*DistributedFirewallingDefaultActionRule, error)
+
+	GetDistributedFirewallingDeploymentPolicy(ctx context.Context) (*DistributedFirewallingDeploymentPolicy, error)
+	CreateDistributedFirewallingDeploymentPolicy(ctx context.Context, deploymentPolicy *DistributedFirewallingDeploymentPolicy) error
+
+	CreateDistributedFirewallingIntraVpc(ctx context.Context, vpcList *DistributedFirewallingIntraVpcList) error
+	GetDistributedFirewallingIntraVpc(ctx context.Context) (*DistributedFirewallingIntraVpcList, error)
+	DeleteDistributedFirewallingIntraVpc(ctx context.Context) error
+
+	SetEnforcementLevel(ctx context.Context, enforcementLevel *EnforcementLevel) error
+	GetEnforcementLevel(ctx context.Context) (*EnforcementLevel, error)
+	UpdateEnforcementLevel(ctx context.Context, enforcementLevel *EnforcementLevel) error
+	DeleteEnforcementLevel(ctx context.Context) error
+
+	CreateDistributedFirewallingPolicyList(ctx context.Context, policyList *DistributedFirewallingPolicyList) error
+	GetDistributedFirewallingPolicyList(ctx context.Context) (*DistributedFirewallingPolicyList, error)
+	UpdateDistributedFirewallingPolicyList(ctx context.Context, policyList *DistributedFirewallingPolicyList) error
+	DeleteDistributedFirewallingPolicyList(ctx context.Context) error
+
+	SetNewCertificate(ctx context.Context, proxyCaConfig *ProxyCaConfig) error
+	GetCaCertificate(ctx context.Context) (*ProxyCaConfig, error)
+	GetMetaCaCertificate(ctx context.Context) (*ProxyCaCertInstance, error)
+	DeleteCaCertificate(ctx context.Context) error
+
+	CreateKubernetesCluster(ctx context.Context, kubernetesCluster *KubernetesCluster) error
+	UpdateKubernetesCluster(ctx context.Context, id string, kubernetesCluster *KubernetesCluster) error
+	GetKubernetesCluster(ctx context.Context, id string) (*KubernetesCluster, error)
+	DeleteKubernetesCluster(ctx context.Context, id string) error
+
+	CreateSmartGroup(ctx context.Context, smartGroup *SmartGroup) (string, error)
+	GetSmartGroup(ctx context.Context, uuid string) (*SmartGroup, error)
+	UpdateSmartGroup(ctx context.Context, smartGroup *SmartGroup, uuid string) error
+	DeleteSmartGroup(ctx context.Context, uuid string) error
+	GetSmartGroups(ctx context.Context) ([]*SmartGroup, error)
+
+	CreateWebGroup(ctx context.Context, webGroup *WebGroup) (string, error)
+	GetWebGroupByName(ctx context.Context, name string) (*WebGroup, error)
+	GetWebGroup(ctx context.Context, uuid string) (*WebGroup, error)
+	UpdateWebGroup(ctx context.Context, webGroup *WebGroup, uuid string) error
+	DeleteWebGroup(ctx context.Context, uuid string) error
+}
+
+// FQDNClient manages FQDN egress filtering.
+type FQDNClient interface {
+	CreateFQDNContext(ctx context.Context, fqdn *FQDN) error
+	DeleteFQDNContext(ctx context.Context, fqdn *FQDN) error
+	UpdateFQDNStatusContext(ctx context.Context, fqdn *FQDN) error
+	UpdateFQDNModeContext(ctx context.Context, fqdn *FQDN) error
+	UpdateDomainsContext(ctx context.Context, fqdn *FQDN) error
+	DetachGwsContext(ctx context.Context, fqdn *FQDN, gwList []string) error
+	ListFQDNTagsContext(ctx context.Context) ([]*FQDN, error)
+	GetFQDNTagContext(ctx context.Context, fqdn *FQDN) (*FQDN, error)
+	ListDomainsContext(ctx context.Context, fqdn *FQDN) (*FQDN, error)
+	ListGwsContext(ctx context.Context, fqdn *FQDN) ([]string, error)
+	AttachTagToGwContext(ctx context.Context, fqdn *FQDN, gateway *Gateway) error
+	UpdateSourceIPFiltersContext(ctx context.Context, fqdn *FQDN, gateway *Gateway, sourceIPs []string) error
+	GetGwFilterTagListContext(ctx context.Context, fqdn *FQDN) (*FQDN, error)
+	GetFQDNPassThroughCIDRsContext(ctx context.Context, gw *Gateway) ([]string, error)
+	ConfigureFQDNPassThroughCIDRsContext(ctx context.Context, gw *Gateway, IPs []string) error
+	DisableFQDNPassThroughContext(ctx context.Context, gw *Gateway) error
+	AddFQDNTagRuleContext(ctx context.Context, fqdn *FQDN) error
+	GetFQDNTagRuleContext(ctx context.Context, fqdn *FQDN) (*FQDN, error)
+	DeleteFQDNTagRuleContext(ctx context.Context, fqdn *FQDN) error
+	EnableFQDNExceptionRule(ctx context.Context) error
+	DisableFQDNExceptionRule(ctx context.Context) error
+	EnableFQDNPrivateNetworks(ctx context.Context) error
+	DisableFQDNPrivateNetwork(ctx context.Context) error
+	SetFQDNCustomNetwork(ctx context.Context, configIPs []string) error
+	EnableFQDNCache(ctx context.Context) error
+	DisableFQDNCache(ctx context.Context) error
+	EnableFQDNExactMatch(ctx context.Context) error
+	DisableFQDNExactMatch(ctx context.Context) error
+	GetFQDNCacheGlobalStatus(ctx context.Context) (*string, error)
+	GetFQDNExactMatchStatus(ctx context.Context) (*string, error)
+	GetFQDNExceptionRuleStatus(ctx context.Context) (*string, error)
+	GetFQDNPrivateNetworkFilteringStatus(ctx context.Context) (*FQDNPrivateNetworkingFilteringStatus, error)
+}
+
+// FireNetClient manages FireNet, firewall instances and firewall vendor integration.
+type FireNetClient interface {
+	GetPrimaryFireNet(ctx context.Context) ([]string, error)
+	GetSecondaryFireNet(ctx context.Context) ([]string, error)
+	CreateCentralizedTransitFireNet(ctx context.Context, firenetAttachment *CentralizedTransitFirenet) error
+	ListCentralizedTransitFireNets(ctx context.Context) (map[string][]string, error)
+	GetCentralizedTransitFireNet(ctx context.Context, centralizedTransitFirenet *CentralizedTransitFirenet) error
+	DeleteCentralizedTransitFireNet(ctx context.Context, firenetAttachment *CentralizedTransitFirenet) error
+
+	GetFireNetContext(ctx context.Context, fireNet *FireNet) (*FireNetDetail, error)
+	AssociateFirewallWithFireNetContext(ctx context.Context, firewallInstance *FirewallInstance) error
+	DisassociateFirewallFromFireNetContext(ctx context.Context, firewallInstance *FirewallInstance) error
+	AttachFirewallToFireNetContext(ctx context.Context, firewallInstance *FirewallInstance) error
+	ConnectFireNetWithTgwContext(ctx context.Context, awsTgw *AWSTgw, vpcSolo VPCSolo, SecurityDomainName string) error
+	DisconnectFireNetFromTgwContext(ctx context.Context, awsTgw *AWSTgw, vpcID string) error
+	EditFireNetInspectionContext(ctx context.Context, fireNet *FireNet) error
+	EditFireNetEgressContext(ctx context.Context, fireNet *FireNet) error
+	EditFireNetHashingAlgorithmContext(ctx context.Context, fireNet *FireNet) error
+	EnableTgwSegmentationForEgressContext(ctx context.Context, net *FireNet) error
+	DisableTgwSegmentationForEgressContext(ctx context.Context, net *FireNet) error
+	EditFirenetEgressStaticCidrContext(ctx context.Context, net *FireNet) error
+	EditFirenetExcludedCidrContext(ctx context.Context, net *FireNet) error
+
+	SetBasePolicyContext(ctx context.Context, firewall *Firewall) error
+	UpdatePolicyContext(ctx context.Context, firewall *Firewall) error
+	GetPolicyContext(ctx context.Context, firewall *Firewall) (*Firewall, error)
+	AddFirewallPolicyContext(ctx context.Context, fw *Firewall) error
+	DeleteFirewallPolicyContext(ctx context.Context, fw *Firewall) error
+	GetFirewallPolicyContext(ctx context.Context, fw *Firewall) (*Firewall, error)
+	InsertFirewallPolicyContext(ctx context.Context, fw *Firewall) error
+
+	CreateFirewallInstanceContext(ctx context.Context, firewallInstance *FirewallInstance) (string, error)
+	GetFirewallInstanceContext(ctx context.Context, firewallInstance *FirewallInstance) (*FirewallInstance, error)
+	DeleteFirewallInstanceContext(ctx context.Context, firewallInstance *FirewallInstance) error
+	GetFirewallInstanceImagesContext(ctx context.Context, vpcId string) (*[]FirewallInstanceImage, error)
+	UpdateFirewallInstanceTags(firewallInstance *FirewallInstance) error
+
+	CreateFirewallManagementAccessContext(ctx context.Context, firewallManagementAccess *FirewallManagementAccess) error
+	GetFirewallManagementAccessContext(ctx context.Context, firewallManagementAccess *FirewallManagementAccess) (*FirewallManagementAccess, error)
+	DestroyFirewallManagementAccessContext(ctx context.Context, firewallManagementAccess *FirewallManagementAccess) error
+
+	CreateFirewallTagContext(ctx context.Context, firewall_tag *FirewallTag) error
+	UpdateFirewallTagContext(ctx context.Context, firewall_tag *FirewallTag) error
+	GetFirewallTagContext(ctx context.Context, firewall_tag *FirewallTag) (*FirewallTag, error)
+	DeleteFirewallTagContext(ctx context.Context, firewall_tag *FirewallTag) error
+
+	CreateTransitFireNetPolicyContext(ctx context.Context, transitFireNetPolicy *TransitFireNetPolicy) error
+	GetTransitFireNetPolicyContext(ctx context.Context, transitFireNetPolicy *TransitFireNetPolicy) error
+	DeleteTransitFireNetPolicyContext(ctx context.Context, transitFireNetPolicy *TransitFireNetPolicy) error
+
+	EditFireNetFirewallVendorInfoContext(ctx context.Context, vendorInfo *VendorInfo) error
+	ShowFireNetFirewallVendorConfigContext(ctx context.Context, vendorInfo *VendorInfo) error
+	EditFireNetFirewallManagerVendorInfo(ctx context.Context, firewallManager *FirewallManager) error
+	SyncFireNetFirewallManagerVendorConfig(ctx context.Context, firewallManager *FirewallManager) error
+	EditFireNetFirewallVendorInfoWithPrivateKeyContext(ctx context.Context, vendorInfo *VendorInfo) error
+}
+
+// Site2CloudClient manages site2cloud connections.
+type Site2CloudClient interface {
+	CreateSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) error
+	GetSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) (*Site2Cloud, error)
+	ListSite2CloudContext(ctx context.Context) ([]Site2Cloud, error)
+	GetSite2CloudConnDetailContext(ctx context.Context, site2cloud *Site2Cloud) (*Site2Cloud, error)
+	UpdateSite2CloudContext(ctx context.Context, site2cloud *EditSite2Cloud) error
+	DeleteSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) error
+	EnableDeadPeerDetection(site2cloud *Site2Cloud) error
+	EnableDeadPeerDetectionContext(ctx context.Context, site2cloud *Site2Cloud) error
+	DisableDeadPeerDetectionContext(ctx context.Context, site2cloud *Site2Cloud) error
+	EnableSite2cloudActiveActiveContext(ctx context.Context, site2cloud *Site2Cloud) error
+	DisableSite2cloudActiveActiveContext(ctx context.Context, site2cloud *Site2Cloud) error
+	EnableSpokeMappedSite2CloudForwardingContext(ctx context.Context, site2cloud *Site2Cloud) error
+	DisableSpokeMappedSite2CloudForwardingContext(ctx context.Context, site2cloud *Site2Cloud) error
+	EnableSite2CloudEventTriggeredHAContext(ctx context.Context, vpcID, connectionName string) error
+	DisableSite2CloudEventTriggeredHAContext(ctx context.Context, vpcID, connectionName string) error
+	EditSite2CloudPhase1LocalIdentifierContext(ctx context.Context, s2c *EditSite2Cloud) error
+
+	CreateS2CCaCert(ctx context.Context, s2cCaCert *S2CCaCert) error
+	GetS2CCaCertTag(ctx context.Context, s2cCaCertTag *S2CCaCertTag) (*S2CCaCertTag, error)
+	DeleteCertInstance(ctx context.Context, caCertInstance *CaCertInstance) error
+}
+
+// VPNClient manages VPN users, VPN profiles and Geo VPN.
+type VPNClient interface {
+	EnableGeoVPN(ctx context.Context, geoVPN *GeoVPN) error
+	GetGeoVPNInfoContext(ctx context.Context, geoVPN *GeoVPN) (*GeoVPN, error)
+	AddElbToGeoVPNContext(ctx context.Context, geoVPN *GeoVPN) error
+	DeleteElbFromGeoVPNContext(ctx context.Context, geoVPN *GeoVPN) error
+	DisableGeoVPNContext(ctx context.Context, geoVPN *GeoVPN) error
+	GetGeoVPNNameContext(ctx context.Context, gateway *Gateway) (*GeoVPN, error)
+
+	CreateProfileContext(ctx context.Context, profile *Profile) error
+	GetProfileContext(ctx context.Context, profile *Profile) (*Profile, error)
+	UpdateProfilePolicyContext(ctx context.Context, profile *Profile) error
+	AttachUsersContext(ctx context.Context, profile *Profile) error
+	DetachUsersContext(ctx context.Context, profile *Profile) error
+	DeleteProfileContext(ctx context.Context, profile *Profile) error
+	GetProfileBasePolicyContext(ctx context.Context, profile *Profile) (*Profile, error)
+	ValidateProfileRule(profileRule *ProfileRule) error
+
+	GetSplitTunnelContext(ctx context.Context, splitTunnel *SplitTunnel) (*SplitTunnelUnit, error)
+	ModifySplitTunnel(splitTunnel *SplitTunnel) error
+
+	EnableVPNCertDownloadContext(ctx context.Context, vpnCertDownload *VPNCertDownload) error
+	DisableVPNCertDownloadContext(ctx context.Context) error
+	GetVPNCertDownloadStatusContext(ctx context.Context) (*GetVPNCertDownloadStatusResp, error)
+
+	CreateVPNUserContext(ctx context.Context, vpnUser *VPNUser) error
+	GetVPNUserContext(ctx context.Context, vpnUser *VPNUser) (*VPNUser, error)
+	DeleteVPNUserContext(ctx context.Context, vpnUser *VPNUser) error
+	UpdateVPNUserContext(ctx context.Context, vpnUser *VPNUser) error
+	ListVPNUsersContext(ctx context.Context) ([]VPNUser, error)
+
+	GetVpnUserAcceleratorContext(ctx context.Context) ([]string, error)
+	UpdateVpnUserAcceleratorContext(ctx context.Context, xlr *VpnUserXlr) error
+}
+
+// AWSTgwClient manages AWS TGWs, their attachments and connections.
+type AWSTgwClient interface {
+	CreateAWSTgwContext(ctx context.Context, awsTgw *AWSTgw) error
+	ListAwsTgwRouteDomainDetailsContext(ctx context.Context, tgwName string) ([]RouteDomainDetail, error)
+	IsFirewallSecurityDomainContext(ctx context.Context, tgwName, domainName string) (bool, error)
+	DeleteAWSTgwContext(ctx context.Context, awsTgw *AWSTgw) error
+	ListTgwDetailsContext(ctx context.Context, awsTgw *AWSTgw) (*AWSTgw, error)
+	GetAttachmentRouteTableDetailsContext(ctx context.Context, tgwName, attachmentName string) (*AttachmentRouteTableDetails, error)
+	UpdateTGWCidrsContext(ctx context.Context, tgwName string, cidrs []string) error
+	UpdateTGWInspectionModeContext(ctx context.Context, tgwName, inspectionMode string) error
+
+	AttachTGWConnectToTGW(ctx context.Context, connect *AwsTgwConnect) error
+	DetachTGWConnectFromTGW(ctx context.Context, connect *AwsTgwConnect) error
+	GetTGWConnect(ctx context.Context, connect *AwsTgwConnect) (*AwsTgwConnect, error)
+	CreateTGWConnectPeer(ctx context.Context, peer *AwsTgwConnectPeer) error
+	DeleteTGWConnectPeer(ctx context.Context, peer *AwsTgwConnectPeer) error
+	GetTGWConnectPeer(ctx context.Context, peer *AwsTgwConnectPeer) (*AwsTgwConnectPeer, error)
+
+	CreateAwsTgwDirectConnectContext(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) error
+	GetAwsTgwDirectConnectContext(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) (*AwsTgwDirectConnect, error)
+	UpdateDirectConnAllowedPrefixContext(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) error
+	DeleteAwsTgwDirectConnectContext(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) error
+	EnableDirectConnectLearnedCidrsApprovalContext(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) error
+	DisableDirectConnectLearnedCidrsApprovalContext(ctx context.Context, awsTgwDirectConnect *AwsTgwDirectConnect) error
+
+	CreateAwsTgwPeeringContext(ctx context.Context, awsTgwPeering *AwsTgwPeering) error
+	GetAwsTgwPeeringContext(ctx context.Context, awsTgwPeering *AwsTgwPeering) error
+	DeleteAwsTgwPeeringContext(ctx context.Context, awsTgwPeering *AwsTgwPeering) error
+
+	CreateDomainConnContext(ctx context.Context, domainConn *DomainConn) error
+	GetDomainConnContext(ctx context.Context, domainConn *DomainConn) error
+	DeleteDomainConnContext(ctx context.Context, domainConn *DomainConn) error
+
+	CreateAwsTgwTransitGwAttachmentContext(ctx context.Context, awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) error
+	GetAwsTgwTransitGwAttachmentContext(ctx context.Context, awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) (*AwsTgwTransitGwAttachment, error)
+	DeleteAwsTgwTransitGwAttachmentContext(ctx context.Context, awsTgwTransitGwAttachment *AwsTgwTransitGwAttachment) error
+
+	CreateAwsTgwVpcAttachmentContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+	GetAwsTgwAttachmentInfoContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) (*AttachmentInfo, error)
+	GetAwsTgwVpcAttachmentContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) (*AwsTgwVpcAttachment, error)
+	DeleteAwsTgwVpcAttachmentContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+	DeleteAwsTgwVpcAttachmentForFireNetContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+	GetAwsTgwDetailContext(ctx context.Context, awsTgw *AWSTgw) (*AWSTgw, error)
+	EditTgwSpokeVpcCustomizedRoutesContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+	EditTgwSpokeVpcCustomizedRouteAdvertisementContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+	UpdateFirewallAttachmentAccessFromOnpremContext(ctx context.Context, awsTgwVpcAttachment *AwsTgwVpcAttachment) error
+
+	CreateAwsTgwVpnConnContext(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) (string, error)
+	GetAwsTgwVpnConnContext(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConn, error)
+	DeleteAwsTgwVpnConnContext(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) error
+	EnableVpnConnectionLearnedCidrsApprovalContext(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) error
+	DisableVpnConnectionLearnedCidrsApprovalContext(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) error
+	GetAwsTgwVpnTunnelDataContext(ctx context.Context, awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConnEdit, error)
+}
+
+// PeeringClient manages native cloud peerings and encrypted peering tunnels.
+type PeeringClient interface {
+	CreateAWSPeerContext(ctx context.Context, awsPeer *AWSPeer) (string, error)
+	GetAWSPeerContext(ctx context.Context, awsPeer *AWSPeer) (*AWSPeer, error)
+	DeleteAWSPeerContext(ctx context.Context, awsPeer *AWSPeer) error
+
+	CreateAzurePeerContext(ctx context.Context, azurePeer *AzurePeer) error
+	GetAzurePeerContext(ctx context.Context, azurePeer *AzurePeer) (*AzurePeer, error)
+	DeleteAzurePeerContext(ctx context.Context, azurePeer *AzurePeer) error
+
+	CreateAzureSpokeNativePeeringContext(ctx context.Context, azureSpokeNativePeering *AzureSpokeNativePeering) error
+	GetAzureSpokeNativePeeringContext(ctx context.Context, azureSpokeNativePeering *AzureSpokeNativePeering) (*AzureSpokeNativePeering, error)
+	DeleteAzureSpokeNativePeeringContext(ctx context.Context, azureSpokeNativePeering *AzureSpokeNativePeering) error
+
+	CreateTransPeerContext(ctx context.Context, transPeer *TransPeer) error
+	GetTransPeerContext(ctx context.Context, transPeer *TransPeer) (*TransPeer, error)
+	DeleteTransPeerContext(ctx context.Context, transPeer *TransPeer) error
+
+	CreateTunnelContext(ctx context.Context, tunnel *Tunnel) error
+	GetTunnelContext(ctx context.Context, tunnel *Tunnel) (*Tunnel, error)
+	UpdateTunnel(tunnel *Tunnel) error
+	DeleteTunnelContext(ctx context.Context, tunnel *Tunnel) error
+}
+
+// SegmentationClient manages network segmentation and network domains.
+type SegmentationClient interface {
+	CreateSecurityDomain(securityDomain *SecurityDomain) error
+	DeleteSecurityDomain(securityDomain *SecurityDomain) error
+	GetSecurityDomainDetails(ctx context.Context, domain *SecurityDomain) (*SecurityDomainDetails, error)
+	EnableIntraDomainInspection(ctx context.Context, intraDomainInspection *IntraDomainInspection) error
+	DisableIntraDomainInspection(ctx context.Context, intraDomainInspection *IntraDomainInspection) error
+	GetAllNetworkDomains(ctx context.Context) ([]NetworkDomainDetails, error)
+	GetIntraDomainInspectionStatus(ctx context.Context, intraDomainInspection *IntraDomainInspection) error
+
+	CreateSegmentationSecurityDomainContext(ctx context.Context, domain *SegmentationSecurityDomain) error
+	DeleteSegmentationSecurityDomainContext(ctx context.Context, domain *SegmentationSecurityDomain) error
+	ListSegmentationSecurityDomainsContext(ctx context.Context) ([]string, error)
+	GetSegmentationSecurityDomainContext(ctx context.Context, domain *SegmentationSecurityDomain) (*SegmentationSecurityDomain, error)
+	CreateSegmentationSecurityDomainConnectionPolicyContext(ctx context.Context, policy *SegmentationSecurityDomainConnectionPolicy) error
+	DeleteSegmentationSecurityDomainConnectionPolicyContext(ctx context.Context, policy *SegmentationSecurityDomainConnectionPolicy) error
+	GetSegmentationSecurityDomainConnectionPolicyContext(ctx context.Context, policy *SegmentationSecurityDomainConnectionPolicy) (*SegmentationSecurityDomainConnectionPolicy, error)
+	CreateSegmentationSecurityDomainAssociationContext(ctx context.Context, association *SegmentationSecurityDomainAssociation) error
+	DeleteSegmentationSecurityDomainAssociationContext(ctx context.Context, association *SegmentationSecurityDomainAssociation) error
+	GetSegmentationSecurityDomainAssociationContext(ctx context.Context, association *SegmentationSecurityDomainAssociation) (*SegmentationSecurityDomainAssociation, error)
+}
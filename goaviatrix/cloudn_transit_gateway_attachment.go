@@ -181,6 +181,10 @@ func (c *Client) EditCloudnTransitGatewayAttachmentASPathPrepend(ctx context.Con
 }
 
 func (c *Client) GetDeviceAttachmentVpcID(connectionName string) (string, error) {
+	return c.GetDeviceAttachmentVpcIDContext(context.Background(), connectionName)
+}
+
+func (c *Client) GetDeviceAttachmentVpcIDContext(ctx context.Context, connectionName string) (string, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_cloudwan_attachments",
@@ -199,7 +203,7 @@ func (c *Client) GetDeviceAttachmentVpcID(connectionName string) (string, error)
 
 	var data Resp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return "", err
 	}
@@ -214,7 +218,11 @@ func (c *Client) GetDeviceAttachmentVpcID(connectionName string) (string, error)
 }
 
 func (c *Client) DeleteDeviceAttachment(connectionName string) error {
-	vpcID, err := c.GetDeviceAttachmentVpcID(connectionName)
+	return c.DeleteDeviceAttachmentContext(context.Background(), connectionName)
+}
+
+func (c *Client) DeleteDeviceAttachmentContext(ctx context.Context, connectionName string) error {
+	vpcID, err := c.GetDeviceAttachmentVpcIDContext(ctx, connectionName)
 	if err != nil {
 		return fmt.Errorf("could not get device attachment VPC id: %v", err)
 	}
@@ -227,7 +235,7 @@ func (c *Client) DeleteDeviceAttachment(connectionName string) error {
 		"async":           "true",
 	}
 
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetAPIContextCloudnTransitGatewayAttachment(ctx context.Context, v interface{}, action string, d map[string]string, checkFunc CheckAPIResponseFunc) error {
@@ -1,5 +1,7 @@
 package goaviatrix
 
+import "context"
+
 type CloudwatchAgent struct {
 	CID                   string
 	RoleArn               string
@@ -17,6 +19,10 @@ type CloudwatchAgentResp struct {
 }
 
 func (c *Client) EnableCloudwatchAgent(r *CloudwatchAgent) error {
+	return c.EnableCloudwatchAgentContext(context.Background(), r)
+}
+
+func (c *Client) EnableCloudwatchAgentContext(ctx context.Context, r *CloudwatchAgent) error {
 	params := map[string]string{
 		"action":               "enable_cloudwatch_agent",
 		"CID":                  c.CID,
@@ -26,10 +32,14 @@ func (c *Client) EnableCloudwatchAgent(r *CloudwatchAgent) error {
 		"exclude_gateway_list": r.ExcludedGatewaysInput,
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
 
 func (c *Client) GetCloudwatchAgentStatus() (*CloudwatchAgentResp, error) {
+	return c.GetCloudwatchAgentStatusContext(context.Background())
+}
+
+func (c *Client) GetCloudwatchAgentStatusContext(ctx context.Context) (*CloudwatchAgentResp, error) {
 	params := map[string]string{
 		"action": "get_cloudwatch_agent_status",
 		"CID":    c.CID,
@@ -43,7 +53,7 @@ func (c *Client) GetCloudwatchAgentStatus() (*CloudwatchAgentResp, error) {
 
 	var data Resp
 
-	err := c.GetAPI(&data, params["action"], params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -56,10 +66,14 @@ func (c *Client) GetCloudwatchAgentStatus() (*CloudwatchAgentResp, error) {
 }
 
 func (c *Client) DisableCloudwatchAgent() error {
+	return c.DisableCloudwatchAgentContext(context.Background())
+}
+
+func (c *Client) DisableCloudwatchAgentContext(ctx context.Context) error {
 	params := map[string]string{
 		"action": "disable_cloudwatch_agent",
 		"CID":    c.CID,
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
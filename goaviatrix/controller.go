@@ -60,28 +60,40 @@ type ResourceCounts struct {
 }
 
 func (c *Client) EnableExceptionRule() error {
+	return c.EnableExceptionRuleContext(context.Background())
+}
+
+func (c *Client) EnableExceptionRuleContext(ctx context.Context) error {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "enable_fqdn_exception_rule",
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DisableExceptionRule() error {
+	return c.DisableExceptionRuleContext(context.Background())
+}
+
+func (c *Client) DisableExceptionRuleContext(ctx context.Context) error {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "disable_fqdn_exception_rule",
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetExceptionRuleStatus() (bool, error) {
+	return c.GetExceptionRuleStatusContext(context.Background())
+}
+
+func (c *Client) GetExceptionRuleStatusContext(ctx context.Context) (bool, error) {
 	var data GetFqdnExceptionRuleResp
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "get_fqdn_exception_rule_status",
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return false, err
 	}
@@ -92,29 +104,41 @@ func (c *Client) GetExceptionRuleStatus() (bool, error) {
 }
 
 func (c *Client) EnableSecurityGroupManagement(account string) error {
+	return c.EnableSecurityGroupManagementContext(context.Background(), account)
+}
+
+func (c *Client) EnableSecurityGroupManagementContext(ctx context.Context, account string) error {
 	form := map[string]string{
 		"CID":                 c.CID,
 		"action":              "enable_controller_security_group_management",
 		"access_account_name": account,
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DisableSecurityGroupManagement() error {
+	return c.DisableSecurityGroupManagementContext(context.Background())
+}
+
+func (c *Client) DisableSecurityGroupManagementContext(ctx context.Context) error {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "disable_controller_security_group_management",
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetSecurityGroupManagementStatus() (*SecurityGroupInfo, error) {
+	return c.GetSecurityGroupManagementStatusContext(context.Background())
+}
+
+func (c *Client) GetSecurityGroupManagementStatusContext(ctx context.Context) (*SecurityGroupInfo, error) {
 	var data GetSecurityGroupManagementResp
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "get_controller_security_group_management_status",
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +146,10 @@ func (c *Client) GetSecurityGroupManagementStatus() (*SecurityGroupInfo, error)
 }
 
 func (c *Client) EnableCloudnBackupConfig(cloudnBackupConfiguration *CloudnBackupConfiguration) error {
+	return c.EnableCloudnBackupConfigContext(context.Background(), cloudnBackupConfiguration)
+}
+
+func (c *Client) EnableCloudnBackupConfigContext(ctx context.Context, cloudnBackupConfiguration *CloudnBackupConfiguration) error {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "enable_cloudn_backup_config",
@@ -140,24 +168,32 @@ func (c *Client) EnableCloudnBackupConfig(cloudnBackupConfiguration *CloudnBacku
 	if cloudnBackupConfiguration.MultipleBackups == "true" {
 		form["multiple_bkup"] = "true"
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DisableCloudnBackupConfig() error {
+	return c.DisableCloudnBackupConfigContext(context.Background())
+}
+
+func (c *Client) DisableCloudnBackupConfigContext(ctx context.Context) error {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "disable_cloudn_backup_config",
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetCloudnBackupConfig() (*CloudnBackupConfiguration, error) {
+	return c.GetCloudnBackupConfigContext(context.Background())
+}
+
+func (c *Client) GetCloudnBackupConfigContext(ctx context.Context) (*CloudnBackupConfiguration, error) {
 	var data GetCloudnBackupConfigResp
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "get_cloudn_backup_config",
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +201,10 @@ func (c *Client) GetCloudnBackupConfig() (*CloudnBackupConfiguration, error) {
 }
 
 func (c *Client) GetControllerVpcDnsServerStatus() (bool, error) {
+	return c.GetControllerVpcDnsServerStatusContext(context.Background())
+}
+
+func (c *Client) GetControllerVpcDnsServerStatusContext(ctx context.Context) (bool, error) {
 	type Resp struct {
 		Return  bool   `json:"return"`
 		Results string `json:"results"`
@@ -175,7 +215,7 @@ func (c *Client) GetControllerVpcDnsServerStatus() (bool, error) {
 		"CID":    c.CID,
 		"action": "get_controller_vpc_dns_server_status",
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return false, err
 	}
@@ -183,11 +223,15 @@ func (c *Client) GetControllerVpcDnsServerStatus() (bool, error) {
 }
 
 func (c *Client) SetControllerVpcDnsServer(enabled bool) error {
+	return c.SetControllerVpcDnsServerContext(context.Background(), enabled)
+}
+
+func (c *Client) SetControllerVpcDnsServerContext(ctx context.Context, enabled bool) error {
 	action := "enable_controller_vpc_dns_server"
 	if !enabled {
 		action = "disable_controller_vpc_dns_server"
 	}
-	return c.PostAPI(action, &APIRequest{
+	return c.PostAPIContext(ctx, action, &APIRequest{
 		CID:    c.CID,
 		Action: action,
 	}, BasicCheck)
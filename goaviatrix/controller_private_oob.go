@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
 )
@@ -12,6 +13,10 @@ type PrivateOobResp struct {
 }
 
 func (c *Client) EnablePrivateOob() error {
+	return c.EnablePrivateOobContext(context.Background())
+}
+
+func (c *Client) EnablePrivateOobContext(ctx context.Context) error {
 	data := map[string]string{
 		"action": "enable_private_oob",
 		"CID":    c.CID,
@@ -22,16 +27,20 @@ func (c *Client) EnablePrivateOob() error {
 		}
 		return nil
 	}
-	return c.PostAPI(data["action"], data, checkFunc)
+	return c.PostAPIContext(ctx, data["action"], data, checkFunc)
 }
 
 func (c *Client) GetPrivateOobState() (bool, error) {
+	return c.GetPrivateOobStateContext(context.Background())
+}
+
+func (c *Client) GetPrivateOobStateContext(ctx context.Context) (bool, error) {
 	var data PrivateOobResp
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "get_private_oob_state",
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return false, err
 	}
@@ -44,6 +53,10 @@ func (c *Client) GetPrivateOobState() (bool, error) {
 }
 
 func (c *Client) DisablePrivateOob() error {
+	return c.DisablePrivateOobContext(context.Background())
+}
+
+func (c *Client) DisablePrivateOobContext(ctx context.Context) error {
 	data := map[string]string{
 		"action": "disable_private_oob",
 		"CID":    c.CID,
@@ -54,5 +67,5 @@ func (c *Client) DisablePrivateOob() error {
 		}
 		return nil
 	}
-	return c.PostAPI(data["action"], data, checkFunc)
+	return c.PostAPIContext(ctx, data["action"], data, checkFunc)
 }
@@ -0,0 +1,86 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrAPITokenExpired is returned when the API token of a client is rejected
+// by the controller and no username and password are set to log in again.
+var ErrAPITokenExpired = errors.New("Aviatrix: Client: API token is invalid or expired and no username and password are set to log in again")
+
+// PasswordSource returns the password used to log in to the controller. It is
+// called on every login, including the logins following an expired CID, so
+// that rotated passwords are picked up.
+type PasswordSource func() (string, error)
+
+// WithAPIToken makes the client use a pre-issued session or API token as its
+// CID instead of logging in with the username and password. When the
+// controller rejects the token, the client logs in with the username and
+// password if they are set, and fails with ErrAPITokenExpired otherwise.
+func WithAPIToken(token string) ClientOption {
+	return func(c *Client) {
+		c.APIToken = token
+	}
+}
+
+// WithPasswordSource makes the client read its password from src on every
+// login instead of using the Password field.
+func WithPasswordSource(src PasswordSource) ClientOption {
+	return func(c *Client) {
+		c.PasswordSource = src
+	}
+}
+
+// PasswordFromFile returns a PasswordSource reading the password from the
+// file at path. Trailing newlines are ignored.
+func PasswordFromFile(path string) PasswordSource {
+	return func() (string, error) {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read password file: %w", err)
+		}
+		return strings.TrimRight(string(b), "\r\n"), nil
+	}
+}
+
+// PasswordFromCommand returns a PasswordSource running command with the
+// system shell and using its standard output as the password. Trailing
+// newlines are ignored.
+func PasswordFromCommand(command string) PasswordSource {
+	return func() (string, error) {
+		var cmd *exec.Cmd
+		if runtime.GOOS == "windows" {
+			cmd = exec.Command("cmd", "/C", command)
+		} else {
+			cmd = exec.Command("sh", "-c", command)
+		}
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return "", fmt.Errorf("password command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return strings.TrimRight(stdout.String(), "\r\n"), nil
+	}
+}
+
+// password returns the password used to log in, read from the
+// PasswordSource of the client if set.
+func (c *Client) password() (string, error) {
+	if c.PasswordSource == nil {
+		return c.Password, nil
+	}
+	return c.PasswordSource()
+}
+
+// hasPasswordCredentials reports whether the client can log in with a
+// username and password.
+func (c *Client) hasPasswordCredentials() bool {
+	return c.Username != "" && (c.Password != "" || c.PasswordSource != nil)
+}
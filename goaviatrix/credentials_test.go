@@ -0,0 +1,77 @@
+package goaviatrix
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "password")
+	assert.NoError(t, os.WriteFile(path, []byte("p@ss word\n"), 0o600))
+
+	password, err := PasswordFromFile(path)()
+	assert.NoError(t, err)
+	assert.Equal(t, "p@ss word", password)
+
+	_, err = PasswordFromFile(filepath.Join(t.TempDir(), "missing"))()
+	assert.ErrorContains(t, err, "failed to read password file")
+}
+
+func TestPasswordFromCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test commands need a POSIX shell")
+	}
+
+	password, err := PasswordFromCommand("printf 'p@ss word\\n'")()
+	assert.NoError(t, err)
+	assert.Equal(t, "p@ss word", password)
+
+	_, err = PasswordFromCommand("echo denied >&2; exit 3")()
+	assert.ErrorContains(t, err, "password command failed")
+	assert.ErrorContains(t, err, "denied")
+}
+
+func TestClientPassword(t *testing.T) {
+	client := &Client{Username: "admin", Password: "in-line"}
+	password, err := client.password()
+	assert.NoError(t, err)
+	assert.Equal(t, "in-line", password)
+	assert.True(t, client.hasPasswordCredentials())
+
+	WithPasswordSource(func() (string, error) { return "from-source", nil })(client)
+	password, err = client.password()
+	assert.NoError(t, err)
+	assert.Equal(t, "from-source", password)
+
+	client = &Client{APIToken: "t0k3n"}
+	assert.False(t, client.hasPasswordCredentials())
+	assert.ErrorIs(t, client.Login(), ErrAPITokenExpired)
+}
+
+func TestLoginForCloudnWithAPIToken(t *testing.T) {
+	var logins []string
+	client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		logins = append(logins, r.PostForm.Get("username"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"return": true, "CID": "cloudn-cid"}`))
+	})
+
+	// Without a username and password the expired token can't be renewed.
+	client.APIToken = "t0k3n"
+	assert.ErrorIs(t, client.LoginForCloudn(), ErrAPITokenExpired)
+	assert.Empty(t, logins)
+	assert.Equal(t, "mockCID", client.CID)
+
+	// With a password source the client logs in to CloudN instead.
+	client.Username = "admin"
+	WithPasswordSource(func() (string, error) { return "from-source", nil })(client)
+	assert.NoError(t, client.LoginForCloudn())
+	assert.Equal(t, []string{"admin"}, logins)
+	assert.Equal(t, "cloudn-cid", client.CID)
+}
@@ -1,5 +1,9 @@
 package goaviatrix
 
+import (
+	"context"
+)
+
 import "strconv"
 
 type DatadogAgent struct {
@@ -19,6 +23,10 @@ type DatadogAgentResp struct {
 }
 
 func (c *Client) EnableDatadogAgent(r *DatadogAgent) error {
+	return c.EnableDatadogAgentContext(context.Background(), r)
+}
+
+func (c *Client) EnableDatadogAgentContext(ctx context.Context, r *DatadogAgent) error {
 	params := map[string]string{
 		"action":               "enable_datadog_agent_logging",
 		"CID":                  c.CID,
@@ -28,10 +36,14 @@ func (c *Client) EnableDatadogAgent(r *DatadogAgent) error {
 		"metrics_only":         strconv.FormatBool(r.MetricsOnly),
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
 
 func (c *Client) GetDatadogAgentStatus() (*DatadogAgentResp, error) {
+	return c.GetDatadogAgentStatusContext(context.Background())
+}
+
+func (c *Client) GetDatadogAgentStatusContext(ctx context.Context) (*DatadogAgentResp, error) {
 	params := map[string]string{
 		"action": "get_datadog_agent_logging_status",
 		"CID":    c.CID,
@@ -45,7 +57,7 @@ func (c *Client) GetDatadogAgentStatus() (*DatadogAgentResp, error) {
 
 	var data Resp
 
-	err := c.GetAPI(&data, params["action"], params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -58,10 +70,14 @@ func (c *Client) GetDatadogAgentStatus() (*DatadogAgentResp, error) {
 }
 
 func (c *Client) DisableDatadogAgent() error {
+	return c.DisableDatadogAgentContext(context.Background())
+}
+
+func (c *Client) DisableDatadogAgentContext(ctx context.Context) error {
 	params := map[string]string{
 		"action": "disable_datadog_agent_logging",
 		"CID":    c.CID,
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
@@ -1,10 +1,11 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Device represents a device used in CloudWAN
@@ -42,6 +43,10 @@ type DeviceInterfaceConfig struct {
 }
 
 func (c *Client) GetDevice(d *Device) (*Device, error) {
+	return c.GetDeviceContext(context.Background(), d)
+}
+
+func (c *Client) GetDeviceContext(ctx context.Context, d *Device) (*Device, error) {
 	type Resp struct {
 		Return  bool     `json:"return"`
 		Results []Device `json:"results"`
@@ -52,7 +57,7 @@ func (c *Client) GetDevice(d *Device) (*Device, error) {
 		"CID":    c.CID,
 		"action": "list_cloudwan_devices_summary",
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +69,7 @@ func (c *Client) GetDevice(d *Device) (*Device, error) {
 		}
 	}
 	if foundDevice == nil {
-		log.Errorf("Could not find Aviatrix device %s", d.Name)
+		tflog.Error(ctx, "Could not find Aviatrix device", map[string]interface{}{"name": d.Name})
 		return nil, ErrNotFound
 	}
 
@@ -101,6 +106,10 @@ func (c *Client) GetDeviceName(connName string) (string, error) {
 }
 
 func (c *Client) GetDeviceInterfaces(deviceName string) (*[]DeviceWanInterface, error) {
+	return c.GetDeviceInterfacesContext(context.Background(), deviceName)
+}
+
+func (c *Client) GetDeviceInterfacesContext(ctx context.Context, deviceName string) (*[]DeviceWanInterface, error) {
 	form := map[string]string{
 		"CID":         c.CID,
 		"action":      "get_cloudwan_device_wan_interfaces",
@@ -109,7 +118,7 @@ func (c *Client) GetDeviceInterfaces(deviceName string) (*[]DeviceWanInterface,
 
 	var data DeviceWanInterfaceResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +127,11 @@ func (c *Client) GetDeviceInterfaces(deviceName string) (*[]DeviceWanInterface,
 }
 
 func (c *Client) ConfigureDeviceInterfaces(config *DeviceInterfaceConfig) error {
-	deviceInterfaces, err := c.GetDeviceInterfaces(config.DeviceName)
+	return c.ConfigureDeviceInterfacesContext(context.Background(), config)
+}
+
+func (c *Client) ConfigureDeviceInterfacesContext(ctx context.Context, config *DeviceInterfaceConfig) error {
+	deviceInterfaces, err := c.GetDeviceInterfacesContext(ctx, config.DeviceName)
 	if err != nil {
 		return err
 	}
@@ -140,5 +153,5 @@ func (c *Client) ConfigureDeviceInterfaces(config *DeviceInterfaceConfig) error
 		"wan_primary_if": config.PrimaryInterface,
 		"wan_primary_ip": config.PrimaryInterfaceIP,
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
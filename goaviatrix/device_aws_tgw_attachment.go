@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -23,18 +24,26 @@ func (b *DeviceAwsTgwAttachment) ID() string {
 }
 
 func (c *Client) CreateDeviceAwsTgwAttachment(attachment *DeviceAwsTgwAttachment) error {
+	return c.CreateDeviceAwsTgwAttachmentContext(context.Background(), attachment)
+}
+
+func (c *Client) CreateDeviceAwsTgwAttachmentContext(ctx context.Context, attachment *DeviceAwsTgwAttachment) error {
 	attachment.Action = "attach_cloudwan_device_to_aws_tgw"
 	attachment.CID = c.CID
 	attachment.Async = true
-	return c.PostAsyncAPI(attachment.Action, attachment, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, attachment.Action, attachment, BasicCheck)
 }
 
 func (c *Client) GetDeviceAwsTgwAttachment(tgwAttachment *DeviceAwsTgwAttachment) (*DeviceAwsTgwAttachment, error) {
+	return c.GetDeviceAwsTgwAttachmentContext(context.Background(), tgwAttachment)
+}
+
+func (c *Client) GetDeviceAwsTgwAttachmentContext(ctx context.Context, tgwAttachment *DeviceAwsTgwAttachment) (*DeviceAwsTgwAttachment, error) {
 	tgwVpcAttachment := &AwsTgwVpcAttachment{
 		TgwName: tgwAttachment.AwsTgwName,
 		VpcID:   tgwAttachment.ConnectionName,
 	}
-	tgwAttachmentInfo, err := c.GetAwsTgwAttachmentInfo(tgwVpcAttachment)
+	tgwAttachmentInfo, err := c.GetAwsTgwAttachmentInfoContext(ctx, tgwVpcAttachment)
 	if err != nil {
 		return nil, err
 	}
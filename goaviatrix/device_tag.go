@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -16,26 +17,30 @@ type DeviceTag struct {
 }
 
 func (c *Client) CreateDeviceTag(deviceTag *DeviceTag) error {
+	return c.CreateDeviceTagContext(context.Background(), deviceTag)
+}
+
+func (c *Client) CreateDeviceTagContext(ctx context.Context, deviceTag *DeviceTag) error {
 	// Create the tag
 	deviceTag.CID = c.CID
 	deviceTag.Action = "add_cloudwan_configtag"
-	err := c.PostAPI(deviceTag.Action, deviceTag, BasicCheck)
+	err := c.PostAPIContext(ctx, deviceTag.Action, deviceTag, BasicCheck)
 	if err != nil {
 		return err
 	}
 
 	// Set the tag config
-	if err := c.UpdateDeviceTagConfig(deviceTag); err != nil {
+	if err := c.UpdateDeviceTagConfigContext(ctx, deviceTag); err != nil {
 		return err
 	}
 
 	// Attach the devices to the tag
-	if err := c.AttachDeviceTag(deviceTag); err != nil {
+	if err := c.AttachDeviceTagContext(ctx, deviceTag); err != nil {
 		return err
 	}
 
 	// Commit the tag config to the devices
-	if err := c.CommitDeviceTag(deviceTag); err != nil {
+	if err := c.CommitDeviceTagContext(ctx, deviceTag); err != nil {
 		return err
 	}
 
@@ -43,6 +48,10 @@ func (c *Client) CreateDeviceTag(deviceTag *DeviceTag) error {
 }
 
 func (c *Client) GetDeviceTag(brt *DeviceTag) (*DeviceTag, error) {
+	return c.GetDeviceTagContext(context.Background(), brt)
+}
+
+func (c *Client) GetDeviceTagContext(ctx context.Context, brt *DeviceTag) (*DeviceTag, error) {
 	// Check if a tag exists with the given name
 	form := map[string]string{
 		"action":              "list_cloudwan_configtag_names",
@@ -57,7 +66,7 @@ func (c *Client) GetDeviceTag(brt *DeviceTag) (*DeviceTag, error) {
 		Reason  string   `json:"reason,omitempty"`
 	}
 	var data Resp
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +88,7 @@ func (c *Client) GetDeviceTag(brt *DeviceTag) (*DeviceTag, error) {
 		Reason  string         `json:"reason,omitempty"`
 	}
 	var detailsData DetailsResp
-	err = c.GetAPI(&detailsData, form["action"], form, BasicCheck)
+	err = c.GetAPIContext(ctx, &detailsData, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -90,29 +99,46 @@ func (c *Client) GetDeviceTag(brt *DeviceTag) (*DeviceTag, error) {
 }
 
 func (c *Client) UpdateDeviceTagConfig(brt *DeviceTag) error {
+	return c.UpdateDeviceTagConfigContext(context.Background(), brt)
+}
+
+func (c *Client) UpdateDeviceTagConfigContext(ctx context.Context, brt *DeviceTag) error {
 	brt.CID = c.CID
 	brt.Action = "edit_cloudwan_configtag"
-	return c.PostAPI(brt.Action, brt, BasicCheck)
+	return c.PostAPIContext(ctx, brt.Action, brt, BasicCheck)
 }
 
 func (c *Client) AttachDeviceTag(brt *DeviceTag) error {
+	return c.AttachDeviceTagContext(context.Background(), brt)
+}
+
+func (c *Client) AttachDeviceTagContext(ctx context.Context, brt *DeviceTag) error {
 	brt.CID = c.CID
 	brt.Action = "attach_devices_to_cloudwan_configtag"
 	brt.DevicesString = strings.Join(brt.Devices, ", ")
-	return c.PostAPI(brt.Action, brt, BasicCheck)
+	return c.PostAPIContext(ctx, brt.Action, brt, BasicCheck)
 }
 
 func (c *Client) CommitDeviceTag(brt *DeviceTag) error {
+	return c.CommitDeviceTagContext(context.Background(), brt)
+}
+
+// CommitDeviceTagContext pushes the tag config to the attached devices,
+// retrying while the devices are busy. The wait between two attempts is
+// interrupted when ctx is done.
+func (c *Client) CommitDeviceTagContext(ctx context.Context, brt *DeviceTag) error {
 	tries, maxTries := 0, 5
 	backoff := 15 * time.Second
 	var err error
 
 	for tries < maxTries {
-		err = c.commitDeviceTagOnce(brt)
+		err = c.commitDeviceTagOnce(ctx, brt)
 		if err != nil {
 			tries++
 			if tries < maxTries {
-				time.Sleep(backoff)
+				if sleepErr := sleepContext(ctx, backoff); sleepErr != nil {
+					return fmt.Errorf("could not commit device tag: %w (last error: %v)", sleepErr, err)
+				}
 				backoff *= 2
 			}
 			continue
@@ -127,14 +153,18 @@ func (c *Client) CommitDeviceTag(brt *DeviceTag) error {
 	return nil
 }
 
-func (c *Client) commitDeviceTagOnce(brt *DeviceTag) error {
+func (c *Client) commitDeviceTagOnce(ctx context.Context, brt *DeviceTag) error {
 	brt.CID = c.CID
 	brt.Action = "commit_cloudwan_configtag_to_devices"
-	return c.PostAPI(brt.Action, brt, BasicCheck)
+	return c.PostAPIContext(ctx, brt.Action, brt, BasicCheck)
 }
 
 func (c *Client) DeleteDeviceTag(brt *DeviceTag) error {
+	return c.DeleteDeviceTagContext(context.Background(), brt)
+}
+
+func (c *Client) DeleteDeviceTagContext(ctx context.Context, brt *DeviceTag) error {
 	brt.CID = c.CID
 	brt.Action = "delete_cloudwan_configtag"
-	return c.PostAPI(brt.Action, brt, BasicCheck)
+	return c.PostAPIContext(ctx, brt.Action, brt, BasicCheck)
 }
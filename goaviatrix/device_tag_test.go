@@ -0,0 +1,29 @@
+package goaviatrix
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommitDeviceTagHonorsContext(t *testing.T) {
+	commits := 0
+	client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		commits++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"return": false, "reason": "device is busy"}`))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := client.CommitDeviceTagContext(ctx, &DeviceTag{Name: "branch"})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "device is busy")
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Equal(t, 1, commits)
+}
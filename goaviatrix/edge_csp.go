@@ -126,6 +126,7 @@ type EdgeCSPResp struct {
 	EnableNat                          string       `json:"enable_nat"`
 	SnatMode                           string       `json:"snat_target"`
 	EnableAutoAdvertiseLanCidrs        bool         `json:"auto_advertise_lan_cidrs"`
+	AdvertisedCidrList                 []string     `json:"advertise_cidr_list,omitempty"`
 }
 
 type EdgeCSPListResp struct {
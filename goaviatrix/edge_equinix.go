@@ -4,7 +4,6 @@ import (
 	"context"
 	b64 "encoding/base64"
 	"encoding/json"
-	"os"
 	"strings"
 )
 
@@ -15,6 +14,7 @@ type EdgeEquinix struct {
 	GwName                             string `json:"name,omitempty"`
 	SiteId                             string `json:"site_id,omitempty"`
 	ZtpFileDownloadPath                string
+	ZtpFileContent                     string `json:"-"`
 	ManagementEgressIpPrefix           string `json:"mgmt_egress_ip,omitempty"`
 	EnableManagementOverPrivateNetwork bool   `json:"mgmt_over_private_network,omitempty"`
 	DnsServerIp                        string `json:"dns_server_ip,omitempty"`
@@ -161,19 +161,14 @@ func (c *Client) CreateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix
 		return err
 	}
 
-	fileName := edgeEquinix.ZtpFileDownloadPath + "/" + edgeEquinix.GwName + "-" + edgeEquinix.SiteId + "-cloud-init.txt"
-
-	outFile, err := os.Create(fileName)
-	if err != nil {
-		return err
+	edgeEquinix.ZtpFileContent = data.Result
+	if edgeEquinix.ZtpFileDownloadPath == "" {
+		return nil
 	}
 
-	_, err = outFile.WriteString(data.Result)
-	if err != nil {
-		return err
-	}
+	fileName := edgeEquinix.ZtpFileDownloadPath + "/" + edgeEquinix.GwName + "-" + edgeEquinix.SiteId + "-cloud-init.txt"
 
-	return nil
+	return createZtpFile(fileName, data.Result)
 }
 
 func (c *Client) GetEdgeEquinix(ctx context.Context, gwName string) (*EdgeEquinixResp, error) {
@@ -4,7 +4,6 @@ import (
 	"context"
 	b64 "encoding/base64"
 	"encoding/json"
-	"os"
 )
 
 type EdgeEquinixHa struct {
@@ -12,6 +11,7 @@ type EdgeEquinixHa struct {
 	CID                      string `json:"CID"`
 	PrimaryGwName            string `json:"primary_gw_name"`
 	ZtpFileDownloadPath      string
+	ZtpFileContent           string `json:"-"`
 	InterfaceList            []*EdgeEquinixInterface
 	Interfaces               string `json:"interfaces"`
 	NoProgressBar            bool   `json:"no_progress_bar,omitempty"`
@@ -51,14 +51,14 @@ func (c *Client) CreateEdgeEquinixHa(ctx context.Context, edgeEquinixHa *EdgeEqu
 		return "", err
 	}
 
-	fileName := edgeEquinixHa.ZtpFileDownloadPath + "/" + edgeEquinixHa.PrimaryGwName + "-hagw-cloud-init.txt"
-
-	outFile, err := os.Create(fileName)
-	if err != nil {
-		return "", err
+	edgeEquinixHa.ZtpFileContent = data.Result
+	if edgeEquinixHa.ZtpFileDownloadPath == "" {
+		return gwName, nil
 	}
 
-	_, err = outFile.WriteString(data.Result)
+	fileName := edgeEquinixHa.ZtpFileDownloadPath + "/" + edgeEquinixHa.PrimaryGwName + "-hagw-cloud-init.txt"
+
+	err = createZtpFile(fileName, data.Result)
 	if err != nil {
 		return "", err
 	}
@@ -72,6 +72,10 @@ type EdgeExternalDeviceConn struct {
 }
 
 func (c *Client) CreateEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error) {
+	return c.CreateEdgeExternalDeviceConnContext(context.Background(), edgeExternalDeviceConn)
+}
+
+func (c *Client) CreateEdgeExternalDeviceConnContext(ctx context.Context, edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error) {
 	type apirespUnderlay struct {
 		Return  bool              `json:"return"`
 		Results map[string]string `json:"results"`
@@ -82,7 +86,7 @@ func (c *Client) CreateEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExtern
 
 	var data apirespUnderlay
 
-	err := c.PostAPIContext2(context.Background(), &data, edgeExternalDeviceConn.Action, edgeExternalDeviceConn, BasicCheck)
+	err := c.PostAPIContext2(ctx, &data, edgeExternalDeviceConn.Action, edgeExternalDeviceConn, BasicCheck)
 	if err != nil {
 		return "", err
 	}
@@ -95,8 +99,12 @@ func (c *Client) CreateEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExtern
 }
 
 func (c *Client) DeleteEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) error {
+	return c.DeleteEdgeExternalDeviceConnContext(context.Background(), edgeExternalDeviceConn)
+}
+
+func (c *Client) DeleteEdgeExternalDeviceConnContext(ctx context.Context, edgeExternalDeviceConn *EdgeExternalDeviceConn) error {
 	edgeExternalDeviceConn.CID = c.CID
 	edgeExternalDeviceConn.Action = "transit_disconnect"
 
-	return c.PostAPIContext2(context.Background(), nil, edgeExternalDeviceConn.Action, edgeExternalDeviceConn, BasicCheck)
+	return c.PostAPIContext2(ctx, nil, edgeExternalDeviceConn.Action, edgeExternalDeviceConn, BasicCheck)
 }
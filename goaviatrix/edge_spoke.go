@@ -27,6 +27,7 @@ type EdgeSpoke struct {
 	SecondaryDnsServerIp               string `json:"dns_server_ip_secondary,omitempty"`
 	ZtpFileType                        string `json:"ztp_file_type,omitempty"`
 	ZtpFileDownloadPath                string
+	ZtpFileContent                     string `json:"-"`
 	ActiveStandby                      string `json:"active_standby,omitempty"`
 	EnableEdgeActiveStandby            bool   `json:"enable_active_standby,omitempty"`
 	DisableEdgeActiveStandby           bool   `json:"disable_active_standby,omitempty"`
@@ -123,6 +124,8 @@ type EdgeSpokeResp struct {
 	InterfaceList                      []*EdgeSpokeInterface         `json:"interfaces"`
 	CustomInterfaceMapping             map[string]CustomInterfaceMap `json:"custom_interface_mapping,omitempty"`
 	AdvertisedCidrList                 []string                      `json:"advertise_cidr_list,omitempty"`
+	EnableNat                          string                        `json:"enable_nat"`
+	SnatMode                           string                        `json:"snat_target"`
 }
 
 type EdgeSpokeListResp struct {
@@ -159,25 +162,31 @@ func (c *Client) CreateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) erro
 	if err != nil {
 		return err
 	}
+	defer resp.Close()
 
-	var fileName string
+	content, err := io.ReadAll(resp)
+	if err != nil {
+		return err
+	}
+
+	// ISO files are binary, so they are exported base64 encoded.
 	if edgeSpoke.ZtpFileType == "iso" {
-		fileName = edgeSpoke.ZtpFileDownloadPath + "/" + edgeSpoke.GwName + "-" + edgeSpoke.SiteId + ".iso"
+		edgeSpoke.ZtpFileContent = b64.StdEncoding.EncodeToString(content)
 	} else {
-		fileName = edgeSpoke.ZtpFileDownloadPath + "/" + edgeSpoke.GwName + "-" + edgeSpoke.SiteId + "-cloud-init.txt"
+		edgeSpoke.ZtpFileContent = string(content)
 	}
-
-	outFile, err := os.Create(fileName)
-	if err != nil {
-		return err
+	if edgeSpoke.ZtpFileDownloadPath == "" {
+		return nil
 	}
 
-	_, err = io.Copy(outFile, resp)
-	if err != nil {
-		return err
+	var fileName string
+	if edgeSpoke.ZtpFileType == "iso" {
+		fileName = edgeSpoke.ZtpFileDownloadPath + "/" + edgeSpoke.GwName + "-" + edgeSpoke.SiteId + ".iso"
+	} else {
+		fileName = edgeSpoke.ZtpFileDownloadPath + "/" + edgeSpoke.GwName + "-" + edgeSpoke.SiteId + "-cloud-init.txt"
 	}
 
-	return nil
+	return os.WriteFile(fileName, content, 0o644)
 }
 
 func (c *Client) GetEdgeSpoke(ctx context.Context, gwName string) (*EdgeSpokeResp, error) {
@@ -0,0 +1,88 @@
+package goaviatrix
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestV2ControllerClient returns a Client sending its v2 API requests to
+// a TLS test server running handler.
+func newTestV2ControllerClient(t *testing.T, handler http.HandlerFunc) *Client {
+	srv := httptest.NewTLSServer(handler)
+	t.Cleanup(srv.Close)
+	return &Client{
+		HTTPClient:   srv.Client(),
+		CID:          "mockCID",
+		ControllerIP: strings.TrimPrefix(srv.URL, "https://"),
+		baseURL:      srv.URL + "/v1/api",
+		RetryPolicy:  newTestRetryPolicy(),
+	}
+}
+
+func TestCreateEdgeSpokeZtpFileContent(t *testing.T) {
+	iso := []byte{0x43, 0x44, 0x30, 0x30, 0x31, 0x00, 0xff}
+	tests := []struct {
+		name        string
+		ztpFileType string
+		body        []byte
+		wantContent string
+		wantFile    string
+	}{
+		{
+			name:        "cloud-init",
+			ztpFileType: "cloud_init",
+			body:        []byte("#cloud-config\n"),
+			wantContent: "#cloud-config\n",
+			wantFile:    "edge-1-site-1-cloud-init.txt",
+		},
+		{
+			name:        "iso",
+			ztpFileType: "iso",
+			body:        iso,
+			wantContent: b64.StdEncoding.EncodeToString(iso),
+			wantFile:    "edge-1-site-1.iso",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestV2ControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/octet-stream")
+				_, _ = w.Write(tt.body)
+			})
+
+			dir := t.TempDir()
+			edgeSpoke := &EdgeSpoke{GwName: "edge-1", SiteId: "site-1", ZtpFileType: tt.ztpFileType, ZtpFileDownloadPath: dir}
+			err := client.CreateEdgeSpoke(context.Background(), edgeSpoke)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantContent, edgeSpoke.ZtpFileContent)
+			written, err := os.ReadFile(filepath.Join(dir, tt.wantFile))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.body, written)
+		})
+	}
+}
+
+func TestCreateEdgeSpokeWithoutZtpFileDownloadPath(t *testing.T) {
+	client := newTestV2ControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write([]byte("#cloud-config\n"))
+	})
+
+	edgeSpoke := &EdgeSpoke{GwName: "edge-1", SiteId: "site-1", ZtpFileType: "cloud_init"}
+	err := client.CreateEdgeSpoke(context.Background(), edgeSpoke)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "#cloud-config\n", edgeSpoke.ZtpFileContent)
+	_, err = os.Stat("/edge-1-site-1-cloud-init.txt")
+	assert.True(t, os.IsNotExist(err))
+}
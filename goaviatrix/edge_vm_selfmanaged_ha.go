@@ -16,6 +16,7 @@ type EdgeVmSelfmanagedHa struct {
 	SiteId                   string
 	ZtpFileType              string
 	ZtpFileDownloadPath      string
+	ZtpFileContent           string `json:"-"`
 	DnsServerIp              string `json:"dns_server_ip,omitempty"`
 	SecondaryDnsServerIp     string `json:"dns_server_ip_secondary,omitempty"`
 	InterfaceList            []*EdgeSpokeInterface
@@ -73,6 +74,12 @@ func (c *Client) CreateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanage
 		return "", err
 	}
 
+	// ISO files are returned base64 encoded and exported as is.
+	edgeVmSelfmanagedHa.ZtpFileContent = data.Result
+	if edgeVmSelfmanagedHa.ZtpFileDownloadPath == "" {
+		return gwName, nil
+	}
+
 	var fileName string
 	if edgeVmSelfmanagedHa.ZtpFileType == "iso" {
 		fileName = edgeVmSelfmanagedHa.ZtpFileDownloadPath + "/" + edgeVmSelfmanagedHa.PrimaryGwName + "-" + edgeVmSelfmanagedHa.SiteId + "-ha.iso"
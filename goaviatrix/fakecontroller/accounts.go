@@ -0,0 +1,104 @@
+package fakecontroller
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/ajg/form"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+// AddAccount stores an access account as if it had been onboarded.
+func (s *Server) AddAccount(account goaviatrix.Account) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accounts[account.AccountName] = &account
+}
+
+// Account returns the stored access account with the given name.
+func (s *Server) Account(name string) (goaviatrix.Account, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	account, ok := s.accounts[name]
+	if !ok {
+		return goaviatrix.Account{}, false
+	}
+	return *account, true
+}
+
+func (s *Server) registerAccounts() {
+	s.actions["setup_account_profile"] = func(req *Request) (interface{}, error) {
+		account, err := decodeAccount(req)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := s.accounts[account.AccountName]; ok {
+			return nil, fmt.Errorf("Account %s already exists.", account.AccountName)
+		}
+		s.accounts[account.AccountName] = account
+		return fmt.Sprintf("An email confirmation has been sent to the account %s.", account.AccountName), nil
+	}
+	s.actions["edit_account_profile"] = func(req *Request) (interface{}, error) {
+		account, err := decodeAccount(req)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := s.accounts[account.AccountName]; !ok {
+			return nil, fmt.Errorf("Account %s does not exist.", account.AccountName)
+		}
+		s.accounts[account.AccountName] = account
+		return fmt.Sprintf("Account %s has been updated.", account.AccountName), nil
+	}
+	s.actions["delete_account_profile"] = func(req *Request) (interface{}, error) {
+		name := req.Params.Get("account_name")
+		if _, ok := s.accounts[name]; !ok {
+			return nil, fmt.Errorf("Account %s does not exist.", name)
+		}
+		delete(s.accounts, name)
+		return fmt.Sprintf("Account %s has been deleted.", name), nil
+	}
+	s.actions["list_accounts"] = func(req *Request) (interface{}, error) {
+		accounts := make([]goaviatrix.Account, 0, len(s.accounts))
+		for _, account := range s.accounts {
+			accounts = append(accounts, *account)
+		}
+		sort.Slice(accounts, func(i, j int) bool {
+			return accounts[i].AccountName < accounts[j].AccountName
+		})
+		return goaviatrix.AccountResult{AccountList: accounts}, nil
+	}
+	s.actions["get_account_audit_records"] = func(req *Request) (interface{}, error) {
+		var records []map[string]string
+		for name := range s.accounts {
+			records = append(records, map[string]string{
+				"account_name": name,
+				"status":       "Pass",
+				"comment":      "",
+			})
+		}
+		sort.Slice(records, func(i, j int) bool {
+			return records[i]["account_name"] < records[j]["account_name"]
+		})
+		return records, nil
+	}
+}
+
+// decodeAccount reads an account from the form fields of the
+// setup_account_profile and edit_account_profile actions.
+func decodeAccount(req *Request) (*goaviatrix.Account, error) {
+	account := &goaviatrix.Account{}
+	if err := form.DecodeValues(account, req.Params); err != nil {
+		return nil, fmt.Errorf("invalid account parameters: %v", err)
+	}
+	if account.AccountName == "" {
+		return nil, fmt.Errorf("account_name is required")
+	}
+	if _, err := strconv.Atoi(req.Params.Get("cloud_type")); err != nil {
+		return nil, fmt.Errorf("invalid cloud_type %q", req.Params.Get("cloud_type"))
+	}
+	account.CID = ""
+	account.Action = ""
+	return account, nil
+}
@@ -0,0 +1,76 @@
+package fakecontroller
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+// AddFQDNTag stores an FQDN filter tag with its domain names and the
+// gateways it is attached to, with their source IP filters.
+func (s *Server) AddFQDNTag(tag goaviatrix.FQDN) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fqdnTags[tag.FQDNTag] = &tag
+}
+
+func (s *Server) registerFQDNTags() {
+	s.actions["list_fqdn_filter_tags"] = func(req *Request) (interface{}, error) {
+		tags := make(map[string]interface{})
+		for name, tag := range s.fqdnTags {
+			tags[name] = map[string]string{"wbmode": tag.FQDNMode, "state": tag.FQDNStatus}
+		}
+		return tags, nil
+	}
+	s.actions["list_fqdn_filter_tag_domain_names"] = func(req *Request) (interface{}, error) {
+		tag, err := s.fqdnTag(req)
+		if err != nil {
+			return nil, err
+		}
+		domains := make([]map[string]string, 0, len(tag.DomainList))
+		for _, domain := range tag.DomainList {
+			domains = append(domains, map[string]string{
+				"fqdn":    domain.FQDN,
+				"proto":   domain.Protocol,
+				"port":    domain.Port,
+				"verdict": domain.Verdict,
+			})
+		}
+		return domains, nil
+	}
+	s.actions["list_fqdn_filter_tag_attached_gws"] = func(req *Request) (interface{}, error) {
+		tag, err := s.fqdnTag(req)
+		if err != nil {
+			return nil, err
+		}
+		gwNames := make([]string, 0, len(tag.GwFilterTagList))
+		for _, gw := range tag.GwFilterTagList {
+			gwNames = append(gwNames, gw.Name)
+		}
+		sort.Strings(gwNames)
+		return gwNames, nil
+	}
+	s.actions["list_fqdn_filter_tag_source_ip_filters"] = func(req *Request) (interface{}, error) {
+		tag, err := s.fqdnTag(req)
+		if err != nil {
+			return nil, err
+		}
+		gwName := req.Params.Get("gateway_name")
+		for _, gw := range tag.GwFilterTagList {
+			if gw.Name == gwName {
+				return goaviatrix.GwSourceIP{ConfiguredIPs: gw.SourceIPList, VpcSubnets: []string{}}, nil
+			}
+		}
+		return nil, fmt.Errorf("Tag %s is not attached to gateway %s", tag.FQDNTag, gwName)
+	}
+}
+
+func (s *Server) fqdnTag(req *Request) (*goaviatrix.FQDN, error) {
+	name := req.Params.Get("tag_name")
+	tag, ok := s.fqdnTags[name]
+	if !ok {
+		return nil, fmt.Errorf("Tag %s does not exist", name)
+	}
+	return tag, nil
+}
@@ -0,0 +1,252 @@
+package fakecontroller
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+type gateway struct {
+	goaviatrix.Gateway
+	acceptCommunities bool
+	sendCommunities   bool
+}
+
+// AddGateway stores a gateway as if it had been launched. Gateways with
+// TransitVpc set to "yes" are transit gateways, all others are spokes.
+func (s *Server) AddGateway(gw goaviatrix.Gateway) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if gw.TransitVpc == "" {
+		gw.TransitVpc = "no"
+	}
+	s.gateways[gw.GwName] = &gateway{Gateway: gw}
+}
+
+// Gateway returns the stored gateway with the given name.
+func (s *Server) Gateway(name string) (goaviatrix.Gateway, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	gw, ok := s.gateways[name]
+	if !ok {
+		return goaviatrix.Gateway{}, false
+	}
+	return gw.Gateway, true
+}
+
+func (s *Server) registerGateways() {
+	s.actions["create_multicloud_primary_gateway"] = func(req *Request) (interface{}, error) {
+		p := req.Params
+		name := p.Get("gw_name")
+		if name == "" {
+			return nil, fmt.Errorf("gw_name is required")
+		}
+		if _, ok := s.gateways[name]; ok {
+			return nil, fmt.Errorf("Gateway %s already exists", name)
+		}
+		cloudType, err := strconv.Atoi(p.Get("cloud_type"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cloud_type %q", p.Get("cloud_type"))
+		}
+		if _, ok := s.accounts[p.Get("account_name")]; !ok {
+			return nil, fmt.Errorf("Account %s does not exist", p.Get("account_name"))
+		}
+
+		gw := &gateway{Gateway: goaviatrix.Gateway{
+			GwName:         name,
+			CloudType:      cloudType,
+			AccountName:    p.Get("account_name"),
+			VpcID:          p.Get("vpc_id"),
+			VpcRegion:      p.Get("vpc_region"),
+			GwSize:         p.Get("gw_size"),
+			VpcNet:         p.Get("gw_subnet"),
+			TransitVpc:     "no",
+			EnableBgp:      isTrue(p.Get("enable_bgp")),
+			InstState:      "up",
+			SingleAZ:       "yes",
+			JumboFrame:     true,
+			BgpHoldTime:    180,
+			BgpPollingTime: 50,
+
+			LearnedCidrsApprovalMode: "gateway",
+		}}
+		if isTrue(p.Get("transit")) {
+			gw.TransitVpc = "yes"
+		} else {
+			gw.SpokeVpc = "yes"
+		}
+		s.nextID++
+		gw.PrivateIP = fmt.Sprintf("10.255.%d.%d", s.nextID/256%256, s.nextID%256)
+		gw.PublicIP = fmt.Sprintf("198.51.%d.%d", s.nextID/256%256, s.nextID%256)
+
+		s.gateways[name] = gw
+		return fmt.Sprintf("Gateway %s has been launched.", name), nil
+	}
+	s.actions["delete_container"] = func(req *Request) (interface{}, error) {
+		name := req.Params.Get("gw_name")
+		if _, ok := s.gateways[name]; !ok {
+			return nil, fmt.Errorf("Gateway %s does not exist", name)
+		}
+		for _, gw := range s.gateways {
+			if gw.TransitGwName == name {
+				return nil, fmt.Errorf("Gateway %s is attached to spoke gateway %s", name, gw.GwName)
+			}
+		}
+		delete(s.gateways, name)
+		return fmt.Sprintf("Gateway %s has been deleted.", name), nil
+	}
+	s.actions["list_vpcs_summary"] = func(req *Request) (interface{}, error) {
+		p := req.Params
+		var gateways []goaviatrix.Gateway
+		for _, gw := range s.gateways {
+			switch {
+			case p.Get("gateway_name") != "" && gw.GwName != p.Get("gateway_name"),
+				isTrue(p.Get("transit_only")) && gw.TransitVpc != "yes",
+				isTrue(p.Get("spoke_only")) && gw.TransitVpc == "yes":
+				continue
+			}
+			gateways = append(gateways, gw.Gateway)
+		}
+		sort.Slice(gateways, func(i, j int) bool {
+			return gateways[i].GwName < gateways[j].GwName
+		})
+		return gateways, nil
+	}
+	s.actions["get_gateway_info"] = func(req *Request) (interface{}, error) {
+		name := req.Params.Get("gateway_name")
+		gw, ok := s.gateways[name]
+		if !ok {
+			return nil, fmt.Errorf("Gateway %s does not exist", name)
+		}
+		return goaviatrix.GatewayDetail{
+			AccountName:   gw.AccountName,
+			GwName:        gw.GwName,
+			TransitGwName: gw.TransitGwName,
+			BgpEnabled:    gw.EnableBgp,
+		}, nil
+	}
+	s.actions["get_firewall_lan_cidr"] = func(req *Request) (interface{}, error) {
+		name := req.Params.Get("gateway_name")
+		if _, ok := s.gateways[name]; !ok {
+			return nil, fmt.Errorf("Gateway %s does not exist", name)
+		}
+		return map[string]string{}, nil
+	}
+	s.actions["get_gro_gso_status"] = func(req *Request) (interface{}, error) {
+		name := req.Params.Get("gateway_name")
+		if _, ok := s.gateways[name]; !ok {
+			return nil, fmt.Errorf("Gateway %s does not exist", name)
+		}
+		return "GRO/GSO is enabled", nil
+	}
+
+	s.actions["enable_single_az_ha"] = s.gatewayAction(func(gw *gateway, p url.Values) {
+		gw.SingleAZ = "yes"
+	})
+	s.actions["disable_single_az_ha"] = s.gatewayAction(func(gw *gateway, p url.Values) {
+		gw.SingleAZ = "no"
+	})
+	s.actions["enable_jumbo_frame"] = s.gatewayAction(func(gw *gateway, p url.Values) {
+		gw.JumboFrame = true
+	})
+	s.actions["disable_jumbo_frame"] = s.gatewayAction(func(gw *gateway, p url.Values) {
+		gw.JumboFrame = false
+	})
+	s.actions["change_bgp_polling_time"] = s.gatewayAction(func(gw *gateway, p url.Values) {
+		gw.BgpPollingTime, _ = strconv.Atoi(p.Get("bgp_polling_time"))
+	})
+	s.actions["set_gateway_accept_bgp_communities_override"] = s.gatewayAction(func(gw *gateway, p url.Values) {
+		gw.acceptCommunities = isTrue(p.Get("accept_communities"))
+	})
+	s.actions["set_gateway_send_bgp_communities_override"] = s.gatewayAction(func(gw *gateway, p url.Values) {
+		gw.sendCommunities = isTrue(p.Get("send_communities"))
+	})
+	s.actions["show_bgp_communities_gateway_overrides"] = func(req *Request) (interface{}, error) {
+		name := req.Params.Get("gateway_name")
+		gw, ok := s.gateways[name]
+		if !ok {
+			return nil, fmt.Errorf("Gateway %s does not exist", name)
+		}
+		// The controller reports both flags as strings.
+		return map[string]string{
+			"accept_communities": strconv.FormatBool(gw.acceptCommunities),
+			"send_communities":   strconv.FormatBool(gw.sendCommunities),
+		}, nil
+	}
+
+	s.actions["attach_spoke_to_transit_gw"] = func(req *Request) (interface{}, error) {
+		spoke, transit, err := s.attachmentGateways(req.Params.Get("spoke_gw"), req.Params.Get("transit_gw"))
+		if err != nil {
+			return nil, err
+		}
+		if spoke.TransitGwName != "" {
+			return nil, fmt.Errorf("Spoke gateway %s is already attached to transit gateway %s", spoke.GwName, spoke.TransitGwName)
+		}
+		spoke.TransitGwName = transit.GwName
+		return fmt.Sprintf("Spoke gateway %s has been attached to transit gateway %s.", spoke.GwName, transit.GwName), nil
+	}
+	s.actions["detach_spoke_from_transit_gw"] = func(req *Request) (interface{}, error) {
+		name := req.Params.Get("spoke_gw")
+		spoke, ok := s.gateways[name]
+		if !ok {
+			return nil, fmt.Errorf("Gateway %s does not exist", name)
+		}
+		if transit := req.Params.Get("transit_gw"); transit != "" && spoke.TransitGwName != transit {
+			return nil, fmt.Errorf("Spoke gateway %s is not attached to transit gateway %s", name, transit)
+		}
+		spoke.TransitGwName = ""
+		return fmt.Sprintf("Spoke gateway %s has been detached.", name), nil
+	}
+	s.actions["get_inter_transit_gateway_peering_details"] = func(req *Request) (interface{}, error) {
+		gw1, gw2 := req.Params.Get("gateway1"), req.Params.Get("gateway2")
+		spoke, transit, err := s.attachmentGateways(gw1, gw2)
+		if err != nil {
+			return nil, err
+		}
+		if spoke.TransitGwName != transit.GwName {
+			return nil, fmt.Errorf("Peering between %s and %s does not exist", gw1, gw2)
+		}
+		return goaviatrix.TransitGatewayPeeringDetailsResults{TunnelCount: 1}, nil
+	}
+}
+
+// gatewayAction returns an action that applies update to the gateway named
+// by the gw_name or gateway_name parameter.
+func (s *Server) gatewayAction(update func(gw *gateway, p url.Values)) ActionFunc {
+	return func(req *Request) (interface{}, error) {
+		name := req.Params.Get("gateway_name")
+		if name == "" {
+			name = req.Params.Get("gw_name")
+		}
+		gw, ok := s.gateways[name]
+		if !ok {
+			return nil, fmt.Errorf("Gateway %s does not exist", name)
+		}
+		update(gw, req.Params)
+		return fmt.Sprintf("Gateway %s has been updated.", name), nil
+	}
+}
+
+// attachmentGateways looks up the two ends of a spoke transit attachment.
+func (s *Server) attachmentGateways(spokeName, transitName string) (*gateway, *gateway, error) {
+	spoke, ok := s.gateways[spokeName]
+	if !ok {
+		return nil, nil, fmt.Errorf("Gateway %s does not exist", spokeName)
+	}
+	transit, ok := s.gateways[transitName]
+	if !ok || transit.TransitVpc != "yes" {
+		return nil, nil, fmt.Errorf("Transit gateway %s does not exist", transitName)
+	}
+	return spoke, transit, nil
+}
+
+func isTrue(s string) bool {
+	switch s {
+	case "true", "yes", "on":
+		return true
+	}
+	return false
+}
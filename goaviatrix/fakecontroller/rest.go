@@ -0,0 +1,134 @@
+package fakecontroller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+// SmartGroup returns the stored smart group with the given UUID.
+func (s *Server) SmartGroup(uuid string) (goaviatrix.SmartGroupResult, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	smartGroup, ok := s.smartGroups[uuid]
+	if !ok {
+		return goaviatrix.SmartGroupResult{}, false
+	}
+	return *smartGroup, true
+}
+
+// PolicyList returns the stored distributed-firewalling policy list.
+func (s *Server) PolicyList() (goaviatrix.DistributedFirewallingPolicyList, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var policyList goaviatrix.DistributedFirewallingPolicyList
+	if s.policyList == nil || json.Unmarshal(s.policyList, &policyList) != nil {
+		return policyList, false
+	}
+	return policyList, true
+}
+
+func (s *Server) registerSmartGroups() {
+	decode := func(req *Request) (*goaviatrix.SmartGroupResult, int, interface{}) {
+		var smartGroup goaviatrix.SmartGroupResult
+		if err := json.Unmarshal(req.Body, &smartGroup); err != nil {
+			return nil, http.StatusBadRequest, "invalid smart group: " + err.Error()
+		}
+		if smartGroup.Name == "" {
+			return nil, http.StatusBadRequest, "name is required"
+		}
+		return &smartGroup, http.StatusOK, nil
+	}
+	uuidOf := func(req *Request) string {
+		return req.Path[strings.LastIndex(req.Path, "/")+1:]
+	}
+
+	s.rest["POST app-domains"] = func(req *Request) (int, interface{}) {
+		smartGroup, status, msg := decode(req)
+		if smartGroup == nil {
+			return status, msg
+		}
+		for _, existing := range s.smartGroups {
+			if existing.Name == smartGroup.Name {
+				return http.StatusConflict, "smart group " + smartGroup.Name + " already exists"
+			}
+		}
+		smartGroup.UUID = s.newUUID()
+		s.smartGroups[smartGroup.UUID] = smartGroup
+		return http.StatusCreated, map[string]string{"uuid": smartGroup.UUID}
+	}
+	s.rest["GET app-domains"] = func(req *Request) (int, interface{}) {
+		smartGroups := make([]goaviatrix.SmartGroupResult, 0, len(s.smartGroups))
+		for _, smartGroup := range s.smartGroups {
+			smartGroups = append(smartGroups, *smartGroup)
+		}
+		sort.Slice(smartGroups, func(i, j int) bool {
+			return smartGroups[i].Name < smartGroups[j].Name
+		})
+		return http.StatusOK, goaviatrix.SmartGroupResp{SmartGroups: smartGroups}
+	}
+	s.rest["GET app-domains/*"] = func(req *Request) (int, interface{}) {
+		smartGroup, ok := s.smartGroups[uuidOf(req)]
+		if !ok {
+			return http.StatusNotFound, "smart group " + uuidOf(req) + " not found"
+		}
+		return http.StatusOK, smartGroup
+	}
+	s.rest["PUT app-domains/*"] = func(req *Request) (int, interface{}) {
+		uuid := uuidOf(req)
+		if _, ok := s.smartGroups[uuid]; !ok {
+			return http.StatusNotFound, "smart group " + uuid + " not found"
+		}
+		smartGroup, status, msg := decode(req)
+		if smartGroup == nil {
+			return status, msg
+		}
+		smartGroup.UUID = uuid
+		s.smartGroups[uuid] = smartGroup
+		return http.StatusOK, map[string]string{"uuid": uuid}
+	}
+	s.rest["DELETE app-domains/*"] = func(req *Request) (int, interface{}) {
+		uuid := uuidOf(req)
+		if _, ok := s.smartGroups[uuid]; !ok {
+			return http.StatusNotFound, "smart group " + uuid + " not found"
+		}
+		delete(s.smartGroups, uuid)
+		return http.StatusOK, map[string]string{}
+	}
+}
+
+func (s *Server) registerPolicyList() {
+	s.rest["PUT microseg/policy-list"] = func(req *Request) (int, interface{}) {
+		var policyList goaviatrix.DistributedFirewallingPolicyList
+		if err := json.Unmarshal(req.Body, &policyList); err != nil {
+			return http.StatusBadRequest, "invalid policy list: " + err.Error()
+		}
+		for i := range policyList.Policies {
+			if policyList.Policies[i].UUID == "" {
+				policyList.Policies[i].UUID = s.newUUID()
+			}
+		}
+		s.policyList, _ = json.Marshal(policyList)
+		return http.StatusOK, map[string]string{}
+	}
+	s.rest["GET microseg/policy-list"] = func(req *Request) (int, interface{}) {
+		if s.policyList == nil {
+			return http.StatusOK, map[string]interface{}{"policies": []interface{}{}}
+		}
+		return http.StatusOK, s.policyList
+	}
+	s.rest["DELETE microseg/policy-list"] = func(req *Request) (int, interface{}) {
+		s.policyList = nil
+		return http.StatusOK, map[string]string{}
+	}
+}
+
+// newUUID returns a unique UUID-formatted ID. Callers must hold s.mu.
+func (s *Server) newUUID() string {
+	s.nextID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012x", s.nextID)
+}
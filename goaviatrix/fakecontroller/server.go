@@ -0,0 +1,417 @@
+// Package fakecontroller provides an in-process stand-in for the Aviatrix
+// Controller API so that the client and the provider resources can be
+// exercised offline.
+//
+// The server understands the form, multipart and JSON encoded actions of the
+// /v2/api endpoint, the login flow, check_task_status polling of async actions
+// and the /v2.5 REST paths. Core objects (accounts, VPCs, gateways, spoke
+// transit attachments, FQDN filter tags, smart groups and the
+// distributed-firewalling policy list) are kept in memory. Anything else can be stubbed with HandleAction and
+// HandleREST.
+package fakecontroller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+const (
+	// DefaultUsername and DefaultPassword are the credentials accepted by a
+	// new Server.
+	DefaultUsername = "admin"
+	DefaultPassword = "password"
+
+	// DefaultVersion is the controller version reported by a new Server.
+	DefaultVersion = "8.1.0-1000.1234"
+)
+
+// Request is a request received by the Server. Params holds the query string
+// merged with the decoded form, multipart or JSON body.
+type Request struct {
+	Method string
+	Path   string
+	Action string
+	Params url.Values
+	Body   []byte
+}
+
+// ActionFunc handles a /v2/api action. The returned value is sent as the
+// "results" of a successful response, unless it is a Response which is sent
+// as is. A non-nil error is sent as a failed response with the error as the
+// reason.
+type ActionFunc func(req *Request) (interface{}, error)
+
+// RESTFunc handles a /v2.5 REST call. The returned value is encoded as the
+// JSON response body. A status of 300 or above is sent as an error with v as
+// the message.
+type RESTFunc func(req *Request) (status int, v interface{})
+
+// Response is a raw /v2/api response body.
+type Response map[string]interface{}
+
+type task struct {
+	results interface{}
+	err     error
+	polls   int
+}
+
+// Server is a fake Aviatrix Controller.
+type Server struct {
+	*httptest.Server
+
+	// Username and Password are the credentials accepted by login.
+	Username string
+	Password string
+	// Version is the controller version reported by list_version_info.
+	Version string
+	// PendingPolls is the number of check_task_status calls answered with
+	// REQUEST_IN_PROGRESS before an async action completes.
+	PendingPolls int
+
+	mu       sync.Mutex
+	nextID   int
+	tokens   map[string]bool
+	cids     map[string]bool
+	tasks    map[string]*task
+	actions  map[string]ActionFunc
+	rest     map[string]RESTFunc
+	requests []Request
+
+	accounts    map[string]*goaviatrix.Account
+	vpcs        map[string]*goaviatrix.VpcEdit
+	gateways    map[string]*gateway
+	fqdnTags    map[string]*goaviatrix.FQDN
+	smartGroups map[string]*goaviatrix.SmartGroupResult
+	policyList  json.RawMessage
+}
+
+// New starts a Server. Callers should call Close when finished.
+func New() *Server {
+	s := &Server{
+		Username:    DefaultUsername,
+		Password:    DefaultPassword,
+		Version:     DefaultVersion,
+		tokens:      make(map[string]bool),
+		cids:        make(map[string]bool),
+		tasks:       make(map[string]*task),
+		actions:     make(map[string]ActionFunc),
+		rest:        make(map[string]RESTFunc),
+		accounts:    make(map[string]*goaviatrix.Account),
+		vpcs:        make(map[string]*goaviatrix.VpcEdit),
+		gateways:    make(map[string]*gateway),
+		fqdnTags:    make(map[string]*goaviatrix.FQDN),
+		smartGroups: make(map[string]*goaviatrix.SmartGroupResult),
+	}
+	s.registerSession()
+	s.registerAccounts()
+	s.registerVpcs()
+	s.registerGateways()
+	s.registerFQDNTags()
+	s.registerSmartGroups()
+	s.registerPolicyList()
+
+	// The client always talks https to the controller.
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Host returns the host:port of the Server, for use as the controller IP.
+func (s *Server) Host() string {
+	return s.Listener.Addr().String()
+}
+
+// NewClient returns a Client logged in to the Server. Async actions are polled
+// every millisecond.
+func (s *Server) NewClient(opts ...goaviatrix.ClientOption) (*goaviatrix.Client, error) {
+	p := goaviatrix.DefaultRetryPolicy()
+	p.BaseBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	p.AsyncPollInterval = time.Millisecond
+	opts = append([]goaviatrix.ClientOption{goaviatrix.WithRetryPolicy(p)}, opts...)
+	return goaviatrix.NewClient(s.Username, s.Password, s.Host(), s.Client(), nil, opts...)
+}
+
+// HandleAction registers fn for a /v2/api action, replacing any built-in
+// handler.
+func (s *Server) HandleAction(action string, fn ActionFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.actions[action] = fn
+}
+
+// HandleREST registers fn for a /v2.5 REST call, replacing any built-in
+// handler. path is relative to /v2.5/api/, and a final "*" segment matches any
+// single path segment, e.g. "app-domains/*".
+func (s *Server) HandleREST(method, path string, fn RESTFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rest[method+" "+path] = fn
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Actions returns the /v2/api actions received so far, in order.
+func (s *Server) Actions() []string {
+	var actions []string
+	for _, req := range s.Requests() {
+		if req.Action != "" {
+			actions = append(actions, req.Action)
+		}
+	}
+	return actions
+}
+
+// ExpireSessions invalidates every CID handed out so far, forcing clients to
+// log in again.
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cids = make(map[string]bool)
+}
+
+// IssueAPIToken returns a new session token accepted as CID, as issued to
+// automation outside of the login flow.
+func (s *Server) IssueAPIToken() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token := s.newID("cid-")
+	s.cids[token] = true
+	return token
+}
+
+// newID returns a unique ID with the given prefix. Callers must hold s.mu.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s%08x", prefix, s.nextID)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	req, err := parseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, *req)
+
+	switch {
+	case req.Path == "/v2/api":
+		s.serveAction(w, r, req)
+	case strings.HasPrefix(req.Path, "/v2.5/api/"):
+		s.serveREST(w, r, req)
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "not found"})
+	}
+}
+
+func (s *Server) serveAction(w http.ResponseWriter, r *http.Request, req *Request) {
+	switch req.Action {
+	case "get_api_token":
+	case "login":
+		if !s.tokens[r.Header.Get("X-Access-Key")] {
+			writeJSON(w, http.StatusOK, Response{"return": false, "reason": "Invalid API token."})
+			return
+		}
+	default:
+		if !s.cids[req.Params.Get("CID")] {
+			writeJSON(w, http.StatusOK, Response{"return": false, "reason": "CID is invalid or expired."})
+			return
+		}
+	}
+
+	fn, ok := s.actions[req.Action]
+	if !ok {
+		writeJSON(w, http.StatusOK, Response{"return": false, "reason": fmt.Sprintf("Valid action required: %s is not supported by the fake controller", req.Action)})
+		return
+	}
+
+	results, err := fn(req)
+	if req.Params.Get("async") == "true" {
+		requestID := s.newID("request-")
+		s.tasks[requestID] = &task{results: results, err: err}
+		writeJSON(w, http.StatusOK, Response{"return": true, "results": requestID})
+		return
+	}
+	writeActionResult(w, results, err)
+}
+
+func (s *Server) serveREST(w http.ResponseWriter, r *http.Request, req *Request) {
+	if !s.cids[strings.TrimPrefix(r.Header.Get("Authorization"), "cid ")] {
+		writeJSON(w, http.StatusForbidden, map[string]string{"message": "Invalid CID"})
+		return
+	}
+
+	path := strings.TrimPrefix(req.Path, "/v2.5/api/")
+	fn, ok := s.rest[req.Method+" "+path]
+	if !ok {
+		if i := strings.LastIndex(path, "/"); i >= 0 {
+			fn, ok = s.rest[req.Method+" "+path[:i]+"/*"]
+		}
+	}
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": fmt.Sprintf("%s %s is not supported by the fake controller", req.Method, path)})
+		return
+	}
+
+	status, v := fn(req)
+	if status >= http.StatusMultipleChoices {
+		writeJSON(w, status, map[string]interface{}{"message": v})
+		return
+	}
+	writeJSON(w, status, v)
+}
+
+func (s *Server) registerSession() {
+	s.actions["get_api_token"] = func(req *Request) (interface{}, error) {
+		token := s.newID("token-")
+		s.tokens[token] = true
+		return map[string]string{"api_token": token}, nil
+	}
+	s.actions["login"] = func(req *Request) (interface{}, error) {
+		if req.Params.Get("username") != s.Username || req.Params.Get("password") != s.Password {
+			return nil, fmt.Errorf("Invalid username or password.")
+		}
+		cid := s.newID("cid-")
+		s.cids[cid] = true
+		return Response{"return": true, "results": "User login: " + s.Username + " in account: admin has been authorized successfully.", "CID": cid}, nil
+	}
+	s.actions["check_task_status"] = func(req *Request) (interface{}, error) {
+		t, ok := s.tasks[req.Params.Get("request_id")]
+		if !ok {
+			return nil, fmt.Errorf("request ID %s does not exist", req.Params.Get("request_id"))
+		}
+		if t.polls < s.PendingPolls {
+			t.polls++
+			return Response{"return": false, "reason": "REQUEST_IN_PROGRESS"}, nil
+		}
+		if t.err != nil {
+			return nil, t.err
+		}
+		// The client expects the results of a finished task to be a string.
+		if results, ok := t.results.(string); ok {
+			return results, nil
+		}
+		return "Task completed successfully.", nil
+	}
+	s.actions["list_version_info"] = func(req *Request) (interface{}, error) {
+		return map[string]string{
+			"current_version":  s.Version,
+			"previous_version": s.Version,
+		}, nil
+	}
+	s.actions["get_private_mode_info"] = func(req *Request) (interface{}, error) {
+		return map[string]interface{}{
+			"contents": map[string]interface{}{"private_mode_enabled": false},
+		}, nil
+	}
+}
+
+// parseRequest reads the action parameters of r. The client sends the body of
+// some GET requests too, so the body is always read.
+func parseRequest(r *http.Request) (*Request, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	params := r.URL.Query()
+
+	mediaType, mediaParams, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range values {
+			params[k] = append(params[k], v...)
+		}
+	case "multipart/form-data":
+		form, err := multipart.NewReader(bytes.NewReader(body), mediaParams["boundary"]).ReadForm(32 << 20)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range form.Value {
+			params[k] = append(params[k], v...)
+		}
+		// Uploaded files are passed to handlers as their contents.
+		for k, files := range form.File {
+			for _, fh := range files {
+				f, err := fh.Open()
+				if err != nil {
+					return nil, err
+				}
+				contents, err := io.ReadAll(f)
+				f.Close()
+				if err != nil {
+					return nil, err
+				}
+				params.Add(k, string(contents))
+			}
+		}
+	case "application/json":
+		if strings.HasPrefix(r.URL.Path, "/v2.5/") {
+			break
+		}
+		var m map[string]interface{}
+		if err := json.Unmarshal(body, &m); err != nil {
+			return nil, err
+		}
+		for k, v := range m {
+			switch v := v.(type) {
+			case nil:
+			case string:
+				params.Add(k, v)
+			case bool, float64:
+				params.Add(k, fmt.Sprint(v))
+			default:
+				b, _ := json.Marshal(v)
+				params.Add(k, string(b))
+			}
+		}
+	}
+
+	return &Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Action: params.Get("action"),
+		Params: params,
+		Body:   body,
+	}, nil
+}
+
+func writeActionResult(w http.ResponseWriter, results interface{}, err error) {
+	if err != nil {
+		writeJSON(w, http.StatusOK, Response{"return": false, "reason": err.Error()})
+		return
+	}
+	if resp, ok := results.(Response); ok {
+		writeJSON(w, http.StatusOK, resp)
+		return
+	}
+	writeJSON(w, http.StatusOK, Response{"return": true, "results": results})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
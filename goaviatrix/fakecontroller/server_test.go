@@ -0,0 +1,310 @@
+package fakecontroller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+func newTestClient(t *testing.T) (*Server, *goaviatrix.Client) {
+	srv := New()
+	t.Cleanup(srv.Close)
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatalf("login to fake controller failed: %v", err)
+	}
+	return srv, client
+}
+
+func TestLogin(t *testing.T) {
+	srv := New()
+	defer srv.Close()
+
+	_, err := goaviatrix.NewClient(srv.Username, "wrong", srv.Host(), srv.Client(), nil)
+	assert.ErrorContains(t, err, "Invalid username or password")
+
+	client, err := srv.NewClient()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, client.CID)
+
+	// An expired session is renewed transparently by the client.
+	srv.ExpireSessions()
+	_, err = client.ListAccounts()
+	assert.NoError(t, err)
+	srv.ExpireSessions()
+	_, err = client.GetSmartGroups(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"get_api_token", "login", // wrong password
+		"get_api_token", "login",
+		"list_accounts", "get_api_token", "login", "list_accounts",
+		"get_api_token", "login", // 403 from app-domains
+	}, srv.Actions())
+}
+
+func TestLoginWithAPIToken(t *testing.T) {
+	srv := New()
+	defer srv.Close()
+
+	client, err := goaviatrix.NewClient("", "", srv.Host(), srv.Client(), nil, goaviatrix.WithAPIToken(srv.IssueAPIToken()))
+	assert.NoError(t, err)
+	_, err = client.ListAccounts()
+	assert.NoError(t, err)
+
+	// Without a username and password the expired token can't be renewed.
+	srv.ExpireSessions()
+	client.InvalidateCache()
+	_, err = client.ListAccounts()
+	assert.ErrorContains(t, err, goaviatrix.ErrAPITokenExpired.Error())
+
+	// With a password source the client logs in again once the token expires.
+	reads := 0
+	client, err = srv.NewClient(goaviatrix.WithAPIToken(srv.IssueAPIToken()), goaviatrix.WithPasswordSource(func() (string, error) {
+		reads++
+		return srv.Password, nil
+	}))
+	assert.NoError(t, err)
+	client.Password = ""
+	srv.ExpireSessions()
+	_, err = client.ListAccounts()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reads)
+	assert.Equal(t, []string{
+		"list_accounts", "list_accounts",
+		"list_accounts", "get_api_token", "login", "list_accounts",
+	}, srv.Actions())
+}
+
+func TestAccounts(t *testing.T) {
+	srv, client := newTestClient(t)
+
+	account := &goaviatrix.Account{
+		AccountName:      "aws-account",
+		CloudType:        goaviatrix.AWS,
+		AwsAccountNumber: "123456789012",
+		AwsAccessKey:     "access-key",
+		AwsSecretKey:     "secret-key",
+	}
+	assert.NoError(t, client.CreateAccount(account))
+	err := client.CreateAccount(account)
+	assert.ErrorAs(t, err, &goaviatrix.DuplicateError{})
+
+	client.InvalidateCache()
+	acc, err := client.GetAccount(&goaviatrix.Account{AccountName: "aws-account"})
+	assert.NoError(t, err)
+	assert.Equal(t, goaviatrix.AWS, acc.CloudType)
+	assert.Equal(t, "123456789012", acc.AwsAccountNumber)
+	assert.NoError(t, client.AuditAccount(context.Background(), account))
+
+	account.AwsAccountNumber = "210987654321"
+	assert.NoError(t, client.UpdateAccount(account))
+	stored, ok := srv.Account("aws-account")
+	assert.True(t, ok)
+	assert.Equal(t, "210987654321", stored.AwsAccountNumber)
+
+	assert.NoError(t, client.DeleteAccount(account))
+	client.InvalidateCache()
+	_, err = client.GetAccount(&goaviatrix.Account{AccountName: "aws-account"})
+	assert.Equal(t, goaviatrix.ErrNotFound, err)
+}
+
+func TestVpcs(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.AddAccount(goaviatrix.Account{AccountName: "aws-account", CloudType: goaviatrix.AWS})
+
+	vpc := &goaviatrix.Vpc{
+		CloudType:          goaviatrix.AWS,
+		AccountName:        "aws-account",
+		Region:             "us-west-1",
+		Name:               "spoke-vpc",
+		Cidr:               "10.1.0.0/16",
+		AviatrixTransitVpc: "no",
+		AviatrixFireNetVpc: "yes",
+	}
+	assert.NoError(t, client.CreateVpc(vpc))
+
+	got, err := client.GetVpc(&goaviatrix.Vpc{Name: "spoke-vpc"})
+	assert.NoError(t, err)
+	assert.Equal(t, "10.1.0.0/16", got.Cidr)
+	assert.Equal(t, "yes", got.AviatrixFireNetVpc)
+	assert.Regexp(t, "^vpc-", got.VpcID)
+
+	cloudType, err := client.GetVpcCloudTypeById(got.VpcID)
+	assert.NoError(t, err)
+	assert.Equal(t, goaviatrix.AWS, cloudType)
+
+	tracker, err := client.GetVpcTracker()
+	assert.NoError(t, err)
+	if assert.Len(t, tracker, 1) {
+		assert.Equal(t, &goaviatrix.VpcTracker{
+			CloudType:   goaviatrix.AWS,
+			AccountName: "aws-account",
+			Region:      "us-west-1",
+			Name:        "spoke-vpc",
+			Cidr:        "10.1.0.0/16",
+			VpcID:       got.VpcID,
+		}, tracker[0])
+	}
+
+	assert.NoError(t, client.DeleteVpc(vpc))
+	_, err = client.GetVpc(&goaviatrix.Vpc{Name: "spoke-vpc"})
+	assert.Equal(t, goaviatrix.ErrNotFound, err)
+}
+
+func TestGatewaysAndAttachments(t *testing.T) {
+	srv, client := newTestClient(t)
+	srv.PendingPolls = 2
+	srv.AddAccount(goaviatrix.Account{AccountName: "aws-account", CloudType: goaviatrix.AWS})
+	srv.AddGateway(goaviatrix.Gateway{GwName: "transit-gw", CloudType: goaviatrix.AWS, AccountName: "aws-account", TransitVpc: "yes"})
+
+	spoke := &goaviatrix.SpokeVpc{
+		CloudType:   goaviatrix.AWS,
+		AccountName: "aws-account",
+		GwName:      "spoke-gw",
+		VpcID:       "vpc-0123",
+		VpcRegion:   "us-west-1",
+		VpcSize:     "t3.small",
+		Subnet:      "10.1.0.0/24",
+	}
+	assert.NoError(t, client.LaunchSpokeVpc(spoke))
+
+	gw, err := client.GetGateway(&goaviatrix.Gateway{GwName: "spoke-gw"})
+	assert.NoError(t, err)
+	assert.Equal(t, "vpc-0123", gw.VpcID)
+	assert.Equal(t, "t3.small", gw.GwSize)
+	assert.NotEmpty(t, gw.PublicIP)
+
+	assert.NoError(t, client.DisableSingleAZGateway(&goaviatrix.Gateway{GwName: "spoke-gw"}))
+	assert.NoError(t, client.SetGatewayBgpCommunitiesSend("spoke-gw", true))
+	accept, send, err := client.GetGatewayBgpCommunities("spoke-gw")
+	assert.NoError(t, err)
+	assert.False(t, accept)
+	assert.True(t, send)
+	stored, _ := srv.Gateway("spoke-gw")
+	assert.Equal(t, "no", stored.SingleAZ)
+
+	transits, err := client.GetTransitGatewayList(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, transits, 1)
+
+	attachment := &goaviatrix.SpokeTransitAttachment{SpokeGwName: "spoke-gw", TransitGwName: "transit-gw"}
+	assert.NoError(t, client.CreateSpokeTransitAttachment(context.Background(), attachment))
+	_, err = client.GetSpokeTransitAttachment(&goaviatrix.SpokeTransitAttachment{SpokeGwName: "spoke-gw", TransitGwName: "transit-gw"})
+	assert.NoError(t, err)
+	assert.ErrorContains(t, client.DeleteGateway(&goaviatrix.Gateway{GwName: "transit-gw"}), "is attached to spoke gateway spoke-gw")
+
+	assert.NoError(t, client.DeleteSpokeTransitAttachment(attachment))
+	_, err = client.GetSpokeTransitAttachment(&goaviatrix.SpokeTransitAttachment{SpokeGwName: "spoke-gw", TransitGwName: "transit-gw"})
+	assert.Equal(t, goaviatrix.ErrNotFound, err)
+
+	assert.NoError(t, client.DeleteGateway(&goaviatrix.Gateway{GwName: "spoke-gw", CloudType: goaviatrix.AWS}))
+	_, err = client.GetGateway(&goaviatrix.Gateway{GwName: "spoke-gw"})
+	assert.Equal(t, goaviatrix.ErrNotFound, err)
+}
+
+func TestFQDNTags(t *testing.T) {
+	srv, client := newTestClient(t)
+	ctx := context.Background()
+
+	srv.AddFQDNTag(goaviatrix.FQDN{
+		FQDNTag:    "egress",
+		FQDNMode:   "white",
+		FQDNStatus: "enabled",
+		DomainList: []*goaviatrix.Filters{{FQDN: "*.example.com", Protocol: "tcp", Port: "443", Verdict: "Allow"}},
+		GwFilterTagList: []goaviatrix.GwFilterTag{
+			{Name: "spoke-gw", SourceIPList: []string{"10.1.0.0/24"}},
+		},
+	})
+
+	tag, err := client.GetFQDNTagContext(ctx, &goaviatrix.FQDN{FQDNTag: "egress"})
+	assert.NoError(t, err)
+	assert.Equal(t, "white", tag.FQDNMode)
+	assert.Equal(t, "enabled", tag.FQDNStatus)
+
+	tag, err = client.ListDomainsContext(ctx, tag)
+	assert.NoError(t, err)
+	assert.Equal(t, []*goaviatrix.Filters{{FQDN: "*.example.com", Protocol: "tcp", Port: "443", Verdict: "Allow"}}, tag.DomainList)
+
+	tag, err = client.GetGwFilterTagListContext(ctx, tag)
+	assert.NoError(t, err)
+	assert.Equal(t, []goaviatrix.GwFilterTag{{Name: "spoke-gw", SourceIPList: []string{"10.1.0.0/24"}}}, tag.GwFilterTagList)
+
+	_, err = client.ListDomainsContext(ctx, &goaviatrix.FQDN{FQDNTag: "ingress"})
+	assert.ErrorContains(t, err, "Tag ingress does not exist")
+}
+
+func TestSmartGroupsAndPolicyList(t *testing.T) {
+	srv, client := newTestClient(t)
+	ctx := context.Background()
+
+	smartGroup := &goaviatrix.SmartGroup{
+		Name: "web",
+		Selector: goaviatrix.SmartGroupSelector{
+			Expressions: []*goaviatrix.SmartGroupMatchExpression{{CIDR: "10.0.0.0/16"}},
+		},
+	}
+	uuid, err := client.CreateSmartGroup(ctx, smartGroup)
+	assert.NoError(t, err)
+
+	got, err := client.GetSmartGroup(ctx, uuid)
+	assert.NoError(t, err)
+	assert.Equal(t, "web", got.Name)
+	assert.Equal(t, "10.0.0.0/16", got.Selector.Expressions[0].CIDR)
+
+	smartGroup.Name = "web-tier"
+	assert.NoError(t, client.UpdateSmartGroup(ctx, smartGroup, uuid))
+	stored, ok := srv.SmartGroup(uuid)
+	assert.True(t, ok)
+	assert.Equal(t, "web-tier", stored.Name)
+
+	policyList := &goaviatrix.DistributedFirewallingPolicyList{
+		Policies: []goaviatrix.DistributedFirewallingPolicy{{
+			Name:           "allow-web",
+			Action:         "PERMIT",
+			Priority:       1,
+			Protocol:       "TCP",
+			SrcSmartGroups: []string{uuid},
+			DstSmartGroups: []string{uuid},
+		}},
+	}
+	assert.NoError(t, client.CreateDistributedFirewallingPolicyList(ctx, policyList))
+	gotList, err := client.GetDistributedFirewallingPolicyList(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "allow-web", gotList.Policies[0].Name)
+	assert.NotEmpty(t, gotList.Policies[0].UUID)
+
+	assert.NoError(t, client.DeleteDistributedFirewallingPolicyList(ctx))
+	_, err = client.GetDistributedFirewallingPolicyList(ctx)
+	assert.Equal(t, goaviatrix.ErrNotFound, err)
+
+	assert.NoError(t, client.DeleteSmartGroup(ctx, uuid))
+	_, err = client.GetSmartGroup(ctx, uuid)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestHandlers(t *testing.T) {
+	srv, client := newTestClient(t)
+	ctx := context.Background()
+
+	gw := &goaviatrix.Gateway{GwName: "gw"}
+	_, err := client.GetJumboFrameStatus(gw)
+	assert.ErrorContains(t, err, "get_jumbo_frame_status is not supported by the fake controller")
+
+	srv.HandleAction("get_jumbo_frame_status", func(req *Request) (interface{}, error) {
+		return "Jumbo frame is enabled on " + req.Params.Get("gateway_name"), nil
+	})
+	enabled, err := client.GetJumboFrameStatus(gw)
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	srv.HandleREST("GET", "app-domains/*", func(req *Request) (int, interface{}) {
+		return http.StatusInternalServerError, fmt.Sprintf("no smart group at %s", req.Path)
+	})
+	_, err = client.GetSmartGroup(ctx, "some-uuid")
+	assert.ErrorContains(t, err, "no smart group at /v2.5/api/app-domains/some-uuid")
+}
@@ -0,0 +1,201 @@
+package fakecontroller
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/AviatrixSystems/terraform-provider-aviatrix/v3/goaviatrix"
+)
+
+// AddVpc stores a VPC as if it had been created by the controller.
+func (s *Server) AddVpc(vpc goaviatrix.VpcEdit) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.vpcs[vpc.Name] = &vpc
+}
+
+// Vpc returns the stored VPC with the given name.
+func (s *Server) Vpc(name string) (goaviatrix.VpcEdit, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	vpc, ok := s.vpcs[name]
+	if !ok {
+		return goaviatrix.VpcEdit{}, false
+	}
+	return *vpc, true
+}
+
+func (s *Server) registerVpcs() {
+	s.actions["create_custom_vpc"] = func(req *Request) (interface{}, error) {
+		p := req.Params
+		name := p.Get("pool_name")
+		if name == "" {
+			return nil, fmt.Errorf("pool_name is required")
+		}
+		if _, ok := s.vpcs[name]; ok {
+			return nil, fmt.Errorf("VPC %s already exists", name)
+		}
+		cloudType, err := strconv.Atoi(p.Get("cloud_type"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cloud_type %q", p.Get("cloud_type"))
+		}
+		if _, ok := s.accounts[p.Get("account_name")]; !ok {
+			return nil, fmt.Errorf("Account %s does not exist", p.Get("account_name"))
+		}
+
+		vpc := &goaviatrix.VpcEdit{
+			CloudType:              cloudType,
+			AccountName:            p.Get("account_name"),
+			Region:                 p.Get("region"),
+			Name:                   name,
+			Cidr:                   p.Get("vpc_cidr"),
+			AviatrixTransitVpc:     p.Get("aviatrix_transit_vpc") == "yes",
+			AviatrixFireNetVpc:     p.Get("aviatrix_firenet_vpc") == "yes",
+			EnablePrivateOobSubnet: p.Get("private_oob_subnet") == "true",
+			PrivateModeSubnets:     p.Get("private_mode_subnets") == "true",
+			EnableIpv6:             p.Get("enable_ipv6") == "true",
+			VpcIpv6Cidr:            p.Get("vpc_ipv6_cidr"),
+		}
+		vpc.SubnetSize, _ = strconv.Atoi(p.Get("subnet_size"))
+		vpc.NumOfSubnetPairs, _ = strconv.Atoi(p.Get("num_of_zones"))
+		if vpc.NumOfSubnetPairs == 0 {
+			vpc.NumOfSubnetPairs, _ = strconv.Atoi(p.Get("num_of_subnets"))
+		}
+		if subnets := p.Get("subnet_list"); subnets != "" {
+			if err := json.Unmarshal([]byte(subnets), &vpc.Subnets); err != nil {
+				return nil, fmt.Errorf("invalid subnet_list: %v", err)
+			}
+		}
+
+		switch {
+		case goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes):
+			vpc.VpcID = []string{name}
+		case goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes):
+			resourceGroup := p.Get("resource_group")
+			if resourceGroup == "" {
+				resourceGroup = "rg-" + name
+			}
+			vpc.VpcID = []string{name + ":" + resourceGroup + ":" + s.newID("")}
+		default:
+			vpc.VpcID = []string{s.newID("vpc-")}
+		}
+
+		s.vpcs[name] = vpc
+		return fmt.Sprintf("VPC %s has been created.", name), nil
+	}
+	s.actions["get_custom_vpc_by_name"] = func(req *Request) (interface{}, error) {
+		name := req.Params.Get("vpc_name")
+		vpc, ok := s.vpcs[name]
+		if !ok {
+			return nil, fmt.Errorf("VPC %s does not exist", name)
+		}
+		return vpc, nil
+	}
+	s.actions["list_custom_vpcs"] = func(req *Request) (interface{}, error) {
+		vpcs := make([]goaviatrix.VpcEdit, 0, len(s.vpcs))
+		for _, vpc := range s.vpcs {
+			vpcs = append(vpcs, *vpc)
+		}
+		sort.Slice(vpcs, func(i, j int) bool {
+			return vpcs[i].Name < vpcs[j].Name
+		})
+		return goaviatrix.AllVpcPoolVpcListResp{AllVpcPoolVpcList: vpcs}, nil
+	}
+	s.actions["cloud_network_info"] = func(req *Request) (interface{}, error) {
+		items := make([]goaviatrix.VPCTrackerItemResp, 0, len(s.vpcs))
+		for _, vpc := range s.vpcs {
+			item := goaviatrix.VPCTrackerItemResp{
+				VendorName:  vendorName(vpc.CloudType),
+				VpcID:       vpc.VpcID[0],
+				AccountName: vpc.AccountName,
+				VpcName:     vpc.Name,
+				Region:      vpc.Region,
+			}
+			if vpc.Cidr != "" {
+				item.CIDRs = []string{vpc.Cidr}
+			}
+			instances := 0
+			for _, gw := range s.gateways {
+				if gw.VpcID == vpc.VpcID[0] {
+					instances++
+				}
+			}
+			item.InstanceCount = instances
+			items = append(items, item)
+		}
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].VpcName < items[j].VpcName
+		})
+		return items, nil
+	}
+	s.actions["list_vpc_route_tables"] = func(req *Request) (interface{}, error) {
+		vpcID := req.Params.Get("vpc_id")
+		for _, vpc := range s.vpcs {
+			if vpc.VpcID[0] != vpcID {
+				continue
+			}
+			// Every VPC gets one public and one private route table.
+			routeTables := []string{"rtb-" + vpcID + "-public~~" + vpc.Name + "-public"}
+			if req.Params.Get("public_only") != "true" {
+				routeTables = append(routeTables, "rtb-"+vpcID+"-private~~"+vpc.Name+"-private")
+			}
+			return map[string][]string{"vpc_rtbs_list": routeTables}, nil
+		}
+		return nil, fmt.Errorf("VPC %s does not exist", vpcID)
+	}
+	s.actions["show_firenet_detail"] = func(req *Request) (interface{}, error) {
+		vpcID := req.Params.Get("vpc_id")
+		for _, vpc := range s.vpcs {
+			if vpc.VpcID[0] == vpcID && vpc.AviatrixFireNetVpc {
+				return goaviatrix.FireNetDetail{
+					CloudType: strconv.Itoa(vpc.CloudType),
+					Region:    vpc.Region,
+					VpcID:     vpcID,
+				}, nil
+			}
+		}
+		return nil, fmt.Errorf("FireNet VPC %s not found in DB", vpcID)
+	}
+	s.actions["delete_custom_vpc"] = func(req *Request) (interface{}, error) {
+		name := req.Params.Get("pool_name")
+		vpc, ok := s.vpcs[name]
+		if !ok || vpc.AccountName != req.Params.Get("account_name") {
+			return nil, fmt.Errorf("VPC %s does not exist", name)
+		}
+		for _, gw := range s.gateways {
+			if gw.VpcID == vpc.VpcID[0] {
+				return nil, fmt.Errorf("VPC %s is in use by gateway %s", name, gw.GwName)
+			}
+		}
+		delete(s.vpcs, name)
+		return fmt.Sprintf("VPC %s has been deleted.", name), nil
+	}
+}
+
+// vendorName returns the shorthand vendor name the VPC tracker reports for
+// cloudType.
+func vendorName(cloudType int) string {
+	switch cloudType {
+	case goaviatrix.AWS:
+		return goaviatrix.ShorthandAWSVendorName
+	case goaviatrix.GCP:
+		return goaviatrix.ShorthandGOOGLEVendorName
+	case goaviatrix.Azure:
+		return goaviatrix.ShorthandAzureARMVendorName
+	case goaviatrix.OCI:
+		return goaviatrix.ShorthandOracleVendorName
+	case goaviatrix.AzureGov:
+		return goaviatrix.ShorthandARMGovVendorName
+	case goaviatrix.AWSGov:
+		return goaviatrix.ShorthandAWSGovVendorName
+	case goaviatrix.AWSChina:
+		return goaviatrix.ShorthandAWSChinaVendorName
+	case goaviatrix.AzureChina:
+		return goaviatrix.ShorthandAzureARMChinaVendorName
+	case goaviatrix.AliCloud:
+		return goaviatrix.ShorthandAliYunVendorName
+	}
+	return ""
+}
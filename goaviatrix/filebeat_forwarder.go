@@ -1,5 +1,7 @@
 package goaviatrix
 
+import "context"
+
 type FilebeatForwarderResp struct {
 	Server           string   `json:"server"`
 	Port             string   `json:"port"`
@@ -8,6 +10,10 @@ type FilebeatForwarderResp struct {
 }
 
 func (c *Client) GetFilebeatForwarderStatus() (*FilebeatForwarderResp, error) {
+	return c.GetFilebeatForwarderStatusContext(context.Background())
+}
+
+func (c *Client) GetFilebeatForwarderStatusContext(ctx context.Context) (*FilebeatForwarderResp, error) {
 	params := map[string]string{
 		"action": "get_logstash_logging_status",
 		"CID":    c.CID,
@@ -21,7 +27,7 @@ func (c *Client) GetFilebeatForwarderStatus() (*FilebeatForwarderResp, error) {
 
 	var data Resp
 
-	err := c.GetAPI(&data, params["action"], params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -34,10 +40,14 @@ func (c *Client) GetFilebeatForwarderStatus() (*FilebeatForwarderResp, error) {
 }
 
 func (c *Client) DisableFilebeatForwarder() error {
+	return c.DisableFilebeatForwarderContext(context.Background())
+}
+
+func (c *Client) DisableFilebeatForwarderContext(ctx context.Context) error {
 	params := map[string]string{
 		"action": "disable_logstash_logging",
 		"CID":    c.CID,
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -73,6 +74,10 @@ func (c *Client) CreateFireNet(fireNet *FireNet) error {
 }
 
 func (c *Client) GetFireNet(fireNet *FireNet) (*FireNetDetail, error) {
+	return c.GetFireNetContext(context.Background(), fireNet)
+}
+
+func (c *Client) GetFireNetContext(ctx context.Context, fireNet *FireNet) (*FireNetDetail, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "show_firenet_detail",
@@ -91,7 +96,7 @@ func (c *Client) GetFireNet(fireNet *FireNet) (*FireNetDetail, error) {
 		return nil
 	}
 
-	err := c.GetAPI(&data, form["action"], form, checkFunc)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, checkFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +109,10 @@ func (c *Client) GetFireNet(fireNet *FireNet) (*FireNetDetail, error) {
 }
 
 func (c *Client) AssociateFirewallWithFireNet(firewallInstance *FirewallInstance) error {
+	return c.AssociateFirewallWithFireNetContext(context.Background(), firewallInstance)
+}
+
+func (c *Client) AssociateFirewallWithFireNetContext(ctx context.Context, firewallInstance *FirewallInstance) error {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "associate_firewall_with_firenet",
@@ -131,10 +140,14 @@ func (c *Client) AssociateFirewallWithFireNet(firewallInstance *FirewallInstance
 		return nil
 	}
 
-	return c.PostAPI(form["action"], form, checkFunc)
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
 }
 
 func (c *Client) DisassociateFirewallFromFireNet(firewallInstance *FirewallInstance) error {
+	return c.DisassociateFirewallFromFireNetContext(context.Background(), firewallInstance)
+}
+
+func (c *Client) DisassociateFirewallFromFireNetContext(ctx context.Context, firewallInstance *FirewallInstance) error {
 	form := map[string]string{
 		"CID":         c.CID,
 		"action":      "disassociate_firewall_with_firenet",
@@ -152,10 +165,14 @@ func (c *Client) DisassociateFirewallFromFireNet(firewallInstance *FirewallInsta
 		return nil
 	}
 
-	return c.PostAPI(form["action"], form, checkFunc)
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
 }
 
 func (c *Client) AttachFirewallToFireNet(firewallInstance *FirewallInstance) error {
+	return c.AttachFirewallToFireNetContext(context.Background(), firewallInstance)
+}
+
+func (c *Client) AttachFirewallToFireNetContext(ctx context.Context, firewallInstance *FirewallInstance) error {
 	form := map[string]string{
 		"CID":         c.CID,
 		"action":      "attach_firewall_to_firenet",
@@ -163,7 +180,7 @@ func (c *Client) AttachFirewallToFireNet(firewallInstance *FirewallInstance) err
 		"firewall_id": firewallInstance.InstanceID,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DetachFirewallFromFireNet(firewallInstance *FirewallInstance) error {
@@ -178,6 +195,10 @@ func (c *Client) DetachFirewallFromFireNet(firewallInstance *FirewallInstance) e
 }
 
 func (c *Client) ConnectFireNetWithTgw(awsTgw *AWSTgw, vpcSolo VPCSolo, SecurityDomainName string) error {
+	return c.ConnectFireNetWithTgwContext(context.Background(), awsTgw, vpcSolo, SecurityDomainName)
+}
+
+func (c *Client) ConnectFireNetWithTgwContext(ctx context.Context, awsTgw *AWSTgw, vpcSolo VPCSolo, SecurityDomainName string) error {
 	form := map[string]string{
 		"CID":         c.CID,
 		"action":      "connect_firenet_with_tgw",
@@ -187,10 +208,14 @@ func (c *Client) ConnectFireNetWithTgw(awsTgw *AWSTgw, vpcSolo VPCSolo, Security
 		"async":       "true",
 	}
 
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DisconnectFireNetFromTgw(awsTgw *AWSTgw, vpcID string) error {
+	return c.DisconnectFireNetFromTgwContext(context.Background(), awsTgw, vpcID)
+}
+
+func (c *Client) DisconnectFireNetFromTgwContext(ctx context.Context, awsTgw *AWSTgw, vpcID string) error {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "disconnect_firenet_with_tgw",
@@ -198,10 +223,14 @@ func (c *Client) DisconnectFireNetFromTgw(awsTgw *AWSTgw, vpcID string) error {
 		"async":  "true",
 	}
 
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EditFireNetInspection(fireNet *FireNet) error {
+	return c.EditFireNetInspectionContext(context.Background(), fireNet)
+}
+
+func (c *Client) EditFireNetInspectionContext(ctx context.Context, fireNet *FireNet) error {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "edit_firenet",
@@ -219,10 +248,14 @@ func (c *Client) EditFireNetInspection(fireNet *FireNet) error {
 		return nil
 	}
 
-	return c.PostAPI(form["action"], form, checkFunc)
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
 }
 
 func (c *Client) EditFireNetEgress(fireNet *FireNet) error {
+	return c.EditFireNetEgressContext(context.Background(), fireNet)
+}
+
+func (c *Client) EditFireNetEgressContext(ctx context.Context, fireNet *FireNet) error {
 	form := map[string]string{
 		"CID":             c.CID,
 		"action":          "edit_firenet",
@@ -240,10 +273,14 @@ func (c *Client) EditFireNetEgress(fireNet *FireNet) error {
 		return nil
 	}
 
-	return c.PostAPI(form["action"], form, checkFunc)
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
 }
 
 func (c *Client) EditFireNetHashingAlgorithm(fireNet *FireNet) error {
+	return c.EditFireNetHashingAlgorithmContext(context.Background(), fireNet)
+}
+
+func (c *Client) EditFireNetHashingAlgorithmContext(ctx context.Context, fireNet *FireNet) error {
 	data := map[string]string{
 		"action":           "edit_firenet",
 		"CID":              c.CID,
@@ -261,30 +298,42 @@ func (c *Client) EditFireNetHashingAlgorithm(fireNet *FireNet) error {
 		return nil
 	}
 
-	return c.PostAPI("edit_firenet", data, checkFunc)
+	return c.PostAPIContext(ctx, "edit_firenet", data, checkFunc)
 }
 
 func (c *Client) EnableTgwSegmentationForEgress(net *FireNet) error {
+	return c.EnableTgwSegmentationForEgressContext(context.Background(), net)
+}
+
+func (c *Client) EnableTgwSegmentationForEgressContext(ctx context.Context, net *FireNet) error {
 	data := map[string]string{
 		"action": "enable_firenet_tgw_segmentation_for_egress",
 		"CID":    c.CID,
 		"vpc_id": net.VpcID,
 	}
 
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) DisableTgwSegmentationForEgress(net *FireNet) error {
+	return c.DisableTgwSegmentationForEgressContext(context.Background(), net)
+}
+
+func (c *Client) DisableTgwSegmentationForEgressContext(ctx context.Context, net *FireNet) error {
 	data := map[string]string{
 		"action": "disable_firenet_tgw_segmentation_for_egress",
 		"CID":    c.CID,
 		"vpc_id": net.VpcID,
 	}
 
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) EditFirenetEgressStaticCidr(net *FireNet) error {
+	return c.EditFirenetEgressStaticCidrContext(context.Background(), net)
+}
+
+func (c *Client) EditFirenetEgressStaticCidrContext(ctx context.Context, net *FireNet) error {
 	data := map[string]string{
 		"action":             "edit_firenet_egress_static_cidr",
 		"CID":                c.CID,
@@ -292,10 +341,14 @@ func (c *Client) EditFirenetEgressStaticCidr(net *FireNet) error {
 		"egress_static_cidr": net.EgressStaticCidrs,
 	}
 
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) EditFirenetExcludedCidr(net *FireNet) error {
+	return c.EditFirenetExcludedCidrContext(context.Background(), net)
+}
+
+func (c *Client) EditFirenetExcludedCidrContext(ctx context.Context, net *FireNet) error {
 	form := map[string]string{
 		"action":       "edit_firenet_excluded_cidr",
 		"CID":          c.CID,
@@ -311,5 +364,5 @@ func (c *Client) EditFirenetExcludedCidr(net *FireNet) error {
 		}
 		return nil
 	}
-	return c.PostAPI(form["action"], form, check)
+	return c.PostAPIContext(ctx, form["action"], form, check)
 }
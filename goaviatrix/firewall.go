@@ -1,14 +1,13 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 
-	"golang.org/x/net/context"
-
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Policy struct {
@@ -40,6 +39,10 @@ type FirewallResp struct {
 }
 
 func (c *Client) SetBasePolicy(firewall *Firewall) error {
+	return c.SetBasePolicyContext(context.Background(), firewall)
+}
+
+func (c *Client) SetBasePolicyContext(ctx context.Context, firewall *Firewall) error {
 	form := map[string]string{
 		"CID":                    c.CID,
 		"action":                 "set_vpc_base_policy",
@@ -48,10 +51,14 @@ func (c *Client) SetBasePolicy(firewall *Firewall) error {
 		"base_policy_log_enable": firewall.BaseLogEnabled,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) UpdatePolicy(firewall *Firewall) error {
+	return c.UpdatePolicyContext(context.Background(), firewall)
+}
+
+func (c *Client) UpdatePolicyContext(ctx context.Context, firewall *Firewall) error {
 	firewall.CID = c.CID
 	firewall.Action = "update_access_policy"
 	// If the PolicyList is nil it will be encoded as 'null'.
@@ -65,10 +72,14 @@ func (c *Client) UpdatePolicy(firewall *Firewall) error {
 	}
 	firewall.NewPolicy = string(args)
 
-	return c.PostAPI(firewall.Action, firewall, BasicCheck)
+	return c.PostAPIContext(ctx, firewall.Action, firewall, BasicCheck)
 }
 
 func (c *Client) GetPolicy(firewall *Firewall) (*Firewall, error) {
+	return c.GetPolicyContext(context.Background(), firewall)
+}
+
+func (c *Client) GetPolicyContext(ctx context.Context, firewall *Firewall) (*Firewall, error) {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "vpc_access_policy",
@@ -78,7 +89,7 @@ func (c *Client) GetPolicy(firewall *Firewall) (*Firewall, error) {
 	checkFunc := func(act, method, reason string, ret bool) error {
 		if !ret {
 			if strings.Contains(reason, "does not exist") {
-				log.Errorf("Couldn't find Aviatrix Firewall policies for gateway %s: %s", firewall.GwName, reason)
+				tflog.Error(ctx, "Couldn't find Aviatrix Firewall policies for gateway", map[string]interface{}{"gw_name": firewall.GwName, "reason": reason})
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
@@ -86,7 +97,7 @@ func (c *Client) GetPolicy(firewall *Firewall) (*Firewall, error) {
 		return nil
 	}
 	var data FirewallResp
-	err := c.GetAPI(&data, form["action"], form, checkFunc)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, checkFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +146,10 @@ func PolicyToMap(p *Policy) map[string]interface{} {
 }
 
 func (c *Client) AddFirewallPolicy(fw *Firewall) error {
+	return c.AddFirewallPolicyContext(context.Background(), fw)
+}
+
+func (c *Client) AddFirewallPolicyContext(ctx context.Context, fw *Firewall) error {
 	rules, err := json.Marshal(fw.PolicyList)
 	if err != nil {
 		return fmt.Errorf("could not marshal firewall policies: %v", err)
@@ -147,10 +162,14 @@ func (c *Client) AddFirewallPolicy(fw *Firewall) error {
 		"rules":        string(rules),
 	}
 
-	return c.PostAPIContext2(context.Background(), nil, form["action"].(string), form, BasicCheck)
+	return c.PostAPIContext2(ctx, nil, form["action"].(string), form, BasicCheck)
 }
 
 func (c *Client) DeleteFirewallPolicy(fw *Firewall) error {
+	return c.DeleteFirewallPolicyContext(context.Background(), fw)
+}
+
+func (c *Client) DeleteFirewallPolicyContext(ctx context.Context, fw *Firewall) error {
 	rules, err := json.Marshal(fw.PolicyList)
 	if err != nil {
 		return fmt.Errorf("could not marshal firewall policies: %v", err)
@@ -174,11 +193,15 @@ func (c *Client) DeleteFirewallPolicy(fw *Firewall) error {
 		return nil
 	}
 
-	return c.PostAPIContext2(context.Background(), nil, form["action"].(string), form, checkFunc)
+	return c.PostAPIContext2(ctx, nil, form["action"].(string), form, checkFunc)
 }
 
 func (c *Client) GetFirewallPolicy(fw *Firewall) (*Firewall, error) {
-	foundFirewall, err := c.GetPolicy(fw)
+	return c.GetFirewallPolicyContext(context.Background(), fw)
+}
+
+func (c *Client) GetFirewallPolicyContext(ctx context.Context, fw *Firewall) (*Firewall, error) {
+	foundFirewall, err := c.GetPolicyContext(ctx, fw)
 	if err != nil {
 		if err == ErrNotFound {
 			return nil, err
@@ -208,6 +231,10 @@ func (c *Client) GetFirewallPolicy(fw *Firewall) (*Firewall, error) {
 }
 
 func (c *Client) InsertFirewallPolicy(fw *Firewall) error {
+	return c.InsertFirewallPolicyContext(context.Background(), fw)
+}
+
+func (c *Client) InsertFirewallPolicyContext(ctx context.Context, fw *Firewall) error {
 	rules, err := json.Marshal(fw.PolicyList)
 	if err != nil {
 		return fmt.Errorf("could not marshal firewall policies: %v", err)
@@ -221,5 +248,5 @@ func (c *Client) InsertFirewallPolicy(fw *Firewall) error {
 		"position":     strconv.Itoa(fw.PolicyList[0].Position),
 	}
 
-	return c.PostAPIContext2(context.Background(), nil, form["action"].(string), form, BasicCheck)
+	return c.PostAPIContext2(ctx, nil, form["action"].(string), form, BasicCheck)
 }
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -91,6 +92,10 @@ type FirewallInstanceImage struct {
 }
 
 func (c *Client) CreateFirewallInstance(firewallInstance *FirewallInstance) (string, error) {
+	return c.CreateFirewallInstanceContext(context.Background(), firewallInstance)
+}
+
+func (c *Client) CreateFirewallInstanceContext(ctx context.Context, firewallInstance *FirewallInstance) (string, error) {
 	action := "add_firewall_instance"
 	form := map[string]string{
 		"CID":                    c.CID,
@@ -165,7 +170,7 @@ func (c *Client) CreateFirewallInstance(firewallInstance *FirewallInstance) (str
 	}
 
 	var data FirewallInstanceCreateResp
-	err := c.PostAPIWithResponse(&data, action, form, BasicCheck)
+	err := c.PostAPIContextWithResponse(ctx, &data, action, form, BasicCheck)
 	if err != nil {
 		return "", err
 	}
@@ -176,6 +181,10 @@ func (c *Client) CreateFirewallInstance(firewallInstance *FirewallInstance) (str
 }
 
 func (c *Client) GetFirewallInstance(firewallInstance *FirewallInstance) (*FirewallInstance, error) {
+	return c.GetFirewallInstanceContext(context.Background(), firewallInstance)
+}
+
+func (c *Client) GetFirewallInstanceContext(ctx context.Context, firewallInstance *FirewallInstance) (*FirewallInstance, error) {
 	form := map[string]string{
 		"CID":         c.CID,
 		"action":      "get_instance_by_id",
@@ -194,7 +203,7 @@ func (c *Client) GetFirewallInstance(firewallInstance *FirewallInstance) (*Firew
 		return nil
 	}
 
-	err := c.GetAPI(&data, form["action"], form, checkFunc)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, checkFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +223,10 @@ func (c *Client) GetFirewallInstance(firewallInstance *FirewallInstance) (*Firew
 }
 
 func (c *Client) DeleteFirewallInstance(firewallInstance *FirewallInstance) error {
+	return c.DeleteFirewallInstanceContext(context.Background(), firewallInstance)
+}
+
+func (c *Client) DeleteFirewallInstanceContext(ctx context.Context, firewallInstance *FirewallInstance) error {
 	form := map[string]string{
 		"CID":         c.CID,
 		"action":      "delete_firenet_firewall_instance",
@@ -222,10 +235,14 @@ func (c *Client) DeleteFirewallInstance(firewallInstance *FirewallInstance) erro
 		"async":       "true",
 	}
 
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetFirewallInstanceImages(vpcId string) (*[]FirewallInstanceImage, error) {
+	return c.GetFirewallInstanceImagesContext(context.Background(), vpcId)
+}
+
+func (c *Client) GetFirewallInstanceImagesContext(ctx context.Context, vpcId string) (*[]FirewallInstanceImage, error) {
 	form := map[string]string{
 		"CID":       c.CID,
 		"action":    "list_firenet",
@@ -235,7 +252,7 @@ func (c *Client) GetFirewallInstanceImages(vpcId string) (*[]FirewallInstanceIma
 
 	var data FirewallInstanceImagesResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -1,5 +1,7 @@
 package goaviatrix
 
+import "context"
+
 type FirewallManagementAccess struct {
 	CID                          string `form:"CID,omitempty"`
 	Action                       string `form:"action,omitempty"`
@@ -14,6 +16,10 @@ type FirewallManagementAccessAPIResp struct {
 }
 
 func (c *Client) CreateFirewallManagementAccess(firewallManagementAccess *FirewallManagementAccess) error {
+	return c.CreateFirewallManagementAccessContext(context.Background(), firewallManagementAccess)
+}
+
+func (c *Client) CreateFirewallManagementAccessContext(ctx context.Context, firewallManagementAccess *FirewallManagementAccess) error {
 	form := map[string]string{
 		"CID":               c.CID,
 		"action":            "edit_transit_firenet_management_access",
@@ -21,10 +27,14 @@ func (c *Client) CreateFirewallManagementAccess(firewallManagementAccess *Firewa
 		"management_access": firewallManagementAccess.ManagementAccessResourceName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetFirewallManagementAccess(firewallManagementAccess *FirewallManagementAccess) (*FirewallManagementAccess, error) {
+	return c.GetFirewallManagementAccessContext(context.Background(), firewallManagementAccess)
+}
+
+func (c *Client) GetFirewallManagementAccessContext(ctx context.Context, firewallManagementAccess *FirewallManagementAccess) (*FirewallManagementAccess, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_transit_firenet_spoke_policies",
@@ -32,7 +42,7 @@ func (c *Client) GetFirewallManagementAccess(firewallManagementAccess *FirewallM
 
 	var data FirewallManagementAccessAPIResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +65,10 @@ func (c *Client) GetFirewallManagementAccess(firewallManagementAccess *FirewallM
 }
 
 func (c *Client) DestroyFirewallManagementAccess(firewallManagementAccess *FirewallManagementAccess) error {
+	return c.DestroyFirewallManagementAccessContext(context.Background(), firewallManagementAccess)
+}
+
+func (c *Client) DestroyFirewallManagementAccessContext(ctx context.Context, firewallManagementAccess *FirewallManagementAccess) error {
 	form := map[string]string{
 		"CID":               c.CID,
 		"action":            "edit_transit_firenet_management_access",
@@ -62,5 +76,5 @@ func (c *Client) DestroyFirewallManagementAccess(firewallManagementAccess *Firew
 		"management_access": firewallManagementAccess.ManagementAccessResourceName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
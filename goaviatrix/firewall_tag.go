@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 )
 
@@ -24,13 +25,21 @@ type FirewallTagResp struct {
 }
 
 func (c *Client) CreateFirewallTag(firewall_tag *FirewallTag) error {
+	return c.CreateFirewallTagContext(context.Background(), firewall_tag)
+}
+
+func (c *Client) CreateFirewallTagContext(ctx context.Context, firewall_tag *FirewallTag) error {
 	firewall_tag.CID = c.CID
 	firewall_tag.Action = "add_policy_tag"
 
-	return c.PostAPI(firewall_tag.Action, firewall_tag, BasicCheck)
+	return c.PostAPIContext(ctx, firewall_tag.Action, firewall_tag, BasicCheck)
 }
 
 func (c *Client) UpdateFirewallTag(firewall_tag *FirewallTag) error {
+	return c.UpdateFirewallTagContext(context.Background(), firewall_tag)
+}
+
+func (c *Client) UpdateFirewallTagContext(ctx context.Context, firewall_tag *FirewallTag) error {
 	action := "update_policy_members"
 	form := map[string]string{
 		"CID":      c.CID,
@@ -47,10 +56,14 @@ func (c *Client) UpdateFirewallTag(firewall_tag *FirewallTag) error {
 	}
 	form["new_policies"] = string(args)
 
-	return c.PostAPI(action, form, BasicCheck)
+	return c.PostAPIContext(ctx, action, form, BasicCheck)
 }
 
 func (c *Client) GetFirewallTag(firewall_tag *FirewallTag) (*FirewallTag, error) {
+	return c.GetFirewallTagContext(context.Background(), firewall_tag)
+}
+
+func (c *Client) GetFirewallTagContext(ctx context.Context, firewall_tag *FirewallTag) (*FirewallTag, error) {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "list_policy_members",
@@ -66,7 +79,7 @@ func (c *Client) GetFirewallTag(firewall_tag *FirewallTag) (*FirewallTag, error)
 		return nil
 	}
 
-	err := c.GetAPI(&data, form["action"], form, checkFunc)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, checkFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -75,8 +88,12 @@ func (c *Client) GetFirewallTag(firewall_tag *FirewallTag) (*FirewallTag, error)
 }
 
 func (c *Client) DeleteFirewallTag(firewall_tag *FirewallTag) error {
+	return c.DeleteFirewallTagContext(context.Background(), firewall_tag)
+}
+
+func (c *Client) DeleteFirewallTagContext(ctx context.Context, firewall_tag *FirewallTag) error {
 	firewall_tag.CID = c.CID
 	firewall_tag.Action = "del_policy_tag"
 
-	return c.PostAPI(firewall_tag.Action, firewall_tag, BasicCheck)
+	return c.PostAPIContext(ctx, firewall_tag.Action, firewall_tag, BasicCheck)
 }
@@ -7,7 +7,7 @@ import (
 	"fmt"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Filters struct {
@@ -78,27 +78,39 @@ type FQDNPrivateNetworkingFilteringStatus struct {
 }
 
 func (c *Client) CreateFQDN(fqdn *FQDN) error {
+	return c.CreateFQDNContext(context.Background(), fqdn)
+}
+
+func (c *Client) CreateFQDNContext(ctx context.Context, fqdn *FQDN) error {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "add_fqdn_filter_tag",
 		"tag_name": fqdn.FQDNTag,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DeleteFQDN(fqdn *FQDN) error {
+	return c.DeleteFQDNContext(context.Background(), fqdn)
+}
+
+func (c *Client) DeleteFQDNContext(ctx context.Context, fqdn *FQDN) error {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "del_fqdn_filter_tag",
 		"tag_name": fqdn.FQDNTag,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 // UpdateFQDNStatus change state to 'enabled' or 'disabled'
 func (c *Client) UpdateFQDNStatus(fqdn *FQDN) error {
+	return c.UpdateFQDNStatusContext(context.Background(), fqdn)
+}
+
+func (c *Client) UpdateFQDNStatusContext(ctx context.Context, fqdn *FQDN) error {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "set_fqdn_filter_tag_state",
@@ -106,11 +118,15 @@ func (c *Client) UpdateFQDNStatus(fqdn *FQDN) error {
 		"status":   fqdn.FQDNStatus,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 // UpdateFQDNMode Change default mode to 'white' or 'black'
 func (c *Client) UpdateFQDNMode(fqdn *FQDN) error {
+	return c.UpdateFQDNModeContext(context.Background(), fqdn)
+}
+
+func (c *Client) UpdateFQDNModeContext(ctx context.Context, fqdn *FQDN) error {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "set_fqdn_filter_tag_color",
@@ -118,10 +134,14 @@ func (c *Client) UpdateFQDNMode(fqdn *FQDN) error {
 		"color":    fqdn.FQDNMode,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) UpdateDomains(fqdn *FQDN) error {
+	return c.UpdateDomainsContext(context.Background(), fqdn)
+}
+
+func (c *Client) UpdateDomainsContext(ctx context.Context, fqdn *FQDN) error {
 	action := "set_fqdn_filter_tag_domain_names"
 	form := map[string]string{
 		"CID":      c.CID,
@@ -136,7 +156,7 @@ func (c *Client) UpdateDomains(fqdn *FQDN) error {
 		form["domain_names"] = string(args)
 	}
 
-	return c.PostAPI(action, form, BasicCheck)
+	return c.PostAPIContext(ctx, action, form, BasicCheck)
 }
 
 func (c *Client) AttachGws(fqdn *FQDN) error {
@@ -144,6 +164,10 @@ func (c *Client) AttachGws(fqdn *FQDN) error {
 }
 
 func (c *Client) DetachGws(fqdn *FQDN, gwList []string) error {
+	return c.DetachGwsContext(context.Background(), fqdn, gwList)
+}
+
+func (c *Client) DetachGwsContext(ctx context.Context, fqdn *FQDN, gwList []string) error {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "detach_fqdn_filter_tag_from_gw",
@@ -152,7 +176,7 @@ func (c *Client) DetachGws(fqdn *FQDN, gwList []string) error {
 
 	for i := range gwList {
 		form["gw_name"] = gwList[i]
-		err := c.PostAPI(form["action"], form, BasicCheck)
+		err := c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 		if err != nil {
 			return err
 		}
@@ -162,6 +186,10 @@ func (c *Client) DetachGws(fqdn *FQDN, gwList []string) error {
 }
 
 func (c *Client) ListFQDNTags() ([]*FQDN, error) {
+	return c.ListFQDNTagsContext(context.Background())
+}
+
+func (c *Client) ListFQDNTagsContext(ctx context.Context) ([]*FQDN, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_fqdn_filter_tags",
@@ -169,7 +197,7 @@ func (c *Client) ListFQDNTags() ([]*FQDN, error) {
 
 	var data map[string]interface{}
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +218,11 @@ func (c *Client) ListFQDNTags() ([]*FQDN, error) {
 }
 
 func (c *Client) GetFQDNTag(fqdn *FQDN) (*FQDN, error) {
-	tags, err := c.ListFQDNTags()
+	return c.GetFQDNTagContext(context.Background(), fqdn)
+}
+
+func (c *Client) GetFQDNTagContext(ctx context.Context, fqdn *FQDN) (*FQDN, error) {
+	tags, err := c.ListFQDNTagsContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -202,11 +234,15 @@ func (c *Client) GetFQDNTag(fqdn *FQDN) (*FQDN, error) {
 			return fqdn, nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix FQDN tag %s", fqdn.FQDNTag)
+	tflog.Error(ctx, "Couldn't find Aviatrix FQDN tag", map[string]interface{}{"fqdn_tag": fqdn.FQDNTag})
 	return nil, ErrNotFound
 }
 
 func (c *Client) ListDomains(fqdn *FQDN) (*FQDN, error) {
+	return c.ListDomainsContext(context.Background(), fqdn)
+}
+
+func (c *Client) ListDomainsContext(ctx context.Context, fqdn *FQDN) (*FQDN, error) {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "list_fqdn_filter_tag_domain_names",
@@ -215,7 +251,7 @@ func (c *Client) ListDomains(fqdn *FQDN) (*FQDN, error) {
 
 	var data map[string]interface{}
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -236,6 +272,10 @@ func (c *Client) ListDomains(fqdn *FQDN) (*FQDN, error) {
 }
 
 func (c *Client) ListGws(fqdn *FQDN) ([]string, error) {
+	return c.ListGwsContext(context.Background(), fqdn)
+}
+
+func (c *Client) ListGwsContext(ctx context.Context, fqdn *FQDN) ([]string, error) {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "list_fqdn_filter_tag_attached_gws",
@@ -244,7 +284,7 @@ func (c *Client) ListGws(fqdn *FQDN) ([]string, error) {
 
 	var data ResultListResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -253,6 +293,10 @@ func (c *Client) ListGws(fqdn *FQDN) ([]string, error) {
 }
 
 func (c *Client) AttachTagToGw(fqdn *FQDN, gateway *Gateway) error {
+	return c.AttachTagToGwContext(context.Background(), fqdn, gateway)
+}
+
+func (c *Client) AttachTagToGwContext(ctx context.Context, fqdn *FQDN, gateway *Gateway) error {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "attach_fqdn_filter_tag_to_gw",
@@ -260,10 +304,14 @@ func (c *Client) AttachTagToGw(fqdn *FQDN, gateway *Gateway) error {
 		"gw_name":  gateway.GwName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) UpdateSourceIPFilters(fqdn *FQDN, gateway *Gateway, sourceIPs []string) error {
+	return c.UpdateSourceIPFiltersContext(context.Background(), fqdn, gateway, sourceIPs)
+}
+
+func (c *Client) UpdateSourceIPFiltersContext(ctx context.Context, fqdn *FQDN, gateway *Gateway, sourceIPs []string) error {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "update_fqdn_filter_tag_source_ip_filters",
@@ -279,11 +327,15 @@ func (c *Client) UpdateSourceIPFilters(fqdn *FQDN, gateway *Gateway, sourceIPs [
 		form["source_ips"] = string(args)
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetGwFilterTagList(fqdn *FQDN) (*FQDN, error) {
-	listGws, err := c.ListGws(fqdn)
+	return c.GetGwFilterTagListContext(context.Background(), fqdn)
+}
+
+func (c *Client) GetGwFilterTagListContext(ctx context.Context, fqdn *FQDN) (*FQDN, error) {
+	listGws, err := c.ListGwsContext(ctx, fqdn)
 	if err != nil {
 		return nil, errors.New("failed for list_fqdn_filter_tag_source_ip_filters: " + err.Error())
 	}
@@ -299,7 +351,7 @@ func (c *Client) GetGwFilterTagList(fqdn *FQDN) (*FQDN, error) {
 
 		var data ResultListSourceIPResp
 
-		err = c.GetAPI(&data, form["action"], form, BasicCheck)
+		err = c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 		if err != nil {
 			return nil, err
 		}
@@ -319,6 +371,10 @@ func (c *Client) GetGwFilterTagList(fqdn *FQDN) (*FQDN, error) {
 }
 
 func (c *Client) GetFQDNPassThroughCIDRs(gw *Gateway) ([]string, error) {
+	return c.GetFQDNPassThroughCIDRsContext(context.Background(), gw)
+}
+
+func (c *Client) GetFQDNPassThroughCIDRsContext(ctx context.Context, gw *Gateway) ([]string, error) {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "list_fqdn_pass_through_cidrs",
@@ -327,7 +383,7 @@ func (c *Client) GetFQDNPassThroughCIDRs(gw *Gateway) ([]string, error) {
 
 	var data FQDNPassThroughResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -340,6 +396,10 @@ func (c *Client) GetFQDNPassThroughCIDRs(gw *Gateway) ([]string, error) {
 }
 
 func (c *Client) ConfigureFQDNPassThroughCIDRs(gw *Gateway, IPs []string) error {
+	return c.ConfigureFQDNPassThroughCIDRsContext(context.Background(), gw, IPs)
+}
+
+func (c *Client) ConfigureFQDNPassThroughCIDRsContext(ctx context.Context, gw *Gateway, IPs []string) error {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "update_fqdn_pass_through_cidrs",
@@ -354,20 +414,28 @@ func (c *Client) ConfigureFQDNPassThroughCIDRs(gw *Gateway, IPs []string) error
 		form["source_cidrs"] = string(args)
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DisableFQDNPassThrough(gw *Gateway) error {
+	return c.DisableFQDNPassThroughContext(context.Background(), gw)
+}
+
+func (c *Client) DisableFQDNPassThroughContext(ctx context.Context, gw *Gateway) error {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "update_fqdn_pass_through_cidrs",
 		"gateway_name": gw.GwName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) AddFQDNTagRule(fqdn *FQDN) error {
+	return c.AddFQDNTagRuleContext(context.Background(), fqdn)
+}
+
+func (c *Client) AddFQDNTagRuleContext(ctx context.Context, fqdn *FQDN) error {
 	policies, err := json.Marshal(fqdn.DomainList)
 	if err != nil {
 		return fmt.Errorf("could not marshal fqdn domain: %v", err)
@@ -380,11 +448,15 @@ func (c *Client) AddFQDNTagRule(fqdn *FQDN) error {
 		"policies": string(policies),
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetFQDNTagRule(fqdn *FQDN) (*FQDN, error) {
-	foundFQDN, err := c.ListDomains(&FQDN{FQDNTag: fqdn.FQDNTag})
+	return c.GetFQDNTagRuleContext(context.Background(), fqdn)
+}
+
+func (c *Client) GetFQDNTagRuleContext(ctx context.Context, fqdn *FQDN) (*FQDN, error) {
+	foundFQDN, err := c.ListDomainsContext(ctx, &FQDN{FQDNTag: fqdn.FQDNTag})
 	if err != nil {
 		return nil, fmt.Errorf("could not list fqdn domains: %v", err)
 	}
@@ -403,6 +475,10 @@ func (c *Client) GetFQDNTagRule(fqdn *FQDN) (*FQDN, error) {
 }
 
 func (c *Client) DeleteFQDNTagRule(fqdn *FQDN) error {
+	return c.DeleteFQDNTagRuleContext(context.Background(), fqdn)
+}
+
+func (c *Client) DeleteFQDNTagRuleContext(ctx context.Context, fqdn *FQDN) error {
 	policies, err := json.Marshal(fqdn.DomainList)
 	if err != nil {
 		return fmt.Errorf("could not marshal fqdn domain: %v", err)
@@ -425,7 +501,7 @@ func (c *Client) DeleteFQDNTagRule(fqdn *FQDN) error {
 		return nil
 	}
 
-	return c.PostAPI(form["action"], form, checkFunc)
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
 }
 
 func (c *Client) EnableFQDNExceptionRule(ctx context.Context) error {
@@ -11,9 +11,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	log "github.com/sirupsen/logrus"
 )
 
 // Gateway simple struct to hold gateway details
@@ -394,11 +393,15 @@ type FQDNGatwayInfo struct {
 }
 
 func (c *Client) CreateGateway(gateway *Gateway) error {
+	return c.CreateGatewayContext(context.Background(), gateway)
+}
+
+func (c *Client) CreateGatewayContext(ctx context.Context, gateway *Gateway) error {
 	gateway.CID = c.CID
 	gateway.Action = "connect_container"
 	gateway.Async = true
 
-	return c.PostAsyncAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
 func (c *Client) CreatePublicSubnetFilteringGateway(gateway *Gateway) error {
@@ -521,6 +524,10 @@ func (c *Client) DisableSingleAZGateway(gateway *Gateway) error {
 }
 
 func (c *Client) GetGateway(gateway *Gateway) (*Gateway, error) {
+	return c.GetGatewayContext(context.Background(), gateway)
+}
+
+func (c *Client) GetGatewayContext(ctx context.Context, gateway *Gateway) (*Gateway, error) {
 	action := "list_vpcs_summary"
 	params := map[string]string{
 		"CID":          c.CID,
@@ -529,7 +536,7 @@ func (c *Client) GetGateway(gateway *Gateway) (*Gateway, error) {
 	}
 
 	var data GatewayListResp
-	err := c.GetAPI(&data, action, params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, action, params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -543,7 +550,7 @@ func (c *Client) GetGateway(gateway *Gateway) (*Gateway, error) {
 			return &gwList[i], nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix gateway %s", gateway.GwName)
+	tflog.Error(ctx, "Couldn't find Aviatrix gateway", map[string]interface{}{"gw_name": gateway.GwName})
 	return nil, ErrNotFound
 }
 
@@ -590,6 +597,10 @@ func (c *Client) GetSpokeGatewayList(ctx context.Context) ([]Gateway, error) {
 }
 
 func (c *Client) GetGatewayDetail(gateway *Gateway) (*GatewayDetail, error) {
+	return c.GetGatewayDetailContext(context.Background(), gateway)
+}
+
+func (c *Client) GetGatewayDetailContext(ctx context.Context, gateway *Gateway) (*GatewayDetail, error) {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "get_gateway_info",
@@ -598,7 +609,7 @@ func (c *Client) GetGatewayDetail(gateway *Gateway) (*GatewayDetail, error) {
 
 	var data GatewayDetailApiResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -607,19 +618,27 @@ func (c *Client) GetGatewayDetail(gateway *Gateway) (*GatewayDetail, error) {
 		return &data.Results, nil
 	}
 
-	log.Errorf("Couldn't find Aviatrix gateway %s", gateway.GwName)
+	tflog.Error(ctx, "Couldn't find Aviatrix gateway", map[string]interface{}{"gw_name": gateway.GwName})
 	return nil, ErrNotFound
 }
 
 func (c *Client) UpdateGateway(gateway *Gateway) error {
+	return c.UpdateGatewayContext(context.Background(), gateway)
+}
+
+func (c *Client) UpdateGatewayContext(ctx context.Context, gateway *Gateway) error {
 	gateway.CID = c.CID
 	gateway.Action = "edit_gw_config"
 	gateway.Async = true
 
-	return c.PostAsyncAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
 func (c *Client) DeleteGateway(gateway *Gateway) error {
+	return c.DeleteGatewayContext(context.Background(), gateway)
+}
+
+func (c *Client) DeleteGatewayContext(ctx context.Context, gateway *Gateway) error {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "delete_container",
@@ -628,7 +647,7 @@ func (c *Client) DeleteGateway(gateway *Gateway) error {
 		"async":      "true",
 	}
 
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EnableSNat(gateway *Gateway) error {
@@ -645,6 +664,10 @@ func (c *Client) EnableSNat(gateway *Gateway) error {
 }
 
 func (c *Client) EnableCustomizedSNat(gateway *Gateway) error {
+	return c.EnableCustomizedSNatContext(context.Background(), gateway)
+}
+
+func (c *Client) EnableCustomizedSNatContext(ctx context.Context, gateway *Gateway) error {
 	gateway.CID = c.CID
 	gateway.Action = "edit_gw_customized_snat_config"
 	args, err := json.Marshal(gateway.SnatPolicy)
@@ -665,7 +688,7 @@ func (c *Client) EnableCustomizedSNat(gateway *Gateway) error {
 	// Reset the SnatPolicy field after encoding so we don't send both.
 	gateway.SnatPolicy = nil
 
-	return c.PostAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
 func (c *Client) DisableSNat(gateway *Gateway) error {
@@ -676,6 +699,10 @@ func (c *Client) DisableSNat(gateway *Gateway) error {
 }
 
 func (c *Client) DisableCustomSNat(gateway *Gateway) error {
+	return c.DisableCustomSNatContext(context.Background(), gateway)
+}
+
+func (c *Client) DisableCustomSNatContext(ctx context.Context, gateway *Gateway) error {
 	gateway.CID = c.CID
 	gateway.Action = "edit_gw_customized_snat_config"
 	args, err := json.Marshal(gateway.SnatPolicy)
@@ -694,10 +721,14 @@ func (c *Client) DisableCustomSNat(gateway *Gateway) error {
 	gateway.PolicyList = base64.StdEncoding.EncodeToString(b.Bytes())
 	gateway.Compress = true
 
-	return c.PostAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
 func (c *Client) UpdateDNat(gateway *Gateway) error {
+	return c.UpdateDNatContext(context.Background(), gateway)
+}
+
+func (c *Client) UpdateDNatContext(ctx context.Context, gateway *Gateway) error {
 	gateway.CID = c.CID
 	gateway.Action = "update_dnat_config"
 	args, err := json.Marshal(gateway.DnatPolicy)
@@ -707,7 +738,7 @@ func (c *Client) UpdateDNat(gateway *Gateway) error {
 	gateway.PolicyList = string(args)
 	gateway.Compress = true
 
-	return c.PostAPI2(gateway.Action, gateway, BasicCheck)
+	return c.PostAPI2Context(ctx, gateway.Action, gateway, BasicCheck)
 }
 
 func (c *Client) UpdateVpnCidr(gateway *Gateway) error {
@@ -1065,6 +1096,12 @@ func (c *Client) DisableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error
 }
 
 func (c *Client) GetVPNConfigList(gateway *Gateway) ([]VPNConfig, error) {
+	return c.GetVPNConfigListContext(context.Background(), gateway)
+}
+
+// GetVPNConfigListContext returns the VPN configurations of the VPN gateway or
+// of the ELB fronting it, whose name is passed in gateway.GwName.
+func (c *Client) GetVPNConfigListContext(ctx context.Context, gateway *Gateway) ([]VPNConfig, error) {
 	form := map[string]string{
 		"CID":     c.CID,
 		"action":  "edit_vpn_config",
@@ -1075,12 +1112,12 @@ func (c *Client) GetVPNConfigList(gateway *Gateway) ([]VPNConfig, error) {
 
 	var data VPNConfigListResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
 
-	return data.Results, ErrNotFound
+	return data.Results, nil
 }
 
 func (c *Client) EnableActiveStandby(transitGateway *TransitVpc) error {
@@ -1104,6 +1141,10 @@ func (c *Client) DisableActiveStandby(transitGateway *TransitVpc) error {
 }
 
 func (c *Client) SwitchActiveTransitGateway(gwName, connName string) error {
+	return c.SwitchActiveTransitGatewayContext(context.Background(), gwName, connName)
+}
+
+func (c *Client) SwitchActiveTransitGatewayContext(ctx context.Context, gwName, connName string) error {
 	action := "active_standby_connection_switchover"
 	form := map[string]string{
 		"CID":             c.CID,
@@ -1111,10 +1152,14 @@ func (c *Client) SwitchActiveTransitGateway(gwName, connName string) error {
 		"gateway_name":    gwName,
 		"connection_name": connName,
 	}
-	return c.PostAPI(action, form, BasicCheck)
+	return c.PostAPIContext(ctx, action, form, BasicCheck)
 }
 
 func (c *Client) GetTransitGatewayLanCidr(gatewayName string) (string, error) {
+	return c.GetTransitGatewayLanCidrContext(context.Background(), gatewayName)
+}
+
+func (c *Client) GetTransitGatewayLanCidrContext(ctx context.Context, gatewayName string) (string, error) {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "get_firewall_lan_cidr",
@@ -1133,7 +1178,7 @@ func (c *Client) GetTransitGatewayLanCidr(gatewayName string) (string, error) {
 
 	var data LANCidrResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return "", err
 	}
@@ -1142,6 +1187,10 @@ func (c *Client) GetTransitGatewayLanCidr(gatewayName string) (string, error) {
 }
 
 func (c *Client) GetFqdnGatewayInfo(gateway *Gateway) (*FQDNGatwayInfo, error) {
+	return c.GetFqdnGatewayInfoContext(context.Background(), gateway)
+}
+
+func (c *Client) GetFqdnGatewayInfoContext(ctx context.Context, gateway *Gateway) (*FQDNGatwayInfo, error) {
 	params := map[string]string{
 		"action":    "list_firenet",
 		"subaction": "instance",
@@ -1149,7 +1198,7 @@ func (c *Client) GetFqdnGatewayInfo(gateway *Gateway) (*FQDNGatwayInfo, error) {
 		"CID":       c.CID,
 	}
 	var data FQDNGatewayInfoResp
-	err := c.GetAPI(&data, params["action"], params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -1188,6 +1237,10 @@ func (c *Client) DisableJumboFrame(gateway *Gateway) error {
 }
 
 func (c *Client) GetJumboFrameStatus(gateway *Gateway) (bool, error) {
+	return c.GetJumboFrameStatusContext(context.Background(), gateway)
+}
+
+func (c *Client) GetJumboFrameStatusContext(ctx context.Context, gateway *Gateway) (bool, error) {
 	action := "get_jumbo_frame_status"
 	form := map[string]string{
 		"CID":          c.CID,
@@ -1202,7 +1255,7 @@ func (c *Client) GetJumboFrameStatus(gateway *Gateway) (bool, error) {
 	}
 
 	var resp JumboFrameResult
-	err := c.GetAPI(&resp, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &resp, form["action"], form, BasicCheck)
 	if err != nil {
 		return false, err
 	}
@@ -0,0 +1,85 @@
+package goaviatrix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientContextCancelsInFlightCalls(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(ctx context.Context, c *Client) error
+	}{
+		{
+			name: "CreateGatewayContext",
+			call: func(ctx context.Context, c *Client) error {
+				return c.CreateGatewayContext(ctx, &Gateway{GwName: "gw"})
+			},
+		},
+		{
+			name: "GetGatewayContext",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetGatewayContext(ctx, &Gateway{GwName: "gw"})
+				return err
+			},
+		},
+		{
+			name: "CreateSite2CloudContext",
+			call: func(ctx context.Context, c *Client) error {
+				return c.CreateSite2CloudContext(ctx, &Site2Cloud{TunnelName: "s2c"})
+			},
+		},
+		{
+			name: "CreateVPNUserContext",
+			call: func(ctx context.Context, c *Client) error {
+				return c.CreateVPNUserContext(ctx, &VPNUser{UserName: "user"})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The controller never answers, so only the context can end the call.
+			client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+				_ = r.ParseForm()
+				<-r.Context().Done()
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			start := time.Now()
+			err := tt.call(ctx, client)
+			assert.Error(t, err)
+			assert.Less(t, time.Since(start), 5*time.Second)
+		})
+	}
+}
+
+func TestGetVPNConfigList(t *testing.T) {
+	client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		q := r.URL.Query()
+		assert.Equal(t, "edit_vpn_config", q.Get("action"))
+		assert.Equal(t, "show", q.Get("command"))
+		assert.Equal(t, "vpc-0123", q.Get("vpc_id"))
+		assert.Equal(t, "vpn-elb", q.Get("lb_name"))
+		_ = json.NewEncoder(w).Encode(VPNConfigListResp{Return: true, Results: []VPNConfig{
+			{Name: "Split Tunnel", Value: "yes", Status: "enabled"},
+			{Name: "Idle Timeout", Value: "300", Status: "enabled"},
+		}})
+	})
+
+	configs, err := client.GetVPNConfigListContext(context.Background(), &Gateway{VpcID: "vpc-0123", GwName: "vpn-elb"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []VPNConfig{
+		{Name: "Split Tunnel", Value: "yes", Status: "enabled"},
+		{Name: "Idle Timeout", Value: "300", Status: "enabled"},
+	}, configs)
+}
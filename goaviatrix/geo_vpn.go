@@ -1,13 +1,12 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 
-	"golang.org/x/net/context"
-
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type GeoVPN struct {
@@ -48,6 +47,10 @@ func (c *Client) EnableGeoVPN(ctx context.Context, geoVPN *GeoVPN) error {
 }
 
 func (c *Client) GetGeoVPNInfo(geoVPN *GeoVPN) (*GeoVPN, error) {
+	return c.GetGeoVPNInfoContext(context.Background(), geoVPN)
+}
+
+func (c *Client) GetGeoVPNInfoContext(ctx context.Context, geoVPN *GeoVPN) (*GeoVPN, error) {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "get_geo_vpn_info",
@@ -66,7 +69,7 @@ func (c *Client) GetGeoVPNInfo(geoVPN *GeoVPN) (*GeoVPN, error) {
 		return nil
 	}
 
-	err := c.GetAPI(&data, form["action"], form, checkFunc)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, checkFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -84,32 +87,48 @@ func (c *Client) GetGeoVPNInfo(geoVPN *GeoVPN) (*GeoVPN, error) {
 		return geoVPN, nil
 	}
 
-	log.Errorf("Couldn't find Aviatrix Geo VPN: %v", geoVPN)
+	tflog.Error(ctx, "Couldn't find Aviatrix Geo VPN", map[string]interface{}{"account_name": geoVPN.AccountName})
 	return nil, ErrNotFound
 }
 
 func (c *Client) AddElbToGeoVPN(geoVPN *GeoVPN) error {
+	return c.AddElbToGeoVPNContext(context.Background(), geoVPN)
+}
+
+func (c *Client) AddElbToGeoVPNContext(ctx context.Context, geoVPN *GeoVPN) error {
 	geoVPN.CID = c.CID
 	geoVPN.Action = "add_elb_to_geo_vpn"
 
-	return c.PostAPI(geoVPN.Action, geoVPN, BasicCheck)
+	return c.PostAPIContext(ctx, geoVPN.Action, geoVPN, BasicCheck)
 }
 
 func (c *Client) DeleteElbFromGeoVPN(geoVPN *GeoVPN) error {
+	return c.DeleteElbFromGeoVPNContext(context.Background(), geoVPN)
+}
+
+func (c *Client) DeleteElbFromGeoVPNContext(ctx context.Context, geoVPN *GeoVPN) error {
 	geoVPN.CID = c.CID
 	geoVPN.Action = "delete_elb_from_geo_vpn"
 
-	return c.PostAPI(geoVPN.Action, geoVPN, BasicCheck)
+	return c.PostAPIContext(ctx, geoVPN.Action, geoVPN, BasicCheck)
 }
 
 func (c *Client) DisableGeoVPN(geoVPN *GeoVPN) error {
+	return c.DisableGeoVPNContext(context.Background(), geoVPN)
+}
+
+func (c *Client) DisableGeoVPNContext(ctx context.Context, geoVPN *GeoVPN) error {
 	geoVPN.CID = c.CID
 	geoVPN.Action = "disable_geo_vpn"
 
-	return c.PostAPI(geoVPN.Action, geoVPN, BasicCheck)
+	return c.PostAPIContext(ctx, geoVPN.Action, geoVPN, BasicCheck)
 }
 
 func (c *Client) GetGeoVPNName(gateway *Gateway) (*GeoVPN, error) {
+	return c.GetGeoVPNNameContext(context.Background(), gateway)
+}
+
+func (c *Client) GetGeoVPNNameContext(ctx context.Context, gateway *Gateway) (*GeoVPN, error) {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "get_geo_vpn_info",
@@ -128,7 +147,7 @@ func (c *Client) GetGeoVPNName(gateway *Gateway) (*GeoVPN, error) {
 		return nil
 	}
 
-	err := c.GetAPI(&data, form["action"], form, checkFunc)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, checkFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -146,6 +165,6 @@ func (c *Client) GetGeoVPNName(gateway *Gateway) (*GeoVPN, error) {
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix Geo VPN")
+	tflog.Error(ctx, "Couldn't find Aviatrix Geo VPN")
 	return nil, ErrNotFound
 }
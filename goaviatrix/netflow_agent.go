@@ -1,5 +1,9 @@
 package goaviatrix
 
+import (
+	"context"
+)
+
 import "strconv"
 
 type NetflowAgent struct {
@@ -21,6 +25,10 @@ type NetflowAgentResp struct {
 }
 
 func (c *Client) EnableNetflowAgent(r *NetflowAgent) error {
+	return c.EnableNetflowAgentContext(context.Background(), r)
+}
+
+func (c *Client) EnableNetflowAgentContext(ctx context.Context, r *NetflowAgent) error {
 	params := map[string]string{
 		"action":               "enable_netflow_agent",
 		"CID":                  c.CID,
@@ -31,10 +39,14 @@ func (c *Client) EnableNetflowAgent(r *NetflowAgent) error {
 		"exclude_gateway_list": r.ExcludedGatewaysInput,
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
 
 func (c *Client) GetNetflowAgentStatus() (*NetflowAgentResp, error) {
+	return c.GetNetflowAgentStatusContext(context.Background())
+}
+
+func (c *Client) GetNetflowAgentStatusContext(ctx context.Context) (*NetflowAgentResp, error) {
 	params := map[string]string{
 		"action": "get_netflow_agent",
 		"CID":    c.CID,
@@ -48,7 +60,7 @@ func (c *Client) GetNetflowAgentStatus() (*NetflowAgentResp, error) {
 
 	var data Resp
 
-	err := c.GetAPI(&data, params["action"], params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -61,10 +73,14 @@ func (c *Client) GetNetflowAgentStatus() (*NetflowAgentResp, error) {
 }
 
 func (c *Client) DisableNetflowAgent() error {
+	return c.DisableNetflowAgentContext(context.Background())
+}
+
+func (c *Client) DisableNetflowAgentContext(ctx context.Context) error {
 	params := map[string]string{
 		"action": "disable_netflow_agent",
 		"CID":    c.CID,
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
@@ -1,5 +1,7 @@
 package goaviatrix
 
+import "context"
+
 type PeriodicPing struct {
 	Action        string `form:"action"`
 	CID           string `form:"CID"`
@@ -22,13 +24,21 @@ type PeriodicPingStatusResult struct {
 }
 
 func (c *Client) CreatePeriodicPing(pp *PeriodicPing) error {
+	return c.CreatePeriodicPingContext(context.Background(), pp)
+}
+
+func (c *Client) CreatePeriodicPingContext(ctx context.Context, pp *PeriodicPing) error {
 	pp.Action = "enable_gateway_periodic_ping"
 	pp.CID = c.CID
 
-	return c.PostAPI(pp.Action, pp, BasicCheck)
+	return c.PostAPIContext(ctx, pp.Action, pp, BasicCheck)
 }
 
 func (c *Client) GetPeriodicPing(pp *PeriodicPing) (*PeriodicPing, error) {
+	return c.GetPeriodicPingContext(context.Background(), pp)
+}
+
+func (c *Client) GetPeriodicPingContext(ctx context.Context, pp *PeriodicPing) (*PeriodicPing, error) {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "get_gateway_periodic_ping_status",
@@ -37,7 +47,7 @@ func (c *Client) GetPeriodicPing(pp *PeriodicPing) (*PeriodicPing, error) {
 
 	var data PeriodicPingStatusResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -54,8 +64,12 @@ func (c *Client) GetPeriodicPing(pp *PeriodicPing) (*PeriodicPing, error) {
 }
 
 func (c *Client) DeletePeriodicPing(pp *PeriodicPing) error {
+	return c.DeletePeriodicPingContext(context.Background(), pp)
+}
+
+func (c *Client) DeletePeriodicPingContext(ctx context.Context, pp *PeriodicPing) error {
 	pp.Action = "disable_gateway_periodic_ping"
 	pp.CID = c.CID
 
-	return c.PostAPI(pp.Action, pp, BasicCheck)
+	return c.PostAPIContext(ctx, pp.Action, pp, BasicCheck)
 }
@@ -1,11 +1,12 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type ProfileRule struct {
@@ -50,6 +51,10 @@ type ProfileBasePolicyResp struct {
 }
 
 func (c *Client) CreateProfile(profile *Profile) error {
+	return c.CreateProfileContext(context.Background(), profile)
+}
+
+func (c *Client) CreateProfileContext(ctx context.Context, profile *Profile) error {
 	form1 := map[string]string{
 		"CID":          c.CID,
 		"action":       "add_user_profile",
@@ -57,7 +62,7 @@ func (c *Client) CreateProfile(profile *Profile) error {
 		"base_policy":  profile.BaseRule,
 	}
 
-	err := c.PostAPI(form1["action"], form1, BasicCheck)
+	err := c.PostAPIContext(ctx, form1["action"], form1, BasicCheck)
 	if err != nil {
 		return err
 	}
@@ -70,7 +75,7 @@ func (c *Client) CreateProfile(profile *Profile) error {
 		"policy":       string(policyStr),
 	}
 
-	err = c.PostAPI(form2["action"], form2, BasicCheck)
+	err = c.PostAPIContext(ctx, form2["action"], form2, BasicCheck)
 	if err != nil {
 		return err
 	}
@@ -83,7 +88,7 @@ func (c *Client) CreateProfile(profile *Profile) error {
 			"username":     user,
 		}
 
-		err = c.PostAPI(form["action"], form, BasicCheck)
+		err = c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 		if err != nil {
 			return err
 		}
@@ -92,6 +97,10 @@ func (c *Client) CreateProfile(profile *Profile) error {
 }
 
 func (c *Client) GetProfile(profile *Profile) (*Profile, error) {
+	return c.GetProfileContext(context.Background(), profile)
+}
+
+func (c *Client) GetProfileContext(ctx context.Context, profile *Profile) (*Profile, error) {
 	form1 := map[string]string{
 		"CID":          c.CID,
 		"action":       "list_profile_policies",
@@ -110,13 +119,13 @@ func (c *Client) GetProfile(profile *Profile) (*Profile, error) {
 		return nil
 	}
 
-	err := c.GetAPI(&data1, form1["action"], form1, checkFunc)
+	err := c.GetAPIContext(ctx, &data1, form1["action"], form1, checkFunc)
 	if err != nil {
 		return nil, err
 	}
 
 	profile.Policy = data1.Results
-	log.Tracef("Profile policy %s", profile.Policy)
+	tflog.Trace(ctx, "Profile policy")
 
 	form2 := map[string]string{
 		"CID":    c.CID,
@@ -125,18 +134,22 @@ func (c *Client) GetProfile(profile *Profile) (*Profile, error) {
 
 	var data2 ProfileUserListResp
 
-	err = c.GetAPI(&data2, form2["action"], form2, BasicCheck)
+	err = c.GetAPIContext(ctx, &data2, form2["action"], form2, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
 
 	profile.UserList = data2.Results[profile.Name]
-	log.Tracef("Profile list of users %s", profile.UserList)
+	tflog.Trace(ctx, "Profile list of users", map[string]interface{}{"user_list": profile.UserList})
 	return profile, nil
 }
 
 func (c *Client) UpdateProfilePolicy(profile *Profile) error {
-	log.Tracef("Updating Profile Policy %#v", profile)
+	return c.UpdateProfilePolicyContext(context.Background(), profile)
+}
+
+func (c *Client) UpdateProfilePolicyContext(ctx context.Context, profile *Profile) error {
+	tflog.Trace(ctx, "Updating Profile Policy", map[string]interface{}{"name": profile.Name})
 
 	policyStr, _ := json.Marshal(profile.Policy)
 	form := map[string]string{
@@ -146,11 +159,15 @@ func (c *Client) UpdateProfilePolicy(profile *Profile) error {
 		"policy":       string(policyStr),
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) AttachUsers(profile *Profile) error {
-	log.Tracef("Attaching users %s", profile.UserList)
+	return c.AttachUsersContext(context.Background(), profile)
+}
+
+func (c *Client) AttachUsersContext(ctx context.Context, profile *Profile) error {
+	tflog.Trace(ctx, "Attaching users", map[string]interface{}{"user_list": profile.UserList})
 
 	for _, user := range profile.UserList {
 		form := map[string]string{
@@ -160,7 +177,7 @@ func (c *Client) AttachUsers(profile *Profile) error {
 			"username":     user,
 		}
 
-		err := c.PostAPI(form["action"], form, BasicCheck)
+		err := c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 		if err != nil {
 			return err
 		}
@@ -169,7 +186,11 @@ func (c *Client) AttachUsers(profile *Profile) error {
 }
 
 func (c *Client) DetachUsers(profile *Profile) error {
-	log.Tracef("Detaching users %s", profile.UserList)
+	return c.DetachUsersContext(context.Background(), profile)
+}
+
+func (c *Client) DetachUsersContext(ctx context.Context, profile *Profile) error {
+	tflog.Trace(ctx, "Detaching users", map[string]interface{}{"user_list": profile.UserList})
 
 	for _, user := range profile.UserList {
 		form := map[string]string{
@@ -179,7 +200,7 @@ func (c *Client) DetachUsers(profile *Profile) error {
 			"username":     user,
 		}
 
-		err := c.PostAPI(form["action"], form, BasicCheck)
+		err := c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 		if err != nil {
 			return err
 		}
@@ -188,16 +209,24 @@ func (c *Client) DetachUsers(profile *Profile) error {
 }
 
 func (c *Client) DeleteProfile(profile *Profile) error {
+	return c.DeleteProfileContext(context.Background(), profile)
+}
+
+func (c *Client) DeleteProfileContext(ctx context.Context, profile *Profile) error {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "del_user_profile",
 		"profile_name": profile.Name,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetProfileBasePolicy(profile *Profile) (*Profile, error) {
+	return c.GetProfileBasePolicyContext(context.Background(), profile)
+}
+
+func (c *Client) GetProfileBasePolicyContext(ctx context.Context, profile *Profile) (*Profile, error) {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "get_profile_base_policy",
@@ -206,7 +235,7 @@ func (c *Client) GetProfileBasePolicy(profile *Profile) (*Profile, error) {
 
 	var data ProfileBasePolicyResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"strings"
 )
 
@@ -17,6 +18,10 @@ type ProxyConfigResp struct {
 }
 
 func (c *Client) CreateProxyConfig(proxyConfig *ProxyConfig) error {
+	return c.CreateProxyConfigContext(context.Background(), proxyConfig)
+}
+
+func (c *Client) CreateProxyConfigContext(ctx context.Context, proxyConfig *ProxyConfig) error {
 	action := "apply_proxy_config"
 	params := map[string]string{
 		"CID":         c.CID,
@@ -34,19 +39,23 @@ func (c *Client) CreateProxyConfig(proxyConfig *ProxyConfig) error {
 			FileContent:    proxyConfig.ProxyCaCertificate,
 		}
 		files = append(files, ca)
-		return c.PostFileAPI(params, files, BasicCheck)
+		return c.PostFileAPIContext(ctx, params, files, BasicCheck)
 	} else {
-		return c.PostAPI(action, params, BasicCheck)
+		return c.PostAPIContext(ctx, action, params, BasicCheck)
 	}
 }
 
 func (c *Client) GetProxyConfig() (*ProxyConfig, error) {
+	return c.GetProxyConfigContext(context.Background())
+}
+
+func (c *Client) GetProxyConfigContext(ctx context.Context) (*ProxyConfig, error) {
 	formData := map[string]string{
 		"action": "show_proxy_config",
 		"CID":    c.CID,
 	}
 	var data ProxyConfigResp
-	err := c.GetAPI(&data, formData["action"], formData, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, formData["action"], formData, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -62,10 +71,14 @@ func (c *Client) GetProxyConfig() (*ProxyConfig, error) {
 }
 
 func (c *Client) DeleteProxyConfig() error {
+	return c.DeleteProxyConfigContext(context.Background())
+}
+
+func (c *Client) DeleteProxyConfigContext(ctx context.Context) error {
 	action := "delete_proxy_config"
 	data := map[string]interface{}{
 		"action": action,
 		"CID":    c.CID,
 	}
-	return c.PostAPI(action, data, BasicCheck)
+	return c.PostAPIContext(ctx, action, data, BasicCheck)
 }
@@ -0,0 +1,129 @@
+package goaviatrix
+
+import (
+	"context"
+	"io"
+	"math"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket shared by all requests of a Client. Tokens
+// are added at rate per second up to burst, and every request takes one.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64) *rateLimiter {
+	burst := math.Max(1, math.Ceil(rate))
+	return &rateLimiter{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before
+// the token can be used.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel gives back a token taken by reserve that was never used.
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// Wait blocks until a request is allowed to be sent or ctx is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	if err := sleepContext(ctx, l.reserve()); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// WithRateLimit limits the Client to requestsPerSecond requests to the
+// controller, with bursts of up to one second worth of requests. A value of
+// 0 or less disables the limit.
+func WithRateLimit(requestsPerSecond float64) ClientOption {
+	return func(c *Client) {
+		if requestsPerSecond <= 0 {
+			c.rateLimiter = nil
+			return
+		}
+		c.rateLimiter = newRateLimiter(requestsPerSecond)
+	}
+}
+
+// WithMaxConcurrentRequests limits the number of requests the Client has in
+// flight to the controller at the same time. A value of 0 or less disables
+// the limit.
+func WithMaxConcurrentRequests(n int) ClientOption {
+	return func(c *Client) {
+		if n <= 0 {
+			c.inFlight = nil
+			return
+		}
+		c.inFlight = make(chan struct{}, n)
+	}
+}
+
+// acquire waits until the rate limit and the concurrency cap of the client
+// allow a new request. The returned func must be called once the request
+// is done, including reading its response body.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if c.inFlight == nil {
+		return func() {}, nil
+	}
+	select {
+	case c.inFlight <- struct{}{}:
+		return func() { <-c.inFlight }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// releaseOnDone releases the slot of a request once its response body has
+// been read to the end or closed, whichever happens first.
+type releaseOnDone struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnDone) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.once.Do(b.release)
+	}
+	return n, err
+}
+
+func (b *releaseOnDone) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
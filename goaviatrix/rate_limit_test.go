@@ -0,0 +1,107 @@
+package goaviatrix
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var current, peak int32
+	client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&current, 1)
+		defer atomic.AddInt32(&current, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"return": true}`))
+	})
+	WithMaxConcurrentRequests(2)(client)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := client.PostAPIContext(context.Background(), "update_gateway", map[string]string{"action": "update_gateway"}, BasicCheck)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&peak))
+}
+
+func TestMaxConcurrentRequestsWhileReadingBody(t *testing.T) {
+	var current, peak int32
+	client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&current, 1)
+		defer atomic.AddInt32(&current, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		// Send the headers first and stream the body slowly.
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"return": `))
+		w.(http.Flusher).Flush()
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`true}`))
+	})
+	WithMaxConcurrentRequests(1)(client)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := client.PostAPIContext(context.Background(), "update_gateway", map[string]string{"action": "update_gateway"}, BasicCheck)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&peak))
+}
+
+func TestRateLimit(t *testing.T) {
+	var calls int32
+	client := newTestControllerClient(t, failingHandler(&calls, 0, http.StatusOK, ""))
+	WithRateLimit(50)(client)
+
+	// The first 50 requests use the burst, the next 10 are paced at 50/s.
+	start := time.Now()
+	for i := 0; i < 60; i++ {
+		err := client.PostAPIContext(context.Background(), "update_gateway", map[string]string{"action": "update_gateway"}, BasicCheck)
+		assert.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+	assert.Equal(t, int32(60), atomic.LoadInt32(&calls))
+}
+
+func TestRateLimitHonorsContext(t *testing.T) {
+	var calls int32
+	client := newTestControllerClient(t, failingHandler(&calls, 0, http.StatusOK, ""))
+	WithRateLimit(0.1)(client)
+
+	err := client.PostAPIContext(context.Background(), "update_gateway", map[string]string{"action": "update_gateway"}, BasicCheck)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = client.PostAPIContext(ctx, "update_gateway", map[string]string{"action": "update_gateway"}, BasicCheck)
+	assert.ErrorContains(t, err, context.DeadlineExceeded.Error())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -1,9 +1,10 @@
 package goaviatrix
 
 import (
+	"context"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type RbacGroupAccessAccountAttachment struct {
@@ -20,13 +21,21 @@ type RbacGroupAccessAccountAttachmentListResp struct {
 }
 
 func (c *Client) CreateRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) error {
+	return c.CreateRbacGroupAccessAccountAttachmentContext(context.Background(), rbacGroupAccessAccountAttachment)
+}
+
+func (c *Client) CreateRbacGroupAccessAccountAttachmentContext(ctx context.Context, rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) error {
 	rbacGroupAccessAccountAttachment.CID = c.CID
 	rbacGroupAccessAccountAttachment.Action = "add_access_accounts_to_rbac_group"
 
-	return c.PostAPI(rbacGroupAccessAccountAttachment.Action, rbacGroupAccessAccountAttachment, BasicCheck)
+	return c.PostAPIContext(ctx, rbacGroupAccessAccountAttachment.Action, rbacGroupAccessAccountAttachment, BasicCheck)
 }
 
 func (c *Client) GetRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) (*RbacGroupAccessAccountAttachment, error) {
+	return c.GetRbacGroupAccessAccountAttachmentContext(context.Background(), rbacGroupAccessAccountAttachment)
+}
+
+func (c *Client) GetRbacGroupAccessAccountAttachmentContext(ctx context.Context, rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) (*RbacGroupAccessAccountAttachment, error) {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "list_access_accounts_in_rbac_group",
@@ -35,7 +44,7 @@ func (c *Client) GetRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttac
 
 	var data RbacGroupAccessAccountAttachmentListResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -43,18 +52,20 @@ func (c *Client) GetRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttac
 	attachments := data.RbacGroupAccessAccountAttachmentList
 	for i := range attachments {
 		if attachments[i] == rbacGroupAccessAccountAttachment.AccessAccountName {
-			log.Infof("Found Aviatrix RBAC group access account attachment: %s",
-				rbacGroupAccessAccountAttachment.GroupName+"~"+rbacGroupAccessAccountAttachment.AccessAccountName)
+			tflog.Info(ctx, "Found Aviatrix RBAC group access account attachment", map[string]interface{}{"group_name": rbacGroupAccessAccountAttachment.GroupName, "access_account_name": rbacGroupAccessAccountAttachment.AccessAccountName})
 			return rbacGroupAccessAccountAttachment, nil
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix RBAC group access account attachment: %s",
-		rbacGroupAccessAccountAttachment.GroupName+"~"+rbacGroupAccessAccountAttachment.AccessAccountName)
+	tflog.Error(ctx, "Couldn't find Aviatrix RBAC group access account attachment", map[string]interface{}{"group_name": rbacGroupAccessAccountAttachment.GroupName, "access_account_name": rbacGroupAccessAccountAttachment.AccessAccountName})
 	return nil, ErrNotFound
 }
 
 func (c *Client) DeleteRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) error {
+	return c.DeleteRbacGroupAccessAccountAttachmentContext(context.Background(), rbacGroupAccessAccountAttachment)
+}
+
+func (c *Client) DeleteRbacGroupAccessAccountAttachmentContext(ctx context.Context, rbacGroupAccessAccountAttachment *RbacGroupAccessAccountAttachment) error {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "delete_access_accounts_from_rbac_group",
@@ -62,19 +73,23 @@ func (c *Client) DeleteRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAt
 		"accounts":   rbacGroupAccessAccountAttachment.AccessAccountName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 // ListRbacGroupAccessAccounts retrieves a list of all access accounts assigned to the specified
 // RBAC group.
 func (c *Client) ListRbacGroupAccessAccounts(groupName string) ([]string, error) {
+	return c.ListRbacGroupAccessAccountsContext(context.Background(), groupName)
+}
+
+func (c *Client) ListRbacGroupAccessAccountsContext(ctx context.Context, groupName string) ([]string, error) {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "list_access_accounts_in_rbac_group",
 		"group_name": groupName,
 	}
 	var data RbacGroupAccessAccountAttachmentListResp
-	if err := c.GetAPI(&data, form["action"], form, BasicCheck); err != nil {
+	if err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck); err != nil {
 		return nil, err
 	}
 	return data.RbacGroupAccessAccountAttachmentList, nil
@@ -82,6 +97,10 @@ func (c *Client) ListRbacGroupAccessAccounts(groupName string) ([]string, error)
 
 // AddRbacGroupAccessAccounts adds one or more access accounts to the specified RBAC group.
 func (c *Client) AddRbacGroupAccessAccounts(groupName string, accessAccounts []string) error {
+	return c.AddRbacGroupAccessAccountsContext(context.Background(), groupName, accessAccounts)
+}
+
+func (c *Client) AddRbacGroupAccessAccountsContext(ctx context.Context, groupName string, accessAccounts []string) error {
 	if len(accessAccounts) == 0 {
 		return nil
 	}
@@ -91,12 +110,16 @@ func (c *Client) AddRbacGroupAccessAccounts(groupName string, accessAccounts []s
 		GroupName:         groupName,
 		AccessAccountName: strings.Join(accessAccounts, ","), // API expects comma-separated in "accounts"
 	}
-	return c.PostAPI(payload.Action, payload, BasicCheck)
+	return c.PostAPIContext(ctx, payload.Action, payload, BasicCheck)
 }
 
 // DeleteRbacGroupAccessAccounts removes one or more access accounts from the specified RBAC
 // group. Takes a group name and a slice of accessAccounts to remove
 func (c *Client) DeleteRbacGroupAccessAccounts(groupName string, accessAccounts []string) error {
+	return c.DeleteRbacGroupAccessAccountsContext(context.Background(), groupName, accessAccounts)
+}
+
+func (c *Client) DeleteRbacGroupAccessAccountsContext(ctx context.Context, groupName string, accessAccounts []string) error {
 	if len(accessAccounts) == 0 {
 		return nil
 	}
@@ -106,22 +129,26 @@ func (c *Client) DeleteRbacGroupAccessAccounts(groupName string, accessAccounts
 		"group_name": groupName,
 		"accounts":   strings.Join(accessAccounts, ","),
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 // SetRbacGroupAccessAccounts sets the exact membership of an RBAC group by comparing
 // current access accounts with desired access accounts, then adding missing access accounts and removing
 // extra access accounts. Ensures the group contains exactly the specified access accounts.
 func (c *Client) SetRbacGroupAccessAccounts(groupName string, desired []string) error {
-	current, err := c.ListRbacGroupAccessAccounts(groupName)
+	return c.SetRbacGroupAccessAccountsContext(context.Background(), groupName, desired)
+}
+
+func (c *Client) SetRbacGroupAccessAccountsContext(ctx context.Context, groupName string, desired []string) error {
+	current, err := c.ListRbacGroupAccessAccountsContext(ctx, groupName)
 	if err != nil {
 		return err
 	}
 	toAdd, toDel := diffStrings(current, desired)
-	if err := c.AddRbacGroupAccessAccounts(groupName, toAdd); err != nil {
+	if err := c.AddRbacGroupAccessAccountsContext(ctx, groupName, toAdd); err != nil {
 		return err
 	}
-	if err := c.DeleteRbacGroupAccessAccounts(groupName, toDel); err != nil {
+	if err := c.DeleteRbacGroupAccessAccountsContext(ctx, groupName, toDel); err != nil {
 		return err
 	}
 	return nil
@@ -1,7 +1,9 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type RbacGroup struct {
@@ -28,13 +30,21 @@ type RbacGroupListDetailsResp struct {
 }
 
 func (c *Client) CreatePermissionGroup(rbacGroup *RbacGroup) error {
+	return c.CreatePermissionGroupContext(context.Background(), rbacGroup)
+}
+
+func (c *Client) CreatePermissionGroupContext(ctx context.Context, rbacGroup *RbacGroup) error {
 	rbacGroup.CID = c.CID
 	rbacGroup.Action = "add_permission_group"
 
-	return c.PostAPI(rbacGroup.Action, rbacGroup, BasicCheck)
+	return c.PostAPIContext(ctx, rbacGroup.Action, rbacGroup, BasicCheck)
 }
 
 func (c *Client) GetPermissionGroup(rbacGroup *RbacGroup) (*RbacGroup, error) {
+	return c.GetPermissionGroupContext(context.Background(), rbacGroup)
+}
+
+func (c *Client) GetPermissionGroupContext(ctx context.Context, rbacGroup *RbacGroup) (*RbacGroup, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_permission_groups",
@@ -42,7 +52,7 @@ func (c *Client) GetPermissionGroup(rbacGroup *RbacGroup) (*RbacGroup, error) {
 
 	var data RbacGroupListResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -50,44 +60,60 @@ func (c *Client) GetPermissionGroup(rbacGroup *RbacGroup) (*RbacGroup, error) {
 	groups := data.RbacGroupList
 	for i := range groups {
 		if groups[i] == rbacGroup.GroupName {
-			log.Infof("Found Aviatrix RBAC group: %s", rbacGroup.GroupName)
+			tflog.Info(ctx, "Found Aviatrix RBAC group", map[string]interface{}{"group_name": rbacGroup.GroupName})
 			return rbacGroup, nil
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix RBAC group: %s", rbacGroup.GroupName)
+	tflog.Error(ctx, "Couldn't find Aviatrix RBAC group", map[string]interface{}{"group_name": rbacGroup.GroupName})
 	return nil, ErrNotFound
 }
 
 func (c *Client) DeletePermissionGroup(rbacGroup *RbacGroup) error {
+	return c.DeletePermissionGroupContext(context.Background(), rbacGroup)
+}
+
+func (c *Client) DeletePermissionGroupContext(ctx context.Context, rbacGroup *RbacGroup) error {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "delete_permission_group",
 		"group_name": rbacGroup.GroupName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EnableLocalLoginForRBACGroup(GroupName string) error {
+	return c.EnableLocalLoginForRBACGroupContext(context.Background(), GroupName)
+}
+
+func (c *Client) EnableLocalLoginForRBACGroupContext(ctx context.Context, GroupName string) error {
 	data := map[string]string{
 		"action":     "enable_local_login",
 		"CID":        c.CID,
 		"group_name": GroupName,
 	}
-	return c.PostAPI("disable_local_login", data, BasicCheck)
+	return c.PostAPIContext(ctx, "disable_local_login", data, BasicCheck)
 }
 
 func (c *Client) DisableLocalLoginForRBACGroup(GroupName string) error {
+	return c.DisableLocalLoginForRBACGroupContext(context.Background(), GroupName)
+}
+
+func (c *Client) DisableLocalLoginForRBACGroupContext(ctx context.Context, GroupName string) error {
 	data := map[string]string{
 		"action":     "disable_local_login",
 		"CID":        c.CID,
 		"group_name": GroupName,
 	}
-	return c.PostAPI("disable_local_login", data, BasicCheck)
+	return c.PostAPIContext(ctx, "disable_local_login", data, BasicCheck)
 }
 
 func (c *Client) GetPermissionGroupDetails(GroupName string) (*RbacGroupResponse, error) {
+	return c.GetPermissionGroupDetailsContext(context.Background(), GroupName)
+}
+
+func (c *Client) GetPermissionGroupDetailsContext(ctx context.Context, GroupName string) (*RbacGroupResponse, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_permission_group_details",
@@ -95,7 +121,7 @@ func (c *Client) GetPermissionGroupDetails(GroupName string) (*RbacGroupResponse
 
 	var data RbacGroupListDetailsResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -103,10 +129,10 @@ func (c *Client) GetPermissionGroupDetails(GroupName string) (*RbacGroupResponse
 	groups := data.RbacGroupList
 	for i := range groups {
 		if groups[i].GroupName == GroupName {
-			log.Infof("Found Aviatrix RBAC group: %s", GroupName)
+			tflog.Info(ctx, "Found Aviatrix RBAC group", map[string]interface{}{"group_name": GroupName})
 			return &groups[i], nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix RBAC group: %s", GroupName)
+	tflog.Error(ctx, "Couldn't find Aviatrix RBAC group", map[string]interface{}{"group_name": GroupName})
 	return nil, ErrNotFound
 }
@@ -1,7 +1,9 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type RbacGroupPermissionAttachment struct {
@@ -25,13 +27,21 @@ type PermissionAttachmentInfo struct {
 }
 
 func (c *Client) CreateRbacGroupPermissionAttachment(rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) error {
+	return c.CreateRbacGroupPermissionAttachmentContext(context.Background(), rbacGroupPermissionAttachment)
+}
+
+func (c *Client) CreateRbacGroupPermissionAttachmentContext(ctx context.Context, rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) error {
 	rbacGroupPermissionAttachment.CID = c.CID
 	rbacGroupPermissionAttachment.Action = "add_permissions_to_rbac_group"
 
-	return c.PostAPI(rbacGroupPermissionAttachment.Action, rbacGroupPermissionAttachment, BasicCheck)
+	return c.PostAPIContext(ctx, rbacGroupPermissionAttachment.Action, rbacGroupPermissionAttachment, BasicCheck)
 }
 
 func (c *Client) GetRbacGroupPermissionAttachment(rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) (*RbacGroupPermissionAttachment, error) {
+	return c.GetRbacGroupPermissionAttachmentContext(context.Background(), rbacGroupPermissionAttachment)
+}
+
+func (c *Client) GetRbacGroupPermissionAttachmentContext(ctx context.Context, rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) (*RbacGroupPermissionAttachment, error) {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "list_rbac_group_permissions",
@@ -40,7 +50,7 @@ func (c *Client) GetRbacGroupPermissionAttachment(rbacGroupPermissionAttachment
 
 	var data RbacGroupPermissionAttachmentListResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -48,18 +58,20 @@ func (c *Client) GetRbacGroupPermissionAttachment(rbacGroupPermissionAttachment
 	attachments := data.RbacGroupPermissionAttachmentList
 	for i := range attachments {
 		if attachments[i].Name == rbacGroupPermissionAttachment.PermissionName {
-			log.Infof("Found Aviatrix RBAC group permission attachment: %s",
-				rbacGroupPermissionAttachment.GroupName+"~"+rbacGroupPermissionAttachment.PermissionName)
+			tflog.Info(ctx, "Found Aviatrix RBAC group permission attachment", map[string]interface{}{"group_name": rbacGroupPermissionAttachment.GroupName, "permission_name": rbacGroupPermissionAttachment.PermissionName})
 			return rbacGroupPermissionAttachment, nil
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix RBAC group permission attachment: %s",
-		rbacGroupPermissionAttachment.GroupName+"~"+rbacGroupPermissionAttachment.PermissionName)
+	tflog.Error(ctx, "Couldn't find Aviatrix RBAC group permission attachment", map[string]interface{}{"group_name": rbacGroupPermissionAttachment.GroupName, "permission_name": rbacGroupPermissionAttachment.PermissionName})
 	return nil, ErrNotFound
 }
 
 func (c *Client) DeleteRbacGroupPermissionAttachment(rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) error {
+	return c.DeleteRbacGroupPermissionAttachmentContext(context.Background(), rbacGroupPermissionAttachment)
+}
+
+func (c *Client) DeleteRbacGroupPermissionAttachmentContext(ctx context.Context, rbacGroupPermissionAttachment *RbacGroupPermissionAttachment) error {
 	form := map[string]string{
 		"CID":         c.CID,
 		"action":      "delete_permissions_from_rbac_group",
@@ -67,5 +79,5 @@ func (c *Client) DeleteRbacGroupPermissionAttachment(rbacGroupPermissionAttachme
 		"permissions": rbacGroupPermissionAttachment.PermissionName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
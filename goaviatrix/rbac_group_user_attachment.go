@@ -1,9 +1,10 @@
 package goaviatrix
 
 import (
+	"context"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type RbacGroupUserAttachment struct {
@@ -20,13 +21,21 @@ type RbacGroupUserAttachmentListResp struct {
 }
 
 func (c *Client) CreateRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUserAttachment) error {
+	return c.CreateRbacGroupUserAttachmentContext(context.Background(), rbacGroupUserAttachment)
+}
+
+func (c *Client) CreateRbacGroupUserAttachmentContext(ctx context.Context, rbacGroupUserAttachment *RbacGroupUserAttachment) error {
 	rbacGroupUserAttachment.CID = c.CID
 	rbacGroupUserAttachment.Action = "add_users_to_rbac_group"
 
-	return c.PostAPI(rbacGroupUserAttachment.Action, rbacGroupUserAttachment, BasicCheck)
+	return c.PostAPIContext(ctx, rbacGroupUserAttachment.Action, rbacGroupUserAttachment, BasicCheck)
 }
 
 func (c *Client) GetRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUserAttachment) (*RbacGroupUserAttachment, error) {
+	return c.GetRbacGroupUserAttachmentContext(context.Background(), rbacGroupUserAttachment)
+}
+
+func (c *Client) GetRbacGroupUserAttachmentContext(ctx context.Context, rbacGroupUserAttachment *RbacGroupUserAttachment) (*RbacGroupUserAttachment, error) {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "list_users_in_rbac_group",
@@ -35,7 +44,7 @@ func (c *Client) GetRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUs
 
 	var data RbacGroupUserAttachmentListResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -43,18 +52,20 @@ func (c *Client) GetRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUs
 	attachments := data.RbacGroupUserAttachmentList
 	for i := range attachments {
 		if attachments[i] == rbacGroupUserAttachment.UserName {
-			log.Infof("Found Aviatrix RBAC group user attachment: %s",
-				rbacGroupUserAttachment.GroupName+"~"+rbacGroupUserAttachment.UserName)
+			tflog.Info(ctx, "Found Aviatrix RBAC group user attachment", map[string]interface{}{"group_name": rbacGroupUserAttachment.GroupName, "user_name": rbacGroupUserAttachment.UserName})
 			return rbacGroupUserAttachment, nil
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix RBAC group user attachment: %s",
-		rbacGroupUserAttachment.GroupName+"~"+rbacGroupUserAttachment.UserName)
+	tflog.Error(ctx, "Couldn't find Aviatrix RBAC group user attachment", map[string]interface{}{"group_name": rbacGroupUserAttachment.GroupName, "user_name": rbacGroupUserAttachment.UserName})
 	return nil, ErrNotFound
 }
 
 func (c *Client) DeleteRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUserAttachment) error {
+	return c.DeleteRbacGroupUserAttachmentContext(context.Background(), rbacGroupUserAttachment)
+}
+
+func (c *Client) DeleteRbacGroupUserAttachmentContext(ctx context.Context, rbacGroupUserAttachment *RbacGroupUserAttachment) error {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "delete_users_from_rbac_group",
@@ -62,19 +73,23 @@ func (c *Client) DeleteRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGrou
 		"users":      rbacGroupUserAttachment.UserName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 // ListRbacGroupUsers retrieves a list of all users assigned to the specified
 // RBAC group.
 func (c *Client) ListRbacGroupUsers(groupName string) ([]string, error) {
+	return c.ListRbacGroupUsersContext(context.Background(), groupName)
+}
+
+func (c *Client) ListRbacGroupUsersContext(ctx context.Context, groupName string) ([]string, error) {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "list_users_in_rbac_group",
 		"group_name": groupName,
 	}
 	var data RbacGroupUserAttachmentListResp
-	if err := c.GetAPI(&data, form["action"], form, BasicCheck); err != nil {
+	if err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck); err != nil {
 		return nil, err
 	}
 	return data.RbacGroupUserAttachmentList, nil
@@ -82,6 +97,10 @@ func (c *Client) ListRbacGroupUsers(groupName string) ([]string, error) {
 
 // AddRbacGroupUsers adds one or more users to the specified RBAC group.
 func (c *Client) AddRbacGroupUsers(groupName string, users []string) error {
+	return c.AddRbacGroupUsersContext(context.Background(), groupName, users)
+}
+
+func (c *Client) AddRbacGroupUsersContext(ctx context.Context, groupName string, users []string) error {
 	if len(users) == 0 {
 		return nil
 	}
@@ -91,12 +110,16 @@ func (c *Client) AddRbacGroupUsers(groupName string, users []string) error {
 		GroupName: groupName,
 		UserName:  strings.Join(users, ","), // API expects comma-separated in "users"
 	}
-	return c.PostAPI(payload.Action, payload, BasicCheck)
+	return c.PostAPIContext(ctx, payload.Action, payload, BasicCheck)
 }
 
 // DeleteRbacGroupUsers removes one or more users from the specified RBAC
 // group. Takes a group name and a slice of usernames to remove
 func (c *Client) DeleteRbacGroupUsers(groupName string, users []string) error {
+	return c.DeleteRbacGroupUsersContext(context.Background(), groupName, users)
+}
+
+func (c *Client) DeleteRbacGroupUsersContext(ctx context.Context, groupName string, users []string) error {
 	if len(users) == 0 {
 		return nil
 	}
@@ -106,22 +129,26 @@ func (c *Client) DeleteRbacGroupUsers(groupName string, users []string) error {
 		"group_name": groupName,
 		"users":      strings.Join(users, ","),
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 // SetRbacGroupUsers sets the exact membership of an RBAC group by comparing
 // current users with desired users, then adding missing users and removing
 // extra users. Ensures the group contains exactly the specified users.
 func (c *Client) SetRbacGroupUsers(groupName string, desired []string) error {
-	current, err := c.ListRbacGroupUsers(groupName)
+	return c.SetRbacGroupUsersContext(context.Background(), groupName, desired)
+}
+
+func (c *Client) SetRbacGroupUsersContext(ctx context.Context, groupName string, desired []string) error {
+	current, err := c.ListRbacGroupUsersContext(ctx, groupName)
 	if err != nil {
 		return err
 	}
 	toAdd, toDel := diffStrings(current, desired)
-	if err := c.AddRbacGroupUsers(groupName, toAdd); err != nil {
+	if err := c.AddRbacGroupUsersContext(ctx, groupName, toAdd); err != nil {
 		return err
 	}
-	if err := c.DeleteRbacGroupUsers(groupName, toDel); err != nil {
+	if err := c.DeleteRbacGroupUsersContext(ctx, groupName, toDel); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,188 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces the value of sensitive fields in the client logs.
+const RedactedValue = "<redacted>"
+
+// sensitiveKeyFragments are matched against normalized field and header
+// names: any name containing one of them is sensitive.
+var sensitiveKeyFragments = []string{
+	"password",
+	"passwd",
+	"passphrase",
+	"secret",
+	"token",
+	"presharedkey",
+	"accesskey",
+	"privatekey",
+	"integrationkey",
+	"md5key",
+	"apikey",
+	"credential",
+}
+
+// sensitiveKeys are normalized field and header names that are sensitive
+// but too short to be matched as fragments.
+var sensitiveKeys = []string{
+	"cid",
+	"psk",
+	"acckey",
+	"sickey",
+	"authorization",
+	"proxyauthorization",
+	"cookie",
+	"setcookie",
+	"xaccesskey",
+}
+
+// formPairRegexp matches the key=value pairs of form encoded bodies and URL
+// query strings.
+var formPairRegexp = regexp.MustCompile(`([?&\s]|^)([^=&?\s"']+)=([^&\s"']*)`)
+
+// Redactor masks the values of sensitive fields, such as passwords, cloud
+// account secrets, pre-shared keys and session IDs, before request and
+// response data is logged.
+type Redactor struct {
+	keys map[string]bool
+}
+
+// NewRedactor returns a Redactor masking the known sensitive fields and
+// every field or header named in extraKeys. Names are compared ignoring
+// case, underscores, dashes and dots.
+func NewRedactor(extraKeys ...string) *Redactor {
+	r := &Redactor{keys: make(map[string]bool, len(sensitiveKeys)+len(extraKeys))}
+	for _, k := range sensitiveKeys {
+		r.keys[k] = true
+	}
+	for _, k := range extraKeys {
+		if k = normalizeRedactKey(k); k != "" {
+			r.keys[k] = true
+		}
+	}
+	return r
+}
+
+var defaultRedactor = NewRedactor()
+
+// WithRedactedKeys masks the values of the given field and header names in
+// the client logs, in addition to the known sensitive fields.
+func WithRedactedKeys(keys []string) ClientOption {
+	return func(c *Client) {
+		c.Redactor = NewRedactor(keys...)
+	}
+}
+
+// redactor returns the Redactor of the client, falling back to the default
+// one.
+func (c *Client) redactor() *Redactor {
+	if c == nil || c.Redactor == nil {
+		return defaultRedactor
+	}
+	return c.Redactor
+}
+
+func normalizeRedactKey(key string) string {
+	// Nested form fields are encoded as parent.child or parent[child].
+	if i := strings.LastIndexAny(key, ".["); i >= 0 && i < len(key)-1 {
+		key = strings.TrimSuffix(key[i+1:], "]")
+	}
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '_', '-', '.', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToLower(key))
+}
+
+// IsSensitive reports whether the value of the named field or header must
+// be masked.
+func (r *Redactor) IsSensitive(key string) bool {
+	k := normalizeRedactKey(key)
+	if r.keys[k] {
+		return true
+	}
+	for _, f := range sensitiveKeyFragments {
+		if strings.Contains(k, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// Form masks the sensitive values of a form encoded body, a URL or any text
+// containing key=value query parameters.
+func (r *Redactor) Form(s string) string {
+	return formPairRegexp.ReplaceAllStringFunc(s, func(pair string) string {
+		m := formPairRegexp.FindStringSubmatch(pair)
+		if m[3] == "" || !r.IsSensitive(m[2]) {
+			return pair
+		}
+		return m[1] + m[2] + "=" + RedactedValue
+	})
+}
+
+// JSON masks the sensitive values of a JSON body. Bodies that are not valid
+// JSON are masked as form data.
+func (r *Redactor) JSON(body []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return r.Form(string(body))
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(r.redactJSONValue(v)); err != nil {
+		return RedactedValue
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func (r *Redactor) redactJSONValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if r.IsSensitive(k) && val != nil && val != "" {
+				v[k] = RedactedValue
+				continue
+			}
+			v[k] = r.redactJSONValue(val)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = r.redactJSONValue(v[i])
+		}
+	}
+	return v
+}
+
+// Value returns a loggable representation of a request or response object
+// with its sensitive fields masked.
+func (r *Redactor) Value(i interface{}) string {
+	b, err := json.Marshal(i)
+	if err != nil {
+		return fmt.Sprintf("%T", i)
+	}
+	return r.JSON(b)
+}
+
+// Header returns a copy of h with the values of sensitive headers masked.
+func (r *Redactor) Header(h http.Header) http.Header {
+	out := make(http.Header, len(h))
+	for k, v := range h {
+		if r.IsSensitive(k) {
+			out[k] = []string{RedactedValue}
+			continue
+		}
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,140 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactorIsSensitive(t *testing.T) {
+	r := NewRedactor("gw_name")
+
+	for _, k := range []string{
+		"password", "aws_secret_key", "arm_application_client_secret", "AwsSecretKey", "edge_csp_password",
+		"pre_shared_key", "backup_pre_shared_key", "pre_shared_key_tun_1", "bgp_md5_key", "api_token",
+		"CID", "aws_access_key", "account_secret_access_key", "private_key", "gcloud_project_credentials_local",
+		"Authorization", "X-Access-Key", "Set-Cookie", "account.password", "gateway[pre_shared_key]", "gw_name",
+	} {
+		assert.True(t, r.IsSensitive(k), k)
+	}
+	for _, k := range []string{
+		"action", "account_name", "vpc_cidr", "cidr", "key_name", "ssh_public_key", "Content-Type", "username",
+	} {
+		assert.False(t, r.IsSensitive(k), k)
+	}
+	assert.False(t, NewRedactor().IsSensitive("gw_name"))
+}
+
+func TestRedactorForm(t *testing.T) {
+	r := NewRedactor()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "form body",
+			in:   "CID=abc123&action=add_account&aws_secret_key=s3cr3t&account_name=aws",
+			want: "CID=<redacted>&action=add_account&aws_secret_key=<redacted>&account_name=aws",
+		},
+		{
+			name: "url",
+			in:   "https://10.0.0.1/v2/api?CID=abc123&action=list_accounts",
+			want: "https://10.0.0.1/v2/api?CID=<redacted>&action=list_accounts",
+		},
+		{
+			name: "error text",
+			in:   `Get "https://10.0.0.1/v2/api?action=login&password=p%40ss": EOF`,
+			want: `Get "https://10.0.0.1/v2/api?action=login&password=<redacted>": EOF`,
+		},
+		{
+			name: "first field after text",
+			in:   "POST https://10.0.0.1/v2/api Body: password=p%40ss&username=admin",
+			want: "POST https://10.0.0.1/v2/api Body: password=<redacted>&username=admin",
+		},
+		{
+			name: "empty value",
+			in:   "action=login&password=",
+			want: "action=login&password=",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, r.Form(tt.in))
+		})
+	}
+}
+
+func TestRedactorJSON(t *testing.T) {
+	r := NewRedactor()
+
+	got := r.JSON([]byte(`{"CID":"abc123","name":"edge","sites":[{"edge_csp_password":"p@ss","id":1}],"nested":{"api_token":"t0k3n"}}`))
+	assert.Equal(t, `{"CID":"<redacted>","name":"edge","nested":{"api_token":"<redacted>"},"sites":[{"edge_csp_password":"<redacted>","id":1}]}`, got)
+
+	assert.Equal(t, "password=<redacted>", r.JSON([]byte("password=p%40ss")))
+}
+
+func TestRedactorValue(t *testing.T) {
+	r := NewRedactor()
+
+	got := r.Value(&Account{AccountName: "aws", AwsSecretKey: "s3cr3t", ArmApplicationClientSecret: "arm-s3cr3t"})
+	assert.Contains(t, got, `"account_name":"aws"`)
+	assert.NotContains(t, got, "s3cr3t")
+
+	assert.Equal(t, "chan int", r.Value(make(chan int)))
+}
+
+func TestRedactorHeader(t *testing.T) {
+	h := http.Header{
+		"Authorization": {"Bearer t0k3n"},
+		"X-Access-Key":  {"t0k3n"},
+		"Content-Type":  {"application/json"},
+	}
+
+	got := NewRedactor().Header(h)
+
+	assert.Equal(t, []string{RedactedValue}, got["Authorization"])
+	assert.Equal(t, []string{RedactedValue}, got["X-Access-Key"])
+	assert.Equal(t, []string{"application/json"}, got["Content-Type"])
+	assert.Equal(t, []string{"Bearer t0k3n"}, h["Authorization"])
+}
+
+func TestClientRequestLogsAreRedacted(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_AVIATRIX_API", "TRACE")
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+
+	client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"return": true, "results": "done"}`))
+	})
+	client.CID = "s3cr3t-cid"
+	WithRedactedKeys([]string{"account_name"})(client)
+
+	form := map[string]string{
+		"CID":            client.CID,
+		"action":         "add_account",
+		"account_name":   "prod-account",
+		"aws_secret_key": "s3cr3t-key",
+	}
+	err := client.PostAPIContext(ctx, "add_account", form, BasicCheck)
+	assert.NoError(t, err)
+
+	_, err = client.RequestContextLogin(ctx, "POST", client.baseURL, form, "t0k3n")
+	assert.NoError(t, err)
+
+	_, err = client.RequestContext2(ctx, "POST", client.baseURL, map[string]interface{}{"edge_csp_password": "s3cr3t-pass"})
+	assert.NoError(t, err)
+
+	logs := buf.String()
+	assert.Contains(t, logs, `"aviatrix_action":"add_account"`)
+	assert.Contains(t, logs, "edge_csp_password")
+	for _, secret := range []string{"s3cr3t-cid", "s3cr3t-key", "s3cr3t-pass", "prod-account"} {
+		assert.NotContains(t, logs, secret)
+	}
+}
@@ -5,11 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type RemoteSyslog struct {
@@ -62,6 +63,10 @@ type RemoteSyslogResp struct {
 }
 
 func (c *Client) EnableRemoteSyslog(r *RemoteSyslog) error {
+	return c.EnableRemoteSyslogContext(context.Background(), r)
+}
+
+func (c *Client) EnableRemoteSyslogContext(ctx context.Context, r *RemoteSyslog) error {
 	params := map[string]string{
 		"action":               "enable_remote_syslog_logging",
 		"CID":                  c.CID,
@@ -106,10 +111,14 @@ func (c *Client) EnableRemoteSyslog(r *RemoteSyslog) error {
 		files = append(files, ca)
 	}
 
-	return c.PostFileAPI(params, files, BasicCheck)
+	return c.PostFileAPIContext(ctx, params, files, BasicCheck)
 }
 
 func (c *Client) GetRemoteSyslogStatus(idx int) (*RemoteSyslogResp, error) {
+	return c.GetRemoteSyslogStatusContext(context.Background(), idx)
+}
+
+func (c *Client) GetRemoteSyslogStatusContext(ctx context.Context, idx int) (*RemoteSyslogResp, error) {
 	params := map[string]string{
 		"action": "get_remote_syslog_logging_status",
 		"CID":    c.CID,
@@ -124,7 +133,7 @@ func (c *Client) GetRemoteSyslogStatus(idx int) (*RemoteSyslogResp, error) {
 
 	var data Resp
 
-	err := c.GetAPIRemoteSyslog(&data, params["action"], params, BasicCheck)
+	err := c.GetAPIRemoteSyslogContext(ctx, &data, params["action"], params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -137,18 +146,26 @@ func (c *Client) GetRemoteSyslogStatus(idx int) (*RemoteSyslogResp, error) {
 }
 
 func (c *Client) DisableRemoteSyslog(idx int) error {
+	return c.DisableRemoteSyslogContext(context.Background(), idx)
+}
+
+func (c *Client) DisableRemoteSyslogContext(ctx context.Context, idx int) error {
 	params := map[string]string{
 		"action": "disable_remote_syslog_logging",
 		"CID":    c.CID,
 		"index":  strconv.Itoa(idx),
 	}
 
-	log.Printf("[INFO] Deleting remote syslog index %d", idx)
+	tflog.Info(ctx, "Deleting remote syslog index", map[string]interface{}{"idx": idx})
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
 
 func (c *Client) GetAPIRemoteSyslog(v interface{}, action string, d map[string]string, checkFunc CheckAPIResponseFunc) error {
+	return c.GetAPIRemoteSyslogContext(context.Background(), v, action, d, checkFunc)
+}
+
+func (c *Client) GetAPIRemoteSyslogContext(ctx context.Context, v interface{}, action string, d map[string]string, checkFunc CheckAPIResponseFunc) error {
 	Url, err := c.urlEncode(d)
 	if err != nil {
 		return fmt.Errorf("could not url encode values for action %q: %v", action, err)
@@ -158,7 +175,7 @@ func (c *Client) GetAPIRemoteSyslog(v interface{}, action string, d map[string]s
 	var resp *http.Response
 	for {
 		try++
-		resp, err = c.GetContext(context.Background(), Url, nil)
+		resp, err = c.GetContext(ctx, Url, nil)
 		if err == nil {
 			break
 		}
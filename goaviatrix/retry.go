@@ -0,0 +1,342 @@
+package goaviatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultRetryMaxAttempts    = 5
+	DefaultRetryBaseBackoff    = 500 * time.Millisecond
+	DefaultRetryMaxBackoff     = 30 * time.Second
+	DefaultAsyncPollInterval   = 10 * time.Second
+	DefaultAsyncPollTimeout    = 60 * time.Minute
+	defaultRetryJitterFraction = 0.5
+	retryAfterHeader           = "Retry-After"
+)
+
+// readOnlyActionPrefixes are the v2 API action prefixes that never change
+// controller state, so requests for them can be safely replayed.
+var readOnlyActionPrefixes = []string{"get_", "list_", "check_", "show_"}
+
+// RetryPolicy controls how the Client retries requests that fail because of
+// transient network or controller errors.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts for a single request,
+	// including the first one. Values lower than 1 are treated as 1.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry. It doubles after every
+	// failed attempt, up to MaxBackoff, and is randomized with jitter.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts.
+	MaxBackoff time.Duration
+	// RetryableStatusCodes are the HTTP status codes considered transient.
+	RetryableStatusCodes []int
+	// RetryableReasons are substrings of non-JSON response bodies, such as
+	// proxy error pages, considered transient.
+	RetryableReasons []string
+	// RetryNonIdempotent allows requests which may change controller state
+	// to be retried after failures that could have reached the controller.
+	// By default such requests are only retried when the controller
+	// explicitly did not process them (429 and 503) or the connection could
+	// not be established.
+	RetryNonIdempotent bool
+	// AsyncPollInterval is the wait between two check_task_status polls of
+	// an asynchronous API.
+	AsyncPollInterval time.Duration
+	// AsyncPollTimeout is the longest time an asynchronous API is polled
+	// before giving up. A context deadline shorter than this wins.
+	AsyncPollTimeout time.Duration
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when none is configured.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:          DefaultRetryMaxAttempts,
+		BaseBackoff:          DefaultRetryBaseBackoff,
+		MaxBackoff:           DefaultRetryMaxBackoff,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+		RetryableReasons:     []string{"502 Proxy Error", "503 Service Unavailable"},
+		AsyncPollInterval:    DefaultAsyncPollInterval,
+		AsyncPollTimeout:     DefaultAsyncPollTimeout,
+	}
+}
+
+// ClientOption configures optional behaviour of a Client created by NewClient.
+type ClientOption func(*Client)
+
+// WithRetryPolicy sets the RetryPolicy used for every request of the Client.
+func WithRetryPolicy(p *RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.RetryPolicy = p
+	}
+}
+
+func (c *Client) retryPolicy() *RetryPolicy {
+	if c.RetryPolicy == nil {
+		return DefaultRetryPolicy()
+	}
+	return c.RetryPolicy
+}
+
+// Backoff returns the randomized wait before retrying after the given failed
+// attempt, starting at 1.
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	if p.BaseBackoff <= 0 {
+		return 0
+	}
+	d := p.BaseBackoff
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	jitter := time.Duration(float64(d) * defaultRetryJitterFraction)
+	if jitter <= 0 {
+		return d
+	}
+	return d - jitter + time.Duration(rand.Int63n(int64(jitter)+1))
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) isRetryableStatus(code int) bool {
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRetryableReason reports whether a response body or reason matches one of
+// the transient reasons of the policy.
+func (p *RetryPolicy) IsRetryableReason(body string) bool {
+	for _, r := range p.RetryableReasons {
+		if r != "" && strings.Contains(body, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// shouldRetry decides whether a request should be sent again after it
+// returned the given response and error.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, idempotent bool, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	replayable := idempotent || p.RetryNonIdempotent
+	if err != nil {
+		return replayable || isDialError(err)
+	}
+	if resp == nil {
+		return false
+	}
+	notProcessed := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+	if p.isRetryableStatus(resp.StatusCode) {
+		return replayable || notProcessed
+	}
+	if resp.StatusCode >= 400 && !strings.Contains(resp.Header.Get("Content-Type"), "json") && len(p.RetryableReasons) > 0 {
+		body, readErr := peekBody(resp)
+		return readErr == nil && replayable && p.IsRetryableReason(body)
+	}
+	return false
+}
+
+// isDialError reports whether err happened before the request could reach
+// the controller, in which case the request is always safe to replay.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// isIdempotent reports whether a request can be sent more than once without
+// changing the outcome.
+func isIdempotent(verb, action string) bool {
+	switch verb {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	for _, prefix := range readOnlyActionPrefixes {
+		if strings.HasPrefix(action, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestAction returns the v2 API action of a request payload, if any.
+func requestAction(i interface{}) string {
+	if i == nil {
+		return ""
+	}
+	v := reflect.ValueOf(i)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return ""
+		}
+		a := v.MapIndex(reflect.ValueOf("action"))
+		if !a.IsValid() {
+			return ""
+		}
+		if s, ok := a.Interface().(string); ok {
+			return s
+		}
+	case reflect.Struct:
+		f := v.FieldByName("Action")
+		if f.IsValid() && f.Kind() == reflect.String {
+			return f.String()
+		}
+	}
+	return ""
+}
+
+// peekBody reads the whole response body and replaces it with a new reader
+// so that it can be read again by the caller.
+func peekBody(resp *http.Response) (string, error) {
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(strings.NewReader(string(b)))
+	return string(b), err
+}
+
+// retryAfter returns the wait requested by the controller through the
+// Retry-After header, if any.
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+	s, err := strconv.Atoi(resp.Header.Get(retryAfterHeader))
+	if err != nil || s < 0 {
+		return 0
+	}
+	return time.Duration(s) * time.Second
+}
+
+// sleepContext waits for d or until ctx is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// do sends the request built by newReq and retries transient failures
+// according to the RetryPolicy of the client. Every attempt is subject to
+// the rate limit and concurrency cap of the client. newReq is called once
+// per attempt so that request bodies can be replayed. Every attempt is
+// logged to the APILogSubsystem with its latency and retry count.
+func (c *Client) do(ctx context.Context, verb, action string, newReq func() (*http.Request, error)) (*http.Response, error) {
+	p := c.retryPolicy()
+	var logCtx context.Context
+
+	for attempt := 1; ; attempt++ {
+		req, err := newReq()
+		if err != nil {
+			return nil, err
+		}
+		if action == "" {
+			// GET requests carry the action in the query string
+			action = req.URL.Query().Get("action")
+		}
+		if logCtx == nil {
+			logCtx = apiLogContext(ctx, verb, action, req.URL.Path)
+		}
+		idempotent := isIdempotent(verb, action)
+
+		fields := map[string]interface{}{
+			APILogFieldURL:        c.redactor().Form(req.URL.String()),
+			APILogFieldAttempt:    attempt,
+			APILogFieldRetryCount: attempt - 1,
+		}
+		tflog.SubsystemTrace(logCtx, APILogSubsystem, "Sending controller API request", fields)
+
+		release, err := c.acquire(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("waiting to send %s %s: %w", verb, action, err)
+		}
+		start := time.Now()
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			release()
+		} else {
+			// The request holds its slot until the response body is read.
+			resp.Body = &releaseOnDone{ReadCloser: resp.Body, release: release}
+		}
+
+		fields[APILogFieldLatency] = time.Since(start).Milliseconds()
+		if err != nil {
+			fields[APILogFieldError] = c.redactor().Form(err.Error())
+		} else {
+			fields[APILogFieldStatusCode] = resp.StatusCode
+		}
+		logDone := func() {
+			if err != nil {
+				tflog.SubsystemWarn(logCtx, APILogSubsystem, "Controller API request failed", fields)
+				return
+			}
+			tflog.SubsystemDebug(logCtx, APILogSubsystem, "Received controller API response", fields)
+		}
+
+		if attempt >= p.maxAttempts() || !p.shouldRetry(ctx, idempotent, resp, err) {
+			logDone()
+			return resp, err
+		}
+
+		wait := p.Backoff(attempt)
+		if ra := retryAfter(resp); ra > wait {
+			wait = ra
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			// Not enough time left for another attempt, return what we have.
+			logDone()
+			return resp, err
+		}
+
+		if err == nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		fields[APILogFieldWait] = wait.String()
+		tflog.SubsystemWarn(logCtx, APILogSubsystem, "Controller API request failed with a transient error, retrying", fields)
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, fmt.Errorf("giving up retrying %s %s: %w", verb, action, err)
+		}
+	}
+}
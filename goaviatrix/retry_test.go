@@ -0,0 +1,272 @@
+package goaviatrix
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestRetryPolicy returns a policy with short waits so tests run quickly.
+func newTestRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.BaseBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	p.AsyncPollInterval = time.Millisecond
+	p.AsyncPollTimeout = time.Second
+	return p
+}
+
+// newTestControllerClient starts a stand-in controller serving handler and
+// returns a Client pointed at it.
+func newTestControllerClient(t *testing.T, handler http.HandlerFunc) *Client {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return &Client{
+		HTTPClient:  srv.Client(),
+		CID:         "mockCID",
+		baseURL:     srv.URL + "/v2/api",
+		RetryPolicy: newTestRetryPolicy(),
+	}
+}
+
+// failingHandler answers the first failures requests with status and body
+// and every following request with a successful API response.
+func failingHandler(calls *int32, failures int32, status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(calls, 1) <= failures {
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"return": true, "results": "done"}`))
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		verb          string
+		action        string
+		status        int
+		body          string
+		failures      int32
+		maxAttempts   int
+		expectedCalls int32
+		expectSuccess bool
+	}{
+		{
+			name:          "GET is retried on bad gateway",
+			verb:          "GET",
+			action:        "list_accounts",
+			status:        http.StatusBadGateway,
+			failures:      2,
+			maxAttempts:   5,
+			expectedCalls: 3,
+			expectSuccess: true,
+		},
+		{
+			name:          "read-only POST action is retried on bad gateway",
+			verb:          "POST",
+			action:        "get_gateway_info",
+			status:        http.StatusBadGateway,
+			failures:      1,
+			maxAttempts:   5,
+			expectedCalls: 2,
+			expectSuccess: true,
+		},
+		{
+			name:          "mutating POST action is not retried on bad gateway",
+			verb:          "POST",
+			action:        "create_gateway",
+			status:        http.StatusBadGateway,
+			failures:      1,
+			maxAttempts:   5,
+			expectedCalls: 1,
+			expectSuccess: false,
+		},
+		{
+			name:          "mutating POST action is retried on service unavailable",
+			verb:          "POST",
+			action:        "create_gateway",
+			status:        http.StatusServiceUnavailable,
+			failures:      1,
+			maxAttempts:   5,
+			expectedCalls: 2,
+			expectSuccess: true,
+		},
+		{
+			name:          "retries stop after max attempts",
+			verb:          "GET",
+			action:        "list_accounts",
+			status:        http.StatusGatewayTimeout,
+			failures:      10,
+			maxAttempts:   3,
+			expectedCalls: 3,
+			expectSuccess: false,
+		},
+		{
+			name:          "proxy error page is retried",
+			verb:          "GET",
+			action:        "list_accounts",
+			status:        http.StatusInternalServerError,
+			body:          "<html>502 Proxy Error</html>",
+			failures:      1,
+			maxAttempts:   5,
+			expectedCalls: 2,
+			expectSuccess: true,
+		},
+		{
+			name:          "non transient status is not retried",
+			verb:          "GET",
+			action:        "list_accounts",
+			status:        http.StatusNotFound,
+			body:          "Not Found",
+			failures:      1,
+			maxAttempts:   5,
+			expectedCalls: 1,
+			expectSuccess: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			client := newTestControllerClient(t, failingHandler(&calls, tt.failures, tt.status, tt.body))
+			client.RetryPolicy.MaxAttempts = tt.maxAttempts
+
+			form := map[string]string{
+				"action": tt.action,
+				"CID":    client.CID,
+			}
+			var resp *http.Response
+			var err error
+			if tt.verb == "GET" {
+				var url string
+				url, err = client.urlEncode(form)
+				if err != nil {
+					t.Fatalf("unexpected error encoding url: %v", err)
+				}
+				resp, err = client.RequestContext(context.Background(), "GET", url, nil)
+			} else {
+				resp, err = client.RequestContext(context.Background(), "POST", client.baseURL, form)
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assert.Equal(t, tt.expectSuccess, resp.StatusCode == http.StatusOK)
+			assert.Equal(t, tt.expectedCalls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestRetryPolicyNonIdempotentOptIn(t *testing.T) {
+	var calls int32
+	client := newTestControllerClient(t, failingHandler(&calls, 1, http.StatusBadGateway, "Bad Gateway"))
+	client.RetryPolicy.RetryNonIdempotent = true
+
+	err := client.PostAPIContext(context.Background(), "create_gateway", map[string]string{"action": "create_gateway"}, BasicCheck)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestRetryPolicyHonorsContextDeadline(t *testing.T) {
+	var calls int32
+	client := newTestControllerClient(t, failingHandler(&calls, 10, http.StatusServiceUnavailable, "Service Unavailable"))
+	client.RetryPolicy.BaseBackoff = time.Minute
+	client.RetryPolicy.MaxBackoff = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	err := client.GetAPIContext(ctx, nil, "list_accounts", map[string]string{"action": "list_accounts"}, BasicCheck)
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{
+		BaseBackoff: 100 * time.Millisecond,
+		MaxBackoff:  time.Second,
+	}
+	for attempt := 1; attempt <= 10; attempt++ {
+		d := p.Backoff(attempt)
+		assert.LessOrEqual(t, d, time.Second)
+		assert.Greater(t, d, time.Duration(0))
+	}
+	assert.LessOrEqual(t, p.Backoff(1), 100*time.Millisecond)
+	assert.GreaterOrEqual(t, p.Backoff(1), 50*time.Millisecond)
+	assert.GreaterOrEqual(t, p.Backoff(10), 500*time.Millisecond)
+}
+
+func TestPostAsyncAPIContext(t *testing.T) {
+	t.Run("polls until the task finishes", func(t *testing.T) {
+		var polls int32
+		client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = r.ParseForm()
+			if r.Form.Get("action") != "check_task_status" {
+				_, _ = w.Write([]byte(`{"return": true, "results": "request-1"}`))
+				return
+			}
+			switch atomic.AddInt32(&polls, 1) {
+			case 1:
+				w.Header().Set("Content-Type", "text/html")
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("502 Proxy Error"))
+			case 2:
+				_, _ = w.Write([]byte(`{"return": false, "reason": "REQUEST_IN_PROGRESS"}`))
+			default:
+				_, _ = w.Write([]byte(`{"return": true, "results": "done"}`))
+			}
+		})
+		client.RetryPolicy.MaxAttempts = 1
+
+		err := client.PostAsyncAPIContext(context.Background(), "upgrade", map[string]string{"action": "upgrade"}, BasicCheck)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&polls))
+	})
+
+	t.Run("gives up after the poll timeout", func(t *testing.T) {
+		client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = r.ParseForm()
+			if r.Form.Get("action") != "check_task_status" {
+				_, _ = w.Write([]byte(`{"return": true, "results": "request-1"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"return": false, "reason": "REQUEST_IN_PROGRESS"}`))
+		})
+		client.RetryPolicy.AsyncPollTimeout = 50 * time.Millisecond
+
+		err := client.PostAsyncAPIContext(context.Background(), "upgrade", map[string]string{"action": "upgrade"}, BasicCheck)
+		assert.ErrorContains(t, err, "never finished")
+	})
+
+	t.Run("stops when the context is cancelled", func(t *testing.T) {
+		client := newTestControllerClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = r.ParseForm()
+			if r.Form.Get("action") != "check_task_status" {
+				_, _ = w.Write([]byte(`{"return": true, "results": "request-1"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"return": false, "reason": "REQUEST_IN_PROGRESS"}`))
+		})
+		client.RetryPolicy.AsyncPollTimeout = time.Hour
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		err := client.PostAsyncAPIContext(ctx, "upgrade", map[string]string{"action": "upgrade"}, BasicCheck)
+		assert.ErrorContains(t, err, "stopped waiting")
+	})
+}
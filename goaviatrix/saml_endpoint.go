@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
 )
@@ -44,13 +45,21 @@ type SamlResp struct {
 }
 
 func (c *Client) CreateSamlEndpoint(samlEndpoint *SamlEndpoint) error {
+	return c.CreateSamlEndpointContext(context.Background(), samlEndpoint)
+}
+
+func (c *Client) CreateSamlEndpointContext(ctx context.Context, samlEndpoint *SamlEndpoint) error {
 	samlEndpoint.CID = c.CID
 	samlEndpoint.Action = "create_saml_endpoint"
 
-	return c.PostAPI(samlEndpoint.Action, samlEndpoint, BasicCheck)
+	return c.PostAPIContext(ctx, samlEndpoint.Action, samlEndpoint, BasicCheck)
 }
 
 func (c *Client) GetSamlEndpoint(samlEndpoint *SamlEndpoint) (*SamlEndpointInfo, error) {
+	return c.GetSamlEndpointContext(context.Background(), samlEndpoint)
+}
+
+func (c *Client) GetSamlEndpointContext(ctx context.Context, samlEndpoint *SamlEndpoint) (*SamlEndpointInfo, error) {
 	form := map[string]string{
 		"CID":           c.CID,
 		"action":        "get_saml_endpoint_information",
@@ -69,7 +78,7 @@ func (c *Client) GetSamlEndpoint(samlEndpoint *SamlEndpoint) (*SamlEndpointInfo,
 		return nil
 	}
 
-	err := c.GetAPI(&data, form["action"], form, checkFunc)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, checkFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -79,18 +88,26 @@ func (c *Client) GetSamlEndpoint(samlEndpoint *SamlEndpoint) (*SamlEndpointInfo,
 }
 
 func (c *Client) EditSamlEndpoint(samlEndpoint *SamlEndpoint) error {
+	return c.EditSamlEndpointContext(context.Background(), samlEndpoint)
+}
+
+func (c *Client) EditSamlEndpointContext(ctx context.Context, samlEndpoint *SamlEndpoint) error {
 	samlEndpoint.CID = c.CID
 	samlEndpoint.Action = "edit_saml_endpoint"
 
-	return c.PostAPI("edit_saml_endpoint", samlEndpoint, BasicCheck)
+	return c.PostAPIContext(ctx, "edit_saml_endpoint", samlEndpoint, BasicCheck)
 }
 
 func (c *Client) DeleteSamlEndpoint(samlEndpoint *SamlEndpoint) error {
+	return c.DeleteSamlEndpointContext(context.Background(), samlEndpoint)
+}
+
+func (c *Client) DeleteSamlEndpointContext(ctx context.Context, samlEndpoint *SamlEndpoint) error {
 	form := map[string]string{
 		"CID":           c.CID,
 		"action":        "delete_saml_endpoint",
 		"endpoint_name": samlEndpoint.EndPointName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
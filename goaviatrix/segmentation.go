@@ -1,5 +1,9 @@
 package goaviatrix
 
+import (
+	"context"
+)
+
 import "strings"
 
 type SegmentationSecurityDomain struct {
@@ -18,26 +22,38 @@ type SegmentationSecurityDomainAssociation struct {
 }
 
 func (c *Client) CreateSegmentationSecurityDomain(domain *SegmentationSecurityDomain) error {
+	return c.CreateSegmentationSecurityDomainContext(context.Background(), domain)
+}
+
+func (c *Client) CreateSegmentationSecurityDomainContext(ctx context.Context, domain *SegmentationSecurityDomain) error {
 	action := "add_multi_cloud_security_domain"
 	data := map[string]interface{}{
 		"action":      action,
 		"CID":         c.CID,
 		"domain_name": domain.DomainName,
 	}
-	return c.PostAPI(action, data, BasicCheck)
+	return c.PostAPIContext(ctx, action, data, BasicCheck)
 }
 
 func (c *Client) DeleteSegmentationSecurityDomain(domain *SegmentationSecurityDomain) error {
+	return c.DeleteSegmentationSecurityDomainContext(context.Background(), domain)
+}
+
+func (c *Client) DeleteSegmentationSecurityDomainContext(ctx context.Context, domain *SegmentationSecurityDomain) error {
 	action := "delete_multi_cloud_security_domain"
 	data := map[string]interface{}{
 		"action":      action,
 		"CID":         c.CID,
 		"domain_name": domain.DomainName,
 	}
-	return c.PostAPI(action, data, BasicCheck)
+	return c.PostAPIContext(ctx, action, data, BasicCheck)
 }
 
 func (c *Client) ListSegmentationSecurityDomains() ([]string, error) {
+	return c.ListSegmentationSecurityDomainsContext(context.Background())
+}
+
+func (c *Client) ListSegmentationSecurityDomainsContext(ctx context.Context) ([]string, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_multi_cloud_security_domain_names",
@@ -51,7 +67,7 @@ func (c *Client) ListSegmentationSecurityDomains() ([]string, error) {
 
 	var data Resp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +75,11 @@ func (c *Client) ListSegmentationSecurityDomains() ([]string, error) {
 }
 
 func (c *Client) GetSegmentationSecurityDomain(domain *SegmentationSecurityDomain) (*SegmentationSecurityDomain, error) {
-	domainNames, err := c.ListSegmentationSecurityDomains()
+	return c.GetSegmentationSecurityDomainContext(context.Background(), domain)
+}
+
+func (c *Client) GetSegmentationSecurityDomainContext(ctx context.Context, domain *SegmentationSecurityDomain) (*SegmentationSecurityDomain, error) {
+	domainNames, err := c.ListSegmentationSecurityDomainsContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +91,10 @@ func (c *Client) GetSegmentationSecurityDomain(domain *SegmentationSecurityDomai
 }
 
 func (c *Client) CreateSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) error {
+	return c.CreateSegmentationSecurityDomainConnectionPolicyContext(context.Background(), policy)
+}
+
+func (c *Client) CreateSegmentationSecurityDomainConnectionPolicyContext(ctx context.Context, policy *SegmentationSecurityDomainConnectionPolicy) error {
 	action := "connect_multi_cloud_security_domains"
 	data := map[string]interface{}{
 		"action":            action,
@@ -78,10 +102,14 @@ func (c *Client) CreateSegmentationSecurityDomainConnectionPolicy(policy *Segmen
 		"domain_name":       policy.Domain1.DomainName,
 		"other_domain_name": policy.Domain2.DomainName,
 	}
-	return c.PostAPI(action, data, BasicCheck)
+	return c.PostAPIContext(ctx, action, data, BasicCheck)
 }
 
 func (c *Client) DeleteSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) error {
+	return c.DeleteSegmentationSecurityDomainConnectionPolicyContext(context.Background(), policy)
+}
+
+func (c *Client) DeleteSegmentationSecurityDomainConnectionPolicyContext(ctx context.Context, policy *SegmentationSecurityDomainConnectionPolicy) error {
 	action := "disconnect_multi_cloud_security_domains"
 	data := map[string]interface{}{
 		"action":            action,
@@ -89,10 +117,14 @@ func (c *Client) DeleteSegmentationSecurityDomainConnectionPolicy(policy *Segmen
 		"domain_name":       policy.Domain1.DomainName,
 		"other_domain_name": policy.Domain2.DomainName,
 	}
-	return c.PostAPI(action, data, BasicCheck)
+	return c.PostAPIContext(ctx, action, data, BasicCheck)
 }
 
 func (c *Client) GetSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) (*SegmentationSecurityDomainConnectionPolicy, error) {
+	return c.GetSegmentationSecurityDomainConnectionPolicyContext(context.Background(), policy)
+}
+
+func (c *Client) GetSegmentationSecurityDomainConnectionPolicyContext(ctx context.Context, policy *SegmentationSecurityDomainConnectionPolicy) (*SegmentationSecurityDomainConnectionPolicy, error) {
 	form := map[string]string{
 		"CID":         c.CID,
 		"action":      "list_multi_cloud_security_domain_connection_policy",
@@ -112,7 +144,7 @@ func (c *Client) GetSegmentationSecurityDomainConnectionPolicy(policy *Segmentat
 
 	var data Resp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +158,10 @@ func (c *Client) GetSegmentationSecurityDomainConnectionPolicy(policy *Segmentat
 }
 
 func (c *Client) CreateSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) error {
+	return c.CreateSegmentationSecurityDomainAssociationContext(context.Background(), association)
+}
+
+func (c *Client) CreateSegmentationSecurityDomainAssociationContext(ctx context.Context, association *SegmentationSecurityDomainAssociation) error {
 	action := "associate_attachment_to_multi_cloud_security_domain"
 	data := map[string]interface{}{
 		"action":          action,
@@ -133,10 +169,14 @@ func (c *Client) CreateSegmentationSecurityDomainAssociation(association *Segmen
 		"attachment_name": association.AttachmentName,
 		"domain_name":     association.SecurityDomainName,
 	}
-	return c.PostAPI(action, data, BasicCheck)
+	return c.PostAPIContext(ctx, action, data, BasicCheck)
 }
 
 func (c *Client) DeleteSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) error {
+	return c.DeleteSegmentationSecurityDomainAssociationContext(context.Background(), association)
+}
+
+func (c *Client) DeleteSegmentationSecurityDomainAssociationContext(ctx context.Context, association *SegmentationSecurityDomainAssociation) error {
 	action := "disassociate_attachment_from_multi_cloud_security_domain"
 	data := map[string]interface{}{
 		"action":          action,
@@ -144,10 +184,14 @@ func (c *Client) DeleteSegmentationSecurityDomainAssociation(association *Segmen
 		"attachment_name": association.AttachmentName,
 		"domain_name":     association.SecurityDomainName,
 	}
-	return c.PostAPI(action, data, BasicCheck)
+	return c.PostAPIContext(ctx, action, data, BasicCheck)
 }
 
 func (c *Client) GetSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) (*SegmentationSecurityDomainAssociation, error) {
+	return c.GetSegmentationSecurityDomainAssociationContext(context.Background(), association)
+}
+
+func (c *Client) GetSegmentationSecurityDomainAssociationContext(ctx context.Context, association *SegmentationSecurityDomainAssociation) (*SegmentationSecurityDomainAssociation, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_multi_cloud_domain_attachments",
@@ -172,7 +216,7 @@ func (c *Client) GetSegmentationSecurityDomainAssociation(association *Segmentat
 
 	var data Resp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
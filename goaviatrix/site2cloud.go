@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -78,10 +79,12 @@ type Site2Cloud struct {
 	EnableSingleIpHA              bool
 	Phase1LocalIdentifier         string
 	Phase1RemoteIdentifier        string
-	AuthType                      string `form:"auth_type,omitempty"`
-	CaCertTagName                 string `form:"cert_name,omitempty"`
-	RemoteIdentifier              string `form:"cert_based_s2c_remote_id,omitempty"`
-	BackupRemoteIdentifier        string `form:"cert_based_s2c_ha_remote_id,omitempty"`
+	AuthType                      string       `form:"auth_type,omitempty"`
+	CaCertTagName                 string       `form:"cert_name,omitempty"`
+	RemoteIdentifier              string       `form:"cert_based_s2c_remote_id,omitempty"`
+	BackupRemoteIdentifier        string       `form:"cert_based_s2c_ha_remote_id,omitempty"`
+	Status                        string       `form:"-" json:"status,omitempty"`
+	Tunnels                       []TunnelInfo `form:"-" json:"-"`
 }
 
 type EditSite2Cloud struct {
@@ -215,6 +218,10 @@ type AlgorithmInfo struct {
 }
 
 func (c *Client) CreateSite2Cloud(site2cloud *Site2Cloud) error {
+	return c.CreateSite2CloudContext(context.Background(), site2cloud)
+}
+
+func (c *Client) CreateSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) error {
 	form := map[string]string{}
 	form["CID"] = c.CID
 	form["CID"] = c.CID
@@ -309,10 +316,14 @@ func (c *Client) CreateSite2Cloud(site2cloud *Site2Cloud) error {
 		form["enable_single_ip_ha"] = "true"
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetSite2Cloud(site2cloud *Site2Cloud) (*Site2Cloud, error) {
+	return c.GetSite2CloudContext(context.Background(), site2cloud)
+}
+
+func (c *Client) GetSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) (*Site2Cloud, error) {
 	form := map[string]string{
 		"CID":             c.CID,
 		"action":          "list_site2cloud_conn",
@@ -321,7 +332,7 @@ func (c *Client) GetSite2Cloud(site2cloud *Site2Cloud) (*Site2Cloud, error) {
 
 	var data Site2CloudResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -335,7 +346,27 @@ func (c *Client) GetSite2Cloud(site2cloud *Site2Cloud) (*Site2Cloud, error) {
 	return nil, ErrNotFound
 }
 
+// ListSite2CloudContext returns the summary and status of all the site2cloud
+// connections.
+func (c *Client) ListSite2CloudContext(ctx context.Context) ([]Site2Cloud, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_site2cloud_conn",
+	}
+
+	var data Site2CloudResp
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return data.Results.Connections, nil
+}
+
 func (c *Client) GetSite2CloudConnDetail(site2cloud *Site2Cloud) (*Site2Cloud, error) {
+	return c.GetSite2CloudConnDetailContext(context.Background(), site2cloud)
+}
+
+func (c *Client) GetSite2CloudConnDetailContext(ctx context.Context, site2cloud *Site2Cloud) (*Site2Cloud, error) {
 	form := map[string]string{
 		"CID":       c.CID,
 		"action":    "get_site2cloud_conn_detail",
@@ -352,7 +383,7 @@ func (c *Client) GetSite2CloudConnDetail(site2cloud *Site2Cloud) (*Site2Cloud, e
 		return nil
 	}
 	var data Site2CloudConnDetailResp
-	err := c.GetAPI(&data, form["action"], form, check)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, check)
 	if err != nil {
 		return nil, err
 	}
@@ -381,6 +412,7 @@ func (c *Client) GetSite2CloudConnDetail(site2cloud *Site2Cloud) (*Site2Cloud, e
 			site2cloud.LocalSubnet = s2cConnDetail.LocalCidr
 		}
 		site2cloud.HAEnabled = s2cConnDetail.HAEnabled
+		site2cloud.Tunnels = s2cConnDetail.Tunnels
 		for i := range s2cConnDetail.Tunnels {
 			if s2cConnDetail.Tunnels[i].GwName == site2cloud.GwName {
 				site2cloud.RemoteGwIP = s2cConnDetail.Tunnels[i].PeerIP
@@ -469,20 +501,32 @@ func (c *Client) GetSite2CloudConnDetail(site2cloud *Site2Cloud) (*Site2Cloud, e
 }
 
 func (c *Client) UpdateSite2Cloud(site2cloud *EditSite2Cloud) error {
+	return c.UpdateSite2CloudContext(context.Background(), site2cloud)
+}
+
+func (c *Client) UpdateSite2CloudContext(ctx context.Context, site2cloud *EditSite2Cloud) error {
 	site2cloud.CID = c.CID
 	site2cloud.Action = "edit_site2cloud_conn"
 
-	return c.PostAPI(site2cloud.Action, site2cloud, BasicCheck)
+	return c.PostAPIContext(ctx, site2cloud.Action, site2cloud, BasicCheck)
 }
 
 func (c *Client) DeleteSite2Cloud(site2cloud *Site2Cloud) error {
+	return c.DeleteSite2CloudContext(context.Background(), site2cloud)
+}
+
+func (c *Client) DeleteSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) error {
 	site2cloud.CID = c.CID
 	site2cloud.Action = "delete_site2cloud_connection"
 
-	return c.PostAPI(site2cloud.Action, site2cloud, BasicCheck)
+	return c.PostAPIContext(ctx, site2cloud.Action, site2cloud, BasicCheck)
 }
 
 func (c *Client) EnableDeadPeerDetection(site2cloud *Site2Cloud) error {
+	return c.EnableDeadPeerDetectionContext(context.Background(), site2cloud)
+}
+
+func (c *Client) EnableDeadPeerDetectionContext(ctx context.Context, site2cloud *Site2Cloud) error {
 	form := map[string]string{
 		"CID":             c.CID,
 		"action":          "enable_dpd_config",
@@ -490,10 +534,14 @@ func (c *Client) EnableDeadPeerDetection(site2cloud *Site2Cloud) error {
 		"connection_name": site2cloud.TunnelName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) DisableDeadPeerDetection(site2cloud *Site2Cloud) error {
+	return c.DisableDeadPeerDetectionContext(context.Background(), site2cloud)
+}
+
+func (c *Client) DisableDeadPeerDetectionContext(ctx context.Context, site2cloud *Site2Cloud) error {
 	form := map[string]string{
 		"CID":             c.CID,
 		"action":          "disable_dpd_config",
@@ -501,10 +549,14 @@ func (c *Client) DisableDeadPeerDetection(site2cloud *Site2Cloud) error {
 		"connection_name": site2cloud.TunnelName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EnableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
+	return c.EnableSite2cloudActiveActiveContext(context.Background(), site2cloud)
+}
+
+func (c *Client) EnableSite2cloudActiveActiveContext(ctx context.Context, site2cloud *Site2Cloud) error {
 	form := map[string]string{
 		"CID":             c.CID,
 		"action":          "enable_site2cloud_active_active_ha",
@@ -522,10 +574,14 @@ func (c *Client) EnableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
 		return nil
 	}
 
-	return c.PostAPI(form["action"], form, checkFunc)
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
 }
 
 func (c *Client) DisableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
+	return c.DisableSite2cloudActiveActiveContext(context.Background(), site2cloud)
+}
+
+func (c *Client) DisableSite2cloudActiveActiveContext(ctx context.Context, site2cloud *Site2Cloud) error {
 	form := map[string]string{
 		"CID":             c.CID,
 		"action":          "disable_site2cloud_active_active_ha",
@@ -543,50 +599,70 @@ func (c *Client) DisableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
 		return nil
 	}
 
-	return c.PostAPI(form["action"], form, checkFunc)
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
 }
 
 func (c *Client) EnableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) error {
+	return c.EnableSpokeMappedSite2CloudForwardingContext(context.Background(), site2cloud)
+}
+
+func (c *Client) EnableSpokeMappedSite2CloudForwardingContext(ctx context.Context, site2cloud *Site2Cloud) error {
 	data := map[string]string{
 		"CID":             c.CID,
 		"action":          "enable_spoke_mapped_site2cloud_forwarding",
 		"vpc_id":          site2cloud.VpcID,
 		"connection_name": site2cloud.TunnelName,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) DisableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) error {
+	return c.DisableSpokeMappedSite2CloudForwardingContext(context.Background(), site2cloud)
+}
+
+func (c *Client) DisableSpokeMappedSite2CloudForwardingContext(ctx context.Context, site2cloud *Site2Cloud) error {
 	data := map[string]string{
 		"CID":             c.CID,
 		"action":          "disable_spoke_mapped_site2cloud_forwarding",
 		"vpc_id":          site2cloud.VpcID,
 		"connection_name": site2cloud.TunnelName,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) EnableSite2CloudEventTriggeredHA(vpcID, connectionName string) error {
+	return c.EnableSite2CloudEventTriggeredHAContext(context.Background(), vpcID, connectionName)
+}
+
+func (c *Client) EnableSite2CloudEventTriggeredHAContext(ctx context.Context, vpcID, connectionName string) error {
 	data := map[string]string{
 		"CID":             c.CID,
 		"action":          "enable_site2cloud_event_triggered_ha",
 		"vpc_id":          vpcID,
 		"connection_name": connectionName,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) DisableSite2CloudEventTriggeredHA(vpcID, connectionName string) error {
+	return c.DisableSite2CloudEventTriggeredHAContext(context.Background(), vpcID, connectionName)
+}
+
+func (c *Client) DisableSite2CloudEventTriggeredHAContext(ctx context.Context, vpcID, connectionName string) error {
 	data := map[string]string{
 		"CID":             c.CID,
 		"action":          "disable_site2cloud_event_triggered_ha",
 		"vpc_id":          vpcID,
 		"connection_name": connectionName,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) EditSite2CloudPhase1LocalIdentifier(s2c *EditSite2Cloud) error {
+	return c.EditSite2CloudPhase1LocalIdentifierContext(context.Background(), s2c)
+}
+
+func (c *Client) EditSite2CloudPhase1LocalIdentifierContext(ctx context.Context, s2c *EditSite2Cloud) error {
 	data := map[string]string{
 		"CID":               c.CID,
 		"action":            "edit_site2cloud_conn",
@@ -605,7 +681,7 @@ func (c *Client) EditSite2CloudPhase1LocalIdentifier(s2c *EditSite2Cloud) error
 		return nil
 	}
 
-	return c.PostAPI(data["action"], data, checkFunc)
+	return c.PostAPIContext(ctx, data["action"], data, checkFunc)
 }
 
 func S2CPh1RemoteIdDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
 )
@@ -33,6 +34,10 @@ type SplitTunnelUnit struct {
 }
 
 func (c *Client) GetSplitTunnel(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error) {
+	return c.GetSplitTunnelContext(context.Background(), splitTunnel)
+}
+
+func (c *Client) GetSplitTunnelContext(ctx context.Context, splitTunnel *SplitTunnel) (*SplitTunnelUnit, error) {
 	form := map[string]string{
 		"CID":     c.CID,
 		"action":  "modify_split_tunnel",
@@ -43,7 +48,7 @@ func (c *Client) GetSplitTunnel(splitTunnel *SplitTunnel) (*SplitTunnelUnit, err
 
 	var data SplitTunnelResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
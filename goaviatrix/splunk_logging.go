@@ -1,5 +1,7 @@
 package goaviatrix
 
+import "context"
+
 type SplunkLoggingResp struct {
 	Server           string   `json:"server_ip"`
 	Port             string   `json:"server_port"`
@@ -9,6 +11,10 @@ type SplunkLoggingResp struct {
 }
 
 func (c *Client) GetSplunkLoggingStatus() (*SplunkLoggingResp, error) {
+	return c.GetSplunkLoggingStatusContext(context.Background())
+}
+
+func (c *Client) GetSplunkLoggingStatusContext(ctx context.Context) (*SplunkLoggingResp, error) {
 	params := map[string]string{
 		"action": "get_splunk_logging_status",
 		"CID":    c.CID,
@@ -22,7 +28,7 @@ func (c *Client) GetSplunkLoggingStatus() (*SplunkLoggingResp, error) {
 
 	var data Resp
 
-	err := c.GetAPI(&data, params["action"], params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -35,10 +41,14 @@ func (c *Client) GetSplunkLoggingStatus() (*SplunkLoggingResp, error) {
 }
 
 func (c *Client) DisableSplunkLogging() error {
+	return c.DisableSplunkLoggingContext(context.Background())
+}
+
+func (c *Client) DisableSplunkLoggingContext(ctx context.Context) error {
 	params := map[string]string{
 		"action": "disable_splunk_logging",
 		"CID":    c.CID,
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
@@ -1,10 +1,18 @@
 package goaviatrix
 
+import (
+	"context"
+)
+
 import "strings"
 
 func (c *Client) EditSpokeExternalDeviceConnASPathPrepend(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error {
+	return c.EditSpokeExternalDeviceConnASPathPrependContext(context.Background(), externalDeviceConn, prependASPath)
+}
+
+func (c *Client) EditSpokeExternalDeviceConnASPathPrependContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn, prependASPath []string) error {
 	action := "edit_spoke_connection_as_path_prepend"
-	return c.PostAPI(action, struct {
+	return c.PostAPIContext(ctx, action, struct {
 		CID            string `form:"CID"`
 		Action         string `form:"action"`
 		GatewayName    string `form:"gateway_name"`
@@ -120,6 +120,10 @@ func (c *Client) DeleteSpokeGatewaySubnetGroup(ctx context.Context, spokeGateway
 }
 
 func (c *Client) GetSubnetsForInspection(gatewayName string) ([]string, error) {
+	return c.GetSubnetsForInspectionContext(context.Background(), gatewayName)
+}
+
+func (c *Client) GetSubnetsForInspectionContext(ctx context.Context, gatewayName string) ([]string, error) {
 	form := map[string]string{
 		"action":       "list_spoke_gateway_subnets",
 		"CID":          c.CID,
@@ -134,7 +138,7 @@ func (c *Client) GetSubnetsForInspection(gatewayName string) ([]string, error) {
 
 	var data Resp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
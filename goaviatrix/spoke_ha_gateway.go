@@ -34,8 +34,12 @@ type APIRespHaGw struct {
 }
 
 func (c *Client) CreateSpokeHaGw(spokeHaGateway *SpokeHaGateway) (string, error) {
+	return c.CreateSpokeHaGwContext(context.Background(), spokeHaGateway)
+}
+
+func (c *Client) CreateSpokeHaGwContext(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error) {
 	spokeHaGateway.CID = c.CID
 	spokeHaGateway.Action = "create_multicloud_ha_gateway"
 
-	return c.PostAPIContext2HaGw(context.Background(), nil, spokeHaGateway.Action, spokeHaGateway, BasicCheck)
+	return c.PostAPIContext2HaGw(ctx, nil, spokeHaGateway.Action, spokeHaGateway, BasicCheck)
 }
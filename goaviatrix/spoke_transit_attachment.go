@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type SpokeTransitAttachment struct {
@@ -65,6 +64,10 @@ func (c *Client) CreateSpokeTransitAttachment(ctx context.Context, spokeTransitA
 }
 
 func (c *Client) GetSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error) {
+	return c.GetSpokeTransitAttachmentContext(context.Background(), spokeTransitAttachment)
+}
+
+func (c *Client) GetSpokeTransitAttachmentContext(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error) {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "get_gateway_info",
@@ -74,7 +77,7 @@ func (c *Client) GetSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitA
 	checkFunc := func(act, method, reason string, ret bool) error {
 		if !ret {
 			if strings.Contains(reason, "does not exist") {
-				log.Errorf("Couldn't find Spoke Transit Attachment: %s", reason)
+				tflog.Error(ctx, "Couldn't find Spoke Transit Attachment", map[string]interface{}{"reason": reason})
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
@@ -84,7 +87,7 @@ func (c *Client) GetSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitA
 
 	var data GatewayDetailApiResp
 
-	err := c.GetAPI(&data, form["action"], form, checkFunc)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, checkFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -97,15 +100,19 @@ func (c *Client) GetSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitA
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix gateway %s", spokeTransitAttachment.SpokeGwName)
+	tflog.Error(ctx, "Couldn't find Aviatrix gateway", map[string]interface{}{"spoke_gw_name": spokeTransitAttachment.SpokeGwName})
 	return nil, ErrNotFound
 }
 
 func (c *Client) DeleteSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitAttachment) error {
+	return c.DeleteSpokeTransitAttachmentContext(context.Background(), spokeTransitAttachment)
+}
+
+func (c *Client) DeleteSpokeTransitAttachmentContext(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error {
 	action := "detach_spoke_from_transit_gw"
 	spokeTransitAttachment.CID = c.CID
 	spokeTransitAttachment.Action = action
-	return c.PostAPI(action, spokeTransitAttachment, BasicCheck)
+	return c.PostAPIContext(ctx, action, spokeTransitAttachment, BasicCheck)
 }
 
 func (c *Client) GetEdgeSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error) {
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
 )
@@ -97,11 +98,15 @@ type SpokeGatewayAdvancedConfigRespResult struct {
 }
 
 func (c *Client) LaunchSpokeVpc(spoke *SpokeVpc) error {
+	return c.LaunchSpokeVpcContext(context.Background(), spoke)
+}
+
+func (c *Client) LaunchSpokeVpcContext(ctx context.Context, spoke *SpokeVpc) error {
 	spoke.CID = c.CID
 	spoke.Action = "create_multicloud_primary_gateway"
 	spoke.Async = true
 
-	return c.PostAsyncAPI(spoke.Action, spoke, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, spoke.Action, spoke, BasicCheck)
 }
 
 func (c *Client) SpokeJoinTransit(spoke *SpokeVpc) error {
@@ -201,6 +206,10 @@ func (c *Client) DisableAutoAdvertiseS2CCidrs(gateway *Gateway) error {
 }
 
 func (c *Client) GetSpokeGatewayAdvancedConfig(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error) {
+	return c.GetSpokeGatewayAdvancedConfigContext(context.Background(), spokeGateway)
+}
+
+func (c *Client) GetSpokeGatewayAdvancedConfigContext(ctx context.Context, spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error) {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "list_aviatrix_spoke_advanced_config",
@@ -209,7 +218,7 @@ func (c *Client) GetSpokeGatewayAdvancedConfig(spokeGateway *SpokeVpc) (*SpokeGa
 
 	var data SpokeGatewayAdvancedConfigResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -255,26 +264,38 @@ func (c *Client) GetSpokeGatewayAdvancedConfig(spokeGateway *SpokeVpc) (*SpokeGa
 }
 
 func (c *Client) EnableSpokeConnectionLearnedCIDRApproval(gwName, connName string) error {
+	return c.EnableSpokeConnectionLearnedCIDRApprovalContext(context.Background(), gwName, connName)
+}
+
+func (c *Client) EnableSpokeConnectionLearnedCIDRApprovalContext(ctx context.Context, gwName, connName string) error {
 	data := map[string]string{
 		"action":          "enable_bgp_connection_cidr_approval",
 		"CID":             c.CID,
 		"gateway_name":    gwName,
 		"connection_name": connName,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) DisableSpokeConnectionLearnedCIDRApproval(gwName, connName string) error {
+	return c.DisableSpokeConnectionLearnedCIDRApprovalContext(context.Background(), gwName, connName)
+}
+
+func (c *Client) DisableSpokeConnectionLearnedCIDRApprovalContext(ctx context.Context, gwName, connName string) error {
 	data := map[string]string{
 		"action":          "disable_bgp_connection_cidr_approval",
 		"CID":             c.CID,
 		"gateway_name":    gwName,
 		"connection_name": connName,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) UpdateSpokeConnectionPendingApprovedCidrs(gwName, connName string, approvedCidrs []string) error {
+	return c.UpdateSpokeConnectionPendingApprovedCidrsContext(context.Background(), gwName, connName, approvedCidrs)
+}
+
+func (c *Client) UpdateSpokeConnectionPendingApprovedCidrsContext(ctx context.Context, gwName, connName string, approvedCidrs []string) error {
 	data := map[string]string{
 		"action":          "set_bgp_connection_approved_cidr_rules",
 		"CID":             c.CID,
@@ -282,10 +303,14 @@ func (c *Client) UpdateSpokeConnectionPendingApprovedCidrs(gwName, connName stri
 		"connection_name": connName,
 		"cidr_rules":      strings.Join(approvedCidrs, ","),
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) EditSpokeConnectionBGPManualAdvertiseCIDRs(gwName, connName string, cidrs []string) error {
+	return c.EditSpokeConnectionBGPManualAdvertiseCIDRsContext(context.Background(), gwName, connName, cidrs)
+}
+
+func (c *Client) EditSpokeConnectionBGPManualAdvertiseCIDRsContext(ctx context.Context, gwName, connName string, cidrs []string) error {
 	data := map[string]string{
 		"action":                                "edit_spoke_connection_bgp_manual_advertise_cidrs",
 		"CID":                                   c.CID,
@@ -293,7 +318,7 @@ func (c *Client) EditSpokeConnectionBGPManualAdvertiseCIDRs(gwName, connName str
 		"connection_name":                       connName,
 		"connection_bgp_manual_advertise_cidrs": strings.Join(cidrs, ","),
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) SetBgpEcmpSpoke(spokeGateway *SpokeVpc, enabled bool) error {
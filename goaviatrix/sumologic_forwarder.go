@@ -1,5 +1,7 @@
 package goaviatrix
 
+import "context"
+
 type SumologicForwarderResp struct {
 	AccessID         string   `json:"acc_id"`
 	AccessKey        string   `json:"acc_key"`
@@ -10,6 +12,10 @@ type SumologicForwarderResp struct {
 }
 
 func (c *Client) GetSumologicForwarderStatus() (*SumologicForwarderResp, error) {
+	return c.GetSumologicForwarderStatusContext(context.Background())
+}
+
+func (c *Client) GetSumologicForwarderStatusContext(ctx context.Context) (*SumologicForwarderResp, error) {
 	params := map[string]string{
 		"action": "get_sumologic_logging_status",
 		"CID":    c.CID,
@@ -23,7 +29,7 @@ func (c *Client) GetSumologicForwarderStatus() (*SumologicForwarderResp, error)
 
 	var data Resp
 
-	err := c.GetAPI(&data, params["action"], params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -36,10 +42,14 @@ func (c *Client) GetSumologicForwarderStatus() (*SumologicForwarderResp, error)
 }
 
 func (c *Client) DisableSumologicForwarder() error {
+	return c.DisableSumologicForwarderContext(context.Background())
+}
+
+func (c *Client) DisableSumologicForwarderContext(ctx context.Context) error {
 	params := map[string]string{
 		"action": "disable_sumologic_logging",
 		"CID":    c.CID,
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"strconv"
 	"strings"
 )
@@ -92,6 +93,78 @@ func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	return result
 }
 
+// DefaultTagsConfig holds the tags added to every taggable resource managed
+// by the provider.
+type DefaultTagsConfig struct {
+	Tags KeyValueTags
+}
+
+// WithDefaultTags sets the tags added to every taggable resource created or
+// updated through the Client.
+func WithDefaultTags(tags map[string]string) ClientOption {
+	return func(c *Client) {
+		if len(tags) == 0 {
+			c.DefaultTagsConfig = nil
+			return
+		}
+		c.DefaultTagsConfig = &DefaultTagsConfig{Tags: tags}
+	}
+}
+
+// HasTags reports whether any default tags are configured.
+func (config *DefaultTagsConfig) HasTags() bool {
+	return config != nil && len(config.Tags) > 0
+}
+
+// MergeTags returns the default tags merged with tags. Tags set on the
+// resource take precedence over default tags with the same key.
+func (config *DefaultTagsConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if !config.HasTags() {
+		return tags
+	}
+
+	return config.Tags.Merge(tags)
+}
+
+// Merge returns a new KeyValueTags holding tags and mergeTags. Values in
+// mergeTags take precedence.
+func (tags KeyValueTags) Merge(mergeTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags, len(tags)+len(mergeTags))
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	for k, v := range mergeTags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// RemoveDefaultConfig returns tags without the default tags, so that only the
+// tags set on the resource itself remain. Keys in keep are never removed, so
+// a resource tag with the same key and value as a default tag is preserved.
+func (tags KeyValueTags) RemoveDefaultConfig(config *DefaultTagsConfig, keep KeyValueTags) KeyValueTags {
+	if !config.HasTags() {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := keep[k]; !ok {
+			if defaultVal, ok := config.Tags[k]; ok && defaultVal == v {
+				continue
+			}
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 func (c *Client) AddTags(tags *Tags) error {
 	tags.CID = c.CID
 	tags.Action = "add_resource_tags"
@@ -100,6 +173,10 @@ func (c *Client) AddTags(tags *Tags) error {
 }
 
 func (c *Client) GetTags(tags *Tags) ([]string, error) {
+	return c.GetTagsContext(context.Background(), tags)
+}
+
+func (c *Client) GetTagsContext(ctx context.Context, tags *Tags) ([]string, error) {
 	data := map[string]string{
 		"action":        "list_resource_tags",
 		"CID":           c.CID,
@@ -108,7 +185,7 @@ func (c *Client) GetTags(tags *Tags) ([]string, error) {
 		"resource_name": tags.ResourceName,
 	}
 	var resp TagAPIResp
-	err := c.GetAPI(&resp, data["action"], data, BasicCheck)
+	err := c.GetAPIContext(ctx, &resp, data["action"], data, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
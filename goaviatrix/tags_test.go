@@ -0,0 +1,99 @@
+package goaviatrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultTagsConfigMergeTags(t *testing.T) {
+	tt := []struct {
+		Name     string
+		Defaults *DefaultTagsConfig
+		Tags     KeyValueTags
+		Expected KeyValueTags
+	}{
+		{
+			"no defaults",
+			nil,
+			KeyValueTags{"env": "prod"},
+			KeyValueTags{"env": "prod"},
+		},
+		{
+			"defaults only",
+			&DefaultTagsConfig{Tags: KeyValueTags{"owner": "netops"}},
+			nil,
+			KeyValueTags{"owner": "netops"},
+		},
+		{
+			"disjoint keys",
+			&DefaultTagsConfig{Tags: KeyValueTags{"owner": "netops"}},
+			KeyValueTags{"env": "prod"},
+			KeyValueTags{"owner": "netops", "env": "prod"},
+		},
+		{
+			"resource tag wins",
+			&DefaultTagsConfig{Tags: KeyValueTags{"owner": "netops", "env": "dev"}},
+			KeyValueTags{"env": "prod"},
+			KeyValueTags{"owner": "netops", "env": "prod"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			assert.Equal(t, test.Expected, test.Defaults.MergeTags(test.Tags))
+		})
+	}
+}
+
+func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
+	defaults := &DefaultTagsConfig{Tags: KeyValueTags{"owner": "netops", "env": "dev"}}
+
+	tt := []struct {
+		Name     string
+		Tags     KeyValueTags
+		Keep     KeyValueTags
+		Expected KeyValueTags
+	}{
+		{
+			"only defaults",
+			KeyValueTags{"owner": "netops", "env": "dev"},
+			nil,
+			KeyValueTags{},
+		},
+		{
+			"overridden default is kept",
+			KeyValueTags{"owner": "netops", "env": "prod"},
+			nil,
+			KeyValueTags{"env": "prod"},
+		},
+		{
+			"resource tag equal to default is kept",
+			KeyValueTags{"owner": "netops", "env": "dev"},
+			KeyValueTags{"env": "dev"},
+			KeyValueTags{"env": "dev"},
+		},
+		{
+			"unrelated tags are kept",
+			KeyValueTags{"owner": "netops", "app": "web"},
+			nil,
+			KeyValueTags{"app": "web"},
+		},
+	}
+
+	for _, test := range tt {
+		t.Run(test.Name, func(t *testing.T) {
+			assert.Equal(t, test.Expected, test.Tags.RemoveDefaultConfig(defaults, test.Keep))
+		})
+	}
+}
+
+func TestWithDefaultTags(t *testing.T) {
+	client := &Client{}
+	WithDefaultTags(map[string]string{"owner": "netops"})(client)
+	assert.True(t, client.DefaultTagsConfig.HasTags())
+
+	WithDefaultTags(nil)(client)
+	assert.Nil(t, client.DefaultTagsConfig)
+	assert.False(t, client.DefaultTagsConfig.HasTags())
+}
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -196,13 +197,21 @@ type ExternalDeviceConnDetailList struct {
 }
 
 func (c *Client) CreateExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
+	return c.CreateExternalDeviceConnContext(context.Background(), externalDeviceConn)
+}
+
+func (c *Client) CreateExternalDeviceConnContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn) error {
 	externalDeviceConn.CID = c.CID
 	externalDeviceConn.Action = "connect_transit_gw_to_external_device"
 
-	return c.PostAPI(externalDeviceConn.Action, externalDeviceConn, BasicCheck)
+	return c.PostAPIContext(ctx, externalDeviceConn.Action, externalDeviceConn, BasicCheck)
 }
 
 func (c *Client) GetExternalDeviceConnDetail(externalDeviceConn *ExternalDeviceConn, localGateway *Gateway) (*ExternalDeviceConn, error) {
+	return c.GetExternalDeviceConnDetailContext(context.Background(), externalDeviceConn, localGateway)
+}
+
+func (c *Client) GetExternalDeviceConnDetailContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn, localGateway *Gateway) (*ExternalDeviceConn, error) {
 	params := map[string]string{
 		"CID":       c.CID,
 		"action":    "get_site2cloud_conn_detail",
@@ -219,7 +228,7 @@ func (c *Client) GetExternalDeviceConnDetail(externalDeviceConn *ExternalDeviceC
 		return nil
 	}
 	var data ExternalDeviceConnDetailResp
-	err := c.GetAPI(&data, params["action"], params, checkFunc)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, checkFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -252,10 +261,14 @@ func (c *Client) GetExternalDeviceConnDetail(externalDeviceConn *ExternalDeviceC
 }
 
 func (c *Client) DeleteExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
+	return c.DeleteExternalDeviceConnContext(context.Background(), externalDeviceConn)
+}
+
+func (c *Client) DeleteExternalDeviceConnContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn) error {
 	externalDeviceConn.CID = c.CID
 	externalDeviceConn.Action = "disconnect_transit_gw"
 
-	return c.PostAPI(externalDeviceConn.Action, externalDeviceConn, BasicCheck)
+	return c.PostAPIContext(ctx, externalDeviceConn.Action, externalDeviceConn, BasicCheck)
 }
 
 func ExternalDeviceConnBgpBfdDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
@@ -367,8 +380,12 @@ func GetUpdatedBgpBfdConfig(bgpBfdConfig []interface{}) BgpBfdConfig {
 }
 
 func (c *Client) EditTransitExternalDeviceConnASPathPrepend(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error {
+	return c.EditTransitExternalDeviceConnASPathPrependContext(context.Background(), externalDeviceConn, prependASPath)
+}
+
+func (c *Client) EditTransitExternalDeviceConnASPathPrependContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn, prependASPath []string) error {
 	action := "edit_transit_connection_as_path_prepend"
-	return c.PostAPI(action, struct {
+	return c.PostAPIContext(ctx, action, struct {
 		CID            string `form:"CID"`
 		Action         string `form:"action"`
 		GatewayName    string `form:"gateway_name"`
@@ -384,6 +401,10 @@ func (c *Client) EditTransitExternalDeviceConnASPathPrepend(externalDeviceConn *
 }
 
 func (c *Client) EditConnectionBgpBfd(externalDeviceConn *ExternalDeviceConn) error {
+	return c.EditConnectionBgpBfdContext(context.Background(), externalDeviceConn)
+}
+
+func (c *Client) EditConnectionBgpBfdContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn) error {
 	action := "edit_connection_bgp_bfd"
 	data := map[string]interface{}{
 		"CID":                c.CID,
@@ -397,10 +418,14 @@ func (c *Client) EditConnectionBgpBfd(externalDeviceConn *ExternalDeviceConn) er
 		data["connection_bgp_bfd_transmit_interval"] = externalDeviceConn.BgpBfdConfig.TransmitInterval
 		data["connection_bgp_bfd_detect_multiplier"] = externalDeviceConn.BgpBfdConfig.Multiplier
 	}
-	return c.PostAPI(action, data, BasicCheck)
+	return c.PostAPIContext(ctx, action, data, BasicCheck)
 }
 
 func (c *Client) EditConnectionBgpMultihop(externalDeviceConn *ExternalDeviceConn) error {
+	return c.EditConnectionBgpMultihopContext(context.Background(), externalDeviceConn)
+}
+
+func (c *Client) EditConnectionBgpMultihopContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn) error {
 	var action string
 	if externalDeviceConn.EnableBgpMultihop {
 		action = "enable_connection_bgp_multihop"
@@ -413,17 +438,25 @@ func (c *Client) EditConnectionBgpMultihop(externalDeviceConn *ExternalDeviceCon
 		"gateway_name":    externalDeviceConn.GwName,
 		"connection_name": externalDeviceConn.ConnectionName,
 	}
-	return c.PostAPI(action, data, BasicCheck)
+	return c.PostAPIContext(ctx, action, data, BasicCheck)
 }
 
 func (c *Client) EditBgpMd5Key(editBgpMd5Key *EditBgpMd5Key) error {
+	return c.EditBgpMd5KeyContext(context.Background(), editBgpMd5Key)
+}
+
+func (c *Client) EditBgpMd5KeyContext(ctx context.Context, editBgpMd5Key *EditBgpMd5Key) error {
 	editBgpMd5Key.CID = c.CID
 	editBgpMd5Key.Action = "update_bgp_connection_md5_signature"
 
-	return c.PostAPI(editBgpMd5Key.Action, editBgpMd5Key, BasicCheck)
+	return c.PostAPIContext(ctx, editBgpMd5Key.Action, editBgpMd5Key, BasicCheck)
 }
 
 func (c *Client) EnableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
+	return c.EnableJumboFrameExternalDeviceConnContext(context.Background(), externalDeviceConn)
+}
+
+func (c *Client) EnableJumboFrameExternalDeviceConnContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn) error {
 	params := map[string]string{
 		"CID":             c.CID,
 		"action":          "enable_jumbo_frame_on_connection_to_cloudn",
@@ -441,10 +474,14 @@ func (c *Client) EnableJumboFrameExternalDeviceConn(externalDeviceConn *External
 		return nil
 	}
 
-	return c.PostAPI(externalDeviceConn.Action, params, checkFunc)
+	return c.PostAPIContext(ctx, externalDeviceConn.Action, params, checkFunc)
 }
 
 func (c *Client) DisableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
+	return c.DisableJumboFrameExternalDeviceConnContext(context.Background(), externalDeviceConn)
+}
+
+func (c *Client) DisableJumboFrameExternalDeviceConnContext(ctx context.Context, externalDeviceConn *ExternalDeviceConn) error {
 	params := map[string]string{
 		"CID":             c.CID,
 		"action":          "disable_jumbo_frame_on_connection_to_cloudn",
@@ -462,10 +499,14 @@ func (c *Client) DisableJumboFrameExternalDeviceConn(externalDeviceConn *Externa
 		return nil
 	}
 
-	return c.PostAPI(externalDeviceConn.Action, params, checkFunc)
+	return c.PostAPIContext(ctx, externalDeviceConn.Action, params, checkFunc)
 }
 
 func (c *Client) ConnectionBGPSendCommunities(bgpSendCommunities *BgpSendCommunities) error {
+	return c.ConnectionBGPSendCommunitiesContext(context.Background(), bgpSendCommunities)
+}
+
+func (c *Client) ConnectionBGPSendCommunitiesContext(ctx context.Context, bgpSendCommunities *BgpSendCommunities) error {
 	bgpSendCommunities.CID = c.CID
 	bgpSendCommunities.Action = "edit_connection_bgp_send_communities"
 
@@ -479,7 +520,7 @@ func (c *Client) ConnectionBGPSendCommunities(bgpSendCommunities *BgpSendCommuni
 		"connection_bgp_send_communities_block":    fmt.Sprint(bgpSendCommunities.ConnSendBlock),
 	}
 
-	return c.PostAPI(params["action"], params, BasicCheck)
+	return c.PostAPIContext(ctx, params["action"], params, BasicCheck)
 }
 
 // configureHAForTwoDevices configures HA settings when there are two external devices
@@ -1,7 +1,9 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type TransitFireNetPolicy struct {
@@ -22,6 +24,10 @@ type TransitFireNetPolicyEdit struct {
 }
 
 func (c *Client) CreateTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPolicy) error {
+	return c.CreateTransitFireNetPolicyContext(context.Background(), transitFireNetPolicy)
+}
+
+func (c *Client) CreateTransitFireNetPolicyContext(ctx context.Context, transitFireNetPolicy *TransitFireNetPolicy) error {
 	form := map[string]string{
 		"CID":                  c.CID,
 		"action":               "add_spoke_to_transit_firenet_inspection",
@@ -29,10 +35,14 @@ func (c *Client) CreateTransitFireNetPolicy(transitFireNetPolicy *TransitFireNet
 		"spoke_gateway_name":   transitFireNetPolicy.InspectedResourceName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPolicy) error {
+	return c.GetTransitFireNetPolicyContext(context.Background(), transitFireNetPolicy)
+}
+
+func (c *Client) GetTransitFireNetPolicyContext(ctx context.Context, transitFireNetPolicy *TransitFireNetPolicy) error {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "list_transit_firenet_spoke_policies",
@@ -41,14 +51,13 @@ func (c *Client) GetTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPol
 
 	var data TransitFireNetPolicyAPIResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return err
 	}
 
 	if len(data.Results) == 0 {
-		log.Errorf("transit firenet policy between transit firenet gateway: %s and inspected resource name: %s not found",
-			transitFireNetPolicy.TransitFireNetGatewayName, transitFireNetPolicy.InspectedResourceName)
+		tflog.Error(ctx, "Transit FireNet policy not found", map[string]interface{}{"transit_fire_net_gateway_name": transitFireNetPolicy.TransitFireNetGatewayName, "inspected_resource_name": transitFireNetPolicy.InspectedResourceName})
 		return ErrNotFound
 	}
 	policyList := data.Results
@@ -66,6 +75,10 @@ func (c *Client) GetTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPol
 }
 
 func (c *Client) DeleteTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPolicy) error {
+	return c.DeleteTransitFireNetPolicyContext(context.Background(), transitFireNetPolicy)
+}
+
+func (c *Client) DeleteTransitFireNetPolicyContext(ctx context.Context, transitFireNetPolicy *TransitFireNetPolicy) error {
 	form := map[string]string{
 		"CID":                  c.CID,
 		"action":               "delete_spoke_from_transit_firenet_inspection",
@@ -73,5 +86,5 @@ func (c *Client) DeleteTransitFireNetPolicy(transitFireNetPolicy *TransitFireNet
 		"spoke_gateway_name":   transitFireNetPolicy.InspectedResourceName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
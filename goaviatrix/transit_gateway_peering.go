@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type TransitGatewayPeering struct {
@@ -102,6 +102,10 @@ func (c *Client) CreateTransitGatewayPeering(ctx context.Context, transitGateway
 }
 
 func (c *Client) GetTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error {
+	return c.GetTransitGatewayPeeringContext(context.Background(), transitGatewayPeering)
+}
+
+func (c *Client) GetTransitGatewayPeeringContext(ctx context.Context, transitGatewayPeering *TransitGatewayPeering) error {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_inter_transit_gateway_peering",
@@ -109,14 +113,13 @@ func (c *Client) GetTransitGatewayPeering(transitGatewayPeering *TransitGatewayP
 
 	var data TransitGatewayPeeringAPIResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return err
 	}
 
 	if len(data.Results) == 0 {
-		log.Errorf("Transit gateway peering with gateways %s and %s not found",
-			transitGatewayPeering.TransitGatewayName1, transitGatewayPeering.TransitGatewayName2)
+		tflog.Error(ctx, "Transit gateway peering not found", map[string]interface{}{"transit_gateway_name1": transitGatewayPeering.TransitGatewayName1, "transit_gateway_name2": transitGatewayPeering.TransitGatewayName2})
 		return ErrNotFound
 	}
 	peeringList := data.Results
@@ -126,9 +129,7 @@ func (c *Client) GetTransitGatewayPeering(transitGatewayPeering *TransitGatewayP
 				peeringList[i][j].TransitGatewayName2 == transitGatewayPeering.TransitGatewayName2 ||
 				peeringList[i][j].TransitGatewayName1 == transitGatewayPeering.TransitGatewayName2 &&
 					peeringList[i][j].TransitGatewayName2 == transitGatewayPeering.TransitGatewayName1 {
-				log.Debugf("Found %s<->%s transit gateway peering: %#v",
-					transitGatewayPeering.TransitGatewayName1,
-					transitGatewayPeering.TransitGatewayName2, peeringList[i][j])
+				tflog.Debug(ctx, "Found transit gateway peering", map[string]interface{}{"transit_gateway_name1": transitGatewayPeering.TransitGatewayName1, "transit_gateway_name2": transitGatewayPeering.TransitGatewayName2})
 				return nil
 			}
 		}
@@ -137,6 +138,10 @@ func (c *Client) GetTransitGatewayPeering(transitGatewayPeering *TransitGatewayP
 }
 
 func (c *Client) GetTransitGatewayPeeringDetails(transitGatewayPeering *TransitGatewayPeering) (*TransitGatewayPeering, error) {
+	return c.GetTransitGatewayPeeringDetailsContext(context.Background(), transitGatewayPeering)
+}
+
+func (c *Client) GetTransitGatewayPeeringDetailsContext(ctx context.Context, transitGatewayPeering *TransitGatewayPeering) (*TransitGatewayPeering, error) {
 	form := map[string]string{
 		"action":   "get_inter_transit_gateway_peering_details",
 		"CID":      c.CID,
@@ -153,7 +158,7 @@ func (c *Client) GetTransitGatewayPeeringDetails(transitGatewayPeering *TransitG
 		return nil
 	}
 	var data TransitGatewayPeeringDetailsAPIResp
-	err := c.GetAPI(&data, form["action"], form, check)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, check)
 	if err != nil {
 		return nil, err
 	}
@@ -209,20 +214,32 @@ func (c *Client) GetTransitGatewayPeeringDetails(transitGatewayPeering *TransitG
 }
 
 func (c *Client) UpdateTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error {
+	return c.UpdateTransitGatewayPeeringContext(context.Background(), transitGatewayPeering)
+}
+
+func (c *Client) UpdateTransitGatewayPeeringContext(ctx context.Context, transitGatewayPeering *TransitGatewayPeering) error {
 	transitGatewayPeering.CID = c.CID
 	transitGatewayPeering.Action = "edit_inter_transit_gateway_peering"
 
-	return c.PostAPI(transitGatewayPeering.Action, transitGatewayPeering, BasicCheck)
+	return c.PostAPIContext(ctx, transitGatewayPeering.Action, transitGatewayPeering, BasicCheck)
 }
 
 func (c *Client) UpdateTransitGatewayPeeringTunnelCount(transitGatewayPeering *TransitGatewayPeeringEdit) error {
+	return c.UpdateTransitGatewayPeeringTunnelCountContext(context.Background(), transitGatewayPeering)
+}
+
+func (c *Client) UpdateTransitGatewayPeeringTunnelCountContext(ctx context.Context, transitGatewayPeering *TransitGatewayPeeringEdit) error {
 	transitGatewayPeering.CID = c.CID
 	transitGatewayPeering.Action = "edit_inter_transit_gateway_peering"
 
-	return c.PostAPI(transitGatewayPeering.Action, transitGatewayPeering, BasicCheck)
+	return c.PostAPIContext(ctx, transitGatewayPeering.Action, transitGatewayPeering, BasicCheck)
 }
 
 func (c *Client) DeleteTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error {
+	return c.DeleteTransitGatewayPeeringContext(context.Background(), transitGatewayPeering)
+}
+
+func (c *Client) DeleteTransitGatewayPeeringContext(ctx context.Context, transitGatewayPeering *TransitGatewayPeering) error {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "delete_inter_transit_gateway_peering",
@@ -230,12 +247,16 @@ func (c *Client) DeleteTransitGatewayPeering(transitGatewayPeering *TransitGatew
 		"gateway2": transitGatewayPeering.TransitGatewayName2,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EditTransitConnectionASPathPrepend(transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error {
+	return c.EditTransitConnectionASPathPrependContext(context.Background(), transitGatewayPeering, prependASPath)
+}
+
+func (c *Client) EditTransitConnectionASPathPrependContext(ctx context.Context, transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error {
 	action := "edit_transit_connection_as_path_prepend"
-	return c.PostAPI(action, struct {
+	return c.PostAPIContext(ctx, action, struct {
 		CID            string `form:"CID"`
 		Action         string `form:"action"`
 		GatewayName    string `form:"gateway_name"`
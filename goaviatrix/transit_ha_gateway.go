@@ -51,10 +51,14 @@ type BackupLinkInterface struct {
 }
 
 func (c *Client) CreateTransitHaGw(transitHaGateway *TransitHaGateway) (string, error) {
+	return c.CreateTransitHaGwContext(context.Background(), transitHaGateway)
+}
+
+func (c *Client) CreateTransitHaGwContext(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error) {
 	transitHaGateway.CID = c.CID
 	transitHaGateway.Action = "create_multicloud_ha_gateway"
 	var data CreateEdgeEquinixResp
-	resp, err := c.PostAPIContext2HaGw(context.Background(), &data, transitHaGateway.Action, transitHaGateway, BasicCheck)
+	resp, err := c.PostAPIContext2HaGw(ctx, &data, transitHaGateway.Action, transitHaGateway, BasicCheck)
 	if err != nil {
 		return "", err
 	}
@@ -6,10 +6,11 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"log"
 	"os"
 	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Gateway simple struct to hold gateway details
@@ -186,10 +187,14 @@ type TransitGatewayBgpLanIpInfo struct {
 }
 
 func (c *Client) LaunchTransitVpc(gateway *TransitVpc) error {
+	return c.LaunchTransitVpcContext(context.Background(), gateway)
+}
+
+func (c *Client) LaunchTransitVpcContext(ctx context.Context, gateway *TransitVpc) error {
 	gateway.CID = c.CID
 	gateway.Action = "create_multicloud_primary_gateway"
 	var data CreateEdgeEquinixResp
-	err := c.PostAPIWithResponse(&data, gateway.Action, gateway, BasicCheck)
+	err := c.PostAPIContextWithResponse(ctx, &data, gateway.Action, gateway, BasicCheck)
 	if err != nil {
 		return err
 	}
@@ -287,6 +292,10 @@ func (c *Client) AttachTransitGWForHybrid(gateway *TransitVpc) error {
 }
 
 func (c *Client) UpdateEdgeGateway(gateway *TransitVpc) error {
+	return c.UpdateEdgeGatewayContext(context.Background(), gateway)
+}
+
+func (c *Client) UpdateEdgeGatewayContext(ctx context.Context, gateway *TransitVpc) error {
 	form := map[string]interface{}{
 		"CID":          c.CID,
 		"action":       "update_edge_gateway",
@@ -318,8 +327,8 @@ func (c *Client) UpdateEdgeGateway(gateway *TransitVpc) error {
 	if !ok {
 		return fmt.Errorf("form[action] is not a string, got type %T", form["action"])
 	}
-	log.Printf("Formm details: %v", form)
-	return c.PostAPI(action, form, BasicCheck)
+	tflog.Debug(ctx, "Form details", map[string]interface{}{"action": action, "gw_name": gateway.GwName})
+	return c.PostAPIContext(ctx, action, form, BasicCheck)
 }
 
 func (c *Client) UpdateEdgeGatewayV2(ctx context.Context, gateway *TransitVpc) error {
@@ -556,6 +565,10 @@ func (c *Client) SetBgpEcmp(transitGateway *TransitVpc, enabled bool) error {
 }
 
 func (c *Client) GetTransitGatewayAdvancedConfig(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error) {
+	return c.GetTransitGatewayAdvancedConfigContext(context.Background(), transitGateway)
+}
+
+func (c *Client) GetTransitGatewayAdvancedConfigContext(ctx context.Context, transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error) {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "list_aviatrix_transit_advanced_config",
@@ -564,7 +577,7 @@ func (c *Client) GetTransitGatewayAdvancedConfig(transitGateway *TransitVpc) (*T
 
 	var data TransitGatewayAdvancedConfigResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -620,26 +633,38 @@ func (c *Client) SetTransitLearnedCIDRsApprovalMode(gw *TransitVpc, mode string)
 }
 
 func (c *Client) EnableTransitConnectionLearnedCIDRApproval(gwName, connName string) error {
+	return c.EnableTransitConnectionLearnedCIDRApprovalContext(context.Background(), gwName, connName)
+}
+
+func (c *Client) EnableTransitConnectionLearnedCIDRApprovalContext(ctx context.Context, gwName, connName string) error {
 	data := map[string]string{
 		"action":          "enable_bgp_connection_cidr_approval",
 		"CID":             c.CID,
 		"gateway_name":    gwName,
 		"connection_name": connName,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) DisableTransitConnectionLearnedCIDRApproval(gwName, connName string) error {
+	return c.DisableTransitConnectionLearnedCIDRApprovalContext(context.Background(), gwName, connName)
+}
+
+func (c *Client) DisableTransitConnectionLearnedCIDRApprovalContext(ctx context.Context, gwName, connName string) error {
 	data := map[string]string{
 		"action":          "disable_bgp_connection_cidr_approval",
 		"CID":             c.CID,
 		"gateway_name":    gwName,
 		"connection_name": connName,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) UpdateTransitConnectionPendingApprovedCidrs(gwName, connName string, approvedCidrs []string) error {
+	return c.UpdateTransitConnectionPendingApprovedCidrsContext(context.Background(), gwName, connName, approvedCidrs)
+}
+
+func (c *Client) UpdateTransitConnectionPendingApprovedCidrsContext(ctx context.Context, gwName, connName string, approvedCidrs []string) error {
 	data := map[string]string{
 		"action":          "set_bgp_connection_approved_cidr_rules",
 		"CID":             c.CID,
@@ -647,10 +672,14 @@ func (c *Client) UpdateTransitConnectionPendingApprovedCidrs(gwName, connName st
 		"connection_name": connName,
 		"cidr_rules":      strings.Join(approvedCidrs, ","),
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) EditTransitConnectionBGPManualAdvertiseCIDRs(gwName, connName string, cidrs []string) error {
+	return c.EditTransitConnectionBGPManualAdvertiseCIDRsContext(context.Background(), gwName, connName, cidrs)
+}
+
+func (c *Client) EditTransitConnectionBGPManualAdvertiseCIDRsContext(ctx context.Context, gwName, connName string, cidrs []string) error {
 	data := map[string]string{
 		"action":                                "edit_transit_connection_bgp_manual_advertise_cidrs",
 		"CID":                                   c.CID,
@@ -658,7 +687,7 @@ func (c *Client) EditTransitConnectionBGPManualAdvertiseCIDRs(gwName, connName s
 		"connection_name":                       connName,
 		"connection_bgp_manual_advertise_cidrs": strings.Join(cidrs, ","),
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) ChangeBgpHoldTime(gwName string, holdTime int) error {
@@ -708,6 +737,10 @@ func (c *Client) DisableMultitierTransit(gwName string) error {
 }
 
 func (c *Client) EditTransitConnectionRemoteSubnet(vpcId, connName, remoteSubnet string) error {
+	return c.EditTransitConnectionRemoteSubnetContext(context.Background(), vpcId, connName, remoteSubnet)
+}
+
+func (c *Client) EditTransitConnectionRemoteSubnetContext(ctx context.Context, vpcId, connName, remoteSubnet string) error {
 	data := map[string]string{
 		"action":      "edit_site2cloud_conn",
 		"CID":         c.CID,
@@ -715,10 +748,14 @@ func (c *Client) EditTransitConnectionRemoteSubnet(vpcId, connName, remoteSubnet
 		"conn_name":   connName,
 		"remote_cidr": remoteSubnet,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) GetBgpLanIPList(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error) {
+	return c.GetBgpLanIPListContext(context.Background(), transitGateway)
+}
+
+func (c *Client) GetBgpLanIPListContext(ctx context.Context, transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error) {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "list_aviatrix_transit_advanced_config",
@@ -727,7 +764,7 @@ func (c *Client) GetBgpLanIPList(transitGateway *TransitVpc) (*TransitGatewayBgp
 
 	var data TransitGatewayBgpLanIpInfoResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
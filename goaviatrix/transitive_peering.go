@@ -2,13 +2,14 @@ package goaviatrix
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"strings"
 
 	//"github.com/davecgh/go-spew/spew"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // TransPeer simple struct to hold transitive peering details
@@ -28,17 +29,25 @@ type TransPeerListResp struct {
 }
 
 func (c *Client) CreateTransPeer(transPeer *TransPeer) error {
+	return c.CreateTransPeerContext(context.Background(), transPeer)
+}
+
+func (c *Client) CreateTransPeerContext(ctx context.Context, transPeer *TransPeer) error {
 	transPeer.CID = c.CID
 	transPeer.Action = "add_extended_vpc_peer"
 
-	return c.PostAPI(transPeer.Action, transPeer, BasicCheck)
+	return c.PostAPIContext(ctx, transPeer.Action, transPeer, BasicCheck)
 }
 
 func (c *Client) GetTransPeer(transPeer *TransPeer) (*TransPeer, error) {
+	return c.GetTransPeerContext(context.Background(), transPeer)
+}
+
+func (c *Client) GetTransPeerContext(ctx context.Context, transPeer *TransPeer) (*TransPeer, error) {
 	// TODO: use GetAPI - need API details
 	transPeer.CID = c.CID
 	transPeer.Action = "list_extended_vpc_peer"
-	resp, err := c.Post(c.baseURL, transPeer)
+	resp, err := c.PostContext(ctx, c.baseURL, transPeer)
 	if err != nil {
 		return nil, errors.New("HTTP Post list_extended_vpc_peer failed: " + err.Error())
 	}
@@ -59,8 +68,7 @@ func (c *Client) GetTransPeer(transPeer *TransPeer) (*TransPeer, error) {
 			return &transPeerList[i], nil
 		}
 	}
-	log.Errorf("Transitive peering with gateways %s and %s with subnet %s not found",
-		transPeer.Source, transPeer.Nexthop, transPeer.ReachableCidr)
+	tflog.Error(ctx, "Transitive peering not found", map[string]interface{}{"source": transPeer.Source, "nexthop": transPeer.Nexthop, "reachable_cidr": transPeer.ReachableCidr})
 	return nil, ErrNotFound
 }
 
@@ -69,8 +77,12 @@ func (c *Client) UpdateTransPeer(transPeer *TransPeer) error {
 }
 
 func (c *Client) DeleteTransPeer(transPeer *TransPeer) error {
+	return c.DeleteTransPeerContext(context.Background(), transPeer)
+}
+
+func (c *Client) DeleteTransPeerContext(ctx context.Context, transPeer *TransPeer) error {
 	transPeer.CID = c.CID
 	transPeer.Action = "delete_extended_vpc_peer"
 
-	return c.PostAPI(transPeer.Action, transPeer, BasicCheck)
+	return c.PostAPIContext(ctx, transPeer.Action, transPeer, BasicCheck)
 }
@@ -3,7 +3,9 @@ package goaviatrix
 // Tunnel simple struct to hold tunnel details
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Tunnel struct {
@@ -26,6 +28,10 @@ type TunnelListResp struct {
 }
 
 func (c *Client) CreateTunnel(tunnel *Tunnel) error {
+	return c.CreateTunnelContext(context.Background(), tunnel)
+}
+
+func (c *Client) CreateTunnelContext(ctx context.Context, tunnel *Tunnel) error {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "peer_vpc_pair",
@@ -34,10 +40,14 @@ func (c *Client) CreateTunnel(tunnel *Tunnel) error {
 		"ha_enabled": tunnel.EnableHA,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetTunnel(tunnel *Tunnel) (*Tunnel, error) {
+	return c.GetTunnelContext(context.Background(), tunnel)
+}
+
+func (c *Client) GetTunnelContext(ctx context.Context, tunnel *Tunnel) (*Tunnel, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_peer_vpc_pairs",
@@ -45,7 +55,7 @@ func (c *Client) GetTunnel(tunnel *Tunnel) (*Tunnel, error) {
 
 	var data TunnelListResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -53,11 +63,11 @@ func (c *Client) GetTunnel(tunnel *Tunnel) (*Tunnel, error) {
 	tunList := data.Results.PairList
 	for i := range tunList {
 		if tunList[i].VpcName1 == tunnel.VpcName1 && tunList[i].VpcName2 == tunnel.VpcName2 {
-			log.Debugf("Found %s~%s tunnel: %#v", tunnel.VpcName1, tunnel.VpcName2, tunList[i])
+			tflog.Debug(ctx, "Found tunnel", map[string]interface{}{"vpc_name1": tunnel.VpcName1, "vpc_name2": tunnel.VpcName2})
 			return &tunList[i], nil
 		}
 	}
-	log.Errorf("Tunnel with gateways %s and %s not found", tunnel.VpcName1, tunnel.VpcName2)
+	tflog.Error(ctx, "Tunnel not found", map[string]interface{}{"vpc_name1": tunnel.VpcName1, "vpc_name2": tunnel.VpcName2})
 	return nil, ErrNotFound
 }
 
@@ -66,6 +76,10 @@ func (c *Client) UpdateTunnel(tunnel *Tunnel) error {
 }
 
 func (c *Client) DeleteTunnel(tunnel *Tunnel) error {
+	return c.DeleteTunnelContext(context.Background(), tunnel)
+}
+
+func (c *Client) DeleteTunnelContext(ctx context.Context, tunnel *Tunnel) error {
 	form := map[string]string{
 		"CID":       c.CID,
 		"action":    "unpeer_vpc_pair",
@@ -73,5 +87,5 @@ func (c *Client) DeleteTunnel(tunnel *Tunnel) error {
 		"vpc_name2": tunnel.VpcName2,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
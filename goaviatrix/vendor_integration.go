@@ -38,6 +38,10 @@ type FirewallManager struct {
 }
 
 func (c *Client) EditFireNetFirewallVendorInfo(vendorInfo *VendorInfo) error {
+	return c.EditFireNetFirewallVendorInfoContext(context.Background(), vendorInfo)
+}
+
+func (c *Client) EditFireNetFirewallVendorInfoContext(ctx context.Context, vendorInfo *VendorInfo) error {
 	form := map[string]string{
 		"CID":             c.CID,
 		"action":          "edit_firenet_firewall_vendor_info",
@@ -52,10 +56,14 @@ func (c *Client) EditFireNetFirewallVendorInfo(vendorInfo *VendorInfo) error {
 		"public_ip":       vendorInfo.PublicIP,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) ShowFireNetFirewallVendorConfig(vendorInfo *VendorInfo) error {
+	return c.ShowFireNetFirewallVendorConfigContext(context.Background(), vendorInfo)
+}
+
+func (c *Client) ShowFireNetFirewallVendorConfigContext(ctx context.Context, vendorInfo *VendorInfo) error {
 	form := map[string]string{
 		"CID":         c.CID,
 		"action":      "show_firenet_firewall_vendor_config",
@@ -64,7 +72,7 @@ func (c *Client) ShowFireNetFirewallVendorConfig(vendorInfo *VendorInfo) error {
 		"sync":        "true",
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EditFireNetFirewallManagerVendorInfo(ctx context.Context, firewallManager *FirewallManager) error {
@@ -98,6 +106,10 @@ func (c *Client) SyncFireNetFirewallManagerVendorConfig(ctx context.Context, fir
 }
 
 func (c *Client) EditFireNetFirewallVendorInfoWithPrivateKey(vendorInfo *VendorInfo) error {
+	return c.EditFireNetFirewallVendorInfoWithPrivateKeyContext(context.Background(), vendorInfo)
+}
+
+func (c *Client) EditFireNetFirewallVendorInfoWithPrivateKeyContext(ctx context.Context, vendorInfo *VendorInfo) error {
 	params := map[string]string{
 		"action":          "edit_firenet_firewall_vendor_info",
 		"CID":             c.CID,
@@ -119,5 +131,5 @@ func (c *Client) EditFireNetFirewallVendorInfoWithPrivateKey(vendorInfo *VendorI
 	}
 	files = append(files, key)
 
-	return c.PostFileAPI(params, files, BasicCheck)
+	return c.PostFileAPIContext(ctx, params, files, BasicCheck)
 }
@@ -29,6 +29,10 @@ type VersionInfo struct {
 }
 
 func (c *Client) GetCurrentVersion() (string, error) {
+	return c.GetCurrentVersionContext(context.Background())
+}
+
+func (c *Client) GetCurrentVersionContext(ctx context.Context) (string, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_version_info",
@@ -36,7 +40,7 @@ func (c *Client) GetCurrentVersion() (string, error) {
 
 	var data VersionInfoResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return "", err
 	}
@@ -44,6 +48,10 @@ func (c *Client) GetCurrentVersion() (string, error) {
 }
 
 func (c *Client) GetVersionInfo() (*VersionInfo, error) {
+	return c.GetVersionInfoContext(context.Background())
+}
+
+func (c *Client) GetVersionInfoContext(ctx context.Context) (*VersionInfo, error) {
 	form := map[string]string{
 		"action": "list_version_info",
 		"CID":    c.CID,
@@ -54,7 +62,7 @@ func (c *Client) GetVersionInfo() (*VersionInfo, error) {
 			CurrentVersion  string `json:"current_version"`
 		}
 	}
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
 )
@@ -69,6 +70,10 @@ type VGWConnBgpManualSpokeAdvertisedNetworksResp struct {
 }
 
 func (c *Client) CreateVGWConn(vgwConn *VGWConn) error {
+	return c.CreateVGWConnContext(context.Background(), vgwConn)
+}
+
+func (c *Client) CreateVGWConnContext(ctx context.Context, vgwConn *VGWConn) error {
 	form := map[string]string{
 		"CID":                  c.CID,
 		"action":               "connect_transit_gw_to_vgw",
@@ -81,7 +86,7 @@ func (c *Client) CreateVGWConn(vgwConn *VGWConn) error {
 		"bgp_local_as_number":  vgwConn.BgpLocalAsNum,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetVGWConn(vgwConn *VGWConn) (*VGWConn, error) {
@@ -115,16 +120,24 @@ func (c *Client) UpdateVGWConn(vgwConn *VGWConn) error {
 }
 
 func (c *Client) DeleteVGWConn(vgwConn *VGWConn) error {
+	return c.DeleteVGWConnContext(context.Background(), vgwConn)
+}
+
+func (c *Client) DeleteVGWConnContext(ctx context.Context, vgwConn *VGWConn) error {
 	form := map[string]string{
 		"CID":             c.CID,
 		"action":          "disconnect_transit_gw_from_vgw",
 		"vpc_id":          vgwConn.VPCId,
 		"connection_name": vgwConn.ConnName,
 	}
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetVGWConnDetail(vgwConn *VGWConn) (*VGWConn, error) {
+	return c.GetVGWConnDetailContext(context.Background(), vgwConn)
+}
+
+func (c *Client) GetVGWConnDetailContext(ctx context.Context, vgwConn *VGWConn) (*VGWConn, error) {
 	params := map[string]string{
 		"CID":       c.CID,
 		"action":    "get_site2cloud_conn_detail",
@@ -141,7 +154,7 @@ func (c *Client) GetVGWConnDetail(vgwConn *VGWConn) (*VGWConn, error) {
 		return nil
 	}
 	var data VGWConnDetailResp
-	err := c.GetAPI(&data, params["action"], params, check)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, check)
 	if err != nil {
 		return nil, err
 	}
@@ -161,8 +174,12 @@ func (c *Client) GetVGWConnDetail(vgwConn *VGWConn) (*VGWConn, error) {
 }
 
 func (c *Client) EditVgwConnectionASPathPrepend(vgwConn *VGWConn, prependASPath []string) error {
+	return c.EditVgwConnectionASPathPrependContext(context.Background(), vgwConn, prependASPath)
+}
+
+func (c *Client) EditVgwConnectionASPathPrependContext(ctx context.Context, vgwConn *VGWConn, prependASPath []string) error {
 	action := "edit_transit_connection_as_path_prepend"
-	return c.PostAPI(action, struct {
+	return c.PostAPIContext(ctx, action, struct {
 		CID            string `form:"CID"`
 		Action         string `form:"action"`
 		GatewayName    string `form:"gateway_name"`
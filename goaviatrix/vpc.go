@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -73,6 +74,10 @@ type SubnetInfo struct {
 }
 
 func (c *Client) CreateVpc(vpc *Vpc) error {
+	return c.CreateVpcContext(context.Background(), vpc)
+}
+
+func (c *Client) CreateVpcContext(ctx context.Context, vpc *Vpc) error {
 	action := "create_custom_vpc"
 	form := map[string]string{
 		"CID":          c.CID,
@@ -124,7 +129,7 @@ func (c *Client) CreateVpc(vpc *Vpc) error {
 		form["vpc_ipv6_cidr"] = vpc.VpcIpv6Cidr
 	}
 
-	return c.PostAPI(action, form, BasicCheck)
+	return c.PostAPIContext(ctx, action, form, BasicCheck)
 }
 
 // GetVpcCloudTypeById returns the cloud_type of the vpc with the given ID.
@@ -174,6 +179,10 @@ func (c *Client) GetCloudTypeFromVpcID(vpcID string) (int, error) {
 }
 
 func (c *Client) GetVpc(vpc *Vpc) (*Vpc, error) {
+	return c.GetVpcContext(context.Background(), vpc)
+}
+
+func (c *Client) GetVpcContext(ctx context.Context, vpc *Vpc) (*Vpc, error) {
 	form := map[string]string{
 		"action":   "get_custom_vpc_by_name",
 		"CID":      c.CID,
@@ -189,7 +198,7 @@ func (c *Client) GetVpc(vpc *Vpc) (*Vpc, error) {
 		}
 		return nil
 	}
-	err := c.GetAPI(&data, form["action"], form, check)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, check)
 	if err != nil {
 		return nil, err
 	}
@@ -221,6 +230,10 @@ func (c *Client) GetVpc(vpc *Vpc) (*Vpc, error) {
 }
 
 func (c *Client) GetVpcRouteTableIDs(vpc *Vpc) ([]string, error) {
+	return c.GetVpcRouteTableIDsContext(context.Background(), vpc)
+}
+
+func (c *Client) GetVpcRouteTableIDsContext(ctx context.Context, vpc *Vpc) ([]string, error) {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "list_vpc_route_tables",
@@ -244,7 +257,7 @@ func (c *Client) GetVpcRouteTableIDs(vpc *Vpc) ([]string, error) {
 	}
 	var data Resp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -262,6 +275,10 @@ func (c *Client) UpdateVpc(vpc *Vpc) error {
 }
 
 func (c *Client) DeleteVpc(vpc *Vpc) error {
+	return c.DeleteVpcContext(context.Background(), vpc)
+}
+
+func (c *Client) DeleteVpcContext(ctx context.Context, vpc *Vpc) error {
 	form := map[string]string{
 		"CID":          c.CID,
 		"action":       "delete_custom_vpc",
@@ -269,7 +286,7 @@ func (c *Client) DeleteVpc(vpc *Vpc) error {
 		"pool_name":    vpc.Name,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EnableNativeAwsGwlbFirenet(vpc *Vpc) error {
@@ -293,6 +310,10 @@ func (c *Client) DisableNativeAwsGwlbFirenet(vpc *Vpc) error {
 }
 
 func (c *Client) ListOciVpcAvailabilityDomains(vpc *Vpc) ([]string, error) {
+	return c.ListOciVpcAvailabilityDomainsContext(context.Background(), vpc)
+}
+
+func (c *Client) ListOciVpcAvailabilityDomainsContext(ctx context.Context, vpc *Vpc) ([]string, error) {
 	params := map[string]string{
 		"action":       "list_oci_vpc_availability_domains",
 		"CID":          c.CID,
@@ -308,7 +329,7 @@ func (c *Client) ListOciVpcAvailabilityDomains(vpc *Vpc) ([]string, error) {
 
 	var data Resp
 
-	err := c.GetAPI(&data, params["action"], params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -317,6 +338,10 @@ func (c *Client) ListOciVpcAvailabilityDomains(vpc *Vpc) ([]string, error) {
 }
 
 func (c *Client) ListOciVpcFaultDomains(vpc *Vpc) ([]string, error) {
+	return c.ListOciVpcFaultDomainsContext(context.Background(), vpc)
+}
+
+func (c *Client) ListOciVpcFaultDomainsContext(ctx context.Context, vpc *Vpc) ([]string, error) {
 	params := map[string]string{
 		"action":       "list_oci_vpc_fault_domains",
 		"CID":          c.CID,
@@ -332,7 +357,7 @@ func (c *Client) ListOciVpcFaultDomains(vpc *Vpc) ([]string, error) {
 
 	var data Resp
 
-	err := c.GetAPI(&data, params["action"], params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, params["action"], params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -1,9 +1,10 @@
 package goaviatrix
 
 import (
+	"context"
 	"math"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type VpcTracker struct {
@@ -43,6 +44,10 @@ type VpcTrackerResp struct {
 
 // GetVpcTracker retrieves the list of VPC's from the 'VPC Tracker' feature.
 func (c *Client) GetVpcTracker() ([]*VpcTracker, error) {
+	return c.GetVpcTrackerContext(context.Background())
+}
+
+func (c *Client) GetVpcTrackerContext(ctx context.Context) ([]*VpcTracker, error) {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "cloud_network_info",
@@ -52,7 +57,7 @@ func (c *Client) GetVpcTracker() ([]*VpcTracker, error) {
 
 	var data VpcTrackerResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +78,7 @@ func (c *Client) GetVpcTracker() ([]*VpcTracker, error) {
 		}
 
 		vpcList = append(vpcList, &VpcTracker{
-			CloudType:     vendorNameToCloudType(vpc.VendorName),
+			CloudType:     vendorNameToCloudType(ctx, vpc.VendorName),
 			VpcID:         vpc.VpcID,
 			AccountName:   vpc.AccountName,
 			Region:        vpc.Region,
@@ -87,7 +92,7 @@ func (c *Client) GetVpcTracker() ([]*VpcTracker, error) {
 	return vpcList, nil
 }
 
-func vendorNameToCloudType(v string) int {
+func vendorNameToCloudType(ctx context.Context, v string) int {
 	vendorToCloud := map[string]int{
 		ShorthandAWSVendorName:           AWS,
 		ShorthandGOOGLEVendorName:        GCP,
@@ -101,7 +106,7 @@ func vendorNameToCloudType(v string) int {
 	}
 	ct, ok := vendorToCloud[v]
 	if !ok {
-		log.Errorf("could not map vendor name to cloud type with vendor=%s", v)
+		tflog.Error(ctx, "could not map vendor name to cloud type", map[string]interface{}{"vendor": v})
 		return 0
 	}
 	return ct
@@ -1,5 +1,7 @@
 package goaviatrix
 
+import "context"
+
 type VPNCertDownload struct {
 	CID          string `form:"CID,omitempty"`
 	Action       string `form:"action,omitempty"`
@@ -18,22 +20,34 @@ type GetVPNCertDownloadStatusResp struct {
 }
 
 func (c *Client) EnableVPNCertDownload(vpnCertDownload *VPNCertDownload) error {
+	return c.EnableVPNCertDownloadContext(context.Background(), vpnCertDownload)
+}
+
+func (c *Client) EnableVPNCertDownloadContext(ctx context.Context, vpnCertDownload *VPNCertDownload) error {
 	vpnCertDownload.CID = c.CID
 	vpnCertDownload.Action = "enable_vpn_client_download"
-	return c.PostAPI(vpnCertDownload.Action, vpnCertDownload, BasicCheck)
+	return c.PostAPIContext(ctx, vpnCertDownload.Action, vpnCertDownload, BasicCheck)
 }
 
 func (c *Client) DisableVPNCertDownload() error {
+	return c.DisableVPNCertDownloadContext(context.Background())
+}
+
+func (c *Client) DisableVPNCertDownloadContext(ctx context.Context) error {
 	var vpnCertDownload VPNCertDownload
 	vpnCertDownload.CID = c.CID
 	vpnCertDownload.Action = "disable_vpn_client_download"
-	return c.PostAPI(vpnCertDownload.Action, vpnCertDownload, BasicCheck)
+	return c.PostAPIContext(ctx, vpnCertDownload.Action, vpnCertDownload, BasicCheck)
 }
 
 func (c *Client) GetVPNCertDownloadStatus() (*GetVPNCertDownloadStatusResp, error) {
+	return c.GetVPNCertDownloadStatusContext(context.Background())
+}
+
+func (c *Client) GetVPNCertDownloadStatusContext(ctx context.Context) (*GetVPNCertDownloadStatusResp, error) {
 	params := map[string]string{"CID": c.CID, "action": "get_allow_downloading_vpn_client_status"}
 	var data GetVPNCertDownloadStatusResp
-	err := c.GetAPI(&data, "get_allow_downloading_vpn_client_status", params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, "get_allow_downloading_vpn_client_status", params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -31,6 +32,10 @@ type VPNUserInfo struct {
 }
 
 func (c *Client) CreateVPNUser(vpnUser *VPNUser) error {
+	return c.CreateVPNUserContext(context.Background(), vpnUser)
+}
+
+func (c *Client) CreateVPNUserContext(ctx context.Context, vpnUser *VPNUser) error {
 	form := map[string]string{
 		"CID":           c.CID,
 		"action":        "add_vpn_user",
@@ -57,10 +62,14 @@ func (c *Client) CreateVPNUser(vpnUser *VPNUser) error {
 		return nil
 	}
 
-	return c.PostAPI(form["action"], form, checkFunc)
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
 }
 
 func (c *Client) GetVPNUser(vpnUser *VPNUser) (*VPNUser, error) {
+	return c.GetVPNUserContext(context.Background(), vpnUser)
+}
+
+func (c *Client) GetVPNUserContext(ctx context.Context, vpnUser *VPNUser) (*VPNUser, error) {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "get_vpn_user_by_name",
@@ -79,7 +88,7 @@ func (c *Client) GetVPNUser(vpnUser *VPNUser) (*VPNUser, error) {
 		return nil
 	}
 
-	err := c.GetAPI(&data, form["action"], form, checkFunc)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, checkFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +105,10 @@ func (c *Client) GetVPNUser(vpnUser *VPNUser) (*VPNUser, error) {
 }
 
 func (c *Client) DeleteVPNUser(vpnUser *VPNUser) error {
+	return c.DeleteVPNUserContext(context.Background(), vpnUser)
+}
+
+func (c *Client) DeleteVPNUserContext(ctx context.Context, vpnUser *VPNUser) error {
 	form := map[string]string{
 		"CID":      c.CID,
 		"action":   "delete_vpn_user",
@@ -109,5 +122,58 @@ func (c *Client) DeleteVPNUser(vpnUser *VPNUser) error {
 		form["vpc_id"] = vpnUser.VpcID
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
+}
+
+type VPNUserListResp struct {
+	Return  bool      `json:"return"`
+	Results []VPNUser `json:"results"`
+	Reason  string    `json:"reason"`
+}
+
+// UpdateVPNUserContext changes the email and SAML endpoint of a VPN user in
+// place, without re-issuing the user's certificate.
+func (c *Client) UpdateVPNUserContext(ctx context.Context, vpnUser *VPNUser) error {
+	form := map[string]string{
+		"CID":           c.CID,
+		"action":        "edit_vpn_user",
+		"username":      vpnUser.UserName,
+		"user_email":    vpnUser.UserEmail,
+		"saml_endpoint": vpnUser.SamlEndpoint,
+	}
+
+	if vpnUser.DnsEnabled {
+		form["dns"] = "true"
+		form["lb_name"] = vpnUser.DnsName
+	} else {
+		form["vpc_id"] = vpnUser.VpcID
+		form["lb_name"] = vpnUser.GwName
+	}
+
+	checkFunc := func(act, method, reason string, ret bool) error {
+		if !ret {
+			if strings.Contains(reason, "Sending VPN certificates to email") {
+				return nil
+			}
+			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
+		}
+		return nil
+	}
+
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
+}
+
+// ListVPNUsersContext returns all VPN users of the controller.
+func (c *Client) ListVPNUsersContext(ctx context.Context) ([]VPNUser, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_vpn_users",
+	}
+
+	var data VPNUserListResp
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return data.Results, nil
 }
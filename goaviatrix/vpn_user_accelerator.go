@@ -1,5 +1,7 @@
 package goaviatrix
 
+import "context"
+
 type VpnUserXlr struct {
 	Action         string `form:"action,omitempty"`
 	CID            string `form:"CID,omitempty"`
@@ -16,6 +18,10 @@ type VpnUserXlrAPIResp struct {
 }
 
 func (c *Client) GetVpnUserAccelerator() ([]string, error) {
+	return c.GetVpnUserAcceleratorContext(context.Background())
+}
+
+func (c *Client) GetVpnUserAcceleratorContext(ctx context.Context) ([]string, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_vpn_user_xlr",
@@ -23,7 +29,7 @@ func (c *Client) GetVpnUserAccelerator() ([]string, error) {
 
 	var data VpnUserXlrAPIResp
 
-	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -32,8 +38,12 @@ func (c *Client) GetVpnUserAccelerator() ([]string, error) {
 }
 
 func (c *Client) UpdateVpnUserAccelerator(xlr *VpnUserXlr) error {
+	return c.UpdateVpnUserAcceleratorContext(context.Background(), xlr)
+}
+
+func (c *Client) UpdateVpnUserAcceleratorContext(ctx context.Context, xlr *VpnUserXlr) error {
 	xlr.CID = c.CID
 	xlr.Action = "update_vpn_user_xlr"
 
-	return c.PostAPI(xlr.Action, xlr, BasicCheck)
+	return c.PostAPIContext(ctx, xlr.Action, xlr, BasicCheck)
 }
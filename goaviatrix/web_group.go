@@ -3,7 +3,6 @@ package goaviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 )
 
 type WebGroupMatchExpression struct {
@@ -43,7 +42,6 @@ func webGroupFilterToMap(filter *WebGroupMatchExpression) map[string]string {
 		filterMap["urlfilter"] = filter.UrlFilter
 	}
 
-	log.Printf("[DEBUG] POST filter map: %v\n", filterMap)
 	return filterMap
 }
 
@@ -252,4 +252,5 @@ SetEnv SKIP_VPN_CERT_DOWNLOAD "no"
 SetEnv SKIP_VPN_PROFILE "no"
 SetEnv SKIP_VPN_USER "no"
 SetEnv SKIP_VPN_USER_ACCELERATOR "no"
+SetEnv SKIP_VPN_USERS "no"
 SetEnv SKIP_WEB_GROUP "no"